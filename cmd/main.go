@@ -3,11 +3,13 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"os/signal"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -15,14 +17,15 @@ import (
 	"github.com/layer5io/meshery/helpers"
 	"github.com/layer5io/meshery/internal/graphql"
 	"github.com/layer5io/meshery/internal/store"
+	"github.com/layer5io/meshery/meshes"
 	"github.com/layer5io/meshery/models"
 	"github.com/layer5io/meshery/models/pattern/core"
+	"github.com/layer5io/meshery/models/pattern/secrets"
 	"github.com/layer5io/meshery/router"
 	"github.com/layer5io/meshkit/broker/nats"
 	"github.com/layer5io/meshkit/database"
 	"github.com/layer5io/meshkit/logger"
 	"github.com/layer5io/meshkit/utils/broadcast"
-	meshsyncmodel "github.com/layer5io/meshsync/pkg/model"
 	"github.com/spf13/viper"
 
 	"github.com/sirupsen/logrus"
@@ -82,8 +85,64 @@ func main() {
 
 	viper.SetDefault("SKIP_DOWNLOAD_CONTENT", false)
 	viper.SetDefault("SKIP_COMP_GEN", false)
+	viper.SetDefault("DB_AUTO_MIGRATE", true)
+	viper.SetDefault("ADAPTER_HEALTH_CHECK_INTERVAL", 30*time.Second)
+	viper.SetDefault("ADAPTER_MTLS", false)
+	viper.SetDefault("ADAPTER_TLS_SECRET", "meshery-adapter-tls")
+	viper.SetDefault("ADAPTER_TLS_RENEW_CHECK_INTERVAL", 24*time.Hour)
+	viper.SetDefault("RESULT_SINK_TIMESCALE_TABLE", "meshery_perf_results")
+	viper.SetDefault("RESULT_SINK_PUSHGATEWAY_JOB", "meshery")
+	viper.SetDefault("RESULT_ARCHIVE_INTERVAL", time.Hour)
+	viper.SetDefault("RESULT_ARCHIVE_S3_REGION", "us-east-1")
+	// Deleted designs and performance profiles are kept in the trash for TRASH_RETENTION, a retention
+	// of zero keeping them until restored
+	viper.SetDefault("TRASH_RETENTION", models.DefaultTrashRetention)
+	viper.SetDefault("TRASH_PURGE_INTERVAL", time.Hour)
+	// The background jobs are listed along with the last JOB_HISTORY of them completed
+	viper.SetDefault("JOB_HISTORY", 100)
+	// Results whose p99 latency is more than RESULT_ANOMALY_THRESHOLD standard deviations away from the
+	// last RESULT_ANOMALY_WINDOW results of their profile are flagged, once the profile has enough of them
+	viper.SetDefault("RESULT_ANOMALY_THRESHOLD", 3.0)
+	viper.SetDefault("RESULT_ANOMALY_WINDOW", 20)
+	viper.SetDefault("RESULT_ANOMALY_MIN_SAMPLES", 5)
+	// Every week, the trend of the p99 latency of every profile over the last TREND_REPORT_WEEKS weeks is
+	// reported, the profiles whose p99 latency grew by more than TREND_REGRESSION_THRESHOLD over them regressing
+	viper.SetDefault("TREND_REPORT_WEEKS", 4)
+	viper.SetDefault("TREND_REGRESSION_THRESHOLD", 0.1)
+	viper.SetDefault("TREND_REPORT_CHECK_INTERVAL", time.Hour)
+	// Uploaded kubeconfigs authenticating with an exec credential plugin are rejected unless the policy
+	// is allow, running the plugin on every connection, or exchange, trading it for a ServiceAccount token
+	viper.SetDefault("KUBECONFIG_EXEC_PLUGINS", models.ExecPluginsDeny)
+	viper.SetDefault("KUBECONFIG_EXEC_COMMANDS", strings.Join(models.DefaultExecPluginCommands, ","))
+	viper.SetDefault("KUBECONFIG_EXEC_CLUSTER_ROLE", models.DefaultConnectionClusterRole)
+	viper.SetDefault("KUBECONFIG_EXEC_TOKEN_EXPIRATION", models.DefaultConnectionTokenExpiration)
+	// Tests against the same service or namespace are queued rather than run concurrently
+	viper.SetDefault("PERF_TEST_ISOLATION", true)
+	// Tests exceeding PERF_MAX_QPS, PERF_MAX_DURATION or PERF_MAX_CONNECTIONS are rejected, a limit of zero
+	// leaving the setting unlimited, unless the admins set load test limits for the workspace of their user
+	viper.SetDefault("PERF_MAX_QPS", 0)
+	viper.SetDefault("PERF_MAX_DURATION", time.Duration(0))
+	viper.SetDefault("PERF_MAX_CONNECTIONS", 0)
+	// Once a test completes, the load generators writing its client-side metrics through remote_write
+	// are waited for during PERF_REMOTE_WRITE_FLUSH_GRACE
+	viper.SetDefault("PERF_REMOTE_WRITE_FLUSH_GRACE", 10*time.Second)
+	// The digests of the users are emailed through the SMTP server at SMTP_HOST, once configured
+	viper.SetDefault("SMTP_PORT", 587)
+	viper.SetDefault("DIGEST_CHECK_INTERVAL", time.Hour)
+	// The design schedules due are run every DESIGN_SCHEDULE_CHECK_INTERVAL
+	viper.SetDefault("DESIGN_SCHEDULE_CHECK_INTERVAL", 30*time.Second)
+	// The last DEBUG_LOG_ENTRIES entries logged at the log level of the server are kept for the admins,
+	// through mesheryctl -vvv, to pull the server-side debug of their requests, zero keeping none
+	viper.SetDefault("DEBUG_LOG_ENTRIES", 0)
 	store.Initialize()
 
+	var debugLogRecorder *helpers.DebugLogRecorder
+	if entries := viper.GetInt("DEBUG_LOG_ENTRIES"); entries > 0 {
+		debugLogRecorder = helpers.NewDebugLogRecorder(entries)
+		logrus.AddHook(debugLogRecorder)
+		log = debugLogRecorder.WrapLogger(log)
+	}
+
 	// Register local OAM traits and workloads
 	if err := core.RegisterMesheryOAMTraits(); err != nil {
 		logrus.Error(err)
@@ -91,6 +150,9 @@ func main() {
 	if err := core.RegisterMesheryOAMWorkloads(); err != nil {
 		logrus.Error(err)
 	}
+	if err := core.RegisterMesheryMigrations(); err != nil {
+		logrus.Error(err)
+	}
 	logrus.Info("Registered Meshery local Capabilities")
 
 	// Get the channel
@@ -121,11 +183,42 @@ func main() {
 	if viper.GetBool("DEBUG") {
 		logrus.SetLevel(logrus.DebugLevel)
 	}
+	if level := viper.GetString("LOG_LEVEL"); level != "" {
+		if l, err := logrus.ParseLevel(level); err != nil {
+			logrus.Errorf("invalid log level %s: %v", level, err)
+		} else {
+			logrus.SetLevel(l)
+		}
+	}
 	logrus.Infof("Log level: %s", logrus.GetLevel())
 
 	adapterURLs := viper.GetStringSlice("ADAPTER_URLS")
 
+	var adapterCerts *helpers.AdapterCerts
+	if viper.GetBool("ADAPTER_MTLS") {
+		if viper.GetString("ADAPTER_TLS_FOLDER") == "" {
+			viper.SetDefault("ADAPTER_TLS_FOLDER", path.Join(home, ".meshery", "tls"))
+		}
+		tlsFolder := viper.GetString("ADAPTER_TLS_FOLDER")
+
+		// The serving certificate handed to the adapters is valid for the hosts they are reached at
+		hosts := []string{"localhost", "127.0.0.1"}
+		for _, u := range adapterURLs {
+			if host, _, err := net.SplitHostPort(u); err == nil {
+				hosts = append(hosts, host)
+			}
+		}
+		adapterCerts = helpers.NewAdapterCerts(tlsFolder, hosts)
+		// Running in a cluster, the CA and the serving certificate are published to the ADAPTER_TLS_SECRET
+		// Secret of the namespace of Meshery Server, for the adapters to mount
+		if err := adapterCerts.PublishToClusterSecret(viper.GetString("ADAPTER_TLS_SECRET")); err != nil {
+			logrus.Debugf("The certificates of the adapters are not published to a Secret: %v", err)
+		}
+		logrus.Infof("Using mutual TLS for the adapters with the certificates in '%s'", tlsFolder)
+	}
+
 	adapterTracker := helpers.NewAdaptersTracker(adapterURLs)
+	adapterHealthTracker := helpers.NewAdapterHealthTracker(adapterTracker, viper.GetDuration("ADAPTER_HEALTH_CHECK_INTERVAL"))
 	queryTracker := helpers.NewUUIDQueryTracker()
 
 	// Uncomment line below to generate a new UUID and force the user to login every time Meshery is started.
@@ -158,31 +251,79 @@ func main() {
 	meshsyncCh := make(chan struct{})
 	brokerConn := nats.NewEmptyConnection
 
-	err = dbHandler.AutoMigrate(
-		&meshsyncmodel.KeyValue{},
-		&meshsyncmodel.Object{},
-		&meshsyncmodel.ResourceSpec{},
-		&meshsyncmodel.ResourceStatus{},
-		&meshsyncmodel.ResourceObjectMeta{},
-		&models.PerformanceProfile{},
-		&models.MesheryResult{},
-		&models.MesheryPattern{},
-		&models.MesheryFilter{},
-		&models.PatternResource{},
-		&models.MesheryApplication{},
-		&models.UserPreference{},
-		&models.PerformanceTestConfig{},
-		&models.SmiResultWithID{},
-		models.K8sContext{},
-	)
+	// The schema of the database is migrated to the version of Meshery Server as it starts, unless
+	// DB_AUTO_MIGRATE is false for the operator to run the migrations with mesheryctl system db migrate
+	schemaMigrator := models.NewSchemaMigrator(&dbHandler, models.SchemaMigrations)
+	if viper.GetBool("DB_AUTO_MIGRATE") {
+		if _, err := schemaMigrator.Migrate(0); err != nil {
+			logrus.Fatal(err)
+		}
+	}
+	schemaStatus, err := schemaMigrator.Status()
 	if err != nil {
 		logrus.Fatal(err)
 	}
+	if schemaStatus.Pending > 0 {
+		logrus.Warnf("The schema of the database is at version %d, %d migrations behind Meshery Server; run them with `mesheryctl system db migrate`", schemaStatus.Version, schemaStatus.Pending)
+	}
+	if schemaStatus.Version > schemaStatus.LatestVersion {
+		logrus.Warnf("The schema of the database is at version %d, migrated by a later version of Meshery Server than this one, at version %d", schemaStatus.Version, schemaStatus.LatestVersion)
+	}
+
+	// The provider tokens of the workload identities are encrypted at rest with WORKLOAD_IDENTITY_KEY, the base64
+	// encoding of a 32 bytes key, or else with the key of WORKLOAD_IDENTITY_KEY_FILE, generated the first time
+	if viper.GetString("WORKLOAD_IDENTITY_KEY_FILE") == "" {
+		viper.SetDefault("WORKLOAD_IDENTITY_KEY_FILE", path.Join(viper.GetString("USER_DATA_FOLDER"), "workload_identity.key"))
+	}
+	tokenCipher, err := models.LoadTokenCipher(viper.GetString("WORKLOAD_IDENTITY_KEY"), viper.GetString("WORKLOAD_IDENTITY_KEY_FILE"))
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	workloadIdentityPersister := &models.WorkloadIdentityPersister{DB: &dbHandler, Cipher: tokenCipher}
+	if err := workloadIdentityPersister.SealProviderTokens(); err != nil {
+		logrus.Fatal(err)
+	}
 
+	// The adapters registered at runtime are registered again, those no longer reachable being reported
+	// unavailable by the adapter health tracker until they are unregistered
+	adapterRegistrationPersister := &models.AdapterRegistrationPersister{DB: &dbHandler}
+	adapterRegistrations, err := adapterRegistrationPersister.GetAdapterRegistrations()
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	for _, registration := range adapterRegistrations {
+		if registration.TLSCA != "" {
+			if err := meshes.RegisterAdapterCA(registration.Address, []byte(registration.TLSCA)); err != nil {
+				logrus.Errorf("unable to register the CA of the adapter at %s: %v", registration.Address, err)
+				continue
+			}
+		}
+		adapterTracker.AddAdapter(ctx, models.Adapter{Location: registration.Address, Name: registration.Name})
+	}
+
+	// The certificates of mutual TLS are valid for the adapters registered at runtime too, and are renewed
+	// before they expire
+	if adapterCerts != nil {
+		hosts := []string{}
+		for _, registration := range adapterRegistrations {
+			if host, _, err := net.SplitHostPort(registration.Address); err == nil && registration.TLSCA == "" {
+				hosts = append(hosts, host)
+			}
+		}
+		if err := adapterCerts.AddHosts(ctx, hosts...); err != nil {
+			logrus.Fatalf("unable to provision the certificates for the adapters: %v", err)
+		}
+		go adapterCerts.Run(ctx, viper.GetDuration("ADAPTER_TLS_RENEW_CHECK_INTERVAL"))
+	}
+	go adapterHealthTracker.Run(ctx)
+
+	jobTracker := helpers.NewJobTracker(viper.GetInt("JOB_HISTORY"))
+	resultPersister := &models.MesheryResultsPersister{DB: &dbHandler}
+	trashPersister := &models.TrashPersister{DB: &dbHandler, Retention: viper.GetDuration("TRASH_RETENTION")}
 	lProv := &models.DefaultLocalProvider{
 		ProviderBaseURL:                 DefaultProviderURL,
 		MapPreferencePersister:          preferencePersister,
-		ResultPersister:                 &models.MesheryResultsPersister{DB: &dbHandler},
+		ResultPersister:                 resultPersister,
 		SmiResultPersister:              &models.SMIResultsPersister{DB: &dbHandler},
 		TestProfilesPersister:           &models.TestProfilesPersister{DB: &dbHandler},
 		PerformanceProfilesPersister:    &models.PerformanceProfilePersister{DB: &dbHandler},
@@ -190,19 +331,21 @@ func main() {
 		MesheryFilterPersister:          &models.MesheryFilterPersister{DB: &dbHandler},
 		MesheryApplicationPersister:     &models.MesheryApplicationPersister{DB: &dbHandler},
 		MesheryPatternResourcePersister: &models.PatternResourcePersister{DB: &dbHandler},
+		PatternCollaborationPersister:   &models.PatternCollaborationPersister{DB: &dbHandler},
 		MesheryK8sContextPersister:      &models.MesheryK8sContextPersister{DB: &dbHandler},
+		TrashPersister:                  trashPersister,
 		GenericPersister:                dbHandler,
 	}
 	lProv.Initialize()
 	seededUUIDs := lProv.SeedContent(log)
 	provs[lProv.Name()] = lProv
 
-	RemoteProviderURLs := viper.GetStringSlice("PROVIDER_BASE_URLS")
-	for _, providerurl := range RemoteProviderURLs {
+	// newRemoteProvider creates the remote provider at the URL, along with those added when the
+	// configuration is reloaded
+	newRemoteProvider := func(providerurl string) (models.Provider, error) {
 		parsedURL, err := url.Parse(providerurl)
 		if err != nil {
-			logrus.Error(providerurl, "is invalid url skipping provider")
-			continue
+			return nil, err
 		}
 		cp := &models.RemoteProvider{
 			RemoteProviderURL:          parsedURL.String(),
@@ -219,17 +362,181 @@ func main() {
 		cp.Initialize()
 
 		cp.SyncPreferences()
-		defer cp.StopSyncPreferences()
+		return cp, nil
+	}
+
+	RemoteProviderURLs := viper.GetStringSlice("PROVIDER_BASE_URLS")
+	for _, providerurl := range RemoteProviderURLs {
+		cp, err := newRemoteProvider(providerurl)
+		if err != nil {
+			logrus.Error(providerurl, "is invalid url skipping provider")
+			continue
+		}
 		provs[cp.Name()] = cp
 	}
 
+	// Every completed performance result is also exported to the configured time-series databases
+	resultSinks := []models.ResultSink{}
+	if influxURL := viper.GetString("RESULT_SINK_INFLUXDB_URL"); influxURL != "" {
+		resultSinks = append(resultSinks, helpers.NewInfluxDBSink(
+			influxURL,
+			viper.GetString("RESULT_SINK_INFLUXDB_DATABASE"),
+			viper.GetString("RESULT_SINK_INFLUXDB_ORG"),
+			viper.GetString("RESULT_SINK_INFLUXDB_TOKEN"),
+		))
+	}
+	if timescaleDSN := viper.GetString("RESULT_SINK_TIMESCALE_DSN"); timescaleDSN != "" {
+		sink, err := helpers.NewTimescaleSink(timescaleDSN, viper.GetString("RESULT_SINK_TIMESCALE_TABLE"))
+		if err != nil {
+			logrus.Error(err)
+		} else {
+			resultSinks = append(resultSinks, sink)
+		}
+	}
+	if pushgatewayURL := viper.GetString("RESULT_SINK_PUSHGATEWAY_URL"); pushgatewayURL != "" {
+		resultSinks = append(resultSinks, helpers.NewPushgatewaySink(pushgatewayURL, viper.GetString("RESULT_SINK_PUSHGATEWAY_JOB")))
+	}
+	for _, sink := range resultSinks {
+		logrus.Infof("Exporting performance results to %s", sink.Name())
+	}
+
+	// Results of the local provider older than RESULT_ARCHIVE_AFTER are moved to object storage,
+	// S3 and S3 compatible stores such as GCS and MinIO or Azure Blob Storage
+	var resultArchive models.ResultArchive
+	if bucket := viper.GetString("RESULT_ARCHIVE_S3_BUCKET"); bucket != "" {
+		resultArchive = helpers.NewS3Archive(
+			viper.GetString("RESULT_ARCHIVE_S3_ENDPOINT"),
+			bucket,
+			viper.GetString("RESULT_ARCHIVE_S3_REGION"),
+			viper.GetString("RESULT_ARCHIVE_S3_ACCESS_KEY"),
+			viper.GetString("RESULT_ARCHIVE_S3_SECRET_KEY"),
+		)
+	} else if containerURL := viper.GetString("RESULT_ARCHIVE_AZURE_CONTAINER_URL"); containerURL != "" {
+		resultArchive = helpers.NewAzureBlobArchive(containerURL, viper.GetString("RESULT_ARCHIVE_AZURE_SAS_TOKEN"))
+	}
+	if resultArchive != nil {
+		if after := viper.GetDuration("RESULT_ARCHIVE_AFTER"); after > 0 {
+			logrus.Infof("Archiving performance results older than %s to %s", after, resultArchive.Name())
+			go helpers.NewResultArchiver(resultPersister, resultArchive, after, viper.GetDuration("RESULT_ARCHIVE_INTERVAL"), jobTracker).Run(ctx)
+		}
+	}
+
+	if trashPersister.Retention > 0 {
+		go helpers.NewTrashPurger(trashPersister, viper.GetDuration("TRASH_PURGE_INTERVAL"), jobTracker).Run(ctx)
+	}
+
+	resultAnalysisPersister := &models.ResultAnalysisPersister{DB: &dbHandler}
+	resultAnomalyDetector := helpers.NewResultAnomalyDetector(
+		resultAnalysisPersister,
+		viper.GetFloat64("RESULT_ANOMALY_THRESHOLD"),
+		viper.GetInt("RESULT_ANOMALY_WINDOW"),
+		viper.GetInt("RESULT_ANOMALY_MIN_SAMPLES"),
+	)
+	trendReportPersister := &models.TrendReportPersister{DB: &dbHandler}
+	trendReporter := helpers.NewTrendReporter(
+		resultAnalysisPersister,
+		trendReportPersister,
+		viper.GetInt("TREND_REPORT_WEEKS"),
+		viper.GetFloat64("TREND_REGRESSION_THRESHOLD"),
+		viper.GetDuration("TREND_REPORT_CHECK_INTERVAL"),
+	)
+	go trendReporter.Run(ctx)
+
+	// The events of the adapter health tracker, of the result anomaly detector and of the requests
+	// to the Kubernetes API being throttled are recorded and relayed to the clients of the event stream
+	eventPersister := &models.EventPersister{DB: &dbHandler}
+	eventRecorder := helpers.NewEventRecorder(eventPersister)
+	healthEvents, _ := adapterHealthTracker.Subscribe()
+	go eventRecorder.Forward(models.EventCategoryHealth, "adapter-health", healthEvents)
+	anomalyEvents, _ := resultAnomalyDetector.Subscribe()
+	go eventRecorder.Forward(models.EventCategoryPerformance, "result-anomaly", anomalyEvents)
+	throttleEvents, _ := models.K8sThrottling.Subscribe()
+	go eventRecorder.Forward(models.EventCategoryKubernetes, "kubernetes-throttling", throttleEvents)
+
+	digestSubscriptionPersister := &models.DigestSubscriptionPersister{DB: &dbHandler}
+	designSchedulePersister := &models.DesignSchedulePersister{DB: &dbHandler}
+	var digestSender models.DigestSenderInterface
+	if host := viper.GetString("SMTP_HOST"); host != "" && viper.GetString("SMTP_FROM") != "" {
+		digestScheduler := helpers.NewDigestScheduler(digestSubscriptionPersister, resultAnalysisPersister, eventPersister, &helpers.SMTPMailer{
+			Host:     host,
+			Port:     viper.GetInt("SMTP_PORT"),
+			Username: viper.GetString("SMTP_USERNAME"),
+			Password: viper.GetString("SMTP_PASSWORD"),
+			From:     viper.GetString("SMTP_FROM"),
+		}, viper.GetDuration("DIGEST_CHECK_INTERVAL"))
+		go digestScheduler.Run(ctx)
+		digestSender = digestScheduler
+	}
+
+	// Secret references of designs are resolved on deployment against the Kubernetes Secrets
+	// of the target cluster, and against Vault when configured. The designs reference the Vault
+	// secrets under the vault_paths of their workspace, or under the comma-separated VAULT_PATHS
+	// for the users in no workspace, none by default.
+	secretResolvers := secrets.NewRegistry()
+	if addr := viper.GetString("VAULT_ADDR"); addr != "" {
+		secretResolvers = secrets.NewRegistry(secrets.NewVaultResolver(addr, viper.GetString("VAULT_TOKEN"), viper.GetString("VAULT_NAMESPACE")))
+	}
+	vaultPaths := []string{}
+	for _, p := range strings.Split(viper.GetString("VAULT_PATHS"), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			vaultPaths = append(vaultPaths, p)
+		}
+	}
+
+	// The designs are deployed to the namespaces of their workspace, or to the comma-separated
+	// DEPLOY_NAMESPACES for the users in no workspace, to any namespace when none are set. The
+	// designs deployed as a ServiceAccount are denied unless namespaces are set.
+	deployNamespaces := []string{}
+	for _, ns := range strings.Split(viper.GetString("DEPLOY_NAMESPACES"), ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			deployNamespaces = append(deployNamespaces, ns)
+		}
+	}
+
+	loadTestGuard := helpers.NewLoadTestGuard(viper.GetBool("PERF_TEST_ISOLATION"))
+
+	// The quotas of the workspaces, and the default quotas of the users in none of them, are read
+	// from WORKSPACES_FILE, every quota being unlimited when it is not set
+	workspaceDefaults, workspaces := models.WorkspaceQuotas{}, []models.Workspace{}
+	if path := viper.GetString("WORKSPACES_FILE"); path != "" {
+		if workspaceDefaults, workspaces, err = helpers.ReadWorkspacesFile(path); err != nil {
+			logrus.Fatal(err)
+		}
+		// The profiles, designs and results of the users of the remote providers are not stored by
+		// Meshery Server, which cannot count them
+		if len(RemoteProviderURLs) > 0 {
+			if err := helpers.CheckArtifactQuotas(workspaceDefaults, workspaces); err != nil {
+				logrus.Fatal(err)
+			}
+		}
+	}
+	// ADMINS are the comma-separated IDs of the users administering Meshery Server
+	admins := []string{}
+	for _, admin := range strings.Split(viper.GetString("ADMINS"), ",") {
+		if admin = strings.TrimSpace(admin); admin != "" {
+			admins = append(admins, admin)
+		}
+	}
+
+	loadTestLimits := models.LoadTestLimits{
+		MaxQPS:         viper.GetFloat64("PERF_MAX_QPS"),
+		MaxDuration:    viper.GetDuration("PERF_MAX_DURATION"),
+		MaxConnections: viper.GetInt("PERF_MAX_CONNECTIONS"),
+	}
+	workspaceQuotas, err := helpers.NewWorkspaceQuotas(&models.WorkspaceUsagePersister{DB: &dbHandler}, &models.WorkspaceLoadTestLimitsPersister{DB: &dbHandler}, workspaceDefaults, loadTestLimits, workspaces)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
 	hc := &models.HandlerConfig{
 		Providers:              provs,
 		ProviderCookieName:     "meshery-provider",
 		ProviderCookieDuration: 30 * 24 * time.Hour,
 
-		AdapterTracker: adapterTracker,
-		QueryTracker:   queryTracker,
+		AdapterTracker:               adapterTracker,
+		AdapterHealthTracker:         adapterHealthTracker,
+		QueryTracker:                 queryTracker,
+		AdapterRegistrationPersister: adapterRegistrationPersister,
 
 		Queue: mainQueue,
 
@@ -240,10 +547,84 @@ func main() {
 
 		PrometheusClient:         models.NewPrometheusClient(),
 		PrometheusClientForQuery: models.NewPrometheusClientWithHTTPClient(&http.Client{Timeout: time.Second}),
+
+		DatadogClient:  models.NewDatadogClientWithHTTPClient(&http.Client{Timeout: 30 * time.Second}),
+		NewRelicClient: models.NewNewRelicClientWithHTTPClient(&http.Client{Timeout: 30 * time.Second}),
+
+		ResultSinks:   resultSinks,
+		ResultArchive: resultArchive,
+
+		ResultAnomalyDetector:   resultAnomalyDetector,
+		ResultAnalysisPersister: resultAnalysisPersister,
+		TrendReporter:           trendReporter,
+		TrendReportPersister:    trendReportPersister,
+
+		EnvironmentPersister: &models.EnvironmentPersister{DB: &dbHandler},
+		SecretResolvers:      secretResolvers,
+		VaultPaths:           vaultPaths,
+		DeployNamespaces:     deployNamespaces,
+
+		WorkloadIdentityPersister:  workloadIdentityPersister,
+		PerfTargetPersister:        &models.PerfTargetPersister{DB: &dbHandler},
+		ResultHookPersister:        &models.ResultHookPersister{DB: &dbHandler},
+		ValidationWebhookPersister: &models.ValidationWebhookPersister{DB: &dbHandler},
+		DashboardSnapshotPersister: &models.DashboardSnapshotPersister{DB: &dbHandler},
+
+		EventRecorder:  eventRecorder,
+		EventPersister: eventPersister,
+
+		DigestSender:                digestSender,
+		DigestSubscriptionPersister: digestSubscriptionPersister,
+
+		DesignSchedulePersister: designSchedulePersister,
+
+		LoadTestGuard:               loadTestGuard,
+		LoadTestLimits:              loadTestLimits,
+		WorkspaceQuotas:             workspaceQuotas,
+		Admins:                      admins,
+		SchemaMigrator:              schemaMigrator,
+		Jobs:                        jobTracker,
+		RemoteWriteReceiver:         helpers.NewRemoteWriteReceiver(viper.GetDuration("PERF_REMOTE_WRITE_FLUSH_GRACE")),
+		LoadTestCheckpointPersister: &models.LoadTestCheckpointPersister{DB: &dbHandler},
+		FeatureFlagPersister:        &models.FeatureFlagPersister{DB: &dbHandler},
+	}
+	if debugLogRecorder != nil {
+		hc.DebugLogRecorder = debugLogRecorder
+	}
+	if adapterCerts != nil {
+		hc.AdapterCerts = adapterCerts
 	}
 
+	defer func() {
+		for _, p := range hc.Providers {
+			if cp, ok := p.(*models.RemoteProvider); ok {
+				cp.StopSyncPreferences()
+			}
+		}
+	}()
+
+	// The providers, adapters, log level and feature flags are applied again, along with the settings
+	// of SERVER_CONFIG_FILE, on POST /api/system/config/reload
+	hc.ServerConfigReloader = helpers.NewServerConfigReloader(viper.GetString("SERVER_CONFIG_FILE"), models.ServerConfig{
+		ProviderURLs: RemoteProviderURLs,
+		AdapterURLs:  adapterURLs,
+		LogLevel:     logrus.GetLevel().String(),
+		FeatureFlags: map[string]bool{
+			"perf_test_isolation": viper.GetBool("PERF_TEST_ISOLATION"),
+		},
+	}, hc, newRemoteProvider, map[string]func(bool){
+		"perf_test_isolation": loadTestGuard.SetEnabled,
+	})
+
 	h := handlers.NewHandlerInstance(hc, meshsyncCh, log, brokerConn)
 
+	// The scheduled deployments and undeployments of designs are run once due, with their
+	// outcome recorded and relayed to the clients of the event stream
+	designScheduler := helpers.NewDesignScheduler(designSchedulePersister, h.RunDesignSchedule, viper.GetDuration("DESIGN_SCHEDULE_CHECK_INTERVAL"), jobTracker)
+	designEvents, _ := designScheduler.Subscribe()
+	go eventRecorder.Forward(models.EventCategoryDesign, "design-schedule", designEvents)
+	go designScheduler.Run(ctx)
+
 	b := broadcast.NewBroadcaster(100)
 	defer b.Close()
 
@@ -0,0 +1,382 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+	pCore "github.com/layer5io/meshery/models/pattern/core"
+	"github.com/layer5io/meshery/models/pattern/patterns/k8s"
+	mesherykube "github.com/layer5io/meshkit/utils/kubernetes"
+	meshsyncmodel "github.com/layer5io/meshsync/pkg/model"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// applicationWorkloadKinds are the kinds of the components of an application which run pods,
+// keyed by the lowercase kind used by workloadClients
+var applicationWorkloadKinds = map[string]string{
+	"deployment":  "Deployment",
+	"statefulset": "StatefulSet",
+	"daemonset":   "DaemonSet",
+}
+
+// applicationWorkload is a component of an application which runs pods
+type applicationWorkload struct {
+	component string
+	kind      string
+	name      string
+	namespace string
+}
+
+func (w applicationWorkload) String() string {
+	return w.kind + "/" + w.name
+}
+
+// swagger:route GET /api/application/{id}/status ApplicationsAPI idGetApplicationStatus
+// Handle GET request for the status of an application
+//
+// Returns the readiness of the workloads of the application, as discovered by MeshSync
+// responses:
+// 	200: applicationStatusResponseWrapper
+
+// ApplicationStatusHandler returns the aggregated readiness of the workloads of a saved application
+func (h *Handler) ApplicationStatusHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	app, workloads, ok := h.getApplicationWorkloads(rw, r, provider)
+	if !ok {
+		return
+	}
+
+	db := provider.GetGenericPersister()
+	if db == nil || db.DB == nil {
+		err := ErrApplicationOperation(fmt.Errorf("meshsync data is not available"), "get the status of")
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	objects := []meshsyncmodel.Object{}
+	err := db.
+		Preload("ObjectMeta").
+		Preload("Spec").
+		Preload("Status").
+		Find(&objects, "kind IN ?", []string{"Deployment", "StatefulSet", "DaemonSet"}).Error
+	if err != nil {
+		h.log.Error(ErrApplicationOperation(err, "get the status of"))
+		http.Error(rw, ErrApplicationOperation(err, "get the status of").Error(), http.StatusInternalServerError)
+		return
+	}
+
+	status := applicationStatus(workloads, objects)
+	status.ID = app.ID
+	status.Name = app.Name
+	h.writeApplicationJSON(rw, status, "application status")
+}
+
+// swagger:route POST /api/application/{id}/rollout/restart ApplicationsAPI idPostApplicationRolloutRestart
+// Handle POST request to restart the workloads of an application
+//
+// Restarts the workloads of the given components of the application, or all of them when no
+// component is given, the way kubectl rollout restart does
+// responses:
+// 	200: applicationOperationResponseWrapper
+
+// ApplicationRolloutRestartHandler restarts the workloads of a saved application
+func (h *Handler) ApplicationRolloutRestartHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	rollout := &models.ApplicationRolloutRequest{}
+	if err := json.NewDecoder(r.Body).Decode(rollout); err != nil && err != io.EOF {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, workloads, ok := h.getApplicationWorkloads(rw, r, provider)
+	if !ok {
+		return
+	}
+	workloads, err := selectApplicationWorkloads(workloads, rollout.Components)
+	if err != nil {
+		h.log.Error(ErrApplicationOperation(err, "restart"))
+		http.Error(rw, ErrApplicationOperation(err, "restart").Error(), http.StatusBadRequest)
+		return
+	}
+
+	kubeclient, ok := r.Context().Value(models.KubeHanderKey).(*mesherykube.Client)
+	if !ok || kubeclient == nil {
+		h.log.Error(ErrNilClient)
+		http.Error(rw, ErrNilClient.Error(), http.StatusBadRequest)
+		return
+	}
+
+	restart, err := rolloutRestartPatch()
+	if err != nil {
+		h.log.Error(ErrApplicationOperation(err, "restart"))
+		http.Error(rw, ErrApplicationOperation(err, "restart").Error(), http.StatusInternalServerError)
+		return
+	}
+	clients := workloadClients(kubeclient.KubeClient)
+	result := &models.ApplicationOperationResult{Workloads: []string{}}
+	for _, w := range workloads {
+		if err := clients[strings.ToLower(w.kind)].patch(r.Context(), w.namespace, w.name, restart); err != nil {
+			h.log.Error(ErrApplicationOperation(err, "restart"))
+			http.Error(rw, ErrApplicationOperation(err, "restart").Error(), http.StatusInternalServerError)
+			return
+		}
+		result.Workloads = append(result.Workloads, w.String())
+	}
+	h.writeApplicationJSON(rw, result, "application rollout")
+}
+
+// swagger:route POST /api/application/{id}/scale ApplicationsAPI idPostApplicationScale
+// Handle POST request to scale a component of an application
+//
+// Sets the number of replicas of the Deployment or StatefulSet of the component in the cluster.
+// The application file is left as is, so deploying the application again restores its replicas.
+// responses:
+// 	200: applicationOperationResponseWrapper
+
+// ApplicationScaleHandler scales the workload of a component of a saved application
+func (h *Handler) ApplicationScaleHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	scale := &models.ApplicationScaleRequest{}
+	if err := json.NewDecoder(r.Body).Decode(scale); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if scale.Component == "" || scale.Replicas < 0 {
+		err := ErrRequestBody(fmt.Errorf("a component and a number of replicas of at least 0 are required"))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, workloads, ok := h.getApplicationWorkloads(rw, r, provider)
+	if !ok {
+		return
+	}
+	workloads, err := selectApplicationWorkloads(workloads, []string{scale.Component})
+	if err == nil && workloads[0].kind == "DaemonSet" {
+		err = fmt.Errorf("component %s is a DaemonSet, which runs a pod on every node and cannot be scaled", scale.Component)
+	}
+	if err != nil {
+		h.log.Error(ErrApplicationOperation(err, "scale"))
+		http.Error(rw, ErrApplicationOperation(err, "scale").Error(), http.StatusBadRequest)
+		return
+	}
+
+	kubeclient, ok := r.Context().Value(models.KubeHanderKey).(*mesherykube.Client)
+	if !ok || kubeclient == nil {
+		h.log.Error(ErrNilClient)
+		http.Error(rw, ErrNilClient.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w := workloads[0]
+	patch, _ := json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"replicas": scale.Replicas}})
+	if err := workloadClients(kubeclient.KubeClient)[strings.ToLower(w.kind)].patch(r.Context(), w.namespace, w.name, patch); err != nil {
+		h.log.Error(ErrApplicationOperation(err, "scale"))
+		http.Error(rw, ErrApplicationOperation(err, "scale").Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeApplicationJSON(rw, &models.ApplicationOperationResult{Workloads: []string{w.String()}}, "application scale")
+}
+
+// getApplicationWorkloads fetches the saved application of the request along with its workloads,
+// writing the error to the response when it fails
+func (h *Handler) getApplicationWorkloads(rw http.ResponseWriter, r *http.Request, provider models.Provider) (*models.MesheryApplication, []applicationWorkload, bool) {
+	resp, err := provider.GetMesheryApplication(r, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrGetApplication(err))
+		http.Error(rw, ErrGetApplication(err).Error(), http.StatusNotFound)
+		return nil, nil, false
+	}
+	app := &models.MesheryApplication{}
+	if err := json.Unmarshal(resp, app); err != nil {
+		h.log.Error(ErrGetApplication(err))
+		http.Error(rw, ErrGetApplication(err).Error(), http.StatusInternalServerError)
+		return nil, nil, false
+	}
+
+	workloads, err := applicationWorkloads(app)
+	if err != nil {
+		h.log.Error(ErrGetApplication(err))
+		http.Error(rw, ErrGetApplication(err).Error(), http.StatusBadRequest)
+		return nil, nil, false
+	}
+	return app, workloads, true
+}
+
+func (h *Handler) writeApplicationJSON(rw http.ResponseWriter, v interface{}, obj string) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		h.log.Error(ErrMarshal(err, obj))
+		http.Error(rw, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// applicationWorkloads returns the components of the application which run pods, sorted by component
+func applicationWorkloads(app *models.MesheryApplication) ([]applicationWorkload, error) {
+	pattern, err := pCore.NewPatternFile([]byte(app.ApplicationFile))
+	if err != nil {
+		return nil, err
+	}
+
+	workloads := []applicationWorkload{}
+	for component, svc := range pattern.Services {
+		kind, ok := applicationWorkloadKinds[strings.ToLower(svc.Type)]
+		if !ok {
+			continue
+		}
+		namespace := svc.Namespace
+		if namespace == "" {
+			namespace = "default"
+		}
+		workloads = append(workloads, applicationWorkload{component: component, kind: kind, name: svc.Name, namespace: namespace})
+	}
+	sort.Slice(workloads, func(i, j int) bool {
+		return workloads[i].component < workloads[j].component
+	})
+	return workloads, nil
+}
+
+// selectApplicationWorkloads returns the workloads of the given components, all of them when none is given
+func selectApplicationWorkloads(workloads []applicationWorkload, components []string) ([]applicationWorkload, error) {
+	if len(components) == 0 {
+		return workloads, nil
+	}
+	selected := []applicationWorkload{}
+	for _, component := range components {
+		found := false
+		for _, w := range workloads {
+			if w.component == component || w.name == component {
+				selected = append(selected, w)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("component %s is not a Deployment, StatefulSet or DaemonSet of the application", component)
+		}
+	}
+	return selected, nil
+}
+
+// applicationStatus aggregates the readiness of the workloads from the objects discovered by MeshSync
+func applicationStatus(workloads []applicationWorkload, objects []meshsyncmodel.Object) *models.ApplicationStatus {
+	status := &models.ApplicationStatus{Workloads: []models.ApplicationWorkloadStatus{}}
+	for _, w := range workloads {
+		ws := models.ApplicationWorkloadStatus{
+			Component: w.component,
+			Kind:      w.kind,
+			Name:      w.name,
+			Namespace: w.namespace,
+			Reason:    "not discovered by MeshSync",
+		}
+		for _, obj := range objects {
+			if obj.Kind != w.kind || obj.ObjectMeta == nil || obj.ObjectMeta.Name != w.name || obj.ObjectMeta.Namespace != w.namespace {
+				continue
+			}
+			ws.Found = true
+			ws.Reason = ""
+			u, err := unstructuredFromMeshSyncObject(obj)
+			if err != nil {
+				ws.Reason = err.Error()
+				break
+			}
+			ws.Ready, ws.Reason = k8s.IsReady(u)
+			ws.Replicas, ws.ReadyReplicas = workloadReplicas(u)
+			break
+		}
+		if ws.Ready {
+			status.ReadyWorkloads++
+		}
+		status.Workloads = append(status.Workloads, ws)
+	}
+	status.Ready = status.ReadyWorkloads == len(status.Workloads)
+	return status
+}
+
+// unstructuredFromMeshSyncObject rebuilds the object with the fields readiness is computed from
+func unstructuredFromMeshSyncObject(obj meshsyncmodel.Object) (*unstructured.Unstructured, error) {
+	raw := func(attribute string) json.RawMessage {
+		if strings.TrimSpace(attribute) == "" {
+			return json.RawMessage("{}")
+		}
+		return json.RawMessage(attribute)
+	}
+	manifest := map[string]interface{}{
+		"apiVersion": obj.APIVersion,
+		"kind":       obj.Kind,
+		"metadata": map[string]interface{}{
+			"name":       obj.ObjectMeta.Name,
+			"namespace":  obj.ObjectMeta.Namespace,
+			"generation": obj.ObjectMeta.Generation,
+		},
+		"spec":   raw(""),
+		"status": raw(""),
+	}
+	if obj.Spec != nil {
+		manifest["spec"] = raw(obj.Spec.Attribute)
+	}
+	if obj.Status != nil {
+		manifest["status"] = raw(obj.Status.Attribute)
+	}
+	b, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(b); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// workloadReplicas returns the desired and the ready replicas of the workload
+func workloadReplicas(u *unstructured.Unstructured) (int64, int64) {
+	if u.GetKind() == "DaemonSet" {
+		desired, _, _ := unstructured.NestedInt64(u.Object, "status", "desiredNumberScheduled")
+		ready, _, _ := unstructured.NestedInt64(u.Object, "status", "numberReady")
+		return desired, ready
+	}
+	replicas, found, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	if !found {
+		replicas = 1
+	}
+	readyField := "readyReplicas"
+	if u.GetKind() == "Deployment" {
+		readyField = "availableReplicas"
+	}
+	ready, _, _ := unstructured.NestedInt64(u.Object, "status", readyField)
+	return replicas, ready
+}
@@ -0,0 +1,272 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// clusterHealthTimeout bounds the health checks of a Kubernetes context
+const clusterHealthTimeout = 15 * time.Second
+
+// mesheryNamespace is the namespace Meshery Operator, MeshSync and Meshery Broker are deployed in
+const mesheryNamespace = "meshery"
+
+var brokerGVR = schema.GroupVersionResource{Group: "meshery.layer5.io", Version: "v1alpha1", Resource: "brokers"}
+
+// requiredPermissions are the permissions Meshery Server needs in a cluster to deploy Meshery
+// Operator and designs, and to discover the workloads
+var requiredPermissions = []authorizationv1.ResourceAttributes{
+	{Verb: "list", Resource: "pods"},
+	{Verb: "create", Resource: "namespaces"},
+	{Verb: "create", Group: "apps", Resource: "deployments"},
+	{Verb: "create", Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"},
+	{Verb: "create", Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"},
+}
+
+// swagger:route GET /api/system/kubernetes/contexts/health SystemAPI idGetK8sContextsHealth
+// Handle GET request for the health of the Kubernetes contexts
+//
+// Checks, for every Kubernetes context, or the one given with the context query parameter, that the
+// Kubernetes API is reachable, that Meshery is allowed to manage the cluster, that MeshSync is
+// deployed and that Meshery Broker is reachable, with remediation hints for the failing checks
+// responses:
+// 	200: k8sContextsHealthRespWrapper
+
+// K8sContextsHealthHandler runs live health checks of the connections to the Kubernetes contexts
+func (h *Handler) K8sContextsHealthHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	token, ok := req.Context().Value(models.TokenCtxKey).(string)
+	if !ok {
+		err := ErrRetrieveUserToken(fmt.Errorf("failed to retrieve user token"))
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	contexts, err := provider.LoadAllK8sContext(token)
+	if err != nil {
+		h.log.Error(ErrClusterHealth(err))
+		http.Error(w, ErrClusterHealth(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if id := req.URL.Query().Get("context"); id != "" {
+		var selected []*models.K8sContext
+		for _, kc := range contexts {
+			if kc.ID == id || kc.Name == id {
+				selected = append(selected, kc)
+			}
+		}
+		if len(selected) == 0 {
+			err := ErrClusterHealth(fmt.Errorf("context %s does not exist", id))
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		contexts = selected
+	}
+
+	results := make([]models.ClusterHealth, len(contexts))
+	var wg sync.WaitGroup
+	for i, kc := range contexts {
+		wg.Add(1)
+		go func(i int, kc *models.K8sContext) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(req.Context(), clusterHealthTimeout)
+			defer cancel()
+			results[i] = checkClusterHealth(ctx, kc)
+		}(i, kc)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].ContextName < results[j].ContextName
+	})
+	h.writeApplicationJSON(w, results, "kubernetes contexts health")
+}
+
+// checkClusterHealth runs the health checks of the Kubernetes context. The checks needing the
+// Kubernetes API are skipped when it is not reachable.
+func checkClusterHealth(ctx context.Context, kc *models.K8sContext) models.ClusterHealth {
+	health := models.ClusterHealth{
+		ContextID:   kc.ID,
+		ContextName: kc.Name,
+		Server:      kc.Server,
+	}
+
+	var client kubernetes.Interface
+	var dynamicClient dynamic.Interface
+	kubeclient, err := kc.GenerateKubeHandler()
+	if err == nil {
+		client, dynamicClient = kubeclient.KubeClient, kubeclient.DynamicKubeClient
+	}
+	health.Checks = clusterHealthChecks(ctx, client, dynamicClient, err)
+
+	health.Healthy = true
+	for _, check := range health.Checks {
+		if check.Status != models.ClusterHealthPassed {
+			health.Healthy = false
+		}
+	}
+	return health
+}
+
+// clusterHealthChecks runs the health checks against the clients of a Kubernetes context,
+// clientErr being the error met creating them
+func clusterHealthChecks(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, clientErr error) []models.ClusterHealthCheck {
+	api := apiHealthCheck(client, clientErr)
+	if api.Status != models.ClusterHealthPassed {
+		checks := []models.ClusterHealthCheck{api}
+		for _, name := range []string{models.ClusterHealthCheckRBAC, models.ClusterHealthCheckMeshSync, models.ClusterHealthCheckBroker} {
+			checks = append(checks, models.ClusterHealthCheck{
+				Name:    name,
+				Status:  models.ClusterHealthSkipped,
+				Message: "skipped as the Kubernetes API is not reachable",
+			})
+		}
+		return checks
+	}
+
+	return []models.ClusterHealthCheck{
+		api,
+		rbacHealthCheck(ctx, client),
+		meshSyncHealthCheck(ctx, client),
+		brokerHealthCheck(ctx, dynamicClient),
+	}
+}
+
+func apiHealthCheck(client kubernetes.Interface, clientErr error) models.ClusterHealthCheck {
+	check := models.ClusterHealthCheck{Name: models.ClusterHealthCheckAPI}
+	if clientErr == nil {
+		var version fmt.Stringer
+		if version, clientErr = client.Discovery().ServerVersion(); clientErr == nil {
+			check.Status = models.ClusterHealthPassed
+			check.Message = fmt.Sprintf("Kubernetes API is reachable, server version %s", version)
+			return check
+		}
+	}
+	check.Status = models.ClusterHealthFailed
+	check.Message = fmt.Sprintf("Kubernetes API is not reachable: %s", clientErr)
+	check.Remedy = "Make sure the cluster is running and its API server is reachable from Meshery Server, and that the credentials of the kubeconfig have not expired; upload a new kubeconfig otherwise"
+	return check
+}
+
+func rbacHealthCheck(ctx context.Context, client kubernetes.Interface) models.ClusterHealthCheck {
+	check := models.ClusterHealthCheck{Name: models.ClusterHealthCheckRBAC}
+	missing := []string{}
+	for _, permission := range requiredPermissions {
+		permission := permission
+		review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &permission},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			check.Status = models.ClusterHealthFailed
+			check.Message = fmt.Sprintf("unable to review the permissions of Meshery: %s", err)
+			check.Remedy = "Allow the user of the kubeconfig to create SelfSubjectAccessReviews"
+			return check
+		}
+		if !review.Status.Allowed {
+			resource := permission.Resource
+			if permission.Group != "" {
+				resource += "." + permission.Group
+			}
+			missing = append(missing, permission.Verb+" "+resource)
+		}
+	}
+	if len(missing) > 0 {
+		check.Status = models.ClusterHealthFailed
+		check.Message = fmt.Sprintf("Meshery is not allowed to %s", strings.Join(missing, ", "))
+		check.Remedy = "Bind the user of the kubeconfig to the cluster-admin ClusterRole, or to a ClusterRole granting the missing permissions"
+		return check
+	}
+	check.Status = models.ClusterHealthPassed
+	check.Message = "Meshery has the permissions it needs"
+	return check
+}
+
+func meshSyncHealthCheck(ctx context.Context, client kubernetes.Interface) models.ClusterHealthCheck {
+	check := models.ClusterHealthCheck{Name: models.ClusterHealthCheckMeshSync}
+	deployment, err := client.AppsV1().Deployments(mesheryNamespace).Get(ctx, "meshery-meshsync", metav1.GetOptions{})
+	switch {
+	case kerrors.IsNotFound(err):
+		check.Status = models.ClusterHealthFailed
+		check.Message = "MeshSync is not deployed"
+		check.Remedy = "Deploy Meshery Operator in the cluster, which deploys MeshSync, from the Settings of Meshery UI or with mesheryctl system start"
+	case err != nil:
+		check.Status = models.ClusterHealthFailed
+		check.Message = fmt.Sprintf("unable to get the MeshSync deployment: %s", err)
+		check.Remedy = "Allow the user of the kubeconfig to get the deployments of the meshery namespace"
+	case deployment.Status.AvailableReplicas == 0:
+		check.Status = models.ClusterHealthFailed
+		check.Message = "MeshSync is deployed but none of its pods is available"
+		check.Remedy = "Check the pods and the events of the meshery-meshsync deployment in the meshery namespace"
+	default:
+		check.Status = models.ClusterHealthPassed
+		check.Message = fmt.Sprintf("MeshSync is deployed, %d pod(s) available", deployment.Status.AvailableReplicas)
+	}
+	return check
+}
+
+func brokerHealthCheck(ctx context.Context, dynamicClient dynamic.Interface) models.ClusterHealthCheck {
+	check := models.ClusterHealthCheck{Name: models.ClusterHealthCheckBroker}
+	if dynamicClient == nil {
+		check.Status = models.ClusterHealthFailed
+		check.Message = "unable to get Meshery Broker, no dynamic client"
+		return check
+	}
+	broker, err := dynamicClient.Resource(brokerGVR).Namespace(mesheryNamespace).Get(ctx, "meshery-broker", metav1.GetOptions{})
+	if err != nil {
+		check.Status = models.ClusterHealthFailed
+		check.Message = fmt.Sprintf("Meshery Broker is not deployed: %s", err)
+		check.Remedy = "Deploy Meshery Operator in the cluster, which deploys Meshery Broker, from the Settings of Meshery UI or with mesheryctl system start"
+		if !kerrors.IsNotFound(err) {
+			check.Message = fmt.Sprintf("unable to get Meshery Broker: %s", err)
+		}
+		return check
+	}
+
+	endpoints := []string{}
+	for _, field := range []string{"external", "internal"} {
+		if endpoint, _, _ := unstructured.NestedString(broker.Object, "status", "endpoint", field); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	if len(endpoints) == 0 {
+		check.Status = models.ClusterHealthFailed
+		check.Message = "Meshery Broker has no endpoint yet"
+		check.Remedy = "Check the pods of the meshery-broker statefulset and the meshery-broker service in the meshery namespace"
+		return check
+	}
+
+	var dialer net.Dialer
+	var dialErr error
+	for _, endpoint := range endpoints {
+		dialCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+		conn, err := dialer.DialContext(dialCtx, "tcp", endpoint)
+		cancel()
+		if err == nil {
+			_ = conn.Close()
+			check.Status = models.ClusterHealthPassed
+			check.Message = fmt.Sprintf("Meshery Broker is reachable at %s", endpoint)
+			return check
+		}
+		dialErr = err
+	}
+	check.Status = models.ClusterHealthFailed
+	check.Message = fmt.Sprintf("Meshery Broker is not reachable at %s: %s", strings.Join(endpoints, " or "), dialErr)
+	check.Remedy = "Make sure the meshery-broker service of the meshery namespace is exposed, e.g. as a LoadBalancer, and reachable from Meshery Server"
+	return check
+}
@@ -98,6 +98,7 @@ func (mc *ContentModifier) isPatternSupported(ctx context.Context, patternfile s
 		false,
 		true,
 		true,
+		patternDeployOptions{},
 	)
 	if err != nil {
 		return err.Error(), false
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route GET /api/user/dashboard/snapshots UserAPI idGetDashboardSnapshots
+// Handle GET request for the snapshots of Meshery UI
+//
+// Returns the snapshots of Meshery UI the user captured, the most recent first
+// responses:
+// 	200: dashboardSnapshotsResponseWrapper
+
+// swagger:route POST /api/user/dashboard/snapshots UserAPI idPostDashboardSnapshot
+// Handle POST request to capture a snapshot of Meshery UI
+//
+// Captures the page, Kubernetes contexts, filters and open design of Meshery UI given, as a snapshot
+// shared by its id or by the deep link to the page showing it. The current Kubernetes context of the
+// user is captured when no context is given, and the name of the open design is filled in.
+// responses:
+// 	200: dashboardSnapshotResponseWrapper
+
+// DashboardSnapshotsHandler handles the requests to list and capture the snapshots of Meshery UI of the user
+func (h *Handler) DashboardSnapshotsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if r.Method == http.MethodGet {
+		snapshots, err := h.config.DashboardSnapshotPersister.GetSnapshots(user.UserID)
+		if err != nil {
+			h.log.Error(ErrDashboardSnapshot(err))
+			http.Error(rw, ErrDashboardSnapshot(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		h.writeDashboardSnapshotJSON(rw, snapshots)
+		return
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	snapshot := &models.DashboardSnapshot{}
+	if err := json.NewDecoder(r.Body).Decode(snapshot); err != nil {
+		obj := "dashboard snapshot"
+		h.log.Error(ErrDecoding(err, obj))
+		http.Error(rw, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := snapshot.State.Validate(); err != nil {
+		h.log.Error(ErrDashboardSnapshot(err))
+		http.Error(rw, ErrDashboardSnapshot(err).Error(), http.StatusBadRequest)
+		return
+	}
+	snapshot.UserID = user.UserID
+
+	if len(snapshot.State.Contexts) == 0 {
+		if token, ok := r.Context().Value(models.TokenCtxKey).(string); ok {
+			if cc, err := provider.GetCurrentContext(token); err == nil && cc.Name != "" {
+				snapshot.State.Contexts = []string{cc.Name}
+			}
+		}
+	}
+	if snapshot.State.DesignID != "" {
+		pattern, err := getSavedPattern(r, provider, snapshot.State.DesignID)
+		if err != nil {
+			h.log.Error(ErrGetPattern(err))
+			http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+			return
+		}
+		snapshot.State.DesignName = pattern.Name
+	}
+
+	if err := h.config.DashboardSnapshotPersister.SaveSnapshot(snapshot); err != nil {
+		h.log.Error(ErrDashboardSnapshot(err))
+		http.Error(rw, ErrDashboardSnapshot(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeDashboardSnapshotJSON(rw, snapshot)
+}
+
+// swagger:route GET /api/user/dashboard/snapshots/{id} UserAPI idGetDashboardSnapshot
+// Handle GET request for a snapshot of Meshery UI
+//
+// Returns the snapshot of Meshery UI with the given id, whoever captured it
+// responses:
+// 	200: dashboardSnapshotResponseWrapper
+
+// GetDashboardSnapshotHandler returns a snapshot of Meshery UI shared by its id
+func (h *Handler) GetDashboardSnapshotHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrDashboardSnapshot(err))
+		http.Error(rw, ErrDashboardSnapshot(err).Error(), http.StatusBadRequest)
+		return
+	}
+	snapshot, err := h.config.DashboardSnapshotPersister.GetSnapshot(id)
+	if err != nil {
+		h.log.Error(ErrDashboardSnapshot(err))
+		http.Error(rw, ErrDashboardSnapshot(err).Error(), http.StatusNotFound)
+		return
+	}
+	h.writeDashboardSnapshotJSON(rw, snapshot)
+}
+
+func (h *Handler) writeDashboardSnapshotJSON(rw http.ResponseWriter, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		obj := "dashboard snapshot"
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// datadogQueryPrefix marks the Datadog queries tracked for a performance test
+const datadogQueryPrefix = "datadog:"
+
+// swagger:route GET /api/telemetry/metrics/datadog/config DatadogAPI idGetDatadogConfig
+// Handle GET for Datadog configuration
+//
+// Used for fetching Datadog configuration
+// responses:
+//  200: datadogConfigResponseWrapper
+
+// swagger:route POST /api/telemetry/metrics/datadog/config DatadogAPI idPostDatadogConfig
+// Handle POST for Datadog configuration
+//
+// Used for persisting Datadog configuration
+// responses:
+//  200:
+
+// swagger:route DELETE /api/telemetry/metrics/datadog/config DatadogAPI idDeleteDatadogConfig
+// Handle DELETE for Datadog configuration
+//
+// Used for deleting Datadog configuration
+// responses:
+//  200:
+
+// DatadogConfigHandler is used for persisting Datadog configuration
+func (h *Handler) DatadogConfigHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	if req.Method == http.MethodGet {
+		err := json.NewEncoder(w).Encode(prefObj.Datadog)
+		if err != nil {
+			obj := "Datadog config"
+			h.log.Error(ErrMarshal(err, obj))
+			http.Error(w, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+			return
+		}
+		return
+	}
+
+	if req.Method == http.MethodPost {
+		cfg := &models.Datadog{
+			Site:           req.FormValue("site"),
+			APIKey:         req.FormValue("apiKey"),
+			ApplicationKey: req.FormValue("applicationKey"),
+		}
+		if cfg.Site == "" {
+			cfg.Site = models.DefaultDatadogSite
+		}
+		if _, err := models.DatadogAPIURL(cfg.Site); err != nil {
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := h.config.DatadogClient.Validate(req.Context(), cfg); err != nil {
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		prefObj.Datadog = cfg
+		h.log.Debug("Datadog site ", cfg.Site, " successfully saved")
+	} else if req.Method == http.MethodDelete {
+		prefObj.Datadog = nil
+	}
+
+	err := provider.RecordPreferences(req, user.UserID, prefObj)
+	if err != nil {
+		h.log.Error(ErrRecordPreferences(err))
+		http.Error(w, ErrRecordPreferences(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	_, _ = w.Write([]byte("{}"))
+}
+
+// swagger:route GET /api/telemetry/metrics/datadog/query DatadogAPI idGetDatadogQuery
+// Handle GET request for Datadog Query
+//
+// Used to run Datadog metrics queries
+// responses:
+// 	200:
+
+// DatadogQueryHandler handles Datadog metrics queries
+func (h *Handler) DatadogQueryHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	if prefObj.Datadog == nil || prefObj.Datadog.APIKey == "" {
+		h.log.Error(ErrDatadogConfig)
+		http.Error(w, ErrDatadogConfig.Error(), http.StatusBadRequest)
+		return
+	}
+
+	reqQuery := req.URL.Query()
+	query := reqQuery.Get("query")
+
+	testUUID := reqQuery.Get("uuid")
+	if testUUID != "" {
+		h.config.QueryTracker.AddOrFlagQuery(req.Context(), testUUID, datadogQueryPrefix+query, false)
+	}
+
+	start, end := parseQueryRange(reqQuery.Get("start"), reqQuery.Get("end"))
+	data, err := h.config.DatadogClient.QueryRange(req.Context(), prefObj.Datadog, query, start, end)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// parseQueryRange parses the unix timestamps bounding a metrics query,
+// defaulting to the last hour
+func parseQueryRange(startParam, endParam string) (time.Time, time.Time) {
+	end := time.Now()
+	if v, err := strconv.ParseInt(endParam, 10, 64); err == nil {
+		end = time.Unix(v, 0)
+	}
+	start := end.Add(-time.Hour)
+	if v, err := strconv.ParseInt(startParam, 10, 64); err == nil {
+		start = time.Unix(v, 0)
+	}
+	return start, end
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route GET /api/system/debug SystemAPI idGetServerDebug
+// Handle GET request for the server-side debug
+//
+// Returns the latest entries logged by Meshery Server at its log level after the cursor given as the
+// after parameter or, without a cursor, at or after the RFC 3339 time given as the since parameter.
+// The cursor to pull the next entries with is returned along with them. As the entries are those of the
+// requests of every user, only the admins of Meshery Server may pull them.
+// responses:
+// 	200: serverDebugResponseWrapper
+
+// ServerDebugHandler returns the entries logged by the server so that clients can troubleshoot
+// their requests
+func (h *Handler) ServerDebugHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if h.config.DebugLogRecorder == nil {
+		http.Error(rw, "server-side debug is not enabled", http.StatusNotFound)
+		return
+	}
+	if !h.isAdmin(user, provider) {
+		err := ErrNotAdmin("pull the server-side debug")
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	q := r.URL.Query()
+	var cursor uint64
+	if after := q.Get("after"); after != "" {
+		c, err := strconv.ParseUint(after, 10, 64)
+		if err != nil {
+			h.log.Error(ErrServerDebug(err))
+			http.Error(rw, ErrServerDebug(err).Error(), http.StatusBadRequest)
+			return
+		}
+		cursor = c
+	}
+	var since time.Time
+	if s := q.Get("since"); s != "" {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			h.log.Error(ErrServerDebug(err))
+			http.Error(rw, ErrServerDebug(err).Error(), http.StatusBadRequest)
+			return
+		}
+		since = t
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(h.config.DebugLogRecorder.After(cursor, since)); err != nil {
+		h.log.Error(ErrEncoding(err, "server debug"))
+		http.Error(rw, ErrEncoding(err, "server debug").Error(), http.StatusInternalServerError)
+	}
+}
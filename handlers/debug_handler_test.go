@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestServerDebugHandler(t *testing.T) {
+	h := newTestHandler(t, &models.HandlerConfig{DebugLogRecorder: helpers.NewDebugLogRecorder(10), Admins: []string{"root"}})
+	pull := func(user *models.User) int {
+		rw := httptest.NewRecorder()
+		h.ServerDebugHandler(rw, httptest.NewRequest(http.MethodGet, "/api/system/debug?after=0", nil), nil, user, nil)
+		return rw.Code
+	}
+
+	if code := pull(&models.User{UserID: "alice"}); code != http.StatusForbidden {
+		t.Errorf("user pulling the server-side debug got %d, want %d", code, http.StatusForbidden)
+	}
+	if code := pull(&models.User{UserID: "root"}); code != http.StatusOK {
+		t.Errorf("admin pulling the server-side debug got %d, want %d", code, http.StatusOK)
+	}
+
+	h = newTestHandler(t, &models.HandlerConfig{Admins: []string{"root"}})
+	if code := pull(&models.User{UserID: "root"}); code != http.StatusNotFound {
+		t.Errorf("pulling the server-side debug while no entries are kept got %d, want %d", code, http.StatusNotFound)
+	}
+}
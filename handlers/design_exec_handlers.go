@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	mesherykube "github.com/layer5io/meshkit/utils/kubernetes"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/util/exec"
+)
+
+// defaultContainerAnnotation names the container kubectl runs the commands in when none is given
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// swagger:route GET /api/pattern/{id}/exec PatternsAPI idGetPatternExec
+// Handle GET request to run a command in a component of a design
+//
+// Returns the container of a running pod of the component, in the current Kubernetes context, which the
+// command would be run in. Upgraded to a WebSocket, the request runs the command in this container, as
+// kubectl exec does, the first byte of the binary messages exchanged telling their channel: 0 for the
+// input of the command, an empty message closing it, 1 and 2 for its output and error output, 3 for its
+// exit code once it ended and 4 for the size of the terminal. The Kubernetes connection must be allowed
+// to create pods/exec, and every command run is recorded as an event.
+// responses:
+// 	200: designExecResponseWrapper
+
+// DesignExecHandler runs commands in the containers of a component of a saved design through WebSockets
+func (h *Handler) DesignExecHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	q := r.URL.Query()
+	command := q["command"]
+	if q.Get("component") == "" || len(command) == 0 {
+		err := ErrDesignExec(fmt.Errorf("the component and the command to run are required"))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	tty := q.Get("tty") == "true"
+	resources, kubeclient, ok := h.getDesignResources(rw, r, provider, "pods")
+	if !ok {
+		return
+	}
+
+	target, err := execTarget(r.Context(), kubeclient.KubeClient, resources, q.Get("container"))
+	if err != nil {
+		h.log.Error(ErrDesignExec(err))
+		http.Error(rw, ErrDesignExec(err).Error(), http.StatusNotFound)
+		return
+	}
+	audit := designExecAudit{user: user, design: mux.Vars(r)["id"], target: target, command: command}
+	if kc, ok := r.Context().Value(models.KubeContextKey).(*models.K8sContext); ok && kc != nil {
+		audit.context = kc.Name
+	}
+	if err := canExec(r.Context(), kubeclient.KubeClient, target); err != nil {
+		h.log.Error(ErrDesignExec(err))
+		h.recordDesignExec(audit.denied(err))
+		http.Error(rw, ErrDesignExec(err).Error(), http.StatusForbidden)
+		return
+	}
+	if !websocket.IsWebSocketUpgrade(r) {
+		h.writeApplicationJSON(rw, target, "design exec")
+		return
+	}
+
+	conn, err := portForwardUpgrader.Upgrade(rw, r, nil)
+	if err != nil {
+		// The upgrader has responded already
+		h.log.Error(ErrDesignExec(err))
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	h.recordDesignExec(audit.started())
+	result := runDesignExec(kubeclient, target, command, tty, conn)
+	if result.Error != "" {
+		h.log.Error(ErrDesignExec(fmt.Errorf("%s", result.Error)))
+	}
+	h.recordDesignExec(audit.ended(result))
+
+	status, _ := json.Marshal(result)
+	_ = conn.WriteMessage(websocket.BinaryMessage, append([]byte{models.DesignExecStatus}, status...))
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+}
+
+// execTarget returns the given container, or the default one, of a running pod of the resources of a component
+func execTarget(ctx context.Context, client kubernetes.Interface, resources []designResource, container string) (*models.DesignExec, error) {
+	pod, res, _, err := componentPod(ctx, client, resources)
+	if err != nil {
+		return nil, err
+	}
+	if container == "" {
+		container = pod.Annotations[defaultContainerAnnotation]
+	}
+	if container == "" {
+		container = pod.Spec.Containers[0].Name
+	}
+	found := false
+	for _, c := range pod.Spec.Containers {
+		found = found || c.Name == container
+	}
+	if !found {
+		return nil, fmt.Errorf("the pod %s has no container named %s", pod.Name, container)
+	}
+	return &models.DesignExec{
+		Component: res.component,
+		Object:    res.String(),
+		Namespace: pod.Namespace,
+		Pod:       pod.Name,
+		Container: container,
+	}, nil
+}
+
+// canExec verifies that the Kubernetes connection is allowed to run commands in the pod
+func canExec(ctx context.Context, client kubernetes.Interface, target *models.DesignExec) error {
+	review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   target.Namespace,
+				Verb:        "create",
+				Resource:    "pods",
+				Subresource: "exec",
+				Name:        target.Pod,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	if !review.Status.Allowed {
+		reason := review.Status.Reason
+		if reason == "" {
+			reason = "no RBAC rule allows it"
+		}
+		return fmt.Errorf("the Kubernetes connection is not allowed to create pods/exec in the namespace %s: %s", target.Namespace, reason)
+	}
+	return nil
+}
+
+// runDesignExec runs the command in the container, its streams being exchanged through the WebSocket,
+// and returns its outcome
+func runDesignExec(kubeclient *mesherykube.Client, target *models.DesignExec, command []string, tty bool, conn *websocket.Conn) models.DesignExecResult {
+	req := kubeclient.KubeClient.CoreV1().RESTClient().Post().
+		Resource("pods").Namespace(target.Namespace).Name(target.Pod).SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: target.Container,
+			Command:   command,
+			Stdin:     true,
+			Stdout:    true,
+			Stderr:    !tty,
+			TTY:       tty,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(&kubeclient.RestConfig, http.MethodPost, req.URL())
+	if err != nil {
+		return models.DesignExecResult{ExitCode: 1, Error: err.Error()}
+	}
+
+	stdin, stdinWriter := io.Pipe()
+	sizes := make(chan remotecommand.TerminalSize, 1)
+	go func() {
+		defer close(sizes)
+		defer func() {
+			_ = stdinWriter.Close()
+		}()
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if len(message) == 0 {
+				continue
+			}
+			switch message[0] {
+			case models.DesignExecStdin:
+				if len(message) == 1 {
+					_ = stdinWriter.Close()
+					continue
+				}
+				if _, err := stdinWriter.Write(message[1:]); err != nil {
+					continue
+				}
+			case models.DesignExecResize:
+				size := models.DesignExecSize{}
+				if json.Unmarshal(message[1:], &size) != nil {
+					continue
+				}
+				// Only the latest size matters
+				select {
+				case <-sizes:
+				default:
+				}
+				sizes <- remotecommand.TerminalSize{Width: size.Width, Height: size.Height}
+			}
+		}
+	}()
+
+	lock := &sync.Mutex{}
+	opts := remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: &designExecWriter{conn: conn, channel: models.DesignExecStdout, lock: lock},
+		Tty:    tty,
+	}
+	if tty {
+		opts.TerminalSizeQueue = designExecSizes(sizes)
+	} else {
+		opts.Stderr = &designExecWriter{conn: conn, channel: models.DesignExecStderr, lock: lock}
+	}
+	err = executor.Stream(opts)
+	// The input is not read anymore once the command ended
+	_ = stdin.Close()
+	if exitErr, ok := err.(exec.CodeExitError); ok {
+		return models.DesignExecResult{ExitCode: exitErr.Code}
+	}
+	if err != nil {
+		return models.DesignExecResult{ExitCode: 1, Error: err.Error()}
+	}
+	return models.DesignExecResult{}
+}
+
+// designExecWriter sends what is written to it on a channel of the WebSocket
+type designExecWriter struct {
+	conn    *websocket.Conn
+	channel byte
+	lock    *sync.Mutex
+}
+
+func (w *designExecWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if err := w.conn.WriteMessage(websocket.BinaryMessage, append([]byte{w.channel}, p...)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// designExecSizes is the queue of the sizes of the terminal received on the WebSocket
+type designExecSizes <-chan remotecommand.TerminalSize
+
+func (s designExecSizes) Next() *remotecommand.TerminalSize {
+	size, ok := <-s
+	if !ok {
+		return nil
+	}
+	return &size
+}
+
+// designExecAudit describes a command run in a design for the events auditing it
+type designExecAudit struct {
+	user    *models.User
+	design  string
+	context string
+	target  *models.DesignExec
+	command []string
+}
+
+func (a designExecAudit) summary(verb string) string {
+	by := "unknown user"
+	if a.user != nil && a.user.UserID != "" {
+		by = a.user.UserID
+	}
+	return fmt.Sprintf("Command %q run by %s in %s/%s of the component %s %s", strings.Join(a.command, " "), by, a.target.Pod, a.target.Container, a.target.Component, verb)
+}
+
+func (a designExecAudit) details() string {
+	return fmt.Sprintf("Design: %s\nKubernetes context: %s\nNamespace: %s\nResource: %s", a.design, a.context, a.target.Namespace, a.target.Object)
+}
+
+func (a designExecAudit) denied(err error) *meshes.EventsResponse {
+	return &meshes.EventsResponse{
+		EventType: meshes.EventType_WARN,
+		Summary:   a.summary("denied"),
+		Details:   a.details() + "\n" + err.Error(),
+	}
+}
+
+func (a designExecAudit) started() *meshes.EventsResponse {
+	return &meshes.EventsResponse{
+		EventType: meshes.EventType_INFO,
+		Summary:   a.summary("started"),
+		Details:   a.details(),
+	}
+}
+
+func (a designExecAudit) ended(result models.DesignExecResult) *meshes.EventsResponse {
+	event := &meshes.EventsResponse{
+		EventType: meshes.EventType_INFO,
+		Summary:   a.summary(fmt.Sprintf("exited with %d", result.ExitCode)),
+		Details:   a.details(),
+	}
+	if result.Error != "" {
+		event.EventType = meshes.EventType_ERROR
+		event.Details += "\n" + result.Error
+	}
+	return event
+}
+
+// recordDesignExec records the event auditing a command run in a design
+func (h *Handler) recordDesignExec(event *meshes.EventsResponse) {
+	if h.config.EventRecorder == nil {
+		return
+	}
+	if _, err := h.config.EventRecorder.Record(models.EventCategoryDesign, "design-exec", event); err != nil {
+		h.log.Error(err)
+	}
+}
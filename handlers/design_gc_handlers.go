@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshery/models/pattern/patterns/k8s"
+	"github.com/spf13/viper"
+)
+
+// swagger:route GET /api/patterns/gc PatternsAPI idGetDesignGC
+// Handle GET request for the resources left behind by deleted designs
+//
+// Lists the resources of the Kubernetes context given with cluster, by name or ID, or of the current
+// one, which are labeled as applied by Meshery Server but belong to no existing design
+// responses:
+// 	200: designGCResponseWrapper
+
+// swagger:route DELETE /api/patterns/gc PatternsAPI idDeleteDesignGC
+// Handle DELETE request to remove the resources left behind by deleted designs
+//
+// Deletes the resources of the Kubernetes context given with cluster, by name or ID, or of the current
+// one, which are labeled as applied by Meshery Server but belong to no existing design, along with the
+// resources they own
+// responses:
+// 	200: designGCResponseWrapper
+
+// DesignGCHandler lists and deletes the resources of a cluster left behind by the designs since deleted
+func (h *Handler) DesignGCHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	token, ok := r.Context().Value(models.TokenCtxKey).(string)
+	if !ok {
+		err := ErrRetrieveUserToken(fmt.Errorf("failed to retrieve user token"))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	mid, ok := viper.Get("INSTANCE_ID").(*uuid.UUID)
+	if !ok || mid == nil {
+		h.log.Error(ErrDesignGC(fmt.Errorf("the ID of the Meshery Server instance is unknown")))
+		http.Error(rw, ErrDesignGC(fmt.Errorf("the ID of the Meshery Server instance is unknown")).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	kc, err := designGCContext(r, token, provider)
+	if err != nil {
+		h.log.Error(ErrDesignGC(err))
+		http.Error(rw, ErrDesignGC(err).Error(), http.StatusBadRequest)
+		return
+	}
+	kubeClient, err := kc.GenerateKubeHandler()
+	if err != nil {
+		h.log.Error(ErrDesignGC(err))
+		http.Error(rw, ErrDesignGC(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	managed, err := k8s.ListManagedResources(r.Context(), kubeClient, mid.String())
+	if err != nil {
+		h.log.Error(ErrDesignGC(err))
+		http.Error(rw, ErrDesignGC(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	ids, names, err := savedDesigns(token, provider)
+	if err != nil {
+		h.log.Error(ErrDesignGC(err))
+		http.Error(rw, ErrDesignGC(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	result := models.DesignGCResult{
+		ContextID:   kc.ID,
+		ContextName: kc.Name,
+		Orphans:     []models.OrphanedResource{},
+		Deleted:     r.Method == http.MethodDelete,
+	}
+	for _, res := range orphanedResources(managed, ids, names) {
+		orphan := models.OrphanedResource{
+			APIVersion: res.APIVersion,
+			Kind:       res.Kind,
+			Namespace:  res.Namespace,
+			Name:       res.Name,
+			DesignID:   res.DesignID,
+			DesignName: res.DesignName,
+		}
+		if result.Deleted {
+			if err := k8s.DeleteManagedResource(r.Context(), kubeClient, res); err != nil {
+				h.log.Error(ErrDesignGC(err))
+				orphan.Error = err.Error()
+			}
+		}
+		result.Orphans = append(result.Orphans, orphan)
+	}
+	h.writeApplicationJSON(rw, result, "design garbage collection")
+}
+
+// designGCContext returns the Kubernetes context with the name or ID given with the cluster query
+// parameter, or the current one when none is given
+func designGCContext(r *http.Request, token string, provider models.Provider) (*models.K8sContext, error) {
+	cluster := strings.TrimSpace(r.URL.Query().Get("cluster"))
+	if cluster == "" {
+		kc, ok := r.Context().Value(models.KubeContextKey).(*models.K8sContext)
+		if !ok || kc == nil {
+			return nil, fmt.Errorf("no current Kubernetes context, give the cluster to collect the garbage of")
+		}
+		return kc, nil
+	}
+
+	contexts, err := provider.LoadAllK8sContext(token)
+	if err != nil {
+		return nil, err
+	}
+	var matches []*models.K8sContext
+	for _, kc := range contexts {
+		if kc.ID == cluster {
+			return kc, nil
+		}
+		if kc.Name == cluster {
+			matches = append(matches, kc)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no Kubernetes context named %s", cluster)
+	case 1:
+		return matches[0], nil
+	}
+	return nil, fmt.Errorf("%d Kubernetes contexts are named %s, give the ID of one of them", len(matches), cluster)
+}
+
+// savedDesigns returns the IDs and names of every design saved with the provider
+func savedDesigns(token string, provider models.Provider) (map[string]bool, map[string]bool, error) {
+	ids, names := map[string]bool{}, map[string]bool{}
+	for page, seen := 0, 0; ; page++ {
+		resp, err := provider.GetMesheryPatterns(token, strconv.Itoa(page), "100", "", "", "")
+		if err != nil {
+			return nil, nil, err
+		}
+		patternPage := models.PatternsAPIResponse{}
+		if err := json.Unmarshal(resp, &patternPage); err != nil {
+			return nil, nil, err
+		}
+		for _, p := range patternPage.Patterns {
+			if p.ID != nil {
+				ids[p.ID.String()] = true
+			}
+			names[p.Name] = true
+		}
+		seen += len(patternPage.Patterns)
+		if len(patternPage.Patterns) == 0 || seen >= int(patternPage.TotalCount) {
+			return ids, names, nil
+		}
+	}
+}
+
+// orphanedResources returns the managed resources whose design no longer exists: those labeled with the
+// ID of a design which is not saved, and those of the designs deployed without being saved whose name
+// no saved design has. The namespaces still holding the resources of an existing design are left out.
+func orphanedResources(managed []k8s.ManagedResource, ids, names map[string]bool) []k8s.ManagedResource {
+	orphaned := func(r k8s.ManagedResource) bool {
+		if r.DesignID != "" {
+			return !ids[r.DesignID]
+		}
+		return !names[r.DesignName]
+	}
+
+	inUse := map[string]bool{}
+	for _, r := range managed {
+		if r.Namespace != "" && !orphaned(r) {
+			inUse[r.Namespace] = true
+		}
+	}
+	orphans := []k8s.ManagedResource{}
+	for _, r := range managed {
+		if !orphaned(r) {
+			continue
+		}
+		if r.Resource.Group == "" && r.Resource.Resource == "namespaces" && inUse[r.Name] {
+			continue
+		}
+		orphans = append(orphans, r)
+	}
+	return orphans
+}
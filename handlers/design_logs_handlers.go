@@ -0,0 +1,332 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+	pCore "github.com/layer5io/meshery/models/pattern/core"
+	mesherykube "github.com/layer5io/meshkit/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// designResource is a Kubernetes resource deployed for a service of a design
+type designResource struct {
+	component string
+	kind      string
+	name      string
+	namespace string
+}
+
+func (d designResource) String() string {
+	return d.kind + "/" + d.name
+}
+
+// swagger:route GET /api/pattern/{id}/logs PatternsAPI idGetPatternLogs
+// Handle GET request for the logs of the pods of a design
+//
+// Streams the logs of the containers of the pods run by the resources deployed for the design in the
+// current Kubernetes context, or for one of its components, every line being prefixed with the
+// component, the pod and the container it comes from
+// responses:
+// 	200: noContentWrapper
+
+// DesignLogsHandler streams the logs of the pods of a saved design
+func (h *Handler) DesignLogsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	resources, kubeclient, ok := h.getDesignResources(rw, r, provider, "logs")
+	if !ok {
+		return
+	}
+
+	q := r.URL.Query()
+	opts := corev1.PodLogOptions{
+		Follow: q.Get("follow") == "true",
+	}
+	if tail, err := strconv.ParseInt(q.Get("tail"), 10, 64); err == nil && tail >= 0 {
+		opts.TailLines = &tail
+	}
+	container := q.Get("container")
+
+	type podContainer struct {
+		component string
+		pod       corev1.Pod
+		container string
+	}
+	streams := []podContainer{}
+	for _, res := range resources {
+		pods, err := designPods(r.Context(), kubeclient.KubeClient, res)
+		if err != nil {
+			h.log.Error(ErrDesignResources(err, "logs"))
+			http.Error(rw, ErrDesignResources(err, "logs").Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, pod := range pods {
+			for _, c := range pod.Spec.Containers {
+				if container == "" || c.Name == container {
+					streams = append(streams, podContainer{component: res.component, pod: pod, container: c.Name})
+				}
+			}
+		}
+	}
+	if len(streams) == 0 {
+		err := ErrDesignResources(fmt.Errorf("no running containers found for the design in the current Kubernetes context"), "logs")
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	flusher, _ := rw.(http.Flusher)
+	var lock sync.Mutex
+	writeLine := func(line string) {
+		lock.Lock()
+		defer lock.Unlock()
+		_, _ = fmt.Fprintln(rw, line)
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, s := range streams {
+		wg.Add(1)
+		go func(s podContainer) {
+			defer wg.Done()
+			prefix := fmt.Sprintf("[%s %s/%s]", s.component, s.pod.Name, s.container)
+			podOpts := opts
+			podOpts.Container = s.container
+			logs, err := kubeclient.KubeClient.CoreV1().Pods(s.pod.Namespace).GetLogs(s.pod.Name, &podOpts).Stream(r.Context())
+			if err != nil {
+				writeLine(fmt.Sprintf("%s failed to get the logs: %s", prefix, err))
+				return
+			}
+			defer func() {
+				_ = logs.Close()
+			}()
+			scanner := bufio.NewScanner(logs)
+			scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+			for scanner.Scan() {
+				writeLine(prefix + " " + scanner.Text())
+			}
+		}(s)
+	}
+	wg.Wait()
+}
+
+// swagger:route GET /api/pattern/{id}/events PatternsAPI idGetPatternEvents
+// Handle GET request for the Kubernetes events of a design
+//
+// Returns the Kubernetes events of the resources deployed for the design in the current Kubernetes
+// context, or for one of its components, and of their pods, the most recent last
+// responses:
+// 	200: designEventsResponseWrapper
+
+// DesignEventsHandler returns the Kubernetes events of the resources of a saved design
+func (h *Handler) DesignEventsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	resources, kubeclient, ok := h.getDesignResources(rw, r, provider, "events")
+	if !ok {
+		return
+	}
+
+	events := []models.DesignEvent{}
+	for _, res := range resources {
+		objects := []designResource{res}
+		pods, err := designPods(r.Context(), kubeclient.KubeClient, res)
+		if err != nil {
+			h.log.Error(ErrDesignResources(err, "events"))
+			http.Error(rw, ErrDesignResources(err, "events").Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, pod := range pods {
+			objects = append(objects, designResource{component: res.component, kind: "Pod", name: pod.Name, namespace: pod.Namespace})
+		}
+
+		for _, obj := range objects {
+			list, err := kubeclient.KubeClient.CoreV1().Events(obj.namespace).List(r.Context(), metav1.ListOptions{
+				FieldSelector: fmt.Sprintf("involvedObject.kind=%s,involvedObject.name=%s", obj.kind, obj.name),
+			})
+			if err != nil {
+				h.log.Error(ErrDesignResources(err, "events"))
+				http.Error(rw, ErrDesignResources(err, "events").Error(), http.StatusInternalServerError)
+				return
+			}
+			for _, e := range list.Items {
+				lastSeen := e.LastTimestamp.Time
+				if lastSeen.IsZero() {
+					lastSeen = e.EventTime.Time
+				}
+				events = append(events, models.DesignEvent{
+					Component: obj.component,
+					Object:    obj.String(),
+					Namespace: obj.namespace,
+					Type:      e.Type,
+					Reason:    e.Reason,
+					Message:   e.Message,
+					Count:     e.Count,
+					LastSeen:  lastSeen,
+				})
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastSeen.Before(events[j].LastSeen)
+	})
+	h.writeApplicationJSON(rw, events, "design events")
+}
+
+// getDesignResources fetches the resources of the saved design of the request, those of the
+// component given if any, along with the client of the current Kubernetes context, writing the
+// error to the response when it fails
+func (h *Handler) getDesignResources(rw http.ResponseWriter, r *http.Request, provider models.Provider, obj string) ([]designResource, *mesherykube.Client, bool) {
+	pattern, err := getSavedPattern(r, provider, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return nil, nil, false
+	}
+	resources, err := designResources(pattern, r.URL.Query().Get("component"))
+	if err != nil {
+		h.log.Error(ErrDesignResources(err, obj))
+		http.Error(rw, ErrDesignResources(err, obj).Error(), http.StatusBadRequest)
+		return nil, nil, false
+	}
+	kubeclient, ok := r.Context().Value(models.KubeHanderKey).(*mesherykube.Client)
+	if !ok || kubeclient == nil {
+		h.log.Error(ErrNilClient)
+		http.Error(rw, ErrNilClient.Error(), http.StatusBadRequest)
+		return nil, nil, false
+	}
+	return resources, kubeclient, true
+}
+
+// designResources returns the Kubernetes resources of the services of the design, of the given
+// component only if any, sorted by component
+func designResources(pattern *models.MesheryPattern, component string) ([]designResource, error) {
+	file, err := pCore.NewPatternFile([]byte(pattern.PatternFile))
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []designResource{}
+	for name, svc := range file.Services {
+		if !strings.HasSuffix(strings.ToLower(svc.Type), ".k8s") {
+			continue
+		}
+		if component != "" && name != component && svc.Name != component {
+			continue
+		}
+		namespace := svc.Namespace
+		if namespace == "" {
+			namespace = "default"
+		}
+		kind := svc.Type[:len(svc.Type)-len(".k8s")]
+		resources = append(resources, designResource{component: name, kind: kind, name: svc.Name, namespace: namespace})
+	}
+	if component != "" && len(resources) == 0 {
+		return nil, fmt.Errorf("the design has no Kubernetes component named %s", component)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].component < resources[j].component
+	})
+	return resources, nil
+}
+
+// designPods returns the pods run by the resource, none if it does not run pods or is not deployed
+func designPods(ctx context.Context, client kubernetes.Interface, res designResource) ([]corev1.Pod, error) {
+	if strings.EqualFold(res.kind, "Pod") {
+		pod, err := client.CoreV1().Pods(res.namespace).Get(ctx, res.name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []corev1.Pod{*pod}, nil
+	}
+
+	getSelector, ok := podSelectors(client)[strings.ToLower(res.kind)]
+	if !ok {
+		return nil, nil
+	}
+	selector, err := getSelector(ctx, res.namespace, res.name)
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	list, err := client.CoreV1().Pods(res.namespace).List(ctx, metav1.ListOptions{LabelSelector: sel.String()})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// podSelectors returns the functions getting the selector of the pods of the resources running
+// pods, keyed by lowercase kind
+func podSelectors(client kubernetes.Interface) map[string]func(ctx context.Context, namespace, name string) (*metav1.LabelSelector, error) {
+	apps := client.AppsV1()
+	return map[string]func(ctx context.Context, namespace, name string) (*metav1.LabelSelector, error){
+		"deployment": func(ctx context.Context, namespace, name string) (*metav1.LabelSelector, error) {
+			d, err := apps.Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return d.Spec.Selector, nil
+		},
+		"statefulset": func(ctx context.Context, namespace, name string) (*metav1.LabelSelector, error) {
+			s, err := apps.StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return s.Spec.Selector, nil
+		},
+		"daemonset": func(ctx context.Context, namespace, name string) (*metav1.LabelSelector, error) {
+			d, err := apps.DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return d.Spec.Selector, nil
+		},
+		"replicaset": func(ctx context.Context, namespace, name string) (*metav1.LabelSelector, error) {
+			rs, err := apps.ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return rs.Spec.Selector, nil
+		},
+		"job": func(ctx context.Context, namespace, name string) (*metav1.LabelSelector, error) {
+			j, err := client.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return j.Spec.Selector, nil
+		},
+	}
+}
@@ -0,0 +1,301 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/layer5io/meshery/models"
+	mesherykube "github.com/layer5io/meshkit/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// portForwardUpgrader upgrades the port forwarding requests to WebSockets, the cross-origin ones
+// of browsers being refused
+var portForwardUpgrader = websocket.Upgrader{
+	ReadBufferSize:  32 * 1024,
+	WriteBufferSize: 32 * 1024,
+}
+
+// swagger:route GET /api/pattern/{id}/port-forward PatternsAPI idGetPatternPortForward
+// Handle GET request to forward a port of a component of a design
+//
+// Returns the port of the running pod of the component, in the current Kubernetes context, which the
+// connections to the given port of the component are forwarded to. Upgraded to a WebSocket, the request
+// tunnels the binary messages exchanged to this pod port, as kubectl port-forward does. The port is
+// the port of the Service, or the container port for the other components, by number or name.
+// responses:
+// 	200: designPortForwardResponseWrapper
+
+// DesignPortForwardHandler forwards the connections tunneled through WebSockets to a component of a saved design
+func (h *Handler) DesignPortForwardHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	q := r.URL.Query()
+	if q.Get("component") == "" || q.Get("port") == "" {
+		err := ErrDesignPortForward(fmt.Errorf("the component and the port to forward are required"))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resources, kubeclient, ok := h.getDesignResources(rw, r, provider, "pods")
+	if !ok {
+		return
+	}
+
+	target, err := portForwardTarget(r.Context(), kubeclient.KubeClient, resources, q.Get("port"))
+	if err != nil {
+		h.log.Error(ErrDesignPortForward(err))
+		http.Error(rw, ErrDesignPortForward(err).Error(), http.StatusNotFound)
+		return
+	}
+	if !websocket.IsWebSocketUpgrade(r) {
+		h.writeApplicationJSON(rw, target, "design port forward")
+		return
+	}
+
+	conn, err := portForwardUpgrader.Upgrade(rw, r, nil)
+	if err != nil {
+		// The upgrader has responded already
+		h.log.Error(ErrDesignPortForward(err))
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	if err := forwardPort(kubeclient, target, conn); err != nil {
+		h.log.Error(ErrDesignPortForward(err))
+		_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, truncateCloseReason(err.Error())), time.Now().Add(time.Second))
+		return
+	}
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+}
+
+// portForwardTarget returns the running pod port the given port of the resources of a component
+// is forwarded to
+func portForwardTarget(ctx context.Context, client kubernetes.Interface, resources []designResource, port string) (*models.DesignPortForward, error) {
+	pod, res, svc, err := componentPod(ctx, client, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	var podPort int32
+	if svc != nil {
+		podPort, err = serviceTargetPort(svc, pod, port)
+	} else {
+		podPort, err = containerPort(pod, intstr.Parse(port))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.DesignPortForward{
+		Component: res.component,
+		Object:    res.String(),
+		Namespace: pod.Namespace,
+		Pod:       pod.Name,
+		Port:      podPort,
+	}, nil
+}
+
+// componentPod returns a running pod of the resources of a component along with the resource it
+// was selected through, a Service being preferred to the workloads, and the Service if so
+func componentPod(ctx context.Context, client kubernetes.Interface, resources []designResource) (*corev1.Pod, designResource, *corev1.Service, error) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		return strings.EqualFold(resources[i].kind, "Service") && !strings.EqualFold(resources[j].kind, "Service")
+	})
+
+	for _, res := range resources {
+		var pods []corev1.Pod
+		var svc *corev1.Service
+		var err error
+		if strings.EqualFold(res.kind, "Service") {
+			svc, pods, err = servicePods(ctx, client, res)
+		} else {
+			pods, err = designPods(ctx, client, res)
+		}
+		if err != nil {
+			return nil, res, nil, err
+		}
+		if pod := runningPod(pods); pod != nil {
+			return pod, res, svc, nil
+		}
+	}
+	return nil, designResource{}, nil, fmt.Errorf("no running pod found for the component in the current Kubernetes context")
+}
+
+// servicePods returns the Service of the resource along with the pods it selects, none if it is not deployed
+func servicePods(ctx context.Context, client kubernetes.Interface, res designResource) (*corev1.Service, []corev1.Pod, error) {
+	svc, err := client.CoreV1().Services(res.namespace).Get(ctx, res.name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil, nil, fmt.Errorf("the service %s selects no pods", res.name)
+	}
+	list, err := client.CoreV1().Pods(res.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return svc, list.Items, nil
+}
+
+// runningPod returns the first running pod, a ready one being preferred, nil if none is running
+func runningPod(pods []corev1.Pod) *corev1.Pod {
+	var running *corev1.Pod
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+				return pod
+			}
+		}
+		if running == nil {
+			running = pod
+		}
+	}
+	return running
+}
+
+// serviceTargetPort returns the port of the pod the given port of the Service targets
+func serviceTargetPort(svc *corev1.Service, pod *corev1.Pod, port string) (int32, error) {
+	for _, p := range svc.Spec.Ports {
+		if p.Name != port && strconv.Itoa(int(p.Port)) != port {
+			continue
+		}
+		if p.TargetPort.Type == intstr.Int && p.TargetPort.IntVal == 0 {
+			return p.Port, nil
+		}
+		return containerPort(pod, p.TargetPort)
+	}
+	return 0, fmt.Errorf("the service %s has no port %s", svc.Name, port)
+}
+
+// containerPort returns the port of the containers of the pod with the given number or name
+func containerPort(pod *corev1.Pod, port intstr.IntOrString) (int32, error) {
+	if port.Type == intstr.Int {
+		if port.IntVal <= 0 || port.IntVal > 65535 {
+			return 0, fmt.Errorf("invalid port %d", port.IntVal)
+		}
+		return port.IntVal, nil
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == port.StrVal {
+				return p.ContainerPort, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("the pod %s has no port named %s", pod.Name, port.StrVal)
+}
+
+// forwardPort tunnels the binary messages of the WebSocket to the port of the pod, until either side
+// closes the connection
+func forwardPort(kubeclient *mesherykube.Client, target *models.DesignPortForward, conn *websocket.Conn) error {
+	transport, upgrader, err := spdy.RoundTripperFor(&kubeclient.RestConfig)
+	if err != nil {
+		return err
+	}
+	reqURL := kubeclient.KubeClient.CoreV1().RESTClient().Post().
+		Resource("pods").Namespace(target.Namespace).Name(target.Pod).SubResource("portforward").URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, reqURL)
+	streamConn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = streamConn.Close()
+	}()
+
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(int(target.Port)))
+	headers.Set(corev1.PortForwardRequestIDHeader, "0")
+	errorStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		return err
+	}
+	// Nothing is written to the error stream
+	_ = errorStream.Close()
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		return err
+	}
+
+	done := make(chan error, 3)
+	go func() {
+		message, err := io.ReadAll(errorStream)
+		if err == nil && len(message) > 0 {
+			err = fmt.Errorf("%s", message)
+		}
+		if err != nil {
+			done <- err
+		}
+	}()
+	go func() {
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := dataStream.Read(buf)
+			if n > 0 {
+				if werr := conn.WriteMessage(websocket.BinaryMessage, buf[:n]); werr != nil {
+					done <- nil
+					return
+				}
+			}
+			if err != nil {
+				done <- nil
+				return
+			}
+		}
+	}()
+	go func() {
+		for {
+			kind, data, err := conn.ReadMessage()
+			if err != nil {
+				done <- nil
+				return
+			}
+			if kind != websocket.BinaryMessage {
+				continue
+			}
+			if _, err := dataStream.Write(data); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+	return <-done
+}
+
+// truncateCloseReason shortens the reason to the size a close frame can hold
+func truncateCloseReason(reason string) string {
+	if len(reason) > 120 {
+		return reason[:120]
+	}
+	return reason
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshery/models/pattern/core"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+)
+
+// swagger:route GET /api/pattern/{id}/schedules PatternsAPI idGetPatternSchedules
+// Handle GET request for the schedules of a design
+//
+// Returns the scheduled deployments and undeployments of the design, the next to run first
+// responses:
+// 	200: designSchedulesResponseWrapper
+
+// swagger:route POST /api/pattern/{id}/schedules PatternsAPI idPostPatternSchedule
+// Handle POST request to schedule a design operation
+//
+// Schedules the deployment or undeployment of the design to the current Kubernetes context, once at
+// a given time or recurringly following a cron expression evaluated in UTC
+// responses:
+// 	200: designScheduleResponseWrapper
+
+// PatternScheduleHandler lists the schedules of a design and schedules its deployment or undeployment
+func (h *Handler) PatternScheduleHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	patternID := mux.Vars(r)["id"]
+	if r.Method == http.MethodGet {
+		schedules, err := h.config.DesignSchedulePersister.GetSchedules(user.UserID, patternID)
+		if err != nil {
+			h.log.Error(ErrDesignSchedule(err))
+			http.Error(rw, ErrDesignSchedule(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		h.writeApplicationJSON(rw, schedules, "design schedules")
+		return
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+	schedule := &models.DesignSchedule{}
+	if err := json.NewDecoder(r.Body).Decode(schedule); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := schedule.Validate(); err != nil {
+		h.log.Error(ErrDesignSchedule(err))
+		http.Error(rw, ErrDesignSchedule(err).Error(), http.StatusBadRequest)
+		return
+	}
+	next, err := helpers.NextDesignScheduleRun(schedule, time.Now())
+	if err != nil {
+		h.log.Error(ErrDesignSchedule(err))
+		http.Error(rw, ErrDesignSchedule(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	pattern, err := getSavedPattern(r, provider, patternID)
+	if err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+	k8sContext, ok := r.Context().Value(models.KubeContextKey).(*models.K8sContext)
+	if !ok || k8sContext == nil {
+		err := ErrInvalidKubeContext(fmt.Errorf("failed to find k8s context"), "the design must be scheduled against a current Kubernetes context")
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	token, ok := r.Context().Value(models.TokenCtxKey).(string)
+	if !ok {
+		err := ErrRetrieveUserToken(fmt.Errorf("failed to retrieve user token"))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	schedule.UserID = user.UserID
+	schedule.PatternID = pattern.ID.String()
+	schedule.PatternName = pattern.Name
+	schedule.PatternFile = pattern.PatternFile
+	schedule.K8sContextID = k8sContext.ID
+	schedule.K8sContextName = k8sContext.Name
+	schedule.Provider = provider.Name()
+	schedule.Token = token
+	schedule.NextRunAt = next
+	schedule.LastRunAt, schedule.LastStatus, schedule.LastMessage = nil, "", ""
+	if err := h.config.DesignSchedulePersister.SaveSchedule(schedule); err != nil {
+		h.log.Error(ErrDesignSchedule(err))
+		http.Error(rw, ErrDesignSchedule(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeApplicationJSON(rw, schedule, "design schedule")
+}
+
+// swagger:route GET /api/patterns/schedules PatternsAPI idGetDesignSchedules
+// Handle GET request for the design schedules
+//
+// Returns the scheduled deployments and undeployments of the designs of the user, the next to run first
+// responses:
+// 	200: designSchedulesResponseWrapper
+
+// swagger:route DELETE /api/patterns/schedules/{id} PatternsAPI idDeleteDesignSchedule
+// Handle DELETE request for a design schedule
+//
+// Cancels the scheduled deployment or undeployment
+// responses:
+// 	200:
+
+// DesignSchedulesHandler lists the design schedules of the user and deletes them
+func (h *Handler) DesignSchedulesHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if r.Method == http.MethodDelete {
+		deleted, err := h.config.DesignSchedulePersister.DeleteSchedule(user.UserID, mux.Vars(r)["id"])
+		if err != nil {
+			h.log.Error(ErrDesignSchedule(err))
+			http.Error(rw, ErrDesignSchedule(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		if !deleted {
+			err := ErrDesignSchedule(fmt.Errorf("schedule %s not found", mux.Vars(r)["id"]))
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusNotFound)
+		}
+		return
+	}
+
+	schedules, err := h.config.DesignSchedulePersister.GetSchedules(user.UserID, "")
+	if err != nil {
+		h.log.Error(ErrDesignSchedule(err))
+		http.Error(rw, ErrDesignSchedule(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeApplicationJSON(rw, schedules, "design schedules")
+}
+
+// RunDesignSchedule deploys or undeploys the design of the schedule to its Kubernetes context with
+// the session of the user who scheduled it, as the scheduler of Meshery Server runs it, until the
+// context is cancelled
+func (h *Handler) RunDesignSchedule(ctx context.Context, schedule *models.DesignSchedule) (string, error) {
+	provider, ok := h.config.Providers[schedule.Provider]
+	if !ok {
+		return "", ErrDesignSchedule(fmt.Errorf("provider %s is not available", schedule.Provider))
+	}
+	k8sContext, err := provider.GetK8sContext(schedule.Token, schedule.K8sContextID)
+	if err != nil {
+		return "", ErrDesignSchedule(err)
+	}
+	kubecfg, err := k8sContext.GenerateKubeConfig()
+	if err != nil {
+		return "", ErrDesignSchedule(err)
+	}
+	kubeClient, err := meshkube.New(kubecfg)
+	if err != nil {
+		return "", ErrDesignSchedule(err)
+	}
+	patternFile, err := core.NewPatternFile([]byte(schedule.PatternFile))
+	if err != nil {
+		return "", ErrPatternFile(err)
+	}
+	prefObj, err := provider.ReadFromPersister(schedule.UserID)
+	if err != nil {
+		h.log.Warn(ErrDesignSchedule(err))
+	}
+
+	ctx = context.WithValue(ctx, models.TokenCtxKey, schedule.Token)
+	ctx = context.WithValue(ctx, models.KubeContextKey, &k8sContext)
+	ctx = context.WithValue(ctx, models.KubeHanderKey, kubeClient)
+	ctx = context.WithValue(ctx, models.KubeConfigKey, kubecfg)
+
+	// Undeployments remove only the components Meshery recorded as deployed, as mesheryctl does
+	isDelete := schedule.Operation == models.DesignScheduleUndeploy
+	msg, err := _processPattern(ctx, provider, patternFile, prefObj, schedule.UserID, isDelete, false, true, patternDeployOptions{
+		recordedOnly:  isDelete,
+		keepNamespace: schedule.KeepNamespace,
+		timeout:       defaultReadinessTimeout,
+		secrets:       h.secretResolvers(schedule.UserID),
+		designID:      schedule.PatternID,
+
+		validationWebhooks: h.validationWebhooks(),
+	})
+	if err != nil {
+		return "", ErrCompConfigPairs(err)
+	}
+	return msg, nil
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// errDigestNotConfigured is returned when Meshery Server is not configured to send emails
+var errDigestNotConfigured = fmt.Errorf("Meshery Server is not configured to send emails, SMTP_HOST and SMTP_FROM are required")
+
+// swagger:route GET /api/user/prefs/digest UserAPI idGetUserDigestPrefs
+// Handle GET for the digest preferences of the user
+//
+// Returns the address the digest of the performance results and events is emailed to and how often
+// responses:
+// 	200: userDigestPrefsRespWrapper
+
+// swagger:route POST /api/user/prefs/digest UserAPI idPostUserDigestPrefs
+// Handle POST for the digest preferences of the user
+//
+// Updates the address the digest is emailed to and how often, daily, weekly or off
+// responses:
+// 	200: userDigestPrefsRespWrapper
+
+// UserDigestPreferencesHandler is used to subscribe the user to the digest
+func (h *Handler) UserDigestPreferencesHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	if req.Method == http.MethodPost {
+		defer func() {
+			_ = req.Body.Close()
+		}()
+
+		subscription := &models.DigestSubscription{}
+		if err := json.NewDecoder(req.Body).Decode(subscription); err != nil {
+			h.log.Error(ErrRequestBody(err))
+			http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+			return
+		}
+		if err := subscription.Validate(); err != nil {
+			h.log.Error(ErrDigestPreferences(err))
+			http.Error(w, ErrDigestPreferences(err).Error(), http.StatusBadRequest)
+			return
+		}
+		if subscription.Frequency != models.DigestOff && h.config.DigestSender == nil {
+			h.log.Error(ErrDigestPreferences(errDigestNotConfigured))
+			http.Error(w, ErrDigestPreferences(errDigestNotConfigured).Error(), http.StatusBadRequest)
+			return
+		}
+		subscription.UserID = user.UserID
+		if err := h.config.DigestSubscriptionPersister.SaveSubscription(subscription); err != nil {
+			h.log.Error(ErrDigestPreferences(err))
+			http.Error(w, ErrDigestPreferences(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	subscription, err := h.config.DigestSubscriptionPersister.GetSubscription(user.UserID)
+	if err != nil {
+		h.log.Error(ErrDigestPreferences(err))
+		http.Error(w, ErrDigestPreferences(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeApplicationJSON(w, subscription, "digest preferences")
+}
+
+// swagger:route POST /api/user/prefs/digest/send UserAPI idSendUserDigest
+// Handle POST request to send the digest now
+//
+// Emails the digest of the period of the subscription of the user right away, which then starts the next period
+// responses:
+// 	200: userDigestRespWrapper
+
+// SendDigestHandler emails the digest to the user right away
+func (h *Handler) SendDigestHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	if h.config.DigestSender == nil {
+		h.log.Error(ErrDigestPreferences(errDigestNotConfigured))
+		http.Error(w, ErrDigestPreferences(errDigestNotConfigured).Error(), http.StatusBadRequest)
+		return
+	}
+	subscription, err := h.config.DigestSubscriptionPersister.GetSubscription(user.UserID)
+	if err != nil {
+		h.log.Error(ErrDigestPreferences(err))
+		http.Error(w, ErrDigestPreferences(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if subscription.Frequency == models.DigestOff {
+		err := fmt.Errorf("the digest is off, subscribe to it first")
+		h.log.Error(ErrDigestPreferences(err))
+		http.Error(w, ErrDigestPreferences(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	digest, err := h.config.DigestSender.SendDigest(subscription, time.Now())
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeApplicationJSON(w, digest, "digest")
+}
@@ -37,6 +37,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshery/models/pattern/core"
 	SMP "github.com/layer5io/service-mesh-performance/spec"
 	v1 "k8s.io/api/core/v1"
 )
@@ -55,11 +56,349 @@ type mesheryPatternResponseWrapper struct {
 	Body models.MesheryPattern
 }
 
+// Returns the users and teams a pattern is shared with
+// swagger:response patternSharesResponseWrapper
+type patternSharesResponseWrapper struct {
+	// in: body
+	Body []models.PatternShare
+}
+
+// Returns a single share of a pattern
+// swagger:response patternShareResponseWrapper
+type patternShareResponseWrapper struct {
+	// in: body
+	Body models.PatternShare
+}
+
+// Returns the reviews of a pattern
+// swagger:response patternReviewsResponseWrapper
+type patternReviewsResponseWrapper struct {
+	// in: body
+	Body models.PatternReviewsAPIResponse
+}
+
+// Returns a single review of a pattern
+// swagger:response patternReviewResponseWrapper
+type patternReviewResponseWrapper struct {
+	// in: body
+	Body models.PatternReview
+}
+
+// Returns the environments designs are deployed to
+// swagger:response environmentsResponseWrapper
+type environmentsResponseWrapper struct {
+	// in: body
+	Body []models.DeploymentEnvironment
+}
+
+// Returns a single environment
+// swagger:response environmentResponseWrapper
+type environmentResponseWrapper struct {
+	// in: body
+	Body models.DeploymentEnvironment
+}
+
+// Returns the deployment of a pattern to an environment
+// swagger:response patternDeploymentResponseWrapper
+type patternDeploymentResponseWrapper struct {
+	// in: body
+	Body models.PatternDeploymentAPIResponse
+}
+
+// Returns the workload identities of the user
+// swagger:response workloadIdentitiesResponseWrapper
+type workloadIdentitiesResponseWrapper struct {
+	// in: body
+	Body []models.WorkloadIdentity
+}
+
+// Returns a single workload identity
+// swagger:response workloadIdentityResponseWrapper
+type workloadIdentityResponseWrapper struct {
+	// in: body
+	Body models.WorkloadIdentity
+}
+
+// Returns the performance results found to be anomalies
+// swagger:response resultAnomaliesResponseWrapper
+type resultAnomaliesResponseWrapper struct {
+	// in: body
+	Body []models.ResultAnalysis
+}
+
+// Returns the checkpoints of a performance test
+// swagger:response loadTestCheckpointsResponseWrapper
+type loadTestCheckpointsResponseWrapper struct {
+	// in: body
+	Body []models.LoadTestCheckpoint
+}
+
+// Returns the performance test targets deployed by Meshery
+// swagger:response perfTargetsResponseWrapper
+type perfTargetsResponseWrapper struct {
+	// in: body
+	Body []models.PerfTarget
+}
+
+// Returns the deployed performance test target and whether it is ready
+// swagger:response perfTargetResponseWrapper
+type perfTargetResponseWrapper struct {
+	// in: body
+	Body models.PerfTargetAPIResponse
+}
+
+// Returns the service mesh configuration applied to a performance test target
+// swagger:response perfTargetMeshResponseWrapper
+type perfTargetMeshResponseWrapper struct {
+	// in: body
+	Body models.PerfTargetMeshConfigurationResult
+}
+
+// Returns the result hooks of the user
+// swagger:response resultHooksResponseWrapper
+type resultHooksResponseWrapper struct {
+	// in: body
+	Body []models.ResultHook
+}
+
+// Returns the saved result hook
+// swagger:response resultHookResponseWrapper
+type resultHookResponseWrapper struct {
+	// in: body
+	Body models.ResultHook
+}
+
+// Returns the annotations a result hook attached to a result
+// swagger:response resultAnnotationsResponseWrapper
+type resultAnnotationsResponseWrapper struct {
+	// in: body
+	Body models.ResultAnnotations
+}
+
+// Returns the validation webhooks of the external policy engines
+// swagger:response validationWebhooksResponseWrapper
+type validationWebhooksResponseWrapper struct {
+	// in: body
+	Body []models.ValidationWebhook
+}
+
+// Returns the saved validation webhook
+// swagger:response validationWebhookResponseWrapper
+type validationWebhookResponseWrapper struct {
+	// in: body
+	Body models.ValidationWebhook
+}
+
+// Returns the snapshots of Meshery UI of the user
+// swagger:response dashboardSnapshotsResponseWrapper
+type dashboardSnapshotsResponseWrapper struct {
+	// in: body
+	Body []models.DashboardSnapshot
+}
+
+// Returns a snapshot of Meshery UI
+// swagger:response dashboardSnapshotResponseWrapper
+type dashboardSnapshotResponseWrapper struct {
+	// in: body
+	Body models.DashboardSnapshot
+}
+
+// Returns the trend report of a performance profile
+// swagger:response trendReportResponseWrapper
+type trendReportResponseWrapper struct {
+	// in: body
+	Body models.TrendReport
+}
+
+// Returns the usage of the quotas of the workspace of the user
+// swagger:response workspaceUsageResponseWrapper
+type workspaceUsageResponseWrapper struct {
+	// in: body
+	Body models.WorkspaceUsageReport
+}
+
+// Returns the load test limits of a workspace
+// swagger:response workspaceLoadTestLimitsResponseWrapper
+type workspaceLoadTestLimitsResponseWrapper struct {
+	// in: body
+	Body models.WorkspaceLoadTestLimitsReport
+}
+
+// Returns the version of the schema of the database and its migrations
+// swagger:response databaseSchemaResponseWrapper
+type databaseSchemaResponseWrapper struct {
+	// in: body
+	Body models.SchemaStatus
+}
+
+// Returns the migrations of the schema of the database run and the version of the schema
+// swagger:response databaseSchemaMigrationResponseWrapper
+type databaseSchemaMigrationResponseWrapper struct {
+	// in: body
+	Body models.SchemaMigrationResult
+}
+
+// Returns the background jobs of Meshery Server
+// swagger:response jobsResponseWrapper
+type jobsResponseWrapper struct {
+	// in: body
+	Body models.JobsAPIResponse
+}
+
+// swagger:parameters idGetJobs
+type jobsParamsWrapper struct {
+	// Only list the jobs of the kind: meshsync-resync, performance-test, design-deployment,
+	// design-schedule, result-archive or trash-purge
+	// in: query
+	Kind string `json:"kind"`
+	// Only list the jobs of the status: running, cancelling, succeeded, failed or cancelled
+	// in: query
+	Status string `json:"status"`
+	// Only list the jobs run for the user
+	// in: query
+	Mine bool `json:"mine"`
+}
+
+// Returns a background job of Meshery Server
+// swagger:response jobResponseWrapper
+type jobResponseWrapper struct {
+	// in: body
+	Body models.Job
+}
+
+// swagger:parameters idGetJob idPostCancelJob
+type jobParamsWrapper struct {
+	// in: path
+	// required: true
+	ID string `json:"id"`
+}
+
+// Returns the deployments of a pattern to environments
+// swagger:response patternDeploymentsResponseWrapper
+type patternDeploymentsResponseWrapper struct {
+	// in: body
+	Body []models.PatternDeployment
+}
+
+// Returns the deprecated fields used by the components of a pattern and the upgraded pattern
+// swagger:response patternUpgradeCheckResponseWrapper
+type patternUpgradeCheckResponseWrapper struct {
+	// in: body
+	Body core.PatternUpgradeCheck
+}
+
+// Returns the image of the topology of a pattern, in SVG or PNG format
+// swagger:response patternSnapshotResponseWrapper
+type patternSnapshotResponseWrapper struct {
+	// in: body
+	Body []byte
+}
+
+// swagger:parameters idGetPatternSnapshot
+type patternSnapshotParamsWrapper struct {
+	// Format of the image, svg or png
+	// in: query
+	Format string `json:"format"`
+}
+
+// Returns the scheduled deployments and undeployments of designs
+// swagger:response designSchedulesResponseWrapper
+type designSchedulesResponseWrapper struct {
+	// in: body
+	Body []models.DesignSchedule
+}
+
+// Returns a scheduled deployment or undeployment of a design
+// swagger:response designScheduleResponseWrapper
+type designScheduleResponseWrapper struct {
+	// in: body
+	Body models.DesignSchedule
+}
+
+// Parameters for scheduling the deployment or undeployment of a design
+// swagger:parameters idPostPatternSchedule
+type designScheduleParamsWrapper struct {
+	// in: body
+	Body models.DesignSchedule
+}
+
 // swagger:response noContentWrapper
 type noContentWrapper struct {
 }
 
-// swagger:parameters idGetMesheryPattern idDeleteMesheryPattern idGetSinglePerformanceProfile idDeletePerformanceProfile idGETProfileResults idDeleteSchedules idGetSingleSchedule idDeleteMesheryApplicationFile idGetMesheryApplication idDeleteMesheryFilter idGetMesheryFilter
+// Returns the number of designs, performance profiles and results transferred
+// swagger:response ownershipTransferResponseWrapper
+type ownershipTransferResponseWrapper struct {
+	// in: body
+	Body models.OwnershipTransferResult
+}
+
+// Parameters for transferring the ownership of artifacts to another user
+// swagger:parameters idPostOwnershipTransfer
+type ownershipTransferParamsWrapper struct {
+	// in: body
+	Body models.OwnershipTransfer
+}
+
+// Returns the deleted designs and performance profiles which can still be restored
+// swagger:response trashResponseWrapper
+type trashResponseWrapper struct {
+	// in: body
+	Body models.TrashPage
+}
+
+// Returns the design or performance profile restored from the trash
+// swagger:response trashItemResponseWrapper
+type trashItemResponseWrapper struct {
+	// in: body
+	Body models.TrashItem
+}
+
+// swagger:parameters idGetTrash
+type trashParamsWrapper struct {
+	// Only list the items of the kind, pattern or profile
+	// in: query
+	Kind string `json:"kind"`
+}
+
+// Returns the entries logged by Meshery Server after the cursor
+// swagger:response serverDebugResponseWrapper
+type serverDebugResponseWrapper struct {
+	// in: body
+	Body models.DebugLog
+}
+
+// swagger:parameters idGetServerDebug
+type serverDebugParamsWrapper struct {
+	// Cursor returned with the entries pulled previously
+	// in: query
+	After uint64 `json:"after"`
+	// Time in RFC 3339 format to return the entries logged at or after, when no cursor is given
+	// in: query
+	Since string `json:"since"`
+}
+
+// Returns the data Meshery sends and whether the user enabled it
+// swagger:response telemetryReportResponseWrapper
+type telemetryReportResponseWrapper struct {
+	// in: body
+	Body models.TelemetryReport
+}
+
+// swagger:parameters idPostTelemetry
+type telemetryParamsWrapper struct {
+	// in: body
+	Body models.TelemetryPreferences
+}
+
+// swagger:parameters idGetPatternFiles idGetPerformanceProfiles idGETProfileResults idGetAllPerformanceResults idGetAllPerfResults
+type ownerParamsWrapper struct {
+	// Only list the artifacts owned by the user of the given ID
+	// in: query
+	Owner string `json:"owner"`
+}
+
+// swagger:parameters idGetMesheryPattern idDeleteMesheryPattern idGetSinglePerformanceProfile idDeletePerformanceProfile idGETProfileResults idDeleteSchedules idGetSingleSchedule idDeleteMesheryApplicationFile idGetMesheryApplication idDeleteMesheryFilter idGetMesheryFilter idGetPatternShares idPostPatternShare idGetPatternReviews idPostPatternReview idPutPatternReview idGetPatternUpgrade idPostPatternUpgrade idGetPatternSnapshot idGetPatternSchedules idPostPatternSchedule idDeleteDesignSchedule idPostRestorePattern idPostRestorePerformanceProfile
 type IDParameterWrapper struct {
 	// id for a specific
 	// in: path
@@ -88,6 +427,13 @@ type performanceProfileResponseWrapper struct {
 	Body models.PerformanceProfile
 }
 
+// Returns the aggregates of the results of a performance profile in the OpenMetrics text format
+// swagger:response performanceProfileMetricsResponseWrapper
+type performanceProfileMetricsResponseWrapper struct {
+	// in: body
+	Body string
+}
+
 // Save a performance profile
 // swagger:parameters idSavePerformanceProfile
 type performanceProfileParameterWrapper struct {
@@ -142,6 +488,8 @@ type v1ServicesMapResponseWrapper struct {
 type prometheusConfigParamsWrapper struct {
 	// in: body
 	PrometheusURL string `json:"prometheusURL,omitempty"`
+	// Name of the connection, the default connection is set when empty
+	Name string `json:"name,omitempty"`
 }
 
 // Returns prometheus configuration
@@ -151,6 +499,13 @@ type prometheusConfigResponseWrapper struct {
 	Body *models.Prometheus
 }
 
+// Returns the Prometheus connections by name
+// swagger:response prometheusConnectionsRespWrapper
+type prometheusConnectionsRespWrapper struct {
+	// in: body
+	Body map[string]string
+}
+
 // Response for prometheus board import
 // swagger:response prometheusBoardImportRespWrapper
 type prometheusBoardImportRespWrapper struct {
@@ -173,6 +528,61 @@ type prometheusBoardParamsWrapper struct {
 	Body []*models.SelectedGrafanaConfig
 }
 
+// Parameters for persisting Datadog configuration
+// swagger:parameters idPostDatadogConfig
+type datadogConfigParamsWrapper struct {
+	// in: formData
+	Site string `json:"site,omitempty"`
+	// in: formData
+	// required: true
+	APIKey string `json:"apiKey"`
+	// in: formData
+	ApplicationKey string `json:"applicationKey,omitempty"`
+}
+
+// Returns Datadog configuration
+// swagger:response datadogConfigResponseWrapper
+type datadogConfigResponseWrapper struct {
+	// in: body
+	Body *models.Datadog
+}
+
+// Parameters for persisting New Relic configuration
+// swagger:parameters idPostNewRelicConfig
+type newRelicConfigParamsWrapper struct {
+	// in: formData
+	Region string `json:"region,omitempty"`
+	// in: formData
+	// required: true
+	AccountID string `json:"accountID"`
+	// in: formData
+	// required: true
+	APIKey string `json:"apiKey"`
+}
+
+// Returns New Relic configuration
+// swagger:response newRelicConfigResponseWrapper
+type newRelicConfigResponseWrapper struct {
+	// in: body
+	Body *models.NewRelic
+}
+
+// Parameters for Datadog and New Relic queries
+// swagger:parameters idGetDatadogQuery idGetNewRelicQuery
+type metricsQueryParamsWrapper struct {
+	// in: query
+	Query string `json:"query"`
+	// unix timestamp of the start of the query range
+	// in: query
+	Start int64 `json:"start,omitempty"`
+	// unix timestamp of the end of the query range
+	// in: query
+	End int64 `json:"end,omitempty"`
+	// performance test the query is correlated with
+	// in: query
+	UUID string `json:"uuid,omitempty"`
+}
+
 // Returns User Load Test Preferencee
 // swagger:response userLoadTestPrefsRespWrapper
 type userLoadTestPrefsRespWrapper struct {
@@ -180,6 +590,20 @@ type userLoadTestPrefsRespWrapper struct {
 	Body *models.Preference
 }
 
+// Returns the mesheryctl preferences of the user
+// swagger:response userCLIPrefsRespWrapper
+type userCLIPrefsRespWrapper struct {
+	// in: body
+	Body *models.CLIPreferences
+}
+
+// Updates the mesheryctl preferences of the user
+// swagger:parameters idPostUserCLIPrefs
+type userCLIPrefsParamsWrapper struct {
+	// in: body
+	Body *models.CLIPreferences
+}
+
 // Updates Anonymous stats
 // swagger:parameters idPostAnonymousStats
 type anonymousStatsParamsWrapper struct {
@@ -225,6 +649,14 @@ type perfSingleResultRespWrapper struct {
 	Body *models.PerformanceSpec
 }
 
+// swagger:parameters idGetSinglePerfResult
+type perfSingleResultParamsWrapper struct {
+	// Set to json to get the result as stored, including the configuration of the run,
+	// or to hgrm to get the latency histogram as HdrHistogram percentile distribution
+	// in: query
+	Output string `json:"output"`
+}
+
 // Returns Perf test preference
 // swagger:response perfTestPrefsRespWrapper
 type perfTestPrefsRespWrapper struct {
@@ -253,7 +685,47 @@ type systemAdaptersRespWrapper struct {
 	Body []models.Adapter
 }
 
-// swagger:parameters idDeleteAdapterConfig idGetSystemAdapters
+// Returns the availability of all the adapters
+// swagger:response systemAdaptersHealthRespWrapper
+type systemAdaptersHealthRespWrapper struct {
+	// in: body
+	Body []models.AdapterHealth
+}
+
+// Returns the ID of the operation submitted to an adapter
+// swagger:response adapterOperationRespWrapper
+type adapterOperationRespWrapper struct {
+	// in: body
+	Body models.AdapterOperationResponse
+}
+
+// Returns the service meshes running in the cluster
+// swagger:response systemMeshesRespWrapper
+type systemMeshesRespWrapper struct {
+	// in: body
+	Body []*models.MeshStatus
+}
+
+// Returns the changes made to enable or disable the injection of the proxy of a mesh
+// swagger:response meshInjectionRespWrapper
+type meshInjectionRespWrapper struct {
+	// in: body
+	Body *models.MeshInjectionResult
+}
+
+// Parameters for enabling or disabling the injection of the proxy of a mesh
+// swagger:parameters idPostMeshInjection
+type meshInjectionParamsWrapper struct {
+	// in: path
+	// required: true
+	// enum: istio,linkerd
+	Mesh string `json:"mesh"`
+	// in: body
+	// required: true
+	Body *models.MeshInjectionRequest
+}
+
+// swagger:parameters idDeleteAdapterConfig idGetSystemAdapters idDeleteAdapterRegister
 type adapterParamsWrapper struct {
 	// in: query
 	Adapter string `json:"adapter"`
@@ -273,6 +745,36 @@ type k8sContextsRespWrapper struct {
 	// Body []*models.K8SContext
 }
 
+// Returns the Gateway API resources discovered by MeshSync along with their issues and conflicts
+// swagger:response gatewayAPIResourcesRespWrapper
+type gatewayAPIResourcesRespWrapper struct {
+	// in: body
+	Body models.GatewayAPIResources
+}
+
+// Parameters for the Gateway API resources
+// swagger:parameters idGetGatewayAPIResources
+type gatewayAPIResourcesParamsWrapper struct {
+	// Name of the Kubernetes context of the cluster to get the resources of, every cluster by default
+	// in: query
+	Context string `json:"context"`
+}
+
+// Returns the health of the kubernetes contexts
+// swagger:response k8sContextsHealthRespWrapper
+type k8sContextsHealthRespWrapper struct {
+	// in: body
+	Body []models.ClusterHealth
+}
+
+// Parameters for checking the health of a kubernetes context
+// swagger:parameters idGetK8sContextsHealth
+type k8sContextsHealthParamsWrapper struct {
+	// ID or name of the kubernetes context, every context by default
+	// in: query
+	Context string `json:"context"`
+}
+
 // Parameters for updating provider choice
 // swagger:parameters idChoiceProvider
 type mesheryProviderParamsWrapper struct {
@@ -317,6 +819,40 @@ type applicationFileParamsWrapper struct {
 	FormFile *bytes.Buffer `json:"Upload Yaml/Yml File"`
 }
 
+// Parameters for deploying a pattern
+// swagger:parameters idPostDeployPattern
+type patternDeployParamsWrapper struct {
+	// service account, as namespace/name, impersonated while deploying the pattern
+	// in: query
+	ServiceAccount string `json:"serviceAccount"`
+	// roll back the components applied so far when the pattern fails to apply
+	// in: query
+	Transactional bool `json:"transactional"`
+	// wait for the applied components to become ready
+	// in: query
+	Wait bool `json:"wait"`
+	// how long to wait for the components to become ready, 5m by default
+	// in: query
+	Timeout string `json:"timeout"`
+	// comma separated list of the components to deploy, all of them by default
+	// in: query
+	Components string `json:"components"`
+}
+
+// Parameters for undeploying a pattern
+// swagger:parameters idDeleteDeployPattern
+type patternUndeployParamsWrapper struct {
+	// only delete the components Meshery has a record of deploying
+	// in: query
+	RecordedOnly bool `json:"recordedOnly"`
+	// leave the namespaces of the pattern in place
+	// in: query
+	KeepNamespace bool `json:"keepNamespace"`
+	// comma separated list of the components to undeploy, all of them by default
+	// in: query
+	Components string `json:"components"`
+}
+
 // Fetches a single Meshery Application
 // swagger:response mesheryApplicationResponseWrapper
 type mesheryApplicationResponseWrapper struct {
@@ -324,6 +860,82 @@ type mesheryApplicationResponseWrapper struct {
 	Body models.MesheryApplication
 }
 
+// Returns the readiness of the workloads of an application
+// swagger:response applicationStatusResponseWrapper
+type applicationStatusResponseWrapper struct {
+	// in: body
+	Body models.ApplicationStatus
+}
+
+// Returns the digest preferences of the user
+// swagger:response userDigestPrefsRespWrapper
+type userDigestPrefsRespWrapper struct {
+	// in: body
+	Body models.DigestSubscription
+}
+
+// Parameters for updating the digest preferences of the user
+// swagger:parameters idPostUserDigestPrefs
+type userDigestPrefsParamsWrapper struct {
+	// in: body
+	Body models.DigestSubscription
+}
+
+// Returns the digest sent to the user
+// swagger:response userDigestRespWrapper
+type userDigestRespWrapper struct {
+	// in: body
+	Body models.Digest
+}
+
+// Returns the events along with their state for the user
+// swagger:response eventsResponseWrapper
+type eventsResponseWrapper struct {
+	// in: body
+	Body []models.UserEvent
+}
+
+// Parameters for filtering the events
+// swagger:parameters idGetEvents
+type eventsParamsWrapper struct {
+	// Severities of the events, info, warning or error, comma separated
+	// in: query
+	Severity string `json:"severity"`
+	// Categories of the events, adapter, health, performance, design, config or kubernetes, comma separated
+	// in: query
+	Category string `json:"category"`
+	// in: query
+	Acknowledged bool `json:"acknowledged"`
+	// in: query
+	Resolved bool `json:"resolved"`
+	// Time in RFC 3339 format of the oldest event
+	// in: query
+	Since string `json:"since"`
+	// in: query
+	Limit int `json:"limit"`
+}
+
+// Parameters for acknowledging or resolving events
+// swagger:parameters idAcknowledgeEvents idResolveEvents
+type eventStateParamsWrapper struct {
+	// in: body
+	Body models.EventStateRequest
+}
+
+// Returns the number of events whose state changed
+// swagger:response eventStateResponseWrapper
+type eventStateResponseWrapper struct {
+	// in: body
+	Body models.EventStateResult
+}
+
+// Returns the workloads of an application changed by an operation
+// swagger:response applicationOperationResponseWrapper
+type applicationOperationResponseWrapper struct {
+	// in: body
+	Body models.ApplicationOperationResult
+}
+
 // Returns all meshery applications
 // swagger:response mesheryApplicationsResponseWrapper
 type mesheryApplicationsResponseWrapper struct {
@@ -345,6 +957,14 @@ type mesheryAdapterParamsWrapper struct {
 	MeshLocationURL string `json:"meshLocationURL"`
 }
 
+// Parameters for registering an adapter at runtime
+// swagger:parameters idPostAdapterRegister
+type adapterRegistrationParamsWrapper struct {
+	// in: body
+	// required: true
+	Body *models.AdapterRegistration
+}
+
 // Parameters for meshery operations
 // swagger:parameters idPostAdapterOperation
 type adapterOpsParamsWrapper struct {
@@ -375,3 +995,160 @@ type filterFilesResponseWrapper struct {
 	// in: body
 	Body *models.MesheryFilter
 }
+
+// Returns the configuration of Meshery Server which can be changed while it runs
+// swagger:response serverConfigResponseWrapper
+type serverConfigResponseWrapper struct {
+	// in: body
+	Body models.ServerConfig
+}
+
+// Returns the configuration of Meshery Server once reloaded along with the settings which changed
+// swagger:response serverConfigReloadResponseWrapper
+type serverConfigReloadResponseWrapper struct {
+	// in: body
+	Body models.ServerConfigReload
+}
+
+// swagger:parameters idPostServerConfigReload
+type serverConfigReloadParamsWrapper struct {
+	// Settings to change, those left out being kept or read again from the configuration file
+	// in: body
+	Body models.ServerConfig
+}
+
+// Returns the feature flags of Meshery Server as in effect for the user
+// swagger:response featureFlagsResponseWrapper
+type featureFlagsResponseWrapper struct {
+	// in: body
+	Body []models.FeatureFlagState
+}
+
+// Returns the rate limit of a Kubernetes connection along with the requests it held
+// swagger:response k8sRateLimitResponseWrapper
+type k8sRateLimitResponseWrapper struct {
+	// in: body
+	Body models.K8sRateLimitStatus
+}
+
+// swagger:parameters idGetK8sRateLimit
+type k8sRateLimitParamsWrapper struct {
+	// ID of the Kubernetes connection
+	// in: path
+	// required: true
+	ID string `json:"id"`
+}
+
+// swagger:parameters idPostK8sRateLimit
+type k8sRateLimitPostParamsWrapper struct {
+	// ID of the Kubernetes connection
+	// in: path
+	// required: true
+	ID string `json:"id"`
+	// in: body
+	Body models.K8sRateLimit
+}
+
+// swagger:parameters idPostFeatureFlag
+type featureFlagParamsWrapper struct {
+	// Name of the feature flag
+	// in: path
+	// required: true
+	Name string `json:"name"`
+	// in: body
+	Body models.FeatureFlagRequest
+}
+
+// Returns the resources of a Kubernetes context left behind by the designs since deleted
+// swagger:response designGCResponseWrapper
+type designGCResponseWrapper struct {
+	// in: body
+	Body models.DesignGCResult
+}
+
+// swagger:parameters idGetDesignGC idDeleteDesignGC
+type designGCParamsWrapper struct {
+	// Name or ID of the Kubernetes context, the current one if not given
+	// in: query
+	Cluster string `json:"cluster"`
+}
+
+// Returns the Kubernetes events of the resources of a design and of their pods
+// swagger:response designEventsResponseWrapper
+type designEventsResponseWrapper struct {
+	// in: body
+	Body []models.DesignEvent
+}
+
+// swagger:parameters idGetPatternLogs idGetPatternEvents
+type designLogsParamsWrapper struct {
+	// in: path
+	// required: true
+	ID string `json:"id"`
+	// Name of the component to restrict to, every Kubernetes component of the design if not given
+	// in: query
+	Component string `json:"component"`
+}
+
+// swagger:parameters idGetPatternLogs
+type designLogsQueryParamsWrapper struct {
+	// Keep streaming the logs as they are written
+	// in: query
+	Follow bool `json:"follow"`
+	// Number of lines from the end of the logs of each container to start from
+	// in: query
+	Tail int64 `json:"tail"`
+	// Name of the container to restrict to
+	// in: query
+	Container string `json:"container"`
+}
+
+// Returns the pod port the connections to a component of a design are forwarded to
+// swagger:response designPortForwardResponseWrapper
+type designPortForwardResponseWrapper struct {
+	// in: body
+	Body models.DesignPortForward
+}
+
+// swagger:parameters idGetPatternPortForward
+type designPortForwardParamsWrapper struct {
+	// in: path
+	// required: true
+	ID string `json:"id"`
+	// Name of the component to forward the connections to
+	// in: query
+	// required: true
+	Component string `json:"component"`
+	// Port of the Service of the component, or of the containers of its pods, by number or name
+	// in: query
+	// required: true
+	Port string `json:"port"`
+}
+
+// Returns the container of a component of a design the commands are run in
+// swagger:response designExecResponseWrapper
+type designExecResponseWrapper struct {
+	// in: body
+	Body models.DesignExec
+}
+
+// swagger:parameters idGetPatternExec
+type designExecParamsWrapper struct {
+	// in: path
+	// required: true
+	ID string `json:"id"`
+	// Name of the component to run the command in
+	// in: query
+	// required: true
+	Component string `json:"component"`
+	// Name of the container to run the command in, the default container of the pod if not given
+	// in: query
+	Container string `json:"container"`
+	// Command to run along with its arguments, given once for each
+	// in: query
+	// required: true
+	Command []string `json:"command"`
+	// Allocate a TTY for the command
+	// in: query
+	TTY bool `json:"tty"`
+}
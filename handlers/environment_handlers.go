@@ -0,0 +1,397 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshery/models/pattern/core"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+)
+
+// swagger:route GET /api/environments EnvironmentsAPI idGetEnvironments
+// Handle GET request for the environments
+//
+// Returns the environments designs are deployed to and promoted through
+// responses:
+// 	200: environmentsResponseWrapper
+
+// swagger:route POST /api/environments EnvironmentsAPI idPostEnvironment
+// Handle POST request to save an environment
+//
+// Creates the environment, or updates the environment with the same name
+// responses:
+// 	200: environmentResponseWrapper
+
+// EnvironmentsHandler handles the requests to list and save environments
+func (h *Handler) EnvironmentsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if r.Method == http.MethodGet {
+		envs, err := h.config.EnvironmentPersister.GetEnvironments()
+		if err != nil {
+			h.log.Error(ErrEnvironment(err))
+			http.Error(rw, ErrEnvironment(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		h.writeEnvironmentJSON(rw, envs, "environments")
+		return
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	env := &models.DeploymentEnvironment{}
+	if err := json.NewDecoder(r.Body).Decode(env); err != nil {
+		obj := "environment"
+		h.log.Error(ErrDecoding(err, obj))
+		http.Error(rw, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := env.Validate(); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	if _, err := provider.GetK8sContext(token, env.K8sContextID); err != nil {
+		h.log.Error(ErrEnvironment(err))
+		http.Error(rw, ErrEnvironment(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.config.EnvironmentPersister.SaveEnvironment(env); err != nil {
+		h.log.Error(ErrEnvironment(err))
+		http.Error(rw, ErrEnvironment(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeEnvironmentJSON(rw, env, "environment")
+}
+
+// swagger:route DELETE /api/environments/{name} EnvironmentsAPI idDeleteEnvironment
+// Handle DELETE request for an environment
+//
+// Deletes the environment, the records of the deployments made to it are kept
+// responses:
+// 	200:
+
+// DeleteEnvironmentHandler deletes the environment with the given name
+func (h *Handler) DeleteEnvironmentHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	name := mux.Vars(r)["name"]
+	if _, err := h.config.EnvironmentPersister.GetEnvironment(name); err != nil {
+		h.log.Error(ErrEnvironment(err))
+		http.Error(rw, ErrEnvironment(err).Error(), http.StatusNotFound)
+		return
+	}
+	if err := h.config.EnvironmentPersister.DeleteEnvironment(name); err != nil {
+		h.log.Error(ErrEnvironment(err))
+		http.Error(rw, ErrEnvironment(err).Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// swagger:route POST /api/pattern/{id}/environments/{environment} PatternsAPI idPostPatternEnvironmentDeploy
+// Handle POST request to deploy a saved pattern to an environment
+//
+// Deploys the pattern to the Kubernetes context of the environment and records the deployment
+// responses:
+// 	200: patternDeploymentResponseWrapper
+
+// PatternEnvironmentDeployHandler deploys a saved pattern to an environment
+func (h *Handler) PatternEnvironmentDeployHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	env, err := h.config.EnvironmentPersister.GetEnvironment(mux.Vars(r)["environment"])
+	if err != nil {
+		h.log.Error(ErrEnvironment(err))
+		http.Error(rw, ErrEnvironment(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	pattern, err := getSavedPattern(r, provider, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	msg, err := h.deployPatternToEnvironment(r, provider, prefObj, user, pattern, env, h.patternDeployOptionsFromRequest(r, user.UserID))
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	deployment := models.PatternDeployment{
+		PatternID:   pattern.ID,
+		PatternName: pattern.Name,
+		Environment: env.Name,
+		PatternSHA:  models.PatternFileSHA(pattern.PatternFile),
+		DeployedBy:  user.UserID,
+	}
+	h.recordPatternDeployment(rw, deployment, msg)
+}
+
+// swagger:route POST /api/pattern/{id}/promote PatternsAPI idPostPatternPromote
+// Handle POST request to promote a pattern to an environment
+//
+// Deploys the pattern to the target environment once it runs in the environment it is
+// promoted from and has the approvals required by the target environment
+// responses:
+// 	200: patternDeploymentResponseWrapper
+
+// PatternPromotionHandler promotes a saved pattern from an environment to another
+func (h *Handler) PatternPromotionHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	promotion := models.PatternPromotionRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&promotion); err != nil {
+		obj := "pattern promotion"
+		h.log.Error(ErrDecoding(err, obj))
+		http.Error(rw, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+		return
+	}
+
+	target, err := h.config.EnvironmentPersister.GetEnvironment(promotion.To)
+	if err != nil {
+		h.log.Error(ErrEnvironment(err))
+		http.Error(rw, ErrEnvironment(err).Error(), http.StatusNotFound)
+		return
+	}
+	from := promotion.From
+	if from == "" {
+		from = target.PromotesFrom
+	}
+	if from == "" || from == target.Name {
+		err := ErrPatternPromotion(fmt.Errorf("environment to promote the design to %s from is not given", target.Name))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pattern, err := getSavedPattern(r, provider, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	source, err := h.config.EnvironmentPersister.GetLatestPatternDeployment(*pattern.ID, from)
+	if err != nil {
+		err := ErrPatternPromotion(fmt.Errorf("design %s has not been deployed to %s", pattern.Name, from))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusConflict)
+		return
+	}
+	sha := models.PatternFileSHA(pattern.PatternFile)
+	if source.PatternSHA != sha {
+		err := ErrPatternPromotion(fmt.Errorf("design %s changed since it was deployed to %s", pattern.Name, from))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusConflict)
+		return
+	}
+
+	resp, err := provider.GetPatternReviews(r, pattern.ID.String())
+	if err != nil {
+		h.log.Error(ErrPatternReview(err))
+		http.Error(rw, ErrPatternReview(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	reviews := []models.PatternReview{}
+	if err := json.Unmarshal(resp, &reviews); err != nil {
+		obj := "pattern reviews"
+		h.log.Error(ErrUnmarshal(err, obj))
+		http.Error(rw, ErrUnmarshal(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
+	approvers, err := promotionApprovers(reviews, target.RequiredApprovals, user.UserID, sha)
+	if err != nil {
+		err := ErrPatternPromotion(fmt.Errorf("design %s cannot be promoted to %s: %s", pattern.Name, target.Name, err))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusConflict)
+		return
+	}
+
+	msg, err := h.deployPatternToEnvironment(r, provider, prefObj, user, pattern, target, h.patternDeployOptionsFromRequest(r, user.UserID))
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	deployment := models.PatternDeployment{
+		PatternID:         pattern.ID,
+		PatternName:       pattern.Name,
+		Environment:       target.Name,
+		PatternSHA:        sha,
+		DeployedBy:        user.UserID,
+		PromotedFrom:      source.ID,
+		SourceEnvironment: from,
+		ApprovedBy:        strings.Join(approvers, ","),
+	}
+	h.recordPatternDeployment(rw, deployment, msg)
+}
+
+// swagger:route GET /api/pattern/{id}/deployments PatternsAPI idGetPatternDeployments
+// Handle GET request for the deployments of a pattern to environments
+//
+// Returns the provenance of the pattern: its deployments and promotions, the latest first
+// responses:
+// 	200: patternDeploymentsResponseWrapper
+
+// PatternDeploymentsHandler returns the deployments of a saved pattern to environments
+func (h *Handler) PatternDeploymentsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	pattern, err := getSavedPattern(r, provider, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	deployments, err := h.config.EnvironmentPersister.GetPatternDeployments(*pattern.ID)
+	if err != nil {
+		h.log.Error(ErrEnvironment(err))
+		http.Error(rw, ErrEnvironment(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeEnvironmentJSON(rw, deployments, "pattern deployments")
+}
+
+// getSavedPattern fetches the saved pattern with the given id from the provider
+func getSavedPattern(r *http.Request, provider models.Provider, patternID string) (*models.MesheryPattern, error) {
+	resp, err := provider.GetMesheryPattern(r, patternID)
+	if err != nil {
+		return nil, err
+	}
+	pattern := &models.MesheryPattern{}
+	if err := json.Unmarshal(resp, pattern); err != nil {
+		return nil, err
+	}
+	if pattern.ID == nil {
+		return nil, fmt.Errorf("pattern %s not found", patternID)
+	}
+	return pattern, nil
+}
+
+// promotionApprovers returns the reviewers who approved the revision of the pattern of the given
+// SHA-256, failing when a reviewer requested changes or when fewer reviewers than required approved
+// it. The approvals of the user promoting the pattern do not count.
+func promotionApprovers(reviews []models.PatternReview, required int, promoter, sha string) ([]string, error) {
+	approvers := []string{}
+	seen := map[string]bool{}
+	for _, review := range reviews {
+		switch review.Status {
+		case models.PatternReviewChangesRequested:
+			return nil, fmt.Errorf("%s requested changes", review.Reviewer)
+		case models.PatternReviewApproved:
+			if review.Reviewer == promoter || review.PatternSHA != sha {
+				continue
+			}
+			if !seen[review.Reviewer] {
+				seen[review.Reviewer] = true
+				approvers = append(approvers, review.Reviewer)
+			}
+		}
+	}
+	if len(approvers) < required {
+		return nil, fmt.Errorf("%d approvals of the current revision by reviewers other than %s are required, got %d", required, promoter, len(approvers))
+	}
+	return approvers, nil
+}
+
+// deployPatternToEnvironment deploys the pattern to the Kubernetes context of the environment
+// rather than to the current context of the user
+func (h *Handler) deployPatternToEnvironment(
+	r *http.Request,
+	provider models.Provider,
+	prefObj *models.Preference,
+	user *models.User,
+	pattern *models.MesheryPattern,
+	env *models.DeploymentEnvironment,
+	opts patternDeployOptions,
+) (string, error) {
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	k8sContext, err := provider.GetK8sContext(token, env.K8sContextID)
+	if err != nil {
+		return "", ErrEnvironment(err)
+	}
+	kubecfg, err := k8sContext.GenerateKubeConfig()
+	if err != nil {
+		return "", ErrEnvironment(err)
+	}
+	kubeClient, err := meshkube.New(kubecfg)
+	if err != nil {
+		return "", ErrEnvironment(err)
+	}
+
+	patternFile, err := core.NewPatternFile([]byte(pattern.PatternFile))
+	if err != nil {
+		return "", ErrPatternFile(err)
+	}
+
+	ctx := context.WithValue(r.Context(), models.KubeContextKey, &k8sContext)
+	ctx = context.WithValue(ctx, models.KubeHanderKey, kubeClient)
+	ctx = context.WithValue(ctx, models.KubeConfigKey, kubecfg)
+
+	if pattern.ID != nil {
+		opts.designID = pattern.ID.String()
+	}
+	opts.validationWebhooks = h.validationWebhooks()
+	msg, err := _processPattern(ctx, provider, patternFile, prefObj, user.UserID, false, false, false, opts)
+	if err != nil {
+		return "", ErrCompConfigPairs(err)
+	}
+	return msg, nil
+}
+
+// recordPatternDeployment persists the provenance record of a deployment and responds with it
+func (h *Handler) recordPatternDeployment(rw http.ResponseWriter, deployment models.PatternDeployment, msg string) {
+	if err := h.config.EnvironmentPersister.SavePatternDeployment(&deployment); err != nil {
+		h.log.Error(ErrEnvironment(err))
+		http.Error(rw, ErrEnvironment(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeEnvironmentJSON(rw, models.PatternDeploymentAPIResponse{Deployment: deployment, Message: msg}, "pattern deployment")
+}
+
+func (h *Handler) writeEnvironmentJSON(rw http.ResponseWriter, v interface{}, obj string) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
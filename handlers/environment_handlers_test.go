@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/models"
+)
+
+func TestPromotionApprovers(t *testing.T) {
+	current := models.PatternFileSHA("name: bookinfo\nreplicas: 2\n")
+	previous := models.PatternFileSHA("name: bookinfo\n")
+	approval := func(reviewer, sha string) models.PatternReview {
+		return models.PatternReview{Reviewer: reviewer, RequestedBy: "alice", Status: models.PatternReviewApproved, PatternSHA: sha}
+	}
+
+	tests := []struct {
+		name     string
+		reviews  []models.PatternReview
+		required int
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "approvals of the current revision",
+			reviews:  []models.PatternReview{approval("bob", current), approval("carol", current), approval("bob", current)},
+			required: 2,
+			want:     []string{"bob", "carol"},
+		},
+		{
+			name:     "approval by the promoter",
+			reviews:  []models.PatternReview{approval("bob", current), approval("mallory", current)},
+			required: 2,
+			wantErr:  true,
+		},
+		{
+			name:     "approval of a previous revision",
+			reviews:  []models.PatternReview{approval("bob", current), approval("carol", previous)},
+			required: 2,
+			wantErr:  true,
+		},
+		{
+			name:     "approval of a previous revision not required",
+			reviews:  []models.PatternReview{approval("bob", current), approval("carol", previous), approval("mallory", current)},
+			required: 1,
+			want:     []string{"bob"},
+		},
+		{
+			name:     "changes requested",
+			reviews:  []models.PatternReview{approval("bob", current), {Reviewer: "carol", Status: models.PatternReviewChangesRequested}},
+			required: 1,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := promotionApprovers(tt.reviews, tt.required, "mallory", current)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("promotionApprovers() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("promotionApprovers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
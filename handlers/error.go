@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/layer5io/meshkit/errors"
 )
@@ -100,6 +102,68 @@ const (
 	ErrInvalidKubeConfigCode    = "2174"
 	ErrInvalidKubeHandlerCode   = "2175"
 	ErrInvalidKubeContextCode   = "2176"
+	ErrRegisterAdapterCode      = "2177"
+	ErrSharePatternCode         = "2180"
+	ErrPatternReviewCode        = "2181"
+	ErrDatadogConfigCode        = "2186"
+	ErrNewRelicConfigCode       = "2187"
+	ErrRestrictedNamespaceCode  = "2188"
+	ErrImpersonationCode        = "2189"
+	ErrPatternNotReadyCode      = "2190"
+	ErrWorkloadScanCode         = "2191"
+	ErrPrometheusConnectionCode = "2197"
+	ErrGrafanaSnapshotsCode     = "2199"
+	ErrNoSnapshotBoardsCode     = "2200"
+	ErrNativeMeshCode           = "2201"
+	ErrUnsupportedMeshCode      = "2202"
+	ErrEnvironmentCode          = "2204"
+	ErrPatternPromotionCode     = "2205"
+	ErrWorkloadIdentityCode     = "2209"
+	ErrWorkloadTokenCode        = "2210"
+	ErrPerfTargetCode           = "2211"
+	ErrGetResultAnomaliesCode   = "2213"
+	ErrGetApplicationCode       = "2214"
+	ErrApplicationOperationCode = "2215"
+	ErrKubeconfigContextsCode   = "2219"
+	ErrClusterHealthCode        = "2220"
+	ErrGetEventsCode            = "2222"
+	ErrUpdateEventsCode         = "2223"
+	ErrDigestPreferencesCode    = "2225"
+	ErrGatewayAPICode           = "2226"
+	ErrPatternUpgradeCode       = "2227"
+	ErrLoadTestLimitsCode       = "2228"
+	ErrDesignScheduleCode       = "2230"
+	ErrRemoteWriteCode          = "2231"
+	ErrAdaptiveLoadTestCode     = "2232"
+	ErrRequestMixCode           = "2234"
+	ErrUnixSocketCode           = "2236"
+	ErrPatternSnapshotCode      = "2237"
+	ErrOwnershipTransferCode    = "2238"
+	ErrTrashCode                = "2240"
+	ErrServerDebugCode          = "2241"
+	ErrTelemetryReportCode      = "2242"
+	ErrUnknownK8sContextsCode   = "2243"
+	ErrResyncK8sContextCode     = "2244"
+	ErrLoadTestCheckpointsCode  = "2245"
+	ErrCheckpointLoadTestCode   = "2246"
+	ErrFeatureFlagsCode         = "2248"
+	ErrFeatureDisabledCode      = "2249"
+	ErrProbeLoadTestTargetCode  = "2250"
+	ErrLoadTestAssertionsCode   = "2252"
+	ErrK8sRateLimitCode         = "2253"
+	ErrDesignGCCode             = "2254"
+	ErrDesignResourcesCode      = "2255"
+	ErrDesignPortForwardCode    = "2256"
+	ErrDesignExecCode           = "2257"
+	ErrLoadTestMethodCode       = "2258"
+	ErrResultHookCode           = "2261"
+	ErrProfileMetricsCode       = "2262"
+	ErrValidationWebhookCode    = "2265"
+	ErrDashboardSnapshotCode    = "2266"
+	ErrTrendReportCode          = "2268"
+	ErrQuotaExceededCode        = "2271"
+	ErrNotAdminCode             = "2281"
+	ErrPatternAccessCode        = "2282"
 )
 
 var (
@@ -107,6 +171,9 @@ var (
 	ErrNilClient         = errors.New(ErrNilClientCode, errors.Alert, []string{"Kubernetes client not initialized"}, []string{"Kubernetes config is not initialized with Meshery"}, []string{"Kubernetes config is not accessible to meshery or not valid"}, []string{"Upload your kubernetes config via the settings dashboard. If uploaded, wait for a minute for it to get initialized"})
 	ErrPrometheusConfig  = errors.New(ErrPrometheusConfigCode, errors.Alert, []string{"Prometheus endpoint not configured"}, []string{"Cannot find valid Prometheus endpoint in user pref"}, []string{"Prometheus endpoint might not be reachable from meshery"}, []string{"Setup your Prometheus Endpoint via the settings dashboard"})
 	ErrGrafanaConfig     = errors.New(ErrGrafanaConfigCode, errors.Alert, []string{"Grafana endpoint not configured"}, []string{"Cannot find valid grafana endpoint in user pref"}, []string{"Grafana endpoint might not be reachable from meshery"}, []string{"Setup your Grafana Endpoint via the settings dashboard"})
+	ErrNoSnapshotBoards  = errors.New(ErrNoSnapshotBoardsCode, errors.Alert, []string{"No Grafana board to snapshot"}, []string{"No Grafana board was given and none is selected in the Grafana settings"}, []string{"Grafana boards have not been selected"}, []string{"Select the boards to snapshot in the Grafana settings or give their UIDs"})
+	ErrDatadogConfig     = errors.New(ErrDatadogConfigCode, errors.Alert, []string{"Datadog connection not configured"}, []string{"Cannot find valid Datadog API key in user pref"}, []string{"Datadog connection has not been set up"}, []string{"Setup your Datadog connection via the settings dashboard"})
+	ErrNewRelicConfig    = errors.New(ErrNewRelicConfigCode, errors.Alert, []string{"New Relic connection not configured"}, []string{"Cannot find valid New Relic API key in user pref"}, []string{"New Relic connection has not been set up"}, []string{"Setup your New Relic connection via the settings dashboard"})
 	ErrStaticBoards      = errors.New(ErrStaticBoardsCode, errors.Alert, []string{"unable to get static board"}, []string{"unable to get static board"}, []string{"No boards could be available in grafana"}, []string{})
 	ErrValidAdapter      = errors.New(ErrValidAdapterCode, errors.Alert, []string{"Unable to find valid Adapter URL"}, []string{"unable to find a valid adapter for the given adapter URL"}, []string{"Given adapter URL is not valid"}, []string{"Please provide a valid Adapter URL"})
 	ErrAddAdapter        = errors.New(ErrAddAdapterCode, errors.Alert, []string{"meshLocationURL is empty"}, []string{"meshLocationURL is empty to add an adapter"}, []string{"meshLocationURL cannot be empty to add an adapter"}, []string{"please provide the meshLocationURL"})
@@ -126,6 +193,22 @@ func ErrGrafanaScan(err error) error {
 	return errors.New(ErrGrafanaScanCode, errors.Alert, []string{"Unable to connect to grafana"}, []string{err.Error()}, []string{"Grafana endpoint might not be reachable from meshery", "Grafana endpoint is incorrect"}, []string{"Check if your Grafana Endpoint is correct", "Connect to Grafana from the settings page in the UI"})
 }
 
+func ErrPrometheusConnection(name string) error {
+	return errors.New(ErrPrometheusConnectionCode, errors.Alert, []string{"Prometheus connection " + name + " not found"}, []string{"No Prometheus connection is registered with the name " + name}, []string{"The Prometheus connection has not been set up or was deleted"}, []string{"Register the Prometheus connection via the settings dashboard or the Prometheus configuration API"})
+}
+
+func ErrGrafanaSnapshots(errs string) error {
+	return errors.New(ErrGrafanaSnapshotsCode, errors.Alert, []string{"Unable to capture the Grafana snapshots of the performance test"}, []string{errs}, []string{"Grafana is not reachable from Meshery", "The Grafana API key is not allowed to create snapshots"}, []string{"Check the Grafana connection in the settings and that the API key has the Editor role"})
+}
+
+func ErrNativeMesh(err error) error {
+	return errors.New(ErrNativeMeshCode, errors.Alert, []string{"Unable to manage the service mesh without its adapter"}, []string{err.Error()}, []string{"MeshSync data is not available", "The namespace does not exist or Meshery is not allowed to update it"}, []string{"Ensure MeshSync is running and has discovered the cluster resources", "Check the namespace and the permissions of the Kubernetes config uploaded to Meshery"})
+}
+
+func ErrUnsupportedMesh(mesh string) error {
+	return errors.New(ErrUnsupportedMeshCode, errors.Alert, []string{"Service mesh " + mesh + " is not supported without its adapter"}, []string{"Only Istio and Linkerd can be managed without their adapters"}, []string{"The service mesh has no adapterless support"}, []string{"Use istio or linkerd, or deploy the adapter of the service mesh"})
+}
+
 func ErrPrometheusQuery(err error) error {
 	return errors.New(ErrPrometheusQueryCode, errors.Alert, []string{"Unable to query prometheus"}, []string{err.Error()}, []string{"Prometheus query did not get executed from meshery", "Prometheus query is invalid"}, []string{"Check if your Prometheus query is correct", "Connect to Prometheus and Grafana from the settings page in the UI"})
 }
@@ -428,3 +511,223 @@ func ErrParsePattern(err error) error {
 func ErrConvertPattern(err error) error {
 	return errors.New(ErrConvertPatternCode, errors.Alert, []string{"Error failed to convert PatternFile to Cytoscape object"}, []string{err.Error()}, []string{}, []string{})
 }
+
+func ErrRegisterAdapter(err error) error {
+	return errors.New(ErrRegisterAdapterCode, errors.Alert, []string{"Unable to register the adapter"}, []string{err.Error()}, []string{"Adapter is not reachable from Meshery", "CA bundle does not match the certificate served by the adapter"}, []string{"Make sure the adapter address is reachable from Meshery and the CA bundle is correct"})
+}
+
+func ErrSharePattern(err error) error {
+	return errors.New(ErrSharePatternCode, errors.Alert, []string{"Error failed to share pattern"}, []string{err.Error()}, []string{}, []string{})
+}
+
+func ErrPatternReview(err error) error {
+	return errors.New(ErrPatternReviewCode, errors.Alert, []string{"Error failed to process pattern review"}, []string{err.Error()}, []string{"Review does not exist or cannot move to the requested status"}, []string{"Pending reviews can be approved or have changes requested, and can be re-requested once changes are made"})
+}
+
+func ErrRestrictedNamespace(service, namespace string) error {
+	return errors.New(ErrRestrictedNamespaceCode, errors.Alert, []string{"Namespace not allowed for the deployment"}, []string{fmt.Sprintf("service %s targets namespace %s which is not among the namespaces the deployment is restricted to", service, namespace)}, []string{"The pattern deploys to a namespace outside of those the admins of Meshery Server allowed the workspace of the user"}, []string{"Update the namespace of the service", "Ask the admins of Meshery Server to add the namespace to the namespaces of the workspace in WORKSPACES_FILE, or to DEPLOY_NAMESPACES"})
+}
+
+func ErrImpersonation(err error) error {
+	return errors.New(ErrImpersonationCode, errors.Alert, []string{"Unable to impersonate the service account"}, []string{err.Error()}, []string{"Service account is not of the form namespace/name", "Service account is not in one of the namespaces allowed to the workspace of the user, or none are", "Kubeconfig of the current context could not be updated for impersonation"}, []string{"Provide the service account as namespace/name and ensure Meshery is allowed to impersonate it", "Ask the admins of Meshery Server to set the namespaces of the workspace in WORKSPACES_FILE, or DEPLOY_NAMESPACES"})
+}
+
+func ErrPatternNotReady(err error, timeout time.Duration) error {
+	return errors.New(ErrPatternNotReadyCode, errors.Alert, []string{fmt.Sprintf("Pattern components not ready within %s", timeout)}, []string{err.Error()}, []string{"Components could not be scheduled or are failing to start", "Timeout is too short for the components to become ready"}, []string{"Inspect the events of the reported resources", "Retry with a longer timeout"})
+}
+
+func ErrWorkloadScan(err error) error {
+	return errors.New(ErrWorkloadScanCode, errors.Alert, []string{"Unable to create a pattern from the cluster workloads"}, []string{err.Error()}, []string{"Neither namespaces nor a label selector were given", "Label selector is invalid", "MeshSync data is not available"}, []string{"Select the workloads by namespace or label selector", "Ensure MeshSync is running and has discovered the cluster resources"})
+}
+
+func ErrEnvironment(err error) error {
+	return errors.New(ErrEnvironmentCode, errors.Alert, []string{"Error failed to process environment"}, []string{err.Error()}, []string{"Environment does not exist", "Kubernetes context of the environment is no longer available"}, []string{"Check the name of the environment and the Kubernetes context it deploys to"})
+}
+
+func ErrPatternPromotion(err error) error {
+	return errors.New(ErrPatternPromotionCode, errors.Alert, []string{"Unable to promote the design"}, []string{err.Error()}, []string{"Design was not deployed to the environment it is promoted from", "Design changed since it was deployed to the environment it is promoted from", "Design lacks the approvals required by the target environment"}, []string{"Deploy the current version of the design to the environment it is promoted from", "Request reviews on the design and have them approved"})
+}
+
+func ErrWorkloadIdentity(err error) error {
+	return errors.New(ErrWorkloadIdentityCode, errors.Alert, []string{"Error failed to process workload identity"}, []string{err.Error()}, []string{"Workload identity does not exist", "Kubernetes context of the workload identity is not available"}, []string{"Check the ID of the workload identity and of its Kubernetes context"})
+}
+
+func ErrWorkloadToken(err error) error {
+	return errors.New(ErrWorkloadTokenCode, errors.Alert, []string{"Unable to authenticate the workload"}, []string{err.Error()}, []string{"ServiceAccount token is invalid, expired or issued for another audience", "No workload identity is bound to the ServiceAccount", "Meshery is not allowed to create TokenReviews in the cluster of the workload"}, []string{"Mount a projected ServiceAccount token with the audience of the workload identity", "Create a workload identity for the ServiceAccount", "Grant Meshery the system:auth-delegator ClusterRole"})
+}
+
+func ErrPerfTarget(err error) error {
+	return errors.New(ErrPerfTargetCode, errors.Alert, []string{"Unable to deploy the performance test target"}, []string{err.Error()}, []string{"Kubernetes context does not exist", "Meshery is not allowed to create namespaces, deployments or services in the cluster"}, []string{"Check the ID or the name of the Kubernetes context", "Grant Meshery the permissions to manage the namespace of the target"})
+}
+
+func ErrGetResultAnomalies(err error) error {
+	return errors.New(ErrGetResultAnomaliesCode, errors.Alert, []string{"Unable to get the anomalous performance results"}, []string{err.Error()}, []string{"The analyses of the performance results could not be read from the database"}, []string{"Make sure the Meshery database is readable"})
+}
+
+func ErrGetApplication(err error) error {
+	return errors.New(ErrGetApplicationCode, errors.Alert, []string{"Error failed to get application"}, []string{err.Error()}, []string{"Cannot get the Application with the given Application ID", "Application file is invalid"}, []string{"Check if the given Application ID is correct"})
+}
+
+func ErrApplicationOperation(err error, obj string) error {
+	return errors.New(ErrApplicationOperationCode, errors.Alert, []string{"Unable to ", obj, " the workloads of the application"}, []string{err.Error()}, []string{"MeshSync data is not available", "Component does not exist or its workload cannot be scaled", "Meshery is not allowed to update the workloads in the cluster"}, []string{"Ensure MeshSync is running and has discovered the cluster resources", "Check the component names with mesheryctl app status", "Check the permissions of the Kubernetes config uploaded to Meshery"})
+}
+
+func ErrKubeconfigContexts(reasons []string) error {
+	return errors.New(ErrKubeconfigContextsCode, errors.Alert, []string{"None of the contexts of the kubeconfig could be used"}, reasons, []string{"Kubeconfig is invalid", "Clusters are not reachable from Meshery Server", "Users authenticate with credential plugins or auth providers Meshery Server cannot use"}, []string{"Check the reason given for every context"})
+}
+
+func ErrClusterHealth(err error) error {
+	return errors.New(ErrClusterHealthCode, errors.Alert, []string{"Unable to check the health of the Kubernetes contexts"}, []string{err.Error()}, []string{"Kubernetes contexts could not be loaded from the provider", "Kubernetes context does not exist"}, []string{"Make sure the Kubernetes context is registered with Meshery Server"})
+}
+
+func ErrGetEvents(err error) error {
+	return errors.New(ErrGetEventsCode, errors.Alert, []string{"Unable to get the events"}, []string{err.Error()}, []string{"The filter of the events is invalid", "The events could not be read from the database"}, []string{"Use the severities and categories Meshery supports and a since time in RFC 3339 format"})
+}
+
+func ErrUpdateEvents(err error) error {
+	return errors.New(ErrUpdateEventsCode, errors.Alert, []string{"Unable to update the state of the events"}, []string{err.Error()}, []string{"Some of the events do not exist", "The state of the events could not be written to the database"}, []string{"Make sure the IDs of the events are valid"})
+}
+
+func ErrDigestPreferences(err error) error {
+	return errors.New(ErrDigestPreferencesCode, errors.Alert, []string{"Unable to update the digest preferences"}, []string{err.Error()}, []string{"The frequency or the email address is invalid", "Meshery Server is not configured to send emails"}, []string{"Use a frequency of daily, weekly or off and a valid email address", "Configure the SMTP server of Meshery Server with SMTP_HOST, SMTP_PORT, SMTP_USERNAME, SMTP_PASSWORD and SMTP_FROM"})
+}
+
+func ErrGatewayAPI(err error) error {
+	return errors.New(ErrGatewayAPICode, errors.Alert, []string{"Unable to get the Gateway API resources"}, []string{err.Error()}, []string{"MeshSync data is not available", "Kubernetes context does not exist or is not connected"}, []string{"Ensure MeshSync is running and has discovered the cluster resources", "Make sure the Kubernetes context is registered with Meshery Server"})
+}
+
+func ErrPatternUpgrade(err error) error {
+	return errors.New(ErrPatternUpgradeCode, errors.Alert, []string{"Unable to upgrade the components of the design"}, []string{err.Error()}, []string{"Design file is invalid", "Upgraded design could not be saved"}, []string{"Check that the design file is valid YAML", "Check the connection to the provider and try again"})
+}
+
+func ErrLoadTestLimits(workspace string, violations []string) error {
+	return errors.New(ErrLoadTestLimitsCode, errors.Alert, []string{"Performance test exceeds the limits of the workspace " + workspace}, violations, []string{"The QPS, duration or concurrent connections of the test are above the load test limits of the workspace, which are those configured for Meshery Server unless an admin set limits for the workspace"}, []string{"Lower the QPS, duration or concurrent connections of the test", "Ask an admin of Meshery Server to raise the load test limits of the workspace, or its operator to raise PERF_MAX_QPS, PERF_MAX_DURATION or PERF_MAX_CONNECTIONS"})
+}
+
+func ErrDesignSchedule(err error) error {
+	return errors.New(ErrDesignScheduleCode, errors.Alert, []string{"Unable to schedule or run the operation of the design"}, []string{err.Error()}, []string{"The operation, the time to run at or the cron expression is invalid", "The Kubernetes context of the schedule no longer exists", "The session the design was scheduled with expired"}, []string{"Use deploy or undeploy with a future time in RFC 3339 format or a cron expression of five fields", "Schedule the design again with a valid Kubernetes context and session"})
+}
+
+func ErrRemoteWrite(err error) error {
+	return errors.New(ErrRemoteWriteCode, errors.Alert, []string{"Unable to receive the client-side metrics written through Prometheus remote_write"}, []string{err.Error()}, []string{"The payload is not a snappy compressed protobuf WriteRequest", "The performance test the metrics are written for is not running"}, []string{"Write with Prometheus remote_write 1.0", "Give the UUID of the running test as the test_id parameter of the URL written to or as the meshery_test_id label of the series"})
+}
+
+func ErrAdaptiveLoadTest(err error) error {
+	return errors.New(ErrAdaptiveLoadTestCode, errors.Alert, []string{"Unable to run the adaptive performance test"}, []string{err.Error()}, []string{"The p99 latency target is missing or invalid", "The test is not run with Nighthawk", "The test configuration has multiple clients"}, []string{"Give the p99 latency budget as targetP99, such as 100ms", "Run adaptive tests with the nighthawk load generator and a single client"})
+}
+
+func ErrUnixSocket(err error) error {
+	return errors.New(ErrUnixSocketCode, errors.Alert, []string{"Unable to test over the Unix domain socket"}, []string{err.Error()}, []string{"The socket is not given as an absolute path", "The test is not run with fortio", "The URL of the test is an https or wss URL"}, []string{"Give the absolute path of the socket, such as /var/run/app.sock", "Test Unix domain sockets with the fortio load generator against an http:// or ws:// URL, its host being sent as the Host of the requests"})
+}
+
+func ErrRequestMix(err error) error {
+	return errors.New(ErrRequestMixCode, errors.Alert, []string{"Invalid request mix"}, []string{err.Error()}, []string{"A request of the mix has no path, a weight below one or an unknown method", "The test is not an HTTP test run with fortio", "The test also has a request body template"}, []string{"Give every request of the mix a weight of at least one, a path and an HTTP method", "Run request mixes with the fortio load generator against an http(s) URL"})
+}
+
+func ErrLoadTestMethod(err error) error {
+	return errors.New(ErrLoadTestMethodCode, errors.Alert, []string{"Invalid HTTP method of the load test"}, []string{err.Error()}, []string{"The method is not an HTTP method", "The test is not an HTTP test", "The test also has a request mix, whose requests have their own methods"}, []string{"Use GET, HEAD, POST, PUT, PATCH, DELETE or OPTIONS", "Give the methods of the requests of a request mix in the mix"})
+}
+
+func ErrPatternSnapshot(err error) error {
+	return errors.New(ErrPatternSnapshotCode, errors.Alert, []string{"Unable to render the image of the design"}, []string{err.Error()}, []string{"Design file is invalid", "Image format is not supported"}, []string{"Check that the design file is valid YAML", "Render designs to svg or png images"})
+}
+
+func ErrOwnershipTransfer(err error) error {
+	return errors.New(ErrOwnershipTransferCode, errors.Alert, []string{"Unable to transfer the ownership of the artifacts"}, []string{err.Error()}, []string{"The user to transfer the artifacts to is not given", "Neither the IDs of the artifacts nor their owner are given", "The provider does not support ownership transfers"}, []string{"Give the user to transfer the artifacts to and either the kind and IDs of the artifacts or their current owner", "Use a provider supporting the transfer-ownership capability"})
+}
+
+func ErrTrash(err error) error {
+	return errors.New(ErrTrashCode, errors.Alert, []string{"Unable to list or restore the deleted designs and performance profiles"}, []string{err.Error()}, []string{"The design or performance profile is not in the trash, it was restored already or purged", "The provider does not support the trash"}, []string{"List the trash to find the id of the design or performance profile", "Use a provider supporting the persist-trash capability"})
+}
+
+func ErrServerDebug(err error) error {
+	return errors.New(ErrServerDebugCode, errors.Alert, []string{"Unable to get the server-side debug"}, []string{err.Error()}, []string{"The after cursor is not a number", "The since time is not in RFC 3339 format"}, []string{"Give the cursor returned with the previous entries as after, or a time in RFC 3339 format as since"})
+}
+
+func ErrTelemetryReport(err error) error {
+	return errors.New(ErrTelemetryReportCode, errors.Alert, []string{"Unable to build the telemetry report"}, []string{err.Error()}, []string{"The latest performance result of the user could not be read from the database"}, []string{"Check the database of Meshery Server"})
+}
+
+func ErrUnknownKubeconfigContexts(unknown, available []string) error {
+	return errors.New(ErrUnknownK8sContextsCode, errors.Alert, []string{"The contexts selected are not in the kubeconfig"}, []string{"Unknown contexts: " + strings.Join(unknown, ", "), "Contexts of the kubeconfig: " + strings.Join(available, ", ")}, []string{"The name of a context is misspelled", "The kubeconfig uploaded is not the one the contexts were selected from"}, []string{"Select the contexts among those of the kubeconfig"})
+}
+
+func ErrResyncK8sContext(err error, name string) error {
+	return errors.New(ErrResyncK8sContextCode, errors.Alert, []string{"Unable to resync the Kubernetes connection " + name}, []string{err.Error()}, []string{"The connections could not be loaded from the provider", "The connection could not be updated with the provider"}, []string{"Check that the provider is reachable and upload the kubeconfig again"})
+}
+
+func ErrLoadTestCheckpoints(err error) error {
+	return errors.New(ErrLoadTestCheckpointsCode, errors.Alert, []string{"Unable to persist or get the checkpoints of the performance test"}, []string{err.Error()}, []string{"The checkpoints could not be written to or read from the database"}, []string{"Make sure the Meshery database is writable"})
+}
+
+func ErrCheckpointLoadTest(err error) error {
+	return errors.New(ErrCheckpointLoadTestCode, errors.Alert, []string{"Unable to checkpoint the performance test"}, []string{err.Error()}, []string{"The checkpoint interval is invalid or shorter than a minute", "The test is adaptive"}, []string{"Give the interval of the checkpoints as checkpoint, such as 10m", "Run adaptive tests without checkpoints"})
+}
+
+func ErrFeatureFlags(err error) error {
+	return errors.New(ErrFeatureFlagsCode, errors.Alert, []string{"Unable to get or set the feature flags"}, []string{err.Error()}, []string{"The feature flag is not one of those of Meshery Server", "The feature flags could not be read from or written to the database"}, []string{"List the feature flags of Meshery Server with `mesheryctl exp features list`", "Check the database of Meshery Server"})
+}
+
+func ErrFeatureDisabled(name string) error {
+	return errors.New(ErrFeatureDisabledCode, errors.Alert, []string{"The experimental capability ", name, " is disabled"}, []string{"The feature flag " + name + " is disabled for the user"}, []string{"The feature flag was disabled globally or by the user"}, []string{"Enable it with `mesheryctl exp features enable " + name + "`"})
+}
+
+func ErrProbeLoadTestTarget(err error) error {
+	return errors.New(ErrProbeLoadTestTargetCode, errors.Alert, []string{"The target of the performance test failed the probe requests sent before the test"}, []string{err.Error()}, []string{"The host of the target cannot be resolved or reached from Meshery Server", "The certificate of the target is not trusted", "The target answered with a status other than those expected, such as 404 for a wrong path or 401 for missing credentials"}, []string{"Check the URL, headers and cookies of the test, and that the target can be reached from Meshery Server", "Give the statuses the target answers with as expectStatus, or skip the probe with skipProbe=true"})
+}
+
+func ErrLoadTestAssertions(err error) error {
+	return errors.New(ErrLoadTestAssertionsCode, errors.Alert, []string{"Invalid response assertions"}, []string{err.Error()}, []string{"A status is not an HTTP status, a JSONPath expression is invalid or the maximum response size is negative", "The test is not an HTTP test run with fortio"}, []string{"Give statuses between 100 and 599, JSONPath expressions such as $.status and a positive maximum response size", "Run tests with assertions with the fortio load generator against an http(s) URL"})
+}
+
+func ErrK8sRateLimit(err error) error {
+	return errors.New(ErrK8sRateLimitCode, errors.Alert, []string{"Unable to get or set the rate limit of the Kubernetes connection"}, []string{err.Error()}, []string{"The connection is not registered with Meshery Server", "The queries per second or the burst are negative", "The connection could not be saved by the provider"}, []string{"List the connections with `mesheryctl system config rate-limit`", "Give a positive qps and burst, or zero for the defaults"})
+}
+
+func ErrDesignGC(err error) error {
+	return errors.New(ErrDesignGCCode, errors.Alert, []string{"Unable to collect the resources left behind by deleted designs"}, []string{err.Error()}, []string{"No Kubernetes context or several ones have the given name", "The Kubernetes cluster is unreachable", "The saved designs could not be fetched from the provider"}, []string{"Give the ID of the Kubernetes context, as listed by `mesheryctl system context view`", "Verify the connection to the Kubernetes cluster and the provider"})
+}
+
+func ErrDesignResources(err error, obj string) error {
+	return errors.New(ErrDesignResourcesCode, errors.Alert, []string{fmt.Sprintf("Unable to get the %s of the resources of the design", obj)}, []string{err.Error()}, []string{"The design has no component with the given name", "The design is not deployed in the current Kubernetes context", "The Kubernetes cluster is unreachable"}, []string{"Give the name of a Kubernetes component of the design", "Deploy the design or switch to the Kubernetes context it is deployed in"})
+}
+
+func ErrDesignPortForward(err error) error {
+	return errors.New(ErrDesignPortForwardCode, errors.Alert, []string{"Unable to forward the port of the component of the design"}, []string{err.Error()}, []string{"The component has no running pod in the current Kubernetes context", "The component has no such port", "The Kubernetes cluster refused the port forwarding"}, []string{"Deploy the design or switch to the Kubernetes context it is deployed in", "Give a port of the Service of the component, or of the containers of its pods"})
+}
+
+func ErrDesignExec(err error) error {
+	return errors.New(ErrDesignExecCode, errors.Alert, []string{"Unable to run the command in the component of the design"}, []string{err.Error()}, []string{"The component has no running pod in the current Kubernetes context", "The pod has no such container", "The Kubernetes connection is not allowed to create pods/exec"}, []string{"Deploy the design or switch to the Kubernetes context it is deployed in", "Give a container of the pods of the component", "Grant the create verb on pods/exec to the credentials of the Kubernetes connection"})
+}
+
+func ErrResultHook(err error) error {
+	return errors.New(ErrResultHookCode, errors.Alert, []string{"Unable to manage the result hooks"}, []string{err.Error()}, []string{"The hook is invalid or does not exist", "The hooks could not be read from or written to the database"}, []string{"Give the hook a name and an http(s) URL", "Check the database of Meshery Server"})
+}
+
+func ErrProfileMetrics(err error) error {
+	return errors.New(ErrProfileMetricsCode, errors.Alert, []string{"Unable to compute the metrics of the performance profile"}, []string{err.Error()}, []string{"The performance profile does not exist", "The results of the profile could not be fetched from the provider"}, []string{"Check the ID of the performance profile", "Make sure the provider is reachable from Meshery Server"})
+}
+
+func ErrValidationWebhook(err error) error {
+	return errors.New(ErrValidationWebhookCode, errors.Alert, []string{"Unable to manage the validation webhooks"}, []string{err.Error()}, []string{"The webhook is invalid or does not exist", "The webhooks could not be read from or written to the database"}, []string{"Give the webhook a name, an http(s) URL and a failure policy of Fail or Ignore", "Check the database of Meshery Server"})
+}
+
+func ErrDashboardSnapshot(err error) error {
+	return errors.New(ErrDashboardSnapshotCode, errors.Alert, []string{"Unable to capture or retrieve the snapshot of Meshery UI"}, []string{err.Error()}, []string{"The page of the snapshot is not a path of Meshery UI", "The snapshot does not exist", "The snapshots could not be read from or written to the database"}, []string{"Give the path of a page of Meshery UI, such as /configuration/patterns", "Check the ID of the snapshot", "Check the database of Meshery Server"})
+}
+
+func ErrTrendReport(err error) error {
+	return errors.New(ErrTrendReportCode, errors.Alert, []string{"Unable to get the trend report of the performance profile"}, []string{err.Error()}, []string{"The performance profile does not exist", "The history of the results or the trend reports could not be read from the database"}, []string{"Check the ID of the performance profile", "Check the database of Meshery Server"})
+}
+
+func ErrQuotaExceeded(err error) error {
+	return errors.New(ErrQuotaExceededCode, errors.Alert, []string{"Quota of the workspace exceeded"}, []string{err.Error()}, []string{"The members of the workspace own as many performance profiles, designs or results, or run as many concurrent performance tests, as its quotas allow"}, []string{"Delete the performance profiles, designs or results the workspace no longer needs, or wait for its tests to complete", "Ask the operator of Meshery Server to raise the quotas of the workspace in WORKSPACES_FILE"})
+}
+
+func ErrNotAdmin(action string) error {
+	return errors.New(ErrNotAdminCode, errors.Alert, []string{"Only the admins of Meshery Server may " + action}, []string{"The user is not an admin of Meshery Server"}, []string{"The ID of the user is not listed in ADMINS"}, []string{"Ask an admin of Meshery Server to do it, or its operator to add the user to ADMINS"})
+}
+
+func ErrPatternAccess(patternID, permission string) error {
+	return errors.New(ErrPatternAccessCode, errors.Alert, []string{"Not allowed to", permission, "the pattern", patternID}, []string{"The user neither owns the pattern nor is it shared with them or their team with the " + permission + " permission"}, []string{"The pattern belongs to another user", "The pattern is shared with the user with the view permission only"}, []string{"Ask the owner of the pattern to share it with you, with mesheryctl pattern share"})
+}
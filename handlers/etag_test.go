@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/layer5io/meshery/models"
+)
+
+func TestETagMiddleware(t *testing.T) {
+	h := newTestHandler(t, &models.HandlerConfig{})
+	body := `{"patterns": []}`
+	next := h.ETagMiddleware(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			http.Error(rw, "not found", http.StatusNotFound)
+		case "/cached":
+			rw.Header().Set("Cache-Control", "max-age=60")
+			_, _ = rw.Write([]byte(body))
+		default:
+			if r.Method == http.MethodPost {
+				rw.WriteHeader(http.StatusCreated)
+			}
+			_, _ = rw.Write([]byte(body))
+		}
+	}))
+	serve := func(method, path, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rw := httptest.NewRecorder()
+		next.ServeHTTP(rw, req)
+		return rw
+	}
+
+	rw := serve(http.MethodGet, "/api/pattern", "")
+	etag := rw.Header().Get("ETag")
+	if rw.Code != http.StatusOK || rw.Body.String() != body || etag == "" {
+		t.Fatalf("got %d %q with the ETag %q, want the body tagged", rw.Code, rw.Body.String(), etag)
+	}
+	if cc := rw.Header().Get("Cache-Control"); cc != "private, no-cache" {
+		t.Errorf("got Cache-Control %q, want the response revalidated", cc)
+	}
+
+	t.Run("matching If-None-Match", func(t *testing.T) {
+		for _, ifNoneMatch := range []string{etag, "W/" + etag, `"stale", ` + etag, "*"} {
+			rw := serve(http.MethodGet, "/api/pattern", ifNoneMatch)
+			if rw.Code != http.StatusNotModified || rw.Body.Len() != 0 {
+				t.Errorf("If-None-Match %s: got %d %q, want %d without a body", ifNoneMatch, rw.Code, rw.Body.String(), http.StatusNotModified)
+			}
+			if rw.Header().Get("ETag") != etag {
+				t.Errorf("If-None-Match %s: got the ETag %q, want %q", ifNoneMatch, rw.Header().Get("ETag"), etag)
+			}
+		}
+	})
+
+	t.Run("mismatching If-None-Match", func(t *testing.T) {
+		for _, ifNoneMatch := range []string{`"stale"`, `W/"stale"`, etag[1 : len(etag)-1]} {
+			rw := serve(http.MethodGet, "/api/pattern", ifNoneMatch)
+			if rw.Code != http.StatusOK || rw.Body.String() != body {
+				t.Errorf("If-None-Match %s: got %d %q, want %d with the body", ifNoneMatch, rw.Code, rw.Body.String(), http.StatusOK)
+			}
+		}
+	})
+
+	t.Run("non-GET requests", func(t *testing.T) {
+		rw := serve(http.MethodPost, "/api/pattern", etag)
+		if rw.Code != http.StatusCreated || rw.Body.String() != body {
+			t.Errorf("POST got %d %q, want the response of the handler", rw.Code, rw.Body.String())
+		}
+		if rw.Header().Get("ETag") != "" {
+			t.Errorf("POST response was tagged %q", rw.Header().Get("ETag"))
+		}
+	})
+
+	t.Run("unsuccessful responses", func(t *testing.T) {
+		rw := serve(http.MethodGet, "/missing", "*")
+		if rw.Code != http.StatusNotFound || rw.Header().Get("ETag") != "" {
+			t.Errorf("got %d with the ETag %q, want %d untagged", rw.Code, rw.Header().Get("ETag"), http.StatusNotFound)
+		}
+	})
+
+	t.Run("Cache-Control of the handler", func(t *testing.T) {
+		rw := serve(http.MethodGet, "/cached", "")
+		if cc := rw.Header().Get("Cache-Control"); cc != "max-age=60" {
+			t.Errorf("got Cache-Control %q, want the one set by the handler", cc)
+		}
+	})
+}
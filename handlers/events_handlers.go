@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route GET /api/system/events EventsAPI idGetEvents
+// Handle GET request for the events
+//
+// Returns the events recorded by Meshery, the latest first, along with whether the user acknowledged
+// and resolved them, filtered by severity, category, state and time
+// responses:
+// 	200: eventsResponseWrapper
+
+// EventsHandler returns the recorded events matching the filter of the query along with their state for the user
+func (h *Handler) EventsHandler(rw http.ResponseWriter, r *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	filter, err := eventFilterFromQuery(r.URL.Query())
+	if err != nil {
+		h.log.Error(ErrGetEvents(err))
+		http.Error(rw, ErrGetEvents(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	events, err := h.config.EventPersister.GetEvents(user.UserID, filter)
+	if err != nil {
+		h.log.Error(ErrGetEvents(err))
+		http.Error(rw, ErrGetEvents(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeApplicationJSON(rw, events, "events")
+}
+
+// swagger:route POST /api/system/events/acknowledge EventsAPI idAcknowledgeEvents
+// Handle POST request to acknowledge events
+//
+// Acknowledges, for the user, the events with the given IDs or, when none are given, every
+// event matching the filter
+// responses:
+// 	200: eventStateResponseWrapper
+
+// AcknowledgeEventsHandler acknowledges events in bulk for the user
+func (h *Handler) AcknowledgeEventsHandler(rw http.ResponseWriter, r *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	h.updateEventsState(rw, r, user, false)
+}
+
+// swagger:route POST /api/system/events/resolve EventsAPI idResolveEvents
+// Handle POST request to resolve events
+//
+// Resolves, and thereby acknowledges, for the user the events with the given IDs or, when none
+// are given, every event matching the filter
+// responses:
+// 	200: eventStateResponseWrapper
+
+// ResolveEventsHandler resolves events in bulk for the user
+func (h *Handler) ResolveEventsHandler(rw http.ResponseWriter, r *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	h.updateEventsState(rw, r, user, true)
+}
+
+func (h *Handler) updateEventsState(rw http.ResponseWriter, r *http.Request, user *models.User, resolve bool) {
+	stateReq := &models.EventStateRequest{}
+	if err := json.NewDecoder(r.Body).Decode(stateReq); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	var updated int
+	var err error
+	if resolve {
+		updated, err = h.config.EventPersister.ResolveEvents(user.UserID, *stateReq)
+	} else {
+		updated, err = h.config.EventPersister.AcknowledgeEvents(user.UserID, *stateReq)
+	}
+	if err != nil {
+		h.log.Error(ErrUpdateEvents(err))
+		http.Error(rw, ErrUpdateEvents(err).Error(), http.StatusBadRequest)
+		return
+	}
+	h.writeApplicationJSON(rw, models.EventStateResult{Updated: updated}, "events state")
+}
+
+// eventFilterFromQuery parses the filter of the events from the query, severities and categories
+// being either repeated or comma separated
+func eventFilterFromQuery(q url.Values) (models.EventFilter, error) {
+	filter := models.EventFilter{}
+	for _, severity := range splitQueryValues(q["severity"]) {
+		filter.Severities = append(filter.Severities, models.EventSeverity(severity))
+	}
+	for _, category := range splitQueryValues(q["category"]) {
+		filter.Categories = append(filter.Categories, models.EventCategory(category))
+	}
+	if err := filter.Validate(); err != nil {
+		return filter, err
+	}
+
+	for key, state := range map[string]**bool{"acknowledged": &filter.Acknowledged, "resolved": &filter.Resolved} {
+		if v := q.Get(key); v != "" {
+			set, err := strconv.ParseBool(v)
+			if err != nil {
+				return filter, fmt.Errorf("invalid %s %q, expected true or false", key, v)
+			}
+			*state = &set
+		}
+	}
+	if v := q.Get("since"); v != "" {
+		since, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, fmt.Errorf("invalid since %q, expected a time in RFC 3339 format", v)
+		}
+		filter.Since = &since
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 0 {
+			return filter, fmt.Errorf("invalid limit %q, expected a positive number", v)
+		}
+		filter.Limit = limit
+	}
+	return filter, nil
+}
+
+func splitQueryValues(values []string) []string {
+	split := []string{}
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				split = append(split, s)
+			}
+		}
+	}
+	return split
+}
@@ -21,6 +21,12 @@ var (
 	flusherMap map[string]http.Flusher
 )
 
+// adapterClient is the client of an adapter along with its location
+type adapterClient struct {
+	location string
+	client   *meshes.MeshClient
+}
+
 // EventStreamHandler endpoint is used for streaming events to the frontend
 func (h *Handler) EventStreamHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, p models.Provider) {
 	// if req.Method != http.MethodGet {
@@ -61,30 +67,35 @@ func (h *Handler) EventStreamHandler(w http.ResponseWriter, req *http.Request, p
 
 	respChan := make(chan []byte, 100)
 
-	newAdaptersChan := make(chan *meshes.MeshClient)
+	newAdaptersChan := make(chan adapterClient)
 
 	go func() {
 		for mClient := range newAdaptersChan {
 			log.Debug("received a new mesh client, listening for events")
-			go func(mClient *meshes.MeshClient) {
-				listenForAdapterEvents(req.Context(), mClient, respChan, log, p)
-				_ = mClient.Close()
+			go func(mClient adapterClient) {
+				listenForAdapterEvents(req.Context(), mClient, respChan, log, p, h.config.EventRecorder)
+				_ = mClient.client.Close()
 			}(mClient)
 		}
 
 		log.Debug("new adapters channel closed")
 	}()
 
+	// The events of the adapter health tracker and of the result anomaly detector are
+	// recorded once, by the event recorder, and relayed to every stream
+	unsubscribeEvents := func() {}
+	if h.config.EventRecorder != nil {
+		var events <-chan *models.Event
+		events, unsubscribeEvents = h.config.EventRecorder.Subscribe()
+		go relayEvents(notify, events, respChan, log)
+	}
+
+	// respChan has several senders, which stop sending once the request is done, and is
+	// never closed; the handler waits for the stream to be written before returning
+	written := make(chan struct{})
 	go func(flusher http.Flusher) {
-		for data := range respChan {
-			log.Debug("received new data on response channel")
-			_, _ = fmt.Fprintf(w, "data: %s\n\n", data)
-			if flusher != nil {
-				flusher.Flush()
-				log.Debugf("Flushed the messages on the wire...")
-			}
-		}
-		log.Debug("response channel closed")
+		writeEvents(notify, w, flusher, respChan, log)
+		close(written)
 	}(flusherMap[client])
 
 STOP:
@@ -92,8 +103,9 @@ STOP:
 		select {
 		case <-notify.Done():
 			log.Debugf("received signal to close connection and channels")
+			unsubscribeEvents()
 			close(newAdaptersChan)
-			close(respChan)
+			<-written
 			break STOP
 		default:
 			meshAdapters := prefObj.MeshAdapters
@@ -147,7 +159,7 @@ STOP:
 							delete(localMeshAdapters, ma.Location)
 						} else {
 							if !ok { // reusing the map check, only when ok is false a new entry will be added
-								newAdaptersChan <- mClient
+								newAdaptersChan <- adapterClient{location: ma.Location, client: mClient}
 							}
 						}
 					}
@@ -160,10 +172,10 @@ STOP:
 	defer log.Debug("events handler closed")
 }
 
-func listenForAdapterEvents(ctx context.Context, mClient *meshes.MeshClient, respChan chan []byte, log *logrus.Entry, p models.Provider) {
+func listenForAdapterEvents(ctx context.Context, mClient adapterClient, respChan chan<- []byte, log *logrus.Entry, p models.Provider, recorder models.EventRecorderInterface) {
 	log.Debugf("Received a stream client...")
 
-	streamClient, err := mClient.MClient.StreamEvents(ctx, &meshes.EventsRequest{})
+	streamClient, err := mClient.client.MClient.StreamEvents(ctx, &meshes.EventsRequest{})
 	if err != nil {
 		log.Error(ErrStreamEvents(err))
 		// errChan <- err
@@ -200,12 +212,60 @@ func listenForAdapterEvents(ctx context.Context, mClient *meshes.MeshClient, res
 			event.Details = fmt.Sprintf("Result-Id: %s", id)
 		}
 
+		var recorded interface{} = event
+		if recorder != nil {
+			e, err := recorder.Record(models.EventCategoryAdapter, mClient.location, event)
+			if err != nil {
+				log.Error(err)
+			}
+			recorded = e
+		}
+
+		data, err := json.Marshal(recorded)
+		if err != nil {
+			log.Error(ErrMarshal(err, "event"))
+			return
+		}
+		select {
+		case respChan <- data:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// relayEvents sends the events of the subscription to the stream until the subscription is
+// cancelled or the request is done
+func relayEvents(ctx context.Context, events <-chan *models.Event, respChan chan<- []byte, log *logrus.Entry) {
+	for event := range events {
 		data, err := json.Marshal(event)
 		if err != nil {
 			log.Error(ErrMarshal(err, "event"))
+			continue
+		}
+		select {
+		case respChan <- data:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// writeEvents writes the data sent on the channel to the stream until the request is done
+func writeEvents(ctx context.Context, w io.Writer, flusher http.Flusher, respChan <-chan []byte, log *logrus.Entry) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("request done, stopped writing to the stream")
 			return
+		case data := <-respChan:
+			log.Debug("received new data on response channel")
+			_, _ = fmt.Fprintf(w, "data: %s\n\n", data)
+			if flusher != nil {
+				flusher.Flush()
+				log.Debugf("Flushed the messages on the wire...")
+			}
 		}
-		respChan <- data
 	}
 }
 
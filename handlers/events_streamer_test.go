@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// testEventRecorder publishes the events sent on its channel to its single subscriber
+type testEventRecorder struct {
+	events chan *models.Event
+}
+
+func (r *testEventRecorder) Record(category models.EventCategory, source string, e *meshes.EventsResponse) (*models.Event, error) {
+	return models.NewEvent(category, source, e), nil
+}
+
+func (r *testEventRecorder) Subscribe() (<-chan *models.Event, func()) {
+	return r.events, func() { close(r.events) }
+}
+
+func TestRelayEventsStopsWithTheRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan *models.Event, 1)
+	// nobody reads the stream once the request is done
+	respChan := make(chan []byte)
+	relayed := make(chan struct{})
+	go func() {
+		relayEvents(ctx, events, respChan, logrus.NewEntry(logrus.New()))
+		close(relayed)
+	}()
+
+	cancel()
+	events <- models.NewEvent(models.EventCategoryAdapter, "istio", &meshes.EventsResponse{Summary: "mTLS enabled"})
+	select {
+	case <-relayed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("events were relayed to the stream of a request which is done")
+	}
+}
+
+func TestWriteEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	respChan := make(chan []byte)
+	buf := &bytes.Buffer{}
+	written := make(chan struct{})
+	go func() {
+		writeEvents(ctx, buf, nil, respChan, logrus.NewEntry(logrus.New()))
+		close(written)
+	}()
+
+	respChan <- []byte(`{"summary": "mTLS enabled"}`)
+	cancel()
+	select {
+	case <-written:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream was written after the request was done")
+	}
+	if got := buf.String(); got != "data: {\"summary\": \"mTLS enabled\"}\n\n" {
+		t.Errorf("stream is %q, want the event sent", got)
+	}
+}
+
+func TestEventStreamHandlerClosesWithTheRequest(t *testing.T) {
+	recorder := &testEventRecorder{events: make(chan *models.Event)}
+	h := newTestHandler(t, &models.HandlerConfig{EventRecorder: recorder})
+	ctx, cancel := context.WithCancel(context.Background())
+	rw := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		h.EventStreamHandler(rw, httptest.NewRequest(http.MethodGet, "/api/events", nil).WithContext(ctx), &models.Preference{}, &models.User{UserID: "alice"}, nil)
+		close(done)
+	}()
+
+	recorder.events <- models.NewEvent(models.EventCategoryAdapter, "istio", &meshes.EventsResponse{Summary: "mTLS enabled"})
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("event stream was not closed once the request was done")
+	}
+	if body := rw.Body.String(); body != "" && !strings.Contains(body, "mTLS enabled") {
+		t.Errorf("stream is %q, want the event relayed", body)
+	}
+}
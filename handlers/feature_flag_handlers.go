@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route GET /api/system/features SystemAPI idGetFeatureFlags
+// Handle GET request for the feature flags
+//
+// Returns the feature flags of the experimental capabilities of Meshery Server as in effect for the
+// user, along with how they are set globally and by the user. Clients look a capability up here to
+// tell whether the server supports it before using it.
+// responses:
+// 	200: featureFlagsResponseWrapper
+
+// FeatureFlagsHandler returns the feature flags of the server as in effect for the user
+func (h *Handler) FeatureFlagsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	h.writeFeatureFlags(rw, user)
+}
+
+// swagger:route POST /api/system/features/{name} SystemAPI idPostFeatureFlag
+// Handle POST request to enable or disable a feature flag
+//
+// Enables or disables the experimental capability for the user or, with global, for every user who
+// did not set the flag themselves, returning the feature flags once set. Only the admins of Meshery
+// Server may set the flags globally.
+// responses:
+// 	200: featureFlagsResponseWrapper
+
+// FeatureFlagHandler enables or disables a feature flag for the user or globally
+func (h *Handler) FeatureFlagHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	name := mux.Vars(r)["name"]
+	if _, ok := models.FeatureFlagDefinitionByName(name); !ok {
+		err := ErrFeatureFlags(fmt.Errorf("unknown feature flag %s", name))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+	flagReq := &models.FeatureFlagRequest{}
+	if err := json.NewDecoder(r.Body).Decode(flagReq); err != nil {
+		h.log.Error(ErrDecoding(err, "feature flag"))
+		http.Error(rw, ErrDecoding(err, "feature flag").Error(), http.StatusBadRequest)
+		return
+	}
+
+	userID := user.UserID
+	if flagReq.Global {
+		if !h.isAdmin(user, provider) {
+			err := ErrNotAdmin("set the feature flags of every user")
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusForbidden)
+			return
+		}
+		userID = ""
+	}
+	if err := h.config.FeatureFlagPersister.SetFeatureFlag(name, userID, flagReq.Enabled); err != nil {
+		h.log.Error(ErrFeatureFlags(err))
+		http.Error(rw, ErrFeatureFlags(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeFeatureFlags(rw, user)
+}
+
+func (h *Handler) writeFeatureFlags(rw http.ResponseWriter, user *models.User) {
+	flags, err := h.config.FeatureFlagPersister.GetFeatureFlags(user.UserID)
+	if err != nil {
+		h.log.Error(ErrFeatureFlags(err))
+		http.Error(rw, ErrFeatureFlags(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(flags); err != nil {
+		h.log.Error(ErrEncoding(err, "feature flags"))
+		http.Error(rw, ErrEncoding(err, "feature flags").Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestFeatureFlagHandler(t *testing.T) {
+	persister := &models.FeatureFlagPersister{DB: newTestDatabase(t)}
+	h := newTestHandler(t, &models.HandlerConfig{FeatureFlagPersister: persister, Admins: []string{"root"}})
+	alice, bob, root := &models.User{UserID: "alice"}, &models.User{UserID: "bob"}, &models.User{UserID: "root"}
+
+	set := func(user *models.User, body string) int {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/api/system/features/"+models.FeatureGatewayAPI, strings.NewReader(body)), map[string]string{"name": models.FeatureGatewayAPI})
+		rw := httptest.NewRecorder()
+		h.FeatureFlagHandler(rw, req, nil, user, nil)
+		return rw.Code
+	}
+	enabled := func(user *models.User) bool {
+		on, err := persister.IsFeatureEnabled(models.FeatureGatewayAPI, user.UserID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return on
+	}
+
+	if code := set(alice, `{"enabled": false, "global": true}`); code != http.StatusForbidden {
+		t.Errorf("user disabling the flag globally got %d, want %d", code, http.StatusForbidden)
+	}
+	if !enabled(bob) {
+		t.Error("flag was disabled for every user by a user")
+	}
+
+	if code := set(alice, `{"enabled": false}`); code != http.StatusOK {
+		t.Errorf("user disabling the flag for themselves got %d, want %d", code, http.StatusOK)
+	}
+	if enabled(alice) || !enabled(bob) {
+		t.Error("flag disabled by a user for themselves is not disabled for them only")
+	}
+
+	if code := set(root, `{"enabled": false, "global": true}`); code != http.StatusOK {
+		t.Errorf("admin disabling the flag globally got %d, want %d", code, http.StatusOK)
+	}
+	if enabled(bob) {
+		t.Error("flag disabled globally by an admin is enabled for a user")
+	}
+}
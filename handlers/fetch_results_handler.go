@@ -2,11 +2,13 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
 	"github.com/gofrs/uuid"
 	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/helpers"
 	"github.com/layer5io/meshery/models"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
@@ -33,7 +35,7 @@ func (h *Handler) FetchResultsHandler(w http.ResponseWriter, req *http.Request,
 
 	tokenString := req.Context().Value(models.TokenCtxKey).(string)
 
-	bdr, err := p.FetchResults(tokenString, q.Get("page"), q.Get("pageSize"), q.Get("search"), q.Get("order"), profileID)
+	bdr, err := p.FetchResults(tokenString, q.Get("page"), q.Get("pageSize"), q.Get("search"), q.Get("order"), profileID, q.Get("owner"))
 	if err != nil {
 		http.Error(w, "error while getting load test results", http.StatusInternalServerError)
 		return
@@ -70,7 +72,7 @@ func (h *Handler) FetchAllResultsHandler(w http.ResponseWriter, req *http.Reques
 
 	tokenString := req.Context().Value(models.TokenCtxKey).(string)
 
-	bdr, err := p.FetchAllResults(tokenString, q.Get("page"), q.Get("pageSize"), q.Get("search"), q.Get("order"), q.Get("from"), q.Get("to"))
+	bdr, err := p.FetchAllResults(tokenString, q.Get("page"), q.Get("pageSize"), q.Get("search"), q.Get("order"), q.Get("from"), q.Get("to"), q.Get("owner"))
 	if err != nil {
 		http.Error(w, "error while getting load test results", http.StatusInternalServerError)
 		return
@@ -115,6 +117,40 @@ func (h *Handler) GetResultHandler(w http.ResponseWriter, req *http.Request, _ *
 		http.Error(w, "error while getting load test results", http.StatusInternalServerError)
 		return
 	}
+	// Results moved to the result archive are retrieved from it transparently
+	if _, archived := models.ArchivedResultOf(bdr); archived {
+		bdr, err = helpers.RestoreArchivedResult(req.Context(), h.config.ResultArchive, bdr)
+		if err != nil {
+			logrus.Error(err)
+			http.Error(w, "error while retrieving the archived load test result", http.StatusInternalServerError)
+			return
+		}
+	}
+	// The result as stored, including the configuration of the run, is returned
+	// when requested instead of its SMP representation
+	if req.URL.Query().Get("output") == "json" {
+		bdr.Annotations = h.resultAnnotations(id)
+		w.Header().Set("content-type", "application/json")
+		if err := json.NewEncoder(w).Encode(bdr); err != nil {
+			logrus.Error(ErrMarshal(err, "test result"))
+			http.Error(w, "error while getting test result", http.StatusInternalServerError)
+		}
+		return
+	}
+	// The latency histogram is returned as HdrHistogram percentile distribution
+	// for the tools reading .hgrm files
+	if req.URL.Query().Get("output") == "hgrm" {
+		b, err := helpers.HGRMFromResult(bdr.Result)
+		if err != nil {
+			logrus.Error(err)
+			http.Error(w, "the result holds no latency histogram", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("content-type", "text/plain")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="result_%s.hgrm"`, bdr.ID))
+		_, _ = w.Write(b)
+		return
+	}
 	sp, err := bdr.ConvertToSpec()
 	if err != nil {
 		logrus.Error(ErrConvertToSpec(err))
@@ -132,6 +168,65 @@ func (h *Handler) GetResultHandler(w http.ResponseWriter, req *http.Request, _ *
 	_, _ = w.Write(b)
 }
 
+// swagger:route GET /api/perf/results/anomalies PerfAPI idGetPerfResultAnomalies
+// Handle GET request for the anomalous performance results
+//
+// Returns the results whose p99 latency deviates from the rolling history of their profile by more than
+// the configured number of standard deviations, of the profile given by profile_id or of every profile
+// responses:
+// 	200: resultAnomaliesResponseWrapper
+
+// GetResultAnomaliesHandler returns the performance results found to be anomalies
+func (h *Handler) GetResultAnomaliesHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, user *models.User, p models.Provider) {
+	anomalies := []models.ResultAnalysis{}
+	if h.config.ResultAnalysisPersister != nil {
+		var err error
+		anomalies, err = h.config.ResultAnalysisPersister.GetAnomalies(req.URL.Query().Get("profile_id"))
+		if err != nil {
+			logrus.Error(ErrGetResultAnomalies(err))
+			http.Error(w, ErrGetResultAnomalies(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.Header().Set("content-type", "application/json")
+	if err := json.NewEncoder(w).Encode(anomalies); err != nil {
+		logrus.Error(ErrMarshal(err, "result anomalies"))
+		http.Error(w, ErrMarshal(err, "result anomalies").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/perf/checkpoints PerfAPI idGetPerfCheckpoints
+// Handle GET request for the checkpoints of a performance test
+//
+// Returns the aggregates persisted at every checkpoint of the test given by test_id, run with a
+// checkpoint interval, while it runs or after it completed or died
+// responses:
+// 	200: loadTestCheckpointsResponseWrapper
+
+// LoadTestCheckpointsHandler returns the checkpoints of a performance test
+func (h *Handler) LoadTestCheckpointsHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, user *models.User, p models.Provider) {
+	testID := req.URL.Query().Get("test_id")
+	if testID == "" {
+		http.Error(w, "test_id is required", http.StatusBadRequest)
+		return
+	}
+	checkpoints := []models.LoadTestCheckpoint{}
+	if h.config.LoadTestCheckpointPersister != nil {
+		var err error
+		checkpoints, err = h.config.LoadTestCheckpointPersister.GetLoadTestCheckpoints(testID)
+		if err != nil {
+			logrus.Error(ErrLoadTestCheckpoints(err))
+			http.Error(w, ErrLoadTestCheckpoints(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.Header().Set("content-type", "application/json")
+	if err := json.NewEncoder(w).Encode(checkpoints); err != nil {
+		logrus.Error(ErrMarshal(err, "load test checkpoints"))
+		http.Error(w, ErrMarshal(err, "load test checkpoints").Error(), http.StatusInternalServerError)
+	}
+}
+
 // GetSmiResultsHandler gets the results of all the smi conformance tests
 func (h *Handler) FetchSmiResultsHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, user *models.User, p models.Provider) {
 	w.Header().Set("content-type", "application/json")
@@ -0,0 +1,553 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/models"
+	meshsyncmodel "github.com/layer5io/meshsync/pkg/model"
+)
+
+// gatewayAPIKinds are the kinds discovered by MeshSync the Gateway API resources are built from,
+// Services being the backends of the routes
+var gatewayAPIKinds = []string{"GatewayClass", "Gateway", "HTTPRoute", "Service"}
+
+// swagger:route GET /api/system/meshsync/gateway GatewayAPI idGetGatewayAPIResources
+// Handle GET request for the Gateway API resources
+//
+// Returns the GatewayClasses, Gateways and HTTPRoutes discovered by MeshSync across the connected clusters,
+// or in the cluster of the given context, along with the invalid references and the conflicting routes
+// responses:
+// 	200: gatewayAPIResourcesRespWrapper
+
+// GatewayAPIResourcesHandler returns the Gateway API resources discovered by MeshSync and the problems of their configuration
+func (h *Handler) GatewayAPIResourcesHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	db := provider.GetGenericPersister()
+	if db == nil || db.DB == nil {
+		err := ErrGatewayAPI(fmt.Errorf("meshsync data is not available"))
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Name the clusters after the contexts connected to them, MeshSync identifying a cluster
+	// by the UID of its kube-system namespace
+	contexts := map[string]string{}
+	if token, ok := req.Context().Value(models.TokenCtxKey).(string); ok {
+		k8sContexts, err := provider.LoadAllK8sContext(token)
+		if err != nil {
+			h.log.Warn(ErrGatewayAPI(err))
+		}
+		for _, kc := range k8sContexts {
+			if kc.KubernetesServerID != nil {
+				contexts[kc.KubernetesServerID.String()] = kc.Name
+			}
+		}
+	}
+
+	query := db.
+		Preload("ObjectMeta").
+		Preload("Spec").
+		Preload("Status")
+	if name := req.URL.Query().Get("context"); name != "" {
+		clusterID := ""
+		for id, contextName := range contexts {
+			if contextName == name {
+				clusterID = id
+			}
+		}
+		if clusterID == "" {
+			err := ErrGatewayAPI(fmt.Errorf("context %s does not exist or is not connected", name))
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		query = query.Where("cluster_id = ?", clusterID)
+	}
+
+	objects := []meshsyncmodel.Object{}
+	if err := query.Find(&objects, "kind IN ?", gatewayAPIKinds).Error; err != nil {
+		h.log.Error(ErrGatewayAPI(err))
+		http.Error(w, ErrGatewayAPI(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.writeApplicationJSON(w, gatewayAPIResources(objects, contexts), "gateway api resources")
+}
+
+// The specs and statuses of the Gateway API resources, as stored by MeshSync
+
+type gatewayAPICondition struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+}
+
+type gatewayAPIStatus struct {
+	Conditions []gatewayAPICondition `json:"conditions"`
+	Addresses  []struct {
+		Value string `json:"value"`
+	} `json:"addresses"`
+}
+
+// condition returns whether the condition of the given types is true, nil when it is not reported
+func (s gatewayAPIStatus) condition(types ...string) *bool {
+	for _, t := range types {
+		for _, c := range s.Conditions {
+			if c.Type == t {
+				set := c.Status == "True"
+				return &set
+			}
+		}
+	}
+	return nil
+}
+
+type gatewayClassSpec struct {
+	ControllerName string `json:"controllerName"`
+}
+
+type gatewaySpec struct {
+	GatewayClassName string `json:"gatewayClassName"`
+	Listeners        []struct {
+		Name          string `json:"name"`
+		Hostname      string `json:"hostname"`
+		Port          int    `json:"port"`
+		Protocol      string `json:"protocol"`
+		AllowedRoutes struct {
+			Namespaces struct {
+				From string `json:"from"`
+			} `json:"namespaces"`
+		} `json:"allowedRoutes"`
+	} `json:"listeners"`
+	Addresses []struct {
+		Value string `json:"value"`
+	} `json:"addresses"`
+}
+
+type httpRouteSpec struct {
+	ParentRefs []struct {
+		Kind        string `json:"kind"`
+		Name        string `json:"name"`
+		Namespace   string `json:"namespace"`
+		SectionName string `json:"sectionName"`
+	} `json:"parentRefs"`
+	Hostnames []string `json:"hostnames"`
+	Rules     []struct {
+		Matches []struct {
+			Path *struct {
+				Type  string `json:"type"`
+				Value string `json:"value"`
+			} `json:"path"`
+			Method  string `json:"method"`
+			Headers []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"headers"`
+		} `json:"matches"`
+		BackendRefs []struct {
+			Kind      string `json:"kind"`
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+			Port      int    `json:"port"`
+			Weight    *int   `json:"weight"`
+		} `json:"backendRefs"`
+	} `json:"rules"`
+}
+
+type serviceSpec struct {
+	Ports []struct {
+		Port int `json:"port"`
+	} `json:"ports"`
+}
+
+// gatewayAPIResources builds the Gateway API resources from the objects discovered by MeshSync,
+// contexts naming the clusters by their ID, and validates them
+func gatewayAPIResources(objects []meshsyncmodel.Object, contexts map[string]string) *models.GatewayAPIResources {
+	resources := &models.GatewayAPIResources{
+		GatewayClasses: []models.GatewayClass{},
+		Gateways:       []models.Gateway{},
+		HTTPRoutes:     []models.HTTPRoute{},
+		Issues:         []models.GatewayAPIIssue{},
+		Conflicts:      []models.HTTPRouteConflict{},
+	}
+	// Ports of the Services, keyed by cluster, namespace and name
+	services := map[string][]int{}
+
+	for _, obj := range objects {
+		if obj.ObjectMeta == nil {
+			continue
+		}
+		cluster := models.GatewayAPICluster{ClusterID: obj.ClusterID, Context: contexts[obj.ClusterID]}
+		status := gatewayAPIStatus{}
+		if obj.Status != nil {
+			_ = json.Unmarshal([]byte(obj.Status.Attribute), &status)
+		}
+
+		switch obj.Kind {
+		case "GatewayClass":
+			spec := gatewayClassSpec{}
+			unmarshalSpec(obj, &spec)
+			resources.GatewayClasses = append(resources.GatewayClasses, models.GatewayClass{
+				GatewayAPICluster: cluster,
+				Name:              obj.ObjectMeta.Name,
+				ControllerName:    spec.ControllerName,
+				Accepted:          status.condition("Accepted"),
+			})
+		case "Gateway":
+			spec := gatewaySpec{}
+			unmarshalSpec(obj, &spec)
+			gateway := models.Gateway{
+				GatewayAPICluster: cluster,
+				Name:              obj.ObjectMeta.Name,
+				Namespace:         obj.ObjectMeta.Namespace,
+				ClassName:         spec.GatewayClassName,
+				Listeners:         []models.GatewayListener{},
+				Addresses:         []string{},
+				// Programmed replaced Ready in v0.7 of the Gateway API
+				Programmed: status.condition("Programmed", "Ready"),
+			}
+			for _, l := range spec.Listeners {
+				from := l.AllowedRoutes.Namespaces.From
+				if from == "" {
+					from = "Same"
+				}
+				gateway.Listeners = append(gateway.Listeners, models.GatewayListener{
+					Name:          l.Name,
+					Hostname:      l.Hostname,
+					Port:          l.Port,
+					Protocol:      l.Protocol,
+					AllowedRoutes: from,
+				})
+			}
+			for _, a := range status.Addresses {
+				gateway.Addresses = append(gateway.Addresses, a.Value)
+			}
+			if len(gateway.Addresses) == 0 {
+				for _, a := range spec.Addresses {
+					gateway.Addresses = append(gateway.Addresses, a.Value)
+				}
+			}
+			resources.Gateways = append(resources.Gateways, gateway)
+		case "HTTPRoute":
+			resources.HTTPRoutes = append(resources.HTTPRoutes, httpRoute(obj, cluster))
+		case "Service":
+			spec := serviceSpec{}
+			unmarshalSpec(obj, &spec)
+			ports := []int{}
+			for _, p := range spec.Ports {
+				ports = append(ports, p.Port)
+			}
+			services[gatewayAPIKey(obj.ClusterID, obj.ObjectMeta.Namespace, obj.ObjectMeta.Name)] = ports
+		}
+	}
+
+	sort.Slice(resources.GatewayClasses, func(i, j int) bool {
+		a, b := resources.GatewayClasses[i], resources.GatewayClasses[j]
+		return gatewayAPIKey(a.ClusterID, "", a.Name) < gatewayAPIKey(b.ClusterID, "", b.Name)
+	})
+	sort.Slice(resources.Gateways, func(i, j int) bool {
+		a, b := resources.Gateways[i], resources.Gateways[j]
+		return gatewayAPIKey(a.ClusterID, a.Namespace, a.Name) < gatewayAPIKey(b.ClusterID, b.Namespace, b.Name)
+	})
+	sort.Slice(resources.HTTPRoutes, func(i, j int) bool {
+		a, b := resources.HTTPRoutes[i], resources.HTTPRoutes[j]
+		return gatewayAPIKey(a.ClusterID, a.Namespace, a.Name) < gatewayAPIKey(b.ClusterID, b.Namespace, b.Name)
+	})
+
+	resources.Issues = validateGatewayAPIResources(resources, services)
+	resources.Conflicts = httpRouteConflicts(resources.HTTPRoutes)
+	return resources
+}
+
+func unmarshalSpec(obj meshsyncmodel.Object, spec interface{}) {
+	if obj.Spec != nil {
+		_ = json.Unmarshal([]byte(obj.Spec.Attribute), spec)
+	}
+}
+
+func gatewayAPIKey(clusterID, namespace, name string) string {
+	return clusterID + "/" + namespace + "/" + name
+}
+
+// httpRoute builds the HTTPRoute from its spec, applying the defaults of the Gateway API
+func httpRoute(obj meshsyncmodel.Object, cluster models.GatewayAPICluster) models.HTTPRoute {
+	spec := httpRouteSpec{}
+	unmarshalSpec(obj, &spec)
+
+	route := models.HTTPRoute{
+		GatewayAPICluster: cluster,
+		Name:              obj.ObjectMeta.Name,
+		Namespace:         obj.ObjectMeta.Namespace,
+		Hostnames:         spec.Hostnames,
+		Parents:           []models.GatewayRef{},
+		Rules:             []models.HTTPRouteRule{},
+		CreatedAt:         obj.ObjectMeta.CreationTimestamp,
+	}
+	if route.Hostnames == nil {
+		route.Hostnames = []string{}
+	}
+	for _, p := range spec.ParentRefs {
+		if p.Kind != "" && p.Kind != "Gateway" {
+			continue
+		}
+		ref := models.GatewayRef{Name: p.Name, Namespace: p.Namespace, SectionName: p.SectionName}
+		if ref.Namespace == "" {
+			ref.Namespace = route.Namespace
+		}
+		route.Parents = append(route.Parents, ref)
+	}
+	for _, r := range spec.Rules {
+		rule := models.HTTPRouteRule{Matches: []models.HTTPRouteMatch{}, Backends: []models.HTTPRouteBackend{}}
+		for _, m := range r.Matches {
+			match := models.HTTPRouteMatch{PathType: "PathPrefix", PathValue: "/", Method: m.Method}
+			if m.Path != nil {
+				if m.Path.Type != "" {
+					match.PathType = m.Path.Type
+				}
+				if m.Path.Value != "" {
+					match.PathValue = m.Path.Value
+				}
+			}
+			if len(m.Headers) > 0 {
+				match.Headers = map[string]string{}
+				for _, header := range m.Headers {
+					match.Headers[strings.ToLower(header.Name)] = header.Value
+				}
+			}
+			rule.Matches = append(rule.Matches, match)
+		}
+		// A rule without matches matches every request
+		if len(rule.Matches) == 0 {
+			rule.Matches = append(rule.Matches, models.HTTPRouteMatch{PathType: "PathPrefix", PathValue: "/"})
+		}
+		for _, b := range r.BackendRefs {
+			backend := models.HTTPRouteBackend{Kind: b.Kind, Name: b.Name, Namespace: b.Namespace, Port: b.Port, Weight: b.Weight}
+			if backend.Kind == "" {
+				backend.Kind = "Service"
+			}
+			if backend.Namespace == "" {
+				backend.Namespace = route.Namespace
+			}
+			rule.Backends = append(rule.Backends, backend)
+		}
+		route.Rules = append(route.Rules, rule)
+	}
+	return route
+}
+
+// validateGatewayAPIResources returns the references to resources which do not exist, the routes
+// not allowed to attach to their Gateways and the resources their controllers did not accept
+func validateGatewayAPIResources(resources *models.GatewayAPIResources, services map[string][]int) []models.GatewayAPIIssue {
+	issues := []models.GatewayAPIIssue{}
+
+	classes := map[string]bool{}
+	for _, class := range resources.GatewayClasses {
+		classes[gatewayAPIKey(class.ClusterID, "", class.Name)] = true
+		if class.Accepted != nil && !*class.Accepted {
+			issues = append(issues, models.GatewayAPIIssue{GatewayAPICluster: class.GatewayAPICluster, Kind: "GatewayClass", Name: class.Name,
+				Severity: models.GatewayAPIIssueWarning, Message: fmt.Sprintf("not accepted by its controller %s", class.ControllerName)})
+		}
+	}
+
+	gateways := map[string]models.Gateway{}
+	for _, gateway := range resources.Gateways {
+		gateways[gatewayAPIKey(gateway.ClusterID, gateway.Namespace, gateway.Name)] = gateway
+		issue := models.GatewayAPIIssue{GatewayAPICluster: gateway.GatewayAPICluster, Kind: "Gateway", Name: gateway.Name, Namespace: gateway.Namespace}
+
+		if !classes[gatewayAPIKey(gateway.ClusterID, "", gateway.ClassName)] {
+			issue.Severity, issue.Message = models.GatewayAPIIssueError, fmt.Sprintf("GatewayClass %s does not exist", gateway.ClassName)
+			issues = append(issues, issue)
+		}
+		if gateway.Programmed != nil && !*gateway.Programmed {
+			issue.Severity, issue.Message = models.GatewayAPIIssueWarning, "not programmed by the controller of its class"
+			issues = append(issues, issue)
+		}
+		listeners := map[string]bool{}
+		for _, l := range gateway.Listeners {
+			if listeners[l.Name] {
+				issue.Severity, issue.Message = models.GatewayAPIIssueError, fmt.Sprintf("listener %s is defined more than once", l.Name)
+				issues = append(issues, issue)
+			}
+			listeners[l.Name] = true
+		}
+	}
+
+	for _, route := range resources.HTTPRoutes {
+		issue := models.GatewayAPIIssue{GatewayAPICluster: route.GatewayAPICluster, Kind: "HTTPRoute", Name: route.Name, Namespace: route.Namespace}
+		report := func(severity, format string, args ...interface{}) {
+			issue.Severity, issue.Message = severity, fmt.Sprintf(format, args...)
+			issues = append(issues, issue)
+		}
+
+		if len(route.Parents) == 0 {
+			report(models.GatewayAPIIssueWarning, "not attached to any Gateway")
+		}
+		for _, parent := range route.Parents {
+			gateway, ok := gateways[gatewayAPIKey(route.ClusterID, parent.Namespace, parent.Name)]
+			if !ok {
+				report(models.GatewayAPIIssueError, "Gateway %s/%s does not exist", parent.Namespace, parent.Name)
+				continue
+			}
+			listeners := []models.GatewayListener{}
+			for _, l := range gateway.Listeners {
+				if parent.SectionName == "" || l.Name == parent.SectionName {
+					listeners = append(listeners, l)
+				}
+			}
+			if len(listeners) == 0 {
+				report(models.GatewayAPIIssueError, "Gateway %s/%s has no listener %s", parent.Namespace, parent.Name, parent.SectionName)
+				continue
+			}
+
+			allowed := []models.GatewayListener{}
+			for _, l := range listeners {
+				// Namespaces selected by labels are not checked
+				if l.AllowedRoutes != "Same" || gateway.Namespace == route.Namespace {
+					allowed = append(allowed, l)
+				}
+			}
+			if len(allowed) == 0 {
+				report(models.GatewayAPIIssueError, "not allowed to attach to Gateway %s/%s, whose listeners only accept routes of namespace %s", parent.Namespace, parent.Name, gateway.Namespace)
+				continue
+			}
+			if !hostnamesIntersect(route.Hostnames, allowed) {
+				report(models.GatewayAPIIssueWarning, "none of the hostnames %s match the listeners of Gateway %s/%s", strings.Join(route.Hostnames, ", "), parent.Namespace, parent.Name)
+			}
+		}
+
+		for _, rule := range route.Rules {
+			for _, backend := range rule.Backends {
+				if backend.Kind != "Service" {
+					continue
+				}
+				ports, ok := services[gatewayAPIKey(route.ClusterID, backend.Namespace, backend.Name)]
+				if !ok {
+					report(models.GatewayAPIIssueError, "backend Service %s/%s does not exist", backend.Namespace, backend.Name)
+					continue
+				}
+				if backend.Port != 0 && !containsPort(ports, backend.Port) {
+					report(models.GatewayAPIIssueError, "backend Service %s/%s does not expose port %d", backend.Namespace, backend.Name, backend.Port)
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// hostnamesIntersect tells if the route, given its hostnames, receives traffic from any of the listeners
+func hostnamesIntersect(hostnames []string, listeners []models.GatewayListener) bool {
+	if len(hostnames) == 0 {
+		return true
+	}
+	for _, l := range listeners {
+		if l.Hostname == "" {
+			return true
+		}
+		for _, hostname := range hostnames {
+			if hostnameMatches(hostname, l.Hostname) || hostnameMatches(l.Hostname, hostname) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hostnameMatches tells if the hostname matches the pattern, which is either a hostname or a
+// wildcard matching the subdomains of a domain, e.g. *.example.com
+func hostnameMatches(hostname, pattern string) bool {
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.HasSuffix(hostname, pattern[1:])
+	}
+	return hostname == pattern
+}
+
+func containsPort(ports []int, port int) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+// httpRouteConflicts returns the matches claimed by several routes for the same hostname of the
+// same Gateway. Routes without hostnames claim every hostname, given as *, and overlapping wildcards
+// are not considered. The oldest route wins, then the first in alphabetical order.
+func httpRouteConflicts(routes []models.HTTPRoute) []models.HTTPRouteConflict {
+	type claim struct {
+		cluster  models.GatewayAPICluster
+		gateway  models.GatewayRef
+		hostname string
+		match    string
+	}
+	claims := map[claim][]models.HTTPRoute{}
+	var order []claim
+
+	for _, route := range routes {
+		hostnames := route.Hostnames
+		if len(hostnames) == 0 {
+			hostnames = []string{"*"}
+		}
+		seen := map[claim]bool{}
+		for _, parent := range route.Parents {
+			for _, hostname := range hostnames {
+				for _, rule := range route.Rules {
+					for _, match := range rule.Matches {
+						c := claim{
+							cluster:  route.GatewayAPICluster,
+							gateway:  models.GatewayRef{Name: parent.Name, Namespace: parent.Namespace},
+							hostname: hostname,
+							match:    httpRouteMatchString(match),
+						}
+						if seen[c] {
+							continue
+						}
+						seen[c] = true
+						if _, ok := claims[c]; !ok {
+							order = append(order, c)
+						}
+						claims[c] = append(claims[c], route)
+					}
+				}
+			}
+		}
+	}
+
+	conflicts := []models.HTTPRouteConflict{}
+	for _, c := range order {
+		claimants := claims[c]
+		if len(claimants) < 2 {
+			continue
+		}
+		sort.SliceStable(claimants, func(i, j int) bool {
+			a, b := claimants[i], claimants[j]
+			if a.CreatedAt != b.CreatedAt && a.CreatedAt != "" && b.CreatedAt != "" {
+				return a.CreatedAt < b.CreatedAt
+			}
+			return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+		})
+		conflict := models.HTTPRouteConflict{GatewayAPICluster: c.cluster, Gateway: c.gateway, Hostname: c.hostname, Match: c.match}
+		for _, route := range claimants {
+			conflict.Routes = append(conflict.Routes, route.Namespace+"/"+route.Name)
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	return conflicts
+}
+
+// httpRouteMatchString returns the match in a canonical form, e.g. PathPrefix /api method=GET header:x-env=canary
+func httpRouteMatchString(match models.HTTPRouteMatch) string {
+	parts := []string{match.PathType + " " + match.PathValue}
+	if match.Method != "" {
+		parts = append(parts, "method="+match.Method)
+	}
+	headers := make([]string, 0, len(match.Headers))
+	for name, value := range match.Headers {
+		headers = append(headers, "header:"+name+"="+value)
+	}
+	sort.Strings(headers)
+	return strings.Join(append(parts, headers...), " ")
+}
@@ -15,6 +15,7 @@ type Handler struct {
 	meshsyncChannel chan struct{}
 	log             logger.Handler
 	brokerConn      broker.Handler
+	workloadTokens  *workloadTokenCache
 }
 
 // NewHandlerInstance returns a Handler instance
@@ -29,6 +30,7 @@ func NewHandlerInstance(
 		meshsyncChannel: meshSyncCh,
 		log:             logger,
 		brokerConn:      brokerConn,
+		workloadTokens:  newWorkloadTokenCache(),
 	}
 
 	h.task = taskq.RegisterTask(&taskq.TaskOptions{
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+)
+
+// newTestHandler returns a Handler of the given config, logging nothing
+func newTestHandler(t *testing.T, config *models.HandlerConfig) *Handler {
+	t.Helper()
+	log, err := logger.New("test", logger.Options{Output: io.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{config: config, log: log, workloadTokens: newWorkloadTokenCache()}
+}
+
+// newTestDatabase returns a database migrated to the latest schema, removed once the test completes
+func newTestDatabase(t *testing.T) *database.Handler {
+	t.Helper()
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s?cache=private&mode=rwc", filepath.Join(t.TempDir(), "mesherydb.sql")),
+		Engine:   database.SQLITE,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.DBClose() })
+	if _, err := models.NewSchemaMigrator(&db, models.SchemaMigrations).Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+	return &db
+}
+
+const testPatternID = "2c4b1f0e-8a3d-4f6b-9c2e-7d5a1b3e4f60"
+
+// newTestLocalProvider returns a local provider persisting to a database of its own
+func newTestLocalProvider(t *testing.T) *models.DefaultLocalProvider {
+	t.Helper()
+	db := newTestDatabase(t)
+	return &models.DefaultLocalProvider{
+		MesheryPatternPersister:       &models.MesheryPatternPersister{DB: db},
+		PatternCollaborationPersister: &models.PatternCollaborationPersister{DB: db},
+		GenericPersister:              *db,
+	}
+}
+
+// saveTestPattern saves the pattern of ID testPatternID with the given pattern file
+func saveTestPattern(t *testing.T, provider *models.DefaultLocalProvider, patternFile string) *models.MesheryPattern {
+	t.Helper()
+	id := uuid.FromStringOrNil(testPatternID)
+	pattern := &models.MesheryPattern{ID: &id, Name: "bookinfo", PatternFile: patternFile}
+	if _, err := provider.MesheryPatternPersister.SaveMesheryPattern(pattern); err != nil {
+		t.Fatal(err)
+	}
+	return pattern
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshkit/errors"
+)
+
+// swagger:route GET /api/system/jobs SystemAPI idGetJobs
+// Handle GET request for the background jobs of Meshery Server
+//
+// Returns the jobs Meshery Server runs in the background, the MeshSync resyncs, the performance tests,
+// the deployments of designs, scheduled or not, and the archival and purge of the results and of the
+// trash, along with the last ones completed. The running jobs are listed first, the most recent first.
+// The admins of Meshery Server are returned every job, the other users their own jobs.
+// responses:
+// 	200: jobsResponseWrapper
+
+// JobsHandler returns the background jobs of the server
+func (h *Handler) JobsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	q := r.URL.Query()
+	filter := models.JobFilter{Kind: q.Get("kind"), Status: models.JobStatus(q.Get("status"))}
+	switch filter.Status {
+	case "", models.JobRunning, models.JobCancelling, models.JobSucceeded, models.JobFailed, models.JobCancelled:
+	default:
+		http.Error(rw, fmt.Sprintf("invalid status %q, expected running, cancelling, succeeded, failed or cancelled", filter.Status), http.StatusBadRequest)
+		return
+	}
+	if q.Get("mine") == "true" || !h.isAdmin(user, provider) {
+		filter.UserID = user.UserID
+	}
+
+	jobs := []models.Job{}
+	if h.config.Jobs != nil {
+		jobs = h.config.Jobs.List(filter)
+	}
+	h.writeJobJSON(rw, &models.JobsAPIResponse{Jobs: jobs})
+}
+
+// swagger:route GET /api/system/jobs/{id} SystemAPI idGetJob
+// Handle GET request for a background job of Meshery Server
+//
+// Returns the job of the given ID, its status, progress and the outcome of the job once completed. The
+// jobs of the server and of the other users are not found but for the admins of Meshery Server.
+// responses:
+// 	200: jobResponseWrapper
+
+// GetJobHandler returns a background job of the server
+func (h *Handler) GetJobHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	id := mux.Vars(r)["id"]
+	if h.config.Jobs == nil {
+		http.Error(rw, models.ErrJobNotFound(id).Error(), http.StatusNotFound)
+		return
+	}
+	job, err := h.config.Jobs.Get(id)
+	if err == nil && job.UserID != user.UserID && !h.isAdmin(user, provider) {
+		err = models.ErrJobNotFound(id)
+	}
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+	h.writeJobJSON(rw, job)
+}
+
+// swagger:route POST /api/system/jobs/{id}/cancel SystemAPI idPostCancelJob
+// Handle POST request to cancel a background job of Meshery Server
+//
+// Asks the running job of the given ID to stop. Users cancel their own jobs, the admins of Meshery Server
+// those of every user and of the server. The MeshSync resyncs cannot be cancelled once started, the
+// performance tests stop early with the results collected until then.
+// responses:
+// 	200: jobResponseWrapper
+
+// CancelJobHandler cancels a running background job of the server
+func (h *Handler) CancelJobHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	id := mux.Vars(r)["id"]
+	if h.config.Jobs == nil {
+		http.Error(rw, models.ErrJobNotFound(id).Error(), http.StatusNotFound)
+		return
+	}
+	job, err := h.config.Jobs.Get(id)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+	if job.UserID != user.UserID && !h.isAdmin(user, provider) {
+		if job.UserID == "" {
+			err := ErrNotAdmin("cancel the jobs of the server")
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusForbidden)
+			return
+		}
+		http.Error(rw, fmt.Sprintf("the job %s runs for another user", id), http.StatusForbidden)
+		return
+	}
+
+	job, err = h.config.Jobs.Cancel(id)
+	if err != nil {
+		h.log.Error(err)
+		status := http.StatusConflict
+		if errors.GetCode(err) == models.ErrJobNotFoundCode {
+			status = http.StatusNotFound
+		}
+		http.Error(rw, err.Error(), status)
+		return
+	}
+	h.log.Info(fmt.Sprintf("Job %s (%s) cancelled by %s", job.ID, job.Name, user.UserID))
+	h.writeJobJSON(rw, job)
+}
+
+// startJob tracks the job, whose reports are ignored when the jobs are not tracked
+func (h *Handler) startJob(ctx context.Context, kind, name, userID string, cancellable bool) models.JobReporter {
+	if h.config.Jobs == nil {
+		return models.UntrackedJob(ctx)
+	}
+	return h.config.Jobs.Start(ctx, kind, name, userID, cancellable)
+}
+
+func (h *Handler) writeJobJSON(rw http.ResponseWriter, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		obj := "jobs"
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestJobHandlers(t *testing.T) {
+	jobs := helpers.NewJobTracker(10)
+	h := newTestHandler(t, &models.HandlerConfig{Jobs: jobs, Admins: []string{"root"}})
+	alice, bob, root := &models.User{UserID: "alice"}, &models.User{UserID: "bob"}, &models.User{UserID: "root"}
+
+	test := jobs.Start(context.Background(), models.JobPerformanceTest, "istio soak", alice.UserID, true)
+	deployment := jobs.Start(context.Background(), models.JobDesignDeployment, "Deploy of design bookinfo", bob.UserID, true)
+	archive := jobs.Start(context.Background(), models.JobResultArchive, "Archive the performance results to s3", "", true)
+	ids := map[models.JobReporter]string{}
+	for _, job := range jobs.List(models.JobFilter{}) {
+		switch job.UserID {
+		case alice.UserID:
+			ids[test] = job.ID
+		case bob.UserID:
+			ids[deployment] = job.ID
+		default:
+			ids[archive] = job.ID
+		}
+	}
+
+	list := func(user *models.User, query string) map[string]bool {
+		rw := httptest.NewRecorder()
+		h.JobsHandler(rw, httptest.NewRequest(http.MethodGet, "/api/system/jobs"+query, nil), nil, user, nil)
+		resp := &models.JobsAPIResponse{}
+		if err := json.NewDecoder(rw.Body).Decode(resp); err != nil {
+			t.Fatal(err)
+		}
+		listed := map[string]bool{}
+		for _, job := range resp.Jobs {
+			listed[job.ID] = true
+		}
+		return listed
+	}
+	get := func(user *models.User, job models.JobReporter) int {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/system/jobs/"+ids[job], nil), map[string]string{"id": ids[job]})
+		rw := httptest.NewRecorder()
+		h.GetJobHandler(rw, req, nil, user, nil)
+		return rw.Code
+	}
+	cancel := func(user *models.User, job models.JobReporter) int {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/api/system/jobs/"+ids[job]+"/cancel", nil), map[string]string{"id": ids[job]})
+		rw := httptest.NewRecorder()
+		h.CancelJobHandler(rw, req, nil, user, nil)
+		return rw.Code
+	}
+
+	t.Run("users list their own jobs", func(t *testing.T) {
+		if listed := list(alice, ""); len(listed) != 1 || !listed[ids[test]] {
+			t.Errorf("alice is listed the jobs %v, want their performance test only", listed)
+		}
+		if code := get(alice, deployment); code != http.StatusNotFound {
+			t.Errorf("alice getting the job of bob got %d, want %d", code, http.StatusNotFound)
+		}
+		if code := get(alice, archive); code != http.StatusNotFound {
+			t.Errorf("alice getting the job of the server got %d, want %d", code, http.StatusNotFound)
+		}
+		if code := get(alice, test); code != http.StatusOK {
+			t.Errorf("alice getting their job got %d, want %d", code, http.StatusOK)
+		}
+	})
+
+	t.Run("admins list every job", func(t *testing.T) {
+		if listed := list(root, ""); len(listed) != 3 {
+			t.Errorf("admin is listed the jobs %v, want the 3 jobs", listed)
+		}
+		if listed := list(root, "?mine=true"); len(listed) != 0 {
+			t.Errorf("admin is listed the jobs %v run for them, want none", listed)
+		}
+		if code := get(root, archive); code != http.StatusOK {
+			t.Errorf("admin getting the job of the server got %d, want %d", code, http.StatusOK)
+		}
+	})
+
+	t.Run("only the admins cancel the jobs of the server", func(t *testing.T) {
+		if code := cancel(alice, archive); code != http.StatusForbidden {
+			t.Errorf("alice cancelling the job of the server got %d, want %d", code, http.StatusForbidden)
+		}
+		if code := cancel(alice, deployment); code != http.StatusForbidden {
+			t.Errorf("alice cancelling the job of bob got %d, want %d", code, http.StatusForbidden)
+		}
+		if archive.Context().Err() != nil || deployment.Context().Err() != nil {
+			t.Error("jobs were cancelled by a user they do not run for")
+		}
+		if code := cancel(root, archive); code != http.StatusOK {
+			t.Errorf("admin cancelling the job of the server got %d, want %d", code, http.StatusOK)
+		}
+		if code := cancel(root, deployment); code != http.StatusOK {
+			t.Errorf("admin cancelling the job of bob got %d, want %d", code, http.StatusOK)
+		}
+	})
+
+	t.Run("performance tests are cancelled", func(t *testing.T) {
+		if code := cancel(alice, test); code != http.StatusOK {
+			t.Fatalf("alice cancelling their performance test got %d, want %d", code, http.StatusOK)
+		}
+		if test.Context().Err() == nil {
+			t.Error("context of the cancelled performance test is not done")
+		}
+	})
+}
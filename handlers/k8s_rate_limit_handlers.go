@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:route GET /api/system/kubernetes/contexts/{id}/rate-limit SystemAPI idGetK8sRateLimit
+// Handle GET request for the rate limit of a Kubernetes connection
+//
+// Returns the rate limit of the requests Meshery Server sends to the Kubernetes API of the connection,
+// along with the requests it held since the server started
+// responses:
+// 	200: k8sRateLimitResponseWrapper
+
+// K8sRateLimitHandler returns the rate limit of the connection along with the requests it held
+func (h *Handler) K8sRateLimitHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	token, ok := r.Context().Value(models.TokenCtxKey).(string)
+	if !ok {
+		http.Error(rw, "failed to get token", http.StatusInternalServerError)
+		return
+	}
+
+	kc, err := provider.GetK8sContext(token, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrK8sRateLimit(err))
+		http.Error(rw, ErrK8sRateLimit(err).Error(), http.StatusNotFound)
+		return
+	}
+	h.writeK8sRateLimit(rw, kc)
+}
+
+// swagger:route POST /api/system/kubernetes/contexts/{id}/rate-limit SystemAPI idPostK8sRateLimit
+// Handle POST request to set the rate limit of a Kubernetes connection
+//
+// Sets the queries per second and the burst of the requests Meshery Server sends to the Kubernetes API
+// of the connection, zero restoring the default, and returns the rate limit once set. The requests in
+// flight keep the rate limit they started with.
+// responses:
+// 	200: k8sRateLimitResponseWrapper
+
+// SetK8sRateLimitHandler sets the rate limit of the connection
+func (h *Handler) SetK8sRateLimitHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	token, ok := r.Context().Value(models.TokenCtxKey).(string)
+	if !ok {
+		http.Error(rw, "failed to get token", http.StatusInternalServerError)
+		return
+	}
+
+	limit := models.K8sRateLimit{}
+	if err := json.NewDecoder(r.Body).Decode(&limit); err != nil {
+		h.log.Error(ErrDecoding(err, "rate limit"))
+		http.Error(rw, ErrDecoding(err, "rate limit").Error(), http.StatusBadRequest)
+		return
+	}
+	if err := limit.Validate(); err != nil {
+		h.log.Error(ErrK8sRateLimit(err))
+		http.Error(rw, ErrK8sRateLimit(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	prev, err := provider.GetK8sContext(token, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrK8sRateLimit(err))
+		http.Error(rw, ErrK8sRateLimit(err).Error(), http.StatusNotFound)
+		return
+	}
+	kc := prev
+	kc.QPS, kc.Burst = limit.QPS, limit.Burst
+	saved, err := saveK8sContext(token, provider, prev, kc)
+	if err != nil {
+		h.log.Error(ErrK8sRateLimit(err))
+		http.Error(rw, ErrK8sRateLimit(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeK8sRateLimit(rw, saved)
+}
+
+func (h *Handler) writeK8sRateLimit(rw http.ResponseWriter, kc models.K8sContext) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(models.K8sThrottling.Status(kc)); err != nil {
+		h.log.Error(ErrEncoding(err, "rate limit"))
+		http.Error(rw, ErrEncoding(err, "rate limit").Error(), http.StatusInternalServerError)
+	}
+}
+
+// saveK8sContext replaces the connection with its updated settings, as the providers only save
+// the connections they do not have. The previous connection is restored if the update cannot be saved.
+func saveK8sContext(token string, provider models.Provider, prev, kc models.K8sContext) (models.K8sContext, error) {
+	if _, err := provider.DeleteK8sContext(token, prev.ID); err != nil {
+		return prev, err
+	}
+	saved, err := provider.SaveK8sContext(token, kc)
+	if err != nil {
+		if _, rerr := provider.SaveK8sContext(token, prev); rerr != nil {
+			logrus.Error("failed to restore context ", prev.Name, ": ", rerr)
+		}
+		return prev, err
+	}
+	return saved, nil
+}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"path/filepath"
+	"strings"
 
 	// for GKE kube API authentication
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -46,7 +47,10 @@ func (h *Handler) K8SConfigHandler(w http.ResponseWriter, req *http.Request, pre
 // swagger:route POST /api/system/kubernetes SystemAPI idPostK8SConfig
 // Handle POST request for Kubernetes Config
 //
-// Used to add kubernetes config to System
+// Used to add kubernetes config to System. The contexts form value selects, as a comma separated list,
+// the contexts which become connections, every context of the kubeconfig if empty. With resync set to
+// true, the connections already registered for the contexts are updated with the credentials of the
+// kubeconfig instead of being duplicated when the credentials rotate.
 // responses:
 // 	200: k8sConfigRespWrapper
 
@@ -89,9 +93,59 @@ func (h *Handler) addK8SConfig(user *models.User, prefObj *models.Preference, w
 		return
 	}
 
-	contexts := models.K8sContextsFromKubeconfig(k8sConfigBytes, mid)
+	// Only the contexts selected become connections, every context of the kubeconfig if none is
+	names := splitK8sContextNames(req.FormValue("contexts"))
+	if len(names) > 0 {
+		available, err := models.KubeconfigContextNames(k8sConfigBytes)
+		if err != nil {
+			err = ErrKubeconfigContexts([]string{err.Error()})
+			logrus.Error(err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if unknown := unknownK8sContextNames(names, available); len(unknown) > 0 {
+			err := ErrUnknownKubeconfigContexts(unknown, available)
+			logrus.Error(err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	contexts, ctxErrs := models.K8sContextsFromKubeconfigWithPolicy(req.Context(), k8sConfigBytes, mid, kubeconfigExecPolicy(), names...)
+	if len(contexts) == 0 && len(ctxErrs) > 0 {
+		err := ErrKubeconfigContexts(k8sContextErrorReasons(ctxErrs))
+		logrus.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// With resync, the connections already registered for the contexts take the rotated credentials
+	// rather than being duplicated
+	var existing []*models.K8sContext
+	resync := req.FormValue("resync") == "true"
+	if resync {
+		existing, err = provider.LoadAllK8sContext(token)
+		if err != nil {
+			err = ErrResyncK8sContext(err, "")
+			logrus.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	for i, ctx := range contexts {
+		if prev := matchingK8sContext(existing, ctx); prev != nil {
+			synced, err := resyncK8sContext(token, provider, *prev, ctx)
+			if err != nil {
+				err = ErrResyncK8sContext(err, ctx.Name)
+				logrus.Error(err)
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			contexts[i] = synced
+			continue
+		}
 
-	for _, ctx := range contexts {
 		_, err := provider.SaveK8sContext(token, ctx) // Ignore errors
 		if err != nil {
 			logrus.Error("failed to persist context")
@@ -105,6 +159,76 @@ func (h *Handler) addK8SConfig(user *models.User, prefObj *models.Preference, w
 	}
 }
 
+// splitK8sContextNames returns the names of the comma separated list of contexts
+func splitK8sContextNames(list string) []string {
+	names := []string{}
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// unknownK8sContextNames returns the names which are not among the contexts available
+func unknownK8sContextNames(names, available []string) []string {
+	unknown := []string{}
+	for _, name := range names {
+		found := false
+		for _, a := range available {
+			if a == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+// matchingK8sContext returns the connection registered by this Meshery instance for the same
+// context of the same cluster, nil if there is none
+func matchingK8sContext(existing []*models.K8sContext, kc models.K8sContext) *models.K8sContext {
+	for _, prev := range existing {
+		if prev.Name != kc.Name || prev.KubernetesServerID == nil || kc.KubernetesServerID == nil {
+			continue
+		}
+		if *prev.KubernetesServerID != *kc.KubernetesServerID {
+			continue
+		}
+		if prev.MesheryInstanceID != nil && kc.MesheryInstanceID != nil && *prev.MesheryInstanceID != *kc.MesheryInstanceID {
+			continue
+		}
+		return prev
+	}
+	return nil
+}
+
+// resyncK8sContext replaces the cluster and credentials of the connection with those of the context,
+// keeping its ID so that whatever refers to the connection still does. The previous connection is
+// restored if the new one cannot be saved.
+func resyncK8sContext(token string, provider models.Provider, prev, kc models.K8sContext) (models.K8sContext, error) {
+	if kc.ID == prev.ID {
+		return prev, nil
+	}
+	kc.ID = prev.ID
+	kc.IsCurrentContext = prev.IsCurrentContext
+
+	if _, err := provider.DeleteK8sContext(token, prev.ID); err != nil {
+		return prev, err
+	}
+	saved, err := provider.SaveK8sContext(token, kc)
+	if err != nil {
+		if _, rerr := provider.SaveK8sContext(token, prev); rerr != nil {
+			logrus.Error("failed to restore context ", prev.Name, ": ", rerr)
+		}
+		return prev, err
+	}
+	return saved, nil
+}
+
 // swagger:route DELETE /api/system/kubernetes SystemAPI idDeleteK8SConfig
 // Handle DELETE request for Kubernetes Config
 //
@@ -162,7 +286,13 @@ func (h *Handler) GetContextsFromK8SConfig(w http.ResponseWriter, req *http.Requ
 		return
 	}
 
-	contexts := models.K8sContextsFromKubeconfig(k8sConfigBytes, mid)
+	contexts, ctxErrs := models.K8sContextsFromKubeconfigWithPolicy(req.Context(), k8sConfigBytes, mid, kubeconfigExecPolicy())
+	if len(contexts) == 0 && len(ctxErrs) > 0 {
+		err := ErrKubeconfigContexts(k8sContextErrorReasons(ctxErrs))
+		logrus.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	err = json.NewEncoder(w).Encode(contexts)
 	if err != nil {
@@ -231,6 +361,38 @@ func (h *Handler) KubernetesPingHandler(w http.ResponseWriter, req *http.Request
 	}
 }
 
+// kubeconfigExecPolicy returns the policy applied to the uploaded kubeconfigs whose users authenticate
+// with an exec credential plugin
+func kubeconfigExecPolicy() models.ExecPluginPolicy {
+	commands := []string{}
+	for _, c := range strings.Split(viper.GetString("KUBECONFIG_EXEC_COMMANDS"), ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			commands = append(commands, c)
+		}
+	}
+	return models.ExecPluginPolicy{
+		Policy:          strings.ToLower(viper.GetString("KUBECONFIG_EXEC_PLUGINS")),
+		Commands:        commands,
+		ClusterRole:     viper.GetString("KUBECONFIG_EXEC_CLUSTER_ROLE"),
+		TokenExpiration: viper.GetDuration("KUBECONFIG_EXEC_TOKEN_EXPIRATION"),
+	}
+}
+
+func k8sContextErrorReasons(ctxErrs []models.K8sContextError) []string {
+	reasons := []string{}
+	for _, e := range ctxErrs {
+		reason := e.Error
+		if e.Name != "" {
+			reason = fmt.Sprintf("context %s: %s", e.Name, reason)
+		}
+		if e.Remedy != "" {
+			reason += " (" + e.Remedy + ")"
+		}
+		reasons = append(reasons, reason)
+	}
+	return reasons
+}
+
 func (h *Handler) GetCurrentContext(token string, prov models.Provider) (*models.K8sContext, error) {
 	// Try to get current context
 	cc, err := prov.GetCurrentContext(token)
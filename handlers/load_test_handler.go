@@ -8,8 +8,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"fortio.org/fortio/periodic"
@@ -21,6 +24,7 @@ import (
 	SMP "github.com/layer5io/service-mesh-performance/spec"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -72,8 +76,9 @@ func (h *Handler) LoadTestUsingSMPHandler(w http.ResponseWriter, req *http.Reque
 	meshName := SMP.ServiceMesh_Type_name[int32(meshType)]
 
 	profileID := perfTest.Config.Id
-
-	loadTestOptions := &models.LoadTestOptions{}
+	if profileID == "" {
+		profileID = mux.Vars(req)["id"]
+	}
 
 	testDuration, err := time.ParseDuration(perfTest.Config.Duration)
 	if err != nil {
@@ -81,44 +86,77 @@ func (h *Handler) LoadTestUsingSMPHandler(w http.ResponseWriter, req *http.Reque
 		http.Error(w, ErrParseDuration.Error(), http.StatusBadRequest)
 		return
 	}
-	loadTestOptions.Duration = testDuration
-	if loadTestOptions.Duration.Seconds() <= 0 {
-		loadTestOptions.Duration = time.Second
+	if testDuration.Seconds() <= 0 {
+		testDuration = time.Second
 	}
 
-	// TODO: check multiple clients in case of distributed perf test
-	testClient := perfTest.Config.Clients[0]
+	// Every client of the test configuration is run concurrently, with its own load settings
+	var clients []*models.LoadTestOptions
+	for _, testClient := range perfTest.Config.Clients {
+		opts, err := smpClientLoadTestOptions(testClient, testName, testDuration)
+		if err != nil {
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.RequestMix = perfTest.RequestMix
+		opts.Method = perfTest.Method
+		opts.Assertions = perfTest.Assertions
+		clients = append(clients, opts)
+	}
+	if len(clients) == 0 {
+		h.log.Error(ErrInvalidLTURL(""))
+		http.Error(w, ErrInvalidLTURL("").Error(), http.StatusBadRequest)
+		return
+	}
+	loadTestOptions := clients[0]
+	loadTestOptions.Clients = clients[1:]
+
+	h.loadTestHelperHandler(w, req, profileID, testName, meshName, req.URL.Query().Get("uuid"), prefObj, loadTestOptions, provider)
+}
+
+// smpClientLoadTestOptions returns the options of the load test run by a client of an SMP test configuration
+func smpClientLoadTestOptions(testClient *SMP.PerformanceTestConfig_Client, testName string, testDuration time.Duration) (*models.LoadTestOptions, error) {
+	if len(testClient.EndpointUrls) == 0 {
+		return nil, ErrInvalidLTURL("")
+	}
 
 	// TODO: consider the multiple endpoints
-	loadTestOptions.URL = testClient.EndpointUrls[0]
-	loadTestOptions.HTTPNumThreads = int(testClient.Connections)
-	loadTestOptions.HTTPQPS = float64(testClient.Rps)
+	loadTestOptions := &models.LoadTestOptions{
+		Name:               testName,
+		URL:                testClient.EndpointUrls[0],
+		Duration:           testDuration,
+		HTTPNumThreads:     int(testClient.Connections),
+		HTTPQPS:            float64(testClient.Rps),
+		Body:               []byte(testClient.Body),
+		ContentType:        testClient.ContentType,
+		AllowInitialErrors: true,
+	}
+	if len(testClient.Headers) > 0 {
+		headers := testClient.Headers
+		loadTestOptions.Headers = &headers
+	}
+	if len(testClient.Cookies) > 0 {
+		cookies := testClient.Cookies
+		loadTestOptions.Cookies = &cookies
+	}
 
 	if loadTestOptions.HTTPNumThreads < 1 {
 		loadTestOptions.HTTPNumThreads = 1
 	}
+	if loadTestOptions.HTTPQPS < 0 {
+		loadTestOptions.HTTPQPS = 0
+	}
 
 	ltURL, err := url.Parse(loadTestOptions.URL)
 	if err != nil {
-		obj := "the provided load test"
-		h.log.Error(ErrParseBool(err, obj))
-		http.Error(w, ErrParseBool(err, obj).Error(), http.StatusBadRequest)
-		return
+		return nil, ErrParseBool(err, "the provided load test")
 	}
 	if !ltURL.IsAbs() {
-		h.log.Error(ErrInvalidLTURL(ltURL.String()))
-		http.Error(w, ErrInvalidLTURL(ltURL.String()).Error(), http.StatusBadRequest)
-		return
-	}
-	loadTestOptions.Name = testName
-
-	if loadTestOptions.HTTPQPS < 0 {
-		loadTestOptions.HTTPQPS = 0
+		return nil, ErrInvalidLTURL(ltURL.String())
 	}
 
-	loadGenerator := testClient.LoadGenerator
-
-	switch loadGenerator {
+	switch testClient.LoadGenerator {
 	case models.Wrk2LG.Name():
 		loadTestOptions.LoadGenerator = models.Wrk2LG
 	case models.NighthawkLG.Name():
@@ -126,9 +164,7 @@ func (h *Handler) LoadTestUsingSMPHandler(w http.ResponseWriter, req *http.Reque
 	default:
 		loadTestOptions.LoadGenerator = models.FortioLG
 	}
-	loadTestOptions.AllowInitialErrors = true
-
-	h.loadTestHelperHandler(w, req, profileID, testName, meshName, "", prefObj, loadTestOptions, provider)
+	return loadTestOptions, nil
 }
 
 func (h *Handler) jsonToMap(headersString string) *map[string]string {
@@ -209,6 +245,17 @@ func (h *Handler) LoadTestHandler(w http.ResponseWriter, req *http.Request, pref
 	loadTestOptions.Body = body
 	loadTestOptions.ContentType = contentType
 
+	// A templated body is rendered for every request, optionally using the rows of CSV data
+	if q.Get("bodyTemplate") == "true" {
+		loadTestOptions.BodyTemplate = true
+		loadTestOptions.TemplateData = []byte(q.Get("templateData"))
+		if _, err := helpers.NewBodyTemplate(bodyString, loadTestOptions.TemplateData); err != nil {
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
 	tt, _ := strconv.Atoi(q.Get("t"))
 	if tt < 1 {
 		tt = 1
@@ -249,6 +296,22 @@ func (h *Handler) LoadTestHandler(w http.ResponseWriter, req *http.Request, pref
 	loadTestOptions.Name = testName
 	loadTestOptions.AllowInitialErrors = true
 
+	// ws:// and wss:// targets are tested with WebSocket connections exchanging messages
+	if ltURL.Scheme == "ws" || ltURL.Scheme == "wss" {
+		loadTestOptions.SupportedLoadTestMethods = models.WebSocket
+		if rampUp := q.Get("wsRampUp"); rampUp != "" {
+			loadTestOptions.WSRampUp, err = time.ParseDuration(rampUp)
+			if err != nil {
+				obj := "websocket ramp up"
+				h.log.Error(ErrParseBool(err, obj))
+				http.Error(w, ErrParseBool(err, obj).Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		loadTestOptions.WSMessageRate, _ = strconv.ParseFloat(q.Get("wsMessageRate"), 64)
+		loadTestOptions.WSMessageSize, _ = strconv.Atoi(q.Get("wsMessageSize"))
+	}
+
 	qps, _ := strconv.ParseFloat(q.Get("qps"), 64)
 	if qps < 0 {
 		qps = 0
@@ -273,8 +336,90 @@ func (h *Handler) loadTestHelperHandler(w http.ResponseWriter, req *http.Request
 	prefObj *models.Preference, loadTestOptions *models.LoadTestOptions, provider models.Provider) {
 	log := logrus.WithField("file", "load_test_handler")
 
+	// The tests are checked against the load test limits of the workspace of the user
+	user, _ := req.Context().Value(models.UserCtxKey).(*models.User)
+	workspace, limits, err := h.loadTestLimits(user)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := adaptiveLoadTestOptions(req.URL.Query(), loadTestOptions, limits); err != nil {
+		err = ErrAdaptiveLoadTest(err)
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := checkpointLoadTestOptions(req.URL.Query(), loadTestOptions); err != nil {
+		err = ErrCheckpointLoadTest(err)
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.requestMixOptions(req, profileID, provider, loadTestOptions); err != nil {
+		err = ErrRequestMix(err)
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.methodOptions(req, profileID, provider, loadTestOptions); err != nil {
+		err = ErrLoadTestMethod(err)
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := assertionOptions(req.URL.Query(), loadTestOptions); err != nil {
+		err = ErrLoadTestAssertions(err)
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := unixSocketOptions(req.URL.Query(), loadTestOptions); err != nil {
+		err = ErrUnixSocket(err)
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Tests above the limits of the workspace are rejected before anything is streamed
+	if violations := limits.Violations(loadTestOptions); len(violations) > 0 {
+		err := ErrLoadTestLimits(workspace, violations)
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	// A few probe requests tell a target which cannot be reached, or which answers with errors,
+	// before a full test duration is spent against it
+	if err := probeLoadTestTargets(req.URL.Query(), loadTestOptions); err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusFailedDependency)
+		return
+	}
+
+	// The workspace of the user runs as many concurrent tests, and stores as many results, as its
+	// quotas allow. The test keeps its turn until it completes, whether or not the client waits for it.
+	releaseQuota := func() {}
+	if user != nil && h.config.WorkspaceQuotas != nil {
+		if !h.checkWorkspaceQuota(w, user, models.QuotaResults) {
+			return
+		}
+		release, err := h.config.WorkspaceQuotas.AcquireTest(user.UserID)
+		if !h.workspaceQuotaError(w, err) {
+			return
+		}
+		releaseQuota = release
+	}
+
 	flusher, ok := w.(http.Flusher)
 	if !ok {
+		releaseQuota()
 		log.Error("Event streaming not supported.")
 		http.Error(w, "Event streaming is not supported at the moment.", http.StatusInternalServerError)
 		return
@@ -286,17 +431,44 @@ func (h *Handler) loadTestHelperHandler(w http.ResponseWriter, req *http.Request
 
 	notify := req.Context()
 
+	// The test is tracked as a job until it completes, whether or not the client waits for it, its
+	// progress estimated from its duration. The test runs with the context of the job, stopping early
+	// once the job is cancelled.
+	userID := ""
+	if user != nil {
+		userID = user.UserID
+	}
+	job := h.startJob(context.Background(), models.JobPerformanceTest, testName, userID, true)
+	job.Expect(loadTestOptions.Duration)
+	for _, client := range append([]*models.LoadTestOptions{loadTestOptions}, loadTestOptions.Clients...) {
+		client.Context = job.Context()
+	}
+
 	respChan := make(chan *models.LoadTestResponse, 100)
 	endChan := make(chan struct{})
 	defer close(endChan)
 
 	go func() {
+		var testErr error
+		defer func() {
+			job.Finish(testErr)
+		}()
 		defer func() {
 			if r := recover(); r != nil {
 				h.log.Error(ErrPanicRecovery(r))
 			}
 		}()
 		for data := range respChan {
+			switch data.Status {
+			case models.LoadTestError:
+				testErr = fmt.Errorf("%s", data.Message)
+			case models.LoadTestSuccess:
+				testErr = nil
+			}
+			if data.Message != "" {
+				job.Message(data.Message)
+			}
+
 			bd, err := json.Marshal(data)
 			if err != nil {
 				h.log.Error(ErrMarshal(err, "meshery result for shipping"))
@@ -315,8 +487,8 @@ func (h *Handler) loadTestHelperHandler(w http.ResponseWriter, req *http.Request
 		h.log.Debug("response channel closed")
 	}()
 	go func() {
-		ctx := context.Background()
-		h.executeLoadTest(ctx, req, profileID, testName, meshName, testUUID, prefObj, provider, loadTestOptions, respChan)
+		h.executeLoadTest(job.Context(), req, profileID, testName, meshName, testUUID, prefObj, provider, loadTestOptions, respChan)
+		releaseQuota()
 		close(respChan)
 	}()
 	select {
@@ -330,29 +502,133 @@ func (h *Handler) loadTestHelperHandler(w http.ResponseWriter, req *http.Request
 }
 
 func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profileID, testName, meshName, testUUID string, prefObj *models.Preference, provider models.Provider, loadTestOptions *models.LoadTestOptions, respChan chan *models.LoadTestResponse) {
+	clients := append([]*models.LoadTestOptions{loadTestOptions}, loadTestOptions.Clients...)
+
+	release := func() {}
+	if h.config.LoadTestGuard != nil {
+		// Overlapping tests against the same service produce meaningless results. The
+		// clients of a test share its turn on their targets, which are acquired in order
+		// so that tests with several targets do not wait on each other.
+		targets := map[string]bool{}
+		for _, client := range clients {
+			if client.UnixSocket != "" {
+				targets["unix:"+client.UnixSocket] = true
+				continue
+			}
+			targets[helpers.LoadTestTarget(client.URL)] = true
+		}
+		ordered := make([]string, 0, len(targets))
+		for target := range targets {
+			ordered = append(ordered, target)
+		}
+		sort.Strings(ordered)
+
+		// The test leaves the queue once its job is cancelled or its client goes away
+		queueCtx, cancelQueue := context.WithCancel(ctx)
+		defer cancelQueue()
+		go func() {
+			select {
+			case <-req.Context().Done():
+				cancelQueue()
+			case <-queueCtx.Done():
+			}
+		}()
+
+		var releases []func()
+		release = func() {
+			for _, r := range releases {
+				r()
+			}
+		}
+		for _, target := range ordered {
+			target := target
+			r, err := h.config.LoadTestGuard.Acquire(queueCtx, target, func(position int) {
+				respChan <- &models.LoadTestResponse{
+					Status:  models.LoadTestInfo,
+					Message: fmt.Sprintf("Another performance test is running against %s, this test is queued at position %d and starts once the tests ahead of it complete", target, position),
+				}
+			})
+			if err != nil {
+				release()
+				h.log.Error(ErrLoadTest(err, "cancelled while queued"))
+				respChan <- &models.LoadTestResponse{
+					Status:  models.LoadTestError,
+					Message: "cancelled while queued",
+				}
+				return
+			}
+			releases = append(releases, r)
+		}
+	}
+
 	respChan <- &models.LoadTestResponse{
 		Status:  models.LoadTestInfo,
 		Message: "Initiating load test . . . ",
 	}
-	var (
-		resultsMap map[string]interface{}
-		resultInst *periodic.RunnerResults
-		err        error
-	)
-	if loadTestOptions.LoadGenerator == models.Wrk2LG {
-		resultsMap, resultInst, err = helpers.WRK2LoadTest(loadTestOptions)
-	} else if loadTestOptions.LoadGenerator == models.NighthawkLG {
-		resultsMap, resultInst, err = helpers.NighthawkLoadTest(loadTestOptions)
-	} else {
-		resultsMap, resultInst, err = helpers.FortioLoadTest(loadTestOptions)
+
+	// Load generators and agents in the cluster write their client-side metrics through
+	// Prometheus remote_write while the test runs, which are kept with its result
+	collectClientMetrics := testUUID != "" && h.config.RemoteWriteReceiver != nil
+	if collectClientMetrics {
+		token, err := h.config.RemoteWriteReceiver.Open(testUUID)
+		if err != nil {
+			h.log.Error(ErrRemoteWrite(err))
+			collectClientMetrics = false
+		} else {
+			respChan <- &models.LoadTestResponse{
+				Status:           models.LoadTestInfo,
+				Message:          fmt.Sprintf("Client-side metrics are accepted through Prometheus remote_write at /api/perf/remote_write?test_id=%s, with remote_write_token as the bearer token, while the test runs", testUUID),
+				RemoteWriteToken: token,
+			}
+		}
 	}
-	if err != nil {
-		h.log.Error(ErrLoadTest(err, "unable to perform"))
+
+	// Long running tests persist checkpoints of their aggregates while they run, which are kept
+	// under the UUID of the test, else under one of their own
+	checkpointTestID := testUUID
+	for _, client := range clients {
+		if client.CheckpointInterval <= 0 {
+			continue
+		}
+		if checkpointTestID == "" {
+			id, _ := uuid.NewV4()
+			checkpointTestID = id.String()
+		}
 		respChan <- &models.LoadTestResponse{
-			Status:  models.LoadTestError,
-			Message: "unable to perform",
+			Status:  models.LoadTestInfo,
+			Message: fmt.Sprintf("Checkpoints are persisted every %s, queryable at /api/perf/checkpoints?test_id=%s while the test runs", client.CheckpointInterval, checkpointTestID),
+		}
+		break
+	}
+
+	results := make([]map[string]interface{}, len(clients))
+	instances := make([]*periodic.RunnerResults, len(clients))
+	errs := make([]error, len(clients))
+	var wg sync.WaitGroup
+	for i, client := range clients {
+		wg.Add(1)
+		go func(i int, client *models.LoadTestOptions) {
+			defer wg.Done()
+			results[i], instances[i], errs[i] = h.runCheckpointedLoadTest(client, i, checkpointTestID, profileID, respChan)
+		}(i, client)
+	}
+	wg.Wait()
+	// The targets are no longer under load, let the next queued test start
+	release()
+	var clientMetrics []models.ClientMetricSeries
+	var droppedClientSamples int
+	if collectClientMetrics {
+		clientMetrics, droppedClientSamples = h.config.RemoteWriteReceiver.Close(testUUID)
+	}
+	for _, err := range errs {
+		if err != nil {
+			h.log.Error(ErrLoadTest(err, "unable to perform"))
+			respChan <- &models.LoadTestResponse{
+				Status:  models.LoadTestError,
+				Message: "unable to perform",
+			}
+			return
 		}
-		return
 	}
 
 	respChan <- &models.LoadTestResponse{
@@ -360,12 +636,39 @@ func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profil
 		Message: "Load test completed, fetching metadata now",
 	}
 
-	resultsMap["load-generator"] = loadTestOptions.LoadGenerator
+	for i, client := range clients {
+		results[i]["load-generator"] = client.LoadGenerator
+		// The options of the run are kept with the result so that it can be reproduced
+		// even after the profile changed
+		results[i]["run-configuration"] = loadTestRunConfiguration(client)
+		// The version of Meshery is part of the environment the results are compared in
+		results[i]["meshery-version"] = viper.GetString("BUILD")
+	}
+	// The result of the first client makes up the run, keeping the views of results
+	// working, while the results of every client are kept along with it
+	resultsMap, resultInst := results[0], instances[0]
+	if len(clients) > 1 {
+		perClient := make([]map[string]interface{}, 0, len(clients))
+		for _, r := range results {
+			clientResult := make(map[string]interface{}, len(r))
+			for k, v := range r {
+				clientResult[k] = v
+			}
+			perClient = append(perClient, clientResult)
+		}
+		resultsMap["clients"] = perClient
+	}
+	if len(clientMetrics) > 0 {
+		resultsMap["client-metrics"] = clientMetrics
+		if droppedClientSamples > 0 {
+			resultsMap["client-metrics-dropped-samples"] = droppedClientSamples
+		}
+	}
 
 	// Get the context
 	mk8scontext, ok := req.Context().Value(models.KubeContextKey).(*models.K8sContext)
 	if !ok || mk8scontext == nil {
-		h.log.Error(ErrLoadTest(err, "unable to perform: failed to identify kubernetes context"))
+		h.log.Error(ErrLoadTest(errors.New("no kubernetes context in the request"), "unable to perform: failed to identify kubernetes context"))
 		respChan <- &models.LoadTestResponse{
 			Status:  models.LoadTestError,
 			Message: "unable to perform: failed to identify kubernetes context",
@@ -423,6 +726,30 @@ func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profil
 		}
 	}
 
+	// Snapshots of Grafana boards over the time range of the run are kept with its result,
+	// of the boards given or else those selected in the Grafana settings
+	if req.URL.Query().Get("grafanaSnapshot") == "true" {
+		respChan <- &models.LoadTestResponse{
+			Status:  models.LoadTestInfo,
+			Message: "Capturing Grafana snapshots of the run",
+		}
+		var boardUIDs []string
+		if boards := req.URL.Query().Get("grafanaBoards"); boards != "" {
+			boardUIDs = strings.Split(boards, ",")
+		}
+		snapshots, err := h.captureGrafanaSnapshots(prefObj, boardUIDs, testName, resultInst.StartTime, resultInst.StartTime.Add(resultInst.ActualDuration))
+		if err != nil {
+			h.log.Warn(err)
+			respChan <- &models.LoadTestResponse{
+				Status:  models.LoadTestInfo,
+				Message: "Unable to capture every Grafana snapshot of the run: " + err.Error(),
+			}
+		}
+		if len(snapshots) > 0 {
+			resultsMap["grafana-snapshots"] = snapshots
+		}
+	}
+
 	respChan <- &models.LoadTestResponse{
 		Status:  models.LoadTestInfo,
 		Message: "Obtained the needed metadatas, attempting to persist the result",
@@ -433,6 +760,10 @@ func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profil
 		Mesh:   meshName,
 		Result: resultsMap,
 	}
+	// The user who started the test owns its result
+	if user, err := provider.GetUserDetails(req); err == nil {
+		result.UserID = user.UserID
+	}
 
 	resultID, err := provider.PublishResults(req, result, profileID)
 	if err != nil {
@@ -448,6 +779,32 @@ func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profil
 		Message: "Done persisting the load test results.",
 	}
 
+	point := helpers.NewResultPoint(resultInst)
+	point.ResultID = resultID
+	point.ProfileID = profileID
+	point.Name = testName
+	point.Mesh = meshName
+	point.LoadGenerator = loadTestOptions.LoadGenerator.Name()
+	point.Errors = helpers.ResultErrors(resultsMap)
+	if len(h.config.ResultSinks) > 0 {
+		go h.exportResult(point)
+	}
+	go h.postProcessResult(result.UserID, resultID, profileID, result)
+
+	// The p99 latency of the result is compared with the history of the profile
+	if h.config.ResultAnomalyDetector != nil && profileID != "" {
+		analysis, err := h.config.ResultAnomalyDetector.Analyze(point)
+		if err != nil {
+			h.log.Error(err)
+		} else if analysis.Anomaly {
+			respChan <- &models.LoadTestResponse{
+				Status: models.LoadTestInfo,
+				Message: fmt.Sprintf("The p99 latency of the result is %.1f standard deviations away from the mean of the last %d results of the profile",
+					analysis.Deviations, analysis.Samples),
+			}
+		}
+	}
+
 	var promURL string
 	if prefObj.Prometheus != nil {
 		promURL = prefObj.Prometheus.PrometheusURL
@@ -456,13 +813,15 @@ func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profil
 	tokenVal, _ := provider.GetProviderToken(req)
 
 	h.log.Debug("promURL: , testUUID: , resultID: ", promURL, testUUID, resultID)
-	if promURL != "" && testUUID != "" && resultID != "" &&
+	if (promURL != "" || prefObj.Datadog != nil || prefObj.NewRelic != nil) && testUUID != "" && resultID != "" &&
 		(provider.GetProviderType() == models.RemoteProviderType ||
 			(provider.GetProviderType() == models.LocalProviderType && prefObj.AnonymousPerfResults)) {
-		_ = h.task.WithArgs(ctx, &models.SubmitMetricsConfig{
+		_ = h.task.WithArgs(context.Background(), &models.SubmitMetricsConfig{
 			TestUUID:  testUUID,
 			ResultID:  resultID,
 			PromURL:   promURL,
+			Datadog:   prefObj.Datadog,
+			NewRelic:  prefObj.NewRelic,
 			StartTime: resultInst.StartTime,
 			EndTime:   resultInst.StartTime.Add(resultInst.ActualDuration),
 			TokenVal:  tokenVal,
@@ -510,7 +869,310 @@ func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profil
 	// }
 }
 
+// adaptiveLoadTestOptions sets up the test to search for the maximum sustainable rate under a p99
+// latency budget when adaptive=true and targetP99 are given, the search staying within the QPS limit
+func adaptiveLoadTestOptions(q url.Values, opts *models.LoadTestOptions, limits models.LoadTestLimits) error {
+	if q.Get("adaptive") != "true" {
+		return nil
+	}
+	if opts.LoadGenerator != models.NighthawkLG {
+		return fmt.Errorf("adaptive tests are run with nighthawk, not %s", opts.LoadGenerator.Name())
+	}
+	if len(opts.Clients) > 0 {
+		return fmt.Errorf("adaptive tests are run with a single client")
+	}
+	targetP99, err := time.ParseDuration(q.Get("targetP99"))
+	if err != nil || targetP99 <= 0 {
+		return fmt.Errorf("invalid p99 latency target %q", q.Get("targetP99"))
+	}
+	opts.Adaptive = true
+	opts.TargetP99 = targetP99
+	opts.AdaptiveMaxQPS = limits.MaxQPS
+	return nil
+}
+
+// minCheckpointInterval is the shortest interval between the checkpoints of a test, every
+// checkpoint restarting the load generator
+const minCheckpointInterval = time.Minute
+
+// checkpointLoadTestOptions sets up the clients of the test to run as segments of the interval given
+// as the checkpoint parameter, the aggregates of the test being checkpointed as each of them completes
+func checkpointLoadTestOptions(q url.Values, opts *models.LoadTestOptions) error {
+	param := q.Get("checkpoint")
+	if param == "" {
+		return nil
+	}
+	interval, err := time.ParseDuration(param)
+	if err != nil || interval < minCheckpointInterval {
+		return fmt.Errorf("invalid checkpoint interval %q, checkpoints are at least %s apart", param, minCheckpointInterval)
+	}
+
+	for _, client := range append([]*models.LoadTestOptions{opts}, opts.Clients...) {
+		if client.Adaptive {
+			return fmt.Errorf("adaptive tests search for their rate in steps of their own and are not checkpointed")
+		}
+		// a test no longer than the interval has nothing to checkpoint before its result
+		if client.Duration > interval {
+			client.CheckpointInterval = interval
+		}
+	}
+	return nil
+}
+
+// runCheckpointedLoadTest runs the load test of a client, persisting the checkpoints of the run
+// under the test ID when the client is checkpointed
+func (h *Handler) runCheckpointedLoadTest(opts *models.LoadTestOptions, client int, testID, profileID string, respChan chan *models.LoadTestResponse) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if opts.CheckpointInterval <= 0 {
+		return runLoadTest(opts)
+	}
+	return helpers.CheckpointedLoadTest(opts, runLoadTest, func(checkpoint *models.LoadTestCheckpoint) {
+		checkpoint.TestID = testID
+		checkpoint.ProfileID = profileID
+		checkpoint.Name = opts.Name
+		checkpoint.Client = client
+		if h.config.LoadTestCheckpointPersister != nil {
+			if err := h.config.LoadTestCheckpointPersister.SaveLoadTestCheckpoint(checkpoint); err != nil {
+				h.log.Error(ErrLoadTestCheckpoints(err))
+			}
+		}
+		respChan <- &models.LoadTestResponse{
+			Status: models.LoadTestInfo,
+			Message: fmt.Sprintf("Checkpoint %d of client %d after %s: %d requests at %.1f QPS, p99 %.2fms overall and %.2fms over the last interval",
+				checkpoint.Seq, client, checkpoint.Duration.Round(time.Second), checkpoint.Requests, checkpoint.QPS, checkpoint.P99*1000, checkpoint.SegmentP99*1000),
+		}
+	})
+}
+
+// requestMixOptions sets up the clients of the test to send the weighted requests of its request mix:
+// the mix of the SMP test configuration, else the mix given as the requestMix parameter, else the mix of
+// the profile run
+func (h *Handler) requestMixOptions(req *http.Request, profileID string, provider models.Provider, opts *models.LoadTestOptions) error {
+	mix := opts.RequestMix
+	if len(mix) == 0 {
+		if param := req.URL.Query().Get("requestMix"); param != "" {
+			if err := json.Unmarshal([]byte(param), &mix); err != nil {
+				return fmt.Errorf("invalid requestMix: %v", err)
+			}
+		} else if profile := h.runProfile(req, profileID, provider); profile != nil {
+			mix = profile.RequestMix
+		}
+	}
+	if len(mix) == 0 {
+		return nil
+	}
+	if err := mix.Validate(); err != nil {
+		return err
+	}
+
+	for _, client := range append([]*models.LoadTestOptions{opts}, opts.Clients...) {
+		if client.LoadGenerator != models.FortioLG || client.SupportedLoadTestMethods == models.WebSocket {
+			return fmt.Errorf("%s is not tested over HTTP with fortio", client.URL)
+		}
+		if client.BodyTemplate {
+			return fmt.Errorf("request mixes send the bodies of their requests rather than the body template")
+		}
+		client.RequestMix = mix
+	}
+	return nil
+}
+
+// methodOptions sets the method of the HTTP requests of the clients of the test: the method of the SMP
+// test configuration, else the method given as the method parameter, else the method of the profile run
+func (h *Handler) methodOptions(req *http.Request, profileID string, provider models.Provider, opts *models.LoadTestOptions) error {
+	method := opts.Method
+	if method == "" {
+		if method = req.URL.Query().Get("method"); method == "" {
+			if profile := h.runProfile(req, profileID, provider); profile != nil {
+				method = profile.Method
+			}
+		}
+	}
+	if method == "" {
+		return nil
+	}
+	if err := models.ValidateHTTPMethod(method); err != nil {
+		return err
+	}
+
+	for _, client := range append([]*models.LoadTestOptions{opts}, opts.Clients...) {
+		if client.SupportedLoadTestMethods == models.WebSocket {
+			return fmt.Errorf("%s is not tested over HTTP", client.URL)
+		}
+		if len(client.RequestMix) > 0 {
+			return fmt.Errorf("the requests of a request mix have their own methods")
+		}
+		client.Method = strings.ToUpper(method)
+	}
+	return nil
+}
+
+// runProfile returns the profile run, nil if none is or it cannot be fetched
+func (h *Handler) runProfile(req *http.Request, profileID string, provider models.Provider) *models.PerformanceProfile {
+	if profileID == "" {
+		return nil
+	}
+	data, err := provider.GetPerformanceProfile(req, profileID)
+	if err != nil {
+		h.log.Debug("unable to fetch the profile run: ", err)
+		return nil
+	}
+	profile := &models.PerformanceProfile{}
+	if err := json.Unmarshal(data, profile); err != nil {
+		return nil
+	}
+	return profile
+}
+
+// assertionOptions sets the assertions the responses of every client of the test are validated with:
+// those of the test configuration, else those given as the JSON assertions parameter
+func assertionOptions(q url.Values, opts *models.LoadTestOptions) error {
+	assertions := opts.Assertions
+	if assertions.Empty() {
+		param := q.Get("assertions")
+		if param == "" {
+			return nil
+		}
+		assertions = &models.LoadTestAssertions{}
+		if err := json.Unmarshal([]byte(param), assertions); err != nil {
+			return fmt.Errorf("invalid assertions: %v", err)
+		}
+		if assertions.Empty() {
+			return nil
+		}
+	}
+	if err := assertions.Validate(); err != nil {
+		return err
+	}
+
+	for _, client := range append([]*models.LoadTestOptions{opts}, opts.Clients...) {
+		if client.LoadGenerator != models.FortioLG || client.SupportedLoadTestMethods == models.WebSocket {
+			return fmt.Errorf("%s is not tested over HTTP with fortio", client.URL)
+		}
+		client.Assertions = assertions
+	}
+	return nil
+}
+
+// unixSocketOptions sets up the clients of the test to send their requests over the Unix domain socket
+// given as the unixSocket parameter, the host of their URL being sent as the Host of the requests
+func unixSocketOptions(q url.Values, opts *models.LoadTestOptions) error {
+	socket := q.Get("unixSocket")
+	if socket == "" {
+		return nil
+	}
+	// abstract sockets of Linux start with @
+	if !filepath.IsAbs(socket) && !strings.HasPrefix(socket, "@") {
+		return fmt.Errorf("socket %s is not an absolute path", socket)
+	}
+
+	for _, client := range append([]*models.LoadTestOptions{opts}, opts.Clients...) {
+		if client.LoadGenerator != models.FortioLG {
+			return fmt.Errorf("%s does not send requests over Unix domain sockets", client.LoadGenerator.Name())
+		}
+		u, err := url.Parse(client.URL)
+		if err != nil {
+			return err
+		}
+		if u.Scheme == "https" || u.Scheme == "wss" {
+			return fmt.Errorf("%s is not a plain text URL, TLS is not supported over Unix domain sockets", client.URL)
+		}
+		client.UnixSocket = socket
+	}
+	return nil
+}
+
+// probeLoadTestTargets probes the target of every client of the test before it starts, expecting
+// the statuses given as the comma separated expectStatus parameter, unless skipProbe is true
+func probeLoadTestTargets(q url.Values, opts *models.LoadTestOptions) error {
+	if q.Get("skipProbe") == "true" {
+		return nil
+	}
+	var expectStatus []int
+	if param := q.Get("expectStatus"); param != "" {
+		for _, s := range strings.Split(param, ",") {
+			status, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil || status < 100 || status > 599 {
+				return ErrProbeLoadTestTarget(fmt.Errorf("invalid expected status %q", s))
+			}
+			expectStatus = append(expectStatus, status)
+		}
+	}
+	for _, client := range append([]*models.LoadTestOptions{opts}, opts.Clients...) {
+		if err := helpers.ProbeLoadTestTarget(client, expectStatus); err != nil {
+			return ErrProbeLoadTestTarget(err)
+		}
+	}
+	return nil
+}
+
+// runLoadTest runs the load test with the load generator of the options
+func runLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+	switch {
+	case opts.SupportedLoadTestMethods == models.WebSocket:
+		return helpers.WebSocketLoadTest(opts)
+	case opts.LoadGenerator == models.Wrk2LG:
+		return helpers.WRK2LoadTest(opts)
+	case opts.LoadGenerator == models.NighthawkLG && opts.Adaptive:
+		return helpers.NighthawkAdaptiveLoadTest(opts)
+	case opts.LoadGenerator == models.NighthawkLG:
+		return helpers.NighthawkLoadTest(opts)
+	}
+	return helpers.FortioLoadTest(opts)
+}
+
 // CollectStaticMetrics is used for collecting static metrics from prometheus and submitting it to Remote Provider
+// loadTestRunConfiguration returns the options a load test was run with
+func loadTestRunConfiguration(opts *models.LoadTestOptions) map[string]interface{} {
+	config := map[string]interface{}{
+		"url":                 opts.URL,
+		"qps":                 opts.HTTPQPS,
+		"concurrent_requests": opts.HTTPNumThreads,
+		"duration":            opts.Duration.String(),
+		"load_generator":      opts.LoadGenerator.Name(),
+		"content_type":        opts.ContentType,
+	}
+	if opts.Headers != nil && len(*opts.Headers) > 0 {
+		config["headers"] = *opts.Headers
+	}
+	if opts.Cookies != nil && len(*opts.Cookies) > 0 {
+		config["cookies"] = *opts.Cookies
+	}
+	if len(opts.Body) > 0 {
+		config["body"] = string(opts.Body)
+	}
+	if opts.Method != "" {
+		config["method"] = opts.Method
+	}
+	if opts.SupportedLoadTestMethods == models.WebSocket {
+		config["ws_ramp_up"] = opts.WSRampUp.String()
+		config["ws_message_rate"] = opts.WSMessageRate
+		config["ws_message_size"] = opts.WSMessageSize
+	}
+	if len(opts.RequestMix) > 0 {
+		config["request_mix"] = opts.RequestMix
+	}
+	if !opts.Assertions.Empty() {
+		config["assertions"] = opts.Assertions
+	}
+	if opts.UnixSocket != "" {
+		config["unix_socket"] = opts.UnixSocket
+	}
+	if opts.CheckpointInterval > 0 {
+		config["checkpoint_interval"] = opts.CheckpointInterval.String()
+	}
+	if opts.Adaptive {
+		config["adaptive"] = true
+		config["target_p99"] = opts.TargetP99.String()
+	}
+	if opts.BodyTemplate {
+		config["body_template"] = true
+		if len(opts.TemplateData) > 0 {
+			config["template_data"] = string(opts.TemplateData)
+		}
+	}
+	return config
+}
+
 func (h *Handler) CollectStaticMetrics(config *models.SubmitMetricsConfig) error {
 	h.log.Debug("initiating collecting prometheus static board metrics for test id: ", config.TestUUID)
 	ctx := context.Background()
@@ -518,7 +1180,38 @@ func (h *Handler) CollectStaticMetrics(config *models.SubmitMetricsConfig) error
 	queryResults := map[string]map[string]interface{}{}
 	step := h.config.PrometheusClient.ComputeStep(ctx, config.StartTime, config.EndTime)
 	for query, flag := range queries {
-		if !flag {
+		if flag {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(query, datadogQueryPrefix):
+			if config.Datadog == nil {
+				continue
+			}
+			data, err := h.config.DatadogClient.QueryRange(ctx, config.Datadog, strings.TrimPrefix(query, datadogQueryPrefix), config.StartTime, config.EndTime)
+			if err != nil {
+				return err
+			}
+			queryResults[query] = map[string]interface{}{
+				"status": "success",
+				"data":   json.RawMessage(data),
+			}
+		case strings.HasPrefix(query, newRelicQueryPrefix):
+			if config.NewRelic == nil {
+				continue
+			}
+			data, err := h.config.NewRelicClient.QueryRange(ctx, config.NewRelic, strings.TrimPrefix(query, newRelicQueryPrefix), config.StartTime, config.EndTime)
+			if err != nil {
+				return err
+			}
+			queryResults[query] = map[string]interface{}{
+				"status": "success",
+				"data":   json.RawMessage(data),
+			}
+		default:
+			if config.PromURL == "" {
+				continue
+			}
 			seriesData, err := h.config.PrometheusClient.QueryRangeUsingClient(ctx, config.PromURL, query, config.StartTime, config.EndTime, step)
 			if err != nil {
 				return err
@@ -530,14 +1223,10 @@ func (h *Handler) CollectStaticMetrics(config *models.SubmitMetricsConfig) error
 					"result":     seriesData,
 				},
 			}
-			h.config.QueryTracker.AddOrFlagQuery(ctx, config.TestUUID, query, true)
 		}
+		h.config.QueryTracker.AddOrFlagQuery(ctx, config.TestUUID, query, true)
 	}
 
-	board, err := h.config.PrometheusClient.GetClusterStaticBoard(ctx, config.PromURL)
-	if err != nil {
-		return err
-	}
 	// TODO: we are NOT persisting the Node metrics for now
 
 	resultUUID, err := uuid.FromString(config.ResultID)
@@ -546,10 +1235,16 @@ func (h *Handler) CollectStaticMetrics(config *models.SubmitMetricsConfig) error
 		return err
 	}
 	result := &models.MesheryResult{
-		ID:                resultUUID,
-		TestID:            config.TestUUID,
-		ServerMetrics:     queryResults,
-		ServerBoardConfig: board,
+		ID:            resultUUID,
+		TestID:        config.TestUUID,
+		ServerMetrics: queryResults,
+	}
+	if config.PromURL != "" {
+		board, err := h.config.PrometheusClient.GetClusterStaticBoard(ctx, config.PromURL)
+		if err != nil {
+			return err
+		}
+		result.ServerBoardConfig = board
 	}
 
 	if err = config.Provider.PublishMetrics(config.TokenVal, result); err != nil {
@@ -559,3 +1254,53 @@ func (h *Handler) CollectStaticMetrics(config *models.SubmitMetricsConfig) error
 	h.config.QueryTracker.RemoveUUID(ctx, config.TestUUID)
 	return nil
 }
+
+// exportResult writes the result point to every configured result sink
+// captureGrafanaSnapshots creates snapshots of the Grafana boards with the given UIDs, or of the
+// boards selected in the Grafana settings when none is given, over the given time range
+func (h *Handler) captureGrafanaSnapshots(prefObj *models.Preference, boardUIDs []string, name string, from, to time.Time) ([]*models.GrafanaSnapshot, error) {
+	if prefObj.Grafana == nil || prefObj.Grafana.GrafanaURL == "" {
+		return nil, ErrGrafanaConfig
+	}
+	if len(boardUIDs) == 0 {
+		for _, config := range prefObj.Grafana.GrafanaBoards {
+			if config.GrafanaBoard != nil && config.GrafanaBoard.UID != "" {
+				boardUIDs = append(boardUIDs, config.GrafanaBoard.UID)
+			}
+		}
+	}
+	if len(boardUIDs) == 0 {
+		return nil, ErrNoSnapshotBoards
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	snapshots := []*models.GrafanaSnapshot{}
+	var errs []string
+	for _, uid := range boardUIDs {
+		snapshot, err := h.config.GrafanaClient.CreateSnapshot(ctx, prefObj.Grafana.GrafanaURL, prefObj.Grafana.GrafanaAPIKey, strings.TrimSpace(uid), name, from, to)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if len(errs) > 0 {
+		return snapshots, ErrGrafanaSnapshots(strings.Join(errs, "; "))
+	}
+	return snapshots, nil
+}
+
+func (h *Handler) exportResult(point *models.ResultPoint) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for _, sink := range h.config.ResultSinks {
+		if err := sink.Write(ctx, point); err != nil {
+			h.log.Error(err)
+			continue
+		}
+		h.log.Debug("exported result ", point.ResultID, " to ", sink.Name())
+	}
+}
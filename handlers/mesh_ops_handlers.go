@@ -5,15 +5,21 @@ import (
 	"context"
 	"encoding/gob"
 	"encoding/json"
+	"fmt"
+	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/helpers"
 	"github.com/layer5io/meshery/meshes"
 	"github.com/layer5io/meshery/models"
 	mesherykube "github.com/layer5io/meshkit/utils/kubernetes"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
 )
 
 func init() {
@@ -51,6 +57,30 @@ func (h *Handler) AdaptersHandler(w http.ResponseWriter, req *http.Request, pref
 	}
 }
 
+// swagger:route GET /api/system/adapters/health SystemAPI idGetSystemAdaptersHealth
+// Handle GET request for adapters health
+//
+// Returns the availability history and uptime of the known adapters
+// responses:
+// 	200: systemAdaptersHealthRespWrapper
+
+// AdaptersHealthHandler is used to fetch the health of all the adapters
+func (h *Handler) AdaptersHealthHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	health := []models.AdapterHealth{}
+	if h.config.AdapterHealthTracker != nil {
+		health = h.config.AdapterHealthTracker.GetAdapterHealth(req.Context())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(health)
+	if err != nil {
+		obj := "adapters health"
+		h.log.Error(ErrMarshal(err, obj))
+		http.Error(w, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
 // AdapterPingHandler is used to ping a given adapter
 func (h *Handler) AdapterPingHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
 	// if req.Method != http.MethodGet {
@@ -264,6 +294,130 @@ func (h *Handler) addAdapter(ctx context.Context, meshAdapters []*models.Adapter
 	return meshAdapters, nil
 }
 
+// swagger:route POST /api/system/adapter/register SystemAPI idPostAdapterRegister
+// Handle POST requests to register an adapter at runtime
+//
+// Registers the adapter serving at the given address with Meshery, without requiring a restart. The adapter
+// must be reachable, verified against the given CA bundle if any, and stays registered across restarts. As
+// the mesh operations hand the adapters the kubeconfig of the users, only the admins of Meshery Server may
+// register them.
+// responses:
+// 	200: systemAdaptersRespWrapper
+
+// swagger:route DELETE /api/system/adapter/register SystemAPI idDeleteAdapterRegister
+// Handle DELETE requests to unregister an adapter
+//
+// Removes an adapter registered at runtime. Only the admins of Meshery Server may unregister the adapters.
+// responses:
+// 	200: systemAdaptersRespWrapper
+
+// AdapterRegistrationHandler is used to register and unregister adapters at runtime
+func (h *Handler) AdapterRegistrationHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	if !h.isAdmin(user, provider) {
+		err := ErrNotAdmin("register or unregister the adapters")
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	switch req.Method {
+	case http.MethodPost:
+		registration := models.AdapterRegistration{}
+		if err := json.NewDecoder(req.Body).Decode(&registration); err != nil {
+			obj := "adapter registration"
+			h.log.Error(ErrDecoding(err, obj))
+			http.Error(w, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+			return
+		}
+
+		if strings.TrimSpace(registration.Address) == "" {
+			h.log.Error(ErrAddAdapter)
+			http.Error(w, ErrAddAdapter.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := registration.Validate(); err != nil {
+			h.log.Error(ErrRegisterAdapter(err))
+			http.Error(w, ErrRegisterAdapter(err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		// With mutual TLS, the serving certificate of the adapters is made valid for the host of the adapter,
+		// unless the adapter serves with a certificate of its own CA
+		if h.config.AdapterCerts != nil && registration.TLSCA == "" {
+			if host, _, err := net.SplitHostPort(registration.Address); err == nil {
+				if err := h.config.AdapterCerts.AddHosts(req.Context(), host); err != nil {
+					h.log.Error(ErrRegisterAdapter(err))
+					http.Error(w, ErrRegisterAdapter(err).Error(), http.StatusInternalServerError)
+					return
+				}
+			}
+		}
+
+		// The adapter is reached with the CA of the registration before it replaces the CA the adapter
+		// was registered with, which keeps being used when the adapter cannot be reached
+		ctx, cancel := context.WithTimeout(req.Context(), 10*time.Second)
+		defer cancel()
+		info, err := meshes.PingAdapterWithCA(ctx, registration.Address, []byte(registration.TLSCA))
+		if err != nil {
+			h.log.Error(ErrRegisterAdapter(err))
+			http.Error(w, ErrRegisterAdapter(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := h.config.AdapterRegistrationPersister.SaveAdapterRegistration(&registration); err != nil {
+			h.log.Error(ErrRegisterAdapter(err))
+			http.Error(w, ErrRegisterAdapter(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		if registration.TLSCA != "" {
+			if err := meshes.RegisterAdapterCA(registration.Address, []byte(registration.TLSCA)); err != nil {
+				h.log.Error(ErrRegisterAdapter(err))
+				http.Error(w, ErrRegisterAdapter(err).Error(), http.StatusBadRequest)
+				return
+			}
+		} else {
+			meshes.UnregisterAdapterCA(registration.Address)
+		}
+
+		adapter := models.Adapter{
+			Location:     registration.Address,
+			Name:         info.Name,
+			Version:      info.Version,
+			GitCommitSHA: info.GitSha,
+		}
+		if registration.Name != "" {
+			adapter.Name = registration.Name
+		}
+		h.config.AdapterTracker.AddAdapter(req.Context(), adapter)
+		h.log.Info("registered adapter ", adapter.Name, " at ", adapter.Location)
+	case http.MethodDelete:
+		adapterLoc := req.URL.Query().Get("adapter")
+		if strings.TrimSpace(adapterLoc) == "" {
+			h.log.Error(ErrValidAdapter)
+			http.Error(w, ErrValidAdapter.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := h.config.AdapterRegistrationPersister.DeleteAdapterRegistration(adapterLoc); err != nil {
+			h.log.Error(ErrRegisterAdapter(err))
+			http.Error(w, ErrRegisterAdapter(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		h.config.AdapterTracker.RemoveAdapter(req.Context(), models.Adapter{Location: adapterLoc})
+		meshes.UnregisterAdapterCA(adapterLoc)
+		h.log.Info("unregistered adapter at ", adapterLoc)
+	default:
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	err := json.NewEncoder(w).Encode(h.config.AdapterTracker.GetAdapters(req.Context()))
+	if err != nil {
+		obj := "data"
+		h.log.Error(ErrMarshal(err, obj))
+		http.Error(w, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
 func (h *Handler) deleteAdapter(meshAdapters []*models.Adapter, w http.ResponseWriter, req *http.Request) ([]*models.Adapter, error) {
 	adapterLoc := req.URL.Query().Get("adapter")
 	h.log.Debug("URL of adapter to be removed: ", adapterLoc)
@@ -301,9 +455,11 @@ func (h *Handler) deleteAdapter(meshAdapters []*models.Adapter, w http.ResponseW
 // swagger:route POST /api/system/adapter/operation SystemAPI idPostAdapterOperation
 // Handle POST requests for Adapter Operations
 //
-// Used to send operations to the adapters
+// Used to send operations to the adapters, the ID of the operation being returned to match the events
+// the adapter reports its progress with. With dryRun, the custom body of the operation is submitted
+// to the cluster as a server-side dry run instead, and the resources it would apply are returned.
 // responses:
-// 	200:
+// 	200: adapterOperationRespWrapper
 
 // MeshOpsHandler is used to send operations to the adapters
 func (h *Handler) MeshOpsHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
@@ -364,6 +520,13 @@ func (h *Handler) MeshOpsHandler(w http.ResponseWriter, req *http.Request, prefO
 		return
 	}
 
+	// The adapters render the manifests of their operations only when running them, so a dry run
+	// is that of the custom body
+	if req.PostFormValue("dryRun") == "true" {
+		h.dryRunOperation(w, req, opName, customBody, namespace, delete != "")
+		return
+	}
+
 	mClient, err := meshes.CreateClient(req.Context(), k8sconfig, mk8scontext.Name, meshAdapters[aID].Location)
 	if err != nil {
 		//h.log.Error(ErrMeshClient)
@@ -395,5 +558,35 @@ func (h *Handler) MeshOpsHandler(w http.ResponseWriter, req *http.Request, prefO
 		http.Error(w, ErrApplyChange(err).Error(), http.StatusInternalServerError)
 		return
 	}
-	_, _ = w.Write([]byte("{}"))
+	h.writeApplicationJSON(w, &models.AdapterOperationResponse{OperationID: operationID.String()}, "adapter operation")
+}
+
+// dryRunOperation responds with the resources of the custom body of the operation as the cluster
+// would apply them, or with those it would delete, submitting them as a server-side dry run
+func (h *Handler) dryRunOperation(w http.ResponseWriter, req *http.Request, opName, customBody, namespace string, isDelete bool) {
+	if strings.TrimSpace(customBody) == "" {
+		err := helpers.ErrDryRunManifest(fmt.Errorf("operation %s has no custom body, the adapter rendering its manifests only when running it", opName))
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	kubeClient, ok := req.Context().Value(models.KubeHanderKey).(*mesherykube.Client)
+	if !ok || kubeClient == nil {
+		h.log.Error(ErrInvalidK8SConfig)
+		http.Error(w, ErrInvalidK8SConfig.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(kubeClient.KubeClient.Discovery()))
+	objs, err := helpers.DryRunManifest(req.Context(), kubeClient.DynamicKubeClient, mapper, []byte(customBody), namespace, isDelete)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := &models.AdapterOperationResponse{Resources: []map[string]interface{}{}}
+	for _, obj := range objs {
+		resp.Resources = append(resp.Resources, obj.Object)
+	}
+	h.writeApplicationJSON(w, resp, "adapter operation")
 }
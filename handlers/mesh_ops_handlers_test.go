@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type testAdapter struct {
+	meshes.UnimplementedMeshServiceServer
+}
+
+func (testAdapter) ComponentInfo(context.Context, *meshes.ComponentInfoRequest) (*meshes.ComponentInfoResponse, error) {
+	return &meshes.ComponentInfoResponse{Name: "istio", Version: "v0.5.0"}, nil
+}
+
+// newTestAdapterCA provisions the certificates of an adapter serving at 127.0.0.1, returning their directory
+func newTestAdapterCA(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if _, err := helpers.ProvisionAdapterCerts(dir, []string{"127.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// newTestTLSAdapter serves an adapter over TLS with the certificate of the given directory, returning its address
+func newTestTLSAdapter(t *testing.T, dir string) string {
+	t.Helper()
+	creds, err := credentials.NewServerTLSFromFile(filepath.Join(dir, "adapter.crt"), filepath.Join(dir, "adapter.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.Creds(creds))
+	meshes.RegisterMeshServiceServer(srv, testAdapter{})
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func readTestFile(t *testing.T, name string) string {
+	t.Helper()
+	content, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
+
+func TestAdapterRegistrationHandler(t *testing.T) {
+	certs := newTestAdapterCA(t)
+	address := newTestTLSAdapter(t, certs)
+	ca := readTestFile(t, filepath.Join(certs, helpers.AdapterTLSCAFile))
+	otherCA := readTestFile(t, filepath.Join(newTestAdapterCA(t), helpers.AdapterTLSCAFile))
+	t.Cleanup(func() { meshes.UnregisterAdapterCA(address) })
+
+	persister := &models.AdapterRegistrationPersister{DB: newTestDatabase(t)}
+	tracker := helpers.NewAdaptersTracker(nil)
+	h := newTestHandler(t, &models.HandlerConfig{
+		AdapterTracker:               tracker,
+		AdapterRegistrationPersister: persister,
+		Admins:                       []string{"root"},
+	})
+	admin := &models.User{UserID: "root"}
+
+	registerAs := func(user *models.User, registration models.AdapterRegistration) int {
+		body, _ := json.Marshal(registration)
+		// the adapters failing the TLS handshake are given up on sooner than by the handler
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		req := httptest.NewRequest(http.MethodPost, "/api/system/adapter/register", bytes.NewReader(body)).WithContext(ctx)
+		rw := httptest.NewRecorder()
+		h.AdapterRegistrationHandler(rw, req, nil, user, nil)
+		return rw.Code
+	}
+	register := func(registration models.AdapterRegistration) int {
+		return registerAs(admin, registration)
+	}
+	// pingRegistered reaches the adapter with the CA registered for it
+	pingRegistered := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err := meshes.PingAdapter(ctx, address)
+		return err
+	}
+	persistedCA := func() string {
+		registrations, err := persister.GetAdapterRegistrations()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range registrations {
+			if r.Address == address {
+				return r.TLSCA
+			}
+		}
+		return ""
+	}
+
+	if code := registerAs(&models.User{UserID: "alice"}, models.AdapterRegistration{Address: address, TLSCA: ca}); code != http.StatusForbidden {
+		t.Fatalf("user registering an adapter got %d, want %d", code, http.StatusForbidden)
+	}
+	if persistedCA() != "" {
+		t.Fatal("registration of an adapter by a user was persisted")
+	}
+	for _, invalid := range []string{"istio-adapter", "*.example.com:10000", "0.0.0.0:10000", "127.0.0.1:0"} {
+		if code := register(models.AdapterRegistration{Address: invalid}); code != http.StatusBadRequest {
+			t.Errorf("registration of the adapter at %s got %d, want %d", invalid, code, http.StatusBadRequest)
+		}
+	}
+
+	if code := register(models.AdapterRegistration{Address: address, TLSCA: ca}); code != http.StatusOK {
+		t.Fatalf("registration with the CA of the adapter answered %d", code)
+	}
+	if err := pingRegistered(); err != nil {
+		t.Fatalf("registered adapter is unreachable: %v", err)
+	}
+	if got := tracker.GetAdapters(context.Background()); len(got) != 1 || got[0].Name != "istio" {
+		t.Errorf("tracked adapters are %+v", got)
+	}
+	if persistedCA() != ca {
+		t.Error("registration of the adapter was not persisted")
+	}
+
+	t.Run("failed registrations keep the registered CA", func(t *testing.T) {
+		for name, tlsCA := range map[string]string{"another CA": otherCA, "no CA": ""} {
+			if code := register(models.AdapterRegistration{Address: address, TLSCA: tlsCA}); code != http.StatusInternalServerError {
+				t.Errorf("registration with %s answered %d, want %d", name, code, http.StatusInternalServerError)
+			}
+			if err := pingRegistered(); err != nil {
+				t.Errorf("adapter is unreachable after the registration with %s failed: %v", name, err)
+			}
+			if persistedCA() != ca {
+				t.Errorf("registration with %s replaced the persisted one", name)
+			}
+		}
+	})
+
+	t.Run("unregister", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		h.AdapterRegistrationHandler(rw, httptest.NewRequest(http.MethodDelete, "/api/system/adapter/register?adapter="+address, nil), nil, &models.User{UserID: "alice"}, nil)
+		if rw.Code != http.StatusForbidden {
+			t.Fatalf("user unregistering the adapter got %d, want %d", rw.Code, http.StatusForbidden)
+		}
+		rw = httptest.NewRecorder()
+		h.AdapterRegistrationHandler(rw, httptest.NewRequest(http.MethodDelete, "/api/system/adapter/register?adapter="+address, nil), nil, admin, nil)
+		if rw.Code != http.StatusOK {
+			t.Fatalf("unregistration answered %d", rw.Code)
+		}
+		if got := tracker.GetAdapters(context.Background()); len(got) != 0 {
+			t.Errorf("tracked adapters are %+v", got)
+		}
+		if persistedCA() != "" {
+			t.Error("registration of the unregistered adapter is still persisted")
+		}
+	})
+}
@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route GET /api/pattern/{id}/share PatternsAPI idGetPatternShares
+// Handle GET request for the shares of a pattern
+//
+// Returns the users and teams the pattern is shared with, to its owner and to the users it is shared with
+// responses:
+// 	200: patternSharesResponseWrapper
+
+// swagger:route POST /api/pattern/{id}/share PatternsAPI idPostPatternShare
+// Handle POST request to share a pattern
+//
+// Shares the pattern with a user or a team, which only its owner can
+// responses:
+// 	200: patternShareResponseWrapper
+
+// PatternShareHandler handles the requests to share a pattern with users and teams
+func (h *Handler) PatternShareHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	patternID := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(patternID); err != nil {
+		h.log.Error(ErrSharePattern(err))
+		http.Error(rw, ErrSharePattern(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		if _, status, err := h.patternAccess(r, provider, user, patternID, "view"); err != nil {
+			h.log.Error(err)
+			http.Error(rw, err.Error(), status)
+			return
+		}
+		resp, err := provider.GetPatternShares(r, patternID)
+		if err != nil {
+			h.log.Error(ErrSharePattern(err))
+			http.Error(rw, ErrSharePattern(err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(rw, string(resp))
+		return
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	pattern, err := getSavedPattern(r, provider, patternID)
+	if err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+	if !h.ownsPattern(user, provider, pattern) {
+		err := ErrPatternAccess(patternID, "share")
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	share := &models.PatternShare{}
+	if err := json.NewDecoder(r.Body).Decode(share); err != nil {
+		obj := "pattern share"
+		h.log.Error(ErrDecoding(err, obj))
+		http.Error(rw, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := share.Validate(); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	share.ID = nil
+	share.SharedBy = user.UserID
+
+	resp, err := provider.SharePattern(r, patternID, share)
+	if err != nil {
+		h.log.Error(ErrSharePattern(err))
+		http.Error(rw, ErrSharePattern(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+}
+
+// ownsPattern checks whether the user owns the pattern. The patterns of no owner, saved before
+// their owners were recorded, are owned by the admins of Meshery Server.
+func (h *Handler) ownsPattern(user *models.User, provider models.Provider, pattern *models.MesheryPattern) bool {
+	if pattern.UserID == nil || *pattern.UserID == "" {
+		return h.isAdmin(user, provider)
+	}
+	return user != nil && *pattern.UserID == user.UserID
+}
+
+// patternAccess loads the pattern with the given id, failing along with the status to respond with
+// when it does not exist or the user is not allowed the permission on it
+func (h *Handler) patternAccess(r *http.Request, provider models.Provider, user *models.User, patternID, permission string) (*models.MesheryPattern, int, error) {
+	pattern, err := getSavedPattern(r, provider, patternID)
+	if err != nil {
+		return nil, http.StatusNotFound, ErrGetPattern(err)
+	}
+	if status, err := h.checkPatternAccess(r, provider, user, pattern, permission); err != nil {
+		return nil, status, err
+	}
+	return pattern, http.StatusOK, nil
+}
+
+// checkPatternAccess fails along with the status to respond with when the user is not allowed the
+// permission, view or edit, on the pattern. Its owner is allowed both, and the users and teams it is
+// shared with the permission of their share.
+func (h *Handler) checkPatternAccess(r *http.Request, provider models.Provider, user *models.User, pattern *models.MesheryPattern, permission string) (int, error) {
+	if h.ownsPattern(user, provider, pattern) {
+		return http.StatusOK, nil
+	}
+
+	patternID := pattern.ID.String()
+	resp, err := provider.GetPatternShares(r, patternID)
+	if err != nil {
+		return http.StatusInternalServerError, ErrSharePattern(err)
+	}
+	shares := []models.PatternShare{}
+	if err := json.Unmarshal(resp, &shares); err != nil {
+		return http.StatusInternalServerError, ErrUnmarshal(err, "pattern shares")
+	}
+	team := ""
+	if h.config.WorkspaceQuotas != nil && user != nil {
+		if ws, ok := h.config.WorkspaceQuotas.Workspace(user.UserID); ok {
+			team = ws.Name
+		}
+	}
+	if user == nil || !models.PatternSharesGrant(shares, user.UserID, team, permission) {
+		return http.StatusForbidden, ErrPatternAccess(patternID, permission)
+	}
+	return http.StatusOK, nil
+}
+
+// swagger:route GET /api/pattern/{id}/review PatternsAPI idGetPatternReviews
+// Handle GET request for the reviews of a pattern
+//
+// Returns the reviews of the pattern along with its overall review status
+// responses:
+// 	200: patternReviewsResponseWrapper
+
+// swagger:route POST /api/pattern/{id}/review PatternsAPI idPostPatternReview
+// Handle POST request to request a review on a pattern
+//
+// Requests a review on the pattern from the given reviewer
+// responses:
+// 	200: patternReviewResponseWrapper
+
+// swagger:route PUT /api/pattern/{id}/review/{reviewID} PatternsAPI idPutPatternReview
+// Handle PUT request to submit a review on a pattern
+//
+// Approves the pattern or requests changes on it, which only the reviewer can, or re-requests the
+// review, which only the user who requested it can. An approval applies to the revision of the
+// pattern approved only.
+// responses:
+// 	200: patternReviewResponseWrapper
+
+// PatternReviewHandler handles the review workflow of a pattern
+func (h *Handler) PatternReviewHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	patternID := mux.Vars(r)["id"]
+
+	if r.Method == http.MethodGet {
+		resp, err := provider.GetPatternReviews(r, patternID)
+		if err != nil {
+			h.log.Error(ErrPatternReview(err))
+			http.Error(rw, ErrPatternReview(err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		reviews := []models.PatternReview{}
+		if err := json.Unmarshal(resp, &reviews); err != nil {
+			obj := "pattern reviews"
+			h.log.Error(ErrUnmarshal(err, obj))
+			http.Error(rw, ErrUnmarshal(err, obj).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(rw).Encode(models.PatternReviewsAPIResponse{
+			Status:  models.AggregatePatternReviewStatus(reviews),
+			Reviews: reviews,
+		}); err != nil {
+			obj := "pattern reviews"
+			h.log.Error(ErrEncoding(err, obj))
+			http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	review := &models.PatternReview{}
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+		obj := "pattern review"
+		h.log.Error(ErrDecoding(err, obj))
+		http.Error(rw, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Method == http.MethodPut {
+		reviewID, err := uuid.FromString(mux.Vars(r)["reviewID"])
+		if err != nil {
+			h.log.Error(ErrPatternReview(err))
+			http.Error(rw, ErrPatternReview(err).Error(), http.StatusBadRequest)
+			return
+		}
+		review.ID = &reviewID
+
+		existing, err := getPatternReview(r, provider, patternID, reviewID)
+		if err != nil {
+			h.log.Error(ErrPatternReview(err))
+			http.Error(rw, ErrPatternReview(err).Error(), http.StatusNotFound)
+			return
+		}
+		if !existing.CanBeSubmittedBy(user.UserID, review.Status) {
+			err := models.ErrPatternReviewNotAllowed(user.UserID, review.Status)
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusForbidden)
+			return
+		}
+		review.SubmittedBy = user.UserID
+		review.PatternSHA = ""
+		if review.Status == models.PatternReviewApproved {
+			pattern, err := getSavedPattern(r, provider, patternID)
+			if err != nil {
+				h.log.Error(ErrGetPattern(err))
+				http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+				return
+			}
+			review.PatternSHA = models.PatternFileSHA(pattern.PatternFile)
+		}
+	} else {
+		if review.Reviewer == "" {
+			err := fmt.Errorf("reviewer is required to request a review")
+			h.log.Error(ErrPatternReview(err))
+			http.Error(rw, ErrPatternReview(err).Error(), http.StatusBadRequest)
+			return
+		}
+		if review.Reviewer == user.UserID {
+			h.log.Error(models.ErrSelfPatternReview)
+			http.Error(rw, models.ErrSelfPatternReview.Error(), http.StatusBadRequest)
+			return
+		}
+		review.ID = nil
+		review.RequestedBy = user.UserID
+	}
+
+	resp, err := provider.SavePatternReview(r, patternID, review)
+	if err != nil {
+		h.log.Error(ErrPatternReview(err))
+		http.Error(rw, ErrPatternReview(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+}
+
+// getPatternReview fetches the review with the given id of the pattern from the provider
+func getPatternReview(r *http.Request, provider models.Provider, patternID string, reviewID uuid.UUID) (*models.PatternReview, error) {
+	resp, err := provider.GetPatternReviews(r, patternID)
+	if err != nil {
+		return nil, err
+	}
+	reviews := []models.PatternReview{}
+	if err := json.Unmarshal(resp, &reviews); err != nil {
+		return nil, err
+	}
+	for i := range reviews {
+		if reviews[i].ID != nil && *reviews[i].ID == reviewID {
+			return &reviews[i], nil
+		}
+	}
+	return nil, fmt.Errorf("review %s of pattern %s not found", reviewID, patternID)
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/models"
+)
+
+// multiUserProvider is a local provider whose users are not all admins, like those of the remote providers
+type multiUserProvider struct {
+	*models.DefaultLocalProvider
+}
+
+func (multiUserProvider) GetProviderType() models.ProviderType { return models.RemoteProviderType }
+
+func TestPatternShareHandler(t *testing.T) {
+	provider := multiUserProvider{newTestLocalProvider(t)}
+	pattern := saveTestPattern(t, provider.DefaultLocalProvider, "name: bookinfo\nservices: {}\n")
+	owner := "alice"
+	pattern.UserID = &owner
+	if _, err := provider.MesheryPatternPersister.SaveMesheryPattern(pattern); err != nil {
+		t.Fatal(err)
+	}
+
+	quotas, err := helpers.NewWorkspaceQuotas(nil, nil, models.WorkspaceQuotas{}, models.LoadTestLimits{}, []models.Workspace{
+		{Name: "platform", Members: []string{"dave"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newTestHandler(t, &models.HandlerConfig{Admins: []string{"root"}, WorkspaceQuotas: quotas})
+
+	share := func(userID, patternID, body string) int {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/api/pattern/"+patternID+"/share", strings.NewReader(body)), map[string]string{"id": patternID})
+		rw := httptest.NewRecorder()
+		h.PatternShareHandler(rw, req, nil, &models.User{UserID: userID}, provider)
+		return rw.Code
+	}
+	get := func(userID string) int {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/pattern/"+testPatternID, nil), map[string]string{"id": testPatternID})
+		rw := httptest.NewRecorder()
+		h.GetMesheryPatternHandler(rw, req, nil, &models.User{UserID: userID}, provider)
+		return rw.Code
+	}
+	shares := func(userID string) int {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/pattern/"+testPatternID+"/share", nil), map[string]string{"id": testPatternID})
+		rw := httptest.NewRecorder()
+		h.PatternShareHandler(rw, req, nil, &models.User{UserID: userID}, provider)
+		return rw.Code
+	}
+
+	t.Run("only the owner shares the pattern", func(t *testing.T) {
+		if code := share("bob", testPatternID, `{"kind": "user", "shared_with": "bob"}`); code != http.StatusForbidden {
+			t.Errorf("bob sharing the pattern of alice with themselves got %d, want %d", code, http.StatusForbidden)
+		}
+		if code := share("alice", "not-a-uuid", `{"kind": "user", "shared_with": "bob"}`); code != http.StatusBadRequest {
+			t.Errorf("sharing a pattern of an invalid id got %d, want %d", code, http.StatusBadRequest)
+		}
+		if code := share("alice", "00000000-0000-4000-8000-000000000000", `{"kind": "user", "shared_with": "bob"}`); code != http.StatusNotFound {
+			t.Errorf("sharing a pattern which does not exist got %d, want %d", code, http.StatusNotFound)
+		}
+	})
+
+	t.Run("the pattern is only read by its owner before it is shared", func(t *testing.T) {
+		if code := get("alice"); code != http.StatusOK {
+			t.Errorf("alice getting their pattern got %d, want %d", code, http.StatusOK)
+		}
+		for _, user := range []string{"bob", "dave"} {
+			if code := get(user); code != http.StatusForbidden {
+				t.Errorf("%s getting the pattern of alice got %d, want %d", user, code, http.StatusForbidden)
+			}
+			if code := shares(user); code != http.StatusForbidden {
+				t.Errorf("%s listing the shares of the pattern of alice got %d, want %d", user, code, http.StatusForbidden)
+			}
+		}
+	})
+
+	t.Run("the shares grant the pattern", func(t *testing.T) {
+		if code := share("alice", testPatternID, `{"kind": "user", "shared_with": "bob"}`); code != http.StatusOK {
+			t.Fatalf("alice sharing their pattern with bob got %d, want %d", code, http.StatusOK)
+		}
+		if code := share("alice", testPatternID, `{"kind": "team", "shared_with": "platform", "permission": "edit"}`); code != http.StatusOK {
+			t.Fatalf("alice sharing their pattern with the platform team got %d, want %d", code, http.StatusOK)
+		}
+		for _, user := range []string{"bob", "dave"} {
+			if code := get(user); code != http.StatusOK {
+				t.Errorf("%s getting the pattern shared with them got %d, want %d", user, code, http.StatusOK)
+			}
+			if code := shares(user); code != http.StatusOK {
+				t.Errorf("%s listing the shares of the pattern shared with them got %d, want %d", user, code, http.StatusOK)
+			}
+		}
+		if code := get("carol"); code != http.StatusForbidden {
+			t.Errorf("carol getting the pattern shared with others got %d, want %d", code, http.StatusForbidden)
+		}
+		if code := share("bob", testPatternID, `{"kind": "user", "shared_with": "carol"}`); code != http.StatusForbidden {
+			t.Errorf("bob sharing the pattern shared with them got %d, want %d", code, http.StatusForbidden)
+		}
+	})
+}
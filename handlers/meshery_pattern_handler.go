@@ -22,6 +22,8 @@ type MesheryPatternRequestBody struct {
 	PatternData   *models.MesheryPattern `json:"pattern_data,omitempty"`
 	CytoscapeJSON string                 `json:"cytoscape_json,omitempty"`
 	K8sManifest   string                 `json:"k8s_manifest,omitempty"`
+	ComposeFile   string                 `json:"compose_file,omitempty"`
+	WorkloadScan  *WorkloadScanRequest   `json:"workload_scan,omitempty"`
 }
 
 // PatternFileRequestHandler will handle requests of both type GET and POST
@@ -71,6 +73,11 @@ func (h *Handler) handlePatternPOST(
 		return
 	}
 
+	// New designs count towards the quota of the workspace of the user saving them
+	if parsedBody.Save && (parsedBody.PatternData == nil || parsedBody.PatternData.ID == nil) && !h.checkWorkspaceQuota(rw, user, models.QuotaDesigns) {
+		return
+	}
+
 	token, err := provider.GetProviderToken(r)
 	if err != nil {
 		h.log.Error(ErrRetrieveUserToken(err))
@@ -104,6 +111,9 @@ func (h *Handler) handlePatternPOST(
 		}
 
 		mesheryPattern := parsedBody.PatternData
+		if mesheryPattern.UserID == nil || *mesheryPattern.UserID == "" {
+			mesheryPattern.UserID = &user.UserID
+		}
 
 		if parsedBody.Save {
 			resp, err := provider.SaveMesheryPattern(token, mesheryPattern)
@@ -171,6 +181,7 @@ func (h *Handler) handlePatternPOST(
 				"path": "",
 				"type": "local",
 			},
+			UserID: &user.UserID,
 		}
 
 		if parsedBody.Save {
@@ -196,13 +207,30 @@ func (h *Handler) handlePatternPOST(
 		return
 	}
 
-	if parsedBody.K8sManifest != "" {
-		pattern, err := pCore.NewPatternFileFromK8sManifest(parsedBody.K8sManifest, false)
+	if parsedBody.K8sManifest != "" || parsedBody.ComposeFile != "" || parsedBody.WorkloadScan != nil {
+		var pattern pCore.Pattern
+		if parsedBody.WorkloadScan != nil {
+			pattern, err = scanWorkloads(provider, parsedBody.WorkloadScan)
+		} else if parsedBody.ComposeFile != "" {
+			pattern, err = pCore.NewPatternFileFromCompose([]byte(parsedBody.ComposeFile))
+		} else {
+			pattern, err = pCore.NewPatternFileFromK8sManifest(parsedBody.K8sManifest, false)
+		}
 		if err != nil {
 			http.Error(rw, fmt.Sprintf("failed to convert to pattern: %s", err), http.StatusBadRequest)
 			return
 		}
 
+		// Imported resources are related as they run in the cluster, when MeshSync knows them.
+		// Scanned workloads are related while being reverse engineered.
+		if parsedBody.WorkloadScan == nil {
+			live, err := liveManifests(provider)
+			if err != nil {
+				h.log.Debug(fmt.Sprintf("relating the imported resources without the state of the cluster: %s", err))
+			}
+			pCore.InferRelationships(&pattern, live)
+		}
+
 		patternYAML, err := pattern.ToYAML()
 		if err != nil {
 			http.Error(rw, fmt.Sprintf("failed to generate pattern: %s", err), http.StatusInternalServerError)
@@ -223,6 +251,7 @@ func (h *Handler) handlePatternPOST(
 				"path": "",
 				"type": "local",
 			},
+			UserID: &user.UserID,
 		}
 
 		if parsedBody.Save {
@@ -266,7 +295,7 @@ func (h *Handler) GetMesheryPatternsHandler(
 	q := r.URL.Query()
 	tokenString := r.Context().Value(models.TokenCtxKey).(string)
 
-	resp, err := provider.GetMesheryPatterns(tokenString, q.Get("page"), q.Get("page_size"), q.Get("search"), q.Get("order"))
+	resp, err := provider.GetMesheryPatterns(tokenString, q.Get("page"), q.Get("page_size"), q.Get("search"), q.Get("order"), q.Get("owner"))
 	if err != nil {
 		h.log.Error(ErrFetchPattern(err))
 		http.Error(rw, ErrFetchPattern(err).Error(), http.StatusInternalServerError)
@@ -354,7 +383,7 @@ func (h *Handler) DeleteMultiMesheryPatternsHandler(
 // swagger:route GET /api/pattern/{id} PatternsAPI idGetMesheryPattern
 // Handle GET for a Meshery Pattern
 //
-// Fetches the pattern with the given id
+// Fetches the pattern with the given id, for its owner and the users and teams it is shared with
 // responses:
 // 	200: mesheryPatternResponseWrapper
 
@@ -374,6 +403,19 @@ func (h *Handler) GetMesheryPatternHandler(
 		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
 		return
 	}
+	pattern := &models.MesheryPattern{}
+	if err := json.Unmarshal(resp, pattern); err != nil || pattern.ID == nil {
+		err = fmt.Errorf("pattern %s not found", patternID)
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+	// The pattern is returned to its owner and to the users and teams it is shared with
+	if status, err := h.checkPatternAccess(r, provider, user, pattern, "view"); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), status)
+		return
+	}
 
 	rw.Header().Set("Content-Type", "application/json")
 	fmt.Fprint(rw, string(resp))
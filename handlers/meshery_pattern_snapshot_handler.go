@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshery/models/pattern/core"
+	"github.com/layer5io/meshery/models/pattern/snapshot"
+)
+
+// swagger:route GET /api/pattern/{id}/snapshot PatternsAPI idGetPatternSnapshot
+// Handle GET request for the image of a design
+//
+// Renders the topology of the design, its components and the relationships between them,
+// to an SVG image, or to a PNG image with format=png
+// responses:
+// 	200: patternSnapshotResponseWrapper
+
+// PatternSnapshotHandler renders the topology of a saved design to an image
+func (h *Handler) PatternSnapshotHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = snapshot.SVG
+	}
+	if format != snapshot.SVG && format != snapshot.PNG {
+		err := ErrPatternSnapshot(fmt.Errorf("unsupported image format %q, use svg or png", format))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pattern, err := getSavedPattern(r, provider, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	patternFile, err := core.NewPatternFile([]byte(pattern.PatternFile))
+	if err != nil {
+		h.log.Error(ErrPatternSnapshot(err))
+		http.Error(rw, ErrPatternSnapshot(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if patternFile.Name == "" {
+		patternFile.Name = pattern.Name
+	}
+
+	var image bytes.Buffer
+	if err := snapshot.Render(&image, patternFile, format); err != nil {
+		h.log.Error(ErrPatternSnapshot(err))
+		http.Error(rw, ErrPatternSnapshot(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", snapshot.ContentType(format))
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", pattern.Name+"."+format))
+	_, _ = rw.Write(image.Bytes())
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshery/models/pattern/core"
+)
+
+// swagger:route GET /api/pattern/{id}/upgrade PatternsAPI idGetPatternUpgrade
+// Handle GET request to check a design against the migration rules
+//
+// Returns the deprecated fields used by the components of the design, according to the migration
+// rules registered by the models, along with the design with the rewritable fields rewritten
+// responses:
+// 	200: patternUpgradeCheckResponseWrapper
+
+// swagger:route POST /api/pattern/{id}/upgrade PatternsAPI idPostPatternUpgrade
+// Handle POST request to upgrade a design
+//
+// Rewrites the deprecated fields used by the components of the design which the migration rules
+// can rewrite and saves the design
+// responses:
+// 	200: patternUpgradeCheckResponseWrapper
+
+// PatternUpgradeHandler checks the components of a saved design for deprecated fields and,
+// on POST, rewrites them and saves the design
+func (h *Handler) PatternUpgradeHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	pattern, err := getSavedPattern(r, provider, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	content, findings, err := core.UpgradePatternFile([]byte(pattern.PatternFile), core.GetMigrations())
+	if err != nil {
+		h.log.Error(ErrPatternUpgrade(err))
+		http.Error(rw, ErrPatternUpgrade(err).Error(), http.StatusBadRequest)
+		return
+	}
+	check := core.PatternUpgradeCheck{
+		ID:          pattern.ID.String(),
+		Name:        pattern.Name,
+		Findings:    findings,
+		PatternFile: string(content),
+	}
+
+	if r.Method == http.MethodPost && check.PatternFile != pattern.PatternFile {
+		token, ok := r.Context().Value(models.TokenCtxKey).(string)
+		if !ok {
+			err := ErrRetrieveUserToken(fmt.Errorf("failed to retrieve user token"))
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		pattern.PatternFile = check.PatternFile
+		if _, err := provider.SaveMesheryPattern(token, pattern); err != nil {
+			h.log.Error(ErrPatternUpgrade(err))
+			http.Error(rw, ErrPatternUpgrade(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		check.Saved = true
+	}
+
+	h.writeApplicationJSON(rw, check, "pattern upgrade check")
+}
@@ -6,13 +6,28 @@ import (
 	"net/http"
 
 	"github.com/layer5io/meshery/models"
-	"github.com/layer5io/meshkit/utils/kubernetes"
 	"github.com/sirupsen/logrus"
 )
 
-// ProviderMiddleware is a middleware to validate if a provider is set
+// ProviderMiddleware is a middleware to validate if a provider is set.
+// Requests of workloads bearing a ServiceAccount token of a workload identity
+// get the provider and the session of the user who created the identity, as long
+// as the session is valid.
 func (h *Handler) ProviderMiddleware(next http.Handler) http.Handler {
 	fn := func(w http.ResponseWriter, req *http.Request) {
+		if token, ok := workloadBearerToken(req); ok {
+			identity, err := h.authenticateWorkload(req, token)
+			if err != nil {
+				h.log.Error(err)
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			req.Header.Del("Authorization")
+			req.AddCookie(&http.Cookie{Name: h.config.ProviderCookieName, Value: identity.Provider})
+			req.AddCookie(&http.Cookie{Name: models.TokenCookieName, Value: identity.ProviderToken})
+			req = req.WithContext(context.WithValue(req.Context(), models.WorkloadIdentityCtxKey, identity))
+		}
+
 		var providerName string
 		var provider models.Provider
 		ck, err := req.Cookie(h.config.ProviderCookieName)
@@ -121,8 +136,8 @@ func (h *Handler) SessionInjectorMiddleware(next func(http.ResponseWriter, *http
 				logrus.Warn("failed to load kube config for the user: ", err)
 			}
 
-			// Create mesherykube handler
-			client, err := kubernetes.New(cfg)
+			// Create mesherykube handler, its requests held by the rate limit of the connection
+			client, err := k8scontext.GenerateKubeHandler()
 			if err != nil {
 				logrus.Warn("failed to create kubeconfig handler for the user")
 				// http.Error(w, "failed to create kubeconfig handler for the user", http.StatusInternalServerError)
@@ -172,6 +187,28 @@ func (h *Handler) SessionInjectorMiddleware(next func(http.ResponseWriter, *http
 // GraphqlSessionInjectorMiddleware - is a middleware which injects user and session object
 func (h *Handler) GraphqlMiddleware(next http.Handler) func(http.ResponseWriter, *http.Request, *models.Preference, *models.User, models.Provider) {
 	return func(w http.ResponseWriter, req *http.Request, pref *models.Preference, user *models.User, prov models.Provider) {
-		next.ServeHTTP(w, req)
+		// Resolvers calling provider methods which authenticate from the request need it
+		ctx := context.WithValue(req.Context(), models.RequestCtxKey, req)
+		next.ServeHTTP(w, req.WithContext(ctx))
+	}
+}
+
+// FeatureFlagMiddleware answers the requests to an experimental capability with ErrFeatureDisabled
+// while its feature flag is disabled for the user
+func (h *Handler) FeatureFlagMiddleware(name string, next func(http.ResponseWriter, *http.Request, *models.Preference, *models.User, models.Provider)) func(http.ResponseWriter, *http.Request, *models.Preference, *models.User, models.Provider) {
+	return func(w http.ResponseWriter, req *http.Request, pref *models.Preference, user *models.User, prov models.Provider) {
+		if h.config.FeatureFlagPersister != nil {
+			enabled, err := h.config.FeatureFlagPersister.IsFeatureEnabled(name, user.UserID)
+			if err != nil {
+				h.log.Error(ErrFeatureFlags(err))
+				http.Error(w, ErrFeatureFlags(err).Error(), http.StatusInternalServerError)
+				return
+			}
+			if !enabled {
+				http.Error(w, ErrFeatureDisabled(name).Error(), http.StatusForbidden)
+				return
+			}
+		}
+		next(w, req, pref, user, prov)
 	}
 }
@@ -0,0 +1,531 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+	mesherykube "github.com/layer5io/meshkit/utils/kubernetes"
+	meshsyncmodel "github.com/layer5io/meshsync/pkg/model"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// nativeMesh describes how Meshery Server recognizes a service mesh from the resources
+// discovered by MeshSync and enrolls namespaces in it, without the adapter of the mesh
+type nativeMesh struct {
+	name string
+	// controlPlaneComponent returns the component of the control plane the pod with the
+	// given labels is, or false when it is not part of the control plane
+	controlPlaneComponent func(labels map[string]string) (string, bool)
+	// versionComponent is the component whose version is the version of the mesh
+	versionComponent string
+	// versionLabel is the label of control plane pods telling the version of the mesh, if any
+	versionLabel   string
+	proxyContainer string
+	// injectionEnabled tells whether the pods of a namespace with the given labels and
+	// annotations get the proxy injected
+	injectionEnabled func(labels, annotations map[string]string) bool
+	// namespaceInjection and workloadInjection return the metadata enabling or disabling the
+	// injection of the proxy in the pods of a namespace or in the pod template of a workload
+	namespaceInjection func(enabled bool) metadataChange
+	workloadInjection  func(enabled bool) metadataChange
+	// benchmarkFeatures turns the features of the mesh measured by the mesh overhead benchmark on or off
+	// for the performance test target in the namespace, and returns those it cannot turn off
+	benchmarkFeatures func(ctx context.Context, client dynamic.Interface, namespace string, config models.PerfTargetMeshConfiguration) ([]string, error)
+}
+
+var nativeMeshes = []nativeMesh{
+	{
+		name: "istio",
+		controlPlaneComponent: func(labels map[string]string) (string, bool) {
+			if _, ok := labels["istio"]; !ok {
+				return "", false
+			}
+			if app, ok := labels["app"]; ok {
+				return app, true
+			}
+			return labels["istio"], true
+		},
+		versionComponent: "istiod",
+		proxyContainer:   "istio-proxy",
+		injectionEnabled: func(labels, annotations map[string]string) bool {
+			if labels["istio-injection"] == "enabled" {
+				return true
+			}
+			_, ok := labels["istio.io/rev"]
+			return ok && labels["istio-injection"] != "disabled"
+		},
+		namespaceInjection: func(enabled bool) metadataChange {
+			if enabled {
+				return metadataChange{labels: map[string]*string{"istio-injection": stringPtr("enabled")}}
+			}
+			return metadataChange{labels: map[string]*string{"istio-injection": nil, "istio.io/rev": nil}}
+		},
+		workloadInjection: func(enabled bool) metadataChange {
+			return metadataChange{labels: map[string]*string{"sidecar.istio.io/inject": stringPtr(strconv.FormatBool(enabled))}}
+		},
+		benchmarkFeatures: istioBenchmarkFeatures,
+	},
+	{
+		name: "linkerd",
+		controlPlaneComponent: func(labels map[string]string) (string, bool) {
+			component, ok := labels["linkerd.io/control-plane-component"]
+			return component, ok
+		},
+		versionComponent: "destination",
+		versionLabel:     "linkerd.io/proxy-version",
+		proxyContainer:   "linkerd-proxy",
+		injectionEnabled: func(labels, annotations map[string]string) bool {
+			return annotations["linkerd.io/inject"] == "enabled"
+		},
+		namespaceInjection: func(enabled bool) metadataChange {
+			if enabled {
+				return metadataChange{annotations: map[string]*string{"linkerd.io/inject": stringPtr("enabled")}}
+			}
+			return metadataChange{annotations: map[string]*string{"linkerd.io/inject": nil}}
+		},
+		workloadInjection: func(enabled bool) metadataChange {
+			if enabled {
+				return metadataChange{annotations: map[string]*string{"linkerd.io/inject": stringPtr("enabled")}}
+			}
+			return metadataChange{annotations: map[string]*string{"linkerd.io/inject": stringPtr("disabled")}}
+		},
+		// the proxies of Linkerd always encrypt the traffic between them and report its metrics
+		benchmarkFeatures: func(ctx context.Context, client dynamic.Interface, namespace string, config models.PerfTargetMeshConfiguration) ([]string, error) {
+			if !config.Sidecar {
+				return nil, nil
+			}
+			return []string{"mtls", "telemetry"}, nil
+		},
+	},
+}
+
+func getNativeMesh(name string) (nativeMesh, bool) {
+	for _, mesh := range nativeMeshes {
+		if mesh.name == strings.ToLower(name) {
+			return mesh, true
+		}
+	}
+	return nativeMesh{}, false
+}
+
+// swagger:route GET /api/system/meshes SystemAPI idGetSystemMeshes
+// Handle GET request for the service meshes running in the cluster
+//
+// Returns the Istio and Linkerd meshes discovered by MeshSync, with their version, control plane
+// components and injected namespaces, without requiring their adapters
+// responses:
+// 	200: systemMeshesRespWrapper
+
+// MeshesStatusHandler returns the service meshes found running in the cluster
+func (h *Handler) MeshesStatusHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	meshes, err := detectMeshes(provider)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(meshes); err != nil {
+		obj := "meshes"
+		h.log.Error(ErrMarshal(err, obj))
+		http.Error(w, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/system/meshes/{mesh}/injection SystemAPI idPostMeshInjection
+// Handle POST request to enable or disable the injection of a mesh proxy in a namespace or workload
+//
+// Labels or annotates the namespace, or the pod template of the workload, through the Kubernetes API
+// the way the mesh expects, without requiring the adapter of the mesh. The workloads are restarted
+// for their pods to get or lose the proxy when asked to, and nothing is changed on a dry run.
+// responses:
+// 	200: meshInjectionRespWrapper
+
+// MeshInjectionHandler enables or disables the injection of the proxy of a mesh in a namespace or workload
+func (h *Handler) MeshInjectionHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	mesh, ok := getNativeMesh(mux.Vars(req)["mesh"])
+	if !ok {
+		err := ErrUnsupportedMesh(mux.Vars(req)["mesh"])
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	injection := &models.MeshInjectionRequest{}
+	if err := json.NewDecoder(req.Body).Decode(injection); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if injection.Namespace == "" {
+		err := ErrRequestBody(fmt.Errorf("namespace is required"))
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	kubeclient, ok := req.Context().Value(models.KubeHanderKey).(*mesherykube.Client)
+	if !ok || kubeclient == nil {
+		h.log.Error(ErrNilClient)
+		http.Error(w, ErrNilClient.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := setMeshInjection(req.Context(), kubeclient.KubeClient, mesh, injection)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		obj := "mesh injection"
+		h.log.Error(ErrMarshal(err, obj))
+		http.Error(w, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// detectMeshes returns the meshes whose control plane pods were discovered by MeshSync
+func detectMeshes(provider models.Provider) ([]*models.MeshStatus, error) {
+	db := provider.GetGenericPersister()
+	if db == nil || db.DB == nil {
+		return nil, ErrNativeMesh(fmt.Errorf("meshsync data is not available"))
+	}
+
+	objects := []meshsyncmodel.Object{}
+	err := db.
+		Preload("ObjectMeta").
+		Preload("ObjectMeta.Labels", "kind = ?", meshsyncmodel.KindLabel).
+		Preload("ObjectMeta.Annotations", "kind = ?", meshsyncmodel.KindAnnotation).
+		Preload("Spec").
+		Find(&objects, "kind IN ?", []string{"Pod", "Namespace"}).Error
+	if err != nil {
+		return nil, ErrNativeMesh(err)
+	}
+
+	result := []*models.MeshStatus{}
+	for _, mesh := range nativeMeshes {
+		if status := meshStatus(mesh, objects); status != nil {
+			result = append(result, status)
+		}
+	}
+	return result, nil
+}
+
+// meshStatus returns the status of the mesh from the pods and namespaces discovered by MeshSync,
+// or nil when no pod of its control plane is running
+func meshStatus(mesh nativeMesh, objects []meshsyncmodel.Object) *models.MeshStatus {
+	status := &models.MeshStatus{
+		Name:               mesh.name,
+		Components:         []models.MeshComponentStatus{},
+		InjectedNamespaces: []string{},
+	}
+	for _, obj := range objects {
+		if obj.ObjectMeta == nil {
+			continue
+		}
+		labels := keyValues(obj.ObjectMeta.Labels)
+		if obj.Kind == "Namespace" {
+			if mesh.injectionEnabled(labels, keyValues(obj.ObjectMeta.Annotations)) {
+				status.InjectedNamespaces = append(status.InjectedNamespaces, obj.ObjectMeta.Name)
+			}
+			continue
+		}
+
+		spec := corev1.PodSpec{}
+		if obj.Spec != nil {
+			_ = json.Unmarshal([]byte(obj.Spec.Attribute), &spec)
+		}
+		component, ok := mesh.controlPlaneComponent(labels)
+		if !ok {
+			if hasContainer(spec, mesh.proxyContainer) {
+				status.Proxies++
+			}
+			continue
+		}
+
+		version := labels[mesh.versionLabel]
+		if version == "" && len(spec.Containers) > 0 {
+			version = imageTag(spec.Containers[0].Image)
+		}
+		status.Components = append(status.Components, models.MeshComponentStatus{
+			Name:      obj.ObjectMeta.Name,
+			Component: component,
+			Version:   version,
+			Namespace: obj.ObjectMeta.Namespace,
+		})
+		if status.Namespace == "" || component == mesh.versionComponent {
+			status.Namespace = obj.ObjectMeta.Namespace
+		}
+		if status.Version == "" || component == mesh.versionComponent {
+			status.Version = version
+		}
+	}
+	if len(status.Components) == 0 {
+		return nil
+	}
+
+	sort.Slice(status.Components, func(i, j int) bool { return status.Components[i].Name < status.Components[j].Name })
+	sort.Strings(status.InjectedNamespaces)
+	return status
+}
+
+// metadataChange is the labels and annotations to set on a resource, nil values being removed
+type metadataChange struct {
+	labels      map[string]*string
+	annotations map[string]*string
+}
+
+// diff returns the changes of the labels and annotations of the resource with the given metadata
+func (c metadataChange) diff(resource string, meta metav1.ObjectMeta) []models.MeshInjectionChange {
+	changes := []models.MeshInjectionChange{}
+	for _, field := range []struct {
+		kind    string
+		current map[string]string
+		desired map[string]*string
+	}{{"label", meta.Labels, c.labels}, {"annotation", meta.Annotations, c.annotations}} {
+		keys := make([]string, 0, len(field.desired))
+		for key := range field.desired {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			var from *string
+			if value, ok := field.current[key]; ok {
+				from = &value
+			}
+			to := field.desired[key]
+			if (from == nil && to == nil) || (from != nil && to != nil && *from == *to) {
+				continue
+			}
+			changes = append(changes, models.MeshInjectionChange{Resource: resource, Kind: field.kind, Key: key, From: from, To: to})
+		}
+	}
+	return changes
+}
+
+// patch returns the merge patch of the metadata applying the change
+func (c metadataChange) patch() map[string]interface{} {
+	metadata := map[string]interface{}{}
+	if len(c.labels) > 0 {
+		metadata["labels"] = c.labels
+	}
+	if len(c.annotations) > 0 {
+		metadata["annotations"] = c.annotations
+	}
+	return map[string]interface{}{"metadata": metadata}
+}
+
+// workloadClient gets and patches the workloads of a kind the proxy of a mesh is injected in
+type workloadClient struct {
+	template func(ctx context.Context, namespace, name string) (metav1.ObjectMeta, error)
+	list     func(ctx context.Context, namespace string) ([]string, error)
+	patch    func(ctx context.Context, namespace, name string, patch []byte) error
+}
+
+func workloadClients(client kubernetes.Interface) map[string]workloadClient {
+	apps := client.AppsV1()
+	return map[string]workloadClient{
+		"deployment": {
+			template: func(ctx context.Context, namespace, name string) (metav1.ObjectMeta, error) {
+				d, err := apps.Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+				if err != nil {
+					return metav1.ObjectMeta{}, err
+				}
+				return d.Spec.Template.ObjectMeta, nil
+			},
+			list: func(ctx context.Context, namespace string) ([]string, error) {
+				list, err := apps.Deployments(namespace).List(ctx, metav1.ListOptions{})
+				if err != nil {
+					return nil, err
+				}
+				names := []string{}
+				for _, d := range list.Items {
+					names = append(names, d.Name)
+				}
+				return names, nil
+			},
+			patch: func(ctx context.Context, namespace, name string, patch []byte) error {
+				_, err := apps.Deployments(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+				return err
+			},
+		},
+		"statefulset": {
+			template: func(ctx context.Context, namespace, name string) (metav1.ObjectMeta, error) {
+				s, err := apps.StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+				if err != nil {
+					return metav1.ObjectMeta{}, err
+				}
+				return s.Spec.Template.ObjectMeta, nil
+			},
+			list: func(ctx context.Context, namespace string) ([]string, error) {
+				list, err := apps.StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+				if err != nil {
+					return nil, err
+				}
+				names := []string{}
+				for _, s := range list.Items {
+					names = append(names, s.Name)
+				}
+				return names, nil
+			},
+			patch: func(ctx context.Context, namespace, name string, patch []byte) error {
+				_, err := apps.StatefulSets(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+				return err
+			},
+		},
+		"daemonset": {
+			template: func(ctx context.Context, namespace, name string) (metav1.ObjectMeta, error) {
+				d, err := apps.DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+				if err != nil {
+					return metav1.ObjectMeta{}, err
+				}
+				return d.Spec.Template.ObjectMeta, nil
+			},
+			list: func(ctx context.Context, namespace string) ([]string, error) {
+				list, err := apps.DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+				if err != nil {
+					return nil, err
+				}
+				names := []string{}
+				for _, d := range list.Items {
+					names = append(names, d.Name)
+				}
+				return names, nil
+			},
+			patch: func(ctx context.Context, namespace, name string, patch []byte) error {
+				_, err := apps.DaemonSets(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+				return err
+			},
+		},
+	}
+}
+
+// setMeshInjection labels or annotates the namespace, or the pod template of the workload, so that
+// the proxy of the mesh is injected, or no longer injected, in their pods. The workloads of the
+// namespace, or the workload, are then restarted when asked to. Only the changes are returned on
+// a dry run.
+func setMeshInjection(ctx context.Context, client kubernetes.Interface, mesh nativeMesh, injection *models.MeshInjectionRequest) (*models.MeshInjectionResult, error) {
+	result := &models.MeshInjectionResult{
+		DryRun:    injection.DryRun,
+		Changes:   []models.MeshInjectionChange{},
+		Restarted: []string{},
+	}
+	clients := workloadClients(client)
+
+	// workloads are given as <kind>/<name>, e.g. deployment/reviews-v1
+	var kind, name string
+	var workloads workloadClient
+	if injection.Workload != "" {
+		parts := strings.SplitN(injection.Workload, "/", 2)
+		kind = strings.TrimSuffix(strings.ToLower(parts[0]), "s")
+		var ok bool
+		if workloads, ok = clients[kind]; !ok || len(parts) != 2 || parts[1] == "" {
+			return nil, ErrNativeMesh(fmt.Errorf("workload %s is not a deployment, statefulset or daemonset given as <kind>/<name>", injection.Workload))
+		}
+		name = parts[1]
+	}
+
+	if injection.Workload == "" {
+		change := mesh.namespaceInjection(injection.Enabled)
+		ns, err := client.CoreV1().Namespaces().Get(ctx, injection.Namespace, metav1.GetOptions{})
+		if err != nil {
+			return nil, ErrNativeMesh(err)
+		}
+		result.Changes = change.diff("namespace/"+injection.Namespace, ns.ObjectMeta)
+		if len(result.Changes) > 0 && !injection.DryRun {
+			patch, _ := json.Marshal(change.patch())
+			if _, err := client.CoreV1().Namespaces().Patch(ctx, injection.Namespace, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return nil, ErrNativeMesh(err)
+			}
+		}
+	} else {
+		change := mesh.workloadInjection(injection.Enabled)
+		template, err := workloads.template(ctx, injection.Namespace, name)
+		if err != nil {
+			return nil, ErrNativeMesh(err)
+		}
+		result.Changes = change.diff(kind+"/"+name, template)
+		if len(result.Changes) > 0 && !injection.DryRun {
+			patch, _ := json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"template": change.patch()}})
+			if err := workloads.patch(ctx, injection.Namespace, name, patch); err != nil {
+				return nil, ErrNativeMesh(err)
+			}
+		}
+	}
+	if !injection.Restart {
+		return result, nil
+	}
+
+	restart, err := rolloutRestartPatch()
+	if err != nil {
+		return nil, ErrNativeMesh(err)
+	}
+	restartKinds := []string{"deployment", "statefulset", "daemonset"}
+	if kind != "" {
+		restartKinds = []string{kind}
+	}
+	for _, k := range restartKinds {
+		names := []string{name}
+		if kind == "" {
+			names, err = clients[k].list(ctx, injection.Namespace)
+			if err != nil {
+				return nil, ErrNativeMesh(err)
+			}
+		}
+		for _, n := range names {
+			if !injection.DryRun {
+				if err := clients[k].patch(ctx, injection.Namespace, n, restart); err != nil {
+					return nil, ErrNativeMesh(err)
+				}
+			}
+			result.Restarted = append(result.Restarted, k+"/"+n)
+		}
+	}
+	return result, nil
+}
+
+// rolloutRestartPatch returns the patch restarting a workload, changing its pod template
+// the way kubectl rollout restart does
+func rolloutRestartPatch() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"template": metadataChange{
+		annotations: map[string]*string{"kubectl.kubernetes.io/restartedAt": stringPtr(time.Now().Format(time.RFC3339))},
+	}.patch()}})
+}
+
+func hasContainer(spec corev1.PodSpec, name string) bool {
+	for _, c := range append(spec.Containers, spec.InitContainers...) {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// imageTag returns the tag of the container image, e.g. 1.12.1 of docker.io/istio/pilot:1.12.1
+func imageTag(image string) string {
+	image = strings.SplitN(image, "@", 2)[0]
+	name := image[strings.LastIndex(image, "/")+1:]
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return "unknown"
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// newRelicQueryPrefix marks the NRQL queries tracked for a performance test
+const newRelicQueryPrefix = "newrelic:"
+
+// swagger:route GET /api/telemetry/metrics/newrelic/config NewRelicAPI idGetNewRelicConfig
+// Handle GET for New Relic configuration
+//
+// Used for fetching New Relic configuration
+// responses:
+//  200: newRelicConfigResponseWrapper
+
+// swagger:route POST /api/telemetry/metrics/newrelic/config NewRelicAPI idPostNewRelicConfig
+// Handle POST for New Relic configuration
+//
+// Used for persisting New Relic configuration
+// responses:
+//  200:
+
+// swagger:route DELETE /api/telemetry/metrics/newrelic/config NewRelicAPI idDeleteNewRelicConfig
+// Handle DELETE for New Relic configuration
+//
+// Used for deleting New Relic configuration
+// responses:
+//  200:
+
+// NewRelicConfigHandler is used for persisting New Relic configuration
+func (h *Handler) NewRelicConfigHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	if req.Method == http.MethodGet {
+		err := json.NewEncoder(w).Encode(prefObj.NewRelic)
+		if err != nil {
+			obj := "New Relic config"
+			h.log.Error(ErrMarshal(err, obj))
+			http.Error(w, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+			return
+		}
+		return
+	}
+
+	if req.Method == http.MethodPost {
+		cfg := &models.NewRelic{
+			Region:    req.FormValue("region"),
+			AccountID: req.FormValue("accountID"),
+			APIKey:    req.FormValue("apiKey"),
+		}
+		if err := h.config.NewRelicClient.Validate(req.Context(), cfg); err != nil {
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		prefObj.NewRelic = cfg
+		h.log.Debug("New Relic account ", cfg.AccountID, " successfully saved")
+	} else if req.Method == http.MethodDelete {
+		prefObj.NewRelic = nil
+	}
+
+	err := provider.RecordPreferences(req, user.UserID, prefObj)
+	if err != nil {
+		h.log.Error(ErrRecordPreferences(err))
+		http.Error(w, ErrRecordPreferences(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	_, _ = w.Write([]byte("{}"))
+}
+
+// swagger:route GET /api/telemetry/metrics/newrelic/query NewRelicAPI idGetNewRelicQuery
+// Handle GET request for New Relic Query
+//
+// Used to run NRQL queries
+// responses:
+// 	200:
+
+// NewRelicQueryHandler handles New Relic NRQL queries
+func (h *Handler) NewRelicQueryHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	if prefObj.NewRelic == nil || prefObj.NewRelic.APIKey == "" {
+		h.log.Error(ErrNewRelicConfig)
+		http.Error(w, ErrNewRelicConfig.Error(), http.StatusBadRequest)
+		return
+	}
+
+	reqQuery := req.URL.Query()
+	query := reqQuery.Get("query")
+
+	testUUID := reqQuery.Get("uuid")
+	if testUUID != "" {
+		h.config.QueryTracker.AddOrFlagQuery(req.Context(), testUUID, newRelicQueryPrefix+query, false)
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if reqQuery.Get("start") != "" || reqQuery.Get("end") != "" {
+		start, end := parseQueryRange(reqQuery.Get("start"), reqQuery.Get("end"))
+		data, err = h.config.NewRelicClient.QueryRange(req.Context(), prefObj.NewRelic, query, start, end)
+	} else {
+		data, err = h.config.NewRelicClient.Query(req.Context(), prefObj.NewRelic, query)
+	}
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(data)
+}
@@ -13,10 +13,13 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/gofrs/uuid"
 	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/helpers"
 	"github.com/layer5io/meshery/meshes"
 	"github.com/layer5io/meshery/models"
 	"github.com/layer5io/meshery/models/pattern/core"
 	"github.com/layer5io/meshery/models/pattern/patterns"
+	"github.com/layer5io/meshery/models/pattern/patterns/k8s"
+	"github.com/layer5io/meshery/models/pattern/secrets"
 	"github.com/layer5io/meshery/models/pattern/stages"
 	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
 	"github.com/sirupsen/logrus"
@@ -84,16 +87,33 @@ func (h *Handler) PatternFileHandler(
 		return
 	}
 
+	opts := h.patternDeployOptionsFromRequest(r, user.UserID)
+	opts.validationWebhooks = h.validationWebhooks()
+
+	// The deployment is tracked as a job, cancelled along with the wait for the readiness of the
+	// components, unless the design is only verified
+	verify := r.URL.Query().Get("verify") == "true"
+	job := models.UntrackedJob(r.Context())
+	if !verify {
+		operation := "Deploy"
+		if isDel {
+			operation = "Undeploy"
+		}
+		job = h.startJob(r.Context(), models.JobDesignDeployment, fmt.Sprintf("%s of design %s", operation, patternFile.Name), user.UserID, true)
+	}
 	msg, err := _processPattern(
-		r.Context(),
+		job.Context(),
 		provider,
 		patternFile,
 		prefObj,
 		user.UserID,
 		isDel,
-		r.URL.Query().Get("verify") == "true",
+		verify,
 		false,
+		opts,
 	)
+	job.Message(msg)
+	job.Finish(err)
 
 	if err != nil {
 		h.log.Error(ErrCompConfigPairs(err))
@@ -138,7 +158,7 @@ func (h *Handler) PatternFileHandler(
 func (h *Handler) OAMRegisterHandler(rw http.ResponseWriter, r *http.Request) {
 	typ := mux.Vars(r)["type"]
 
-	if !(typ == "workload" || typ == "trait" || typ == "scope") {
+	if !(typ == "workload" || typ == "trait" || typ == "scope" || typ == "migration") {
 		rw.WriteHeader(http.StatusNotFound)
 		return
 	}
@@ -262,7 +282,7 @@ func (h *Handler) OAMComponentDetailByIDHandler(rw http.ResponseWriter, r *http.
 //
 // Adding a workload/trait/scope
 //
-// {type} being of either trait, scope, workload; registration of adapter capabilities.
+// {type} being of either trait, scope, workload or migration; registration of adapter capabilities and of the migration rules of their components.
 // Example: /api/oam/trait => Here {type} is "trait"
 //
 // responses:
@@ -285,6 +305,9 @@ func (h *Handler) POSTOAMRegisterHandler(typ string, r *http.Request) error {
 	if typ == "scope" {
 		return core.RegisterScope(body)
 	}
+	if typ == "migration" {
+		return core.RegisterMigration(body)
+	}
 
 	return nil
 }
@@ -294,7 +317,7 @@ func (h *Handler) POSTOAMRegisterHandler(typ string, r *http.Request) error {
 //
 // Getting list of workloads/traits/scopes
 //
-// {type} being of either trait, scope, workload; registration of adapter capabilities.
+// {type} being of either trait, scope, workload or migration; registration of adapter capabilities and of the migration rules of their components.
 // Example: /api/oam/workload => Here {type} is "workload"
 //
 // responses:
@@ -354,6 +377,13 @@ func (h *Handler) GETOAMRegisterHandler(typ string, rw http.ResponseWriter, trim
 			http.Error(rw, ErrScopeDefinition(err).Error(), http.StatusInternalServerError)
 		}
 	}
+
+	if typ == "migration" {
+		if err := enc.Encode(core.GetMigrations()); err != nil {
+			h.log.Error(ErrEncoding(err, "migration rules"))
+			http.Error(rw, ErrEncoding(err, "migration rules").Error(), http.StatusInternalServerError)
+		}
+	}
 }
 
 func mergeMsgs(msgs []string) string {
@@ -377,6 +407,7 @@ func _processPattern(
 	isDelete bool,
 	verify bool,
 	skipPrintLogs bool,
+	opts patternDeployOptions,
 ) (string, error) {
 	// Get the token from the context
 	token, ok := ctx.Value(models.TokenCtxKey).(string)
@@ -402,7 +433,19 @@ func _processPattern(
 		return "", ErrInvalidKubeContext(fmt.Errorf("failed to find k8s context"), "_processPattern couldn't find a valid k8s context")
 	}
 
+	if err := opts.checkNamespaces(pattern); err != nil {
+		return "", err
+	}
+
 	internal := func(kubeClient *meshkube.Client, kubecfg []byte, mk8scontext *models.K8sContext) (string, error) {
+		if opts.serviceAccount != "" {
+			var err error
+			kubecfg, kubeClient, err = opts.impersonate(kubecfg, mk8scontext.Name)
+			if err != nil {
+				return "", err
+			}
+		}
+
 		sip := &serviceInfoProvider{
 			token:      token,
 			provider:   provider,
@@ -421,24 +464,51 @@ func _processPattern(
 
 			accumulatedMsgs: []string{},
 			err:             nil,
+
+			ctx:              ctx,
+			readinessTimeout: opts.timeout,
 		}
 
 		chain := stages.CreateChain()
 		chain.
 			Add(stages.Import(sip, sap)).
-			Add(stages.ServiceIdentifier(sip, sap)).
-			Add(stages.Filler(skipPrintLogs)).
-			Add(stages.Validator(sip, sap))
+			Add(stages.ServiceIdentifier(sip, sap))
+
+		if isDelete && opts.recordedOnly {
+			chain.Add(stages.RecordedServices(opts.keepNamespace))
+		}
+
+		chain.Add(stages.Filler(skipPrintLogs))
+		if len(opts.components) > 0 {
+			chain.Add(stages.SelectedServices(sip, opts.components))
+		}
+		if opts.secrets != nil && !isDelete {
+			// Kubernetes Secrets are read from the cluster the pattern is deployed to,
+			// as the impersonated ServiceAccount if any
+			resolvers := opts.secrets.With(secrets.NewKubernetesSecretResolver(kubeClient.KubeClient))
+			chain.Add(stages.ResolveSecrets(ctx, resolvers))
+		}
+		chain.Add(stages.Validator(sip, sap))
+		if !isDelete && len(opts.validationWebhooks) > 0 {
+			// The rendered manifests are validated even when the pattern is only verified, as a pre-flight check
+			validator := helpers.NewDesignValidator(opts.validationWebhooks, userID, opts.designID, mk8scontext.Name, verify)
+			chain.Add(stages.ValidateManifests(ctx, validator, sap))
+		}
 
 		if !verify {
-			chain.
-				Add(stages.Provision(sip, sap)).
-				Add(stages.Persist(sip, sap))
+			chain.Add(stages.Provision(sip, sap))
+			if opts.transactional {
+				chain.Add(stages.Rollback(sip, sap))
+			}
+			chain.Add(stages.Persist(sip, sap))
 		}
 
+		labels, annotations := opts.ownership(pattern)
+		var provisioned []stages.ProvisionedService
 		chain.
 			Add(func(data *stages.Data, err error, next stages.ChainStageNextFunction) {
 				data.Lock.Lock()
+				provisioned, _ = data.Other[stages.ProvisionedKey].([]stages.ProvisionedService)
 				for k, v := range data.Other {
 					if strings.HasSuffix(k, stages.ProvisionSuffixKey) {
 						msg, ok := v.(string)
@@ -452,10 +522,18 @@ func _processPattern(
 				sap.err = err
 			}).
 			Process(&stages.Data{
-				Pattern: &pattern,
-				Other:   map[string]interface{}{},
+				Pattern:     &pattern,
+				Labels:      labels,
+				Annotations: annotations,
+				Other:       map[string]interface{}{},
 			})
 
+		if opts.wait && !isDelete && !verify && sap.err == nil {
+			msgs, err := opts.waitForReadiness(ctx, kubeClient, provisioned)
+			sap.accumulatedMsgs = append(sap.accumulatedMsgs, msgs...)
+			sap.err = err
+		}
+
 		return mergeMsgs(sap.accumulatedMsgs), sap.err
 	}
 
@@ -555,6 +633,10 @@ type serviceActionProvider struct {
 	skipPrintLogs   bool
 	accumulatedMsgs []string
 	err             error
+
+	// ctx and readinessTimeout bound the wait for the components depended upon to become ready
+	ctx              context.Context
+	readinessTimeout time.Duration
 }
 
 func (sap *serviceActionProvider) Terminate(err error) {
@@ -565,6 +647,31 @@ func (sap *serviceActionProvider) Terminate(err error) {
 }
 
 func (sap *serviceActionProvider) Provision(ccp stages.CompConfigPair) (string, error) {
+	return sap.provision(ccp, sap.opIsDelete)
+}
+
+// Rollback deletes a component which was provisioned as part of the pattern
+func (sap *serviceActionProvider) Rollback(ccp stages.CompConfigPair) (string, error) {
+	return sap.provision(ccp, true)
+}
+
+// WaitForReady waits for a kubernetes component to become ready, or to complete if a Job. The
+// readiness of the components provisioned by the adapters is not tracked.
+func (sap *serviceActionProvider) WaitForReady(ccp stages.CompConfigPair) error {
+	if !strings.HasSuffix(strings.ToLower(ccp.Component.Spec.Type), ".k8s") {
+		return nil
+	}
+	timeout := sap.readinessTimeout
+	if timeout <= 0 {
+		timeout = defaultReadinessTimeout
+	}
+	ctx, cancel := context.WithTimeout(sap.ctx, timeout)
+	defer cancel()
+
+	return k8s.WaitForReady(ctx, sap.kubeClient, ccp.Component)
+}
+
+func (sap *serviceActionProvider) provision(ccp stages.CompConfigPair, isDelete bool) (string, error) {
 	// Marshal the component
 	jsonComp, err := json.Marshal(ccp.Component)
 	if err != nil {
@@ -590,7 +697,7 @@ func (sap *serviceActionProvider) Provision(ccp stages.CompConfigPair) (string,
 				sap.kubeClient,
 				[]string{string(jsonComp)},
 				string(jsonConfig),
-				sap.opIsDelete,
+				isDelete,
 			)
 
 			return resp, err
@@ -614,7 +721,7 @@ func (sap *serviceActionProvider) Provision(ccp stages.CompConfigPair) (string,
 		if strings.HasPrefix(adapter, string(rawAdapter)) {
 			resp, err := mClient.MClient.ApplyOperation(context.TODO(), &meshes.ApplyRuleRequest{
 				Username:  sap.userID,
-				DeleteOp:  sap.opIsDelete,
+				DeleteOp:  isDelete,
 				OpName:    "custom",
 				Namespace: "",
 			})
@@ -625,7 +732,7 @@ func (sap *serviceActionProvider) Provision(ccp stages.CompConfigPair) (string,
 		// Else it is an OAM adapter call
 		resp, err := mClient.MClient.ProcessOAM(context.TODO(), &meshes.ProcessOAMRequest{
 			Username:  sap.userID,
-			DeleteOp:  sap.opIsDelete,
+			DeleteOp:  isDelete,
 			OamComps:  []string{string(jsonComp)},
 			OamConfig: string(jsonConfig),
 		})
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route POST /api/user/ownership/transfer OwnershipAPI idPostOwnershipTransfer
+// Handle POST request for the transfer of the ownership of artifacts
+//
+// Transfers the designs, performance profiles and results of the given IDs, or all
+// of those owned by a user, to another user
+// responses:
+// 	200: ownershipTransferResponseWrapper
+
+// OwnershipTransferHandler transfers the ownership of designs, performance profiles and
+// results to another user, such as when a team member leaves the team
+func (h *Handler) OwnershipTransferHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	transfer := &models.OwnershipTransfer{}
+	if err := json.NewDecoder(r.Body).Decode(transfer); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := transfer.Validate(); err != nil {
+		h.log.Error(ErrOwnershipTransfer(err))
+		http.Error(rw, ErrOwnershipTransfer(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if transfer.To == transfer.From {
+		err := ErrOwnershipTransfer(fmt.Errorf("the artifacts are owned by %s already", transfer.To))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	token, err := provider.GetProviderToken(r)
+	if err != nil {
+		h.log.Error(ErrRetrieveUserToken(err))
+		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	result, err := provider.TransferOwnership(token, transfer)
+	if err != nil {
+		h.log.Error(ErrOwnershipTransfer(err))
+		http.Error(rw, ErrOwnershipTransfer(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.log.Info(fmt.Sprintf("user %s transferred the ownership of %v to %s", user.UserID, result.Transferred, transfer.To))
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(result); err != nil {
+		h.log.Error(ErrMarshal(err, "ownership transfer"))
+		http.Error(rw, ErrMarshal(err, "ownership transfer").Error(), http.StatusInternalServerError)
+	}
+}
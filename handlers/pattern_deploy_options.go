@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshery/models/pattern/core"
+	"github.com/layer5io/meshery/models/pattern/patterns/k8s"
+	"github.com/layer5io/meshery/models/pattern/secrets"
+	"github.com/layer5io/meshery/models/pattern/stages"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	"github.com/spf13/viper"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// patternDeployOptions holds the optional behaviour requested for a pattern deployment
+type patternDeployOptions struct {
+	// serviceAccount, as "namespace/name", is impersonated while applying the pattern
+	serviceAccount string
+	// namespaces the pattern is allowed to deploy to, set by the admins for the workspace of the user,
+	// any namespace if empty unless a ServiceAccount is impersonated
+	namespaces []string
+	// transactional rolls back the services provisioned so far when the pattern fails to apply
+	transactional bool
+	// recordedOnly restricts a deletion to the services Meshery has a record of provisioning
+	recordedOnly bool
+	// keepNamespace leaves the namespaces of the pattern in place on deletion
+	keepNamespace bool
+	// wait for the applied components to become ready, for at most timeout
+	wait    bool
+	timeout time.Duration
+	// secrets resolves the secret references of the pattern, which are left as is when nil
+	secrets *secrets.Registry
+	// components restricts the deployment to the given services of the pattern, all of them if empty
+	components []string
+	// designID is the ID of the saved design deployed, set on the resources applied along with the
+	// other labels of ownership
+	designID string
+	// validationWebhooks the manifests of the pattern are validated with before it is deployed
+	validationWebhooks []models.ValidationWebhook
+}
+
+// defaultReadinessTimeout is how long to wait for the components to become ready when no timeout is given
+const defaultReadinessTimeout = 5 * time.Minute
+
+// patternDeployOptionsFromRequest reads the deployment options from the query parameters of the
+// request. The secret references are resolved and the namespaces are restricted as allowed to the user.
+func (h *Handler) patternDeployOptionsFromRequest(r *http.Request, userID string) patternDeployOptions {
+	q := r.URL.Query()
+	opts := patternDeployOptions{
+		serviceAccount: strings.TrimSpace(q.Get("serviceAccount")),
+		namespaces:     h.deployNamespaces(userID),
+		transactional:  q.Get("transactional") == "true",
+		recordedOnly:   q.Get("recordedOnly") == "true",
+		keepNamespace:  q.Get("keepNamespace") == "true",
+		wait:           q.Get("wait") == "true",
+		timeout:        defaultReadinessTimeout,
+		secrets:        h.secretResolvers(userID),
+	}
+	if designID, err := uuid.FromString(strings.TrimSpace(q.Get("designId"))); err == nil {
+		opts.designID = designID.String()
+	}
+	if timeout, err := time.ParseDuration(q.Get("timeout")); err == nil && timeout > 0 {
+		opts.timeout = timeout
+	}
+	for _, c := range strings.Split(q.Get("components"), ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			opts.components = append(opts.components, c)
+		}
+	}
+	return opts
+}
+
+// secretResolvers returns the resolvers of the secret references of the designs of the user, who
+// references the Vault secrets under the paths the admins allowed the workspace of the user
+func (h *Handler) secretResolvers(userID string) *secrets.Registry {
+	paths := h.config.VaultPaths
+	if h.config.WorkspaceQuotas != nil {
+		if ws, ok := h.config.WorkspaceQuotas.Workspace(userID); ok {
+			paths = ws.VaultPaths
+		}
+	}
+	return h.config.SecretResolvers.WithVaultPaths(paths)
+}
+
+// deployNamespaces returns the namespaces the designs of the user may be deployed to, those the
+// admins allowed the workspace of the user
+func (h *Handler) deployNamespaces(userID string) []string {
+	namespaces := h.config.DeployNamespaces
+	if h.config.WorkspaceQuotas != nil {
+		if ws, ok := h.config.WorkspaceQuotas.Workspace(userID); ok {
+			namespaces = ws.Namespaces
+		}
+	}
+	return namespaces
+}
+
+// ownership returns the labels and annotations of ownership set on the resources applied for the
+// pattern, which tell the resources left behind by the designs since deleted
+func (opts patternDeployOptions) ownership(pattern core.Pattern) (map[string]string, map[string]string) {
+	instanceID := ""
+	if mid, ok := viper.Get("INSTANCE_ID").(*uuid.UUID); ok && mid != nil {
+		instanceID = mid.String()
+	}
+	return k8s.OwnershipLabels(instanceID, opts.designID), k8s.OwnershipAnnotations(pattern.Name)
+}
+
+// checkNamespaces verifies that every service of the pattern targets one of the allowed
+// namespaces, and that the impersonated ServiceAccount is in one of them. A pattern impersonating
+// a ServiceAccount is denied when no namespaces are allowed.
+func (opts patternDeployOptions) checkNamespaces(pattern core.Pattern) error {
+	if len(opts.namespaces) == 0 {
+		if opts.serviceAccount != "" {
+			return ErrImpersonation(fmt.Errorf("no namespaces are allowed to the designs deployed as a ServiceAccount by the admins of Meshery Server"))
+		}
+		return nil
+	}
+
+	allowed := map[string]bool{}
+	for _, ns := range opts.namespaces {
+		allowed[ns] = true
+	}
+	if opts.serviceAccount != "" {
+		if ns := strings.Split(opts.serviceAccount, "/")[0]; !allowed[ns] {
+			return ErrImpersonation(fmt.Errorf("service account %s is in the namespace %s, which is not among the allowed namespaces", opts.serviceAccount, ns))
+		}
+	}
+	for name, svc := range pattern.Services {
+		ns := svc.Namespace
+		if ns == "" {
+			ns = "default"
+		}
+		if !allowed[ns] {
+			return ErrRestrictedNamespace(name, ns)
+		}
+	}
+	return nil
+}
+
+// impersonate returns a kubeconfig and kube handler which act as the requested
+// ServiceAccount in the given context, so that the pattern is applied with the
+// permissions granted to that ServiceAccount rather than the ones of Meshery
+func (opts patternDeployOptions) impersonate(kubecfg []byte, contextName string) ([]byte, *meshkube.Client, error) {
+	parts := strings.Split(opts.serviceAccount, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, nil, ErrImpersonation(fmt.Errorf("service account %q must be of the form namespace/name", opts.serviceAccount))
+	}
+
+	cfg, err := clientcmd.Load(kubecfg)
+	if err != nil {
+		return nil, nil, ErrImpersonation(err)
+	}
+	if _, ok := cfg.Contexts[contextName]; ok {
+		cfg.CurrentContext = contextName
+	}
+	kctx, ok := cfg.Contexts[cfg.CurrentContext]
+	if !ok {
+		return nil, nil, ErrImpersonation(fmt.Errorf("context %q not found in the kubeconfig", contextName))
+	}
+	authInfo, ok := cfg.AuthInfos[kctx.AuthInfo]
+	if !ok {
+		return nil, nil, ErrImpersonation(fmt.Errorf("user %q not found in the kubeconfig", kctx.AuthInfo))
+	}
+	authInfo.Impersonate = fmt.Sprintf("system:serviceaccount:%s:%s", parts[0], parts[1])
+
+	impersonatedCfg, err := clientcmd.Write(*cfg)
+	if err != nil {
+		return nil, nil, ErrImpersonation(err)
+	}
+	kubeClient, err := meshkube.New(impersonatedCfg)
+	if err != nil {
+		return nil, nil, ErrImpersonation(err)
+	}
+	return impersonatedCfg, kubeClient, nil
+}
+
+// waitForReadiness waits for the kubernetes components provisioned for the pattern
+// to become ready and reports the readiness of every component
+func (opts patternDeployOptions) waitForReadiness(ctx context.Context, kubeClient *meshkube.Client, provisioned []stages.ProvisionedService) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+
+	start := time.Now()
+	msgs := []string{}
+	errs := []string{}
+	for _, svc := range provisioned {
+		if !strings.HasSuffix(strings.ToLower(svc.Component.Spec.Type), ".k8s") {
+			msgs = append(msgs, fmt.Sprintf("readiness of %s is not tracked", svc.Name))
+			continue
+		}
+		if err := k8s.WaitForReady(ctx, kubeClient, svc.Component); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		msgs = append(msgs, fmt.Sprintf("%s is ready after %s", svc.Name, time.Since(start).Round(time.Second)))
+	}
+
+	if len(errs) > 0 {
+		return msgs, ErrPatternNotReady(fmt.Errorf("%s", strings.Join(errs, "\n")), opts.timeout)
+	}
+	return msgs, nil
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshery/models/pattern/core"
+	"github.com/layer5io/meshery/models/pattern/secrets"
+)
+
+func TestSecretResolvers(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"data":{"password":"s3cret"},"metadata":{"version":1}}}`))
+	}))
+	defer vault.Close()
+
+	quotas, err := helpers.NewWorkspaceQuotas(nil, nil, models.WorkspaceQuotas{}, models.LoadTestLimits{}, []models.Workspace{
+		{Name: "payments", Members: []string{"alice"}, VaultPaths: []string{"secret/data/payments"}},
+		{Name: "shop", Members: []string{"bob"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newTestHandler(t, &models.HandlerConfig{
+		SecretResolvers: secrets.NewRegistry(secrets.NewVaultResolver(vault.URL, "root", "")),
+		VaultPaths:      []string{"secret/data/shared"},
+		WorkspaceQuotas: quotas,
+	})
+
+	tests := []struct {
+		userID  string
+		ref     string
+		wantErr bool
+	}{
+		{userID: "alice", ref: "vault://secret/data/payments/db#password"},
+		{userID: "alice", ref: "vault://secret/data/shop/db#password", wantErr: true},
+		{userID: "alice", ref: "vault://secret/data/shared/db#password", wantErr: true},
+		// the members of a workspace without Vault paths reference no Vault secrets
+		{userID: "bob", ref: "vault://secret/data/payments/db#password", wantErr: true},
+		{userID: "bob", ref: "vault://secret/data/shared/db#password", wantErr: true},
+		// the users in no workspace reference those of VAULT_PATHS
+		{userID: "carol", ref: "vault://secret/data/shared/db#password"},
+		{userID: "carol", ref: "vault://secret/data/payments/db#password", wantErr: true},
+	}
+	for _, tt := range tests {
+		_, _, err := h.secretResolvers(tt.userID).Resolve(context.Background(), tt.ref)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s resolving %s: error = %v, wantErr %v", tt.userID, tt.ref, err, tt.wantErr)
+		}
+	}
+}
+
+func TestDeployNamespaces(t *testing.T) {
+	quotas, err := helpers.NewWorkspaceQuotas(nil, nil, models.WorkspaceQuotas{}, models.LoadTestLimits{}, []models.Workspace{
+		{Name: "payments", Members: []string{"alice"}, Namespaces: []string{"payments", "payments-staging"}},
+		{Name: "shop", Members: []string{"bob"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newTestHandler(t, &models.HandlerConfig{
+		DeployNamespaces: []string{"sandbox"},
+		WorkspaceQuotas:  quotas,
+	})
+	pattern := func(namespaces ...string) core.Pattern {
+		p := core.Pattern{Services: map[string]*core.Service{}}
+		for _, ns := range namespaces {
+			p.Services["svc-"+ns] = &core.Service{Namespace: ns}
+		}
+		return p
+	}
+
+	tests := []struct {
+		name    string
+		userID  string
+		query   string
+		pattern core.Pattern
+		wantErr bool
+	}{
+		{name: "namespaces of the workspace", userID: "alice", pattern: pattern("payments", "payments-staging")},
+		{name: "namespace of another workspace", userID: "alice", pattern: pattern("payments", "shop"), wantErr: true},
+		{name: "namespaces of the query ignored", userID: "alice", query: "?namespaces=shop", pattern: pattern("shop"), wantErr: true},
+		{name: "ServiceAccount of the workspace", userID: "alice", query: "?serviceAccount=payments/deployer", pattern: pattern("payments")},
+		{name: "ServiceAccount of another namespace", userID: "alice", query: "?serviceAccount=kube-system/deployer", pattern: pattern("payments"), wantErr: true},
+		// the members of a workspace without namespaces deploy anywhere, but not as a ServiceAccount
+		{name: "workspace without namespaces", userID: "bob", pattern: pattern("shop", "sandbox")},
+		{name: "ServiceAccount of a workspace without namespaces", userID: "bob", query: "?serviceAccount=shop/deployer", pattern: pattern("shop"), wantErr: true},
+		// the users in no workspace deploy to those of DEPLOY_NAMESPACES
+		{name: "DEPLOY_NAMESPACES", userID: "carol", query: "?serviceAccount=sandbox/deployer", pattern: pattern("sandbox")},
+		{name: "outside DEPLOY_NAMESPACES", userID: "carol", pattern: pattern("payments"), wantErr: true},
+	}
+	for _, tt := range tests {
+		opts := h.patternDeployOptionsFromRequest(httptest.NewRequest(http.MethodPost, "/api/pattern/deploy"+tt.query, nil), tt.userID)
+		if err := opts.checkNamespaces(tt.pattern); (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	// with no namespaces set by the admins, the designs are only deployed as Meshery Server
+	h = newTestHandler(t, &models.HandlerConfig{})
+	if err := h.patternDeployOptionsFromRequest(httptest.NewRequest(http.MethodPost, "/api/pattern/deploy", nil), "carol").checkNamespaces(pattern("default")); err != nil {
+		t.Errorf("design deployed without namespaces set failed: %v", err)
+	}
+	if err := h.patternDeployOptionsFromRequest(httptest.NewRequest(http.MethodPost, "/api/pattern/deploy?serviceAccount=default/deployer", nil), "carol").checkNamespaces(pattern("default")); err == nil {
+		t.Error("design deployed as a ServiceAccount without namespaces set was not denied")
+	}
+}
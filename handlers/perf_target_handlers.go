@@ -0,0 +1,475 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+	mesherykube "github.com/layer5io/meshkit/utils/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kubeerror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+var (
+	// perfTargetReadyTimeout is how long the deployment of the echo server waits for it to become available
+	perfTargetReadyTimeout = 90 * time.Second
+	perfTargetPollInterval = 2 * time.Second
+)
+
+// swagger:route GET /api/perf/targets PerformanceAPI idGetPerfTargets
+// Handle GET request for the performance test targets
+//
+// Returns the echo servers deployed by Meshery as targets of performance tests
+// responses:
+// 	200: perfTargetsResponseWrapper
+
+// swagger:route POST /api/perf/targets PerformanceAPI idPostPerfTarget
+// Handle POST request to deploy a performance test target
+//
+// Deploys the fortio echo server, and a fortio proxy in front of it, in a namespace of a cluster and
+// registers their URLs, giving a known-good target to measure the overhead of a service mesh without
+// touching the applications
+// responses:
+// 	200: perfTargetResponseWrapper
+
+// PerfTargetsHandler handles the requests to list and deploy performance test targets
+func (h *Handler) PerfTargetsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if r.Method == http.MethodGet {
+		targets, err := h.config.PerfTargetPersister.GetPerfTargets()
+		if err != nil {
+			h.log.Error(ErrPerfTarget(err))
+			http.Error(rw, ErrPerfTarget(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		h.writePerfTargetJSON(rw, targets)
+		return
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	deployReq := &models.PerfTargetDeployRequest{}
+	if err := json.NewDecoder(r.Body).Decode(deployReq); err != nil {
+		obj := "performance test target"
+		h.log.Error(ErrDecoding(err, obj))
+		http.Error(rw, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+		return
+	}
+	if deployReq.Namespace == "" {
+		deployReq.Namespace = models.DefaultPerfTargetNamespace
+	}
+	if deployReq.Image == "" {
+		deployReq.Image = models.DefaultPerfTargetImage
+	}
+
+	k8sContextID, kubeClient, status, err := h.perfTargetCluster(r, provider, deployReq.Cluster)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), status)
+		return
+	}
+
+	ready, err := deployPerfTarget(r.Context(), kubeClient.KubeClient, deployReq.Namespace, deployReq.Image)
+	if err != nil {
+		h.log.Error(ErrPerfTarget(err))
+		http.Error(rw, ErrPerfTarget(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	target := models.PerfTarget{
+		K8sContextID: k8sContextID,
+		Namespace:    deployReq.Namespace,
+		Image:        deployReq.Image,
+		URL:          models.PerfTargetURL(deployReq.Namespace),
+		MeshURL:      models.PerfTargetMeshURL(deployReq.Namespace),
+	}
+	if err := h.config.PerfTargetPersister.SavePerfTarget(&target); err != nil {
+		h.log.Error(ErrPerfTarget(err))
+		http.Error(rw, ErrPerfTarget(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writePerfTargetJSON(rw, models.PerfTargetAPIResponse{Target: target, Ready: ready})
+}
+
+// perfTargetCluster returns the id of the Kubernetes context with the given id or name, and a
+// client of its cluster, defaulting to the current context of the user
+func (h *Handler) perfTargetCluster(r *http.Request, provider models.Provider, cluster string) (string, *mesherykube.Client, int, error) {
+	if cluster == "" {
+		k8sContext, _ := r.Context().Value(models.KubeContextKey).(*models.K8sContext)
+		kubeClient, ok := r.Context().Value(models.KubeHanderKey).(*mesherykube.Client)
+		if k8sContext == nil || !ok || kubeClient == nil {
+			return "", nil, http.StatusBadRequest, ErrNilClient
+		}
+		return k8sContext.ID, kubeClient, http.StatusOK, nil
+	}
+
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	k8sContexts, err := provider.LoadAllK8sContext(token)
+	if err != nil {
+		return "", nil, http.StatusInternalServerError, ErrPerfTarget(err)
+	}
+	for _, k8sContext := range k8sContexts {
+		if k8sContext.ID != cluster && k8sContext.Name != cluster {
+			continue
+		}
+		kubecfg, err := k8sContext.GenerateKubeConfig()
+		if err != nil {
+			return "", nil, http.StatusInternalServerError, ErrPerfTarget(err)
+		}
+		kubeClient, err := mesherykube.New(kubecfg)
+		if err != nil {
+			return "", nil, http.StatusInternalServerError, ErrPerfTarget(err)
+		}
+		return k8sContext.ID, kubeClient, http.StatusOK, nil
+	}
+	return "", nil, http.StatusNotFound, ErrPerfTarget(fmt.Errorf("kubernetes context %s not found", cluster))
+}
+
+// deployPerfTarget creates, or updates, the namespace, the deployments and the services of the echo
+// server and of the proxy in front of it, and returns whether they were rolled out within perfTargetReadyTimeout
+func deployPerfTarget(ctx context.Context, client kubernetes.Interface, namespace, image string) (bool, error) {
+	_, err := client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if kubeerror.IsNotFound(err) {
+		_, err = client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return false, err
+	}
+
+	echo := fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", models.PerfTargetName, namespace, models.PerfTargetHTTPPort)
+	workloads := []struct {
+		name  string
+		args  []string
+		ports []corev1.ContainerPort
+	}{
+		{
+			name: models.PerfTargetName,
+			args: []string{"server"},
+			ports: []corev1.ContainerPort{
+				{Name: "http", ContainerPort: models.PerfTargetHTTPPort},
+				{Name: "grpc", ContainerPort: models.PerfTargetGRPCPort},
+			},
+		},
+		{
+			name: models.PerfTargetProxyName,
+			args: []string{"server", "-M", fmt.Sprintf("%d %s", models.PerfTargetProxyPort, echo)},
+			ports: []corev1.ContainerPort{
+				{Name: "http", ContainerPort: models.PerfTargetHTTPPort},
+				{Name: "http-proxy", ContainerPort: models.PerfTargetProxyPort},
+			},
+		},
+	}
+
+	for _, workload := range workloads {
+		labels := map[string]string{
+			"app":                          workload.name,
+			"app.kubernetes.io/managed-by": "meshery",
+		}
+		replicas := int32(1)
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: workload.name, Namespace: namespace, Labels: labels},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": workload.name}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "fortio",
+							Image: image,
+							Args:  workload.args,
+							Ports: workload.ports,
+							ReadinessProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									HTTPGet: &corev1.HTTPGetAction{Path: "/echo", Port: intstr.FromString("http")},
+								},
+							},
+						}},
+					},
+				},
+			},
+		}
+		deployments := client.AppsV1().Deployments(namespace)
+		if _, err := deployments.Create(ctx, deployment, metav1.CreateOptions{}); kubeerror.IsAlreadyExists(err) {
+			if _, err := deployments.Update(ctx, deployment, metav1.UpdateOptions{}); err != nil {
+				return false, err
+			}
+		} else if err != nil {
+			return false, err
+		}
+
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: workload.name, Namespace: namespace, Labels: labels},
+			Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": workload.name}},
+		}
+		for _, port := range workload.ports {
+			service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{Name: port.Name, Port: port.ContainerPort, TargetPort: intstr.FromString(port.Name)})
+		}
+		if _, err := client.CoreV1().Services(namespace).Create(ctx, service, metav1.CreateOptions{}); err != nil && !kubeerror.IsAlreadyExists(err) {
+			return false, err
+		}
+	}
+
+	return waitForPerfTarget(ctx, client, namespace)
+}
+
+// waitForPerfTarget returns whether the deployments of the echo server and of the proxy are rolled
+// out, their pods all running the latest template, waiting for perfTargetReadyTimeout at most
+func waitForPerfTarget(ctx context.Context, client kubernetes.Interface, namespace string) (bool, error) {
+	deadline := time.Now().Add(perfTargetReadyTimeout)
+	for {
+		rolledOut := true
+		for _, name := range []string{models.PerfTargetName, models.PerfTargetProxyName} {
+			d, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			replicas := int32(1)
+			if d.Spec.Replicas != nil {
+				replicas = *d.Spec.Replicas
+			}
+			if d.Status.ObservedGeneration < d.Generation || d.Status.UpdatedReplicas < replicas ||
+				d.Status.AvailableReplicas < replicas || d.Status.Replicas > d.Status.UpdatedReplicas {
+				rolledOut = false
+			}
+		}
+		if rolledOut {
+			return true, nil
+		}
+		if time.Now().After(deadline) {
+			return false, nil
+		}
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-time.After(perfTargetPollInterval):
+		}
+	}
+}
+
+// swagger:route PUT /api/perf/targets/{id}/mesh PerformanceAPI idPutPerfTargetMesh
+// Handle PUT request to configure the service mesh for a performance test target
+//
+// Injects, or removes, the sidecar of the mesh in the pods of the echo server and of the proxy in front
+// of it, and turns the mTLS and the telemetry of the mesh on or off for them, so that the overhead of each
+// feature can be measured. The workloads are restarted and rolled out before the response is sent.
+// responses:
+// 	200: perfTargetMeshResponseWrapper
+
+// PerfTargetMeshHandler configures the service mesh for the performance test target with the given id
+func (h *Handler) PerfTargetMeshHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	config := models.PerfTargetMeshConfiguration{}
+	if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+		obj := "mesh configuration"
+		h.log.Error(ErrDecoding(err, obj))
+		http.Error(rw, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := config.Validate(); err != nil {
+		h.log.Error(ErrPerfTarget(err))
+		http.Error(rw, ErrPerfTarget(err).Error(), http.StatusBadRequest)
+		return
+	}
+	mesh, ok := getNativeMesh(config.Mesh)
+	if !ok {
+		err := ErrUnsupportedMesh(config.Mesh)
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrPerfTarget(err))
+		http.Error(rw, ErrPerfTarget(err).Error(), http.StatusBadRequest)
+		return
+	}
+	target, err := h.config.PerfTargetPersister.GetPerfTarget(id)
+	if err != nil {
+		h.log.Error(ErrPerfTarget(err))
+		http.Error(rw, ErrPerfTarget(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	k8sContext, err := provider.GetK8sContext(token, target.K8sContextID)
+	if err != nil {
+		h.log.Error(ErrPerfTarget(err))
+		http.Error(rw, ErrPerfTarget(err).Error(), http.StatusNotFound)
+		return
+	}
+	kubecfg, err := k8sContext.GenerateKubeConfig()
+	if err != nil {
+		h.log.Error(ErrPerfTarget(err))
+		http.Error(rw, ErrPerfTarget(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	kubeClient, err := mesherykube.New(kubecfg)
+	if err != nil {
+		h.log.Error(ErrPerfTarget(err))
+		http.Error(rw, ErrPerfTarget(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	result, err := configurePerfTargetMesh(r.Context(), kubeClient.KubeClient, kubeClient.DynamicKubeClient, mesh, target.Namespace, config)
+	if err != nil {
+		h.log.Error(ErrPerfTarget(err))
+		http.Error(rw, ErrPerfTarget(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writePerfTargetJSON(rw, result)
+}
+
+// configurePerfTargetMesh applies the mesh configuration to the echo server and the proxy deployed in the namespace
+func configurePerfTargetMesh(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, mesh nativeMesh, namespace string, config models.PerfTargetMeshConfiguration) (*models.PerfTargetMeshConfigurationResult, error) {
+	alwaysOn, err := mesh.benchmarkFeatures(ctx, dynamicClient, namespace, config)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := setMeshInjection(ctx, client, mesh, &models.MeshInjectionRequest{
+		Namespace: namespace,
+		Enabled:   config.Sidecar,
+		Restart:   true,
+	}); err != nil {
+		return nil, err
+	}
+
+	// the restart is only observed by the deployment controller a moment later
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(perfTargetPollInterval):
+	}
+	ready, err := waitForPerfTarget(ctx, client, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PerfTargetMeshConfigurationResult{Configuration: config, AlwaysOn: alwaysOn, Ready: ready}, nil
+}
+
+var (
+	istioPeerAuthenticationResource = schema.GroupVersionResource{Group: "security.istio.io", Version: "v1beta1", Resource: "peerauthentications"}
+	istioDestinationRuleResource    = schema.GroupVersionResource{Group: "networking.istio.io", Version: "v1beta1", Resource: "destinationrules"}
+	istioTelemetryResource          = schema.GroupVersionResource{Group: "telemetry.istio.io", Version: "v1alpha1", Resource: "telemetries"}
+)
+
+// istioBenchmarkFeatures turns mTLS on or off between the proxy and the echo server with a PeerAuthentication
+// and a DestinationRule, and disables the metrics, the access logs and the traces of the namespace with a
+// Telemetry resource when telemetry is off. The resources are removed when the sidecar is off.
+func istioBenchmarkFeatures(ctx context.Context, client dynamic.Interface, namespace string, config models.PerfTargetMeshConfiguration) ([]string, error) {
+	name := "meshery-" + models.PerfTargetName
+	host := fmt.Sprintf("%s.%s.svc.cluster.local", models.PerfTargetName, namespace)
+
+	mode, tlsMode := "DISABLE", "DISABLE"
+	if config.MTLS {
+		mode, tlsMode = "STRICT", "ISTIO_MUTUAL"
+	}
+	resources := []struct {
+		gvr     schema.GroupVersionResource
+		kind    string
+		spec    map[string]interface{}
+		enabled bool
+	}{
+		{
+			gvr:  istioPeerAuthenticationResource,
+			kind: "PeerAuthentication",
+			spec: map[string]interface{}{
+				"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": models.PerfTargetName}},
+				"mtls":     map[string]interface{}{"mode": mode},
+			},
+			enabled: config.Sidecar,
+		},
+		{
+			gvr:  istioDestinationRuleResource,
+			kind: "DestinationRule",
+			spec: map[string]interface{}{
+				"host":          host,
+				"trafficPolicy": map[string]interface{}{"tls": map[string]interface{}{"mode": tlsMode}},
+			},
+			enabled: config.Sidecar,
+		},
+		{
+			gvr:  istioTelemetryResource,
+			kind: "Telemetry",
+			spec: map[string]interface{}{
+				"metrics": []interface{}{map[string]interface{}{
+					"providers": []interface{}{map[string]interface{}{"name": "prometheus"}},
+					"overrides": []interface{}{map[string]interface{}{"disabled": true}},
+				}},
+				"accessLogging": []interface{}{map[string]interface{}{"disabled": true}},
+				"tracing":       []interface{}{map[string]interface{}{"disableSpanReporting": true}},
+			},
+			enabled: config.Sidecar && !config.Telemetry,
+		},
+	}
+
+	for _, res := range resources {
+		resourceClient := client.Resource(res.gvr).Namespace(namespace)
+		if !res.enabled {
+			if err := resourceClient.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !kubeerror.IsNotFound(err) {
+				return nil, err
+			}
+			continue
+		}
+
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": res.gvr.GroupVersion().String(),
+			"kind":       res.kind,
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+				"labels":    map[string]interface{}{"app.kubernetes.io/managed-by": "meshery"},
+			},
+			"spec": res.spec,
+		}}
+		current, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+		if kubeerror.IsNotFound(err) {
+			_, err = resourceClient.Create(ctx, obj, metav1.CreateOptions{})
+		} else if err == nil {
+			obj.SetResourceVersion(current.GetResourceVersion())
+			_, err = resourceClient.Update(ctx, obj, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (h *Handler) writePerfTargetJSON(rw http.ResponseWriter, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		obj := "performance test target"
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
@@ -4,11 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/helpers"
 	"github.com/layer5io/meshery/models"
 )
 
+// profileMetricsHistory is the number of the latest results of a profile its metrics are computed from
+const profileMetricsHistory = 100
+
 // swagger:route POST /api/user/performance/profiles PerformanceAPI idSavePerformanceProfile
 // Handle POST requests for saving performance profile
 //
@@ -40,6 +47,31 @@ func (h *Handler) SavePerformanceProfileHandler(
 	j, _ := json.Marshal(parsedBody)
 	h.log.Info("performance profile is ", string(j))
 
+	if parsedBody == nil {
+		http.Error(rw, ErrRequestBody(fmt.Errorf("no performance profile")).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := parsedBody.RequestMix.Validate(); err != nil {
+		h.log.Error(ErrRequestMix(err))
+		http.Error(rw, ErrRequestMix(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if parsedBody.Method != "" {
+		if err := models.ValidateHTTPMethod(parsedBody.Method); err != nil {
+			h.log.Error(ErrLoadTestMethod(err))
+			http.Error(rw, ErrLoadTestMethod(err).Error(), http.StatusBadRequest)
+			return
+		}
+		parsedBody.Method = strings.ToUpper(parsedBody.Method)
+	}
+	if parsedBody.UserID == "" {
+		parsedBody.UserID = user.UserID
+	}
+	// New profiles count towards the quota of the workspace of the user creating them
+	if parsedBody.ID == nil && !h.checkWorkspaceQuota(rw, user, models.QuotaProfiles) {
+		return
+	}
+
 	token, err := provider.GetProviderToken(r)
 	if err != nil {
 		//unable to save user config data
@@ -84,7 +116,7 @@ func (h *Handler) GetPerformanceProfilesHandler(
 
 	tokenString := r.Context().Value(models.TokenCtxKey).(string)
 
-	resp, err := provider.GetPerformanceProfiles(tokenString, q.Get("page"), q.Get("page_size"), q.Get("search"), q.Get("order"))
+	resp, err := provider.GetPerformanceProfiles(tokenString, q.Get("page"), q.Get("page_size"), q.Get("search"), q.Get("order"), q.Get("owner"))
 	if err != nil {
 		obj := "performance profile"
 		//get query performance profile
@@ -155,3 +187,139 @@ func (h *Handler) GetPerformanceProfileHandler(
 	rw.Header().Set("Content-Type", "application/json")
 	fmt.Fprint(rw, string(resp))
 }
+
+// swagger:route GET /api/user/performance/profiles/{id}/metrics PerformanceAPI idGetPerformanceProfileMetrics
+// Handle GET requests for the metrics of a performance profile
+//
+// Returns the rolling aggregates of the results of the profile in the OpenMetrics text format: the latencies and
+// rate of its latest result, the median p99 latency of its results of the last 7 days and the trend of the p99
+// latency of its results over time, for SLO dashboards to follow the health of the profile
+// responses:
+// 	200: performanceProfileMetricsResponseWrapper
+
+// GetPerformanceProfileMetricsHandler returns the aggregates of the results of the performance profile with the given id
+func (h *Handler) GetPerformanceProfileMetricsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	performanceProfileID := mux.Vars(r)["id"]
+
+	resp, err := provider.GetPerformanceProfile(r, performanceProfileID)
+	if err != nil {
+		h.log.Error(ErrProfileMetrics(err))
+		http.Error(rw, ErrProfileMetrics(err).Error(), http.StatusNotFound)
+		return
+	}
+	profile := models.PerformanceProfile{}
+	if err := json.Unmarshal(resp, &profile); err != nil {
+		obj := "performance profile"
+		h.log.Error(ErrUnmarshal(err, obj))
+		http.Error(rw, ErrUnmarshal(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	page, err := provider.FetchResults(token, "0", strconv.Itoa(profileMetricsHistory), "", "test_start_time desc", performanceProfileID, "")
+	if err != nil {
+		h.log.Error(ErrProfileMetrics(err))
+		http.Error(rw, ErrProfileMetrics(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	results := models.MesheryResultPage{}
+	if err := json.Unmarshal(page, &results); err != nil {
+		obj := "performance results"
+		h.log.Error(ErrUnmarshal(err, obj))
+		http.Error(rw, ErrUnmarshal(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	metrics := helpers.NewProfileMetrics(performanceProfileID, profile.Name, results.Results, time.Now())
+	rw.Header().Set("Content-Type", helpers.ProfileMetricsContentType)
+	fmt.Fprint(rw, helpers.ProfileOpenMetrics(metrics))
+}
+
+// swagger:route GET /api/user/performance/profiles/{id}/trend PerformanceAPI idGetPerformanceProfileTrend
+// Handle GET requests for the trend report of a performance profile
+//
+// Returns the weekly trend report of the profile: the slope and the variance of the p99 latency of its results over
+// the last weeks, along with the statistics of every week, catching the slow regressions invisible in single
+// comparisons. The report is generated every week by Meshery Server, and computed over the weeks up to now for the
+// profiles not reported on yet. It is returned as JSON, or rendered as markdown or HTML with format=markdown|html.
+// responses:
+// 	200: trendReportResponseWrapper
+
+// GetPerformanceProfileTrendHandler returns the trend report of the performance profile with the given id
+func (h *Handler) GetPerformanceProfileTrendHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	performanceProfileID := mux.Vars(r)["id"]
+
+	resp, err := provider.GetPerformanceProfile(r, performanceProfileID)
+	if err != nil {
+		h.log.Error(ErrTrendReport(err))
+		http.Error(rw, ErrTrendReport(err).Error(), http.StatusNotFound)
+		return
+	}
+	profile := models.PerformanceProfile{}
+	if err := json.Unmarshal(resp, &profile); err != nil {
+		obj := "performance profile"
+		h.log.Error(ErrUnmarshal(err, obj))
+		http.Error(rw, ErrUnmarshal(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "markdown" && format != "html" {
+		err := fmt.Errorf("invalid format %q, expected json, markdown or html", format)
+		h.log.Error(ErrTrendReport(err))
+		http.Error(rw, ErrTrendReport(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if h.config.TrendReportPersister == nil || h.config.TrendReporter == nil {
+		err := fmt.Errorf("the trend reports are not enabled on Meshery Server")
+		h.log.Error(ErrTrendReport(err))
+		http.Error(rw, ErrTrendReport(err).Error(), http.StatusNotImplemented)
+		return
+	}
+
+	report, err := h.config.TrendReportPersister.GetLatestTrendReport(performanceProfileID)
+	if err == nil && report == nil {
+		report, err = h.config.TrendReporter.Report(performanceProfileID, profile.Name, time.Now())
+	}
+	if err != nil {
+		h.log.Error(ErrTrendReport(err))
+		http.Error(rw, ErrTrendReport(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	report.Name = profile.Name
+
+	switch format {
+	case "markdown", "html":
+		out, err := helpers.RenderTrendReport(report, format == "html")
+		if err != nil {
+			h.log.Error(ErrTrendReport(err))
+			http.Error(rw, ErrTrendReport(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		if format == "html" {
+			rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+		} else {
+			rw.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		}
+		fmt.Fprint(rw, out)
+	default:
+		rw.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(rw).Encode(report); err != nil {
+			obj := "trend report"
+			h.log.Error(ErrEncoding(err, obj))
+			http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/gorilla/mux"
 	"github.com/layer5io/meshery/helpers"
 	"github.com/layer5io/meshery/models"
 )
@@ -190,12 +191,32 @@ func (h *Handler) PrometheusConfigHandler(w http.ResponseWriter, req *http.Reque
 			promURL = strings.TrimSuffix(promURL, u.RequestURI())
 		}
 
-		prefObj.Prometheus = &models.Prometheus{
-			PrometheusURL: promURL,
+		// A connection given a name is registered besides the default one
+		if name := req.FormValue("name"); name != "" && name != models.DefaultPrometheusConnection {
+			if prefObj.Prometheus == nil {
+				prefObj.Prometheus = &models.Prometheus{}
+			}
+			if prefObj.Prometheus.Connections == nil {
+				prefObj.Prometheus.Connections = map[string]string{}
+			}
+			prefObj.Prometheus.Connections[name] = promURL
+		} else {
+			prefObj.Prometheus = &models.Prometheus{
+				PrometheusURL: promURL,
+				Connections:   prometheusConnections(prefObj.Prometheus),
+			}
 		}
 		h.log.Debug("Prometheus URL %s successfully saved", promURL)
 	} else if req.Method == http.MethodDelete {
-		prefObj.Prometheus = nil
+		if name := req.URL.Query().Get("name"); name != "" && name != models.DefaultPrometheusConnection {
+			if prefObj.Prometheus != nil {
+				delete(prefObj.Prometheus.Connections, name)
+			}
+		} else if connections := prometheusConnections(prefObj.Prometheus); len(connections) > 0 {
+			prefObj.Prometheus = &models.Prometheus{Connections: connections}
+		} else {
+			prefObj.Prometheus = nil
+		}
 	}
 
 	err := provider.RecordPreferences(req, user.UserID, prefObj)
@@ -298,6 +319,60 @@ func (h *Handler) GrafanaBoardImportForPrometheusHandler(w http.ResponseWriter,
 	}
 }
 
+// swagger:route GET /api/telemetry/metrics/connections PrometheusAPI idGetPrometheusConnections
+// Handle GET request for Prometheus connections
+//
+// Used to list the Prometheus connections, the default one included, by name
+// responses:
+// 	200: prometheusConnectionsRespWrapper
+
+// PrometheusConnectionsHandler lists the registered Prometheus connections
+func (h *Handler) PrometheusConnectionsHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	connections := map[string]string{}
+	if prefObj.Prometheus != nil {
+		for name, promURL := range prefObj.Prometheus.Connections {
+			connections[name] = promURL
+		}
+		if prefObj.Prometheus.PrometheusURL != "" {
+			connections[models.DefaultPrometheusConnection] = prefObj.Prometheus.PrometheusURL
+		}
+	}
+	if err := json.NewEncoder(w).Encode(connections); err != nil {
+		obj := "Prometheus connections"
+		h.log.Error(ErrMarshal(err, obj))
+		http.Error(w, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/telemetry/metrics/connections/{name}/query PrometheusAPI idGetPrometheusConnectionQuery
+// Handle GET request for PromQL queries against a Prometheus connection
+//
+// Used to run ad hoc PromQL queries, instant or range queries when a start time is given,
+// through Meshery against the Prometheus connection with the given name
+// responses:
+// 	200:
+
+// PrometheusConnectionQueryHandler runs PromQL queries against a registered Prometheus connection
+func (h *Handler) PrometheusConnectionQueryHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	name := mux.Vars(req)["name"]
+	promURL, ok := prefObj.Prometheus.ConnectionURL(name)
+	if !ok {
+		h.log.Error(ErrPrometheusConnection(name))
+		http.Error(w, ErrPrometheusConnection(name).Error(), http.StatusNotFound)
+		return
+	}
+
+	reqQuery := req.URL.Query()
+	data, err := h.config.PrometheusClient.PromQL(req.Context(), promURL, &reqQuery)
+	if err != nil {
+		h.log.Error(ErrPrometheusQuery(err))
+		http.Error(w, ErrPrometheusQuery(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
 // swagger:route GET /api/telemetry/metrics/query PrometheusAPI idGetPrometheusQuery
 // Handle GET request for Prometheus Query
 //
@@ -468,3 +543,11 @@ func (h *Handler) SaveSelectedPrometheusBoardsHandler(w http.ResponseWriter, req
 	}
 	_, _ = w.Write([]byte("{}"))
 }
+
+// prometheusConnections returns the Prometheus connections registered by name
+func prometheusConnections(prom *models.Prometheus) map[string]string {
+	if prom == nil {
+		return nil
+	}
+	return prom.Connections
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/models"
+)
+
+// maxRemoteWriteBody caps the size of a remote_write request
+const maxRemoteWriteBody = 32 << 20
+
+// swagger:route POST /api/perf/remote_write PerformanceAPI idPostPerfRemoteWrite
+// Handle POST request of Prometheus remote_write
+//
+// Receives the client-side metrics, such as connection errors or DNS timing, written by load generators
+// and agents during a performance test, to be stored with its result. The UUID of the running test is given
+// as the test_id parameter, or as the meshery_test_id label of the series, and the write token issued as the
+// test starts as a bearer token. Writes without the token of their test or for tests which are not running
+// are rejected.
+// responses:
+// 	204:
+
+// RemoteWriteHandler receives the client-side metrics written through Prometheus remote_write during
+// performance tests. It requires no session, the writers authenticating with the write token of their test.
+func (h *Handler) RemoteWriteHandler(w http.ResponseWriter, req *http.Request) {
+	if h.config.RemoteWriteReceiver == nil {
+		http.Error(w, "remote_write is not enabled", http.StatusNotFound)
+		return
+	}
+	defer func() {
+		_ = req.Body.Close()
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxRemoteWriteBody+1))
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxRemoteWriteBody {
+		err := ErrRemoteWrite(fmt.Errorf("request exceeds %d bytes", maxRemoteWriteBody))
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	series, err := helpers.DecodeRemoteWrite(body)
+	if err != nil {
+		h.log.Error(ErrRemoteWrite(err))
+		http.Error(w, ErrRemoteWrite(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the series are collected per test, dropping the label identifying it
+	testID := req.URL.Query().Get("test_id")
+	perTest := map[string][]models.ClientMetricSeries{}
+	for _, s := range series {
+		id := testID
+		if label, ok := s.Labels[models.ClientMetricsTestLabel]; ok {
+			if id == "" {
+				id = label
+			}
+			delete(s.Labels, models.ClientMetricsTestLabel)
+		}
+		if id == "" {
+			err := ErrRemoteWrite(fmt.Errorf("no test_id parameter nor %s label", models.ClientMetricsTestLabel))
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		perTest[id] = append(perTest[id], s)
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "))
+	for id := range perTest {
+		if !h.config.RemoteWriteReceiver.Authorize(id, token) {
+			err := ErrRemoteWrite(fmt.Errorf("the request does not bear the write token of the running test %s", id))
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+	for id, s := range perTest {
+		if err := h.config.RemoteWriteReceiver.Append(id, s); err != nil {
+			// Prometheus does not retry on client errors, the writes of a completed test are dropped
+			http.Error(w, ErrRemoteWrite(err).Error(), http.StatusNotFound)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"bytes"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/models"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// newTestRemoteWriteBody returns a remote_write WriteRequest of a single series with a single sample
+func newTestRemoteWriteBody(labels map[string]string, value float64, timestamp int64) []byte {
+	var series []byte
+	for name, v := range labels {
+		var label []byte
+		label = protowire.AppendTag(label, 1, protowire.BytesType)
+		label = protowire.AppendString(label, name)
+		label = protowire.AppendTag(label, 2, protowire.BytesType)
+		label = protowire.AppendString(label, v)
+		series = protowire.AppendTag(series, 1, protowire.BytesType)
+		series = protowire.AppendBytes(series, label)
+	}
+	var sample []byte
+	sample = protowire.AppendTag(sample, 1, protowire.Fixed64Type)
+	sample = protowire.AppendFixed64(sample, math.Float64bits(value))
+	sample = protowire.AppendTag(sample, 2, protowire.VarintType)
+	sample = protowire.AppendVarint(sample, uint64(timestamp))
+	series = protowire.AppendTag(series, 2, protowire.BytesType)
+	series = protowire.AppendBytes(series, sample)
+
+	var request []byte
+	request = protowire.AppendTag(request, 1, protowire.BytesType)
+	request = protowire.AppendBytes(request, series)
+	return snappy.Encode(nil, request)
+}
+
+func TestRemoteWriteHandler(t *testing.T) {
+	const testID = "8f0d5c2e-1b7a-4c3d-9e6f-2a4b6c8d0e1f"
+	receiver := helpers.NewRemoteWriteReceiver(0)
+	token, err := receiver.Open(testID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := receiver.Open("3c1e4a7b-5d2f-4e8a-b9c0-1d2e3f4a5b6c"); err != nil {
+		t.Fatal(err)
+	}
+	h := newTestHandler(t, &models.HandlerConfig{RemoteWriteReceiver: receiver})
+
+	body := newTestRemoteWriteBody(map[string]string{"__name__": "dns_errors_total"}, 3, 1622548800000)
+	tests := []struct {
+		name       string
+		testID     string
+		token      string
+		wantStatus int
+	}{
+		{name: "no token", testID: testID, wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", testID: testID, token: "0123456789abcdef", wantStatus: http.StatusUnauthorized},
+		{name: "token of another test", testID: "3c1e4a7b-5d2f-4e8a-b9c0-1d2e3f4a5b6c", token: token, wantStatus: http.StatusUnauthorized},
+		{name: "test not running", testID: "5b9e2d4f-7a1c-4b3e-8d6f-0c2a4e6b8d0f", token: token, wantStatus: http.StatusUnauthorized},
+		{name: "write token of the test", testID: testID, token: token, wantStatus: http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/perf/remote_write?test_id="+tt.testID, bytes.NewReader(body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rw := httptest.NewRecorder()
+			h.RemoteWriteHandler(rw, req)
+			if rw.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rw.Code, tt.wantStatus, rw.Body.String())
+			}
+		})
+	}
+
+	series, dropped := receiver.Close(testID)
+	if len(series) != 1 || len(series[0].Samples) != 1 || series[0].Samples[0].Value != 3 || dropped != 0 {
+		t.Errorf("test collected %+v, dropping %d samples, want the single sample written with its token", series, dropped)
+	}
+	if series, _ := receiver.Close("3c1e4a7b-5d2f-4e8a-b9c0-1d2e3f4a5b6c"); len(series) != 0 {
+		t.Errorf("series written with the token of another test were collected: %+v", series)
+	}
+}
@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/models"
+)
+
+// resultHookTimeout is how long the hooks post-processing a result may take altogether
+const resultHookTimeout = 30 * time.Second
+
+// swagger:route GET /api/perf/hooks PerformanceAPI idGetResultHooks
+// Handle GET request for the result hooks
+//
+// Returns the hooks post-processing the performance results of the user, without their secrets
+// responses:
+// 	200: resultHooksResponseWrapper
+
+// swagger:route POST /api/perf/hooks PerformanceAPI idPostResultHook
+// Handle POST request to create or update a result hook
+//
+// Creates the webhook, or updates the hook with the same id or else the same name, invoked after every
+// performance result of the user is persisted with the SMP document of the result. The annotations the
+// hook answers with, such as custom scores or business KPIs, are attached to the result.
+// responses:
+// 	200: resultHookResponseWrapper
+
+// ResultHooksHandler handles the requests to list and save the result hooks of the user
+func (h *Handler) ResultHooksHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if r.Method == http.MethodGet {
+		hooks, err := h.config.ResultHookPersister.GetHooks(user.UserID, false)
+		if err != nil {
+			h.log.Error(ErrResultHook(err))
+			http.Error(rw, ErrResultHook(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		for i := range hooks {
+			hooks[i].Secret = ""
+		}
+		h.writeResultHookJSON(rw, hooks)
+		return
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	hook := &models.ResultHook{}
+	if err := json.NewDecoder(r.Body).Decode(hook); err != nil {
+		obj := "result hook"
+		h.log.Error(ErrDecoding(err, obj))
+		http.Error(rw, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := hook.Validate(); err != nil {
+		h.log.Error(ErrResultHook(err))
+		http.Error(rw, ErrResultHook(err).Error(), http.StatusBadRequest)
+		return
+	}
+	hook.UserID = user.UserID
+	if err := h.config.ResultHookPersister.SaveHook(hook); err != nil {
+		h.log.Error(ErrResultHook(err))
+		http.Error(rw, ErrResultHook(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	hook.Secret = ""
+	h.writeResultHookJSON(rw, hook)
+}
+
+// swagger:route DELETE /api/perf/hooks/{id} PerformanceAPI idDeleteResultHook
+// Handle DELETE request for a result hook
+//
+// Deletes the result hook of the user with the given id, the annotations it attached are kept
+// responses:
+// 	200:
+
+// DeleteResultHookHandler deletes a result hook of the user
+func (h *Handler) DeleteResultHookHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrResultHook(err))
+		http.Error(rw, ErrResultHook(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.config.ResultHookPersister.DeleteHook(user.UserID, id); err != nil {
+		h.log.Error(ErrResultHook(err))
+		http.Error(rw, ErrResultHook(err).Error(), http.StatusNotFound)
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+// swagger:route POST /api/perf/hooks/{id}/run PerformanceAPI idRunResultHook
+// Handle POST request to run a result hook
+//
+// Invokes the result hook, even when disabled, with the result given by result_id and attaches the annotations
+// it answers with to the result, to try a hook or to annotate the results persisted before it was created
+// responses:
+// 	200: resultAnnotationsResponseWrapper
+
+// RunResultHookHandler runs a result hook of the user on a result
+func (h *Handler) RunResultHookHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrResultHook(err))
+		http.Error(rw, ErrResultHook(err).Error(), http.StatusBadRequest)
+		return
+	}
+	resultID := uuid.FromStringOrNil(r.URL.Query().Get("result_id"))
+	if resultID == uuid.Nil {
+		h.log.Error(ErrQueryGet("result_id"))
+		http.Error(rw, "please provide a valid result_id", http.StatusBadRequest)
+		return
+	}
+	hook, err := h.config.ResultHookPersister.GetHook(user.UserID, id)
+	if err != nil {
+		h.log.Error(ErrResultHook(err))
+		http.Error(rw, ErrResultHook(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	result, err := provider.GetResult(token, resultID)
+	if err != nil {
+		h.log.Error(ErrGetResult(err))
+		http.Error(rw, ErrGetResult(err).Error(), http.StatusNotFound)
+		return
+	}
+	if _, archived := models.ArchivedResultOf(result); archived {
+		if result, err = helpers.RestoreArchivedResult(r.Context(), h.config.ResultArchive, result); err != nil {
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	event, err := resultHookEvent(resultID.String(), result)
+	if err != nil {
+		h.log.Error(ErrConvertToSpec(err))
+		http.Error(rw, ErrConvertToSpec(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), resultHookTimeout)
+	defer cancel()
+	annotations, err := h.runResultHook(ctx, hook, event)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadGateway)
+		return
+	}
+	h.writeResultHookJSON(rw, annotations)
+}
+
+// postProcessResult invokes the enabled result hooks of the user with the persisted result of the
+// profile, attaching the annotations they answer with to it
+func (h *Handler) postProcessResult(userID, resultID, profileID string, result *models.MesheryResult) {
+	if h.config.ResultHookPersister == nil || userID == "" || resultID == "" {
+		return
+	}
+	hooks, err := h.config.ResultHookPersister.GetHooks(userID, true)
+	if err != nil {
+		h.log.Error(ErrResultHook(err))
+		return
+	}
+	if len(hooks) == 0 {
+		return
+	}
+	event, err := resultHookEvent(resultID, result)
+	if err != nil {
+		h.log.Error(ErrConvertToSpec(err))
+		return
+	}
+	if profileID != "" {
+		event.ProfileID = profileID
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), resultHookTimeout)
+	defer cancel()
+	for i := range hooks {
+		if _, err := h.runResultHook(ctx, &hooks[i], event); err != nil {
+			h.log.Error(err)
+			continue
+		}
+		h.log.Debug("post-processed result ", resultID, " with the hook ", hooks[i].Name)
+	}
+}
+
+// runResultHook invokes the hook with the event, records the invocation and saves the annotations it answered with
+func (h *Handler) runResultHook(ctx context.Context, hook *models.ResultHook, event *models.ResultHookEvent) (models.ResultAnnotations, error) {
+	var processor models.ResultPostProcessor = helpers.NewWebhookPostProcessor(hook)
+	annotations, err := processor.Process(ctx, event)
+	if rerr := h.config.ResultHookPersister.RecordInvocation(*hook.ID, time.Now(), err); rerr != nil {
+		h.log.Error(ErrResultHook(rerr))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(annotations) == 0 {
+		return annotations, nil
+	}
+	if err := h.config.ResultHookPersister.SaveAnnotations(&models.ResultAnnotation{
+		ResultID:    event.ResultID,
+		Hook:        processor.Name(),
+		Annotations: annotations,
+	}); err != nil {
+		return nil, ErrResultHook(err)
+	}
+	return annotations, nil
+}
+
+// resultHookEvent returns the event result hooks receive for the result
+func resultHookEvent(resultID string, result *models.MesheryResult) (*models.ResultHookEvent, error) {
+	smp, err := helpers.SMPDocument(result)
+	if err != nil {
+		return nil, err
+	}
+	event := &models.ResultHookEvent{
+		ResultID: resultID,
+		Name:     result.Name,
+		Mesh:     result.Mesh,
+		SMP:      smp,
+	}
+	if result.PerformanceProfile != nil {
+		event.ProfileID = result.PerformanceProfile.String()
+	}
+	return event, nil
+}
+
+func (h *Handler) writeResultHookJSON(rw http.ResponseWriter, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		obj := "result hook"
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// resultAnnotations returns the annotations the result hooks attached to the result, none when
+// they cannot be read
+func (h *Handler) resultAnnotations(resultID string) map[string]models.ResultAnnotations {
+	if h.config.ResultHookPersister == nil {
+		return nil
+	}
+	annotations, err := h.config.ResultHookPersister.GetAnnotations(resultID)
+	if err != nil {
+		h.log.Warn(ErrResultHook(fmt.Errorf("unable to read the annotations of the result %s: %v", resultID, err)))
+		return nil
+	}
+	return annotations
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/models"
+)
+
+func TestResultHookEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  map[string]interface{}
+		wantSMP bool
+	}{
+		{
+			name: "HTTP result",
+			result: map[string]interface{}{
+				"RunType":   "HTTP",
+				"StartTime": "2021-06-01T12:00:00Z",
+				"ActualQPS": 10.5,
+				"DurationHistogram": map[string]interface{}{
+					"Min": 0.001,
+					"Max": 0.01,
+					"Avg": 0.005,
+					"Percentiles": []map[string]interface{}{
+						{"Percentile": 99, "Value": 0.009},
+					},
+				},
+			},
+			wantSMP: true,
+		},
+		{
+			name:    "gRPC result",
+			result:  map[string]interface{}{"RunType": "GRPC Health"},
+			wantSMP: false,
+		},
+		{
+			name:    "result without run type",
+			result:  map[string]interface{}{"ActualQPS": 10.5},
+			wantSMP: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := resultHookEvent("5d55f3c7-7b3d-4e15-94f2-09c407cd45e6", &models.MesheryResult{
+				Name:   "istio soak",
+				Mesh:   "istio",
+				Result: tt.result,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := json.Marshal(event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Contains(string(body), `"smp"`); got != tt.wantSMP {
+				t.Errorf("event %s has an SMP document: %t, want %t", body, got, tt.wantSMP)
+			}
+			if tt.wantSMP && !strings.Contains(string(event.SMP), `"p99":9`) {
+				t.Errorf("SMP document %s is missing the p99 latency", event.SMP)
+			}
+		})
+	}
+}
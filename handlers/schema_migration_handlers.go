@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshkit/errors"
+)
+
+// swagger:route GET /api/system/database/schema SystemAPI idGetDatabaseSchema
+// Handle GET request for the version of the schema of the database
+//
+// Returns the version of the schema of the database of Meshery Server, the latest version of the
+// migrations of the server and whether every migration is applied
+// responses:
+// 	200: databaseSchemaResponseWrapper
+
+// DatabaseSchemaHandler returns the version of the schema of the database and its migrations
+func (h *Handler) DatabaseSchemaHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if h.config.SchemaMigrator == nil {
+		http.Error(rw, "the schema of the database cannot be migrated", http.StatusNotFound)
+		return
+	}
+	status, err := h.config.SchemaMigrator.Status()
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeSchemaJSON(rw, status)
+}
+
+// swagger:route POST /api/system/database/schema/migrate SystemAPI idPostDatabaseSchemaMigrate
+// Handle POST request to migrate the schema of the database
+//
+// Applies the pending migrations of the schema of the database up to the version given with to,
+// every one of them by default. The migrations applied are returned and recorded as an event.
+// responses:
+// 	200: databaseSchemaMigrationResponseWrapper
+
+// MigrateDatabaseSchemaHandler applies the pending migrations of the schema of the database
+func (h *Handler) MigrateDatabaseSchemaHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if h.config.SchemaMigrator == nil {
+		http.Error(rw, "the schema of the database cannot be migrated", http.StatusNotFound)
+		return
+	}
+	h.runSchemaMigrations(rw, r, user, "migrated", 0, h.config.SchemaMigrator.Migrate)
+}
+
+// swagger:route POST /api/system/database/schema/rollback SystemAPI idPostDatabaseSchemaRollback
+// Handle POST request to roll back the schema of the database
+//
+// Reverses the migrations of the schema of the database applied above the version given with to,
+// the last one applied by default. Nothing is rolled back when one of them cannot be. The migrations
+// rolled back are returned and recorded as an event.
+// responses:
+// 	200: databaseSchemaMigrationResponseWrapper
+
+// RollbackDatabaseSchemaHandler rolls back the migrations of the schema of the database
+func (h *Handler) RollbackDatabaseSchemaHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if h.config.SchemaMigrator == nil {
+		http.Error(rw, "the schema of the database cannot be migrated", http.StatusNotFound)
+		return
+	}
+	h.runSchemaMigrations(rw, r, user, "rolled back", -1, h.config.SchemaMigrator.Rollback)
+}
+
+// runSchemaMigrations migrates or rolls back the schema to the version of the to query parameter,
+// or to the given one when it is not set
+func (h *Handler) runSchemaMigrations(rw http.ResponseWriter, r *http.Request, user *models.User, action string, to int, run func(to int) ([]models.MigrationStatus, error)) {
+	if v := r.URL.Query().Get("to"); v != "" {
+		version, err := strconv.Atoi(v)
+		if err != nil || version < 0 {
+			err = models.ErrInvalidSchemaVersion(fmt.Errorf("invalid version %q", v))
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		to = version
+	}
+
+	migrations, err := run(to)
+	if len(migrations) > 0 && h.config.EventRecorder != nil {
+		if _, err := h.config.EventRecorder.Record(models.EventCategoryConfig, "schema-migration", schemaMigrationEvent(user, action, migrations)); err != nil {
+			h.log.Error(err)
+		}
+	}
+	if err != nil {
+		h.log.Error(err)
+		status := http.StatusInternalServerError
+		if errors.GetCode(err) == models.ErrInvalidSchemaVersionCode {
+			status = http.StatusBadRequest
+		}
+		http.Error(rw, err.Error(), status)
+		return
+	}
+
+	status, err := h.config.SchemaMigrator.Status()
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeSchemaJSON(rw, &models.SchemaMigrationResult{Migrations: migrations, Status: *status})
+}
+
+// schemaMigrationEvent returns the event auditing the migrations run by the user
+func schemaMigrationEvent(user *models.User, action string, migrations []models.MigrationStatus) *meshes.EventsResponse {
+	details := make([]string, 0, len(migrations))
+	for _, m := range migrations {
+		details = append(details, fmt.Sprintf("%d: %s", m.Version, m.Description))
+	}
+	by := "unknown user"
+	if user != nil && user.UserID != "" {
+		by = user.UserID
+	}
+	return &meshes.EventsResponse{
+		EventType: meshes.EventType_INFO,
+		Summary:   fmt.Sprintf("Database schema %s by %s, %d migration(s)", action, by, len(migrations)),
+		Details:   strings.Join(details, "\n"),
+	}
+}
+
+func (h *Handler) writeSchemaJSON(rw http.ResponseWriter, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		obj := "database schema"
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route GET /api/system/config SystemAPI idGetServerConfig
+// Handle GET request for the configuration of the server
+//
+// Returns the configuration of Meshery Server which can be changed while it runs
+// responses:
+// 	200: serverConfigResponseWrapper
+
+// ServerConfigHandler returns the configuration of the server which can be changed while it runs
+func (h *Handler) ServerConfigHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if h.config.ServerConfigReloader == nil {
+		http.Error(rw, "the configuration of the server cannot be reloaded", http.StatusNotFound)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(h.config.ServerConfigReloader.Config()); err != nil {
+		h.log.Error(ErrEncoding(err, "server config"))
+		http.Error(rw, ErrEncoding(err, "server config").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/system/config/reload SystemAPI idPostServerConfigReload
+// Handle POST request to reload the configuration of the server
+//
+// Reads the configuration file of Meshery Server again, if any, and applies it along with the settings
+// given, without restarting the server: the remote providers, the adapters, the log level and the
+// feature flags. The settings which changed are returned and recorded as an event. Only the admins of
+// Meshery Server may reload its configuration.
+// responses:
+// 	200: serverConfigReloadResponseWrapper
+
+// ServerConfigReloadHandler applies the changes of the configuration of the server while it runs
+func (h *Handler) ServerConfigReloadHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if h.config.ServerConfigReloader == nil {
+		http.Error(rw, "the configuration of the server cannot be reloaded", http.StatusNotFound)
+		return
+	}
+	if !h.isAdmin(user, provider) {
+		err := ErrNotAdmin("reload the configuration of the server")
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	update := &models.ServerConfig{}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(update); err != nil {
+			h.log.Error(ErrDecoding(err, "server config"))
+			http.Error(rw, ErrDecoding(err, "server config").Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	reload, err := h.config.ServerConfigReloader.Reload(update)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(reload.Changes) > 0 && h.config.EventRecorder != nil {
+		if _, err := h.config.EventRecorder.Record(models.EventCategoryConfig, "server-config", serverConfigEvent(user, reload.Changes)); err != nil {
+			h.log.Error(err)
+		}
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(reload); err != nil {
+		h.log.Error(ErrEncoding(err, "server config reload"))
+		http.Error(rw, ErrEncoding(err, "server config reload").Error(), http.StatusInternalServerError)
+	}
+}
+
+// serverConfigEvent returns the event auditing the changes of the configuration made by the user
+func serverConfigEvent(user *models.User, changes []models.ServerConfigChange) *meshes.EventsResponse {
+	details := make([]string, 0, len(changes))
+	for _, c := range changes {
+		details = append(details, fmt.Sprintf("%s: %q -> %q", c.Setting, c.Old, c.New))
+	}
+	by := "unknown user"
+	if user != nil && user.UserID != "" {
+		by = user.UserID
+	}
+	return &meshes.EventsResponse{
+		EventType: meshes.EventType_INFO,
+		Summary:   fmt.Sprintf("Server configuration reloaded by %s, %d setting(s) changed", by, len(changes)),
+		Details:   strings.Join(details, "\n"),
+	}
+}
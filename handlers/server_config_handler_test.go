@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+func TestServerConfigReloadHandler(t *testing.T) {
+	level := logrus.GetLevel()
+	t.Cleanup(func() { logrus.SetLevel(level) })
+
+	config := &models.HandlerConfig{Admins: []string{"root"}}
+	config.ServerConfigReloader = helpers.NewServerConfigReloader("", models.ServerConfig{LogLevel: "info"}, config, nil, nil)
+	h := newTestHandler(t, config)
+
+	reload := func(user *models.User) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/system/config/reload", strings.NewReader(`{"log_level": "debug"}`))
+		rw := httptest.NewRecorder()
+		h.ServerConfigReloadHandler(rw, req, nil, user, nil)
+		return rw.Code
+	}
+
+	if code := reload(&models.User{UserID: "alice"}); code != http.StatusForbidden {
+		t.Errorf("user reloading the configuration got %d, want %d", code, http.StatusForbidden)
+	}
+	if got := config.ServerConfigReloader.Config().LogLevel; got != "info" {
+		t.Errorf("log level is %q after a user reloaded the configuration, want it unchanged", got)
+	}
+	if code := reload(&models.User{UserID: "root"}); code != http.StatusOK {
+		t.Errorf("admin reloading the configuration got %d, want %d", code, http.StatusOK)
+	}
+	if got := config.ServerConfigReloader.Config().LogLevel; got != "debug" {
+		t.Errorf("log level is %q after an admin reloaded the configuration, want %q", got, "debug")
+	}
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route GET /api/system/telemetry SystemAPI idGetTelemetry
+// Handle GET request for the telemetry report
+//
+// Returns the data Meshery sends, where to and whether the user enabled it, with samples of the data
+// as it would be sent next
+// responses:
+// 	200: telemetryReportResponseWrapper
+
+// swagger:route POST /api/system/telemetry SystemAPI idPostTelemetry
+// Handle POST request to enable or disable the telemetry
+//
+// Enables or disables the anonymous usage statistics and performance results of the user, returning the
+// telemetry report once updated
+// responses:
+// 	200: telemetryReportResponseWrapper
+
+// TelemetryHandler reports the data sent by Meshery and updates the telemetry preferences of the user
+func (h *Handler) TelemetryHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if r.Method == http.MethodPost {
+		defer func() {
+			_ = r.Body.Close()
+		}()
+
+		telemetryPrefs := &models.TelemetryPreferences{}
+		if err := json.NewDecoder(r.Body).Decode(telemetryPrefs); err != nil {
+			h.log.Error(ErrDecoding(err, "telemetry preferences"))
+			http.Error(rw, ErrDecoding(err, "telemetry preferences").Error(), http.StatusBadRequest)
+			return
+		}
+		telemetryPrefs.Apply(prefObj)
+		if err := provider.RecordPreferences(r, user.UserID, prefObj); err != nil {
+			h.log.Error(ErrSavingUserPreference(err))
+			http.Error(rw, ErrSavingUserPreference(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	report, err := provider.GetTelemetryReport(user, prefObj)
+	if err != nil {
+		h.log.Error(ErrTelemetryReport(err))
+		http.Error(rw, ErrTelemetryReport(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(report); err != nil {
+		h.log.Error(ErrEncoding(err, "telemetry report"))
+		http.Error(rw, ErrEncoding(err, "telemetry report").Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route GET /api/user/trash TrashAPI idGetTrash
+// Handle GET request for the trash
+//
+// Returns the deleted designs and performance profiles which can still be restored, and when
+// they are purged
+// responses:
+// 	200: trashResponseWrapper
+
+// TrashHandler returns the designs and performance profiles in the trash
+func (h *Handler) TrashHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	token, err := provider.GetProviderToken(r)
+	if err != nil {
+		h.log.Error(ErrRetrieveUserToken(err))
+		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := provider.GetTrash(token, r.URL.Query().Get("kind"))
+	if err != nil {
+		h.log.Error(ErrTrash(err))
+		http.Error(rw, ErrTrash(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+}
+
+// swagger:route POST /api/pattern/{id}/restore PatternsAPI idPostRestorePattern
+// Handle POST request to restore a deleted pattern
+//
+// Restores the pattern with the given id from the trash
+// responses:
+// 	200: trashItemResponseWrapper
+
+// RestoreMesheryPatternHandler restores a deleted pattern from the trash
+func (h *Handler) RestoreMesheryPatternHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	h.restoreFromTrash(rw, r, provider, models.OwnedPattern)
+}
+
+// swagger:route POST /api/user/performance/profiles/{id}/restore PerformanceAPI idPostRestorePerformanceProfile
+// Handle POST request to restore a deleted performance profile
+//
+// Restores the performance profile with the given id from the trash, with its results
+// responses:
+// 	200: trashItemResponseWrapper
+
+// RestorePerformanceProfileHandler restores a deleted performance profile from the trash
+func (h *Handler) RestorePerformanceProfileHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if !h.restoreFromTrash(rw, r, provider, models.OwnedPerformanceProfile) {
+		return
+	}
+	if h.config.PerformanceChannel != nil {
+		h.config.PerformanceChannel <- struct{}{}
+	}
+}
+
+// restoreFromTrash restores the item of the kind and of the id of the request, reporting
+// whether it was restored
+func (h *Handler) restoreFromTrash(rw http.ResponseWriter, r *http.Request, provider models.Provider, kind string) bool {
+	token, err := provider.GetProviderToken(r)
+	if err != nil {
+		h.log.Error(ErrRetrieveUserToken(err))
+		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
+		return false
+	}
+
+	resp, err := provider.RestoreFromTrash(token, kind, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrTrash(err))
+		http.Error(rw, ErrTrash(err).Error(), http.StatusNotFound)
+		return false
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+	return true
+}
@@ -27,6 +27,23 @@ func (h *Handler) UserHandler(w http.ResponseWriter, req *http.Request, _ *model
 	}
 }
 
+// isAdmin returns whether the user administers Meshery Server, the single user of the local
+// provider always doing so
+func (h *Handler) isAdmin(user *models.User, provider models.Provider) bool {
+	if provider != nil && provider.GetProviderType() == models.LocalProviderType {
+		return true
+	}
+	if user == nil {
+		return false
+	}
+	for _, admin := range h.config.Admins {
+		if admin == user.UserID {
+			return true
+		}
+	}
+	return false
+}
+
 // swagger:route GET /api/user/prefs UserAPI idGetUserTestPrefs
 // Handle GET for User Load Test Preferences
 //
@@ -117,3 +134,59 @@ func (h *Handler) UserPrefsHandler(w http.ResponseWriter, req *http.Request, pre
 		return
 	}
 }
+
+// swagger:route GET /api/user/prefs/cli UserAPI idGetUserCLIPrefs
+// Handle GET for User CLI Preferences
+//
+// Returns the mesheryctl preferences stored under the user account
+// responses:
+// 	200: userCLIPrefsRespWrapper
+
+// swagger:route POST /api/user/prefs/cli UserAPI idPostUserCLIPrefs
+// Handle POST for User CLI Preferences
+//
+// Updates the mesheryctl preferences stored under the user account
+// responses:
+// 	200: userCLIPrefsRespWrapper
+
+// UserCLIPreferencesHandler is used to sync the mesheryctl preferences of the user
+func (h *Handler) UserCLIPreferencesHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	if req.Method == http.MethodPost {
+		defer func() {
+			_ = req.Body.Close()
+		}()
+
+		cliPrefs := &models.CLIPreferences{}
+		if err := json.NewDecoder(req.Body).Decode(cliPrefs); err != nil {
+			obj := "cli preferences"
+			h.log.Error(ErrDecoding(err, obj))
+			http.Error(w, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+			return
+		}
+		cliPrefs.UpdatedAt = time.Now()
+		prefObj.CLIPreferences = cliPrefs
+		// opting out of the telemetry in mesheryctl opts the account out of the anonymous
+		// usage statistics and performance results Meshery sends
+		if cliPrefs.TelemetryOptOut {
+			prefObj.AnonymousUsageStats = false
+			prefObj.AnonymousPerfResults = false
+		}
+
+		if err := provider.RecordPreferences(req, user.UserID, prefObj); err != nil {
+			h.log.Error(ErrRecordPreferences(err))
+			http.Error(w, ErrRecordPreferences(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	cliPrefs := prefObj.CLIPreferences
+	if cliPrefs == nil {
+		cliPrefs = &models.CLIPreferences{}
+	}
+	if err := json.NewEncoder(w).Encode(cliPrefs); err != nil {
+		obj := "cli preferences"
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(w, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
+}
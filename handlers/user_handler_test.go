@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/models"
+)
+
+func TestUserCLIPreferencesHandler(t *testing.T) {
+	persister, err := models.NewMapPreferencePersister()
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := &models.DefaultLocalProvider{MapPreferencePersister: persister}
+	h := newTestHandler(t, &models.HandlerConfig{})
+	user := &models.User{UserID: "alice"}
+
+	push := func(body string) *models.Preference {
+		prefObj, err := persister.ReadFromPersister(user.UserID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rw := httptest.NewRecorder()
+		h.UserCLIPreferencesHandler(rw, httptest.NewRequest(http.MethodPost, "/api/user/prefs/cli", strings.NewReader(body)), prefObj, user, provider)
+		if rw.Code != http.StatusOK {
+			t.Fatalf("pushing the preferences %s got %d, want %d", body, rw.Code, http.StatusOK)
+		}
+		if prefObj, err = persister.ReadFromPersister(user.UserID); err != nil {
+			t.Fatal(err)
+		}
+		return prefObj
+	}
+
+	prefObj := push(`{"outputFormat": "json", "telemetryOptOut": false}`)
+	if !prefObj.AnonymousUsageStats || !prefObj.AnonymousPerfResults {
+		t.Error("telemetry was turned off without alice opting out of it")
+	}
+	if prefObj.CLIPreferences == nil || prefObj.CLIPreferences.OutputFormat != "json" {
+		t.Errorf("preferences were stored as %+v, want those pushed", prefObj.CLIPreferences)
+	}
+
+	prefObj = push(`{"outputFormat": "json", "telemetryOptOut": true}`)
+	if prefObj.AnonymousUsageStats || prefObj.AnonymousPerfResults {
+		t.Error("telemetry is on after alice opted out of it in mesheryctl")
+	}
+}
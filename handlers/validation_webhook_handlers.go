@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route GET /api/system/validation_webhooks SystemAPI idGetValidationWebhooks
+// Handle GET request for the validation webhooks
+//
+// Returns the webhooks of the external policy engines the designs are validated with before they are deployed,
+// without their secrets
+// responses:
+// 	200: validationWebhooksResponseWrapper
+
+// swagger:route POST /api/system/validation_webhooks SystemAPI idPostValidationWebhook
+// Handle POST request to create or update a validation webhook
+//
+// Creates the webhook, or updates the webhook with the same id or else the same name, called with the
+// manifests of every design before it is deployed or verified. The design is deployed only when every
+// enabled webhook allows it; a webhook failing denies the design unless its failure policy is Ignore.
+// Only the admins of Meshery Server may save the webhooks.
+// responses:
+// 	200: validationWebhookResponseWrapper
+
+// ValidationWebhooksHandler handles the requests to list and save the validation webhooks
+func (h *Handler) ValidationWebhooksHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if r.Method == http.MethodGet {
+		webhooks, err := h.config.ValidationWebhookPersister.GetWebhooks(false)
+		if err != nil {
+			h.log.Error(ErrValidationWebhook(err))
+			http.Error(rw, ErrValidationWebhook(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		for i := range webhooks {
+			webhooks[i].Secret = ""
+		}
+		h.writeValidationWebhookJSON(rw, webhooks)
+		return
+	}
+	if !h.isAdmin(user, provider) {
+		err := ErrNotAdmin("save the validation webhooks")
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	webhook := &models.ValidationWebhook{}
+	if err := json.NewDecoder(r.Body).Decode(webhook); err != nil {
+		obj := "validation webhook"
+		h.log.Error(ErrDecoding(err, obj))
+		http.Error(rw, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := webhook.Validate(); err != nil {
+		h.log.Error(ErrValidationWebhook(err))
+		http.Error(rw, ErrValidationWebhook(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.config.ValidationWebhookPersister.SaveWebhook(webhook); err != nil {
+		h.log.Error(ErrValidationWebhook(err))
+		http.Error(rw, ErrValidationWebhook(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	webhook.Secret = ""
+	h.writeValidationWebhookJSON(rw, webhook)
+}
+
+// swagger:route DELETE /api/system/validation_webhooks/{id} SystemAPI idDeleteValidationWebhook
+// Handle DELETE request for a validation webhook
+//
+// Deletes the validation webhook with the given id. Only the admins of Meshery Server may delete the webhooks.
+// responses:
+// 	200:
+
+// DeleteValidationWebhookHandler deletes a validation webhook
+func (h *Handler) DeleteValidationWebhookHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if !h.isAdmin(user, provider) {
+		err := ErrNotAdmin("delete the validation webhooks")
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrValidationWebhook(err))
+		http.Error(rw, ErrValidationWebhook(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.config.ValidationWebhookPersister.DeleteWebhook(id); err != nil {
+		h.log.Error(ErrValidationWebhook(err))
+		http.Error(rw, ErrValidationWebhook(err).Error(), http.StatusNotFound)
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) writeValidationWebhookJSON(rw http.ResponseWriter, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		obj := "validation webhook"
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// validationWebhooks returns the enabled validation webhooks the designs are validated with before they
+// are deployed, none when they cannot be read
+func (h *Handler) validationWebhooks() []models.ValidationWebhook {
+	if h.config.ValidationWebhookPersister == nil {
+		return nil
+	}
+	webhooks, err := h.config.ValidationWebhookPersister.GetWebhooks(true)
+	if err != nil {
+		h.log.Error(ErrValidationWebhook(err))
+		return nil
+	}
+	return webhooks
+}
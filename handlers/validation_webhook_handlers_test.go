@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestValidationWebhookHandlers(t *testing.T) {
+	persister := &models.ValidationWebhookPersister{DB: newTestDatabase(t)}
+	h := newTestHandler(t, &models.HandlerConfig{ValidationWebhookPersister: persister, Admins: []string{"root"}})
+	alice, root := &models.User{UserID: "alice"}, &models.User{UserID: "root"}
+
+	save := func(user *models.User, body string) *httptest.ResponseRecorder {
+		rw := httptest.NewRecorder()
+		h.ValidationWebhooksHandler(rw, httptest.NewRequest(http.MethodPost, "/api/system/validation_webhooks", strings.NewReader(body)), nil, user, nil)
+		return rw
+	}
+	remove := func(user *models.User, id string) int {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/api/system/validation_webhooks/"+id, nil), map[string]string{"id": id})
+		rw := httptest.NewRecorder()
+		h.DeleteValidationWebhookHandler(rw, req, nil, user, nil)
+		return rw.Code
+	}
+	webhooks := func() []models.ValidationWebhook {
+		webhooks, err := persister.GetWebhooks(false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return webhooks
+	}
+
+	if rw := save(alice, `{"name": "opa", "url": "https://opa.example.com/validate"}`); rw.Code != http.StatusForbidden {
+		t.Errorf("user creating a webhook got %d, want %d", rw.Code, http.StatusForbidden)
+	}
+	if got := webhooks(); len(got) != 0 {
+		t.Fatalf("webhooks created by a user were saved: %+v", got)
+	}
+
+	rw := save(root, `{"name": "opa", "url": "https://opa.example.com/validate"}`)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("admin creating a webhook got %d, want %d", rw.Code, http.StatusOK)
+	}
+	webhook := &models.ValidationWebhook{}
+	if err := json.NewDecoder(rw.Body).Decode(webhook); err != nil {
+		t.Fatal(err)
+	}
+
+	if rw := save(alice, `{"name": "opa", "url": "https://opa.example.com/validate", "disabled": true}`); rw.Code != http.StatusForbidden {
+		t.Errorf("user disabling the webhook got %d, want %d", rw.Code, http.StatusForbidden)
+	}
+	if code := remove(alice, webhook.ID.String()); code != http.StatusForbidden {
+		t.Errorf("user deleting the webhook got %d, want %d", code, http.StatusForbidden)
+	}
+	if got := webhooks(); len(got) != 1 || got[0].Disabled {
+		t.Fatalf("webhook was changed by a user: %+v", got)
+	}
+
+	if code := remove(root, webhook.ID.String()); code != http.StatusOK {
+		t.Errorf("admin deleting the webhook got %d, want %d", code, http.StatusOK)
+	}
+	if got := webhooks(); len(got) != 0 {
+		t.Errorf("deleted webhook is still saved: %+v", got)
+	}
+}
@@ -0,0 +1,380 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshkit/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// workloadTokenTTL is how long a reviewed ServiceAccount token is trusted before it is reviewed again
+const workloadTokenTTL = time.Minute
+
+// workloadTokenFailureTTL is how long a ServiceAccount token failing to authenticate is rejected
+// before it is reviewed again, sparing the API servers the TokenReviews of a misconfigured workload
+const workloadTokenFailureTTL = 10 * time.Second
+
+// workloadTokenCache holds the workload identities of the recently reviewed ServiceAccount
+// tokens, or the reason they failed to authenticate, keyed by the checksum of the token,
+// sparing a TokenReview on every request
+type workloadTokenCache struct {
+	mu       sync.Mutex
+	sessions map[string]workloadSession
+}
+
+type workloadSession struct {
+	identity  models.WorkloadIdentity
+	err       error
+	expiresAt time.Time
+}
+
+func newWorkloadTokenCache() *workloadTokenCache {
+	return &workloadTokenCache{sessions: map[string]workloadSession{}}
+}
+
+func (c *workloadTokenCache) get(key string) (workloadSession, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	session, ok := c.sessions[key]
+	if !ok || time.Now().After(session.expiresAt) {
+		return workloadSession{}, false
+	}
+	return session, true
+}
+
+func (c *workloadTokenCache) put(key string, session workloadSession) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, s := range c.sessions {
+		if now.After(s.expiresAt) {
+			delete(c.sessions, k)
+		}
+	}
+	c.sessions[key] = session
+}
+
+// evict drops the sessions of the workload identity with the given id
+func (c *workloadTokenCache) evict(id uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, session := range c.sessions {
+		if session.identity.ID != nil && *session.identity.ID == id {
+			delete(c.sessions, k)
+		}
+	}
+}
+
+// workloadBearerToken returns the ServiceAccount token of a request sent by a workload,
+// that is a bearer token on a request without a Meshery session
+func workloadBearerToken(req *http.Request) (string, bool) {
+	if _, err := req.Cookie(models.TokenCookieName); err == nil {
+		return "", false
+	}
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return "", false
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	return token, token != ""
+}
+
+// serviceAccountTokenClaims reads, without verifying them, the claims of a ServiceAccount token
+// needed to find the workload identities it may belong to. The token is verified by a TokenReview.
+func serviceAccountTokenClaims(token string) (namespace, serviceAccount string, expiresAt time.Time, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", "", time.Time{}, fmt.Errorf("token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	claims := struct {
+		Subject   string `json:"sub"`
+		ExpiresAt int64  `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", "", time.Time{}, err
+	}
+
+	subject := strings.Split(claims.Subject, ":")
+	if len(subject) != 4 || subject[0] != "system" || subject[1] != "serviceaccount" {
+		return "", "", time.Time{}, fmt.Errorf("token is not a ServiceAccount token")
+	}
+	if claims.ExpiresAt > 0 {
+		expiresAt = time.Unix(claims.ExpiresAt, 0)
+	}
+	return subject[2], subject[3], expiresAt, nil
+}
+
+// reviewWorkloadToken has the API server of the cluster authenticate the token for the given
+// audience and returns the username the token is authenticated as
+func reviewWorkloadToken(ctx context.Context, client kubernetes.Interface, token, audience string) (string, error) {
+	review, err := client.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: []string{audience},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+	if !review.Status.Authenticated {
+		return "", fmt.Errorf("token was not authenticated: %s", review.Status.Error)
+	}
+
+	// The API server leaves the audiences out when its authenticator does not support them
+	for _, aud := range review.Status.Audiences {
+		if aud == audience {
+			return review.Status.User.Username, nil
+		}
+	}
+	return "", fmt.Errorf("token was not issued for the audience %s", audience)
+}
+
+// authenticateWorkload returns the workload identity the ServiceAccount token of the request belongs
+// to, once the session of the user the identity acts on behalf of is still valid
+func (h *Handler) authenticateWorkload(req *http.Request, token string) (models.WorkloadIdentity, error) {
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+	if session, ok := h.workloadTokens.get(key); ok {
+		return session.identity, session.err
+	}
+
+	identity, expiresAt, err := h.reviewWorkload(req, token)
+	if err != nil {
+		err = ErrWorkloadToken(err)
+		h.workloadTokens.put(key, workloadSession{err: err, expiresAt: time.Now().Add(workloadTokenFailureTTL)})
+		return models.WorkloadIdentity{}, err
+	}
+
+	ttl := time.Now().Add(workloadTokenTTL)
+	if !expiresAt.IsZero() && expiresAt.Before(ttl) {
+		ttl = expiresAt
+	}
+	h.workloadTokens.put(key, workloadSession{identity: identity, expiresAt: ttl})
+	return identity, nil
+}
+
+// reviewWorkload reviews the ServiceAccount token against the clusters of the workload identities of
+// its ServiceAccount, the token having to authenticate against exactly one of them, and checks the
+// session of the user the identity acts on behalf of
+func (h *Handler) reviewWorkload(req *http.Request, token string) (models.WorkloadIdentity, time.Time, error) {
+	namespace, serviceAccount, expiresAt, err := serviceAccountTokenClaims(token)
+	if err != nil {
+		return models.WorkloadIdentity{}, expiresAt, err
+	}
+	identities, err := h.config.WorkloadIdentityPersister.GetWorkloadIdentitiesBySubject(namespace, serviceAccount)
+	if err != nil {
+		return models.WorkloadIdentity{}, expiresAt, err
+	}
+
+	errs := []string{}
+	matches := []models.WorkloadIdentity{}
+	for _, identity := range identities {
+		provider, ok := h.config.Providers[identity.Provider]
+		if !ok {
+			continue
+		}
+		k8sContext, err := provider.GetK8sContext(identity.ProviderToken, identity.K8sContextID)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		kubeClient, err := k8sContext.GenerateKubeHandler()
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		username, err := reviewWorkloadToken(req.Context(), kubeClient.KubeClient, token, identity.Audience)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		if username == identity.Subject() {
+			matches = append(matches, identity)
+		}
+	}
+
+	switch {
+	case len(matches) > 1:
+		return models.WorkloadIdentity{}, expiresAt, fmt.Errorf("the ServiceAccount %s/%s is bound to %d workload identities of the cluster, delete all but one of them", namespace, serviceAccount, len(matches))
+	case len(matches) == 1:
+		return matches[0], expiresAt, checkWorkloadSession(req, h.config.Providers[matches[0].Provider], matches[0])
+	case len(errs) > 0:
+		return models.WorkloadIdentity{}, expiresAt, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return models.WorkloadIdentity{}, expiresAt, fmt.Errorf("no workload identity is bound to the ServiceAccount %s/%s", namespace, serviceAccount)
+}
+
+// checkWorkloadSession checks that the provider token of the workload identity is still a session,
+// refreshed by the provider when it expired, of the user who created the identity
+func checkWorkloadSession(req *http.Request, provider models.Provider, identity models.WorkloadIdentity) error {
+	sessionReq := req.Clone(req.Context())
+	sessionReq.Header.Del("Authorization")
+	sessionReq.Header.Del("Cookie")
+	sessionReq.AddCookie(&http.Cookie{Name: models.TokenCookieName, Value: identity.ProviderToken})
+
+	if err := provider.GetSession(sessionReq); err != nil {
+		return fmt.Errorf("the session of the user %s the workload identity acts on behalf of expired, recreate the workload identity: %v", identity.CreatedBy, err)
+	}
+	user, err := provider.GetUserDetails(sessionReq)
+	if err != nil {
+		return fmt.Errorf("unable to get the user the workload identity acts on behalf of: %v", err)
+	}
+	if user.UserID != identity.CreatedBy {
+		return fmt.Errorf("the session of the workload identity belongs to the user %s rather than to %s who created it", user.UserID, identity.CreatedBy)
+	}
+	return nil
+}
+
+// swagger:route GET /api/identity/workloads IdentityAPI idGetWorkloadIdentities
+// Handle GET request for the workload identities
+//
+// Returns the workload identities created by the user
+// responses:
+// 	200: workloadIdentitiesResponseWrapper
+
+// swagger:route POST /api/identity/workloads IdentityAPI idPostWorkloadIdentity
+// Handle POST request to create a workload identity
+//
+// Allows the pods running as a ServiceAccount of a connected cluster to call the API of Meshery
+// on behalf of the user with their projected ServiceAccount token, as long as the session of the
+// user is valid. A ServiceAccount of a cluster can be bound to a single workload identity.
+// responses:
+// 	200: workloadIdentityResponseWrapper
+
+// WorkloadIdentitiesHandler handles the requests to list and create workload identities
+func (h *Handler) WorkloadIdentitiesHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if _, ok := r.Context().Value(models.WorkloadIdentityCtxKey).(models.WorkloadIdentity); ok {
+		err := ErrWorkloadIdentity(fmt.Errorf("workloads cannot manage workload identities"))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		identities, err := h.config.WorkloadIdentityPersister.GetWorkloadIdentities(user.UserID)
+		if err != nil {
+			h.log.Error(ErrWorkloadIdentity(err))
+			http.Error(rw, ErrWorkloadIdentity(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		h.writeWorkloadIdentityJSON(rw, identities)
+		return
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	identity := &models.WorkloadIdentity{}
+	if err := json.NewDecoder(r.Body).Decode(identity); err != nil {
+		obj := "workload identity"
+		h.log.Error(ErrDecoding(err, obj))
+		http.Error(rw, ErrDecoding(err, obj).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := identity.Validate(); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	token, err := provider.GetProviderToken(r)
+	if err != nil {
+		h.log.Error(ErrRetrieveUserToken(err))
+		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusUnauthorized)
+		return
+	}
+	if _, err := provider.GetK8sContext(token, identity.K8sContextID); err != nil {
+		h.log.Error(ErrWorkloadIdentity(err))
+		http.Error(rw, ErrWorkloadIdentity(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	identity.ID = nil
+	identity.Provider = provider.Name()
+	identity.ProviderToken = token
+	identity.CreatedBy = user.UserID
+	if err := h.config.WorkloadIdentityPersister.SaveWorkloadIdentity(identity); err != nil {
+		if e, ok := err.(*errors.Error); ok && e.Code == models.ErrDuplicateWorkloadIdentityCode {
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusConflict)
+			return
+		}
+		h.log.Error(ErrWorkloadIdentity(err))
+		http.Error(rw, ErrWorkloadIdentity(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeWorkloadIdentityJSON(rw, identity)
+}
+
+// swagger:route DELETE /api/identity/workloads/{id} IdentityAPI idDeleteWorkloadIdentity
+// Handle DELETE request for a workload identity
+//
+// Revokes the access of the workloads running as the ServiceAccount of the identity
+// responses:
+// 	200:
+
+// DeleteWorkloadIdentityHandler deletes the workload identity with the given id
+func (h *Handler) DeleteWorkloadIdentityHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if _, ok := r.Context().Value(models.WorkloadIdentityCtxKey).(models.WorkloadIdentity); ok {
+		err := ErrWorkloadIdentity(fmt.Errorf("workloads cannot manage workload identities"))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrWorkloadIdentity(err))
+		http.Error(rw, ErrWorkloadIdentity(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.config.WorkloadIdentityPersister.DeleteWorkloadIdentity(id, user.UserID); err != nil {
+		h.log.Error(ErrWorkloadIdentity(err))
+		http.Error(rw, ErrWorkloadIdentity(err).Error(), http.StatusNotFound)
+		return
+	}
+	h.workloadTokens.evict(id)
+}
+
+func (h *Handler) writeWorkloadIdentityJSON(rw http.ResponseWriter, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		obj := "workload identity"
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+	authenticationv1 "k8s.io/api/authentication/v1"
+)
+
+// newTestServiceAccountToken returns a token of the ServiceAccount, authenticated by
+// newTestTokenReviewServer when its signature is valid
+func newTestServiceAccountToken(namespace, serviceAccount, signature string) string {
+	claims, _ := json.Marshal(map[string]interface{}{
+		"sub": fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	return "e30." + base64.RawURLEncoding.EncodeToString(claims) + "." + signature
+}
+
+// newTestTokenReviewServer returns an API server reviewing the tokens of newTestServiceAccountToken,
+// counting the TokenReviews
+func newTestTokenReviewServer(t *testing.T, reviews *int32) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/authentication.k8s.io/v1/tokenreviews" {
+			http.NotFound(rw, r)
+			return
+		}
+		atomic.AddInt32(reviews, 1)
+
+		review := authenticationv1.TokenReview{}
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		parts := strings.Split(review.Spec.Token, ".")
+		if len(parts) == 3 && parts[2] == "valid" {
+			payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+			claims := struct {
+				Subject string `json:"sub"`
+			}{}
+			_ = json.Unmarshal(payload, &claims)
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authenticationv1.UserInfo{Username: claims.Subject},
+				Audiences:     review.Spec.Audiences,
+			}
+		} else {
+			review.Status = authenticationv1.TokenReviewStatus{Error: "invalid bearer token"}
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(rw).Encode(review)
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestAuthenticateWorkload(t *testing.T) {
+	var reviews int32
+	ts := newTestTokenReviewServer(t, &reviews)
+
+	db := newTestDatabase(t)
+	tc, err := models.LoadTokenCipher("", filepath.Join(t.TempDir(), "workload_identity.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	persister := &models.WorkloadIdentityPersister{DB: db, Cipher: tc}
+	provider := &models.DefaultLocalProvider{
+		ProviderProperties:         models.ProviderProperties{ProviderName: "Meshery"},
+		MesheryK8sContextPersister: &models.MesheryK8sContextPersister{DB: db},
+		GenericPersister:           *db,
+	}
+	for _, id := range []string{"kind", "kind-mirror"} {
+		if _, err := provider.SaveK8sContext("", models.K8sContext{
+			ID:      id,
+			Name:    id,
+			Cluster: map[string]interface{}{"name": id, "cluster": map[string]interface{}{"server": ts.URL}},
+			Auth:    map[string]interface{}{"name": "meshery", "user": map[string]interface{}{"token": "meshery"}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h := newTestHandler(t, &models.HandlerConfig{
+		Providers:                 map[string]models.Provider{"Meshery": provider},
+		WorkloadIdentityPersister: persister,
+	})
+
+	bind := func(k8sContextID, serviceAccount, createdBy string) {
+		t.Helper()
+		identity := &models.WorkloadIdentity{K8sContextID: k8sContextID, Namespace: "ops", ServiceAccount: serviceAccount, Provider: "Meshery", CreatedBy: createdBy}
+		if err := identity.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if err := persister.SaveWorkloadIdentity(identity); err != nil {
+			t.Fatal(err)
+		}
+	}
+	authenticate := func(token string) (models.WorkloadIdentity, error) {
+		req := httptest.NewRequest(http.MethodGet, "/api/pattern", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		return h.authenticateWorkload(req, token)
+	}
+
+	bind("kind", "release-bot", "meshery")
+	bind("kind", "stale-bot", "alice")
+
+	t.Run("valid token", func(t *testing.T) {
+		atomic.StoreInt32(&reviews, 0)
+		token := newTestServiceAccountToken("ops", "release-bot", "valid")
+		for i := 0; i < 2; i++ {
+			identity, err := authenticate(token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if identity.CreatedBy != "meshery" || identity.K8sContextID != "kind" {
+				t.Errorf("token authenticated as %+v", identity)
+			}
+		}
+		if got := atomic.LoadInt32(&reviews); got != 1 {
+			t.Errorf("token was reviewed %d times, want once", got)
+		}
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		atomic.StoreInt32(&reviews, 0)
+		token := newTestServiceAccountToken("ops", "release-bot", "forged")
+		for i := 0; i < 2; i++ {
+			if _, err := authenticate(token); err == nil {
+				t.Fatal("invalid token was authenticated")
+			}
+		}
+		if got := atomic.LoadInt32(&reviews); got != 1 {
+			t.Errorf("failing token was reviewed %d times, want once", got)
+		}
+	})
+
+	t.Run("session of another user", func(t *testing.T) {
+		_, err := authenticate(newTestServiceAccountToken("ops", "stale-bot", "valid"))
+		if err == nil || !strings.Contains(err.Error(), "rather than to alice") {
+			t.Errorf("token of an identity whose session belongs to another user authenticated with %v", err)
+		}
+	})
+
+	t.Run("ambiguous bindings", func(t *testing.T) {
+		bind("kind-mirror", "release-bot", "meshery")
+		h.workloadTokens = newWorkloadTokenCache()
+		_, err := authenticate(newTestServiceAccountToken("ops", "release-bot", "valid"))
+		if err == nil || !strings.Contains(err.Error(), "bound to 2 workload identities") {
+			t.Errorf("token of a ServiceAccount bound twice authenticated with %v", err)
+		}
+	})
+}
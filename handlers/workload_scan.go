@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshery/models"
+	pCore "github.com/layer5io/meshery/models/pattern/core"
+	meshsyncmodel "github.com/layer5io/meshsync/pkg/model"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// WorkloadScanRequest selects the resources discovered by MeshSync which are
+// reverse engineered into a pattern
+type WorkloadScanRequest struct {
+	// Name of the generated pattern
+	Name string `json:"name,omitempty"`
+	// Namespaces the workloads are selected from
+	Namespaces []string `json:"namespaces,omitempty"`
+	// LabelSelector the labels of the workloads must match
+	LabelSelector string `json:"label_selector,omitempty"`
+}
+
+// scanWorkloads creates a pattern out of the cluster resources known to MeshSync
+// which match the scan request. Resources owned by other resources, e.g. the
+// ReplicaSets of a Deployment, are left out as they are recreated by their owner.
+func scanWorkloads(provider models.Provider, scan *WorkloadScanRequest) (pCore.Pattern, error) {
+	if len(scan.Namespaces) == 0 && scan.LabelSelector == "" {
+		return pCore.Pattern{}, ErrWorkloadScan(fmt.Errorf("namespaces or a label selector are required"))
+	}
+
+	selector, err := labels.Parse(scan.LabelSelector)
+	if err != nil {
+		return pCore.Pattern{}, ErrWorkloadScan(err)
+	}
+
+	objects, err := meshSyncObjects(provider)
+	if err != nil {
+		return pCore.Pattern{}, ErrWorkloadScan(err)
+	}
+
+	namespaces := map[string]bool{}
+	for _, ns := range scan.Namespaces {
+		namespaces[ns] = true
+	}
+
+	manifests := []map[string]interface{}{}
+	for _, obj := range objects {
+		if obj.ObjectMeta == nil || isOwned(obj.ObjectMeta) {
+			continue
+		}
+
+		// Namespaces are selected by name, everything else by namespace and labels
+		if obj.Kind == "Namespace" && namespaces[obj.ObjectMeta.Name] {
+			manifests = append(manifests, manifestFromMeshSyncObject(obj))
+			continue
+		}
+		if len(namespaces) > 0 && !namespaces[obj.ObjectMeta.Namespace] {
+			continue
+		}
+		if !selector.Matches(labels.Set(keyValues(obj.ObjectMeta.Labels))) {
+			continue
+		}
+
+		manifests = append(manifests, manifestFromMeshSyncObject(obj))
+	}
+
+	name := scan.Name
+	if name == "" {
+		name = "scanned-workloads"
+	}
+	return pCore.NewPatternFileFromK8sResources(name, manifests)
+}
+
+// meshSyncObjects returns the cluster resources known to MeshSync
+func meshSyncObjects(provider models.Provider) ([]meshsyncmodel.Object, error) {
+	db := provider.GetGenericPersister()
+	if db == nil || db.DB == nil {
+		return nil, fmt.Errorf("meshsync data is not available")
+	}
+
+	objects := []meshsyncmodel.Object{}
+	err := db.
+		Preload("ObjectMeta").
+		Preload("ObjectMeta.Labels", "kind = ?", meshsyncmodel.KindLabel).
+		Preload("ObjectMeta.Annotations", "kind = ?", meshsyncmodel.KindAnnotation).
+		Preload("Spec").
+		Find(&objects).Error
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// liveManifests returns the manifests of the cluster resources known to MeshSync
+// which are not owned by other resources
+func liveManifests(provider models.Provider) ([]map[string]interface{}, error) {
+	objects, err := meshSyncObjects(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := []map[string]interface{}{}
+	for _, obj := range objects {
+		if obj.ObjectMeta == nil || isOwned(obj.ObjectMeta) {
+			continue
+		}
+		manifests = append(manifests, manifestFromMeshSyncObject(obj))
+	}
+	return manifests, nil
+}
+
+// manifestFromMeshSyncObject rebuilds the kubernetes manifest of a MeshSync object,
+// leaving out the fields populated by the cluster
+func manifestFromMeshSyncObject(obj meshsyncmodel.Object) map[string]interface{} {
+	metadata := map[string]interface{}{
+		"name": obj.ObjectMeta.Name,
+	}
+	if obj.ObjectMeta.Namespace != "" {
+		metadata["namespace"] = obj.ObjectMeta.Namespace
+	}
+	if l := keyValues(obj.ObjectMeta.Labels); len(l) > 0 {
+		metadata["labels"] = toInterfaceMap(l)
+	}
+	if a := keyValues(obj.ObjectMeta.Annotations); len(a) > 0 {
+		// The last applied configuration duplicates the whole manifest
+		delete(a, "kubectl.kubernetes.io/last-applied-configuration")
+		metadata["annotations"] = toInterfaceMap(a)
+	}
+
+	manifest := map[string]interface{}{
+		"apiVersion": obj.APIVersion,
+		"kind":       obj.Kind,
+		"metadata":   metadata,
+	}
+
+	if obj.Spec != nil && obj.Spec.Attribute != "" {
+		spec := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(obj.Spec.Attribute), &spec); err == nil {
+			if obj.Kind == "Service" {
+				// Cluster IPs are allocated by the cluster the Service is created in
+				delete(spec, "clusterIP")
+				delete(spec, "clusterIPs")
+			}
+			manifest["spec"] = spec
+		}
+	}
+
+	// Secret values are never copied into a design
+	if obj.Data != "" && obj.Kind != "Secret" {
+		data := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(obj.Data), &data); err == nil {
+			manifest["data"] = data
+		}
+	}
+
+	return manifest
+}
+
+// isOwned reports whether the object is managed by another object
+func isOwned(meta *meshsyncmodel.ResourceObjectMeta) bool {
+	refs := strings.TrimSpace(meta.OwnerReferences)
+	return refs != "" && refs != "null" && refs != "[]"
+}
+
+func keyValues(kvs []*meshsyncmodel.KeyValue) map[string]string {
+	result := map[string]string{}
+	for _, kv := range kvs {
+		if kv != nil {
+			result[kv.Key] = kv.Value
+		}
+	}
+	return result
+}
+
+func toInterfaceMap(m map[string]string) map[string]interface{} {
+	result := map[string]interface{}{}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/models"
+)
+
+// swagger:route GET /api/user/workspace/usage UserAPI idGetWorkspaceUsage
+// Handle GET request for the usage of the quotas of the workspace of the user
+//
+// Returns the workspace of the user, its members and quotas, and the performance profiles, concurrent
+// performance tests, size of the results and designs they use of them. A quota of zero is unlimited.
+// responses:
+// 	200: workspaceUsageResponseWrapper
+
+// WorkspaceUsageHandler returns the usage of the quotas of the workspace of the user
+func (h *Handler) WorkspaceUsageHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if h.config.WorkspaceQuotas == nil {
+		http.Error(rw, "workspace quotas are not enabled on this Meshery Server", http.StatusNotImplemented)
+		return
+	}
+	report, err := h.config.WorkspaceQuotas.Usage(user.UserID)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(report); err != nil {
+		obj := "workspace usage"
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/user/workspace/limits UserAPI idGetWorkspaceLoadTestLimits
+// Handle GET request for the load test limits of the workspace of the user
+//
+// Returns the load test limits the performance tests of the user are checked against, those set by an
+// admin for the workspace of the user or, when none were, the default ones of Meshery Server. A limit
+// of zero is unlimited.
+// responses:
+// 	200: workspaceLoadTestLimitsResponseWrapper
+
+// WorkspaceLoadTestLimitsHandler returns the load test limits of the workspace of the user
+func (h *Handler) WorkspaceLoadTestLimitsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if h.config.WorkspaceQuotas == nil {
+		http.Error(rw, "workspace quotas are not enabled on this Meshery Server", http.StatusNotImplemented)
+		return
+	}
+	report, err := h.config.WorkspaceQuotas.LoadTestLimits(user.UserID)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeWorkspaceLoadTestLimits(rw, report)
+}
+
+// swagger:route GET /api/workspaces/{workspace}/limits UserAPI idGetWorkspaceLoadTestLimitsOfWorkspace
+// Handle GET request for the load test limits of a workspace
+//
+// Returns the load test limits of the workspace, for the admins of Meshery Server
+// responses:
+// 	200: workspaceLoadTestLimitsResponseWrapper
+
+// swagger:route PUT /api/workspaces/{workspace}/limits UserAPI idPutWorkspaceLoadTestLimits
+// Handle PUT request for the load test limits of a workspace
+//
+// Sets the load test limits of the workspace, such as {"max_qps": 500, "max_duration": "10m", "max_connections": 64},
+// in place of the default ones of Meshery Server. A limit of zero is unlimited. For the admins of Meshery Server.
+// responses:
+// 	200: workspaceLoadTestLimitsResponseWrapper
+
+// swagger:route DELETE /api/workspaces/{workspace}/limits UserAPI idDeleteWorkspaceLoadTestLimits
+// Handle DELETE request for the load test limits of a workspace
+//
+// Restores the default load test limits of Meshery Server for the workspace, for the admins of Meshery Server
+// responses:
+// 	200: workspaceLoadTestLimitsResponseWrapper
+
+// WorkspaceLoadTestLimitsAdminHandler returns, sets or resets the load test limits of a workspace
+func (h *Handler) WorkspaceLoadTestLimitsAdminHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if h.config.WorkspaceQuotas == nil {
+		http.Error(rw, "workspace quotas are not enabled on this Meshery Server", http.StatusNotImplemented)
+		return
+	}
+	if !h.isAdmin(user, provider) {
+		err := ErrNotAdmin("read or set the load test limits of the workspaces")
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+	workspace := mux.Vars(r)["workspace"]
+
+	switch r.Method {
+	case http.MethodPut:
+		limits := &models.LoadTestLimits{}
+		if err := json.NewDecoder(r.Body).Decode(limits); err != nil {
+			http.Error(rw, fmt.Sprintf("invalid load test limits: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := limits.Validate(); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := h.config.WorkspaceQuotas.SetLoadTestLimits(workspace, limits, user.UserID); err != nil {
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	case http.MethodDelete:
+		if err := h.config.WorkspaceQuotas.SetLoadTestLimits(workspace, nil, user.UserID); err != nil {
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	report, err := h.config.WorkspaceQuotas.WorkspaceLoadTestLimits(workspace)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeWorkspaceLoadTestLimits(rw, report)
+}
+
+func (h *Handler) writeWorkspaceLoadTestLimits(rw http.ResponseWriter, report *models.WorkspaceLoadTestLimitsReport) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(report); err != nil {
+		obj := "workspace load test limits"
+		h.log.Error(ErrEncoding(err, obj))
+		http.Error(rw, ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// loadTestLimits returns the load test limits of the workspace of the user along with the name of
+// the workspace, the default ones of Meshery Server when workspace quotas are not enabled
+func (h *Handler) loadTestLimits(user *models.User) (string, models.LoadTestLimits, error) {
+	if h.config.WorkspaceQuotas == nil || user == nil {
+		return "", h.config.LoadTestLimits, nil
+	}
+	report, err := h.config.WorkspaceQuotas.LoadTestLimits(user.UserID)
+	if err != nil {
+		return "", models.LoadTestLimits{}, err
+	}
+	return report.Workspace, report.Limits, nil
+}
+
+// checkWorkspaceQuota responds with the error and returns false when the workspace of the user has
+// no room for one more artifact of the kind
+func (h *Handler) checkWorkspaceQuota(rw http.ResponseWriter, user *models.User, kind string) bool {
+	if h.config.WorkspaceQuotas == nil || user == nil {
+		return true
+	}
+	return h.workspaceQuotaError(rw, h.config.WorkspaceQuotas.Check(user.UserID, kind))
+}
+
+// workspaceQuotaError responds with the error, a quota exceeded being forbidden, and returns whether
+// there was none
+func (h *Handler) workspaceQuotaError(rw http.ResponseWriter, err error) bool {
+	if err == nil {
+		return true
+	}
+	var exceeded *models.QuotaExceeded
+	if errors.As(err, &exceeded) {
+		err = ErrQuotaExceeded(exceeded)
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return false
+	}
+	h.log.Error(err)
+	http.Error(rw, err.Error(), http.StatusInternalServerError)
+	return false
+}
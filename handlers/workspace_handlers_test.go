@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/helpers"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestWorkspaceLoadTestLimitsHandlers(t *testing.T) {
+	db := newTestDatabase(t)
+	defaults := models.LoadTestLimits{MaxQPS: 100, MaxDuration: 5 * time.Minute}
+	quotas, err := helpers.NewWorkspaceQuotas(
+		&models.WorkspaceUsagePersister{DB: db},
+		&models.WorkspaceLoadTestLimitsPersister{DB: db},
+		models.WorkspaceQuotas{},
+		defaults,
+		[]models.Workspace{{Name: "payments", Members: []string{"alice"}}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newTestHandler(t, &models.HandlerConfig{
+		LoadTestLimits:  defaults,
+		WorkspaceQuotas: quotas,
+		Admins:          []string{"root"},
+	})
+	alice, carol, root := &models.User{UserID: "alice"}, &models.User{UserID: "carol"}, &models.User{UserID: "root"}
+
+	setLimits := func(user *models.User, method, body string) (int, *models.WorkspaceLoadTestLimitsReport) {
+		req := mux.SetURLVars(httptest.NewRequest(method, "/api/workspaces/payments/limits", strings.NewReader(body)), map[string]string{"workspace": "payments"})
+		rw := httptest.NewRecorder()
+		h.WorkspaceLoadTestLimitsAdminHandler(rw, req, nil, user, nil)
+		report := &models.WorkspaceLoadTestLimitsReport{}
+		if rw.Code == http.StatusOK {
+			if err := json.NewDecoder(rw.Body).Decode(report); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return rw.Code, report
+	}
+	checkTest := func(user *models.User, qps float64) []string {
+		workspace, limits, err := h.loadTestLimits(user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if workspace == "" {
+			t.Errorf("limits of %s are those of no workspace", user.UserID)
+		}
+		return limits.Violations(&models.LoadTestOptions{URL: "https://staging.example.com", HTTPQPS: qps, HTTPNumThreads: 8, Duration: time.Minute})
+	}
+
+	t.Run("default limits", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		h.WorkspaceLoadTestLimitsHandler(rw, httptest.NewRequest(http.MethodGet, "/api/user/workspace/limits", nil), nil, alice, nil)
+		report := &models.WorkspaceLoadTestLimitsReport{}
+		if err := json.NewDecoder(rw.Body).Decode(report); err != nil {
+			t.Fatal(err)
+		}
+		if report.Workspace != "payments" || !report.Default || report.Limits != defaults {
+			t.Errorf("limits of alice are %+v, want the default ones %+v", report, defaults)
+		}
+		if violations := checkTest(alice, 400); len(violations) == 0 {
+			t.Error("test at 400 QPS is within the default limit of 100 QPS")
+		}
+	})
+
+	t.Run("only the admins set the limits", func(t *testing.T) {
+		if code, _ := setLimits(alice, http.MethodPut, `{"max_qps": 500}`); code != http.StatusForbidden {
+			t.Errorf("member of the workspace setting its limits got %d, want %d", code, http.StatusForbidden)
+		}
+		if code, _ := setLimits(alice, http.MethodGet, ""); code != http.StatusForbidden {
+			t.Errorf("member of the workspace reading the limits of workspaces got %d, want %d", code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("invalid limits", func(t *testing.T) {
+		for _, body := range []string{`{"max_qps": -1}`, `{"max_duration": "ten minutes"}`, `{"max_connections": "64"}`} {
+			if code, _ := setLimits(root, http.MethodPut, body); code != http.StatusBadRequest {
+				t.Errorf("limits %s got %d, want %d", body, code, http.StatusBadRequest)
+			}
+		}
+	})
+
+	t.Run("limits of the workspace", func(t *testing.T) {
+		code, report := setLimits(root, http.MethodPut, `{"max_qps": 500, "max_duration": "10m", "max_connections": 64}`)
+		want := models.LoadTestLimits{MaxQPS: 500, MaxDuration: 10 * time.Minute, MaxConnections: 64}
+		if code != http.StatusOK || report.Default || report.Limits != want || report.UpdatedBy != "root" {
+			t.Fatalf("setting the limits got %d, %+v, want %+v", code, report, want)
+		}
+		if violations := checkTest(alice, 400); len(violations) > 0 {
+			t.Errorf("test at 400 QPS exceeds the limits of the payments workspace: %v", violations)
+		}
+		if violations := checkTest(carol, 400); len(violations) == 0 {
+			t.Error("test at 400 QPS of a user in no workspace is within the default limit of 100 QPS")
+		}
+	})
+
+	t.Run("reset to the default limits", func(t *testing.T) {
+		if code, report := setLimits(root, http.MethodDelete, ""); code != http.StatusOK || !report.Default || report.Limits != defaults {
+			t.Errorf("resetting the limits got %d, %+v, want the default ones", code, report)
+		}
+		if violations := checkTest(alice, 400); len(violations) == 0 {
+			t.Error("test at 400 QPS is within the default limit of 100 QPS once the limits are reset")
+		}
+	})
+}
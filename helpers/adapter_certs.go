@@ -0,0 +1,347 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/meshes"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// File names follow the layout of the secrets issued by cert-manager so that
+	// a cert-manager Certificate can be mounted in place of the provisioned one
+	AdapterTLSCAFile   = "ca.crt"
+	AdapterTLSCertFile = "tls.crt"
+	AdapterTLSKeyFile  = "tls.key"
+
+	adapterTLSCAKeyFile      = "ca.key"
+	adapterTLSServerCertFile = "adapter.crt"
+	adapterTLSServerKeyFile  = "adapter.key"
+
+	// adapterCertRenewBefore is how long before they expire the certificates are renewed
+	adapterCertRenewBefore = 30 * 24 * time.Hour
+
+	serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// adapterCertValidity is how long the issued certificates are valid for
+var adapterCertValidity = 365 * 24 * time.Hour
+
+// ProvisionAdapterCerts makes sure the given directory holds the certificates needed for
+// mutual TLS between Meshery and its adapters: a CA along with a client certificate for Meshery
+// and a serving certificate, valid for the given hosts, to be mounted by the adapters. The
+// certificates missing or expiring within 30 days are issued again, as is the serving certificate
+// when it is not valid for every given host, every certificate being issued again along with the
+// CA. A CA mounted without its key, such as one issued by cert-manager, is left as it is. It returns
+// whether any certificate was issued.
+func ProvisionAdapterCerts(dir string, hosts []string) (bool, error) {
+	_, caKeyErr := os.Stat(filepath.Join(dir, adapterTLSCAKeyFile))
+	_, certErr := os.Stat(filepath.Join(dir, AdapterTLSCertFile))
+	if os.IsNotExist(caKeyErr) && certErr == nil {
+		return false, nil
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return false, err
+	}
+
+	issued := false
+	caCert, caKey, err := loadCertificate(dir, AdapterTLSCAFile, adapterTLSCAKeyFile)
+	if err != nil || expiresSoon(caCert) {
+		caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return false, err
+		}
+		caTemplate := &x509.Certificate{
+			Subject:               pkix.Name{CommonName: "meshery-adapter-ca", Organization: []string{"Meshery"}},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		}
+		if caCert, err = issueCertificate(dir, AdapterTLSCAFile, adapterTLSCAKeyFile, caTemplate, nil, caKey); err != nil {
+			return false, err
+		}
+		issued = true
+	}
+
+	serverCert, _, err := loadCertificate(dir, adapterTLSServerCertFile, adapterTLSServerKeyFile)
+	if err != nil || issued || expiresSoon(serverCert) || !validForHosts(serverCert, hosts) {
+		serverTemplate := &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "meshery-adapter", Organization: []string{"Meshery"}},
+			KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}
+		for _, host := range hosts {
+			if ip := net.ParseIP(host); ip != nil {
+				serverTemplate.IPAddresses = append(serverTemplate.IPAddresses, ip)
+			} else {
+				serverTemplate.DNSNames = append(serverTemplate.DNSNames, host)
+			}
+		}
+		if _, err = issueCertificate(dir, adapterTLSServerCertFile, adapterTLSServerKeyFile, serverTemplate, caCert, caKey); err != nil {
+			return false, err
+		}
+		issued = true
+	}
+
+	clientCert, _, err := loadCertificate(dir, AdapterTLSCertFile, AdapterTLSKeyFile)
+	if err != nil || issued || expiresSoon(clientCert) {
+		clientTemplate := &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "meshery", Organization: []string{"Meshery"}},
+			KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		if _, err = issueCertificate(dir, AdapterTLSCertFile, AdapterTLSKeyFile, clientTemplate, caCert, caKey); err != nil {
+			return false, err
+		}
+		issued = true
+	}
+	return issued, nil
+}
+
+// issueCertificate signs the template with the given parent, self-signing it when the parent is nil,
+// and writes the resulting certificate and its key to the given files
+func issueCertificate(dir, certFile, keyFile string, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, error) {
+	key := parentKey
+	if parent != nil {
+		var err error
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		parent = template
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	template.SerialNumber = serial
+	template.NotBefore = time.Now().Add(-time.Minute)
+	template.NotAfter = time.Now().Add(adapterCertValidity)
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.WriteFile(filepath.Join(dir, certFile), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+		return nil, err
+	}
+	if err = os.WriteFile(filepath.Join(dir, keyFile), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+// loadCertificate reads the certificate and its key from the given files
+func loadCertificate(dir, certFile, keyFile string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certPEM, err := os.ReadFile(filepath.Join(dir, certFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPEM, err := os.ReadFile(filepath.Join(dir, keyFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	certBlock, _ := pem.Decode(certPEM)
+	keyBlock, _ := pem.Decode(keyPEM)
+	if certBlock == nil || keyBlock == nil {
+		return nil, nil, fmt.Errorf("%s or %s is not PEM encoded", certFile, keyFile)
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+func expiresSoon(cert *x509.Certificate) bool {
+	return time.Now().Add(adapterCertRenewBefore).After(cert.NotAfter)
+}
+
+// validForHosts checks whether the certificate is valid for every given host
+func validForHosts(cert *x509.Certificate, hosts []string) bool {
+	for _, host := range hosts {
+		if cert.VerifyHostname(host) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// AdapterCerts keeps the certificates of mutual TLS between Meshery and its adapters valid for the
+// hosts of the adapters, those registered at runtime included, renewing them before they expire.
+// The CA and the serving certificate are published to a Secret for the adapters to mount, when set.
+type AdapterCerts struct {
+	dir string
+
+	hosts     []string
+	hostsLock *sync.Mutex
+
+	secretClient    kubernetes.Interface
+	secretNamespace string
+	secretName      string
+}
+
+// NewAdapterCerts returns an instance of AdapterCerts provisioning the certificates in the given
+// directory, their serving certificate being valid for the given hosts
+func NewAdapterCerts(dir string, hosts []string) *AdapterCerts {
+	return &AdapterCerts{
+		dir:       dir,
+		hosts:     append([]string{}, hosts...),
+		hostsLock: &sync.Mutex{},
+	}
+}
+
+// PublishToSecret publishes the CA and the serving certificate to the given Secret, as a TLS secret
+func (ac *AdapterCerts) PublishToSecret(client kubernetes.Interface, namespace, name string) {
+	ac.secretClient = client
+	ac.secretNamespace = namespace
+	ac.secretName = name
+}
+
+// PublishToClusterSecret publishes the CA and the serving certificate to the Secret of the given name in
+// the namespace Meshery Server runs in, failing when it does not run in a cluster
+func (ac *AdapterCerts) PublishToClusterSecret(name string) error {
+	namespace, err := os.ReadFile(serviceAccountNamespaceFile)
+	if err != nil {
+		return err
+	}
+	client, err := getK8SClientSet(nil, "")
+	if err != nil {
+		return err
+	}
+	ac.PublishToSecret(client, strings.TrimSpace(string(namespace)), name)
+	return nil
+}
+
+// AddHosts makes the serving certificate valid for the given hosts too, issuing it again when needed
+func (ac *AdapterCerts) AddHosts(ctx context.Context, hosts ...string) error {
+	ac.hostsLock.Lock()
+	for _, host := range hosts {
+		known := false
+		for _, h := range ac.hosts {
+			known = known || h == host
+		}
+		if !known {
+			ac.hosts = append(ac.hosts, host)
+		}
+	}
+	ac.hostsLock.Unlock()
+	return ac.Provision(ctx)
+}
+
+// Provision provisions the certificates, configuring the connections to the adapters with the client
+// certificate and publishing the CA and the serving certificate
+func (ac *AdapterCerts) Provision(ctx context.Context) error {
+	ac.hostsLock.Lock()
+	defer ac.hostsLock.Unlock()
+
+	issued, err := ProvisionAdapterCerts(ac.dir, ac.hosts)
+	if err != nil {
+		return err
+	}
+	if issued {
+		logrus.Infof("Issued the certificates of mutual TLS with the adapters in '%s'", ac.dir)
+	}
+	if err := meshes.ConfigureMTLS(filepath.Join(ac.dir, AdapterTLSCertFile), filepath.Join(ac.dir, AdapterTLSKeyFile), filepath.Join(ac.dir, AdapterTLSCAFile)); err != nil {
+		return err
+	}
+	return ac.publish(ctx)
+}
+
+// Run provisions the certificates on every tick until the context is cancelled, renewing them
+// before they expire
+func (ac *AdapterCerts) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := ac.Provision(ctx); err != nil {
+				logrus.Errorf("unable to renew the certificates of the adapters: %v", err)
+			}
+		}
+	}
+}
+
+// publish creates or updates the Secret of the adapters with the CA and the serving certificate
+func (ac *AdapterCerts) publish(ctx context.Context) error {
+	if ac.secretClient == nil {
+		return nil
+	}
+
+	data := map[string][]byte{}
+	for key, file := range map[string]string{
+		AdapterTLSCAFile:   AdapterTLSCAFile,
+		AdapterTLSCertFile: adapterTLSServerCertFile,
+		AdapterTLSKeyFile:  adapterTLSServerKeyFile,
+	} {
+		content, err := os.ReadFile(filepath.Join(ac.dir, file))
+		if err != nil {
+			return err
+		}
+		data[key] = content
+	}
+
+	secrets := ac.secretClient.CoreV1().Secrets(ac.secretNamespace)
+	secret, err := secrets.Get(ctx, ac.secretName, metav1.GetOptions{})
+	if kubeerrors.IsNotFound(err) {
+		_, err = secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ac.secretName,
+				Namespace: ac.secretNamespace,
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "meshery"},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: data,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for key, content := range data {
+		changed = changed || !bytes.Equal(secret.Data[key], content)
+	}
+	if !changed {
+		return nil
+	}
+	secret.Data = data
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,156 @@
+package helpers
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func readTestCertificate(t *testing.T, dir, file string) *x509.Certificate {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		t.Fatalf("%s is not PEM encoded", file)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func provisionTestAdapterCerts(t *testing.T, dir string, hosts []string, wantIssued bool) {
+	t.Helper()
+	issued, err := ProvisionAdapterCerts(dir, hosts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issued != wantIssued {
+		t.Errorf("ProvisionAdapterCerts(%v) issued certificates: %t, want %t", hosts, issued, wantIssued)
+	}
+}
+
+func TestProvisionAdapterCerts(t *testing.T) {
+	dir := t.TempDir()
+	hosts := []string{"localhost", "127.0.0.1"}
+
+	t.Run("generation", func(t *testing.T) {
+		provisionTestAdapterCerts(t, dir, hosts, true)
+
+		ca := readTestCertificate(t, dir, AdapterTLSCAFile)
+		server := readTestCertificate(t, dir, adapterTLSServerCertFile)
+		client := readTestCertificate(t, dir, AdapterTLSCertFile)
+		for name, cert := range map[string]*x509.Certificate{"serving": server, "client": client} {
+			if err := cert.CheckSignatureFrom(ca); err != nil {
+				t.Errorf("%s certificate is not signed by the CA: %v", name, err)
+			}
+		}
+		if !validForHosts(server, hosts) {
+			t.Errorf("serving certificate is valid for %v and %v, want %v", server.DNSNames, server.IPAddresses, hosts)
+		}
+	})
+
+	t.Run("reuse", func(t *testing.T) {
+		before := readTestCertificate(t, dir, adapterTLSServerCertFile)
+		provisionTestAdapterCerts(t, dir, hosts, false)
+		if after := readTestCertificate(t, dir, adapterTLSServerCertFile); !after.Equal(before) {
+			t.Error("valid serving certificate was issued again")
+		}
+	})
+
+	t.Run("renewal for new hosts", func(t *testing.T) {
+		ca := readTestCertificate(t, dir, AdapterTLSCAFile)
+		more := append(append([]string{}, hosts...), "istio-adapter.example.com")
+		provisionTestAdapterCerts(t, dir, more, true)
+
+		if !readTestCertificate(t, dir, AdapterTLSCAFile).Equal(ca) {
+			t.Error("CA was issued again along with the serving certificate")
+		}
+		if server := readTestCertificate(t, dir, adapterTLSServerCertFile); !validForHosts(server, more) {
+			t.Errorf("serving certificate is valid for %v, want %v", server.DNSNames, more)
+		}
+	})
+
+	t.Run("renewal before expiry", func(t *testing.T) {
+		dir := t.TempDir()
+		validity := adapterCertValidity
+		adapterCertValidity = adapterCertRenewBefore / 2
+		provisionTestAdapterCerts(t, dir, hosts, true)
+		adapterCertValidity = validity
+		ca := readTestCertificate(t, dir, AdapterTLSCAFile)
+
+		provisionTestAdapterCerts(t, dir, hosts, true)
+		for _, file := range []string{AdapterTLSCAFile, adapterTLSServerCertFile, AdapterTLSCertFile} {
+			if cert := readTestCertificate(t, dir, file); time.Until(cert.NotAfter) < adapterCertRenewBefore {
+				t.Errorf("%s expiring at %s was not renewed", file, cert.NotAfter)
+			}
+		}
+		if readTestCertificate(t, dir, AdapterTLSCAFile).Equal(ca) {
+			t.Error("expiring CA was not issued again")
+		}
+	})
+
+	t.Run("certificates mounted without the key of their CA", func(t *testing.T) {
+		mounted := t.TempDir()
+		for _, file := range []string{AdapterTLSCAFile, AdapterTLSCertFile, AdapterTLSKeyFile} {
+			if err := os.WriteFile(filepath.Join(mounted, file), []byte("issued by cert-manager"), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+		provisionTestAdapterCerts(t, mounted, hosts, false)
+	})
+}
+
+func TestAdapterCertsPublishToSecret(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	dir := t.TempDir()
+	certs := NewAdapterCerts(dir, []string{"localhost"})
+	certs.PublishToSecret(client, "meshery", "meshery-adapter-tls")
+
+	published := func() []byte {
+		secret, err := client.CoreV1().Secrets("meshery").Get(ctx, "meshery-adapter-tls", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, key := range []string{AdapterTLSCAFile, AdapterTLSCertFile, AdapterTLSKeyFile} {
+			if len(secret.Data[key]) == 0 {
+				t.Errorf("Secret is missing %s", key)
+			}
+		}
+		return secret.Data[AdapterTLSCertFile]
+	}
+
+	if err := certs.Provision(ctx); err != nil {
+		t.Fatal(err)
+	}
+	before := published()
+
+	// An adapter registered at runtime gets a serving certificate valid for its host
+	if err := certs.AddHosts(ctx, "istio-adapter.example.com"); err != nil {
+		t.Fatal(err)
+	}
+	after := published()
+	if string(after) == string(before) {
+		t.Fatal("serving certificate issued for the new host was not published")
+	}
+	block, _ := pem.Decode(after)
+	server, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !validForHosts(server, []string{"localhost", "istio-adapter.example.com"}) {
+		t.Errorf("published serving certificate is valid for %v", server.DNSNames)
+	}
+}
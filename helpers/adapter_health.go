@@ -0,0 +1,193 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// maxAdapterHealthHistory is the number of health checks retained per adapter
+const maxAdapterHealthHistory = 120
+
+// AdapterHealthTracker periodically pings the known adapters and records their availability
+type AdapterHealthTracker struct {
+	adapterTracker models.AdaptersTrackerInterface
+	interval       time.Duration
+	timeout        time.Duration
+
+	health     map[string]*models.AdapterHealth
+	healthLock *sync.Mutex
+
+	subscribers     map[chan *meshes.EventsResponse]struct{}
+	subscribersLock *sync.Mutex
+}
+
+// NewAdapterHealthTracker returns an instance of AdapterHealthTracker which checks
+// the adapters known to the given adapter tracker once every interval
+func NewAdapterHealthTracker(adapterTracker models.AdaptersTrackerInterface, interval time.Duration) *AdapterHealthTracker {
+	timeout := 5 * time.Second
+	if interval < timeout {
+		timeout = interval
+	}
+	return &AdapterHealthTracker{
+		adapterTracker:  adapterTracker,
+		interval:        interval,
+		timeout:         timeout,
+		health:          map[string]*models.AdapterHealth{},
+		healthLock:      &sync.Mutex{},
+		subscribers:     map[chan *meshes.EventsResponse]struct{}{},
+		subscribersLock: &sync.Mutex{},
+	}
+}
+
+// Run checks the adapters on every tick until the context is cancelled
+func (a *AdapterHealthTracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		a.checkAdapters(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetAdapterHealth returns the health of all the tracked adapters
+func (a *AdapterHealthTracker) GetAdapterHealth(ctx context.Context) []models.AdapterHealth {
+	a.healthLock.Lock()
+	defer a.healthLock.Unlock()
+
+	health := make([]models.AdapterHealth, 0, len(a.health))
+	for _, h := range a.health {
+		c := *h
+		c.History = append([]models.AdapterHealthCheck{}, h.History...)
+		health = append(health, c)
+	}
+	return health
+}
+
+// Subscribe returns a channel on which an event is published whenever an adapter
+// changes state, along with a function to cancel the subscription
+func (a *AdapterHealthTracker) Subscribe() (<-chan *meshes.EventsResponse, func()) {
+	ch := make(chan *meshes.EventsResponse, 10)
+
+	a.subscribersLock.Lock()
+	a.subscribers[ch] = struct{}{}
+	a.subscribersLock.Unlock()
+
+	return ch, func() {
+		a.subscribersLock.Lock()
+		defer a.subscribersLock.Unlock()
+		if _, ok := a.subscribers[ch]; ok {
+			delete(a.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (a *AdapterHealthTracker) checkAdapters(ctx context.Context) {
+	adapters := a.adapterTracker.GetAdapters(ctx)
+
+	known := map[string]struct{}{}
+	for _, adapter := range adapters {
+		known[adapter.Location] = struct{}{}
+
+		pingCtx, cancel := context.WithTimeout(ctx, a.timeout)
+		info, err := meshes.PingAdapter(pingCtx, adapter.Location)
+		cancel()
+		if err != nil {
+			logrus.Debugf("adapter %s is unreachable: %v", adapter.Location, err)
+		}
+		a.record(adapter, info, err == nil)
+	}
+
+	// Forget about the adapters which are not tracked anymore
+	a.healthLock.Lock()
+	for location := range a.health {
+		if _, ok := known[location]; !ok {
+			delete(a.health, location)
+		}
+	}
+	a.healthLock.Unlock()
+}
+
+func (a *AdapterHealthTracker) record(adapter models.Adapter, info *meshes.ComponentInfoResponse, available bool) {
+	now := time.Now()
+
+	a.healthLock.Lock()
+	h, ok := a.health[adapter.Location]
+	if !ok {
+		h = &models.AdapterHealth{
+			Location:  adapter.Location,
+			Available: available,
+			Since:     now,
+		}
+		a.health[adapter.Location] = h
+	}
+	h.Name = adapter.Name
+	h.Version = adapter.Version
+	if info != nil {
+		h.Name = info.Name
+		h.Version = info.Version
+	}
+
+	changed := ok && h.Available != available
+	if changed {
+		h.Since = now
+	}
+	h.Available = available
+	h.LastChecked = now
+	h.History = append(h.History, models.AdapterHealthCheck{Time: now, Available: available})
+	if len(h.History) > maxAdapterHealthHistory {
+		h.History = h.History[len(h.History)-maxAdapterHealthHistory:]
+	}
+
+	up := 0
+	for _, check := range h.History {
+		if check.Available {
+			up++
+		}
+	}
+	h.Uptime = float64(up) * 100 / float64(len(h.History))
+	a.healthLock.Unlock()
+
+	if changed {
+		a.publish(adapterStateEvent(adapter.Location, available))
+	}
+}
+
+func (a *AdapterHealthTracker) publish(event *meshes.EventsResponse) {
+	a.subscribersLock.Lock()
+	defer a.subscribersLock.Unlock()
+
+	for ch := range a.subscribers {
+		select {
+		case ch <- event:
+		default:
+			logrus.Debug("dropping adapter health event for a slow subscriber")
+		}
+	}
+}
+
+func adapterStateEvent(location string, available bool) *meshes.EventsResponse {
+	if available {
+		return &meshes.EventsResponse{
+			EventType: meshes.EventType_INFO,
+			Summary:   fmt.Sprintf("Adapter %s is available", location),
+			Details:   fmt.Sprintf("Meshery is able to reach the adapter at %s again", location),
+		}
+	}
+	return &meshes.EventsResponse{
+		EventType: meshes.EventType_ERROR,
+		Summary:   fmt.Sprintf("Adapter %s is unavailable", location),
+		Details:   fmt.Sprintf("Meshery is unable to reach the adapter at %s, operations through it will fail until it is back", location),
+	}
+}
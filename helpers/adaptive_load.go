@@ -0,0 +1,162 @@
+package helpers
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"fortio.org/fortio/periodic"
+	"github.com/layer5io/meshery/models"
+)
+
+const (
+	// adaptiveMeasuringPeriod is the length of a step of an adaptive test, shortened for tests
+	// too short to fit adaptiveMinSteps of it
+	adaptiveMeasuringPeriod = 10 * time.Second
+	adaptiveMinSteps        = 3
+	// adaptiveInitialRPS is the rate an adaptive test without a rate starts at
+	adaptiveInitialRPS = 10
+	// adaptiveConvergence is how close the rates within and over the latency budget get before
+	// the search stops
+	adaptiveConvergence = 0.05
+	// adaptiveMinSendRate is the share of the requested rate a step has to achieve, a target unable
+	// to take the requested rate being over its budget whatever its latency
+	adaptiveMinSendRate = 0.9
+)
+
+// AdaptiveLoadStep is a step of an adaptive test, run at a fixed rate for the measuring period
+type AdaptiveLoadStep struct {
+	RPS          float64 `json:"rps"`
+	ActualQPS    float64 `json:"actual_qps"`
+	P99          float64 `json:"p99_ms"`
+	WithinBudget bool    `json:"within_budget"`
+}
+
+// NighthawkAdaptiveLoadTest runs a Nighthawk test searching for the maximum sustainable rate of the
+// target under the p99 latency budget of the options, in the manner of the adaptive load controller of
+// Nighthawk. The rate doubles while within budget, then is bisected between the highest rate within and
+// the lowest rate over the budget. The result of the step at the highest rate within budget is returned,
+// along with the steps run.
+func NighthawkAdaptiveLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+	return adaptiveLoadTest(opts, NighthawkLoadTest)
+}
+
+func adaptiveLoadTest(opts *models.LoadTestOptions, run func(*models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error)) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if opts.TargetP99 <= 0 {
+		return nil, nil, ErrRunningTest(fmt.Errorf("adaptive test without a p99 latency target"))
+	}
+	period := adaptiveMeasuringPeriod
+	if opts.Duration < adaptiveMinSteps*period {
+		period = opts.Duration / adaptiveMinSteps
+	}
+	if period < time.Second {
+		period = time.Second
+	}
+	deadline := time.Now().Add(opts.Duration)
+
+	rps := opts.HTTPQPS
+	if rps <= 0 {
+		rps = adaptiveInitialRPS
+	}
+	var (
+		within, over float64
+		converged    bool
+		limited      bool
+		steps        []AdaptiveLoadStep
+		bestMap      map[string]interface{}
+		bestResult   *periodic.RunnerResults
+		lastMap      map[string]interface{}
+		lastResult   *periodic.RunnerResults
+	)
+	for len(steps) == 0 || time.Until(deadline) >= period {
+		if opts.AdaptiveMaxQPS > 0 && rps > opts.AdaptiveMaxQPS {
+			rps = opts.AdaptiveMaxQPS
+		}
+		rps = math.Max(1, math.Round(rps))
+
+		step := *opts
+		step.HTTPQPS = rps
+		step.Duration = period
+		resultsMap, result, err := run(&step)
+		if err != nil {
+			return nil, nil, err
+		}
+		p99, err := runnerPercentile(result, 99)
+		if err != nil {
+			return nil, nil, ErrRunningTest(err)
+		}
+		ok := p99 <= opts.TargetP99.Seconds() && result.ActualQPS >= adaptiveMinSendRate*rps
+		steps = append(steps, AdaptiveLoadStep{
+			RPS:          rps,
+			ActualQPS:    result.ActualQPS,
+			P99:          p99 * 1000,
+			WithinBudget: ok,
+		})
+		lastMap, lastResult = resultsMap, result
+		if ok {
+			within = rps
+			bestMap, bestResult = resultsMap, result
+		} else {
+			over = rps
+		}
+		// a cancelled search ends with the steps run until then
+		if loadTestContext(opts).Err() != nil {
+			break
+		}
+
+		if over == 0 {
+			// not over budget yet, unless capped by the limit of the server
+			if opts.AdaptiveMaxQPS > 0 && rps >= opts.AdaptiveMaxQPS {
+				limited = true
+				break
+			}
+			rps *= 2
+			continue
+		}
+		if within == 0 {
+			// over budget from the start
+			if rps <= 1 {
+				break
+			}
+			rps /= 2
+			continue
+		}
+		rps = (within + over) / 2
+		if (over-within)/within <= adaptiveConvergence || math.Round(rps) == within || math.Round(rps) == over {
+			converged = true
+			break
+		}
+	}
+
+	if bestMap == nil {
+		// no rate kept the latency within budget, the lowest rate tried stands for the run
+		bestMap, bestResult = lastMap, lastResult
+	}
+	bestMap["adaptive-load"] = map[string]interface{}{
+		"target_p99": opts.TargetP99.String(),
+		"max_rps":    within,
+		"converged":  converged,
+		"limited":    limited,
+		"steps":      steps,
+	}
+	return bestMap, bestResult, nil
+}
+
+// runnerPercentile returns the latency, in seconds, at the percentile of the results, estimated from
+// the histogram when the percentile was not computed by the load generator
+func runnerPercentile(result *periodic.RunnerResults, percentile float64) (float64, error) {
+	if result == nil || result.DurationHistogram == nil || result.DurationHistogram.Count == 0 {
+		return 0, fmt.Errorf("no latencies were recorded")
+	}
+	for _, p := range result.DurationHistogram.Percentiles {
+		if p.Percentile == percentile {
+			return p.Value, nil
+		}
+	}
+	for _, bucket := range result.DurationHistogram.Data {
+		if bucket.Percent >= percentile {
+			return bucket.End, nil
+		}
+	}
+	return result.DurationHistogram.Max, nil
+}
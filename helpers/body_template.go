@@ -0,0 +1,107 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"text/template"
+
+	"github.com/gofrs/uuid"
+)
+
+// BodyTemplate renders a distinct request body for every request of a load test,
+// so that endpoints rejecting duplicate payloads can be tested. Templates use the
+// text/template syntax with:
+//
+//	{{.Seq}}          sequence number of the request, starting at 1
+//	{{.Row.<column>}} column of the CSV data row of the request, rows being used in turn
+//	{{uuid}}          random UUID, different for every occurrence
+type BodyTemplate struct {
+	template *template.Template
+	rows     []map[string]string
+	seq      uint64
+}
+
+// bodyTemplateData is the data a body template is rendered with
+type bodyTemplateData struct {
+	Seq uint64
+	Row map[string]string
+}
+
+// NewBodyTemplate parses the body template. The optional CSV data must start with
+// a header row naming the columns.
+func NewBodyTemplate(body string, csvData []byte) (*BodyTemplate, error) {
+	tmpl, err := template.New("body").Option("missingkey=error").Funcs(template.FuncMap{
+		"uuid": func() (string, error) {
+			id, err := uuid.NewV4()
+			if err != nil {
+				return "", err
+			}
+			return id.String(), nil
+		},
+	}).Parse(body)
+	if err != nil {
+		return nil, ErrBodyTemplate(err)
+	}
+
+	bt := &BodyTemplate{template: tmpl}
+	if len(bytes.TrimSpace(csvData)) > 0 {
+		if bt.rows, err = parseTemplateData(csvData); err != nil {
+			return nil, ErrBodyTemplate(err)
+		}
+	}
+
+	// Render once so that errors surface before the test starts
+	if _, err := bt.render(bodyTemplateData{Seq: 0, Row: bt.row(0)}); err != nil {
+		return nil, err
+	}
+	return bt, nil
+}
+
+// Render returns the body of the next request
+func (bt *BodyTemplate) Render() ([]byte, error) {
+	seq := atomic.AddUint64(&bt.seq, 1)
+	return bt.render(bodyTemplateData{Seq: seq, Row: bt.row(seq - 1)})
+}
+
+func (bt *BodyTemplate) render(data bodyTemplateData) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := bt.template.Execute(&buf, data); err != nil {
+		return nil, ErrBodyTemplate(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (bt *BodyTemplate) row(i uint64) map[string]string {
+	if len(bt.rows) == 0 {
+		return map[string]string{}
+	}
+	return bt.rows[i%uint64(len(bt.rows))]
+}
+
+// parseTemplateData returns the rows of the CSV data keyed by column name
+func parseTemplateData(data []byte) ([]map[string]string, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("the data file needs a header row and at least one data row")
+	}
+
+	header := records[0]
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+	}
+	rows := make([]map[string]string, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := make(map[string]string, len(header))
+		for i, column := range header {
+			row[column] = record[i]
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
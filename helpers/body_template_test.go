@@ -0,0 +1,101 @@
+package helpers
+
+import (
+	"regexp"
+	"sync"
+	"testing"
+)
+
+func TestBodyTemplate(t *testing.T) {
+	csvData := []byte("sku, quantity\nA-1,2\nB-7,5\n")
+	bt, err := NewBodyTemplate(`{"order": {{.Seq}}, "sku": "{{.Row.sku}}", "quantity": {{.Row.quantity}}}`, csvData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`{"order": 1, "sku": "A-1", "quantity": 2}`,
+		`{"order": 2, "sku": "B-7", "quantity": 5}`,
+		// the rows are used in turn
+		`{"order": 3, "sku": "A-1", "quantity": 2}`,
+	}
+	for _, w := range want {
+		body, err := bt.Render()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != w {
+			t.Errorf("got body %s, want %s", body, w)
+		}
+	}
+}
+
+func TestBodyTemplateUUID(t *testing.T) {
+	bt, err := NewBodyTemplate(`{{uuid}} {{uuid}}`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := bt.Render()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := regexp.MustCompile(`^([0-9a-f-]{36}) ([0-9a-f-]{36})$`).FindStringSubmatch(string(body))
+	if ids == nil || ids[1] == ids[2] {
+		t.Errorf("got body %q, want two distinct UUIDs", body)
+	}
+}
+
+func TestBodyTemplateSequenceIsUnique(t *testing.T) {
+	bt, err := NewBodyTemplate(`{{.Seq}}`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the requests of a test are rendered by several goroutines at once
+	var mu sync.Mutex
+	seen := map[string]bool{}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				body, err := bt.Render()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				mu.Lock()
+				if seen[string(body)] {
+					t.Errorf("sequence number %s rendered twice", body)
+				}
+				seen[string(body)] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(seen) != 800 {
+		t.Errorf("got %d distinct bodies, want 800", len(seen))
+	}
+}
+
+func TestNewBodyTemplateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		csv  string
+	}{
+		{name: "invalid template", body: `{{.Seq`},
+		{name: "unknown function", body: `{{now}}`},
+		{name: "missing column", body: `{{.Row.price}}`, csv: "sku\nA-1\n"},
+		{name: "column without data", body: `{{.Row.sku}}`},
+		{name: "header row only", body: `{{.Row.sku}}`, csv: "sku\n"},
+		{name: "rows of different lengths", body: `{{.Row.sku}}`, csv: "sku,quantity\nA-1\n"},
+	}
+	for _, tt := range tests {
+		if _, err := NewBodyTemplate(tt.body, []byte(tt.csv)); err == nil {
+			t.Errorf("%s: body template was parsed", tt.name)
+		}
+	}
+}
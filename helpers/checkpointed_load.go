@@ -0,0 +1,237 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"fortio.org/fortio/periodic"
+	"fortio.org/fortio/stats"
+	"github.com/layer5io/meshery/models"
+)
+
+// checkpointedHistograms are the histograms of the results of the load generators merged across
+// the segments of a checkpointed test
+var checkpointedHistograms = []string{"DurationHistogram", "ErrorsDurationHistogram", "Sizes", "HeaderSizes"}
+
+// CheckpointedLoadTest runs the test as consecutive segments of the checkpoint interval of the
+// options, handing the aggregates of the test up to the end of every segment to checkpoint. The
+// results of the segments are merged into the result of the test, along with the checkpoints. A
+// segment failing once others completed ends the test with the result of those, marked as
+// interrupted, rather than losing the hours a soak test ran.
+func CheckpointedLoadTest(opts *models.LoadTestOptions, run func(*models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error), checkpoint func(*models.LoadTestCheckpoint)) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if opts.CheckpointInterval <= 0 {
+		return run(opts)
+	}
+
+	var (
+		maps        []map[string]interface{}
+		results     []*periodic.RunnerResults
+		checkpoints []*models.LoadTestCheckpoint
+		interrupted error
+	)
+	for remaining := opts.Duration; remaining > 0; {
+		segment := *opts
+		segment.CheckpointInterval = 0
+		segment.Duration = opts.CheckpointInterval
+		if remaining < segment.Duration {
+			segment.Duration = remaining
+		}
+		remaining -= segment.Duration
+
+		resultsMap, result, err := run(&segment)
+		if err != nil {
+			if len(results) == 0 {
+				return nil, nil, err
+			}
+			interrupted = err
+			break
+		}
+		maps = append(maps, resultsMap)
+		results = append(results, result)
+
+		cp := newLoadTestCheckpoint(len(results), MergeRunnerResults(results), result)
+		checkpoints = append(checkpoints, cp)
+		if checkpoint != nil {
+			checkpoint(cp)
+		}
+
+		// a cancelled test ends with the segments run until then
+		if err := loadTestContext(opts).Err(); err != nil {
+			interrupted = fmt.Errorf("test cancelled after %d segments", len(results))
+			break
+		}
+	}
+
+	merged := MergeRunnerResults(results)
+	resultsMap := mergeResultMaps(maps, merged)
+	resultsMap["RequestedDuration"] = opts.Duration.String()
+	summary := map[string]interface{}{
+		"interval":    opts.CheckpointInterval.String(),
+		"checkpoints": checkpoints,
+	}
+	if interrupted != nil {
+		summary["interrupted"] = interrupted.Error()
+	}
+	resultsMap["checkpoints"] = summary
+	return resultsMap, merged, nil
+}
+
+// MergeRunnerResults merges the results of consecutive runs of a test into the result of a single run
+// lasting as long as them together
+func MergeRunnerResults(results []*periodic.RunnerResults) *periodic.RunnerResults {
+	merged := *results[0]
+	histograms := make([]*stats.HistogramData, 0, len(results))
+	merged.ActualDuration = 0
+	for _, r := range results {
+		merged.ActualDuration += r.ActualDuration
+		histograms = append(histograms, r.DurationHistogram)
+	}
+	merged.DurationHistogram = MergeHistogramData(histograms)
+	if merged.ActualDuration > 0 {
+		merged.ActualQPS = float64(merged.DurationHistogram.Count) / merged.ActualDuration.Seconds()
+	}
+	return &merged
+}
+
+// MergeHistogramData merges exported histograms, the buckets of which share their bounds but for
+// the minimum and the maximum of each. The mean and standard deviation are exact, the percentiles
+// being computed again from the merged buckets.
+func MergeHistogramData(histograms []*stats.HistogramData) *stats.HistogramData {
+	merged := &stats.HistogramData{}
+	counts := map[float64]int64{}
+	percentiles := []float64{}
+	var sumOfSquares float64
+	for _, h := range histograms {
+		if h == nil || h.Count == 0 {
+			continue
+		}
+		if merged.Count == 0 || h.Min < merged.Min {
+			merged.Min = h.Min
+		}
+		if merged.Count == 0 || h.Max > merged.Max {
+			merged.Max = h.Max
+		}
+		merged.Count += h.Count
+		merged.Sum += h.Sum
+		sumOfSquares += float64(h.Count) * (h.StdDev*h.StdDev + h.Avg*h.Avg)
+		for _, b := range h.Data {
+			counts[b.End] += b.Count
+		}
+		if len(percentiles) == 0 {
+			for _, p := range h.Percentiles {
+				percentiles = append(percentiles, p.Percentile)
+			}
+		}
+	}
+	if merged.Count == 0 {
+		return merged
+	}
+	merged.Avg = merged.Sum / float64(merged.Count)
+	merged.StdDev = math.Sqrt(math.Max(0, sumOfSquares/float64(merged.Count)-merged.Avg*merged.Avg))
+
+	ends := make([]float64, 0, len(counts))
+	for end := range counts {
+		ends = append(ends, end)
+	}
+	sort.Float64s(ends)
+	var total int64
+	start := merged.Min
+	for _, end := range ends {
+		total += counts[end]
+		merged.Data = append(merged.Data, stats.Bucket{
+			Interval: stats.Interval{Start: start, End: end},
+			Percent:  100 * float64(total) / float64(merged.Count),
+			Count:    counts[end],
+		})
+		start = end
+	}
+	merged.Data[len(merged.Data)-1].End = merged.Max
+	return merged.CalcPercentiles(percentiles)
+}
+
+// mergeResultMaps merges the results of the load generators for the segments of a test, the first
+// of which stands for the test but for its duration, rate, histograms and return codes
+func mergeResultMaps(maps []map[string]interface{}, merged *periodic.RunnerResults) map[string]interface{} {
+	resultsMap := make(map[string]interface{}, len(maps[0]))
+	for k, v := range maps[0] {
+		resultsMap[k] = v
+	}
+	resultsMap["ActualDuration"] = merged.ActualDuration
+	resultsMap["ActualQPS"] = merged.ActualQPS
+
+	for _, key := range checkpointedHistograms {
+		histograms := []*stats.HistogramData{}
+		for _, m := range maps {
+			if h := histogramFromMap(m[key]); h != nil {
+				histograms = append(histograms, h)
+			}
+		}
+		if len(histograms) > 0 {
+			resultsMap[key] = MergeHistogramData(histograms)
+		}
+	}
+
+	retCodes := map[string]float64{}
+	for _, m := range maps {
+		codes, _ := m["RetCodes"].(map[string]interface{})
+		for code, count := range codes {
+			if n, ok := count.(float64); ok {
+				retCodes[code] += n
+			}
+		}
+	}
+	if len(retCodes) > 0 {
+		resultsMap["RetCodes"] = retCodes
+	}
+
+	assertions := []*models.LoadTestAssertionsResult{}
+	for _, m := range maps {
+		if a := assertionsResultFromMap(m["assertions"]); a != nil {
+			assertions = append(assertions, a)
+		}
+	}
+	if len(assertions) > 0 {
+		resultsMap["assertions"] = mergeAssertionsResults(assertions)
+	}
+	return resultsMap
+}
+
+// histogramFromMap returns the histogram of the result of a load generator, nil if there is none
+func histogramFromMap(v interface{}) *stats.HistogramData {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	h := &stats.HistogramData{}
+	if err := json.Unmarshal(data, h); err != nil || h.Count == 0 {
+		return nil
+	}
+	return h
+}
+
+// newLoadTestCheckpoint returns the checkpoint of the test at the end of a segment, given the results
+// of the test so far and of the segment alone
+func newLoadTestCheckpoint(seq int, test, segment *periodic.RunnerResults) *models.LoadTestCheckpoint {
+	point := NewResultPoint(test)
+	cp := &models.LoadTestCheckpoint{
+		Seq:        seq,
+		StartTime:  point.StartTime,
+		Duration:   point.Duration,
+		Requests:   point.Requests,
+		QPS:        point.QPS,
+		Min:        point.Min,
+		Max:        point.Max,
+		Average:    point.Average,
+		SegmentQPS: segment.ActualQPS,
+	}
+	cp.P50, _ = runnerPercentile(test, 50)
+	cp.P90, _ = runnerPercentile(test, 90)
+	cp.P99, _ = runnerPercentile(test, 99)
+	cp.SegmentP99, _ = runnerPercentile(segment, 99)
+	return cp
+}
@@ -0,0 +1,134 @@
+package helpers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshkit/errors"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/sirupsen/logrus"
+)
+
+// DebugLogRecorder keeps the latest entries logged by Meshery Server in a ring so that clients,
+// such as mesheryctl -vvv, can pull the server-side debug of their requests. It records the
+// entries logged through logrus as a hook, and those logged through the meshkit logger it wraps,
+// at the levels the log level of the server enables.
+type DebugLogRecorder struct {
+	size int
+
+	entries []*models.DebugLogEntry
+	seq     uint64
+	lock    *sync.Mutex
+}
+
+// NewDebugLogRecorder returns an instance of DebugLogRecorder keeping the given number of entries,
+// none if it is not positive
+func NewDebugLogRecorder(size int) *DebugLogRecorder {
+	if size < 0 {
+		size = 0
+	}
+	return &DebugLogRecorder{
+		size:    size,
+		entries: make([]*models.DebugLogEntry, 0, size),
+		lock:    &sync.Mutex{},
+	}
+}
+
+// After returns the entries logged after the cursor, or at or after the given time when the
+// cursor is zero
+func (r *DebugLogRecorder) After(cursor uint64, since time.Time) *models.DebugLog {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	log := &models.DebugLog{Entries: []*models.DebugLogEntry{}, Next: r.seq}
+	for _, entry := range r.entries {
+		if cursor != 0 && entry.Seq <= cursor {
+			continue
+		}
+		if cursor == 0 && entry.Time.Before(since) {
+			continue
+		}
+		log.Entries = append(log.Entries, entry)
+	}
+	return log
+}
+
+func (r *DebugLogRecorder) record(level logrus.Level, message string, fields map[string]string) {
+	if r.size == 0 || !logrus.IsLevelEnabled(level) {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.seq++
+	entry := &models.DebugLogEntry{
+		Seq:     r.seq,
+		Time:    time.Now(),
+		Level:   level.String(),
+		Message: message,
+		Fields:  fields,
+	}
+	if len(r.entries) == r.size {
+		copy(r.entries, r.entries[1:])
+		r.entries = r.entries[:r.size-1]
+	}
+	r.entries = append(r.entries, entry)
+}
+
+// Levels returns every level, logrus firing the hook only for the levels its logger is enabled at
+func (r *DebugLogRecorder) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire records the logrus entry
+func (r *DebugLogRecorder) Fire(entry *logrus.Entry) error {
+	var fields map[string]string
+	if len(entry.Data) > 0 {
+		fields = make(map[string]string, len(entry.Data))
+		for k, v := range entry.Data {
+			fields[k] = fmt.Sprint(v)
+		}
+	}
+	r.record(entry.Level, entry.Message, fields)
+	return nil
+}
+
+// WrapLogger returns a meshkit logger recording the entries before handing them to the given one
+func (r *DebugLogRecorder) WrapLogger(next logger.Handler) logger.Handler {
+	return &debugLogger{Handler: next, recorder: r}
+}
+
+// debugLogger records the entries of the meshkit logger it embeds
+type debugLogger struct {
+	logger.Handler
+	recorder *DebugLogRecorder
+}
+
+func (l *debugLogger) Info(description ...interface{}) {
+	l.recorder.record(logrus.InfoLevel, fmt.Sprint(description...), nil)
+	l.Handler.Info(description...)
+}
+
+func (l *debugLogger) Debug(description ...interface{}) {
+	l.recorder.record(logrus.DebugLevel, fmt.Sprint(description...), nil)
+	l.Handler.Debug(description...)
+}
+
+func (l *debugLogger) Warn(err error) {
+	l.recorder.record(logrus.WarnLevel, err.Error(), errorFields(err))
+	l.Handler.Warn(err)
+}
+
+func (l *debugLogger) Error(err error) {
+	l.recorder.record(logrus.ErrorLevel, err.Error(), errorFields(err))
+	l.Handler.Error(err)
+}
+
+func errorFields(err error) map[string]string {
+	if e, ok := err.(*errors.Error); ok && e.Code != "" {
+		return map[string]string{"code": e.Code}
+	}
+	return nil
+}
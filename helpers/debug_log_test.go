@@ -0,0 +1,52 @@
+package helpers
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshkit/logger"
+	"github.com/sirupsen/logrus"
+)
+
+func TestDebugLogRecorder(t *testing.T) {
+	level := logrus.GetLevel()
+	t.Cleanup(func() { logrus.SetLevel(level) })
+
+	log, err := logger.New("test", logger.Options{Output: io.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder := NewDebugLogRecorder(2)
+	wrapped := recorder.WrapLogger(log)
+	messages := func() []string {
+		entries := recorder.After(0, time.Time{}).Entries
+		got := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			got = append(got, entry.Level+": "+entry.Message)
+		}
+		return got
+	}
+
+	logrus.SetLevel(logrus.InfoLevel)
+	wrapped.Debug("resolving the components")
+	wrapped.Info("deploying bookinfo")
+	if got := messages(); len(got) != 1 || got[0] != "info: deploying bookinfo" {
+		t.Errorf("recorded %v at the info level, want the info entry only", got)
+	}
+
+	logrus.SetLevel(logrus.DebugLevel)
+	wrapped.Debug("resolving the components")
+	wrapped.Error(ErrRunningTest(errors.New("connection refused")))
+	if got := messages(); len(got) != 2 || got[0] != "debug: resolving the components" || !strings.HasPrefix(got[1], "error: ") {
+		t.Errorf("recorded %v, want the last 2 entries", got)
+	}
+
+	none := NewDebugLogRecorder(0)
+	none.WrapLogger(log).Info("deploying bookinfo")
+	if entries := none.After(0, time.Time{}).Entries; len(entries) != 0 {
+		t.Errorf("recorder keeping no entries recorded %d", len(entries))
+	}
+}
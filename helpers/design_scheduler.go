@@ -0,0 +1,154 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// DesignScheduler deploys and undeploys the designs of the design schedules once they are due,
+// publishing an event with the outcome of every run
+type DesignScheduler struct {
+	persister *models.DesignSchedulePersister
+	run       func(ctx context.Context, schedule *models.DesignSchedule) (string, error)
+	interval  time.Duration
+	jobs      *JobTracker
+
+	subscribers     map[chan *meshes.EventsResponse]struct{}
+	subscribersLock *sync.Mutex
+}
+
+// NewDesignScheduler returns an instance of DesignScheduler checking every interval for the schedules
+// which are due and running their operation with the given function, every run being tracked as a job
+func NewDesignScheduler(persister *models.DesignSchedulePersister, run func(ctx context.Context, schedule *models.DesignSchedule) (string, error), interval time.Duration, jobs *JobTracker) *DesignScheduler {
+	return &DesignScheduler{
+		persister:       persister,
+		run:             run,
+		interval:        interval,
+		jobs:            jobs,
+		subscribers:     map[chan *meshes.EventsResponse]struct{}{},
+		subscribersLock: &sync.Mutex{},
+	}
+}
+
+// Run runs the schedules which are due on every tick until the context is cancelled
+func (s *DesignScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runDueSchedules(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *DesignScheduler) runDueSchedules(ctx context.Context, now time.Time) {
+	schedules, err := s.persister.GetDueSchedules(now)
+	if err != nil {
+		logrus.Error(ErrRunDesignSchedule(err))
+		return
+	}
+	for i := range schedules {
+		s.runSchedule(ctx, &schedules[i], now)
+	}
+}
+
+// runSchedule runs the operation of the schedule, records its outcome along with the next run,
+// none for a schedule running once, and publishes it
+func (s *DesignScheduler) runSchedule(ctx context.Context, schedule *models.DesignSchedule, now time.Time) {
+	job := s.jobs.Start(ctx, models.JobDesignSchedule, fmt.Sprintf("Scheduled %s of design %s", schedule.Operation, schedule.PatternName), schedule.UserID, true)
+	msg, err := s.run(job.Context(), schedule)
+	job.Message(msg)
+	job.Finish(err)
+
+	schedule.LastRunAt = &now
+	schedule.LastStatus = models.DesignScheduleSucceeded
+	schedule.LastMessage = msg
+	event := &meshes.EventsResponse{
+		EventType: meshes.EventType_INFO,
+		Summary:   fmt.Sprintf("Scheduled %s of design %s succeeded", schedule.Operation, schedule.PatternName),
+		Details:   msg,
+	}
+	if err != nil {
+		schedule.LastStatus = models.DesignScheduleFailed
+		schedule.LastMessage = err.Error()
+		event.EventType = meshes.EventType_ERROR
+		event.Summary = fmt.Sprintf("Scheduled %s of design %s failed", schedule.Operation, schedule.PatternName)
+		event.Details = err.Error()
+	}
+
+	schedule.NextRunAt = nil
+	if schedule.Cron != "" {
+		next, err := NextDesignScheduleRun(schedule, now)
+		if err != nil {
+			logrus.Error(ErrRunDesignSchedule(err))
+		}
+		schedule.NextRunAt = next
+	}
+	if err := s.persister.RecordRun(schedule); err != nil {
+		logrus.Error(ErrRunDesignSchedule(err))
+	}
+	s.publish(event)
+}
+
+// NextDesignScheduleRun returns when the schedule runs next after the given time: at its time for a
+// schedule running once, unless the time is past, and at the next time its cron expression matches
+// in UTC for a recurring schedule
+func NextDesignScheduleRun(schedule *models.DesignSchedule, after time.Time) (*time.Time, error) {
+	if schedule.Cron == "" {
+		if schedule.RunAt == nil || !schedule.RunAt.After(after) {
+			return nil, fmt.Errorf("time to run at must be in the future")
+		}
+		return schedule.RunAt, nil
+	}
+	cron, err := ParseCron(schedule.Cron)
+	if err != nil {
+		return nil, err
+	}
+	next := cron.Next(after.UTC())
+	if next.IsZero() {
+		return nil, fmt.Errorf("cron expression %q never matches", schedule.Cron)
+	}
+	return &next, nil
+}
+
+// Subscribe returns a channel on which an event is published with the outcome of every run
+// of a schedule, along with a function to cancel the subscription
+func (s *DesignScheduler) Subscribe() (<-chan *meshes.EventsResponse, func()) {
+	ch := make(chan *meshes.EventsResponse, 10)
+
+	s.subscribersLock.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.subscribersLock.Unlock()
+
+	return ch, func() {
+		s.subscribersLock.Lock()
+		defer s.subscribersLock.Unlock()
+		if _, ok := s.subscribers[ch]; ok {
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (s *DesignScheduler) publish(event *meshes.EventsResponse) {
+	s.subscribersLock.Lock()
+	defer s.subscribersLock.Unlock()
+
+	for ch := range s.subscribers {
+		select {
+		case ch <- event:
+		default:
+			logrus.Debug("dropping design schedule event for a slow subscriber")
+		}
+	}
+}
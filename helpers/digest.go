@@ -0,0 +1,190 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// SMTPMailer sends emails through an SMTP server, upgrading the connection with STARTTLS
+// when the server supports it
+type SMTPMailer struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// Send emails the plain text body to the given address
+func (m *SMTPMailer) Send(to, subject, body string) error {
+	var auth smtp.Auth
+	if m.Username != "" {
+		auth = smtp.PlainAuth("", m.Username, m.Password, m.Host)
+	}
+	msg := strings.Join([]string{
+		"From: " + m.From,
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+	return smtp.SendMail(net.JoinHostPort(m.Host, strconv.Itoa(m.Port)), auth, m.From, []string{to}, []byte(msg))
+}
+
+// DigestScheduler emails their digest to the users subscribed to it once it is due
+type DigestScheduler struct {
+	subscriptions *models.DigestSubscriptionPersister
+	analyses      *models.ResultAnalysisPersister
+	events        *models.EventPersister
+	send          func(to, subject, body string) error
+	interval      time.Duration
+}
+
+// NewDigestScheduler returns an instance of DigestScheduler checking every interval for the digests
+// which are due and sending them with the given mailer
+func NewDigestScheduler(subscriptions *models.DigestSubscriptionPersister, analyses *models.ResultAnalysisPersister, events *models.EventPersister, mailer *SMTPMailer, interval time.Duration) *DigestScheduler {
+	return &DigestScheduler{
+		subscriptions: subscriptions,
+		analyses:      analyses,
+		events:        events,
+		send:          mailer.Send,
+		interval:      interval,
+	}
+}
+
+// Run sends the digests which are due on every tick until the context is cancelled
+func (s *DigestScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sendDueDigests(time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *DigestScheduler) sendDueDigests(now time.Time) {
+	subscriptions, err := s.subscriptions.GetActiveSubscriptions()
+	if err != nil {
+		logrus.Error(ErrSendDigest(err))
+		return
+	}
+	for i := range subscriptions {
+		if !subscriptions[i].Due(now) {
+			continue
+		}
+		if _, err := s.SendDigest(&subscriptions[i], now); err != nil {
+			logrus.Error(err)
+		}
+	}
+}
+
+// SendDigest emails the digest of the period of the subscription ending at the given time, the period
+// starting with the last digest when it is more recent. A digest of a period where nothing happened
+// is not emailed, though the period is still recorded as covered.
+func (s *DigestScheduler) SendDigest(subscription *models.DigestSubscription, now time.Time) (*models.Digest, error) {
+	period := subscription.Frequency.Period()
+	if period == 0 {
+		period = models.DigestDaily.Period()
+	}
+	from := now.Add(-period)
+	if subscription.LastSentAt != nil && subscription.LastSentAt.After(from) {
+		from = *subscription.LastSentAt
+	}
+
+	digest, err := s.BuildDigest(subscription.UserID, from, now)
+	if err != nil {
+		return nil, ErrSendDigest(err)
+	}
+	if !digest.Empty() {
+		subject, body, err := RenderDigest(digest)
+		if err != nil {
+			return nil, ErrSendDigest(err)
+		}
+		if err := s.send(subscription.Email, subject, body); err != nil {
+			return nil, ErrSendDigest(err)
+		}
+	}
+	if err := s.subscriptions.MarkSent(subscription.UserID, now); err != nil {
+		return nil, ErrSendDigest(err)
+	}
+	return digest, nil
+}
+
+// BuildDigest gathers the performance results completed in the period, the anomalies among them and
+// the warnings and errors of the period the user did not acknowledge
+func (s *DigestScheduler) BuildDigest(userID string, from, to time.Time) (*models.Digest, error) {
+	digest := &models.Digest{From: from, To: to, Results: []models.ResultAnalysis{}, Anomalies: []models.ResultAnalysis{}}
+
+	analyses, err := s.analyses.GetAnalysesBetween(from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, analysis := range analyses {
+		digest.Results = append(digest.Results, analysis)
+		if analysis.Anomaly {
+			digest.Anomalies = append(digest.Anomalies, analysis)
+		}
+	}
+
+	unacknowledged := false
+	digest.Events, err = s.events.GetEvents(userID, models.EventFilter{
+		Severities:   []models.EventSeverity{models.EventSeverityWarning, models.EventSeverityError},
+		Acknowledged: &unacknowledged,
+		Since:        &from,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return digest, nil
+}
+
+var digestTemplate = template.Must(template.New("digest").Funcs(template.FuncMap{
+	"ms":   func(seconds float64) string { return fmt.Sprintf("%.3fms", seconds*1000) },
+	"date": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04 MST") },
+}).Parse(`Meshery digest from {{ date .From }} to {{ date .To }}
+
+Performance results: {{ len .Results }}
+{{- range .Results }}
+  - {{ .Name }}: p99 {{ ms .P99 }}{{ if .Anomaly }} (anomaly){{ end }}
+{{- end }}
+
+Anomalies: {{ len .Anomalies }}
+{{- range .Anomalies }}
+  - {{ .Name }}: p99 {{ ms .P99 }} is {{ printf "%.1f" .Deviations }} standard deviations away from the mean of {{ ms .Mean }} of the last {{ .Samples }} results
+{{- end }}
+
+Unacknowledged warnings and errors: {{ len .Events }}
+{{- range .Events }}
+  - [{{ .Severity }}] {{ .Summary }}{{ if .Source }} ({{ .Source }}){{ end }}
+{{- end }}
+
+Acknowledge the events with: mesheryctl events ack --all
+Change how often you receive this digest with: mesheryctl config digest --frequency daily|weekly|off
+`))
+
+// RenderDigest returns the subject and the plain text body of the email of the digest
+func RenderDigest(digest *models.Digest) (string, string, error) {
+	var body bytes.Buffer
+	if err := digestTemplate.Execute(&body, digest); err != nil {
+		return "", "", err
+	}
+	subject := fmt.Sprintf("Meshery digest: %d results, %d anomalies, %d unacknowledged events", len(digest.Results), len(digest.Anomalies), len(digest.Events))
+	return subject, body.String(), nil
+}
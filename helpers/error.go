@@ -30,6 +30,29 @@ const (
 	ErrNewKubeClientGeneratorCode          = "2070"
 	ErrRestConfigFromKubeConfigCode        = "2071"
 	ErrNewKubeClientCode                   = "2072"
+	ErrWriteResultSinkCode                 = "2183"
+	ErrBodyTemplateCode                    = "2193"
+	ErrBodyTemplateUnsupportedCode         = "2194"
+	ErrArchiveResultCode                   = "2195"
+	ErrRestoreArchivedResultCode           = "2196"
+	ErrAnalyzeResultCode                   = "2212"
+	ErrRecordEventCode                     = "2221"
+	ErrSendDigestCode                      = "2224"
+	ErrRunDesignScheduleCode               = "2229"
+	ErrRequestMixUnsupportedCode           = "2233"
+	ErrUnixSocketUnsupportedCode           = "2235"
+	ErrPurgeTrashCode                      = "2239"
+	ErrReloadServerConfigCode              = "2247"
+	ErrAssertionsUnsupportedCode           = "2251"
+	ErrLoadTestMethodUnsupportedCode       = "2259"
+	ErrInvokeResultHookCode                = "2260"
+	ErrDesignDeniedCode                    = "2263"
+	ErrInvokeValidationWebhookCode         = "2264"
+	ErrGenerateTrendReportCode             = "2267"
+	ErrLoadWorkspacesCode                  = "2269"
+	ErrWorkspaceUsageCode                  = "2270"
+	ErrWorkspaceLoadTestLimitsCode         = "2280"
+	ErrDryRunManifestCode                  = "2283"
 )
 
 func ErrNewDynamicClientGenerator(err error) error {
@@ -135,3 +158,95 @@ func ErrStartingNighthawkServer(err error) error {
 func ErrNewKubeClient(err error) error {
 	return errors.New(ErrNewKubeClientCode, errors.Alert, []string{"Unable to create new kube client"}, []string{err.Error()}, []string{}, []string{})
 }
+
+func ErrWriteResultSink(err error, sink string) error {
+	return errors.New(ErrWriteResultSinkCode, errors.Alert, []string{"Unable to export the performance result to " + sink}, []string{err.Error()}, []string{"The result sink is not reachable from the Meshery server or rejected the write"}, []string{"Make sure the result sink is reachable and the configured database, organization and credentials are valid"})
+}
+
+func ErrBodyTemplate(err error) error {
+	return errors.New(ErrBodyTemplateCode, errors.Alert, []string{"Unable to render the request body template"}, []string{err.Error()}, []string{"The body template is not a valid template or refers to a column missing from the data file"}, []string{"Make sure the template uses {{.Seq}}, {{uuid}} and {{.Row.<column>}} with columns named in the header row of the data file"})
+}
+
+func ErrBodyTemplateUnsupported(loadGenerator string) error {
+	return errors.New(ErrBodyTemplateUnsupportedCode, errors.Alert, []string{"Request body templates are not supported by " + loadGenerator}, []string{}, []string{"Only fortio renders a request body per request"}, []string{"Run the test with the fortio load generator"})
+}
+
+func ErrUnixSocketUnsupported(target string) error {
+	return errors.New(ErrUnixSocketUnsupportedCode, errors.Alert, []string{"Unix domain socket targets are not supported by " + target}, []string{}, []string{"Only fortio sends plain HTTP, gRPC and WebSocket requests over Unix domain sockets"}, []string{"Run the test with the fortio load generator against an http:// or ws:// URL"})
+}
+
+func ErrRequestMixUnsupported(loadGenerator string) error {
+	return errors.New(ErrRequestMixUnsupportedCode, errors.Alert, []string{"Request mixes are not supported by " + loadGenerator}, []string{}, []string{"Only fortio HTTP tests send the weighted requests of a request mix"}, []string{"Run the test with the fortio load generator against an http(s) URL"})
+}
+
+func ErrAssertionsUnsupported(loadGenerator string) error {
+	return errors.New(ErrAssertionsUnsupportedCode, errors.Alert, []string{"Response assertions are not supported by " + loadGenerator}, []string{}, []string{"Only fortio HTTP tests validate the responses with assertions"}, []string{"Run the test with the fortio load generator against an http(s) URL"})
+}
+
+func ErrLoadTestMethodUnsupported(loadGenerator string) error {
+	return errors.New(ErrLoadTestMethodUnsupportedCode, errors.Alert, []string{"HTTP methods are not supported by " + loadGenerator}, []string{}, []string{"Only HTTP tests send their requests with a given method"}, []string{"Run the test against an http(s) URL, or without a method"})
+}
+
+func ErrArchiveResult(err error, archive string) error {
+	return errors.New(ErrArchiveResultCode, errors.Alert, []string{"Unable to archive the performance results to " + archive}, []string{err.Error()}, []string{"The result archive is not reachable from the Meshery server or rejected the upload"}, []string{"Make sure the bucket or container exists and the configured endpoint and credentials are valid"})
+}
+
+func ErrRestoreArchivedResult(err error, archive string) error {
+	return errors.New(ErrRestoreArchivedResultCode, errors.Alert, []string{"Unable to retrieve the performance result archived to " + archive}, []string{err.Error()}, []string{"The result archive is not configured, not reachable from the Meshery server or no longer holds the result"}, []string{"Make sure the Meshery server is configured with the archive the result was moved to and its credentials are valid"})
+}
+
+func ErrAnalyzeResult(err error) error {
+	return errors.New(ErrAnalyzeResultCode, errors.Alert, []string{"Unable to compare the performance result with the history of its profile"}, []string{err.Error()}, []string{"The history of the results of the profile could not be read from or written to the database"}, []string{"Make sure the Meshery database is writable"})
+}
+
+func ErrRecordEvent(err error) error {
+	return errors.New(ErrRecordEventCode, errors.Alert, []string{"Unable to record the event"}, []string{err.Error()}, []string{"The event could not be written to the database"}, []string{"Make sure the Meshery database is writable"})
+}
+
+func ErrSendDigest(err error) error {
+	return errors.New(ErrSendDigestCode, errors.Alert, []string{"Unable to send the digest"}, []string{err.Error()}, []string{"The SMTP server is not reachable or rejected the credentials or the addresses", "The content of the digest could not be read from the database"}, []string{"Check the SMTP_HOST, SMTP_PORT, SMTP_USERNAME, SMTP_PASSWORD and SMTP_FROM settings of Meshery Server"})
+}
+
+func ErrRunDesignSchedule(err error) error {
+	return errors.New(ErrRunDesignScheduleCode, errors.Alert, []string{"Unable to run the design schedules"}, []string{err.Error()}, []string{"The design schedules could not be read from or written to the database", "The cron expression of the schedule is invalid"}, []string{"Check the database of Meshery Server", "Delete the schedule and create it again with a valid cron expression"})
+}
+
+func ErrPurgeTrash(err error) error {
+	return errors.New(ErrPurgeTrashCode, errors.Alert, []string{"Unable to purge the expired designs and performance profiles from the trash"}, []string{err.Error()}, []string{"The trash could not be read from or written to the database"}, []string{"Check the database of Meshery Server"})
+}
+
+func ErrReloadServerConfig(err error) error {
+	return errors.New(ErrReloadServerConfigCode, errors.Alert, []string{"Unable to reload the configuration of Meshery Server"}, []string{err.Error()}, []string{"A setting of the configuration is invalid", "The configuration file of the server cannot be read"}, []string{"Check the settings given and those of the file SERVER_CONFIG_FILE points to; nothing is applied until all of them are valid"})
+}
+
+func ErrInvokeResultHook(err error, hook string) error {
+	return errors.New(ErrInvokeResultHookCode, errors.Alert, []string{"Unable to post-process the performance result with the hook " + hook}, []string{err.Error()}, []string{"The hook is not reachable from the Meshery server, failed or did not answer with annotations"}, []string{"Make sure the URL of the hook is reachable and it answers with a 2xx status and a JSON object holding the annotations"})
+}
+
+func ErrDesignDenied(reasons []string) error {
+	return errors.New(ErrDesignDeniedCode, errors.Alert, []string{"The design was denied by the validation webhooks"}, reasons, []string{"The manifests of the design violate the admission policies of the validation webhooks"}, []string{"Change the design so that it complies with the policies, or have the administrator of Meshery Server update the validation webhooks"})
+}
+
+func ErrInvokeValidationWebhook(err error, webhook string) error {
+	return errors.New(ErrInvokeValidationWebhookCode, errors.Alert, []string{"Unable to validate the design with the validation webhook " + webhook}, []string{err.Error()}, []string{"The webhook is not reachable from the Meshery server, failed or did not answer with a validation response"}, []string{"Make sure the URL of the webhook is reachable and it answers with a 2xx status and a JSON object holding the allowed field", "Set the failure policy of the webhook to Ignore to deploy designs when it is unavailable"})
+}
+
+func ErrGenerateTrendReport(err error) error {
+	return errors.New(ErrGenerateTrendReportCode, errors.Alert, []string{"Unable to generate the performance trend reports"}, []string{err.Error()}, []string{"The history of the results or the trend reports could not be read from or written to the database"}, []string{"Make sure the Meshery database is writable"})
+}
+
+func ErrLoadWorkspaces(err error) error {
+	return errors.New(ErrLoadWorkspacesCode, errors.Alert, []string{"Unable to load the workspaces of Meshery Server"}, []string{err.Error()}, []string{"The file of WORKSPACES_FILE cannot be read or is invalid", "A user is a member of several workspaces", "The quotas cap the profiles, designs or results while remote providers, which store them, are configured"}, []string{"Check that WORKSPACES_FILE is readable and lists every workspace once, with a name, its members and its quotas", "Make every user a member of one workspace at most", "Set only max_concurrent_tests when PROVIDER_BASE_URLS is set"})
+}
+
+func ErrWorkspaceUsage(err error) error {
+	return errors.New(ErrWorkspaceUsageCode, errors.Alert, []string{"Unable to compute the usage of the quotas of the workspace"}, []string{err.Error()}, []string{"The profiles, designs or results of the workspace could not be read from the database"}, []string{"Check the database of Meshery Server"})
+}
+
+func ErrWorkspaceLoadTestLimits(err error) error {
+	return errors.New(ErrWorkspaceLoadTestLimitsCode, errors.Alert, []string{"Unable to read or save the load test limits of the workspace"}, []string{err.Error()}, []string{"The load test limits of the workspace could not be read from, or saved to, the database"}, []string{"Check the database of Meshery Server"})
+}
+
+func ErrDryRunManifest(err error) error {
+	return errors.New(ErrDryRunManifestCode, errors.Alert, []string{"Unable to dry run the manifest"}, []string{err.Error()}, []string{"The manifest is not valid YAML or JSON", "The kind of a resource of the manifest is not served by the cluster", "The Kubernetes API rejected a resource, e.g. because it is invalid or not allowed to the user of the context"}, []string{"Check the resources of the manifest and that their kinds are installed in the cluster"})
+}
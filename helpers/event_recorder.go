@@ -0,0 +1,79 @@
+package helpers
+
+import (
+	"sync"
+
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// EventRecorder persists the events reported by the components of Meshery Server and publishes
+// the ones every user is interested in to the subscribers of the event stream
+type EventRecorder struct {
+	persister *models.EventPersister
+
+	subscribers     map[chan *models.Event]struct{}
+	subscribersLock *sync.Mutex
+}
+
+// NewEventRecorder returns an instance of EventRecorder persisting the events with the given persister
+func NewEventRecorder(persister *models.EventPersister) *EventRecorder {
+	return &EventRecorder{
+		persister:       persister,
+		subscribers:     map[chan *models.Event]struct{}{},
+		subscribersLock: &sync.Mutex{},
+	}
+}
+
+// Record persists the event without publishing it
+func (r *EventRecorder) Record(category models.EventCategory, source string, e *meshes.EventsResponse) (*models.Event, error) {
+	event := models.NewEvent(category, source, e)
+	if err := r.persister.CreateEvent(event); err != nil {
+		return event, ErrRecordEvent(err)
+	}
+	return event, nil
+}
+
+// Forward records and publishes every event received on the channel until it is closed
+func (r *EventRecorder) Forward(category models.EventCategory, source string, events <-chan *meshes.EventsResponse) {
+	for e := range events {
+		event, err := r.Record(category, source, e)
+		if err != nil {
+			logrus.Error(err)
+		}
+		r.publish(event)
+	}
+}
+
+// Subscribe returns a channel on which the forwarded events are published,
+// along with a function to cancel the subscription
+func (r *EventRecorder) Subscribe() (<-chan *models.Event, func()) {
+	ch := make(chan *models.Event, 10)
+
+	r.subscribersLock.Lock()
+	r.subscribers[ch] = struct{}{}
+	r.subscribersLock.Unlock()
+
+	return ch, func() {
+		r.subscribersLock.Lock()
+		defer r.subscribersLock.Unlock()
+		if _, ok := r.subscribers[ch]; ok {
+			delete(r.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (r *EventRecorder) publish(event *models.Event) {
+	r.subscribersLock.Lock()
+	defer r.subscribersLock.Unlock()
+
+	for ch := range r.subscribers {
+		select {
+		case ch <- event:
+		default:
+			logrus.Debug("dropping event for a slow subscriber")
+		}
+	}
+}
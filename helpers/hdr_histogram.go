@@ -0,0 +1,60 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"fortio.org/fortio/stats"
+)
+
+// HGRMFromResult renders the latency histogram of a performance result in the
+// percentile distribution format of HdrHistogram (.hgrm), read by the HdrHistogram
+// plotter and the tooling built around it. Values are in milliseconds.
+func HGRMFromResult(result map[string]interface{}) ([]byte, error) {
+	raw, ok := result["DurationHistogram"]
+	if !ok || raw == nil {
+		return nil, ErrConvertingResultToMap(fmt.Errorf("the result holds no latency histogram"))
+	}
+	bd, err := json.Marshal(raw)
+	if err != nil {
+		return nil, ErrConvertingResultToMap(err)
+	}
+	histogram := &stats.HistogramData{}
+	if err := json.Unmarshal(bd, histogram); err != nil {
+		return nil, ErrUnmarshal(err, "latency histogram")
+	}
+	return HGRM(histogram), nil
+}
+
+// HGRM renders the histogram, recorded in seconds, as an HdrHistogram percentile
+// distribution in milliseconds. Every bucket of the histogram is a line giving its
+// upper bound along with the percentile and the count of values up to it.
+func HGRM(h *stats.HistogramData) []byte {
+	const ms = 1000.0
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "%12s %14s %10s %14s\n\n", "Value", "Percentile", "TotalCount", "1/(1-Percentile)")
+
+	var total int64
+	if h.Count > 0 {
+		fmt.Fprintf(&b, "%12.3f %2.12f %10d %14.2f\n", h.Min*ms, 0.0, 0, 1.0)
+	}
+	for _, bucket := range h.Data {
+		total += bucket.Count
+		value := math.Min(bucket.End, h.Max)
+		percentile := bucket.Percent / 100
+		if total >= h.Count || percentile >= 1 {
+			// The last line has no inverse as it would be infinite
+			fmt.Fprintf(&b, "%12.3f %2.12f %10d\n", value*ms, 1.0, total)
+			continue
+		}
+		fmt.Fprintf(&b, "%12.3f %2.12f %10d %14.2f\n", value*ms, percentile, total, 1/(1-percentile))
+	}
+
+	fmt.Fprintf(&b, "#[Mean    = %12.3f, StdDeviation   = %12.3f]\n", h.Avg*ms, h.StdDev*ms)
+	fmt.Fprintf(&b, "#[Max     = %12.3f, Total count    = %12d]\n", h.Max*ms, h.Count)
+	fmt.Fprintf(&b, "#[Buckets = %12d, SubBuckets     = %12d]\n", len(h.Data), 1)
+	return b.Bytes()
+}
@@ -0,0 +1,67 @@
+package helpers
+
+import (
+	"testing"
+
+	"fortio.org/fortio/stats"
+)
+
+func TestHGRM(t *testing.T) {
+	h := &stats.HistogramData{
+		Count:  4,
+		Min:    0.001,
+		Max:    0.004,
+		Avg:    0.0025,
+		StdDev: 0.001,
+		Data: []stats.Bucket{
+			{Interval: stats.Interval{Start: 0.001, End: 0.002}, Percent: 50, Count: 2},
+			// the last bucket ends past the maximum, which bounds it
+			{Interval: stats.Interval{Start: 0.002, End: 0.005}, Percent: 100, Count: 2},
+		},
+	}
+	want := `       Value     Percentile TotalCount 1/(1-Percentile)
+
+       1.000 0.000000000000          0           1.00
+       2.000 0.500000000000          2           2.00
+       4.000 1.000000000000          4
+#[Mean    =        2.500, StdDeviation   =        1.000]
+#[Max     =        4.000, Total count    =            4]
+#[Buckets =            2, SubBuckets     =            1]
+`
+	if got := string(HGRM(h)); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	// an empty histogram has no minimum to start the distribution with
+	if got := string(HGRM(&stats.HistogramData{})); got != `       Value     Percentile TotalCount 1/(1-Percentile)
+
+#[Mean    =        0.000, StdDeviation   =        0.000]
+#[Max     =        0.000, Total count    =            0]
+#[Buckets =            0, SubBuckets     =            1]
+` {
+		t.Errorf("got\n%s\nfor an empty histogram", got)
+	}
+}
+
+func TestHGRMFromResult(t *testing.T) {
+	result := map[string]interface{}{
+		"DurationHistogram": map[string]interface{}{
+			"Count": 1, "Min": 0.002, "Max": 0.002, "Avg": 0.002,
+			"Data": []interface{}{map[string]interface{}{"Start": 0.002, "End": 0.002, "Percent": 100, "Count": 1}},
+		},
+	}
+	got, err := HGRMFromResult(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := HGRM(&stats.HistogramData{Count: 1, Min: 0.002, Max: 0.002, Avg: 0.002, Data: []stats.Bucket{{Interval: stats.Interval{Start: 0.002, End: 0.002}, Percent: 100, Count: 1}}}); string(got) != string(want) {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if _, err := HGRMFromResult(map[string]interface{}{"ActualQPS": 10}); err == nil {
+		t.Error("result without a latency histogram was rendered")
+	}
+	if _, err := HGRMFromResult(map[string]interface{}{"DurationHistogram": "p99=10ms"}); err == nil {
+		t.Error("result with an invalid latency histogram was rendered")
+	}
+}
@@ -0,0 +1,237 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// JobTracker tracks the background jobs of Meshery Server in memory, the running jobs along with
+// the last ones completed, as many as its history keeps. A nil JobTracker tracks no job.
+type JobTracker struct {
+	history int
+	jobs    map[string]*trackedJob
+	lock    *sync.Mutex
+}
+
+// NewJobTracker returns an instance of JobTracker keeping the given number of completed jobs
+func NewJobTracker(history int) *JobTracker {
+	return &JobTracker{
+		history: history,
+		jobs:    map[string]*trackedJob{},
+		lock:    &sync.Mutex{},
+	}
+}
+
+// trackedJob is a job of the JobTracker, its state being guarded by the lock of the tracker
+type trackedJob struct {
+	tracker *JobTracker
+	job     models.Job
+	ctx     context.Context
+	cancel  context.CancelFunc
+	// expected is the estimated duration of the job, the progress following the time elapsed
+	// until the job reports it
+	expected time.Duration
+	reported bool
+}
+
+// Start tracks a job running from now on with a context derived from the given one, the job being
+// cancelled through the API when it is cancellable
+func (t *JobTracker) Start(ctx context.Context, kind, name, userID string, cancellable bool) models.JobReporter {
+	if t == nil {
+		return models.UntrackedJob(ctx)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		logrus.Error(err)
+		return models.UntrackedJob(ctx)
+	}
+
+	j := &trackedJob{
+		tracker: t,
+		job: models.Job{
+			ID:          id.String(),
+			Kind:        kind,
+			Name:        name,
+			UserID:      userID,
+			Status:      models.JobRunning,
+			Cancellable: cancellable,
+			StartedAt:   time.Now(),
+		},
+	}
+	j.ctx, j.cancel = context.WithCancel(ctx)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.jobs[j.job.ID] = j
+	return j
+}
+
+// List returns the jobs selected by the filter, the running ones first and the most recent first
+func (t *JobTracker) List(filter models.JobFilter) []models.Job {
+	if t == nil {
+		return []models.Job{}
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	jobs := []models.Job{}
+	for _, j := range t.jobs {
+		if job := j.snapshot(now); filter.Matches(&job) {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.Slice(jobs, func(i, k int) bool {
+		if jobs[i].Completed() != jobs[k].Completed() {
+			return !jobs[i].Completed()
+		}
+		return jobs[i].StartedAt.After(jobs[k].StartedAt)
+	})
+	return jobs
+}
+
+// Get returns the job of the given ID
+func (t *JobTracker) Get(id string) (*models.Job, error) {
+	if t == nil {
+		return nil, models.ErrJobNotFound(id)
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	j, ok := t.jobs[id]
+	if !ok {
+		return nil, models.ErrJobNotFound(id)
+	}
+	job := j.snapshot(time.Now())
+	return &job, nil
+}
+
+// Cancel asks the running job of the given ID to stop, the job being cancelled once it stops
+func (t *JobTracker) Cancel(id string) (*models.Job, error) {
+	if t == nil {
+		return nil, models.ErrJobNotFound(id)
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	j, ok := t.jobs[id]
+	if !ok {
+		return nil, models.ErrJobNotFound(id)
+	}
+	if j.job.Completed() {
+		return nil, models.ErrCancelJob(fmt.Errorf("the job %s already %s", id, j.job.Status))
+	}
+	if !j.job.Cancellable {
+		return nil, models.ErrCancelJob(fmt.Errorf("the job %s of kind %s cannot be cancelled", id, j.job.Kind))
+	}
+	j.job.Status = models.JobCancelling
+	j.cancel()
+	job := j.snapshot(time.Now())
+	return &job, nil
+}
+
+// prune forgets the oldest completed jobs beyond the history
+func (t *JobTracker) prune() {
+	completed := []*trackedJob{}
+	for _, j := range t.jobs {
+		if j.job.Completed() {
+			completed = append(completed, j)
+		}
+	}
+	if len(completed) <= t.history {
+		return
+	}
+	sort.Slice(completed, func(i, k int) bool { return completed[i].job.FinishedAt.After(*completed[k].job.FinishedAt) })
+	for _, j := range completed[t.history:] {
+		delete(t.jobs, j.job.ID)
+	}
+}
+
+// snapshot returns the job as of now, with its progress
+func (j *trackedJob) snapshot(now time.Time) models.Job {
+	job := j.job
+	switch {
+	case job.Status == models.JobSucceeded:
+		job.Progress = percentage(1)
+	case j.reported && job.ItemsTotal > 0:
+		job.Progress = percentage(float64(job.ItemsDone) / float64(job.ItemsTotal))
+	case !j.reported && j.expected > 0 && !job.Completed():
+		// An estimate never tells the job is done, only the job does
+		job.Progress = percentage(math.Min(float64(now.Sub(job.StartedAt))/float64(j.expected), 0.99))
+	}
+	return job
+}
+
+func percentage(fraction float64) *float64 {
+	p := float64(int(fraction*1000)) / 10
+	return &p
+}
+
+// Context returns the context of the job, done once the job is cancelled or completes
+func (j *trackedJob) Context() context.Context {
+	return j.ctx
+}
+
+// Progress reports the work done out of the total, the total being 0 when it is not known
+func (j *trackedJob) Progress(done, total int) {
+	j.tracker.lock.Lock()
+	defer j.tracker.lock.Unlock()
+	j.job.ItemsDone, j.job.ItemsTotal = done, total
+	j.reported = true
+}
+
+// Expect estimates the progress of the job from the time elapsed until the job reports it
+func (j *trackedJob) Expect(duration time.Duration) {
+	j.tracker.lock.Lock()
+	defer j.tracker.lock.Unlock()
+	j.expected = duration
+}
+
+// Message reports what the job is doing
+func (j *trackedJob) Message(msg string) {
+	j.tracker.lock.Lock()
+	defer j.tracker.lock.Unlock()
+	j.job.Message = msg
+}
+
+// Finish completes the job, cancelled when it was asked to stop and failed when err is not nil
+func (j *trackedJob) Finish(err error) {
+	j.tracker.lock.Lock()
+	defer j.tracker.lock.Unlock()
+	if j.job.Completed() {
+		return
+	}
+
+	now := time.Now()
+	j.job.FinishedAt = &now
+	switch {
+	case j.job.Status == models.JobCancelling:
+		j.job.Status = models.JobCancelled
+		if err != nil {
+			j.job.Message = err.Error()
+		}
+	case err != nil:
+		j.job.Status = models.JobFailed
+		j.job.Message = err.Error()
+	default:
+		j.job.Status = models.JobSucceeded
+	}
+	j.cancel()
+	j.tracker.prune()
+}
+
+// Discard forgets the job, which turned out to have nothing to do
+func (j *trackedJob) Discard() {
+	j.tracker.lock.Lock()
+	defer j.tracker.lock.Unlock()
+	delete(j.tracker.jobs, j.job.ID)
+	j.cancel()
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/layer5io/meshkit/utils"
 	mesherykube "github.com/layer5io/meshkit/utils/kubernetes"
 	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -156,6 +157,10 @@ func FetchKubernetesNodes(kubeconfig []byte, contextName string) ([]*models.K8SN
 		node.ContainerRuntimeVersion = nodeInfo.ContainerRuntimeVersion
 		logrus.Debugf("Architecture: %s", nodeInfo.Architecture)
 		node.Architecture = nodeInfo.Architecture
+		node.InstanceType = n.Labels[corev1.LabelInstanceTypeStable]
+		if node.InstanceType == "" {
+			node.InstanceType = n.Labels[corev1.LabelInstanceType]
+		}
 
 		nodes = append(nodes, node)
 	}
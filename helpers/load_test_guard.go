@@ -0,0 +1,120 @@
+package helpers
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// LoadTestGuard prevents load tests against the same target from overlapping,
+// as concurrent tests skew each other's results. Tests against a target which
+// is already under test wait in FIFO order until the running test completes.
+type LoadTestGuard struct {
+	enabled bool
+
+	// queues holds, per target, the wake up channel of the running test
+	// followed by the ones of the waiting tests
+	queues     map[string][]chan struct{}
+	queuesLock *sync.Mutex
+}
+
+// NewLoadTestGuard returns a LoadTestGuard. A disabled guard lets every test run
+// immediately.
+func NewLoadTestGuard(enabled bool) *LoadTestGuard {
+	return &LoadTestGuard{
+		enabled:    enabled,
+		queues:     map[string][]chan struct{}{},
+		queuesLock: &sync.Mutex{},
+	}
+}
+
+// SetEnabled enables or disables the guard, the tests waiting already being let run in turn
+func (g *LoadTestGuard) SetEnabled(enabled bool) {
+	g.queuesLock.Lock()
+	defer g.queuesLock.Unlock()
+	g.enabled = enabled
+}
+
+// Acquire blocks until no other test runs against the target, calling onQueued
+// with the position in the queue when the test has to wait. The returned
+// function must be called once the test completes. An error is returned when
+// the context is cancelled while waiting.
+func (g *LoadTestGuard) Acquire(ctx context.Context, target string, onQueued func(position int)) (func(), error) {
+	g.queuesLock.Lock()
+	enabled := g.enabled
+	g.queuesLock.Unlock()
+	if !enabled || target == "" {
+		return func() {}, nil
+	}
+
+	turn := make(chan struct{})
+	g.queuesLock.Lock()
+	g.queues[target] = append(g.queues[target], turn)
+	position := len(g.queues[target]) - 1
+	if position == 0 {
+		close(turn)
+	}
+	g.queuesLock.Unlock()
+
+	release := func() { g.leave(target, turn) }
+
+	if position > 0 && onQueued != nil {
+		onQueued(position)
+	}
+
+	select {
+	case <-turn:
+		return release, nil
+	case <-ctx.Done():
+		release()
+		return nil, ctx.Err()
+	}
+}
+
+// leave removes the test from the queue of the target, handing over to the
+// next test when it was the running one
+func (g *LoadTestGuard) leave(target string, turn chan struct{}) {
+	g.queuesLock.Lock()
+	defer g.queuesLock.Unlock()
+
+	queue := g.queues[target]
+	for i, ch := range queue {
+		if ch != turn {
+			continue
+		}
+		queue = append(queue[:i], queue[i+1:]...)
+		if i == 0 && len(queue) > 0 {
+			close(queue[0])
+		}
+		break
+	}
+
+	if len(queue) == 0 {
+		delete(g.queues, target)
+		return
+	}
+	g.queues[target] = queue
+}
+
+// LoadTestTarget returns the key identifying the service under test. Kubernetes
+// service hostnames (<service>.<namespace>.svc[.<domain>]) are keyed by namespace
+// and service so that the different forms of the same service are treated alike,
+// other endpoints by their hostname.
+func LoadTestTarget(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "" || net.ParseIP(host) != nil {
+		return host
+	}
+
+	parts := strings.Split(host, ".")
+	if len(parts) >= 3 && parts[2] == "svc" {
+		return parts[1] + "/" + parts[0]
+	}
+	return host
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/url"
 	"os"
 	"os/exec"
@@ -33,8 +34,47 @@ var (
 	nighthawkRunning = false
 )
 
+// loadTestContext returns the context of the test, done once it is cancelled
+func loadTestContext(opts *models.LoadTestOptions) context.Context {
+	if opts.Context != nil {
+		return opts.Context
+	}
+	return context.Background()
+}
+
+// contextAborter returns the aborter of a fortio run stopping the run once the context is done,
+// and the function to call once the run completes
+func contextAborter(ctx context.Context) (*periodic.Aborter, func()) {
+	aborter := periodic.NewAborter()
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			aborter.Abort()
+		case <-done:
+		}
+	}()
+	return aborter, func() { close(done) }
+}
+
 // FortioLoadTest is the actual code which invokes Fortio to run the load test
 func FortioLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if opts.BodyTemplate && opts.SupportedLoadTestMethods == 2 {
+		return nil, nil, ErrBodyTemplateUnsupported("fortio gRPC tests")
+	}
+	if len(opts.RequestMix) > 0 && opts.SupportedLoadTestMethods == 2 {
+		return nil, nil, ErrRequestMixUnsupported("fortio gRPC tests")
+	}
+	if !opts.Assertions.Empty() && opts.SupportedLoadTestMethods == 2 {
+		return nil, nil, ErrAssertionsUnsupported("fortio gRPC tests")
+	}
+	if opts.Method != "" && opts.SupportedLoadTestMethods == 2 {
+		return nil, nil, ErrLoadTestMethodUnsupported("fortio gRPC tests")
+	}
+	assertions, err := newResponseAssertions(opts.Assertions)
+	if err != nil {
+		return nil, nil, err
+	}
 	defaults := &periodic.DefaultRunnerOptions
 	httpOpts, err := sharedHTTPOptions(opts)
 	if err != nil {
@@ -60,7 +100,11 @@ func FortioLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *peri
 		Labels:      labels,
 		Exactly:     0,
 	}
+	var stop func()
+	ro.Stop, stop = contextAborter(loadTestContext(opts))
+	defer stop()
 	var res periodic.HasRunnerResult
+	var perRequest []map[string]interface{}
 	if opts.SupportedLoadTestMethods == 2 {
 		o := fgrpc.GRPCRunnerOptions{
 			RunnerOptions:      ro,
@@ -75,6 +119,24 @@ func FortioLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *peri
 			UnixDomainSocket:   httpOpts.UnixDomainSocket,
 		}
 		res, err = fgrpc.RunGRPCTest(&o)
+	} else if len(opts.RequestMix) > 0 {
+		res, perRequest, err = runRequestMixHTTPTest(ro, httpOpts, opts.RequestMix, opts.TemplateData, assertions)
+		if err != nil {
+			return nil, nil, ErrRunningTest(err)
+		}
+	} else if opts.BodyTemplate || assertions != nil || opts.HTTPMethod() != httpOpts.Method() {
+		// fortio cannot validate the responses nor send other methods than GET and POST, so tests
+		// with assertions or other methods are run as templated tests
+		var template *BodyTemplate
+		if opts.BodyTemplate {
+			if template, err = NewBodyTemplate(string(opts.Body), opts.TemplateData); err != nil {
+				return nil, nil, err
+			}
+		}
+		res, err = runTemplatedHTTPTest(ro, httpOpts, opts.Method, template, assertions)
+		if err != nil {
+			return nil, nil, ErrRunningTest(err)
+		}
 	} else {
 		o := fhttp.HTTPRunnerOptions{
 			HTTPOptions:        *httpOpts,
@@ -110,12 +172,30 @@ func FortioLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *peri
 	if err != nil {
 		return nil, nil, ErrUnmarshal(err, "data to map")
 	}
+	if perRequest != nil {
+		resultsMap["request-mix"] = perRequest
+	}
+	if assertions != nil {
+		resultsMap["assertions"] = assertions.result()
+	}
 	logrus.Debugf("Mapped version of the test: %+#v", resultsMap)
 	return resultsMap, result, nil
 }
 
 // WRK2LoadTest is the actual code which invokes Wrk2 to run the load test
 func WRK2LoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if opts.BodyTemplate {
+		return nil, nil, ErrBodyTemplateUnsupported(models.Wrk2LG.Name())
+	}
+	if len(opts.RequestMix) > 0 {
+		return nil, nil, ErrRequestMixUnsupported(models.Wrk2LG.Name())
+	}
+	if !opts.Assertions.Empty() {
+		return nil, nil, ErrAssertionsUnsupported(models.Wrk2LG.Name())
+	}
+	if opts.UnixSocket != "" {
+		return nil, nil, ErrUnixSocketUnsupported(models.Wrk2LG.Name())
+	}
 	qps := opts.HTTPQPS // TODO possibly use translated <=0 to "max" from results/options normalization in periodic/
 	if qps <= 0 {
 		qps = -1 // 0==unitialized struct == default duration, -1 (0 for flag) is max
@@ -137,7 +217,11 @@ func WRK2LoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *period
 		return nil, nil, ErrGrpcSupport(err, "Wrk2")
 	}
 	var gres *api.GoWRK2
-	gres, err = api.WRKRun(ro)
+	if wrk2CustomRequest(opts) {
+		gres, err = runWRK2(ro, opts)
+	} else {
+		gres, err = api.WRKRun(ro)
+	}
 	if err == nil {
 		logrus.Debugf("WRK Result: %+v", gres)
 		res, err = api.TransformWRKToFortio(gres, ro)
@@ -226,6 +310,18 @@ func startNighthawkServer(timeout int64) error {
 
 // NighthawkLoadTest is the actual code which invokes nighthawk to run the load test
 func NighthawkLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if opts.BodyTemplate {
+		return nil, nil, ErrBodyTemplateUnsupported(models.NighthawkLG.Name())
+	}
+	if len(opts.RequestMix) > 0 {
+		return nil, nil, ErrRequestMixUnsupported(models.NighthawkLG.Name())
+	}
+	if !opts.Assertions.Empty() {
+		return nil, nil, ErrAssertionsUnsupported(models.NighthawkLG.Name())
+	}
+	if opts.UnixSocket != "" {
+		return nil, nil, ErrUnixSocketUnsupported(models.NighthawkLG.Name())
+	}
 	err := startNighthawkServer(int64(opts.Duration))
 	if err != nil {
 		return nil, nil, ErrRunningNighthawkServer(err)
@@ -245,10 +341,11 @@ func NighthawkLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *p
 	}
 
 	if u.Port() == "" {
+		// JoinHostPort brackets IPv6 literals
 		if u.Scheme == "http" {
-			rURL = fmt.Sprintf("http://%s:80%s", u.Hostname(), u.Path)
+			rURL = fmt.Sprintf("http://%s%s", net.JoinHostPort(u.Hostname(), "80"), u.Path)
 		} else {
-			rURL = fmt.Sprintf("https://%s:443%s", u.Hostname(), u.Path)
+			rURL = fmt.Sprintf("https://%s%s", net.JoinHostPort(u.Hostname(), "443"), u.Path)
 		}
 		// Add support for more protocols here
 	}
@@ -294,14 +391,12 @@ func NighthawkLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *p
 
 	// Nighthawk doesn't send the specified request payload but instead sends
 	// 'a' characters corresponding to that body's size
-	// TODO: Request method should be specifiable through UI
 	if reqBodyLength := len(opts.Body); reqBodyLength > 0 {
 		requestOptions.RequestBodySize = &wrappers.UInt32Value{Value: uint32(len(opts.Body))}
-		requestOptions.RequestMethod = v3.RequestMethod_POST
 	} else {
 		requestOptions.RequestBodySize = &wrappers.UInt32Value{Value: uint32(0)}
-		requestOptions.RequestMethod = v3.RequestMethod_GET
 	}
+	requestOptions.RequestMethod = v3.RequestMethod(v3.RequestMethod_value[opts.HTTPMethod()])
 
 	ro := &nighthawk_proto.CommandLineOptions{
 		OneofDurationOptions: &nighthawk_proto.CommandLineOptions_Duration{
@@ -378,7 +473,7 @@ func NighthawkLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *p
 
 	logrus.Info("starting test")
 
-	client, err := c.Handler.ExecutionStream(context.TODO())
+	client, err := c.Handler.ExecutionStream(loadTestContext(opts))
 	if err != nil {
 		return nil, nil, ErrRunningTest(err)
 	}
@@ -484,6 +579,11 @@ func sharedHTTPOptions(opts *models.LoadTestOptions) (*fhttp.HTTPOptions, error)
 	if len(opts.ContentType) > 0 {
 		httpOpts.ContentType = opts.ContentType
 	}
+	if opts.UnixSocket != "" {
+		// only the fast client of fortio dials Unix domain sockets
+		httpOpts.UnixDomainSocket = opts.UnixSocket
+		httpOpts.DisableFastClient = false
+	}
 
 	return &httpOpts, nil
 }
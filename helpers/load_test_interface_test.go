@@ -0,0 +1,93 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"fortio.org/fortio/periodic"
+	"fortio.org/fortio/stats"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestFortioLoadTestCancelled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	opts := &models.LoadTestOptions{
+		Name:           "cancelled",
+		URL:            srv.URL,
+		HTTPQPS:        20,
+		HTTPNumThreads: 2,
+		Duration:       time.Minute,
+		Context:        ctx,
+	}
+	time.AfterFunc(500*time.Millisecond, cancel)
+
+	done := make(chan *periodic.RunnerResults, 1)
+	go func() {
+		_, result, err := FortioLoadTest(opts)
+		if err != nil {
+			t.Error(err)
+		}
+		done <- result
+	}()
+	select {
+	case result := <-done:
+		if result == nil {
+			return
+		}
+		if result.ActualDuration >= opts.Duration {
+			t.Errorf("cancelled test ran for %s", result.ActualDuration)
+		}
+		if result.DurationHistogram.Count == 0 {
+			t.Error("cancelled test kept none of the requests sent until then")
+		}
+	case <-time.After(30 * time.Second):
+		t.Fatal("test did not stop once cancelled")
+	}
+}
+
+func TestCheckpointedLoadTestCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := &models.LoadTestOptions{
+		Duration:           time.Hour,
+		CheckpointInterval: 10 * time.Minute,
+		Context:            ctx,
+	}
+	segments := 0
+	run := func(segment *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+		segments++
+		return map[string]interface{}{}, &periodic.RunnerResults{
+			ActualDuration: segment.Duration,
+			DurationHistogram: &stats.HistogramData{
+				Count: 100, Min: 0.001, Max: 0.01, Sum: 0.5, Avg: 0.005,
+				Data: []stats.Bucket{{Interval: stats.Interval{Start: 0.001, End: 0.01}, Percent: 100, Count: 100}},
+			},
+		}, nil
+	}
+	checkpoint := func(cp *models.LoadTestCheckpoint) {
+		if cp.Seq == 2 {
+			cancel()
+		}
+	}
+
+	resultsMap, result, err := CheckpointedLoadTest(opts, run, checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if segments != 2 {
+		t.Errorf("cancelled test ran %d segments, want 2", segments)
+	}
+	if result.ActualDuration != 20*time.Minute || result.DurationHistogram.Count != 200 {
+		t.Errorf("result of the cancelled test lasted %s with %d requests, want those of the 2 segments run", result.ActualDuration, result.DurationHistogram.Count)
+	}
+	summary, _ := resultsMap["checkpoints"].(map[string]interface{})
+	if summary["interrupted"] != "test cancelled after 2 segments" {
+		t.Errorf("cancelled test is summarized as %+v", summary)
+	}
+}
@@ -0,0 +1,79 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+)
+
+// DryRunManifest submits the resources of the manifest to the Kubernetes API as a server-side dry
+// run, nothing being persisted, and returns the resources as the API would have created or updated
+// them, with their defaults set and their admission webhooks run. The resources without a namespace
+// are put in the given one. On deletion, the resources which would be deleted are returned instead,
+// those which do not exist being left out.
+func DryRunManifest(ctx context.Context, client dynamic.Interface, mapper meta.RESTMapper, manifest []byte, namespace string, isDelete bool) ([]*unstructured.Unstructured, error) {
+	objs := []*unstructured.Unstructured{}
+	decoder := kyaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, ErrDryRunManifest(err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		gvk := obj.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return nil, ErrDryRunManifest(fmt.Errorf("%s %s: %w", gvk.Kind, obj.GetName(), err))
+		}
+		var resource dynamic.ResourceInterface = client.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			if obj.GetNamespace() == "" {
+				obj.SetNamespace(namespace)
+			}
+			resource = client.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+		}
+
+		dryRun := []string{metav1.DryRunAll}
+		if isDelete {
+			existing, err := resource.Get(ctx, obj.GetName(), metav1.GetOptions{})
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, ErrDryRunManifest(fmt.Errorf("%s %s: %w", gvk.Kind, obj.GetName(), err))
+			}
+			if err := resource.Delete(ctx, obj.GetName(), metav1.DeleteOptions{DryRun: dryRun}); err != nil {
+				return nil, ErrDryRunManifest(fmt.Errorf("%s %s: %w", gvk.Kind, obj.GetName(), err))
+			}
+			objs = append(objs, existing)
+			continue
+		}
+		applied, err := resource.Create(ctx, obj, metav1.CreateOptions{DryRun: dryRun})
+		if kerrors.IsAlreadyExists(err) {
+			var existing *unstructured.Unstructured
+			if existing, err = resource.Get(ctx, obj.GetName(), metav1.GetOptions{}); err == nil {
+				obj.SetResourceVersion(existing.GetResourceVersion())
+				applied, err = resource.Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRun})
+			}
+		}
+		if err != nil {
+			return nil, ErrDryRunManifest(fmt.Errorf("%s %s: %w", gvk.Kind, obj.GetName(), err))
+		}
+		objs = append(objs, applied)
+	}
+	return objs, nil
+}
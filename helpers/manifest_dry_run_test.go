@@ -0,0 +1,107 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+const testDryRunManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: access-logs
+data:
+  format: json
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: mesh-config
+  namespace: istio-system
+data:
+  mtls: strict
+`
+
+func TestDryRunManifest(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+
+	existing := `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "mesh-config", "namespace": "istio-system", "resourceVersion": "7"}, "data": {"mtls": "permissive"}}`
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		dryRun := r.URL.Query().Get("dryRun")
+		if r.Method == http.MethodDelete {
+			// the options of a deletion are its body
+			opts := metav1.DeleteOptions{}
+			_ = json.NewDecoder(r.Body).Decode(&opts)
+			if len(opts.DryRun) == 1 {
+				dryRun = opts.DryRun[0]
+			}
+		}
+		if r.Method != http.MethodGet && dryRun != metav1.DryRunAll {
+			t.Errorf("%s %s was not a dry run", r.Method, r.URL.Path)
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces/istio-system/configmaps/mesh-config":
+			_, _ = rw.Write([]byte(existing))
+		case r.Method == http.MethodGet:
+			rw.WriteHeader(http.StatusNotFound)
+			_, _ = rw.Write([]byte(`{"kind": "Status", "apiVersion": "v1", "status": "Failure", "reason": "NotFound", "code": 404}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/namespaces/istio-system/configmaps":
+			rw.WriteHeader(http.StatusConflict)
+			_, _ = rw.Write([]byte(`{"kind": "Status", "apiVersion": "v1", "status": "Failure", "reason": "AlreadyExists", "code": 409}`))
+		case r.Method == http.MethodDelete:
+			_, _ = rw.Write([]byte(`{"kind": "Status", "apiVersion": "v1", "status": "Success"}`))
+		default:
+			// the resource is returned as applied, along with the path it was applied at
+			body, _ := io.ReadAll(r.Body)
+			obj := map[string]interface{}{}
+			_ = json.Unmarshal(body, &obj)
+			obj["path"] = r.Method + " " + r.URL.Path
+			_ = json.NewEncoder(rw).Encode(obj)
+		}
+	}))
+	defer srv.Close()
+	client, err := dynamic.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objs, err := DryRunManifest(context.Background(), client, mapper, []byte(testDryRunManifest), "istio-test", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 2 {
+		t.Fatalf("dry run returned %d resources, want 2", len(objs))
+	}
+	if path := objs[0].Object["path"]; path != "POST /api/v1/namespaces/istio-test/configmaps" {
+		t.Errorf("resource without a namespace was created with %v, want in the namespace of the operation", path)
+	}
+	if path := objs[1].Object["path"]; path != "PUT /api/v1/namespaces/istio-system/configmaps/mesh-config" || objs[1].GetResourceVersion() != "7" {
+		t.Errorf("existing resource was applied with %v at version %s, want an update of version 7", path, objs[1].GetResourceVersion())
+	}
+
+	objs, err = DryRunManifest(context.Background(), client, mapper, []byte(testDryRunManifest), "istio-test", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 1 || objs[0].GetName() != "mesh-config" {
+		t.Errorf("dry run of the deletion returned %d resources, want the existing mesh-config only", len(objs))
+	}
+
+	if _, err := DryRunManifest(context.Background(), client, mapper, []byte("apiVersion: v1\nkind: Secret\nmetadata:\n  name: token\n"), "istio-test", false); err == nil {
+		t.Error("dry run of a kind the cluster does not serve succeeded")
+	}
+	if _, err := DryRunManifest(context.Background(), client, mapper, []byte("kind: [ConfigMap"), "istio-test", false); err == nil {
+		t.Error("dry run of an invalid manifest succeeded")
+	}
+}
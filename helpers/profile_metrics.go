@@ -0,0 +1,177 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// ProfileMetricsContentType is the content type of the OpenMetrics text format
+const ProfileMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// profileMetricsWindow is the period the median p99 latency of a profile is computed over
+const profileMetricsWindow = 7 * 24 * time.Hour
+
+// NewProfileMetrics aggregates the results of the profile at the given time. The results lacking
+// a latency histogram, such as those moved to the result archive, are left out.
+func NewProfileMetrics(profileID, name string, results []*models.MesheryResult, now time.Time) *models.ProfileMetrics {
+	metrics := &models.ProfileMetrics{ProfileID: profileID, Name: name}
+
+	points := make([]*models.ResultPoint, 0, len(results))
+	for _, result := range results {
+		if point := profileResultPoint(result); point != nil {
+			points = append(points, point)
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].StartTime.After(points[j].StartTime)
+	})
+	metrics.Results = len(points)
+	if len(points) == 0 {
+		return metrics
+	}
+	metrics.Latest = points[0]
+
+	var window []float64
+	for _, point := range points {
+		if now.Sub(point.StartTime) <= profileMetricsWindow {
+			window = append(window, point.P99)
+		}
+	}
+	if len(window) > 0 {
+		metrics.WindowMedianP99 = median(window)
+		metrics.WindowResults = len(window)
+	}
+
+	metrics.P99Trend, metrics.HasTrend = p99Trend(points)
+	return metrics
+}
+
+// profileResultPoint returns the latencies, rate and start time of the result, nil when it has no p99 latency
+func profileResultPoint(result *models.MesheryResult) *models.ResultPoint {
+	if result == nil || result.Result == nil {
+		return nil
+	}
+	b, err := json.Marshal(result.Result)
+	if err != nil {
+		return nil
+	}
+	runnerResults := models.RunnerResults{}
+	if err := json.Unmarshal(b, &runnerResults); err != nil {
+		return nil
+	}
+
+	point := &models.ResultPoint{
+		ResultID:      result.ID.String(),
+		Name:          result.Name,
+		Mesh:          result.Mesh,
+		LoadGenerator: runnerResults.LoadGenerator,
+		QPS:           runnerResults.QPS,
+		Duration:      time.Duration(runnerResults.ActualDuration),
+		Min:           runnerResults.DurationHistogram.Min,
+		Max:           runnerResults.DurationHistogram.Max,
+		Average:       runnerResults.DurationHistogram.Average,
+	}
+	for _, p := range runnerResults.DurationHistogram.Percentiles {
+		switch p.Percentile {
+		case 50:
+			point.P50 = p.Value
+		case 90:
+			point.P90 = p.Value
+		case 99:
+			point.P99 = p.Value
+		}
+	}
+	if point.P99 == 0 {
+		return nil
+	}
+	switch {
+	case runnerResults.StartTime != nil:
+		point.StartTime = *runnerResults.StartTime
+	case result.TestStartTime != nil:
+		point.StartTime = *result.TestStartTime
+	default:
+		return nil
+	}
+	return point
+}
+
+// median returns the median of the values, which it sorts
+func median(values []float64) float64 {
+	sort.Float64s(values)
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
+
+// p99Trend returns the slope of the least-squares fit of the p99 latency of the result points over
+// their start time, in seconds per day, and false when the points were all started at once
+func p99Trend(points []*models.ResultPoint) (float64, bool) {
+	if len(points) < 2 {
+		return 0, false
+	}
+	origin := points[len(points)-1].StartTime
+	xs := make([]float64, 0, len(points))
+	ys := make([]float64, 0, len(points))
+	for _, point := range points {
+		xs = append(xs, point.StartTime.Sub(origin).Hours()/24)
+		ys = append(ys, point.P99)
+	}
+	return leastSquaresSlope(xs, ys)
+}
+
+// ProfileOpenMetrics renders the metrics of the profile as gauges of the OpenMetrics text format,
+// the latencies in seconds
+func ProfileOpenMetrics(metrics *models.ProfileMetrics) string {
+	labels := fmt.Sprintf(`profile_id="%s",name="%s"`,
+		prometheusLabelEscaper.Replace(metrics.ProfileID),
+		prometheusLabelEscaper.Replace(metrics.Name))
+
+	var b strings.Builder
+	gauge := func(name, unit, help string, samples ...[2]string) {
+		fmt.Fprintf(&b, "# TYPE %s gauge\n", name)
+		if unit != "" {
+			fmt.Fprintf(&b, "# UNIT %s %s\n", name, unit)
+		}
+		fmt.Fprintf(&b, "# HELP %s %s\n", name, help)
+		for _, sample := range samples {
+			fmt.Fprintf(&b, "%s{%s%s} %s\n", name, labels, sample[0], sample[1])
+		}
+	}
+	value := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	gauge("meshery_perf_profile_results", "", "Number of results of the performance profile the aggregates are computed from.",
+		[2]string{"", strconv.Itoa(metrics.Results)})
+	if latest := metrics.Latest; latest != nil {
+		gauge("meshery_perf_profile_latest_latency_seconds", "seconds", "Latency percentiles of the most recent result of the performance profile.",
+			[2]string{`,quantile="0.5"`, value(latest.P50)},
+			[2]string{`,quantile="0.9"`, value(latest.P90)},
+			[2]string{`,quantile="0.99"`, value(latest.P99)},
+		)
+		gauge("meshery_perf_profile_latest_requests_per_second", "", "Requests per second of the most recent result of the performance profile.",
+			[2]string{"", value(latest.QPS)})
+		gauge("meshery_perf_profile_latest_timestamp_seconds", "seconds", "Start time of the most recent result of the performance profile, in seconds since the epoch.",
+			[2]string{"", strconv.FormatInt(latest.StartTime.Unix(), 10)})
+	}
+	gauge("meshery_perf_profile_p99_7d_results", "", "Number of results of the performance profile started within the last 7 days.",
+		[2]string{"", strconv.Itoa(metrics.WindowResults)})
+	if metrics.WindowResults > 0 {
+		gauge("meshery_perf_profile_p99_7d_median_seconds", "seconds", "Median p99 latency of the results of the performance profile started within the last 7 days.",
+			[2]string{"", value(metrics.WindowMedianP99)})
+	}
+	if metrics.HasTrend {
+		gauge("meshery_perf_profile_p99_trend", "", "Slope of the p99 latency of the results of the performance profile over time, in seconds per day; positive when the profile gets slower.",
+			[2]string{"", value(metrics.P99Trend)})
+	}
+	b.WriteString("# EOF\n")
+	return b.String()
+}
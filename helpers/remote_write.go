@@ -0,0 +1,262 @@
+package helpers
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/layer5io/meshery/models"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// maxRemoteWriteSeries caps the series collected for a test
+	maxRemoteWriteSeries = 10000
+	// maxRemoteWriteSamples caps the samples collected for a test
+	maxRemoteWriteSamples = 1000000
+)
+
+// RemoteWriteReceiver collects the client-side metrics load generators write through Prometheus
+// remote_write while their test runs. Only the series of the tests which are running are accepted,
+// from the writers bearing the write token issued for the test.
+type RemoteWriteReceiver struct {
+	// flushGrace is how long the writers of a test are waited for once it completes, for the
+	// samples they buffered to arrive
+	flushGrace time.Duration
+
+	tests     map[string]*remoteWriteTest
+	testsLock *sync.Mutex
+}
+
+type remoteWriteTest struct {
+	token   string
+	series  map[string]*models.ClientMetricSeries
+	order   []string
+	samples int
+	dropped int
+}
+
+// NewRemoteWriteReceiver returns an instance of RemoteWriteReceiver waiting for the given grace
+// on the writers of a test which wrote series, once the test completes
+func NewRemoteWriteReceiver(flushGrace time.Duration) *RemoteWriteReceiver {
+	return &RemoteWriteReceiver{
+		flushGrace: flushGrace,
+		tests:      map[string]*remoteWriteTest{},
+		testsLock:  &sync.Mutex{},
+	}
+}
+
+// Open starts collecting the series written for the test, returning the token its writers
+// authenticate with
+func (r *RemoteWriteReceiver) Open(testID string) (string, error) {
+	r.testsLock.Lock()
+	defer r.testsLock.Unlock()
+	if test, ok := r.tests[testID]; ok {
+		return test.token, nil
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(raw)
+	r.tests[testID] = &remoteWriteTest{token: token, series: map[string]*models.ClientMetricSeries{}}
+	return token, nil
+}
+
+// Authorize checks whether the token is the write token of the test, while it runs
+func (r *RemoteWriteReceiver) Authorize(testID, token string) bool {
+	r.testsLock.Lock()
+	defer r.testsLock.Unlock()
+
+	test, ok := r.tests[testID]
+	return ok && token != "" && subtle.ConstantTimeCompare([]byte(test.token), []byte(token)) == 1
+}
+
+// Append adds the series written for the test, merging the samples of the series with the same labels
+func (r *RemoteWriteReceiver) Append(testID string, series []models.ClientMetricSeries) error {
+	r.testsLock.Lock()
+	defer r.testsLock.Unlock()
+
+	test, ok := r.tests[testID]
+	if !ok {
+		return fmt.Errorf("no performance test %s is running", testID)
+	}
+	for _, s := range series {
+		key := seriesKey(s.Labels)
+		collected, ok := test.series[key]
+		if !ok {
+			if len(test.series) >= maxRemoteWriteSeries {
+				test.dropped += len(s.Samples)
+				continue
+			}
+			collected = &models.ClientMetricSeries{Labels: s.Labels}
+			test.series[key] = collected
+			test.order = append(test.order, key)
+		}
+		room := maxRemoteWriteSamples - test.samples
+		if room < len(s.Samples) {
+			test.dropped += len(s.Samples) - room
+			s.Samples = s.Samples[:room]
+		}
+		collected.Samples = append(collected.Samples, s.Samples...)
+		test.samples += len(s.Samples)
+	}
+	return nil
+}
+
+// Close stops collecting for the test, returning the series written in the order they were first
+// written, their samples sorted by time. When series were written, their writers are waited for
+// during the flush grace first.
+func (r *RemoteWriteReceiver) Close(testID string) ([]models.ClientMetricSeries, int) {
+	r.testsLock.Lock()
+	test, ok := r.tests[testID]
+	wrote := ok && len(test.series) > 0
+	r.testsLock.Unlock()
+	if !ok {
+		return nil, 0
+	}
+	if wrote {
+		time.Sleep(r.flushGrace)
+	}
+
+	r.testsLock.Lock()
+	defer r.testsLock.Unlock()
+	delete(r.tests, testID)
+
+	series := make([]models.ClientMetricSeries, 0, len(test.order))
+	for _, key := range test.order {
+		s := test.series[key]
+		sort.SliceStable(s.Samples, func(i, j int) bool {
+			return s.Samples[i].Timestamp < s.Samples[j].Timestamp
+		})
+		series = append(series, *s)
+	}
+	return series, test.dropped
+}
+
+func seriesKey(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var key strings.Builder
+	for _, name := range names {
+		key.WriteString(name)
+		key.WriteByte(0)
+		key.WriteString(labels[name])
+		key.WriteByte(0)
+	}
+	return key.String()
+}
+
+// DecodeRemoteWrite decodes the snappy compressed protobuf WriteRequest of Prometheus remote_write
+// into its series of samples. Exemplars, histograms and metadata are skipped.
+func DecodeRemoteWrite(body []byte) ([]models.ClientMetricSeries, error) {
+	data, err := snappy.Decode(nil, body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snappy payload: %v", err)
+	}
+
+	var series []models.ClientMetricSeries
+	err = consumeMessage(data, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		// WriteRequest.timeseries
+		if num != 1 || typ != protowire.BytesType {
+			return nil
+		}
+		s, err := decodeTimeSeries(value)
+		if err != nil {
+			return err
+		}
+		series = append(series, s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+func decodeTimeSeries(data []byte) (models.ClientMetricSeries, error) {
+	s := models.ClientMetricSeries{Labels: map[string]string{}}
+	err := consumeMessage(data, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		// TimeSeries.labels
+		case 1:
+			var name, labelValue string
+			if err := consumeMessage(value, func(num protowire.Number, typ protowire.Type, value []byte) error {
+				if typ == protowire.BytesType && num == 1 {
+					name = string(value)
+				} else if typ == protowire.BytesType && num == 2 {
+					labelValue = string(value)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			s.Labels[name] = labelValue
+		// TimeSeries.samples
+		case 2:
+			var sample models.ClientMetricSample
+			if err := consumeMessage(value, func(num protowire.Number, typ protowire.Type, value []byte) error {
+				if typ == protowire.Fixed64Type && num == 1 {
+					v, _ := protowire.ConsumeFixed64(value)
+					sample.Value = math.Float64frombits(v)
+				} else if typ == protowire.VarintType && num == 2 {
+					v, _ := protowire.ConsumeVarint(value)
+					sample.Timestamp = int64(v)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			s.Samples = append(s.Samples, sample)
+		}
+		return nil
+	})
+	return s, err
+}
+
+// consumeMessage calls fn with every field of the protobuf message, the value of varint and
+// fixed fields being their raw bytes, to be decoded with protowire.ConsumeVarint or ConsumeFixed64
+func consumeMessage(data []byte, fn func(num protowire.Number, typ protowire.Type, value []byte) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return fmt.Errorf("invalid protobuf payload: %v", protowire.ParseError(n))
+		}
+		data = data[n:]
+
+		var value []byte
+		switch typ {
+		case protowire.BytesType:
+			v, m := protowire.ConsumeBytes(data)
+			if m < 0 {
+				return fmt.Errorf("invalid protobuf payload: %v", protowire.ParseError(m))
+			}
+			value, n = v, m
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return fmt.Errorf("invalid protobuf payload: %v", protowire.ParseError(n))
+			}
+			value = data[:n]
+		}
+		if err := fn(num, typ, value); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/layer5io/meshery/models"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// responseAssertions validates the responses of a load test with its assertions, counting the
+// responses failing every assertion
+type responseAssertions struct {
+	assertions   *models.LoadTestAssertions
+	descriptions []string
+
+	// the JSONPath expressions keep state while evaluated, so they are evaluated in turn
+	jsonPaths    []*jsonpath.JSONPath
+	jsonPathLock sync.Mutex
+
+	lock     sync.Mutex
+	requests int64
+	failed   int64
+	errors   int64
+	failures []int64
+}
+
+// newResponseAssertions returns the validator of the assertions, nil when there are none
+func newResponseAssertions(assertions *models.LoadTestAssertions) (*responseAssertions, error) {
+	if assertions.Empty() {
+		return nil, nil
+	}
+	if err := assertions.Validate(); err != nil {
+		return nil, ErrGeneratingLoadTest(err)
+	}
+	ra := &responseAssertions{
+		assertions:   assertions,
+		descriptions: assertions.Descriptions(),
+	}
+	for _, p := range assertions.JSONPath {
+		jp, err := p.Compile()
+		if err != nil {
+			return nil, ErrGeneratingLoadTest(err)
+		}
+		ra.jsonPaths = append(ra.jsonPaths, jp)
+	}
+	ra.failures = make([]int64, len(ra.descriptions))
+	return ra, nil
+}
+
+// check validates the response answered with the status and body, returning whether it passed
+// every assertion
+func (ra *responseAssertions) check(status int, body []byte) bool {
+	failed := make([]bool, 0, len(ra.descriptions))
+	a := ra.assertions
+	if len(a.Status) > 0 {
+		ok := false
+		for _, s := range a.Status {
+			ok = ok || s == status
+		}
+		failed = append(failed, !ok)
+	}
+	for _, s := range a.BodyContains {
+		failed = append(failed, !bytes.Contains(body, []byte(s)))
+	}
+	if len(ra.jsonPaths) > 0 {
+		var data interface{}
+		parsed := json.Unmarshal(body, &data) == nil
+		for i, jp := range ra.jsonPaths {
+			failed = append(failed, !parsed || !ra.matchJSONPath(jp, data, a.JSONPath[i].Value))
+		}
+	}
+	if a.MaxResponseSize > 0 {
+		failed = append(failed, int64(len(body)) > a.MaxResponseSize)
+	}
+
+	passed := true
+	ra.lock.Lock()
+	defer ra.lock.Unlock()
+	ra.requests++
+	for i, f := range failed {
+		if f {
+			ra.failures[i]++
+			passed = false
+		}
+	}
+	if !passed {
+		ra.failed++
+		if status >= 200 && status < 300 {
+			ra.errors++
+		}
+	}
+	return passed
+}
+
+// matchJSONPath tells whether the expression finds a value in the data, equal to the value when given
+func (ra *responseAssertions) matchJSONPath(jp *jsonpath.JSONPath, data interface{}, value string) bool {
+	ra.jsonPathLock.Lock()
+	results, err := jp.FindResults(data)
+	ra.jsonPathLock.Unlock()
+	if err != nil {
+		return false
+	}
+	for _, result := range results {
+		for _, v := range result {
+			if value == "" || fmt.Sprint(v.Interface()) == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// result returns the summary of the assertions, kept with the result of the test
+func (ra *responseAssertions) result() *models.LoadTestAssertionsResult {
+	ra.lock.Lock()
+	defer ra.lock.Unlock()
+	result := &models.LoadTestAssertionsResult{Requests: ra.requests, Failed: ra.failed, Errors: ra.errors}
+	for i, d := range ra.descriptions {
+		result.Assertions = append(result.Assertions, models.AssertionResult{Assertion: d, Failed: ra.failures[i]})
+	}
+	return result
+}
+
+// mergeAssertionsResults merges the summaries of the assertions of the segments of a test
+func mergeAssertionsResults(results []*models.LoadTestAssertionsResult) *models.LoadTestAssertionsResult {
+	if len(results) == 0 {
+		return nil
+	}
+	merged := &models.LoadTestAssertionsResult{Assertions: append([]models.AssertionResult{}, results[0].Assertions...)}
+	for i, r := range results {
+		merged.Requests += r.Requests
+		merged.Failed += r.Failed
+		merged.Errors += r.Errors
+		if i == 0 {
+			continue
+		}
+		for j := range merged.Assertions {
+			if j < len(r.Assertions) {
+				merged.Assertions[j].Failed += r.Assertions[j].Failed
+			}
+		}
+	}
+	return merged
+}
+
+// assertionsResultFromMap returns the summary of the assertions of the result of a load generator,
+// nil if there is none
+func assertionsResultFromMap(v interface{}) *models.LoadTestAssertionsResult {
+	switch r := v.(type) {
+	case nil:
+		return nil
+	case *models.LoadTestAssertionsResult:
+		return r
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	r := &models.LoadTestAssertionsResult{}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil
+	}
+	return r
+}
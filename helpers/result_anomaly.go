@@ -0,0 +1,143 @@
+package helpers
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// ResultAnomalyDetector flags the performance results whose p99 latency deviates from the mean of the
+// last results of their profile by more than a number of standard deviations, publishing an event for each
+type ResultAnomalyDetector struct {
+	persister *models.ResultAnalysisPersister
+	// threshold is the number of standard deviations, window the number of results of the rolling
+	// history and minSamples the number of results the history needs before results are flagged
+	threshold  float64
+	window     int
+	minSamples int
+
+	subscribers     map[chan *meshes.EventsResponse]struct{}
+	subscribersLock *sync.Mutex
+}
+
+// NewResultAnomalyDetector returns an instance of ResultAnomalyDetector comparing every result with the
+// window results of its profile before it, once the profile has at least minSamples of them
+func NewResultAnomalyDetector(persister *models.ResultAnalysisPersister, threshold float64, window, minSamples int) *ResultAnomalyDetector {
+	if minSamples < 2 {
+		minSamples = 2
+	}
+	if window < minSamples {
+		window = minSamples
+	}
+	return &ResultAnomalyDetector{
+		persister:       persister,
+		threshold:       threshold,
+		window:          window,
+		minSamples:      minSamples,
+		subscribers:     map[chan *meshes.EventsResponse]struct{}{},
+		subscribersLock: &sync.Mutex{},
+	}
+}
+
+// Analyze compares the p99 latency of the result with the history of its profile, records the analysis
+// in the history and publishes an event when the result is an anomaly
+func (d *ResultAnomalyDetector) Analyze(point *models.ResultPoint) (*models.ResultAnalysis, error) {
+	history, err := d.persister.GetProfileHistory(point.ProfileID, d.window)
+	if err != nil {
+		return nil, ErrAnalyzeResult(err)
+	}
+	p99s := make([]float64, 0, len(history))
+	for _, h := range history {
+		p99s = append(p99s, h.P99)
+	}
+
+	analysis := AnalyzeP99(point.P99, p99s, d.threshold, d.minSamples)
+	analysis.ResultID = point.ResultID
+	analysis.ProfileID = point.ProfileID
+	analysis.Name = point.Name
+	if err := d.persister.CreateResultAnalysis(analysis); err != nil {
+		return nil, ErrAnalyzeResult(err)
+	}
+
+	if analysis.Anomaly {
+		d.publish(resultAnomalyEvent(analysis))
+	}
+	return analysis, nil
+}
+
+// Subscribe returns a channel on which an event is published whenever a result is found to be
+// an anomaly, along with a function to cancel the subscription
+func (d *ResultAnomalyDetector) Subscribe() (<-chan *meshes.EventsResponse, func()) {
+	ch := make(chan *meshes.EventsResponse, 10)
+
+	d.subscribersLock.Lock()
+	d.subscribers[ch] = struct{}{}
+	d.subscribersLock.Unlock()
+
+	return ch, func() {
+		d.subscribersLock.Lock()
+		defer d.subscribersLock.Unlock()
+		if _, ok := d.subscribers[ch]; ok {
+			delete(d.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (d *ResultAnomalyDetector) publish(event *meshes.EventsResponse) {
+	d.subscribersLock.Lock()
+	defer d.subscribersLock.Unlock()
+
+	for ch := range d.subscribers {
+		select {
+		case ch <- event:
+		default:
+			logrus.Debug("dropping result anomaly event for a slow subscriber")
+		}
+	}
+}
+
+// AnalyzeP99 compares the p99 latency with those of the history. The standard deviation is floored
+// at 1% of the mean, so that a steady history does not make every slight change an anomaly.
+func AnalyzeP99(p99 float64, history []float64, threshold float64, minSamples int) *models.ResultAnalysis {
+	analysis := &models.ResultAnalysis{P99: p99, Samples: len(history), Threshold: threshold}
+	if len(history) == 0 {
+		return analysis
+	}
+
+	for _, v := range history {
+		analysis.Mean += v
+	}
+	analysis.Mean /= float64(len(history))
+	if len(history) > 1 {
+		variance := 0.0
+		for _, v := range history {
+			variance += (v - analysis.Mean) * (v - analysis.Mean)
+		}
+		analysis.StdDev = math.Sqrt(variance / float64(len(history)-1))
+	}
+
+	stdDev := math.Max(analysis.StdDev, analysis.Mean/100)
+	if stdDev > 0 {
+		analysis.Deviations = (p99 - analysis.Mean) / stdDev
+	}
+	analysis.Anomaly = len(history) >= minSamples && math.Abs(analysis.Deviations) > threshold
+	return analysis
+}
+
+func resultAnomalyEvent(analysis *models.ResultAnalysis) *meshes.EventsResponse {
+	direction := "slower"
+	if analysis.Deviations < 0 {
+		direction = "faster"
+	}
+	return &meshes.EventsResponse{
+		EventType: meshes.EventType_WARN,
+		Summary:   fmt.Sprintf("Performance result %s is an anomaly", analysis.Name),
+		Details: fmt.Sprintf("The p99 latency of %.3fms is %.1f standard deviations %s than the mean of %.3fms of the last %d results of the profile",
+			analysis.P99*1000, math.Abs(analysis.Deviations), direction, analysis.Mean*1000, analysis.Samples),
+	}
+}
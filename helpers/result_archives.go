@@ -0,0 +1,455 @@
+package helpers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	ghodssyaml "github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// resultArchiveBatchSize is the number of results archived at once during a sweep
+const resultArchiveBatchSize = 50
+
+// resultSummaryKeys are the runner results kept in the database for an archived result,
+// enough for listing the results of a profile without retrieving them from the archive
+var resultSummaryKeys = []string{
+	"URL", "load-generator", "RunType", "Labels", "StartTime",
+	"RequestedQPS", "RequestedDuration", "ActualQPS", "ActualDuration", "NumThreads",
+	"grafana-snapshots",
+}
+
+// archivedResultDocument is the document an archived result is stored as: the result in
+// SMP format along with the result as stored by Meshery, for restoring it losslessly
+type archivedResultDocument struct {
+	SMP           json.RawMessage `json:"smp,omitempty"`
+	MesheryResult json.RawMessage `json:"meshery_result"`
+}
+
+// S3Archive stores performance results in an S3 compatible bucket using path style
+// requests signed with AWS Signature Version 4. Besides AWS S3 this works with MinIO and
+// with Google Cloud Storage through its interoperability endpoint and HMAC keys.
+type S3Archive struct {
+	endpoint  string
+	bucket    string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+// NewS3Archive returns an S3Archive storing results in the bucket of the given region.
+// The AWS S3 endpoint of the region is used when no endpoint is given.
+func NewS3Archive(endpoint, bucket, region, accessKey, secretKey string) *S3Archive {
+	if region == "" {
+		region = "us-east-1"
+	}
+	if endpoint == "" {
+		endpoint = "https://s3." + region + ".amazonaws.com"
+	}
+	return &S3Archive{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		bucket:    bucket,
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// Name returns the name of the archive
+func (s *S3Archive) Name() string {
+	return "s3://" + s.bucket
+}
+
+// Put uploads the data as the object with the given key
+func (s *S3Archive) Put(ctx context.Context, key string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return archiveResponseError(resp)
+}
+
+// Get downloads the object with the given key
+func (s *S3Archive) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := archiveResponseError(resp); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (s *S3Archive) do(ctx context.Context, method, key string, data []byte) (*http.Response, error) {
+	path := "/" + s.bucket + "/" + escapeObjectKey(key)
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, path, data, time.Now().UTC())
+	return s.client.Do(req)
+}
+
+// sign adds the AWS Signature Version 4 authorization of the request to its headers
+func (s *S3Archive) sign(req *http.Request, path string, payload []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		"",
+		"host:" + req.URL.Host + "\nx-amz-content-sha256:" + payloadHash + "\nx-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	for _, part := range []string{s.region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", s.accessKey, scope, signedHeaders, signature))
+}
+
+// AzureBlobArchive stores performance results as block blobs of an Azure Storage
+// container, authorizing the requests with a shared access signature
+type AzureBlobArchive struct {
+	containerURL string
+	sasToken     string
+	client       *http.Client
+}
+
+// NewAzureBlobArchive returns an AzureBlobArchive storing results in the container at the
+// given URL, e.g. https://<account>.blob.core.windows.net/<container>
+func NewAzureBlobArchive(containerURL, sasToken string) *AzureBlobArchive {
+	return &AzureBlobArchive{
+		containerURL: strings.TrimSuffix(containerURL, "/"),
+		sasToken:     strings.TrimPrefix(sasToken, "?"),
+		client:       &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// Name returns the name of the archive
+func (a *AzureBlobArchive) Name() string {
+	return a.containerURL
+}
+
+// Put uploads the data as the blob with the given key
+func (a *AzureBlobArchive) Put(ctx context.Context, key string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, a.blobURL(key), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+	req.Header.Set("x-ms-version", "2020-04-08")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return archiveResponseError(resp)
+}
+
+// Get downloads the blob with the given key
+func (a *AzureBlobArchive) Get(ctx context.Context, key string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.blobURL(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-ms-version", "2020-04-08")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := archiveResponseError(resp); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (a *AzureBlobArchive) blobURL(key string) string {
+	u := a.containerURL + "/" + escapeObjectKey(key)
+	if a.sasToken != "" {
+		u += "?" + a.sasToken
+	}
+	return u
+}
+
+// ResultArchiver periodically moves the performance results older than a threshold from
+// the database to a result archive, leaving a summary of each result in the database
+type ResultArchiver struct {
+	persister *models.MesheryResultsPersister
+	archive   models.ResultArchive
+	after     time.Duration
+	interval  time.Duration
+	jobs      *JobTracker
+}
+
+// NewResultArchiver returns an instance of ResultArchiver which archives the results whose
+// test started more than after ago, checking for such results once every interval. Every sweep
+// archiving results is tracked as a job.
+func NewResultArchiver(persister *models.MesheryResultsPersister, archive models.ResultArchive, after, interval time.Duration, jobs *JobTracker) *ResultArchiver {
+	return &ResultArchiver{
+		persister: persister,
+		archive:   archive,
+		after:     after,
+		interval:  interval,
+		jobs:      jobs,
+	}
+}
+
+// Run archives the results due on every tick until the context is cancelled
+func (a *ResultArchiver) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		job := a.jobs.Start(ctx, models.JobResultArchive, "Archive the performance results to "+a.archive.Name(), "", true)
+		count, err := a.Sweep(job)
+		if err != nil {
+			logrus.Error(err)
+		}
+		if count > 0 {
+			logrus.Infof("Archived %d performance results to %s", count, a.archive.Name())
+		}
+		if count == 0 && err == nil {
+			job.Discard()
+		} else {
+			job.Finish(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep archives every result due, reporting the results archived on the job, until the job is
+// cancelled, and returns the number of results archived
+func (a *ResultArchiver) Sweep(job models.JobReporter) (int, error) {
+	ctx := job.Context()
+	count := 0
+	for {
+		results, err := a.persister.GetResultsStartedBefore(time.Now().Add(-a.after), resultArchiveBatchSize)
+		if err != nil {
+			return count, ErrArchiveResult(err, a.archive.Name())
+		}
+		for _, result := range results {
+			if result == nil {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return count, err
+			}
+			if err := a.archiveResult(ctx, result); err != nil {
+				return count, err
+			}
+			count++
+			job.Progress(count, 0)
+			job.Message(fmt.Sprintf("%d results archived", count))
+		}
+		if len(results) < resultArchiveBatchSize {
+			return count, nil
+		}
+	}
+}
+
+func (a *ResultArchiver) archiveResult(ctx context.Context, result *models.MesheryResult) error {
+	data, err := ArchiveDocument(result)
+	if err != nil {
+		return ErrArchiveResult(err, a.archive.Name())
+	}
+	key := ResultArchiveKey(result)
+	if err := a.archive.Put(ctx, key, data); err != nil {
+		return ErrArchiveResult(err, a.archive.Name())
+	}
+
+	// The result is only replaced by its summary once it is safely in the archive
+	summary := resultSummary(result.Result)
+	summary[models.ArchivedResultKey] = models.ArchivedResult{
+		Archive:    a.archive.Name(),
+		Key:        key,
+		ArchivedAt: time.Now().UTC(),
+	}
+	result.Result = summary
+	if err := a.persister.UpdateResult(result); err != nil {
+		return ErrArchiveResult(err, a.archive.Name())
+	}
+	return nil
+}
+
+// ResultArchiveKey returns the key the result is archived under
+func ResultArchiveKey(result *models.MesheryResult) string {
+	profile := "none"
+	if result.PerformanceProfile != nil {
+		profile = result.PerformanceProfile.String()
+	}
+	return "results/" + profile + "/" + result.ID.String() + ".smp.json.gz"
+}
+
+// ArchiveDocument returns the gzip compressed document the result is archived as
+func ArchiveDocument(result *models.MesheryResult) ([]byte, error) {
+	full, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	document := archivedResultDocument{MesheryResult: full}
+	if document.SMP, err = SMPDocument(result); err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(raw); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// RestoreArchivedResult returns the result as it was before being archived, retrieving it
+// from the archive. Results which were not archived are returned as is.
+func RestoreArchivedResult(ctx context.Context, archive models.ResultArchive, result *models.MesheryResult) (*models.MesheryResult, error) {
+	archived, ok := models.ArchivedResultOf(result)
+	if !ok {
+		return result, nil
+	}
+	if archive == nil {
+		return nil, ErrRestoreArchivedResult(fmt.Errorf("no result archive is configured"), archived.Archive)
+	}
+	if archive.Name() != archived.Archive {
+		return nil, ErrRestoreArchivedResult(fmt.Errorf("the configured result archive is %s", archive.Name()), archived.Archive)
+	}
+
+	data, err := archive.Get(ctx, archived.Key)
+	if err != nil {
+		return nil, ErrRestoreArchivedResult(err, archived.Archive)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrRestoreArchivedResult(err, archived.Archive)
+	}
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, ErrRestoreArchivedResult(err, archived.Archive)
+	}
+	document := archivedResultDocument{}
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return nil, ErrRestoreArchivedResult(err, archived.Archive)
+	}
+	restored := &models.MesheryResult{}
+	if err := json.Unmarshal(document.MesheryResult, restored); err != nil {
+		return nil, ErrRestoreArchivedResult(err, archived.Archive)
+	}
+	return restored, nil
+}
+
+// resultSummary returns the runner results kept in the database once the result is archived.
+// The latency histogram is kept without its buckets, which make up most of the result.
+func resultSummary(result map[string]interface{}) map[string]interface{} {
+	summary := map[string]interface{}{}
+	for _, key := range resultSummaryKeys {
+		if value, ok := result[key]; ok {
+			summary[key] = value
+		}
+	}
+	if histogram, ok := result["DurationHistogram"].(map[string]interface{}); ok {
+		h := map[string]interface{}{}
+		for key, value := range histogram {
+			if key != "Data" {
+				h[key] = value
+			}
+		}
+		summary["DurationHistogram"] = h
+	}
+	return summary
+}
+
+func archiveResponseError(resp *http.Response) error {
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+func escapeObjectKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// SMPDocument returns the result as an SMP document in JSON, none for the results of other than
+// HTTP tests which cannot be converted to SMP
+func SMPDocument(result *models.MesheryResult) (json.RawMessage, error) {
+	full, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	// The conversion to SMP modifies the result, so it is done on a copy
+	converted := &models.MesheryResult{}
+	if err := json.Unmarshal(full, converted); err != nil {
+		return nil, err
+	}
+	if runType, _ := converted.Result["RunType"].(string); runType != "HTTP" {
+		return nil, nil
+	}
+	spec, err := converted.ConvertToSpec()
+	if err != nil {
+		return nil, err
+	}
+	smp, err := yaml.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	return ghodssyaml.YAMLToJSON(smp)
+}
@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// resultHookResponseLimit is the size up to which the responses of result hooks are read
+const resultHookResponseLimit = 1 << 20
+
+// WebhookPostProcessor post-processes performance results by posting them to a result hook
+type WebhookPostProcessor struct {
+	hook   *models.ResultHook
+	client *http.Client
+}
+
+// NewWebhookPostProcessor returns a WebhookPostProcessor invoking the given hook
+func NewWebhookPostProcessor(hook *models.ResultHook) *WebhookPostProcessor {
+	return &WebhookPostProcessor{
+		hook:   hook,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns the name of the hook
+func (p *WebhookPostProcessor) Name() string {
+	return p.hook.Name
+}
+
+// Process posts the event to the hook, signed with its secret, and returns the annotations it answered with
+func (p *WebhookPostProcessor) Process(ctx context.Context, event *models.ResultHookEvent) (models.ResultAnnotations, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, ErrInvokeResultHook(err, p.Name())
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, ErrInvokeResultHook(err, p.Name())
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.hook.Secret != "" {
+		req.Header.Set(models.WebhookSignatureHeader, WebhookSignature(p.hook.Secret, body))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, ErrInvokeResultHook(err, p.Name())
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, resultHookResponseLimit))
+	if err != nil {
+		return nil, ErrInvokeResultHook(err, p.Name())
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, ErrInvokeResultHook(fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(data))), p.Name())
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+	response := models.ResultHookResponse{}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, ErrInvokeResultHook(fmt.Errorf("invalid response: %v", err), p.Name())
+	}
+	return response.Annotations, nil
+}
+
+// WebhookSignature returns the hex HMAC-SHA256 of the body with the secret, which result hooks and
+// validation webhooks verify the requests they receive with
+func WebhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,365 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"fortio.org/fortio/periodic"
+	"github.com/layer5io/meshery/models"
+	"github.com/lib/pq"
+)
+
+// ResultMeasurement is the name of the InfluxDB measurement performance results are written to
+const ResultMeasurement = "meshery_perf_result"
+
+// NewResultPoint flattens the runner results of a completed load test into a ResultPoint
+func NewResultPoint(result *periodic.RunnerResults) *models.ResultPoint {
+	point := &models.ResultPoint{
+		StartTime: result.StartTime,
+		Duration:  result.ActualDuration,
+		QPS:       result.ActualQPS,
+	}
+	if h := result.DurationHistogram; h != nil {
+		point.Requests = h.Count
+		point.Min = h.Min
+		point.Max = h.Max
+		point.Average = h.Avg
+		for _, p := range h.Percentiles {
+			switch p.Percentile {
+			case 50:
+				point.P50 = p.Value
+			case 90:
+				point.P90 = p.Value
+			case 99:
+				point.P99 = p.Value
+			}
+		}
+		// fortio does not compute the p95 latency by default
+		point.P95, _ = runnerPercentile(result, 95)
+	}
+	return point
+}
+
+// ResultErrors returns the count of the requests of the result answered with other than a 2xx status,
+// or the SERVING status of gRPC health checks, along with those failing an assertion of the test
+func ResultErrors(resultsMap map[string]interface{}) int64 {
+	var failed float64
+	count := func(code string, n float64) {
+		if !(len(code) == 3 && code[0] == '2') && code != "SERVING" {
+			failed += n
+		}
+	}
+	switch codes := resultsMap["RetCodes"].(type) {
+	case map[string]interface{}:
+		for code, n := range codes {
+			if n, ok := n.(float64); ok {
+				count(code, n)
+			}
+		}
+	case map[string]float64:
+		for code, n := range codes {
+			count(code, n)
+		}
+	}
+	if assertions := assertionsResultFromMap(resultsMap["assertions"]); assertions != nil {
+		failed += float64(assertions.Errors)
+	}
+	return int64(failed)
+}
+
+// InfluxDBSink writes performance results to InfluxDB using the line protocol.
+// When an organization is given the InfluxDB 2.x API is used and the database is
+// treated as the bucket, otherwise the 1.x API is used.
+type InfluxDBSink struct {
+	url      string
+	database string
+	org      string
+	token    string
+	client   *http.Client
+}
+
+// NewInfluxDBSink returns an InfluxDBSink writing to the InfluxDB server at the given URL
+func NewInfluxDBSink(serverURL, database, org, token string) *InfluxDBSink {
+	return &InfluxDBSink{
+		url:      strings.TrimSuffix(serverURL, "/"),
+		database: database,
+		org:      org,
+		token:    token,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns the name of the sink
+func (s *InfluxDBSink) Name() string {
+	return "influxdb"
+}
+
+// Write writes the result point to InfluxDB
+func (s *InfluxDBSink) Write(ctx context.Context, point *models.ResultPoint) error {
+	q := url.Values{}
+	q.Set("precision", "ns")
+	endpoint := s.url + "/write"
+	if s.org != "" {
+		endpoint = s.url + "/api/v2/write"
+		q.Set("org", s.org)
+		q.Set("bucket", s.database)
+	} else {
+		q.Set("db", s.database)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"?"+q.Encode(), bytes.NewBufferString(InfluxLine(point)))
+	if err != nil {
+		return ErrWriteResultSink(err, s.Name())
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Token "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return ErrWriteResultSink(err, s.Name())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(resp.Body)
+		return ErrWriteResultSink(fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(body))), s.Name())
+	}
+	return nil
+}
+
+// InfluxLine renders the result point as a single line of the InfluxDB line protocol
+func InfluxLine(point *models.ResultPoint) string {
+	var b strings.Builder
+	b.WriteString(ResultMeasurement)
+	for _, tag := range [][2]string{
+		{"name", point.Name},
+		{"mesh", point.Mesh},
+		{"load_generator", point.LoadGenerator},
+		{"profile_id", point.ProfileID},
+	} {
+		if tag[1] == "" {
+			continue
+		}
+		b.WriteString("," + tag[0] + "=" + influxTagEscaper.Replace(tag[1]))
+	}
+
+	b.WriteString(" result_id=\"" + influxFieldEscaper.Replace(point.ResultID) + "\"")
+	b.WriteString(",requests=" + strconv.FormatInt(point.Requests, 10) + "i")
+	for _, field := range []struct {
+		key   string
+		value float64
+	}{
+		{"qps", point.QPS},
+		{"duration_seconds", point.Duration.Seconds()},
+		{"latency_min", point.Min},
+		{"latency_max", point.Max},
+		{"latency_avg", point.Average},
+		{"latency_p50", point.P50},
+		{"latency_p90", point.P90},
+		{"latency_p99", point.P99},
+	} {
+		b.WriteString("," + field.key + "=" + strconv.FormatFloat(field.value, 'f', -1, 64))
+	}
+
+	b.WriteString(" " + strconv.FormatInt(point.StartTime.UnixNano(), 10) + "\n")
+	return b.String()
+}
+
+var (
+	influxTagEscaper   = strings.NewReplacer(",", `\,`, " ", `\ `, "=", `\=`)
+	influxFieldEscaper = strings.NewReplacer(`"`, `\"`, `\`, `\\`)
+)
+
+// TimescaleSink writes performance results to a TimescaleDB hypertable
+type TimescaleSink struct {
+	db    *sql.DB
+	table string
+
+	ready     bool
+	readyLock sync.Mutex
+}
+
+// NewTimescaleSink returns a TimescaleSink writing to the given table of the
+// database identified by the postgres connection string
+func NewTimescaleSink(dsn, table string) (*TimescaleSink, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, ErrWriteResultSink(err, "timescaledb")
+	}
+	return &TimescaleSink{
+		db:    db,
+		table: table,
+	}, nil
+}
+
+// Name returns the name of the sink
+func (s *TimescaleSink) Name() string {
+	return "timescaledb"
+}
+
+// Write inserts the result point into the hypertable, creating it on first use
+func (s *TimescaleSink) Write(ctx context.Context, point *models.ResultPoint) error {
+	if err := s.ensureTable(ctx); err != nil {
+		return ErrWriteResultSink(err, s.Name())
+	}
+
+	_, err := s.db.ExecContext(ctx, `INSERT INTO `+pq.QuoteIdentifier(s.table)+` (
+		time, result_id, profile_id, name, mesh, load_generator, duration_seconds, qps, requests,
+		latency_min, latency_max, latency_avg, latency_p50, latency_p90, latency_p99
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+		point.StartTime, point.ResultID, point.ProfileID, point.Name, point.Mesh, point.LoadGenerator,
+		point.Duration.Seconds(), point.QPS, point.Requests,
+		point.Min, point.Max, point.Average, point.P50, point.P90, point.P99,
+	)
+	if err != nil {
+		return ErrWriteResultSink(err, s.Name())
+	}
+	return nil
+}
+
+func (s *TimescaleSink) ensureTable(ctx context.Context) error {
+	s.readyLock.Lock()
+	defer s.readyLock.Unlock()
+
+	if s.ready {
+		return nil
+	}
+
+	_, err := s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+pq.QuoteIdentifier(s.table)+` (
+		time TIMESTAMPTZ NOT NULL,
+		result_id TEXT,
+		profile_id TEXT,
+		name TEXT,
+		mesh TEXT,
+		load_generator TEXT,
+		duration_seconds DOUBLE PRECISION,
+		qps DOUBLE PRECISION,
+		requests BIGINT,
+		latency_min DOUBLE PRECISION,
+		latency_max DOUBLE PRECISION,
+		latency_avg DOUBLE PRECISION,
+		latency_p50 DOUBLE PRECISION,
+		latency_p90 DOUBLE PRECISION,
+		latency_p99 DOUBLE PRECISION
+	)`)
+	if err != nil {
+		return err
+	}
+	if _, err = s.db.ExecContext(ctx, `SELECT create_hypertable($1, 'time', if_not_exists => TRUE)`, s.table); err != nil {
+		return err
+	}
+
+	s.ready = true
+	return nil
+}
+
+// PushgatewaySink pushes the summary metrics of performance results to a Prometheus Pushgateway,
+// grouped by profile so that the latest result of every profile is kept for alerting
+type PushgatewaySink struct {
+	url    string
+	job    string
+	client *http.Client
+}
+
+// NewPushgatewaySink returns a PushgatewaySink pushing to the Pushgateway at the given URL under
+// the job. Basic auth credentials are taken from the user info of the URL.
+func NewPushgatewaySink(serverURL, job string) *PushgatewaySink {
+	return &PushgatewaySink{
+		url:    strings.TrimSuffix(serverURL, "/"),
+		job:    job,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns the name of the sink
+func (s *PushgatewaySink) Name() string {
+	return "pushgateway"
+}
+
+// Write replaces the metrics of the group of the profile of the result point with those of the point
+func (s *PushgatewaySink) Write(ctx context.Context, point *models.ResultPoint) error {
+	endpoint := s.url + "/metrics/" + pushgatewayGroupingKey("job", s.job) + "/" + pushgatewayGroupingKey("profile_id", point.ProfileID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewBufferString(PushgatewayMetrics(point)))
+	if err != nil {
+		return ErrWriteResultSink(err, s.Name())
+	}
+	req.Header.Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return ErrWriteResultSink(err, s.Name())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(resp.Body)
+		return ErrWriteResultSink(fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(body))), s.Name())
+	}
+	return nil
+}
+
+// PushgatewayMetrics renders the result point as gauges of the Prometheus text exposition format,
+// the latencies in seconds. The profile ID is left to the grouping key the metrics are pushed under.
+func PushgatewayMetrics(point *models.ResultPoint) string {
+	labels := fmt.Sprintf(`name="%s",mesh="%s",load_generator="%s"`,
+		prometheusLabelEscaper.Replace(point.Name),
+		prometheusLabelEscaper.Replace(point.Mesh),
+		prometheusLabelEscaper.Replace(point.LoadGenerator))
+
+	var b strings.Builder
+	gauge := func(name, help string, samples ...[2]string) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+		for _, sample := range samples {
+			fmt.Fprintf(&b, "%s{%s%s} %s\n", name, labels, sample[0], sample[1])
+		}
+	}
+	value := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	gauge("meshery_perf_latency_seconds", "Latency percentiles of the most recent result of the performance profile.",
+		[2]string{`,quantile="0.5"`, value(point.P50)},
+		[2]string{`,quantile="0.9"`, value(point.P90)},
+		[2]string{`,quantile="0.95"`, value(point.P95)},
+		[2]string{`,quantile="0.99"`, value(point.P99)},
+	)
+	gauge("meshery_perf_requests_per_second", "Requests per second of the most recent result of the performance profile.",
+		[2]string{"", value(point.QPS)})
+	errorRatio := 0.0
+	if point.Requests > 0 {
+		errorRatio = float64(point.Errors) / float64(point.Requests)
+	}
+	gauge("meshery_perf_error_ratio", "Ratio of the requests of the most recent result of the performance profile which failed.",
+		[2]string{"", value(errorRatio)})
+	gauge("meshery_perf_duration_seconds", "Duration of the most recent result of the performance profile.",
+		[2]string{"", value(point.Duration.Seconds())})
+	gauge("meshery_perf_result_timestamp_seconds", "Start time of the most recent result of the performance profile, in seconds since the epoch.",
+		[2]string{"", strconv.FormatInt(point.StartTime.Unix(), 10)})
+	return b.String()
+}
+
+// pushgatewayGroupingKey returns the path segments of a label of the grouping key, encoding in base64
+// the values the path cannot hold
+func pushgatewayGroupingKey(label, value string) string {
+	switch {
+	case value == "":
+		return label + "@base64/="
+	case strings.Contains(value, "/"):
+		return label + "@base64/" + base64.URLEncoding.EncodeToString([]byte(value))
+	}
+	return label + "/" + url.PathEscape(value)
+}
+
+var prometheusLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
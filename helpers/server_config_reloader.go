@@ -0,0 +1,261 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// ServerConfigReloader applies the changes of the configuration of Meshery Server while it runs: the
+// remote providers, the adapters, the log level and the feature flags. The configuration file, when
+// set, is read again on every reload, which lets the ConfigMap it is mounted from on Kubernetes be
+// edited rather than the environment of the pod.
+type ServerConfigReloader struct {
+	configFile  string
+	handler     *models.HandlerConfig
+	newProvider func(providerURL string) (models.Provider, error)
+	// featureFlags are the setters of the features which can be toggled
+	featureFlags map[string]func(enabled bool)
+
+	config models.ServerConfig
+	lock   *sync.Mutex
+}
+
+// NewServerConfigReloader returns an instance of ServerConfigReloader applying the configuration to
+// the handler config, starting from the given one. The remote providers are created with newProvider.
+func NewServerConfigReloader(configFile string, initial models.ServerConfig, handler *models.HandlerConfig, newProvider func(providerURL string) (models.Provider, error), featureFlags map[string]func(enabled bool)) *ServerConfigReloader {
+	if initial.FeatureFlags == nil {
+		initial.FeatureFlags = map[string]bool{}
+	}
+	return &ServerConfigReloader{
+		configFile:   configFile,
+		handler:      handler,
+		newProvider:  newProvider,
+		featureFlags: featureFlags,
+		config:       initial,
+		lock:         &sync.Mutex{},
+	}
+}
+
+// Config returns the configuration in effect
+func (r *ServerConfigReloader) Config() models.ServerConfig {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return copyServerConfig(r.config)
+}
+
+// Reload reads the configuration file again, if any, and applies it along with the update, returning
+// the settings which changed. Nothing is applied when a setting is invalid.
+func (r *ServerConfigReloader) Reload(update *models.ServerConfig) (*models.ServerConfigReload, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	next := copyServerConfig(r.config)
+	if r.configFile != "" {
+		if err := readServerConfigFile(r.configFile, &next); err != nil {
+			return nil, ErrReloadServerConfig(err)
+		}
+	}
+	if update != nil {
+		mergeServerConfig(&next, update)
+	}
+	if err := r.validate(&next); err != nil {
+		return nil, ErrReloadServerConfig(err)
+	}
+
+	changes := []models.ServerConfigChange{}
+	if change, ok := listChange("adapter_urls", r.config.AdapterURLs, next.AdapterURLs); ok {
+		r.applyAdapters(r.config.AdapterURLs, next.AdapterURLs)
+		changes = append(changes, change)
+	}
+	if change, ok := listChange("provider_urls", r.config.ProviderURLs, next.ProviderURLs); ok {
+		if err := r.applyProviders(r.config.ProviderURLs, next.ProviderURLs); err != nil {
+			return nil, ErrReloadServerConfig(err)
+		}
+		changes = append(changes, change)
+	}
+	if next.LogLevel != r.config.LogLevel {
+		level, _ := logrus.ParseLevel(next.LogLevel)
+		logrus.SetLevel(level)
+		changes = append(changes, models.ServerConfigChange{Setting: "log_level", Old: r.config.LogLevel, New: next.LogLevel})
+	}
+	flags := make([]string, 0, len(next.FeatureFlags))
+	for flag := range next.FeatureFlags {
+		flags = append(flags, flag)
+	}
+	sort.Strings(flags)
+	for _, flag := range flags {
+		old, enabled := r.config.FeatureFlags[flag], next.FeatureFlags[flag]
+		if old == enabled {
+			continue
+		}
+		r.featureFlags[flag](enabled)
+		changes = append(changes, models.ServerConfigChange{Setting: "feature_flags." + flag, Old: strconv.FormatBool(old), New: strconv.FormatBool(enabled)})
+	}
+
+	r.config = next
+	for _, change := range changes {
+		logrus.Infof("Server configuration reloaded: %s changed from %q to %q", change.Setting, change.Old, change.New)
+	}
+	return &models.ServerConfigReload{Config: copyServerConfig(next), Changes: changes}, nil
+}
+
+// validate checks the settings of the configuration before any of them is applied
+func (r *ServerConfigReloader) validate(config *models.ServerConfig) error {
+	for _, providerURL := range config.ProviderURLs {
+		if u, err := url.Parse(providerURL); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid provider URL %q", providerURL)
+		}
+	}
+	if _, err := logrus.ParseLevel(config.LogLevel); err != nil {
+		return err
+	}
+	for flag := range config.FeatureFlags {
+		if _, ok := r.featureFlags[flag]; !ok {
+			return fmt.Errorf("unknown feature flag %q, expected one of %s", flag, strings.Join(r.featureFlagNames(), ", "))
+		}
+	}
+	return nil
+}
+
+// applyAdapters adds the adapters which were not tracked and removes those no longer configured
+func (r *ServerConfigReloader) applyAdapters(old, next []string) {
+	ctx := context.Background()
+	for _, location := range old {
+		if !containsString(next, location) {
+			r.handler.AdapterTracker.RemoveAdapter(ctx, models.Adapter{Location: location})
+		}
+	}
+	for _, location := range next {
+		if !containsString(old, location) {
+			r.handler.AdapterTracker.AddAdapter(ctx, models.Adapter{Location: location})
+		}
+	}
+}
+
+// applyProviders creates the remote providers which were not configured and stops those no longer
+// configured. The providers are swapped at once, the requests in flight keeping those they started with.
+func (r *ServerConfigReloader) applyProviders(old, next []string) error {
+	providers := make(map[string]models.Provider, len(r.handler.Providers))
+	for name, provider := range r.handler.Providers {
+		providers[name] = provider
+	}
+
+	removed := []models.Provider{}
+	for name, provider := range providers {
+		remote, ok := provider.(*models.RemoteProvider)
+		if ok && containsString(old, remote.RemoteProviderURL) && !containsString(next, remote.RemoteProviderURL) {
+			delete(providers, name)
+			removed = append(removed, provider)
+		}
+	}
+	for _, providerURL := range next {
+		if containsString(old, providerURL) {
+			continue
+		}
+		provider, err := r.newProvider(providerURL)
+		if err != nil {
+			return err
+		}
+		providers[provider.Name()] = provider
+	}
+
+	r.handler.Providers = providers
+	for _, provider := range removed {
+		provider.(*models.RemoteProvider).StopSyncPreferences()
+	}
+	return nil
+}
+
+// featureFlagNames returns the names of the feature flags, sorted
+func (r *ServerConfigReloader) featureFlagNames() []string {
+	names := make([]string, 0, len(r.featureFlags))
+	for name := range r.featureFlags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// readServerConfigFile sets the settings found in the configuration file, named as the environment
+// variables of the server, on the configuration
+func readServerConfigFile(path string, config *models.ServerConfig) error {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+	if v.IsSet("PROVIDER_BASE_URLS") {
+		config.ProviderURLs = v.GetStringSlice("PROVIDER_BASE_URLS")
+	}
+	if v.IsSet("ADAPTER_URLS") {
+		config.AdapterURLs = v.GetStringSlice("ADAPTER_URLS")
+	}
+	if v.IsSet("LOG_LEVEL") {
+		config.LogLevel = v.GetString("LOG_LEVEL")
+	}
+	for flag, enabled := range v.GetStringMap("FEATURE_FLAGS") {
+		b, err := strconv.ParseBool(fmt.Sprint(enabled))
+		if err != nil {
+			return fmt.Errorf("invalid value %v of the feature flag %q", enabled, flag)
+		}
+		config.FeatureFlags[flag] = b
+	}
+	return nil
+}
+
+// mergeServerConfig sets the settings of the update on the configuration
+func mergeServerConfig(config, update *models.ServerConfig) {
+	if update.ProviderURLs != nil {
+		config.ProviderURLs = update.ProviderURLs
+	}
+	if update.AdapterURLs != nil {
+		config.AdapterURLs = update.AdapterURLs
+	}
+	if update.LogLevel != "" {
+		config.LogLevel = update.LogLevel
+	}
+	for flag, enabled := range update.FeatureFlags {
+		config.FeatureFlags[flag] = enabled
+	}
+}
+
+// copyServerConfig returns a copy of the configuration sharing none of its slices and maps
+func copyServerConfig(config models.ServerConfig) models.ServerConfig {
+	c := config
+	c.ProviderURLs = append([]string{}, config.ProviderURLs...)
+	c.AdapterURLs = append([]string{}, config.AdapterURLs...)
+	c.FeatureFlags = make(map[string]bool, len(config.FeatureFlags))
+	for flag, enabled := range config.FeatureFlags {
+		c.FeatureFlags[flag] = enabled
+	}
+	return c
+}
+
+// listChange returns the change of a setting holding a list, the order of which does not matter
+func listChange(setting string, old, next []string) (models.ServerConfigChange, bool) {
+	o, n := append([]string{}, old...), append([]string{}, next...)
+	sort.Strings(o)
+	sort.Strings(n)
+	if strings.Join(o, " ") == strings.Join(n, " ") {
+		return models.ServerConfigChange{}, false
+	}
+	return models.ServerConfigChange{Setting: setting, Old: strings.Join(old, " "), New: strings.Join(next, " ")}, true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,282 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/layer5io/meshery/models"
+)
+
+const (
+	// targetProbes is the number of probe requests sent to the target of a load test before it starts
+	targetProbes = 3
+	// targetProbeTimeout is how long a probe request waits for the target to answer
+	targetProbeTimeout = 10 * time.Second
+	// targetProbeBodySnippet is how much of the body of a failed probe is quoted in its diagnosis
+	targetProbeBodySnippet = 200
+)
+
+// ProbeLoadTestTarget sends a few probe requests to the target of the load test, as the test sends
+// them, before the load generator starts. It fails with the diagnosis of the first probe which
+// fails: the host cannot be resolved or reached, its certificate is not trusted, or it answers with
+// a status other than those expected, any status below 400 when none is. The targets of gRPC tests
+// are not probed.
+func ProbeLoadTestTarget(opts *models.LoadTestOptions, expectStatus []int) error {
+	switch opts.SupportedLoadTestMethods {
+	case models.WebSocket:
+		return probeWebSocketTarget(opts)
+	case models.HTTP, 0:
+	default:
+		return nil
+	}
+
+	httpOpts, err := sharedHTTPOptions(opts)
+	if err != nil {
+		return err
+	}
+	httpOpts.Init(httpOpts.URL)
+	base, err := url.Parse(strings.TrimLeft(httpOpts.URL, " \t\r\n"))
+	if err != nil {
+		return err
+	}
+
+	requests, err := probeRequests(opts, base)
+	if err != nil {
+		return err
+	}
+
+	transport := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: httpOpts.Insecure},
+	}
+	if socket := httpOpts.UnixDomainSocket; socket != "" {
+		transport.Proxy = nil
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+		}
+	}
+	client := &http.Client{Timeout: targetProbeTimeout, Transport: transport}
+	headers := httpOpts.GenerateHeaders()
+
+	for _, request := range requests {
+		if err := probeHTTPTarget(client, headers, request, expectStatus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// probeRequest is a probe request, its path and body rendered
+type probeRequest struct {
+	method string
+	target string
+	body   []byte
+}
+
+// probeRequests returns the probe requests of the test: the requests of its mix in turn, else the
+// request of the test, with its body rendered when it is a template
+func probeRequests(opts *models.LoadTestOptions, base *url.URL) ([]*probeRequest, error) {
+	var body *BodyTemplate
+	var mix []*templatedRequest
+	var err error
+	for _, r := range opts.RequestMix {
+		request := &templatedRequest{method: strings.ToUpper(r.Method), base: base}
+		if request.method == "" {
+			request.method = http.MethodGet
+		}
+		if request.path, err = NewBodyTemplate(r.Path, opts.TemplateData); err != nil {
+			return nil, err
+		}
+		if r.Body != "" {
+			if request.body, err = NewBodyTemplate(r.Body, opts.TemplateData); err != nil {
+				return nil, err
+			}
+		}
+		mix = append(mix, request)
+	}
+	if len(mix) == 0 && opts.BodyTemplate {
+		if body, err = NewBodyTemplate(string(opts.Body), opts.TemplateData); err != nil {
+			return nil, err
+		}
+	}
+
+	requests := make([]*probeRequest, 0, targetProbes)
+	for i := 0; i < targetProbes; i++ {
+		if len(mix) == 0 {
+			request := &probeRequest{method: http.MethodGet, target: base.String(), body: opts.Body}
+			if body != nil {
+				if request.body, err = body.Render(); err != nil {
+					return nil, err
+				}
+			}
+			// fortio posts the body of the test unless given another method
+			if opts.Method != "" {
+				request.method = opts.HTTPMethod()
+			} else if len(request.body) > 0 {
+				request.method = http.MethodPost
+			}
+			requests = append(requests, request)
+			continue
+		}
+
+		r := mix[i%len(mix)]
+		path, err := r.path.Render()
+		if err != nil {
+			return nil, err
+		}
+		ref, err := url.Parse(string(path))
+		if err != nil {
+			return nil, err
+		}
+		request := &probeRequest{method: r.method, target: base.ResolveReference(ref).String()}
+		if r.body != nil {
+			if request.body, err = r.body.Render(); err != nil {
+				return nil, err
+			}
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// probeHTTPTarget sends the probe request, diagnosing its failure
+func probeHTTPTarget(client *http.Client, headers http.Header, request *probeRequest, expectStatus []int) error {
+	var body io.Reader
+	if len(request.body) > 0 {
+		body = bytes.NewReader(request.body)
+	}
+	req, err := http.NewRequest(request.method, request.target, body)
+	if err != nil {
+		return err
+	}
+	req.Header = headers.Clone()
+	if host := headers.Get("Host"); host != "" {
+		req.Host = host
+	}
+
+	probe := request.method + " " + request.target
+	resp, err := client.Do(req)
+	if err != nil {
+		return diagnoseProbeError(probe, err)
+	}
+	defer resp.Body.Close()
+	if statusExpected(resp.StatusCode, expectStatus) {
+		return nil
+	}
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, targetProbeBodySnippet))
+	return diagnoseProbeStatus(probe, resp, expectStatus, strings.TrimSpace(string(snippet)))
+}
+
+// probeWebSocketTarget opens a connection to the target of a WebSocket test, diagnosing its failure
+func probeWebSocketTarget(opts *models.LoadTestOptions) error {
+	header := http.Header{}
+	if opts.Headers != nil {
+		for k, v := range *opts.Headers {
+			header.Add(k, v)
+		}
+	}
+	if opts.Cookies != nil {
+		cookies := []string{}
+		for k, v := range *opts.Cookies {
+			cookies = append(cookies, fmt.Sprintf("%s=%s", k, v))
+		}
+		header.Set("Cookie", strings.Join(cookies, "; "))
+	}
+	dialer := &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: targetProbeTimeout,
+		TLSClientConfig:  &tls.Config{InsecureSkipVerify: opts.IsInsecure},
+	}
+	if socket := opts.UnixSocket; socket != "" {
+		dialer.Proxy = nil
+		dialer.NetDialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+		}
+	}
+
+	conn, resp, err := dialer.Dial(opts.URL, header)
+	if err != nil {
+		if resp != nil {
+			defer resp.Body.Close()
+			snippet, _ := io.ReadAll(io.LimitReader(resp.Body, targetProbeBodySnippet))
+			return diagnoseProbeStatus("WebSocket handshake with "+opts.URL, resp, []int{http.StatusSwitchingProtocols}, strings.TrimSpace(string(snippet)))
+		}
+		return diagnoseProbeError("WebSocket handshake with "+opts.URL, err)
+	}
+	return conn.Close()
+}
+
+// statusExpected tells whether the status is among those expected, or below 400 when none is
+func statusExpected(status int, expectStatus []int) bool {
+	if len(expectStatus) == 0 {
+		return status < http.StatusBadRequest
+	}
+	for _, s := range expectStatus {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// diagnoseProbeError tells why a probe request got no answer
+func diagnoseProbeError(probe string, err error) error {
+	var dnsErr *net.DNSError
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidCert x509.CertificateInvalidError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &dnsErr):
+		return fmt.Errorf("%s failed: the host %s cannot be resolved from Meshery Server", probe, dnsErr.Name)
+	case errors.As(err, &unknownAuthority):
+		return fmt.Errorf("%s failed: the certificate of the target is signed by an authority Meshery Server does not trust", probe)
+	case errors.As(err, &hostnameErr):
+		return fmt.Errorf("%s failed: the certificate of the target is not valid for %s", probe, hostnameErr.Host)
+	case errors.As(err, &invalidCert):
+		return fmt.Errorf("%s failed: the certificate of the target is invalid: %v", probe, invalidCert)
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return fmt.Errorf("%s failed: the connection was refused, nothing listens at the address of the target", probe)
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return fmt.Errorf("%s failed: the target did not answer within %s", probe, targetProbeTimeout)
+	}
+	return fmt.Errorf("%s failed: %v", probe, err)
+}
+
+// diagnoseProbeStatus tells why the status a probe request was answered with is not expected
+func diagnoseProbeStatus(probe string, resp *http.Response, expectStatus []int, body string) error {
+	expected := "a status below 400"
+	if len(expectStatus) > 0 {
+		statuses := make([]string, 0, len(expectStatus))
+		for _, s := range expectStatus {
+			statuses = append(statuses, strconv.Itoa(s))
+		}
+		expected = strings.Join(statuses, " or ")
+	}
+	diagnosis := fmt.Sprintf("%s was answered with %s, expected %s", probe, resp.Status, expected)
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		diagnosis += ": the target requires authentication, check the headers and cookies of the test"
+	case http.StatusNotFound:
+		diagnosis += ": check the path of the URL of the test"
+	case http.StatusMethodNotAllowed:
+		diagnosis += ": the target does not accept the method of the requests of the test"
+	}
+	if body != "" {
+		diagnosis += fmt.Sprintf(" (response: %q)", body)
+	}
+	return errors.New(diagnosis)
+}
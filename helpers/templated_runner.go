@@ -0,0 +1,270 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"fortio.org/fortio/fhttp"
+	"fortio.org/fortio/periodic"
+	"fortio.org/fortio/stats"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// templatedRequestRunner sends a request with a freshly rendered body on every
+// call of the periodic runner. Fortio only substitutes {uuid} in request bodies,
+// so templated tests are run with this runner on top of fortio's periodic engine
+// and report the same results as fortio's own HTTP runner. The requests of a
+// request mix are sent in turn following their schedule. The runner validates the
+// responses with the assertions of the test, if any, which fortio cannot.
+type templatedRequestRunner struct {
+	client     *http.Client
+	headers    http.Header
+	requests   []*templatedRequest
+	schedule   []int
+	seq        uint64
+	assertions *responseAssertions
+
+	lock        sync.Mutex
+	retCodes    map[int]int64
+	sizes       *stats.Histogram
+	headerSizes *stats.Histogram
+}
+
+// templatedRequest is a request sent by the templatedRequestRunner, its path, when
+// templated, being resolved against the URL of the test. The payload is sent as is
+// when the body is not a template.
+type templatedRequest struct {
+	method  string
+	base    *url.URL
+	path    *BodyTemplate
+	body    *BodyTemplate
+	payload []byte
+
+	sent   int64
+	failed int64
+}
+
+// Run sends a single request, to be called by the periodic runner
+func (r *templatedRequestRunner) Run(_ int) {
+	request := r.requests[0]
+	if len(r.schedule) > 0 {
+		seq := atomic.AddUint64(&r.seq, 1) - 1
+		request = r.requests[r.schedule[seq%uint64(len(r.schedule))]]
+	}
+	code, size, headerSize, passed := r.send(request)
+	atomic.AddInt64(&request.sent, 1)
+	if code < 200 || code >= 400 || !passed {
+		atomic.AddInt64(&request.failed, 1)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.retCodes[code]++
+	r.sizes.Record(float64(size))
+	r.headerSizes.Record(float64(headerSize))
+}
+
+// send returns the status code and the sizes of the body and headers of the
+// response, the code being -1 when no response was received like for fortio, and
+// whether the response passed the assertions
+func (r *templatedRequestRunner) send(request *templatedRequest) (int, int, int, bool) {
+	target := request.base.String()
+	if request.path != nil {
+		path, err := request.path.Render()
+		if err != nil {
+			logrus.Error(err)
+			return -1, 0, 0, true
+		}
+		ref, err := url.Parse(string(path))
+		if err != nil {
+			logrus.Error(ErrRunningTest(err))
+			return -1, 0, 0, true
+		}
+		target = request.base.ResolveReference(ref).String()
+	}
+	var body io.Reader
+	if request.body != nil {
+		rendered, err := request.body.Render()
+		if err != nil {
+			logrus.Error(err)
+			return -1, 0, 0, true
+		}
+		body = bytes.NewReader(rendered)
+	} else if len(request.payload) > 0 {
+		body = bytes.NewReader(request.payload)
+	}
+
+	req, err := http.NewRequest(request.method, target, body)
+	if err != nil {
+		logrus.Error(ErrRunningTest(err))
+		return -1, 0, 0, true
+	}
+	req.Header = r.headers.Clone()
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		logrus.Debugf("templated request to %s failed: %v", target, err)
+		return -1, 0, 0, true
+	}
+	defer resp.Body.Close()
+
+	headerSize := 0
+	for k, values := range resp.Header {
+		for _, v := range values {
+			// name, ": " and CRLF framing every header line
+			headerSize += len(k) + len(v) + 4
+		}
+	}
+	if r.assertions == nil {
+		size, _ := io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, int(size), headerSize, true
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logrus.Debugf("templated request to %s failed: %v", target, err)
+		return -1, len(data), headerSize, true
+	}
+	return resp.StatusCode, len(data), headerSize, r.assertions.check(resp.StatusCode, data)
+}
+
+// runTemplatedHTTPTest runs an HTTP load test rendering the request body from the
+// body template for every request, or sending the payload of the test as fortio
+// does when there is no template. The requests use the given method, else POST
+// when they have a body and GET otherwise as with fortio.
+func runTemplatedHTTPTest(ro periodic.RunnerOptions, httpOpts *fhttp.HTTPOptions, method string, template *BodyTemplate, assertions *responseAssertions) (*fhttp.HTTPRunnerResults, error) {
+	base, err := url.Parse(httpOpts.URL)
+	if err != nil {
+		return nil, err
+	}
+	request := &templatedRequest{method: http.MethodPost, base: base, body: template}
+	if template == nil {
+		request.payload = httpOpts.Payload
+		if len(request.payload) == 0 {
+			request.method = http.MethodGet
+		}
+	}
+	if method != "" {
+		request.method = strings.ToUpper(method)
+	}
+	result, _, err := runTemplatedRequests(ro, httpOpts, []*templatedRequest{request}, nil, assertions)
+	return result, err
+}
+
+// runRequestMixHTTPTest runs an HTTP load test sending the requests of the mix in proportion
+// to their weights, returning the requests sent and failed for every request of the mix
+func runRequestMixHTTPTest(ro periodic.RunnerOptions, httpOpts *fhttp.HTTPOptions, mix models.RequestMix, templateData []byte, assertions *responseAssertions) (*fhttp.HTTPRunnerResults, []map[string]interface{}, error) {
+	base, err := url.Parse(strings.TrimLeft(httpOpts.URL, " \t\r\n"))
+	if err != nil {
+		return nil, nil, err
+	}
+	requests := make([]*templatedRequest, 0, len(mix))
+	for _, r := range mix {
+		request := &templatedRequest{method: strings.ToUpper(r.Method), base: base}
+		if request.method == "" {
+			request.method = http.MethodGet
+		}
+		if request.path, err = NewBodyTemplate(r.Path, templateData); err != nil {
+			return nil, nil, err
+		}
+		if r.Body != "" {
+			if request.body, err = NewBodyTemplate(r.Body, templateData); err != nil {
+				return nil, nil, err
+			}
+		}
+		requests = append(requests, request)
+	}
+
+	result, requests, err := runTemplatedRequests(ro, httpOpts, requests, requestMixSchedule(mix), assertions)
+	if err != nil {
+		return nil, nil, err
+	}
+	perRequest := make([]map[string]interface{}, 0, len(mix))
+	for i, r := range mix {
+		perRequest = append(perRequest, map[string]interface{}{
+			"method": requests[i].method,
+			"path":   r.Path,
+			"weight": r.Weight,
+			"sent":   requests[i].sent,
+			"failed": requests[i].failed,
+		})
+	}
+	return result, perRequest, nil
+}
+
+// requestMixSchedule returns the order the requests of the mix are sent in, spreading every
+// request evenly over the total weight of the mix with the smooth weighted round-robin of nginx
+// rather than sending all the requests of a kind in a row
+func requestMixSchedule(mix models.RequestMix) []int {
+	total := mix.TotalWeight()
+	current := make([]int, len(mix))
+	schedule := make([]int, 0, total)
+	for len(schedule) < total {
+		best := 0
+		for i, r := range mix {
+			current[i] += r.Weight
+			if current[i] > current[best] {
+				best = i
+			}
+		}
+		current[best] -= total
+		schedule = append(schedule, best)
+	}
+	return schedule
+}
+
+func runTemplatedRequests(ro periodic.RunnerOptions, httpOpts *fhttp.HTTPOptions, requests []*templatedRequest, schedule []int, assertions *responseAssertions) (*fhttp.HTTPRunnerResults, []*templatedRequest, error) {
+	ro.RunType = "HTTP"
+	httpOpts.Init(httpOpts.URL)
+
+	transport := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		MaxIdleConnsPerHost: ro.NumThreads,
+		// Insecure test endpoints are opted in explicitly like for fortio
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: httpOpts.Insecure},
+	}
+	if socket := httpOpts.UnixDomainSocket; socket != "" {
+		transport.Proxy = nil
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+		}
+	}
+	runner := &templatedRequestRunner{
+		client: &http.Client{
+			Timeout:   httpOpts.HTTPReqTimeOut,
+			Transport: transport,
+		},
+		headers:     httpOpts.GenerateHeaders(),
+		requests:    requests,
+		schedule:    schedule,
+		assertions:  assertions,
+		retCodes:    map[int]int64{},
+		sizes:       stats.NewHistogram(0, 100),
+		headerSizes: stats.NewHistogram(0, 5),
+	}
+
+	r := periodic.NewPeriodicRunner(&ro)
+	defer r.Options().Abort()
+	r.Options().MakeRunners(runner)
+
+	result := &fhttp.HTTPRunnerResults{
+		RunnerResults: r.Run(),
+		URL:           httpOpts.URL,
+	}
+	r.Options().ReleaseRunners()
+
+	runner.lock.Lock()
+	defer runner.lock.Unlock()
+	result.RetCodes = runner.retCodes
+	result.Sizes = runner.sizes.Export()
+	result.HeaderSizes = runner.headerSizes.Export()
+	return result, requests, nil
+}
@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/models"
+)
+
+func TestRequestMixSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []int
+		want    []int
+	}{
+		{name: "single request", weights: []int{3}, want: []int{0, 0, 0}},
+		{name: "equal weights", weights: []int{1, 1, 1}, want: []int{0, 1, 2}},
+		// the requests of a kind are spread over the mix rather than sent in a row
+		{name: "uneven weights", weights: []int{5, 1, 1}, want: []int{0, 0, 1, 0, 2, 0, 0}},
+		{name: "request without weight", weights: []int{2, 0, 1}, want: []int{0, 2, 0}},
+	}
+	for _, tt := range tests {
+		mix := models.RequestMix{}
+		for _, w := range tt.weights {
+			mix = append(mix, models.WeightedRequest{Weight: w, Path: "/"})
+		}
+		if got := requestMixSchedule(mix); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got schedule %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRequestMixScheduleProportions(t *testing.T) {
+	mix := models.RequestMix{{Weight: 70, Path: "/products"}, {Weight: 20, Path: "/product/1"}, {Weight: 10, Method: "POST", Path: "/cart"}}
+	schedule := requestMixSchedule(mix)
+	if len(schedule) != mix.TotalWeight() {
+		t.Fatalf("got a schedule of %d requests, want %d", len(schedule), mix.TotalWeight())
+	}
+
+	counts := make([]int, len(mix))
+	run, longest := 0, 0
+	for i, r := range schedule {
+		counts[r]++
+		if i > 0 && schedule[i-1] == r {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+	for i, r := range mix {
+		if counts[i] != r.Weight {
+			t.Errorf("request %s is sent %d times, want its weight %d", r.Path, counts[i], r.Weight)
+		}
+	}
+	// with 70% of the requests to /products, at most 3 follow each other before another is sent
+	if longest > 3 {
+		t.Errorf("%d requests of a kind are sent in a row, want them spread over the mix", longest)
+	}
+}
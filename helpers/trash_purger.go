@@ -0,0 +1,58 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// TrashPurger periodically deletes the designs and performance profiles kept in the trash
+// for longer than its retention
+type TrashPurger struct {
+	persister *models.TrashPersister
+	interval  time.Duration
+	jobs      *JobTracker
+}
+
+// NewTrashPurger returns an instance of TrashPurger which purges the expired items of the
+// trash once every interval, every purge deleting items being tracked as a job
+func NewTrashPurger(persister *models.TrashPersister, interval time.Duration, jobs *JobTracker) *TrashPurger {
+	return &TrashPurger{
+		persister: persister,
+		interval:  interval,
+		jobs:      jobs,
+	}
+}
+
+// Run purges the expired items on every tick until the context is cancelled
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		job := p.jobs.Start(ctx, models.JobTrashPurge, "Purge the trash of the items deleted more than "+p.persister.Retention.String()+" ago", "", false)
+		count, err := p.persister.Purge()
+		if err != nil {
+			err = ErrPurgeTrash(err)
+			logrus.Error(err)
+		}
+		if count > 0 {
+			logrus.Infof("Purged %d designs and performance profiles deleted more than %s ago", count, p.persister.Retention)
+			job.Progress(int(count), int(count))
+			job.Message(fmt.Sprintf("%d designs and performance profiles purged", count))
+		}
+		if count == 0 && err == nil {
+			job.Discard()
+		} else {
+			job.Finish(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"text/template"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+const week = 7 * 24 * time.Hour
+
+// TrendReporter reports weekly on the rate of change of the p99 latency of every profile with results
+// over the last weeks, from the history of the results the anomaly detector records
+type TrendReporter struct {
+	analyses *models.ResultAnalysisPersister
+	reports  *models.TrendReportPersister
+	// weeks is the number of weeks a report covers and threshold the relative increase of the p99
+	// latency over them beyond which the profile regressed
+	weeks     int
+	threshold float64
+	interval  time.Duration
+}
+
+// NewTrendReporter returns an instance of TrendReporter checking every interval for the reports of the
+// week just completed, each covering the given number of weeks
+func NewTrendReporter(analyses *models.ResultAnalysisPersister, reports *models.TrendReportPersister, weeks int, threshold float64, interval time.Duration) *TrendReporter {
+	if weeks < 1 {
+		weeks = 1
+	}
+	return &TrendReporter{
+		analyses:  analyses,
+		reports:   reports,
+		weeks:     weeks,
+		threshold: threshold,
+		interval:  interval,
+	}
+}
+
+// Run generates the missing reports on every tick until the context is cancelled
+func (r *TrendReporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if count, err := r.GenerateReports(time.Now()); err != nil {
+			logrus.Error(err)
+		} else if count > 0 {
+			logrus.Infof("Generated the performance trend reports of %d profiles", count)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// GenerateReports generates the reports of the profiles with results in the period ending with the last
+// week completed at the given time, those already generated excepted, and returns how many it generated
+func (r *TrendReporter) GenerateReports(now time.Time) (int, error) {
+	end := WeekStart(now)
+	start := end.Add(-time.Duration(r.weeks) * week)
+	analyses, err := r.analyses.GetAnalysesBetween(start, end)
+	if err != nil {
+		return 0, ErrGenerateTrendReport(err)
+	}
+
+	profiles := map[string][]models.ResultAnalysis{}
+	for _, analysis := range analyses {
+		if analysis.ProfileID != "" {
+			profiles[analysis.ProfileID] = append(profiles[analysis.ProfileID], analysis)
+		}
+	}
+
+	count := 0
+	for profileID, history := range profiles {
+		exists, err := r.reports.HasTrendReport(profileID, end)
+		if err != nil {
+			return count, ErrGenerateTrendReport(err)
+		}
+		if exists {
+			continue
+		}
+		// the analyses are the latest first, the latest result naming the profile
+		report := NewTrendReport(profileID, history[0].Name, history, end, r.weeks, r.threshold)
+		if err := r.reports.SaveTrendReport(report); err != nil {
+			return count, ErrGenerateTrendReport(err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// Report returns the report of the profile over the weeks up to the given time, including the week in
+// progress, for the profiles whose weekly report is not generated yet
+func (r *TrendReporter) Report(profileID, name string, now time.Time) (*models.TrendReport, error) {
+	end := WeekStart(now).Add(week)
+	analyses, err := r.analyses.GetAnalysesBetween(end.Add(-time.Duration(r.weeks)*week), end)
+	if err != nil {
+		return nil, ErrGenerateTrendReport(err)
+	}
+	history := []models.ResultAnalysis{}
+	for _, analysis := range analyses {
+		if analysis.ProfileID == profileID {
+			history = append(history, analysis)
+		}
+	}
+	return NewTrendReport(profileID, name, history, end, r.weeks, r.threshold), nil
+}
+
+// WeekStart returns the start of the week of the given time, Monday 00:00 UTC
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// NewTrendReport computes the report of the profile from the analyses of its results completed in the
+// given number of weeks ending at periodEnd, a profile regressing when its p99 latency grows over the
+// period by more than threshold relative to its mean
+func NewTrendReport(profileID, name string, analyses []models.ResultAnalysis, periodEnd time.Time, weeks int, threshold float64) *models.TrendReport {
+	report := &models.TrendReport{
+		ProfileID:   profileID,
+		Name:        name,
+		PeriodStart: periodEnd.Add(-time.Duration(weeks) * week),
+		PeriodEnd:   periodEnd,
+		Threshold:   threshold,
+		Weeks:       models.TrendWeeks{},
+	}
+
+	buckets := make([][]float64, weeks)
+	var xs, ys []float64
+	for _, analysis := range analyses {
+		if analysis.CreatedAt == nil || analysis.CreatedAt.Before(report.PeriodStart) || !analysis.CreatedAt.Before(periodEnd) {
+			continue
+		}
+		i := int(analysis.CreatedAt.Sub(report.PeriodStart) / week)
+		buckets[i] = append(buckets[i], analysis.P99)
+		xs = append(xs, analysis.CreatedAt.Sub(report.PeriodStart).Hours()/24/7)
+		ys = append(ys, analysis.P99)
+	}
+
+	for i, p99s := range buckets {
+		w := models.TrendWeek{Start: report.PeriodStart.Add(time.Duration(i) * week), Results: len(p99s)}
+		if len(p99s) > 0 {
+			w.P99Mean, w.P99Variance = meanVariance(p99s)
+			w.P99Median = median(append([]float64{}, p99s...))
+		}
+		report.Weeks = append(report.Weeks, w)
+	}
+
+	report.Results = len(ys)
+	if report.Results == 0 {
+		return report
+	}
+	report.P99Mean, report.P99Variance = meanVariance(ys)
+	report.P99Slope, report.HasTrend = leastSquaresSlope(xs, ys)
+	if report.HasTrend && report.P99Mean > 0 {
+		report.P99Change = report.P99Slope * float64(weeks) / report.P99Mean
+		report.Regression = report.P99Change > threshold
+	}
+	return report
+}
+
+// meanVariance returns the mean and the population variance of the values
+func meanVariance(values []float64) (float64, float64) {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	var squares float64
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, squares / float64(len(values))
+}
+
+// leastSquaresSlope returns the slope of the least-squares fit of ys over xs, and false when there
+// are fewer than two points or all of them share the same x
+func leastSquaresSlope(xs, ys []float64) (float64, bool) {
+	if len(xs) < 2 {
+		return 0, false
+	}
+	meanX, _ := meanVariance(xs)
+	meanY, _ := meanVariance(ys)
+	var sxx, sxy float64
+	for i := range xs {
+		dx := xs[i] - meanX
+		sxx += dx * dx
+		sxy += dx * (ys[i] - meanY)
+	}
+	if sxx == 0 {
+		return 0, false
+	}
+	return sxy / sxx, true
+}
+
+var trendReportFuncs = map[string]interface{}{
+	"ms":      func(seconds float64) string { return fmt.Sprintf("%.3fms", seconds*1000) },
+	"ms2":     func(seconds2 float64) string { return fmt.Sprintf("%.3fms²", seconds2*1e6) },
+	"slope":   func(seconds float64) string { return fmt.Sprintf("%+.3fms/week", seconds*1000) },
+	"percent": func(ratio float64) string { return fmt.Sprintf("%+.1f%%", ratio*100) },
+	"date":    func(t time.Time) string { return t.UTC().Format("2006-01-02") },
+}
+
+var trendReportMarkdown = template.Must(template.New("trend").Funcs(trendReportFuncs).Parse(`# Performance trend of {{ .Name }}
+
+From {{ date .PeriodStart }} to {{ date .PeriodEnd }}, {{ .Results }} results.
+{{ if not .HasTrend }}
+Not enough results to compute a trend.
+{{- else if .Regression }}
+**Regression**: the p99 latency grew by {{ percent .P99Change }} over the period, more than the threshold of {{ percent .Threshold }}.
+{{- else }}
+No regression: the p99 latency changed by {{ percent .P99Change }} over the period, within the threshold of {{ percent .Threshold }}.
+{{- end }}
+
+| Statistic | Value |
+|---|---|
+| p99 mean | {{ ms .P99Mean }} |
+| p99 variance | {{ ms2 .P99Variance }} |
+| p99 slope | {{ slope .P99Slope }} |
+| p99 change | {{ percent .P99Change }} |
+
+| Week | Results | p99 median | p99 mean | p99 variance |
+|---|---|---|---|---|
+{{- range .Weeks }}
+| {{ date .Start }} | {{ .Results }} | {{ if .Results }}{{ ms .P99Median }} | {{ ms .P99Mean }} | {{ ms2 .P99Variance }}{{ else }}- | - | -{{ end }} |
+{{- end }}
+`))
+
+var trendReportHTML = htmltemplate.Must(htmltemplate.New("trend").Funcs(trendReportFuncs).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Performance trend of {{ .Name }}</title></head>
+<body>
+<h1>Performance trend of {{ .Name }}</h1>
+<p>From {{ date .PeriodStart }} to {{ date .PeriodEnd }}, {{ .Results }} results.</p>
+{{- if not .HasTrend }}
+<p>Not enough results to compute a trend.</p>
+{{- else if .Regression }}
+<p><strong>Regression</strong>: the p99 latency grew by {{ percent .P99Change }} over the period, more than the threshold of {{ percent .Threshold }}.</p>
+{{- else }}
+<p>No regression: the p99 latency changed by {{ percent .P99Change }} over the period, within the threshold of {{ percent .Threshold }}.</p>
+{{- end }}
+<table>
+<tr><th>Statistic</th><th>Value</th></tr>
+<tr><td>p99 mean</td><td>{{ ms .P99Mean }}</td></tr>
+<tr><td>p99 variance</td><td>{{ ms2 .P99Variance }}</td></tr>
+<tr><td>p99 slope</td><td>{{ slope .P99Slope }}</td></tr>
+<tr><td>p99 change</td><td>{{ percent .P99Change }}</td></tr>
+</table>
+<table>
+<tr><th>Week</th><th>Results</th><th>p99 median</th><th>p99 mean</th><th>p99 variance</th></tr>
+{{- range .Weeks }}
+<tr><td>{{ date .Start }}</td><td>{{ .Results }}</td>{{ if .Results }}<td>{{ ms .P99Median }}</td><td>{{ ms .P99Mean }}</td><td>{{ ms2 .P99Variance }}</td>{{ else }}<td>-</td><td>-</td><td>-</td>{{ end }}</tr>
+{{- end }}
+</table>
+</body>
+</html>
+`))
+
+// RenderTrendReport renders the report as markdown or as HTML
+func RenderTrendReport(report *models.TrendReport, html bool) (string, error) {
+	var b bytes.Buffer
+	var err error
+	if html {
+		err = trendReportHTML.Execute(&b, report)
+	} else {
+		err = trendReportMarkdown.Execute(&b, report)
+	}
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
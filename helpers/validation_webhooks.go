@@ -0,0 +1,125 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// DesignValidator validates the manifests of a design with the validation webhooks before it is deployed
+type DesignValidator struct {
+	webhooks []models.ValidationWebhook
+	client   *http.Client
+
+	userID   string
+	designID string
+	cluster  string
+	dryRun   bool
+}
+
+// NewDesignValidator returns a DesignValidator calling the enabled webhooks among the given ones
+// about the design deployed by the user to the cluster, or only verified when dryRun is set
+func NewDesignValidator(webhooks []models.ValidationWebhook, userID, designID, cluster string, dryRun bool) *DesignValidator {
+	return &DesignValidator{
+		webhooks: webhooks,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		userID:   userID,
+		designID: designID,
+		cluster:  cluster,
+		dryRun:   dryRun,
+	}
+}
+
+// ValidateManifests posts the manifests to every enabled webhook, in order, and returns an error listing
+// the reasons of the webhooks denying them. A webhook failing denies the manifests unless its failure
+// policy is Ignore.
+func (v *DesignValidator) ValidateManifests(ctx context.Context, designName string, manifests []map[string]interface{}) error {
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(&models.DesignValidationRequest{
+		UID:        uid.String(),
+		DryRun:     v.dryRun,
+		UserID:     v.userID,
+		DesignID:   v.designID,
+		DesignName: designName,
+		Cluster:    v.cluster,
+		Manifests:  manifests,
+	})
+	if err != nil {
+		return err
+	}
+
+	var reasons []string
+	for i := range v.webhooks {
+		webhook := &v.webhooks[i]
+		if webhook.Disabled {
+			continue
+		}
+		response, err := v.validate(ctx, webhook, body)
+		if err != nil {
+			if webhook.FailurePolicy == models.ValidationWebhookIgnore {
+				logrus.Warn(err)
+				continue
+			}
+			return err
+		}
+		if response.Allowed {
+			continue
+		}
+
+		message := response.Message
+		if message == "" {
+			message = "denied"
+		}
+		reasons = append(reasons, webhook.Name+": "+message)
+		for _, violation := range response.Violations {
+			reasons = append(reasons, webhook.Name+": "+violation.String())
+		}
+	}
+	if len(reasons) > 0 {
+		return ErrDesignDenied(reasons)
+	}
+	return nil
+}
+
+// validate posts the body to the webhook, signed with its secret, and returns its response
+func (v *DesignValidator) validate(ctx context.Context, webhook *models.ValidationWebhook, body []byte) (*models.DesignValidationResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, ErrInvokeValidationWebhook(err, webhook.Name)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if webhook.Secret != "" {
+		req.Header.Set(models.WebhookSignatureHeader, WebhookSignature(webhook.Secret, body))
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, ErrInvokeValidationWebhook(err, webhook.Name)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, resultHookResponseLimit))
+	if err != nil {
+		return nil, ErrInvokeValidationWebhook(err, webhook.Name)
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, ErrInvokeValidationWebhook(fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(data))), webhook.Name)
+	}
+	response := &models.DesignValidationResponse{}
+	if err := json.Unmarshal(data, response); err != nil {
+		return nil, ErrInvokeValidationWebhook(fmt.Errorf("invalid response: %v", err), webhook.Name)
+	}
+	return response, nil
+}
@@ -0,0 +1,256 @@
+package helpers
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"fortio.org/fortio/periodic"
+	"fortio.org/fortio/stats"
+	"github.com/gorilla/websocket"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultWSMessageSize is the size in bytes of the messages sent when none is given
+const defaultWSMessageSize = 64
+
+// WebSocketRunnerResults is the result of a WebSocket load test. The embedded
+// runner results hold the round trip times of the messages as duration histogram
+// so that WebSocket results are charted like the HTTP ones.
+type WebSocketRunnerResults struct {
+	periodic.RunnerResults
+	URL                  string
+	ConnectLatency       *stats.HistogramData
+	Connections          int
+	ConnectErrors        int64
+	MessagesSent         int64
+	MessagesReceived     int64
+	MessageErrors        int64
+	MessageSize          int
+	RequestedMessageRate float64
+	RampUp               string
+}
+
+// webSocketStats aggregates the measurements of all connections
+type webSocketStats struct {
+	lock             sync.Mutex
+	connect          *stats.Histogram
+	rtt              *stats.Histogram
+	connectErrors    int64
+	messagesSent     int64
+	messagesReceived int64
+	messageErrors    int64
+}
+
+// WebSocketLoadTest opens one WebSocket connection per thread, spread evenly over
+// the ramp up, each sending messages of the given size at the given rate and
+// waiting for the reply of every message to measure its round trip time. A rate
+// of zero sends the next message as soon as the reply to the previous one arrives.
+func WebSocketLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if !opts.Assertions.Empty() {
+		return nil, nil, ErrAssertionsUnsupported("WebSocket tests")
+	}
+	connections := opts.HTTPNumThreads
+	if connections < 1 {
+		connections = 1
+	}
+	rate := opts.WSMessageRate
+	if rate <= 0 && opts.HTTPQPS > 0 {
+		rate = opts.HTTPQPS / float64(connections)
+	}
+	size := opts.WSMessageSize
+	if size <= 0 {
+		size = defaultWSMessageSize
+	}
+	if opts.WSRampUp >= opts.Duration {
+		return nil, nil, ErrGeneratingLoadTest(fmt.Errorf("the ramp up of %s must be shorter than the test duration of %s", opts.WSRampUp, opts.Duration))
+	}
+
+	header := http.Header{}
+	if opts.Headers != nil {
+		for k, v := range *opts.Headers {
+			header.Add(k, v)
+		}
+	}
+	if opts.Cookies != nil {
+		cookies := []string{}
+		for k, v := range *opts.Cookies {
+			cookies = append(cookies, fmt.Sprintf("%s=%s", k, v))
+		}
+		header.Set("Cookie", strings.Join(cookies, "; "))
+	}
+	dialer := &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:  &tls.Config{InsecureSkipVerify: opts.IsInsecure},
+	}
+	if socket := opts.UnixSocket; socket != "" {
+		dialer.Proxy = nil
+		dialer.NetDialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+		}
+	}
+
+	resolution := periodic.DefaultRunnerOptions.Resolution
+	ws := &webSocketStats{
+		connect: stats.NewHistogram(0, resolution),
+		rtt:     stats.NewHistogram(0, resolution),
+	}
+
+	logrus.Infof("Starting WebSocket test for %s with %d connections over %s for %s", opts.URL, connections, opts.WSRampUp, opts.Duration)
+	start := time.Now()
+	ctx, cancel := context.WithDeadline(loadTestContext(opts), start.Add(opts.Duration))
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i := 0; i < connections; i++ {
+		delay := opts.WSRampUp * time.Duration(i) / time.Duration(connections)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return
+			}
+			ws.runConnection(ctx, dialer, opts.URL, header, rate, size)
+		}()
+	}
+	wg.Wait()
+	actualDuration := time.Since(start)
+
+	percentiles := []float64{50, 75, 90, 99, 99.9}
+	requestedQPS := "max"
+	if rate > 0 {
+		requestedQPS = strconv.FormatFloat(rate*float64(connections), 'f', -1, 64)
+	}
+	result := &WebSocketRunnerResults{
+		RunnerResults: periodic.RunnerResults{
+			RunType:           "WebSocket",
+			Labels:            opts.Name + " -_- " + opts.URL,
+			StartTime:         start,
+			RequestedQPS:      requestedQPS,
+			RequestedDuration: opts.Duration.String(),
+			ActualQPS:         float64(ws.messagesReceived) / actualDuration.Seconds(),
+			ActualDuration:    actualDuration,
+			NumThreads:        connections,
+			DurationHistogram: ws.rtt.Export().CalcPercentiles(percentiles),
+		},
+		URL:                  opts.URL,
+		ConnectLatency:       ws.connect.Export().CalcPercentiles(percentiles),
+		Connections:          connections,
+		ConnectErrors:        ws.connectErrors,
+		MessagesSent:         ws.messagesSent,
+		MessagesReceived:     ws.messagesReceived,
+		MessageErrors:        ws.messageErrors,
+		MessageSize:          size,
+		RequestedMessageRate: rate,
+		RampUp:               opts.WSRampUp.String(),
+	}
+	if ws.connectErrors == int64(connections) {
+		return nil, nil, ErrRunningTest(fmt.Errorf("none of the %d connections to %s could be established", connections, opts.URL))
+	}
+
+	bd, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, ErrConvertingResultToMap(err)
+	}
+	resultsMap := map[string]interface{}{}
+	if err := json.Unmarshal(bd, &resultsMap); err != nil {
+		return nil, nil, ErrUnmarshal(err, "data to map")
+	}
+	logrus.Debugf("Mapped version of the test: %+#v", resultsMap)
+	return resultsMap, &result.RunnerResults, nil
+}
+
+// runConnection exchanges messages over a single connection until the context is done
+func (ws *webSocketStats) runConnection(ctx context.Context, dialer *websocket.Dialer, url string, header http.Header, rate float64, size int) {
+	connectStart := time.Now()
+	conn, resp, err := dialer.DialContext(ctx, url, header)
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		logrus.Debugf("unable to connect to %s: %v", url, err)
+		ws.lock.Lock()
+		ws.connectErrors++
+		ws.lock.Unlock()
+		return
+	}
+	defer conn.Close()
+	ws.record(ws.connect, time.Since(connectStart))
+
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetReadDeadline(deadline)
+
+	var ticker *time.Ticker
+	if rate > 0 {
+		ticker = time.NewTicker(time.Duration(float64(time.Second) / rate))
+		defer ticker.Stop()
+	}
+
+	payload := []byte(strings.Repeat("x", size))
+	for seq := 0; ; seq++ {
+		if ticker != nil {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				ws.close(conn)
+				return
+			}
+		} else if ctx.Err() != nil {
+			ws.close(conn)
+			return
+		}
+
+		// Number the messages so that replies of echo servers can be told apart when inspected
+		copy(payload, strconv.Itoa(seq))
+		sent := time.Now()
+		_ = conn.SetWriteDeadline(deadline)
+		if err := conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+			ws.countError(ctx)
+			return
+		}
+		ws.lock.Lock()
+		ws.messagesSent++
+		ws.lock.Unlock()
+
+		if _, _, err := conn.ReadMessage(); err != nil {
+			ws.countError(ctx)
+			return
+		}
+		ws.record(ws.rtt, time.Since(sent))
+	}
+}
+
+// record adds the measurement to the histogram, counting replies for the round trips
+func (ws *webSocketStats) record(h *stats.Histogram, d time.Duration) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	h.Record(d.Seconds())
+	if h == ws.rtt {
+		ws.messagesReceived++
+	}
+}
+
+// countError counts a failed message unless it failed because the test ended
+func (ws *webSocketStats) countError(ctx context.Context) {
+	if deadline, ok := ctx.Deadline(); ctx.Err() != nil || (ok && !time.Now().Before(deadline)) {
+		return
+	}
+	ws.lock.Lock()
+	ws.messageErrors++
+	ws.lock.Unlock()
+}
+
+func (ws *webSocketStats) close(conn *websocket.Conn) {
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+}
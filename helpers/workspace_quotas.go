@@ -0,0 +1,219 @@
+package helpers
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/spf13/viper"
+)
+
+// WorkspaceQuotas enforces the quotas of the workspaces of Meshery Server. The users who are
+// members of no workspace are each a workspace of their own, named after them, with the default
+// quotas. The profiles, designs and results are counted in the database of Meshery Server and the
+// concurrent tests as they run. The load test limits set by the admins for a workspace apply to
+// its tests in place of the default ones.
+type WorkspaceQuotas struct {
+	usage    *models.WorkspaceUsagePersister
+	defaults models.WorkspaceQuotas
+	// limits are the load test limits set for the workspaces, defaultLimits applying to the others
+	limits        *models.WorkspaceLoadTestLimitsPersister
+	defaultLimits models.LoadTestLimits
+	// workspaces are the workspaces by the IDs of their members
+	workspaces map[string]*models.Workspace
+
+	// running is the number of tests running by workspace
+	running map[string]int
+	lock    *sync.Mutex
+}
+
+// NewWorkspaceQuotas returns an instance of WorkspaceQuotas enforcing the default quotas on the
+// users who are members of none of the workspaces, and the default load test limits on the
+// workspaces without limits of their own
+func NewWorkspaceQuotas(usage *models.WorkspaceUsagePersister, limits *models.WorkspaceLoadTestLimitsPersister, defaults models.WorkspaceQuotas, defaultLimits models.LoadTestLimits, workspaces []models.Workspace) (*WorkspaceQuotas, error) {
+	q := &WorkspaceQuotas{
+		usage:         usage,
+		defaults:      defaults,
+		limits:        limits,
+		defaultLimits: defaultLimits,
+		workspaces:    map[string]*models.Workspace{},
+		running:       map[string]int{},
+		lock:          &sync.Mutex{},
+	}
+	names := map[string]bool{}
+	for i := range workspaces {
+		ws := &workspaces[i]
+		if ws.Name == "" {
+			return nil, ErrLoadWorkspaces(fmt.Errorf("the workspace with the members %v has no name", ws.Members))
+		}
+		if names[ws.Name] {
+			return nil, ErrLoadWorkspaces(fmt.Errorf("the workspace %s is defined twice", ws.Name))
+		}
+		names[ws.Name] = true
+		for _, member := range ws.Members {
+			if other, ok := q.workspaces[member]; ok {
+				return nil, ErrLoadWorkspaces(fmt.Errorf("%s is a member of both the workspaces %s and %s", member, other.Name, ws.Name))
+			}
+			q.workspaces[member] = ws
+		}
+	}
+	return q, nil
+}
+
+// ReadWorkspacesFile returns the default quotas and the workspaces of the given file, under the
+// default and workspaces keys
+func ReadWorkspacesFile(path string) (models.WorkspaceQuotas, []models.Workspace, error) {
+	defaults := models.WorkspaceQuotas{}
+	workspaces := []models.Workspace{}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return defaults, nil, ErrLoadWorkspaces(err)
+	}
+	if err := v.UnmarshalKey("default", &defaults); err != nil {
+		return defaults, nil, ErrLoadWorkspaces(err)
+	}
+	if err := v.UnmarshalKey("workspaces", &workspaces); err != nil {
+		return defaults, nil, ErrLoadWorkspaces(err)
+	}
+	return defaults, workspaces, nil
+}
+
+// CheckArtifactQuotas returns an error when the default quotas or those of a workspace cap the
+// profiles, designs or results. These are counted in the database of Meshery Server, which does
+// not hold those of the users of the remote providers, so the quotas cannot be enforced when
+// remote providers are configured.
+func CheckArtifactQuotas(defaults models.WorkspaceQuotas, workspaces []models.Workspace) error {
+	if defaults.CapsArtifacts() {
+		return ErrLoadWorkspaces(fmt.Errorf("the default quotas cap the profiles, designs or results, which are stored by the remote providers"))
+	}
+	for _, ws := range workspaces {
+		if ws.Quotas.CapsArtifacts() {
+			return ErrLoadWorkspaces(fmt.Errorf("the quotas of the workspace %s cap the profiles, designs or results, which are stored by the remote providers", ws.Name))
+		}
+	}
+	return nil
+}
+
+// workspace returns the workspace of the user
+func (q *WorkspaceQuotas) workspace(userID string) *models.Workspace {
+	if ws, ok := q.workspaces[userID]; ok {
+		return ws
+	}
+	return &models.Workspace{Name: userID, Members: []string{userID}, Quotas: q.defaults}
+}
+
+// Workspace returns the workspace the user is a member of, false when the user is a member of none
+func (q *WorkspaceQuotas) Workspace(userID string) (models.Workspace, bool) {
+	ws, ok := q.workspaces[userID]
+	if !ok {
+		return models.Workspace{}, false
+	}
+	return *ws, true
+}
+
+// Usage returns the usage of the quotas of the workspace of the user
+func (q *WorkspaceQuotas) Usage(userID string) (*models.WorkspaceUsageReport, error) {
+	ws := q.workspace(userID)
+	usage, err := q.usage.GetUsage(ws.Members)
+	if err != nil {
+		return nil, ErrWorkspaceUsage(err)
+	}
+	q.lock.Lock()
+	usage.ConcurrentTests = q.running[ws.Name]
+	q.lock.Unlock()
+	return &models.WorkspaceUsageReport{
+		Workspace: ws.Name,
+		Members:   ws.Members,
+		Quotas:    ws.Quotas,
+		Usage:     *usage,
+	}, nil
+}
+
+// Check returns a *models.QuotaExceeded error when the workspace of the user has no room for one
+// more artifact of the kind
+func (q *WorkspaceQuotas) Check(userID, kind string) error {
+	report, err := q.Usage(userID)
+	if err != nil {
+		return err
+	}
+	if exceeded := report.Quotas.Check(report.Workspace, kind, &report.Usage); exceeded != nil {
+		return exceeded
+	}
+	return nil
+}
+
+// AcquireTest takes one of the concurrent tests of the workspace of the user, returning the
+// function to call once the test completes
+func (q *WorkspaceQuotas) AcquireTest(userID string) (func(), error) {
+	ws := q.workspace(userID)
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	usage := &models.WorkspaceUsage{ConcurrentTests: q.running[ws.Name]}
+	if exceeded := ws.Quotas.Check(ws.Name, models.QuotaConcurrentTests, usage); exceeded != nil {
+		return nil, exceeded
+	}
+	q.running[ws.Name]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			q.lock.Lock()
+			defer q.lock.Unlock()
+			if q.running[ws.Name]--; q.running[ws.Name] <= 0 {
+				delete(q.running, ws.Name)
+			}
+		})
+	}, nil
+}
+
+// LoadTestLimits returns the load test limits of the workspace of the user
+func (q *WorkspaceQuotas) LoadTestLimits(userID string) (*models.WorkspaceLoadTestLimitsReport, error) {
+	return q.WorkspaceLoadTestLimits(q.workspace(userID).Name)
+}
+
+// WorkspaceLoadTestLimits returns the load test limits of the workspace with the given name, the
+// default ones when it has none of its own
+func (q *WorkspaceQuotas) WorkspaceLoadTestLimits(workspace string) (*models.WorkspaceLoadTestLimitsReport, error) {
+	limits, err := q.limits.GetWorkspaceLoadTestLimits(workspace)
+	if err != nil {
+		return nil, ErrWorkspaceLoadTestLimits(err)
+	}
+	if limits == nil {
+		return &models.WorkspaceLoadTestLimitsReport{Workspace: workspace, Limits: q.defaultLimits, Default: true}, nil
+	}
+	return &models.WorkspaceLoadTestLimitsReport{
+		Workspace: workspace,
+		Limits: models.LoadTestLimits{
+			MaxQPS:         limits.MaxQPS,
+			MaxDuration:    limits.MaxDuration,
+			MaxConnections: limits.MaxConnections,
+		},
+		UpdatedBy: limits.UpdatedBy,
+		UpdatedAt: limits.UpdatedAt,
+	}, nil
+}
+
+// SetLoadTestLimits sets the load test limits of the workspace, nil limits restoring the default
+// ones of Meshery Server
+func (q *WorkspaceQuotas) SetLoadTestLimits(workspace string, limits *models.LoadTestLimits, updatedBy string) error {
+	if limits == nil {
+		if err := q.limits.DeleteWorkspaceLoadTestLimits(workspace); err != nil {
+			return ErrWorkspaceLoadTestLimits(err)
+		}
+		return nil
+	}
+	err := q.limits.SaveWorkspaceLoadTestLimits(&models.WorkspaceLoadTestLimits{
+		Workspace:      workspace,
+		MaxQPS:         limits.MaxQPS,
+		MaxDuration:    limits.MaxDuration,
+		MaxConnections: limits.MaxConnections,
+		UpdatedBy:      updatedBy,
+	})
+	if err != nil {
+		return ErrWorkspaceLoadTestLimits(err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package helpers
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshkit/database"
+)
+
+// newTestWorkspaceQuotas returns the quotas of the payments workspace of alice and bob, persisted
+// to a database migrated to the latest schema, with the default load test limits given
+func newTestWorkspaceQuotas(t *testing.T, db *database.Handler, defaultLimits models.LoadTestLimits) *WorkspaceQuotas {
+	t.Helper()
+	quotas, err := NewWorkspaceQuotas(
+		&models.WorkspaceUsagePersister{DB: db},
+		&models.WorkspaceLoadTestLimitsPersister{DB: db},
+		models.WorkspaceQuotas{},
+		defaultLimits,
+		[]models.Workspace{{Name: "payments", Members: []string{"alice", "bob"}}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return quotas
+}
+
+func newTestWorkspaceDatabase(t *testing.T) *database.Handler {
+	t.Helper()
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s?cache=private&mode=rwc", filepath.Join(t.TempDir(), "mesherydb.sql")),
+		Engine:   database.SQLITE,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.DBClose() })
+	if _, err := models.NewSchemaMigrator(&db, models.SchemaMigrations).Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+	return &db
+}
+
+func TestWorkspaceLoadTestLimits(t *testing.T) {
+	db := newTestWorkspaceDatabase(t)
+	defaults := models.LoadTestLimits{MaxQPS: 100, MaxDuration: 5 * time.Minute}
+	quotas := newTestWorkspaceQuotas(t, db, defaults)
+
+	limitsOf := func(q *WorkspaceQuotas, userID string) *models.WorkspaceLoadTestLimitsReport {
+		t.Helper()
+		report, err := q.LoadTestLimits(userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	if report := limitsOf(quotas, "alice"); report.Workspace != "payments" || !report.Default || report.Limits != defaults {
+		t.Errorf("limits of a workspace without limits of its own are %+v, want the default ones %+v", report, defaults)
+	}
+
+	limits := models.LoadTestLimits{MaxQPS: 500, MaxDuration: 10 * time.Minute, MaxConnections: 64}
+	if err := quotas.SetLoadTestLimits("payments", &limits, "root"); err != nil {
+		t.Fatal(err)
+	}
+	for _, member := range []string{"alice", "bob"} {
+		if report := limitsOf(quotas, member); report.Default || report.Limits != limits || report.UpdatedBy != "root" {
+			t.Errorf("limits of %s are %+v, want those of the payments workspace %+v", member, report, limits)
+		}
+	}
+	if report := limitsOf(quotas, "carol"); report.Workspace != "carol" || !report.Default || report.Limits != defaults {
+		t.Errorf("limits of a user in no workspace are %+v, want the default ones", report)
+	}
+
+	// the limits are kept across restarts of Meshery Server
+	if report := limitsOf(newTestWorkspaceQuotas(t, db, defaults), "alice"); report.Limits != limits {
+		t.Errorf("limits of the payments workspace after a restart are %+v, want %+v", report.Limits, limits)
+	}
+
+	if err := quotas.SetLoadTestLimits("payments", nil, "root"); err != nil {
+		t.Fatal(err)
+	}
+	if report := limitsOf(quotas, "alice"); !report.Default || report.Limits != defaults {
+		t.Errorf("limits of the payments workspace once reset are %+v, want the default ones", report)
+	}
+}
+
+func TestCheckArtifactQuotas(t *testing.T) {
+	tests := []struct {
+		name       string
+		defaults   models.WorkspaceQuotas
+		workspaces []models.Workspace
+		wantErr    bool
+	}{
+		{name: "concurrent tests only", defaults: models.WorkspaceQuotas{MaxConcurrentTests: 1}, workspaces: []models.Workspace{{Name: "payments", Quotas: models.WorkspaceQuotas{MaxConcurrentTests: 2}}}},
+		{name: "no quotas"},
+		{name: "default profiles", defaults: models.WorkspaceQuotas{MaxProfiles: 10}, wantErr: true},
+		{name: "results of a workspace", workspaces: []models.Workspace{{Name: "payments", Quotas: models.WorkspaceQuotas{MaxResultsGB: 5}}}, wantErr: true},
+		{name: "designs of a workspace", workspaces: []models.Workspace{{Name: "payments"}, {Name: "search", Quotas: models.WorkspaceQuotas{MaxDesigns: 100}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		if err := CheckArtifactQuotas(tt.defaults, tt.workspaces); (err != nil) != tt.wantErr {
+			t.Errorf("%s: got error %v, want an error: %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/layer5io/gowrk2/api"
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// wrk2Location is where the wrk2 binary is, unless given by WRK_LOCATION
+	wrk2Location = "./wrk2/wrk"
+	// wrk2ResultScript is the script of gowrk2 reporting the results of wrk2 as JSON
+	wrk2ResultScript = "./wrk2/scripts/multiple-endpoints_in_json.lua"
+)
+
+// wrk2CustomRequest tells if the requests of the test differ from the GET requests without body
+// nor headers gowrk2 sends
+func wrk2CustomRequest(opts *models.LoadTestOptions) bool {
+	return opts.Method != "" || len(opts.Body) > 0 || opts.ContentType != "" ||
+		(opts.Headers != nil && len(*opts.Headers) > 0) || (opts.Cookies != nil && len(*opts.Cookies) > 0)
+}
+
+// runWRK2 runs wrk2 as gowrk2 does, its requests being formatted with the method, body and
+// headers of the test by a script wrapping the result script of gowrk2
+func runWRK2(config *api.GoWRK2Config, opts *models.LoadTestOptions) (*api.GoWRK2, error) {
+	wrkLoc := wrk2Location
+	if loc := os.Getenv("WRK_LOCATION"); loc != "" {
+		wrkLoc = loc
+	}
+	target, err := url.Parse(config.URL)
+	if err != nil || !target.IsAbs() {
+		return nil, fmt.Errorf("given URL (%s) is not a valid URL", config.URL)
+	}
+	if target.Port() == "" {
+		if target.Scheme == "https" {
+			target.Host += ":443"
+		} else {
+			target.Host += ":80"
+		}
+	}
+
+	script, err := os.CreateTemp("", "meshery-wrk2-*.lua")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.Remove(script.Name())
+	}()
+	_, err = script.WriteString(wrk2RequestScript(opts))
+	if cerr := script.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dur := strconv.FormatFloat(config.DurationInSeconds, 'f', -1, 64)
+	args := []string{"-t" + strconv.Itoa(config.Thread),
+		"-d" + dur + "s",
+		"-R" + strconv.FormatFloat(config.RQPS, 'f', -1, 64),
+		"-s", script.Name(), target.String()}
+	logrus.Debugf("received command: wrk %v", args)
+
+	startTime := time.Now()
+	out, err := exec.Command(wrkLoc, args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute the requested command: %v", err)
+	}
+	raw, err := parseWRK2Output(out)
+	if err != nil {
+		return nil, err
+	}
+	raw.StartTime = startTime
+	raw.RequestedDuration = dur + "s"
+	raw.RequestedQPS = fmt.Sprintf("%f", config.RQPS)
+	return raw, nil
+}
+
+// wrk2RequestScript returns the script making wrk2 send the requests of the test, which overrides the
+// formatting of the requests before loading the result script of gowrk2 so that its requests use it too
+func wrk2RequestScript(opts *models.LoadTestOptions) string {
+	headers := map[string]string{}
+	if opts.Headers != nil {
+		for k, v := range *opts.Headers {
+			headers[k] = v
+		}
+	}
+	if opts.Cookies != nil && len(*opts.Cookies) > 0 {
+		cookies := make([]string, 0, len(*opts.Cookies))
+		for k, v := range *opts.Cookies {
+			cookies = append(cookies, (&http.Cookie{Name: k, Value: v}).String())
+		}
+		sort.Strings(cookies)
+		headers["Cookie"] = strings.Join(cookies, "; ")
+	}
+	if opts.ContentType != "" {
+		headers["Content-Type"] = opts.ContentType
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("local format = wrk.format\n")
+	b.WriteString("wrk.format = function(method, path, headers, body)\n")
+	b.WriteString("\tlocal merged = {}\n")
+	b.WriteString("\tfor k, v in pairs(headers or wrk.headers) do merged[k] = v end\n")
+	for _, k := range names {
+		fmt.Fprintf(&b, "\tmerged[%s] = %s\n", luaQuote(k), luaQuote(headers[k]))
+	}
+	if len(opts.Body) > 0 {
+		fmt.Fprintf(&b, "\tbody = %s\n", luaQuote(string(opts.Body)))
+	}
+	fmt.Fprintf(&b, "\treturn format(%s, path, merged, body)\n", luaQuote(opts.HTTPMethod()))
+	b.WriteString("end\n")
+	fmt.Fprintf(&b, "dofile(%s)\n", luaQuote(wrk2ResultScript))
+	return b.String()
+}
+
+// luaQuote returns the string as a Lua string literal
+func luaQuote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c >= 0x7f:
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// parseWRK2Output parses the JSON results of the result script, skipping what wrk2 prints before
+// them as gowrk2 does
+func parseWRK2Output(out []byte) (*api.GoWRK2, error) {
+	raw := &api.GoWRK2{}
+	err := json.Unmarshal(out, raw)
+	if err == nil {
+		return raw, nil
+	}
+	if i := strings.Index(string(out), ","); i > -1 && i+1 < len(out) {
+		raw = &api.GoWRK2{}
+		if json.Unmarshal(out[i+1:], raw) == nil {
+			return raw, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to unmarshal the result: %v", err)
+}
@@ -69,6 +69,30 @@ type Error struct {
 	Description string `json:"description"`
 }
 
+type Event struct {
+	ID             string        `json:"id"`
+	Severity       EventSeverity `json:"severity"`
+	Category       EventCategory `json:"category"`
+	Summary        string        `json:"summary"`
+	Details        *string       `json:"details"`
+	OperationID    *string       `json:"operation_id"`
+	Source         *string       `json:"source"`
+	CreatedAt      *string       `json:"created_at"`
+	Acknowledged   bool          `json:"acknowledged"`
+	AcknowledgedAt *string       `json:"acknowledged_at"`
+	Resolved       bool          `json:"resolved"`
+	ResolvedAt     *string       `json:"resolved_at"`
+}
+
+type EventFilter struct {
+	Severities   []EventSeverity `json:"severities"`
+	Categories   []EventCategory `json:"categories"`
+	Acknowledged *bool           `json:"acknowledged"`
+	Resolved     *bool           `json:"resolved"`
+	Since        *string         `json:"since"`
+	Limit        *int            `json:"limit"`
+}
+
 type MesheryResult struct {
 	MesheryID          *string                `json:"meshery_id"`
 	Name               *string                `json:"name"`
@@ -115,6 +139,11 @@ type PageFilter struct {
 	To       *string `json:"to"`
 }
 
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
 type PatternLocation struct {
 	Branch *string `json:"branch"`
 	Host   *string `json:"host"`
@@ -156,23 +185,62 @@ type PerfPageResult struct {
 }
 
 type PerfProfile struct {
-	ConcurrentRequest int       `json:"concurrent_request"`
-	CreatedAt         *string   `json:"created_at"`
-	Duration          string    `json:"duration"`
-	Endpoints         []*string `json:"endpoints"`
-	ID                string    `json:"id"`
-	LastRun           *string   `json:"last_run"`
-	LoadGenerators    []*string `json:"load_generators"`
-	Name              *string   `json:"name"`
-	QPS               *int      `json:"qps"`
-	TotalResults      *int      `json:"total_results"`
-	UpdatedAt         *string   `json:"updated_at"`
-	UserID            string    `json:"user_id"`
-	RequestHeaders    *string   `json:"request_headers"`
-	RequestCookies    *string   `json:"request_cookies"`
-	RequestBody       *string   `json:"request_body"`
-	ContentType       *string   `json:"content_type"`
-	ServiceMesh       *string   `json:"service_mesh"`
+	ConcurrentRequest int                `json:"concurrent_request"`
+	CreatedAt         *string            `json:"created_at"`
+	Duration          string             `json:"duration"`
+	Endpoints         []*string          `json:"endpoints"`
+	ID                string             `json:"id"`
+	LastRun           *string            `json:"last_run"`
+	LoadGenerators    []*string          `json:"load_generators"`
+	Name              *string            `json:"name"`
+	QPS               *int               `json:"qps"`
+	TotalResults      *int               `json:"total_results"`
+	UpdatedAt         *string            `json:"updated_at"`
+	UserID            string             `json:"user_id"`
+	RequestHeaders    *string            `json:"request_headers"`
+	RequestCookies    *string            `json:"request_cookies"`
+	RequestBody       *string            `json:"request_body"`
+	ContentType       *string            `json:"content_type"`
+	ServiceMesh       *string            `json:"service_mesh"`
+	RequestMix        []*WeightedRequest `json:"request_mix"`
+}
+
+type PerfProfileConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*PerfProfileEdge `json:"edges"`
+}
+
+type PerfProfileEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *PerfProfile `json:"node"`
+}
+
+type PerfProfileInput struct {
+	ID                *string                 `json:"id"`
+	Name              string                  `json:"name"`
+	Endpoints         []string                `json:"endpoints"`
+	Duration          string                  `json:"duration"`
+	ConcurrentRequest int                     `json:"concurrent_request"`
+	QPS               *int                    `json:"qps"`
+	LoadGenerators    []string                `json:"load_generators"`
+	ServiceMesh       *string                 `json:"service_mesh"`
+	RequestHeaders    *string                 `json:"request_headers"`
+	RequestCookies    *string                 `json:"request_cookies"`
+	RequestBody       *string                 `json:"request_body"`
+	ContentType       *string                 `json:"content_type"`
+	RequestMix        []*WeightedRequestInput `json:"request_mix"`
+}
+
+type PerfResultConnection struct {
+	TotalCount int               `json:"totalCount"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	Edges      []*PerfResultEdge `json:"edges"`
+}
+
+type PerfResultEdge struct {
+	Cursor string         `json:"cursor"`
+	Node   *MesheryResult `json:"node"`
 }
 
 type ReSyncActions struct {
@@ -184,6 +252,108 @@ type ServiceMeshFilter struct {
 	Type *MeshType `json:"type"`
 }
 
+type WeightedRequest struct {
+	Weight int     `json:"weight"`
+	Method *string `json:"method"`
+	Path   string  `json:"path"`
+	Body   *string `json:"body"`
+}
+
+type WeightedRequestInput struct {
+	Weight int     `json:"weight"`
+	Method *string `json:"method"`
+	Path   string  `json:"path"`
+	Body   *string `json:"body"`
+}
+
+type EventCategory string
+
+const (
+	EventCategoryAdapter     EventCategory = "ADAPTER"
+	EventCategoryHealth      EventCategory = "HEALTH"
+	EventCategoryPerformance EventCategory = "PERFORMANCE"
+	EventCategoryDesign      EventCategory = "DESIGN"
+)
+
+var AllEventCategory = []EventCategory{
+	EventCategoryAdapter,
+	EventCategoryHealth,
+	EventCategoryPerformance,
+	EventCategoryDesign,
+}
+
+func (e EventCategory) IsValid() bool {
+	switch e {
+	case EventCategoryAdapter, EventCategoryHealth, EventCategoryPerformance, EventCategoryDesign:
+		return true
+	}
+	return false
+}
+
+func (e EventCategory) String() string {
+	return string(e)
+}
+
+func (e *EventCategory) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventCategory(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventCategory", str)
+	}
+	return nil
+}
+
+func (e EventCategory) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type EventSeverity string
+
+const (
+	EventSeverityInfo    EventSeverity = "INFO"
+	EventSeverityWarning EventSeverity = "WARNING"
+	EventSeverityError   EventSeverity = "ERROR"
+)
+
+var AllEventSeverity = []EventSeverity{
+	EventSeverityInfo,
+	EventSeverityWarning,
+	EventSeverityError,
+}
+
+func (e EventSeverity) IsValid() bool {
+	switch e {
+	case EventSeverityInfo, EventSeverityWarning, EventSeverityError:
+		return true
+	}
+	return false
+}
+
+func (e EventSeverity) String() string {
+	return string(e)
+}
+
+func (e *EventSeverity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventSeverity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventSeverity", str)
+	}
+	return nil
+}
+
+func (e EventSeverity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type MeshType string
 
 const (
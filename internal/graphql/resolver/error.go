@@ -22,6 +22,7 @@ const (
 	ErrBrokerNotConnectedCode       = "2151"
 	ErrGettingNamespaceCode         = "1014"
 	ErrFetchingPatternsCode         = "1015"
+	ErrInvalidCursorCode            = "2192"
 )
 
 var (
@@ -84,3 +85,7 @@ func ErrGettingNamespace(err error) error {
 func ErrFetchingPatterns(err error) error {
 	return errors.New(ErrFetchingPatternsCode, errors.Alert, []string{"Cannot fetch patterns"}, []string{err.Error()}, []string{"There might be something wrong with the Meshery or Meshery Cloud"}, []string{"Try again, if still exist, please post an issue on Meshery repository"})
 }
+
+func ErrInvalidCursor(err error) error {
+	return errors.New(ErrInvalidCursorCode, errors.Alert, []string{"Invalid pagination arguments"}, []string{err.Error()}, []string{"The cursor was not returned by a previous query", "first is negative"}, []string{"Pass the endCursor of the previous page as after and a positive first"})
+}
@@ -0,0 +1,123 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/handlers"
+	"github.com/layer5io/meshery/internal/graphql/model"
+	"github.com/layer5io/meshery/models"
+)
+
+func (r *Resolver) getEvents(ctx context.Context, filter *model.EventFilter) ([]*model.Event, error) {
+	user := ctx.Value(models.UserCtxKey).(*models.User)
+
+	eventFilter, err := eventFilterFromModel(filter)
+	if err != nil {
+		r.Log.Error(handlers.ErrGetEvents(err))
+		return nil, handlers.ErrGetEvents(err)
+	}
+	events, err := r.Config.EventPersister.GetEvents(user.UserID, eventFilter)
+	if err != nil {
+		r.Log.Error(handlers.ErrGetEvents(err))
+		return nil, handlers.ErrGetEvents(err)
+	}
+
+	result := make([]*model.Event, 0, len(events))
+	for _, event := range events {
+		result = append(result, eventToModel(event))
+	}
+	return result, nil
+}
+
+func (r *Resolver) updateEventsState(ctx context.Context, ids []string, filter *model.EventFilter, resolve bool) (int, error) {
+	user := ctx.Value(models.UserCtxKey).(*models.User)
+
+	req := models.EventStateRequest{}
+	for _, id := range ids {
+		eventID, err := uuid.FromString(id)
+		if err != nil {
+			return 0, handlers.ErrUpdateEvents(fmt.Errorf("invalid event ID %q", id))
+		}
+		req.IDs = append(req.IDs, eventID)
+	}
+	if filter != nil {
+		eventFilter, err := eventFilterFromModel(filter)
+		if err != nil {
+			return 0, handlers.ErrUpdateEvents(err)
+		}
+		req.Filter = &eventFilter
+	}
+
+	var updated int
+	var err error
+	if resolve {
+		updated, err = r.Config.EventPersister.ResolveEvents(user.UserID, req)
+	} else {
+		updated, err = r.Config.EventPersister.AcknowledgeEvents(user.UserID, req)
+	}
+	if err != nil {
+		r.Log.Error(handlers.ErrUpdateEvents(err))
+		return 0, handlers.ErrUpdateEvents(err)
+	}
+	return updated, nil
+}
+
+// eventFilterFromModel converts the filter of the query, whose enums are the upper case
+// severities and categories
+func eventFilterFromModel(filter *model.EventFilter) (models.EventFilter, error) {
+	eventFilter := models.EventFilter{}
+	if filter == nil {
+		return eventFilter, nil
+	}
+	for _, severity := range filter.Severities {
+		eventFilter.Severities = append(eventFilter.Severities, models.EventSeverity(strings.ToLower(severity.String())))
+	}
+	for _, category := range filter.Categories {
+		eventFilter.Categories = append(eventFilter.Categories, models.EventCategory(strings.ToLower(category.String())))
+	}
+	eventFilter.Acknowledged = filter.Acknowledged
+	eventFilter.Resolved = filter.Resolved
+	if filter.Since != nil && *filter.Since != "" {
+		since, err := time.Parse(time.RFC3339, *filter.Since)
+		if err != nil {
+			return eventFilter, fmt.Errorf("invalid since %q, expected a time in RFC 3339 format", *filter.Since)
+		}
+		eventFilter.Since = &since
+	}
+	if filter.Limit != nil {
+		eventFilter.Limit = *filter.Limit
+	}
+	return eventFilter, eventFilter.Validate()
+}
+
+func eventToModel(event models.UserEvent) *model.Event {
+	result := &model.Event{
+		ID:           event.ID.String(),
+		Severity:     model.EventSeverity(strings.ToUpper(string(event.Severity))),
+		Category:     model.EventCategory(strings.ToUpper(string(event.Category))),
+		Summary:      event.Summary,
+		Details:      &event.Details,
+		OperationID:  &event.OperationID,
+		Source:       &event.Source,
+		Acknowledged: event.Acknowledged,
+		Resolved:     event.Resolved,
+	}
+	for _, t := range []struct {
+		from *time.Time
+		to   **string
+	}{
+		{event.CreatedAt, &result.CreatedAt},
+		{event.AcknowledgedAt, &result.AcknowledgedAt},
+		{event.ResolvedAt, &result.ResolvedAt},
+	} {
+		if t.from != nil {
+			formatted := t.from.Format(time.RFC3339)
+			*t.to = &formatted
+		}
+	}
+	return result
+}
@@ -93,6 +93,24 @@ func (r *Resolver) getMeshSyncStatus(ctx context.Context) model.OperatorControll
 }
 
 func (r *Resolver) resyncCluster(ctx context.Context, provider models.Provider, actions *model.ReSyncActions) (model.Status, error) {
+	// The resync is tracked as a job until MeshSync is asked for the discovery, which it runs on its own
+	job := models.UntrackedJob(ctx)
+	if r.Config != nil && r.Config.Jobs != nil && (actions.ClearDb == "true" || actions.ReSync == "true") {
+		userID := ""
+		if user, ok := ctx.Value(models.UserCtxKey).(*models.User); ok {
+			userID = user.UserID
+		}
+		job = r.Config.Jobs.Start(ctx, models.JobMeshSyncResync, "Resync of the cluster by MeshSync", userID, false)
+	}
+	status, err := r.requestResync(provider, actions)
+	if err == nil && actions.ReSync == "true" {
+		job.Message("Discovery requested from MeshSync, the resources of the cluster are updated as it reports them")
+	}
+	job.Finish(err)
+	return status, err
+}
+
+func (r *Resolver) requestResync(provider models.Provider, actions *model.ReSyncActions) (model.Status, error) {
 	if actions.ClearDb == "true" {
 		// Clear existing data
 		err := provider.GetGenericPersister().Migrator().DropTable(
@@ -0,0 +1,131 @@
+package resolver
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/layer5io/meshery/internal/graphql/model"
+)
+
+const (
+	// defaultPageSize is the number of items returned by a connection when first is not given
+	defaultPageSize = 25
+	// maxPageSize is the maximum number of items a connection returns at once
+	maxPageSize = 100
+	// maxProviderPageSize is the maximum number of items requested from a provider at once
+	maxProviderPageSize = 2 * maxPageSize
+
+	cursorPrefix = "cursor:"
+)
+
+// pageWindow maps a cursor based page request onto the page based API of the providers
+type pageWindow struct {
+	// offset is the position of the first item of the page
+	offset int
+	first  int
+
+	// page and pageSize are requested from the provider and the first skip items
+	// of the provider page are dropped
+	page     int
+	pageSize int
+	skip     int
+}
+
+// newPageWindow returns the window of at most first items after the item identified by the cursor
+func newPageWindow(first *int, after *string) (pageWindow, error) {
+	w := pageWindow{first: defaultPageSize}
+	if first != nil {
+		if *first < 0 {
+			return w, ErrInvalidCursor(fmt.Errorf("first must not be negative"))
+		}
+		w.first = *first
+	}
+	if w.first > maxPageSize {
+		w.first = maxPageSize
+	}
+	if after != nil && *after != "" {
+		position, err := decodeCursor(*after)
+		if err != nil {
+			return w, ErrInvalidCursor(err)
+		}
+		w.offset = position + 1
+	}
+
+	if w.first == 0 {
+		// no item is returned, the provider page only gives the total count
+		w.pageSize = 1
+		return w, nil
+	}
+	// The offset may not fall on a boundary of the pages of first items, so the window is fit
+	// into the smallest provider page holding it, the items up to the offset being skipped
+	for size := w.first; size <= maxProviderPageSize; size++ {
+		if w.offset%size+w.first <= size {
+			w.page = w.offset / size
+			w.pageSize = size
+			w.skip = w.offset % size
+			return w, nil
+		}
+	}
+	// No provider page of a bounded size holds the window, so it is shortened to the end of
+	// the page of first items holding the offset, the pages after it being aligned
+	w.page = w.offset / w.first
+	w.pageSize = w.first
+	w.skip = w.offset % w.first
+	w.first -= w.skip
+	return w, nil
+}
+
+// bounds returns the range of the provider page which belongs to the window
+func (w pageWindow) bounds(n int) (int, int) {
+	start := w.skip
+	if start > n {
+		start = n
+	}
+	end := start + w.first
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+// pageInfo returns the page info of a window holding n items out of total
+func (w pageWindow) pageInfo(n, total int) *model.PageInfo {
+	info := &model.PageInfo{
+		HasNextPage: w.offset+n < total,
+	}
+	if n > 0 {
+		cursor := encodeCursor(w.offset + n - 1)
+		info.EndCursor = &cursor
+	}
+	return info
+}
+
+// encodeCursor returns the opaque cursor of the item at the given position
+func encodeCursor(position int) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(position)))
+}
+
+// decodeCursor returns the position of the item identified by the cursor
+func decodeCursor(cursor string) (int, error) {
+	byt, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	if !strings.HasPrefix(string(byt), cursorPrefix) {
+		return 0, fmt.Errorf("malformed cursor %q", cursor)
+	}
+	position, err := strconv.Atoi(strings.TrimPrefix(string(byt), cursorPrefix))
+	if err != nil || position < 0 {
+		return 0, fmt.Errorf("malformed cursor %q", cursor)
+	}
+	return position, nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
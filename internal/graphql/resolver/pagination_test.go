@@ -0,0 +1,138 @@
+package resolver
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func intPtr(i int) *int { return &i }
+
+func stringPtr(s string) *string { return &s }
+
+func TestNewPageWindow(t *testing.T) {
+	tests := []struct {
+		name      string
+		first     *int
+		after     *string
+		wantFirst int
+		wantPage  int
+		wantSize  int
+		wantSkip  int
+	}{
+		{name: "default page", wantFirst: defaultPageSize, wantSize: defaultPageSize},
+		{name: "first page", first: intPtr(10), wantFirst: 10, wantSize: 10},
+		{name: "page boundary", first: intPtr(10), after: stringPtr(encodeCursor(19)), wantFirst: 10, wantPage: 2, wantSize: 10},
+		{name: "off the page boundary", first: intPtr(10), after: stringPtr(encodeCursor(4)), wantFirst: 10, wantPage: 0, wantSize: 15, wantSkip: 5},
+		{name: "first capped", first: intPtr(1000), wantFirst: maxPageSize, wantSize: maxPageSize},
+		{name: "no item", first: intPtr(0), after: stringPtr(encodeCursor(41)), wantFirst: 0, wantSize: 1},
+		{name: "far off the page boundary", first: intPtr(maxPageSize), after: stringPtr(encodeCursor(100049)), wantFirst: maxPageSize, wantPage: 962, wantSize: 104, wantSkip: 2},
+	}
+	for _, tt := range tests {
+		w, err := newPageWindow(tt.first, tt.after)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if w.first != tt.wantFirst || w.page != tt.wantPage || w.pageSize != tt.wantSize || w.skip != tt.wantSkip {
+			t.Errorf("%s: got window %+v, want first %d of page %d of %d items skipping %d", tt.name, w, tt.wantFirst, tt.wantPage, tt.wantSize, tt.wantSkip)
+		}
+	}
+}
+
+func TestPageWindowItems(t *testing.T) {
+	const total = 1000
+	// page returns the positions of the items of the provider page, as the providers do
+	page := func(w pageWindow) []int {
+		items := []int{}
+		for i := w.page * w.pageSize; i < (w.page+1)*w.pageSize && i < total; i++ {
+			items = append(items, i)
+		}
+		return items
+	}
+
+	for _, first := range []int{1, 7, 25, maxPageSize} {
+		for offset := 0; offset < total+10; offset += 13 {
+			after := stringPtr("")
+			if offset > 0 {
+				after = stringPtr(encodeCursor(offset - 1))
+			}
+			w, err := newPageWindow(intPtr(first), after)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if w.pageSize > maxProviderPageSize {
+				t.Fatalf("first %d after %d: %d items requested from the provider, want at most %d", first, offset, w.pageSize, maxProviderPageSize)
+			}
+
+			items := page(w)
+			start, end := w.bounds(len(items))
+			got := items[start:end]
+			if len(got) == 0 && offset < total {
+				t.Fatalf("first %d after %d: no item returned", first, offset)
+			}
+			for i, position := range got {
+				if position != offset+i {
+					t.Fatalf("first %d after %d: got items %v, want the items from %d", first, offset, got, offset)
+				}
+			}
+			if len(got) > first {
+				t.Fatalf("first %d after %d: got %d items", first, offset, len(got))
+			}
+
+			info := w.pageInfo(len(got), total)
+			if info.HasNextPage != (offset+len(got) < total) {
+				t.Errorf("first %d after %d: hasNextPage is %t", first, offset, info.HasNextPage)
+			}
+		}
+	}
+}
+
+func TestPageInfo(t *testing.T) {
+	w := pageWindow{offset: 20, first: 10}
+	info := w.pageInfo(10, 45)
+	if !info.HasNextPage || info.EndCursor == nil || *info.EndCursor != encodeCursor(29) {
+		t.Errorf("got %+v, want a next page and the cursor of the item 29", info)
+	}
+	info = w.pageInfo(5, 25)
+	if info.HasNextPage || info.EndCursor == nil || *info.EndCursor != encodeCursor(24) {
+		t.Errorf("got %+v, want the last page and the cursor of the item 24", info)
+	}
+	if info = w.pageInfo(0, 20); info.HasNextPage || info.EndCursor != nil {
+		t.Errorf("got %+v, want no next page nor end cursor for an empty window", info)
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	tests := []struct {
+		cursor  string
+		want    int
+		wantErr bool
+	}{
+		{cursor: encodeCursor(0), want: 0},
+		{cursor: encodeCursor(42), want: 42},
+		{cursor: "not base64!", wantErr: true},
+		{cursor: encode("42"), wantErr: true},
+		{cursor: encode("offset:42"), wantErr: true},
+		{cursor: encode(cursorPrefix), wantErr: true},
+		{cursor: encode(cursorPrefix + "forty-two"), wantErr: true},
+		{cursor: encode(cursorPrefix + "-1"), wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := decodeCursor(tt.cursor)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q: got error %v, want an error: %t", tt.cursor, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: got position %d, want %d", tt.cursor, got, tt.want)
+		}
+	}
+
+	if _, err := newPageWindow(intPtr(10), stringPtr(encode("offset:42"))); err == nil {
+		t.Error("window after a malformed cursor was returned")
+	}
+	if _, err := newPageWindow(intPtr(-1), nil); err == nil {
+		t.Error("window of a negative number of items was returned")
+	}
+}
@@ -14,7 +14,7 @@ func (r *Resolver) fetchPatterns(ctx context.Context, provider models.Provider,
 	user := ctx.Value(models.UserCtxKey).(*models.User)
 	prefObj := ctx.Value(models.PerfObjCtxKey).(*models.Preference)
 
-	resp, err := provider.GetMesheryPatterns(tokenString, selector.Page, selector.PageSize, *selector.Search, *selector.Order)
+	resp, err := provider.GetMesheryPatterns(tokenString, selector.Page, selector.PageSize, *selector.Search, *selector.Order, "")
 
 	if err != nil {
 		r.Log.Error(err)
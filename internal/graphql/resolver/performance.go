@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
 
 	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshery/handlers"
@@ -61,7 +63,7 @@ func (r *Resolver) fetchResults(ctx context.Context, provider models.Provider, s
 
 	tokenString := ctx.Value(models.TokenCtxKey).(string)
 
-	bdr, err := provider.FetchResults(tokenString, selector.Page, selector.PageSize, *selector.Search, *selector.Order, profileID)
+	bdr, err := provider.FetchResults(tokenString, selector.Page, selector.PageSize, *selector.Search, *selector.Order, profileID, "")
 
 	if err != nil {
 		r.Log.Error(err)
@@ -168,7 +170,7 @@ func (r *Resolver) subscribePerfProfiles(ctx context.Context, provider models.Pr
 func (r *Resolver) getPerformanceProfiles(ctx context.Context, provider models.Provider, selector model.PageFilter) (*model.PerfPageProfiles, error) {
 	tokenString := ctx.Value(models.TokenCtxKey).(string)
 
-	bdr, err := provider.GetPerformanceProfiles(tokenString, selector.Page, selector.PageSize, *selector.Search, *selector.Order)
+	bdr, err := provider.GetPerformanceProfiles(tokenString, selector.Page, selector.PageSize, *selector.Search, *selector.Order, "")
 
 	if err != nil {
 		r.Log.Error(err)
@@ -188,7 +190,7 @@ func (r *Resolver) getPerformanceProfiles(ctx context.Context, provider models.P
 func (r *Resolver) fetchAllResults(ctx context.Context, provider models.Provider, selector model.PageFilter) (*model.PerfPageResult, error) {
 	tokenString := ctx.Value(models.TokenCtxKey).(string)
 
-	bdr, err := provider.FetchAllResults(tokenString, selector.Page, selector.PageSize, *selector.Search, *selector.Order, *selector.From, *selector.To)
+	bdr, err := provider.FetchAllResults(tokenString, selector.Page, selector.PageSize, *selector.Search, *selector.Order, *selector.From, *selector.To, "")
 
 	if err != nil {
 		r.Log.Error(err)
@@ -204,3 +206,173 @@ func (r *Resolver) fetchAllResults(ctx context.Context, provider models.Provider
 
 	return performanceResults, nil
 }
+
+func (r *Resolver) perfProfiles(ctx context.Context, provider models.Provider, first *int, after, search, order *string) (*model.PerfProfileConnection, error) {
+	w, err := newPageWindow(first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenString := ctx.Value(models.TokenCtxKey).(string)
+
+	bdr, err := provider.GetPerformanceProfiles(tokenString, strconv.Itoa(w.page), strconv.Itoa(w.pageSize), stringValue(search), stringValue(order), "")
+	if err != nil {
+		r.Log.Error(err)
+		return nil, err
+	}
+
+	profiles := &model.PerfPageProfiles{}
+	if err := json.Unmarshal(bdr, profiles); err != nil {
+		obj := "performance profiles data"
+		return nil, handlers.ErrUnmarshal(err, obj)
+	}
+
+	start, end := w.bounds(len(profiles.Profiles))
+	conn := &model.PerfProfileConnection{
+		TotalCount: profiles.TotalCount,
+		PageInfo:   w.pageInfo(end-start, profiles.TotalCount),
+		Edges:      []*model.PerfProfileEdge{},
+	}
+	for i, profile := range profiles.Profiles[start:end] {
+		if profile == nil {
+			continue
+		}
+		conn.Edges = append(conn.Edges, &model.PerfProfileEdge{
+			Cursor: encodeCursor(w.offset + i),
+			Node:   profile,
+		})
+	}
+
+	return conn, nil
+}
+
+func (r *Resolver) perfProfile(ctx context.Context, provider models.Provider, id string) (*model.PerfProfile, error) {
+	if id == "" {
+		return nil, handlers.ErrQueryGet("*id")
+	}
+
+	req, ok := ctx.Value(models.RequestCtxKey).(*http.Request)
+	if !ok {
+		return nil, ErrInvalidRequest
+	}
+
+	bdr, err := provider.GetPerformanceProfile(req, id)
+	if err != nil {
+		r.Log.Error(err)
+		return nil, err
+	}
+
+	profile := &model.PerfProfile{}
+	if err := json.Unmarshal(bdr, profile); err != nil {
+		obj := "performance profile data"
+		return nil, handlers.ErrUnmarshal(err, obj)
+	}
+
+	return profile, nil
+}
+
+func (r *Resolver) perfResults(ctx context.Context, provider models.Provider, profileID *string, first *int, after, search, order, from, to *string) (*model.PerfResultConnection, error) {
+	w, err := newPageWindow(first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenString := ctx.Value(models.TokenCtxKey).(string)
+
+	var bdr []byte
+	if id := stringValue(profileID); id != "" {
+		bdr, err = provider.FetchResults(tokenString, strconv.Itoa(w.page), strconv.Itoa(w.pageSize), stringValue(search), stringValue(order), id, "")
+	} else {
+		bdr, err = provider.FetchAllResults(tokenString, strconv.Itoa(w.page), strconv.Itoa(w.pageSize), stringValue(search), stringValue(order), stringValue(from), stringValue(to), "")
+	}
+	if err != nil {
+		r.Log.Error(err)
+		return nil, err
+	}
+
+	results := &model.PerfPageResult{}
+	if err := json.Unmarshal(bdr, results); err != nil {
+		obj := "result data"
+		return nil, handlers.ErrUnmarshal(err, obj)
+	}
+
+	start, end := w.bounds(len(results.Results))
+	conn := &model.PerfResultConnection{
+		TotalCount: results.TotalCount,
+		PageInfo:   w.pageInfo(end-start, results.TotalCount),
+		Edges:      []*model.PerfResultEdge{},
+	}
+	for i, result := range results.Results[start:end] {
+		if result == nil {
+			continue
+		}
+		conn.Edges = append(conn.Edges, &model.PerfResultEdge{
+			Cursor: encodeCursor(w.offset + i),
+			Node:   result,
+		})
+	}
+
+	return conn, nil
+}
+
+func (r *Resolver) savePerfProfile(ctx context.Context, provider models.Provider, input model.PerfProfileInput) (*model.PerfProfile, error) {
+	// The input shares its field names with the performance profile of the providers
+	byt, err := json.Marshal(input)
+	if err != nil {
+		return nil, handlers.ErrMarshal(err, "performance profile")
+	}
+	performanceProfile := &models.PerformanceProfile{}
+	if err := json.Unmarshal(byt, performanceProfile); err != nil {
+		return nil, handlers.ErrUnmarshal(err, "performance profile")
+	}
+	if err := performanceProfile.RequestMix.Validate(); err != nil {
+		return nil, handlers.ErrRequestMix(err)
+	}
+	if user, ok := ctx.Value(models.UserCtxKey).(*models.User); ok && performanceProfile.UserID == "" {
+		performanceProfile.UserID = user.UserID
+	}
+
+	tokenString := ctx.Value(models.TokenCtxKey).(string)
+
+	bdr, err := provider.SavePerformanceProfile(tokenString, performanceProfile)
+	if err != nil {
+		obj := "performance profile"
+		r.Log.Error(handlers.ErrFailToSave(err, obj))
+		return nil, handlers.ErrFailToSave(err, obj)
+	}
+
+	if r.Config.PerformanceChannel != nil {
+		r.Config.PerformanceChannel <- struct{}{}
+	}
+
+	profile := &model.PerfProfile{}
+	if err := json.Unmarshal(bdr, profile); err != nil {
+		obj := "performance profile data"
+		return nil, handlers.ErrUnmarshal(err, obj)
+	}
+
+	return profile, nil
+}
+
+func (r *Resolver) deletePerfProfile(ctx context.Context, provider models.Provider, id string) (string, error) {
+	if id == "" {
+		return "", handlers.ErrQueryGet("*id")
+	}
+
+	req, ok := ctx.Value(models.RequestCtxKey).(*http.Request)
+	if !ok {
+		return "", ErrInvalidRequest
+	}
+
+	if _, err := provider.DeletePerformanceProfile(req, id); err != nil {
+		obj := "performance profile"
+		r.Log.Error(handlers.ErrFailToDelete(err, obj))
+		return "", handlers.ErrFailToDelete(err, obj)
+	}
+
+	if r.Config.PerformanceChannel != nil {
+		r.Config.PerformanceChannel <- struct{}{}
+	}
+
+	return id, nil
+}
@@ -26,6 +26,24 @@ func (r *mutationResolver) ChangeOperatorStatus(ctx context.Context, input *mode
 	return r.changeOperatorStatus(ctx, provider, input.TargetStatus)
 }
 
+func (r *mutationResolver) SavePerfProfile(ctx context.Context, input model.PerfProfileInput) (*model.PerfProfile, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.savePerfProfile(ctx, provider, input)
+}
+
+func (r *mutationResolver) DeletePerfProfile(ctx context.Context, id string) (string, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.deletePerfProfile(ctx, provider, id)
+}
+
+func (r *mutationResolver) AcknowledgeEvents(ctx context.Context, ids []string, filter *model.EventFilter) (int, error) {
+	return r.updateEventsState(ctx, ids, filter, false)
+}
+
+func (r *mutationResolver) ResolveEvents(ctx context.Context, ids []string, filter *model.EventFilter) (int, error) {
+	return r.updateEventsState(ctx, ids, filter, true)
+}
+
 func (r *queryResolver) GetAvailableAddons(ctx context.Context, selector *model.MeshType) ([]*model.AddonList, error) {
 	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
 	if selector != nil {
@@ -114,6 +132,25 @@ func (r *queryResolver) FetchPatterns(ctx context.Context, selector model.PageFi
 	return r.fetchPatterns(ctx, provider, selector)
 }
 
+func (r *queryResolver) PerfProfiles(ctx context.Context, first *int, after *string, search *string, order *string) (*model.PerfProfileConnection, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.perfProfiles(ctx, provider, first, after, search, order)
+}
+
+func (r *queryResolver) PerfProfile(ctx context.Context, id string) (*model.PerfProfile, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.perfProfile(ctx, provider, id)
+}
+
+func (r *queryResolver) PerfResults(ctx context.Context, profileID *string, first *int, after *string, search *string, order *string, from *string, to *string) (*model.PerfResultConnection, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.perfResults(ctx, provider, profileID, first, after, search, order, from, to)
+}
+
+func (r *queryResolver) GetEvents(ctx context.Context, filter *model.EventFilter) ([]*model.Event, error) {
+	return r.getEvents(ctx, filter)
+}
+
 func (r *subscriptionResolver) ListenToAddonState(ctx context.Context, selector *model.MeshType) (<-chan []*model.AddonList, error) {
 	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
 	if selector != nil {
@@ -0,0 +1,34 @@
+package adapter
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+)
+
+// AdapterCmd represents the root command for adapter commands
+var AdapterCmd = &cobra.Command{
+	Use:   "adapter",
+	Short: "Manage Meshery Adapters",
+	Long:  `Register, unregister and list the Meshery Adapters known to Meshery Server without restarting it`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	AdapterCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+
+	availableSubcommands = []*cobra.Command{registerCmd, unregisterCmd, listCmd}
+	AdapterCmd.AddCommand(availableSubcommands...)
+}
@@ -0,0 +1,217 @@
+package adapter
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestAdapterCmd(t *testing.T) {
+	// setup current context
+	utils.SetupContextEnv(t)
+
+	// initialize mock server for handling requests
+	utils.StartMockery(t)
+
+	// create a test helper
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures")
+	testdataDir := filepath.Join(currDir, "testdata")
+	token := filepath.Join(fixturesDir, "token.golden")
+	caFile := filepath.Join(fixturesDir, "ca.crt.golden")
+	ca := utils.NewGoldenFile(t, "ca.crt.golden", fixturesDir).Load()
+
+	adaptersURL := testContext.BaseURL + "/api/system/adapters"
+	registerURL := testContext.BaseURL + "/api/system/adapter/register"
+
+	// test scenrios for fetching data
+	tests := []struct {
+		Name             string
+		Args             []string
+		ExpectedResponse string
+		URLs             []utils.MockURL
+		// Registration is the registration the adapter is expected to be registered with
+		Registration *models.AdapterRegistration
+		// Unregistered is the address the adapter is expected to be unregistered at
+		Unregistered string
+		ExpectError  bool
+	}{
+		// first, as the flag is required only until given in a scenario
+		{
+			Name:             "Register an adapter without its address",
+			Args:             []string{"register", "--name", "istio"},
+			ExpectedResponse: "register.noaddress.output.golden",
+			ExpectError:      true,
+		},
+		{
+			Name:             "List the adapters",
+			Args:             []string{"list"},
+			ExpectedResponse: "list.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: adaptersURL, Response: "adapters.response.golden", ResponseCode: 200},
+			},
+		},
+		{
+			Name:             "Register an adapter",
+			Args:             []string{"register", "--name", "istio", "--address", "istio-adapter.example.com:10000"},
+			ExpectedResponse: "register.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: registerURL, Response: "register.response.golden", ResponseCode: 200},
+			},
+			Registration: &models.AdapterRegistration{Name: "istio", Address: "istio-adapter.example.com:10000"},
+		},
+		{
+			Name:             "Register an adapter serving over TLS",
+			Args:             []string{"register", "--address", "istio-adapter.example.com:10000", "--tls-ca", caFile},
+			ExpectedResponse: "register.tls.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: registerURL, Response: "register.response.golden", ResponseCode: 200},
+			},
+			Registration: &models.AdapterRegistration{Address: "istio-adapter.example.com:10000", TLSCA: ca},
+		},
+		{
+			Name:             "Register an adapter not listed once registered",
+			Args:             []string{"register", "--address", "linkerd-adapter.example.com:10001"},
+			ExpectedResponse: "register.unlisted.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: registerURL, Response: "register.response.golden", ResponseCode: 200},
+			},
+			Registration: &models.AdapterRegistration{Address: "linkerd-adapter.example.com:10001"},
+		},
+		{
+			Name:             "Register an adapter with a missing CA bundle",
+			Args:             []string{"register", "--address", "istio-adapter.example.com:10000", "--tls-ca", "missing-ca.crt"},
+			ExpectedResponse: "register.noca.output.golden",
+			ExpectError:      true,
+		},
+		{
+			Name:             "Register an adapter not reachable",
+			Args:             []string{"register", "--address", "istio-adapter.example.com:10000"},
+			ExpectedResponse: "register.error.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: registerURL, Response: "register.error.response.golden", ResponseCode: 400},
+			},
+			Registration: &models.AdapterRegistration{Address: "istio-adapter.example.com:10000"},
+			ExpectError:  true,
+		},
+		{
+			Name:             "Register an adapter without being an admin",
+			Args:             []string{"register", "--address", "istio-adapter.example.com:10000"},
+			ExpectedResponse: "register.forbidden.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: registerURL, Response: "register.forbidden.response.golden", ResponseCode: 403},
+			},
+			Registration: &models.AdapterRegistration{Address: "istio-adapter.example.com:10000"},
+			ExpectError:  true,
+		},
+		{
+			Name:             "Unregister an adapter",
+			Args:             []string{"unregister", "istio-adapter.example.com:10000"},
+			ExpectedResponse: "unregister.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "DELETE", URL: registerURL, Response: "unregister.response.golden", ResponseCode: 200},
+			},
+			Unregistered: "istio-adapter.example.com:10000",
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			for _, url := range tt.URLs {
+				// View api response from golden files
+				apiResponse := utils.NewGoldenFile(t, url.Response, fixturesDir).Load()
+
+				// mock response, the adapter being registered or unregistered only when requested as expected
+				responder := httpmock.NewStringResponder(url.ResponseCode, apiResponse)
+				switch url.Method {
+				case "POST":
+					responder = func(req *http.Request) (*http.Response, error) {
+						registration := &models.AdapterRegistration{}
+						if err := json.NewDecoder(req.Body).Decode(registration); err != nil || *registration != *tt.Registration {
+							return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected adapter registration"), nil
+						}
+						return httpmock.NewStringResponse(url.ResponseCode, apiResponse), nil
+					}
+				case "DELETE":
+					responder = func(req *http.Request) (*http.Response, error) {
+						if req.URL.Query().Get("adapter") != tt.Unregistered {
+							return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected adapter"), nil
+						}
+						return httpmock.NewStringResponse(url.ResponseCode, apiResponse), nil
+					}
+				}
+				httpmock.RegisterResponder(url.Method, url.URL, responder)
+			}
+
+			// set token
+			utils.TokenFlag = token
+
+			// reset the flags of the previous scenario
+			name, address, tlsCA = "", "", ""
+
+			// Expected response
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+
+			// Grab console prints
+			rescueStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			b := utils.SetupMeshkitLoggerTesting(t, false)
+			AdapterCmd.SetArgs(tt.Args)
+			err := AdapterCmd.Execute()
+
+			w.Close()
+			out, _ := io.ReadAll(r)
+			os.Stdout = rescueStdout
+
+			if err != nil {
+				// if we're supposed to get an error
+				if tt.ExpectError {
+					// write it in file
+					if *update {
+						golden.Write(err.Error())
+					}
+					expectedResponse := golden.Load()
+
+					utils.Equals(t, expectedResponse, err.Error())
+					return
+				}
+				t.Fatal(err)
+			}
+
+			// response being printed in console, followed by the logs
+			actualResponse := string(out) + b.String()
+
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+
+			utils.Equals(t, expectedResponse, actualResponse)
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
@@ -0,0 +1,25 @@
+package adapter
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrReadCAFileCode      = "1046"
+	ErrAdapterRequestCode  = "1047"
+	ErrAdapterResponseCode = "1048"
+)
+
+func ErrReadCAFile(err error) error {
+	return errors.New(ErrReadCAFileCode, errors.Alert, []string{"Unable to read the CA bundle"}, []string{err.Error()}, []string{}, []string{"Make sure the path passed with --tls-ca points to a PEM encoded CA bundle"})
+}
+
+func ErrAdapterRequest(err error) error {
+	return errors.New(ErrAdapterRequestCode, errors.Alert, []string{"Error sending request to Meshery Server"}, []string{err.Error()}, []string{}, []string{"Check that Meshery Server is running with `mesheryctl system status`"})
+}
+
+func ErrAdapterResponse(status int, body string) error {
+	return errors.New(ErrAdapterResponseCode, errors.Alert, []string{"Meshery Server rejected the request"}, []string{fmt.Sprintf("server responded with status %d: %s", status, body)}, []string{}, []string{"Check the Meshery Server logs and try again"})
+}
@@ -0,0 +1,78 @@
+package adapter
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List adapters known to Meshery Server",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		req, err := utils.NewRequest("GET", mctlCfg.GetBaseMesheryURL()+"/api/system/adapters", nil)
+		if err != nil {
+			return ErrAdapterRequest(err)
+		}
+		adapters, err := doAdapterRequest(req)
+		if err != nil {
+			return err
+		}
+
+		var data [][]string
+		for _, adapter := range adapters {
+			data = append(data, []string{adapter.Location, adapter.Name, adapter.Version})
+		}
+		utils.PrintToTable([]string{"ADDRESS", "NAME", "VERSION"}, data)
+		return nil
+	},
+}
+
+// sendAdapterRequest sends a request to the adapter registration endpoint of Meshery Server
+// and returns the adapters known to it afterwards
+func sendAdapterRequest(mctlCfg *config.MesheryCtlConfig, method, query string, body io.Reader) ([]models.Adapter, error) {
+	req, err := utils.NewRequest(method, mctlCfg.GetBaseMesheryURL()+"/api/system/adapter/register"+query, body)
+	if err != nil {
+		return nil, ErrAdapterRequest(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return doAdapterRequest(req)
+}
+
+func doAdapterRequest(req *http.Request) ([]models.Adapter, error) {
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, ErrAdapterRequest(err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, ErrAdapterResponse(res.StatusCode, string(body))
+	}
+
+	adapters := []models.Adapter{}
+	if err = json.Unmarshal(body, &adapters); err != nil {
+		return nil, err
+	}
+	return adapters, nil
+}
@@ -0,0 +1,79 @@
+package adapter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	name    string
+	address string
+	tlsCA   string
+)
+
+var registerCmd = &cobra.Command{
+	Use:   "register",
+	Short: "Register an adapter with Meshery Server",
+	Long:  `Register an adapter running at an arbitrary address, including other clusters, without restarting Meshery Server. The adapter must be reachable at a host and port, and stays registered across restarts of Meshery Server until unregistered. Only the admins of Meshery Server may register and unregister the adapters.`,
+	Args:  cobra.NoArgs,
+	Example: `
+// Register the Istio adapter running in another cluster
+mesheryctl adapter register --name istio --address istio-adapter.example.com:10000
+
+// Register an adapter serving over TLS
+mesheryctl adapter register --name istio --address istio-adapter.example.com:10000 --tls-ca ca.crt
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		registration := models.AdapterRegistration{
+			Name:    name,
+			Address: address,
+		}
+		if tlsCA != "" {
+			ca, err := os.ReadFile(tlsCA)
+			if err != nil {
+				return ErrReadCAFile(err)
+			}
+			registration.TLSCA = string(ca)
+		}
+
+		payload, err := json.Marshal(registration)
+		if err != nil {
+			return err
+		}
+
+		adapters, err := sendAdapterRequest(mctlCfg, "POST", "", bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+
+		for _, adapter := range adapters {
+			if adapter.Location == address {
+				utils.Log.Info(fmt.Sprintf("Adapter %s registered at %s", adapter.Name, adapter.Location))
+				return nil
+			}
+		}
+		utils.Log.Info("Adapter registered at ", address)
+		return nil
+	},
+}
+
+func init() {
+	registerCmd.Flags().StringVar(&name, "name", "", "(optional) name of the adapter, defaults to the name reported by the adapter")
+	registerCmd.Flags().StringVar(&address, "address", "", "address of the adapter in host:port format")
+	registerCmd.Flags().StringVar(&tlsCA, "tls-ca", "", "(optional) path to the PEM encoded CA bundle used to verify an adapter serving over TLS")
+	_ = registerCmd.MarkFlagRequired("address")
+}
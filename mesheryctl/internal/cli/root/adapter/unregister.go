@@ -0,0 +1,33 @@
+package adapter
+
+import (
+	"net/url"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var unregisterCmd = &cobra.Command{
+	Use:   "unregister [address]",
+	Short: "Unregister an adapter from Meshery Server",
+	Args:  cobra.ExactArgs(1),
+	Example: `
+// Unregister the adapter running at the given address
+mesheryctl adapter unregister istio-adapter.example.com:10000
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		if _, err = sendAdapterRequest(mctlCfg, "DELETE", "?adapter="+url.QueryEscape(args[0]), nil); err != nil {
+			return err
+		}
+		utils.Log.Info("Adapter at ", args[0], " unregistered")
+		return nil
+	},
+}
@@ -0,0 +1,32 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+)
+
+// ApispecCmd represents the root command for the API specification commands
+var ApispecCmd = &cobra.Command{
+	Use:   "apispec",
+	Short: "Inspect the API specification of Meshery Server",
+	Long:  `Compare the API specification of the running Meshery Server with the one mesheryctl was built against`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	availableSubcommands = []*cobra.Command{diffCmd}
+	ApispecCmd.AddCommand(availableSubcommands...)
+}
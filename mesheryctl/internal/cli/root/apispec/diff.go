@@ -0,0 +1,350 @@
+package apispec
+
+import (
+	// embed holds the expected API specification in the binary
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/constants"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// expectedSpec is the API specification of the Meshery Server release mesheryctl is built along,
+// kept in sync with helpers/swagger.yaml by `make swagger-spec`
+//
+//go:embed spec/swagger.yaml
+var expectedSpec []byte
+
+var httpMethods = []string{"get", "put", "post", "delete", "patch", "head", "options"}
+
+var (
+	showAll        bool
+	failOnBreaking bool
+)
+
+// openAPISpec holds the parts of an OpenAPI 2.0 document the compatibility of clients depends on
+type openAPISpec struct {
+	Paths       map[string]map[string]json.RawMessage `json:"paths"`
+	Definitions map[string]schema                     `json:"definitions"`
+	Responses   map[string]response                   `json:"responses"`
+}
+
+type operation struct {
+	Parameters []parameter         `json:"parameters"`
+	Responses  map[string]response `json:"responses"`
+}
+
+type parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Type     string  `json:"type"`
+	Schema   *schema `json:"schema"`
+}
+
+type response struct {
+	Ref    string  `json:"$ref"`
+	Schema *schema `json:"schema"`
+}
+
+type schema struct {
+	Ref        string            `json:"$ref"`
+	Type       string            `json:"type"`
+	Items      *schema           `json:"items"`
+	Properties map[string]schema `json:"properties"`
+}
+
+// difference is a change of the API of the server relative to the API mesheryctl expects
+type difference struct {
+	Breaking bool
+	Location string
+	Change   string
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Diff the API of Meshery Server against the one expected by mesheryctl",
+	Long: `Fetch the OpenAPI specification of the running Meshery Server and compare it with the specification
+embedded in mesheryctl. Operations, parameters, responses and fields which mesheryctl expects but which were
+removed or changed by the server are breaking differences; additions are compatible differences.`,
+	Args: cobra.NoArgs,
+	Example: `
+// List the breaking differences between the API of Meshery Server and the one mesheryctl expects
+mesheryctl exp apispec diff
+
+// List the compatible differences as well
+mesheryctl exp apispec diff --all
+
+// Fail when Meshery Server has breaking differences, e.g. in CI
+mesheryctl exp apispec diff --fail-on-breaking
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		expected, err := parseSpec(expectedSpec)
+		if err != nil {
+			return ErrParseAPISpec("embedded API specification", err)
+		}
+		actual, err := fetchServerSpec(mctlCfg.GetBaseMesheryURL())
+		if err != nil {
+			return err
+		}
+
+		diffs := diffSpecs(expected, actual)
+		breaking := 0
+		var data [][]string
+		for _, d := range diffs {
+			kind := "compatible"
+			if d.Breaking {
+				kind = "BREAKING"
+				breaking++
+			} else if !showAll {
+				continue
+			}
+			data = append(data, []string{kind, d.Location, d.Change})
+		}
+		if len(data) > 0 {
+			utils.PrintToTable([]string{"KIND", "LOCATION", "CHANGE"}, data)
+		}
+		utils.Log.Info(fmt.Sprintf("%d breaking and %d compatible differences between the API of Meshery Server and the one expected by mesheryctl %s", breaking, len(diffs)-breaking, constants.GetMesheryctlVersion()))
+
+		if failOnBreaking && breaking > 0 {
+			return errors.Errorf("Meshery Server has %d breaking API differences", breaking)
+		}
+		return nil
+	},
+}
+
+// fetchServerSpec fetches the API specification served by Meshery Server
+func fetchServerSpec(baseURL string) (*openAPISpec, error) {
+	resp, err := http.Get(baseURL + "/swagger.yaml")
+	if err != nil {
+		return nil, err
+	}
+	defer utils.SafeClose(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrFetchAPISpec(resp.StatusCode, string(body))
+	}
+
+	spec, err := parseSpec(body)
+	if err != nil {
+		return nil, ErrParseAPISpec(baseURL+"/swagger.yaml", err)
+	}
+	return spec, nil
+}
+
+func parseSpec(data []byte) (*openAPISpec, error) {
+	spec := &openAPISpec{}
+	if err := yaml.Unmarshal(data, spec); err != nil {
+		return nil, err
+	}
+	if len(spec.Paths) == 0 {
+		return nil, errors.New("no paths found")
+	}
+	return spec, nil
+}
+
+// operations returns the operations of the path by upper cased method
+func (s *openAPISpec) operations(path string) (map[string]operation, error) {
+	ops := map[string]operation{}
+	for _, method := range httpMethods {
+		raw, ok := s.Paths[path][method]
+		if !ok {
+			continue
+		}
+		op := operation{}
+		if err := json.Unmarshal(raw, &op); err != nil {
+			return nil, err
+		}
+		ops[strings.ToUpper(method)] = op
+	}
+	return ops, nil
+}
+
+// responseType describes the schema of the response, following the references to shared responses
+func (s *openAPISpec) responseType(r response) string {
+	if r.Ref != "" {
+		shared, ok := s.Responses[strings.TrimPrefix(r.Ref, "#/responses/")]
+		if !ok {
+			return r.Ref
+		}
+		r = shared
+	}
+	return schemaType(r.Schema)
+}
+
+func schemaType(s *schema) string {
+	switch {
+	case s == nil:
+		return "none"
+	case s.Ref != "":
+		return strings.TrimPrefix(s.Ref, "#/definitions/")
+	case s.Type == "array":
+		return "[]" + schemaType(s.Items)
+	case s.Type == "":
+		return "object"
+	}
+	return s.Type
+}
+
+func parameterType(p parameter) string {
+	if p.Schema != nil {
+		return schemaType(p.Schema)
+	}
+	return p.Type
+}
+
+// diffSpecs returns the differences of the actual API relative to the expected one, sorted by location
+func diffSpecs(expected, actual *openAPISpec) []difference {
+	diffs := []difference{}
+
+	paths := map[string]bool{}
+	for path := range expected.Paths {
+		paths[path] = true
+	}
+	for path := range actual.Paths {
+		paths[path] = true
+	}
+	for path := range paths {
+		expectedOps, err := expected.operations(path)
+		if err != nil {
+			diffs = append(diffs, difference{Location: path, Change: "operations of the embedded specification cannot be read"})
+			continue
+		}
+		actualOps, err := actual.operations(path)
+		if err != nil {
+			diffs = append(diffs, difference{Breaking: true, Location: path, Change: "operations of the server cannot be read"})
+			continue
+		}
+
+		for method, op := range expectedOps {
+			location := method + " " + path
+			actualOp, ok := actualOps[method]
+			if !ok {
+				diffs = append(diffs, difference{Breaking: true, Location: location, Change: "operation removed"})
+				continue
+			}
+			diffs = append(diffs, diffOperation(location, expected, actual, op, actualOp)...)
+		}
+		for method := range actualOps {
+			if _, ok := expectedOps[method]; !ok {
+				diffs = append(diffs, difference{Location: method + " " + path, Change: "operation added"})
+			}
+		}
+	}
+
+	for name, def := range expected.Definitions {
+		location := "definition " + name
+		actualDef, ok := actual.Definitions[name]
+		if !ok {
+			diffs = append(diffs, difference{Breaking: true, Location: location, Change: "definition removed"})
+			continue
+		}
+		for prop, propSchema := range def.Properties {
+			actualProp, ok := actualDef.Properties[prop]
+			if !ok {
+				diffs = append(diffs, difference{Breaking: true, Location: location, Change: fmt.Sprintf("field %s removed", prop)})
+				continue
+			}
+			if from, to := schemaType(&propSchema), schemaType(&actualProp); from != to {
+				diffs = append(diffs, difference{Breaking: true, Location: location, Change: fmt.Sprintf("field %s changed from %s to %s", prop, from, to)})
+			}
+		}
+		for prop := range actualDef.Properties {
+			if _, ok := def.Properties[prop]; !ok {
+				diffs = append(diffs, difference{Location: location, Change: fmt.Sprintf("field %s added", prop)})
+			}
+		}
+	}
+	for name := range actual.Definitions {
+		if _, ok := expected.Definitions[name]; !ok {
+			diffs = append(diffs, difference{Location: "definition " + name, Change: "definition added"})
+		}
+	}
+
+	sort.SliceStable(diffs, func(i, j int) bool {
+		if diffs[i].Location != diffs[j].Location {
+			return diffs[i].Location < diffs[j].Location
+		}
+		return diffs[i].Change < diffs[j].Change
+	})
+	return diffs
+}
+
+func diffOperation(location string, expectedSpec, actualSpec *openAPISpec, expected, actual operation) []difference {
+	diffs := []difference{}
+
+	key := func(p parameter) string { return p.In + " parameter " + p.Name }
+	expectedParams := map[string]parameter{}
+	for _, p := range expected.Parameters {
+		expectedParams[key(p)] = p
+	}
+	actualParams := map[string]parameter{}
+	for _, p := range actual.Parameters {
+		actualParams[key(p)] = p
+	}
+
+	for k, p := range expectedParams {
+		actualParam, ok := actualParams[k]
+		if !ok {
+			diffs = append(diffs, difference{Breaking: true, Location: location, Change: k + " removed"})
+			continue
+		}
+		if from, to := parameterType(p), parameterType(actualParam); from != to {
+			diffs = append(diffs, difference{Breaking: true, Location: location, Change: fmt.Sprintf("%s changed from %s to %s", k, from, to)})
+		}
+		if actualParam.Required && !p.Required {
+			diffs = append(diffs, difference{Breaking: true, Location: location, Change: k + " is now required"})
+		}
+	}
+	for k, p := range actualParams {
+		if _, ok := expectedParams[k]; ok {
+			continue
+		}
+		if p.Required {
+			diffs = append(diffs, difference{Breaking: true, Location: location, Change: "required " + k + " added"})
+		} else {
+			diffs = append(diffs, difference{Location: location, Change: k + " added"})
+		}
+	}
+
+	for code, r := range expected.Responses {
+		actualResponse, ok := actual.Responses[code]
+		if !ok {
+			diffs = append(diffs, difference{Breaking: true, Location: location, Change: fmt.Sprintf("response %s removed", code)})
+			continue
+		}
+		if from, to := expectedSpec.responseType(r), actualSpec.responseType(actualResponse); from != to {
+			diffs = append(diffs, difference{Breaking: true, Location: location, Change: fmt.Sprintf("response %s changed from %s to %s", code, from, to)})
+		}
+	}
+	for code := range actual.Responses {
+		if _, ok := expected.Responses[code]; !ok {
+			diffs = append(diffs, difference{Location: location, Change: fmt.Sprintf("response %s added", code)})
+		}
+	}
+	return diffs
+}
+
+func init() {
+	diffCmd.Flags().BoolVarP(&showAll, "all", "a", false, "(optional) list the compatible differences as well")
+	diffCmd.Flags().BoolVar(&failOnBreaking, "fail-on-breaking", false, "(optional) exit with an error when Meshery Server has breaking differences")
+}
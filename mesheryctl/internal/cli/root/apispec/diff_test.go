@@ -0,0 +1,146 @@
+package apispec
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const expectedFixture = `
+paths:
+  /api/pattern:
+    get:
+      parameters:
+      - in: query
+        name: page
+        type: string
+      responses:
+        "200":
+          $ref: '#/responses/patternsResponseWrapper'
+    post:
+      responses:
+        "200":
+          $ref: '#/responses/patternResponseWrapper'
+  /api/filter:
+    get:
+      responses:
+        "200":
+          description: ok
+definitions:
+  MesheryPattern:
+    properties:
+      id:
+        type: string
+      name:
+        type: string
+responses:
+  patternsResponseWrapper:
+    schema:
+      items:
+        $ref: '#/definitions/MesheryPattern'
+      type: array
+  patternResponseWrapper:
+    schema:
+      $ref: '#/definitions/MesheryPattern'
+`
+
+const actualFixture = `
+paths:
+  /api/pattern:
+    get:
+      parameters:
+      - in: query
+        name: page
+        type: integer
+      - in: query
+        name: search
+        type: string
+      - in: header
+        name: X-Workspace
+        required: true
+        type: string
+      responses:
+        "200":
+          $ref: '#/responses/patternsResponseWrapper'
+  /api/application:
+    get:
+      responses:
+        "200":
+          description: ok
+  /api/filter:
+    get:
+      responses:
+        "200":
+          description: ok
+definitions:
+  MesheryPattern:
+    properties:
+      id:
+        type: integer
+      pattern_file:
+        type: string
+responses:
+  patternsResponseWrapper:
+    schema:
+      $ref: '#/definitions/MesheryPattern'
+`
+
+func TestDiffSpecs(t *testing.T) {
+	expected, err := parseSpec([]byte(expectedFixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := parseSpec([]byte(actualFixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []difference{
+		{Breaking: false, Location: "GET /api/application", Change: "operation added"},
+		{Breaking: true, Location: "GET /api/pattern", Change: "query parameter page changed from string to integer"},
+		{Breaking: false, Location: "GET /api/pattern", Change: "query parameter search added"},
+		{Breaking: true, Location: "GET /api/pattern", Change: "required header parameter X-Workspace added"},
+		{Breaking: true, Location: "GET /api/pattern", Change: "response 200 changed from []MesheryPattern to MesheryPattern"},
+		{Breaking: true, Location: "POST /api/pattern", Change: "operation removed"},
+		{Breaking: true, Location: "definition MesheryPattern", Change: "field id changed from string to integer"},
+		{Breaking: true, Location: "definition MesheryPattern", Change: "field name removed"},
+		{Breaking: false, Location: "definition MesheryPattern", Change: "field pattern_file added"},
+	}
+	got := diffSpecs(expected, actual)
+	if len(got) != len(want) {
+		t.Fatalf("got %d differences, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("difference %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestEmbeddedSpec(t *testing.T) {
+	expected, err := parseSpec(expectedSpec)
+	if err != nil {
+		t.Fatalf("embedded API specification cannot be parsed: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/swagger.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(expectedSpec)
+	}))
+	defer server.Close()
+
+	actual, err := fetchServerSpec(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diffs := diffSpecs(expected, actual); len(diffs) != 0 {
+		t.Errorf("expected no differences against the same specification, got %+v", diffs)
+	}
+
+	if _, err := fetchServerSpec(server.URL + "/missing"); err == nil {
+		t.Error("expected an error when the specification is not served")
+	}
+}
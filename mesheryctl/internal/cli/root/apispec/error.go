@@ -0,0 +1,21 @@
+package apispec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrFetchAPISpecCode = "1061"
+	ErrParseAPISpecCode = "1062"
+)
+
+func ErrFetchAPISpec(status int, message string) error {
+	return errors.New(ErrFetchAPISpecCode, errors.Alert, []string{"Unable to fetch the API specification of Meshery Server"}, []string{fmt.Sprintf("Meshery Server returned status %d: %s", status, strings.TrimSpace(message))}, []string{"Meshery Server is not running", "Meshery Server does not serve its API specification at /swagger.yaml"}, []string{"Check that Meshery Server is running with mesheryctl system status"})
+}
+
+func ErrParseAPISpec(source string, err error) error {
+	return errors.New(ErrParseAPISpecCode, errors.Alert, []string{"Unable to parse the API specification"}, []string{fmt.Sprintf("%s: %s", source, err)}, []string{"The API specification is not a valid OpenAPI 2.0 document"}, []string{"Check that the URL of the current context points to Meshery Server"})
+}
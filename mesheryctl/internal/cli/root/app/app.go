@@ -17,7 +17,7 @@ var (
 var AppCmd = &cobra.Command{
 	Use:   "app",
 	Short: "Service Mesh Apps Management",
-	Long:  `Manage all apps operations; ;list, view, onboard and offboard`,
+	Long:  `Manage all apps operations; list, view, onboard, offboard, status, rollout and scale`,
 	Args:  cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
@@ -30,6 +30,6 @@ var AppCmd = &cobra.Command{
 func init() {
 	AppCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
 
-	availableSubcommands = []*cobra.Command{onboardCmd, viewCmd, offboardCmd, listCmd}
+	availableSubcommands = []*cobra.Command{onboardCmd, viewCmd, offboardCmd, listCmd, statusCmd, rolloutCmd, scaleCmd}
 	AppCmd.AddCommand(availableSubcommands...)
 }
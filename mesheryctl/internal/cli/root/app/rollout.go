@@ -0,0 +1,82 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var rolloutComponents []string
+
+var rolloutCmd = &cobra.Command{
+	Use:   "rollout",
+	Short: "Manage the rollout of an application",
+	Long:  `Manage the rollout of the workloads of an application`,
+	Example: `
+// Restart the workloads of an application
+mesheryctl app rollout restart bookinfo
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var rolloutRestartCmd = &cobra.Command{
+	Use:   "restart <application name|application id>",
+	Short: "Restart the workloads of an application",
+	Long: `Restart the Deployments, StatefulSets and DaemonSets of an application the way kubectl rollout restart does,
+replacing their pods one after another. Every workload of the application is restarted unless components are given.`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// Restart the workloads of an application
+mesheryctl app rollout restart bookinfo
+
+// Restart the workloads of two components of an application
+mesheryctl app rollout restart bookinfo --component reviews-v1 --component ratings
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		baseURL := mctlCfg.GetBaseMesheryURL()
+
+		app, err := fetchApplication(baseURL, strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+		payload, err := json.Marshal(models.ApplicationRolloutRequest{Components: rolloutComponents})
+		if err != nil {
+			return err
+		}
+		result := &models.ApplicationOperationResult{}
+		if err := doApplicationRequest("POST", baseURL+"/api/application/"+app.ID.String()+"/rollout/restart", bytes.NewReader(payload), result); err != nil {
+			return err
+		}
+
+		if len(result.Workloads) == 0 {
+			utils.Log.Info(fmt.Sprintf("Application %s has no workload to restart", app.Name))
+			return nil
+		}
+		for _, workload := range result.Workloads {
+			utils.Log.Info(fmt.Sprintf("%s restarted", workload))
+		}
+		utils.Log.Info(fmt.Sprintf("Follow the rollout with: mesheryctl app status %s", app.Name))
+		return nil
+	},
+}
+
+func init() {
+	rolloutRestartCmd.Flags().StringArrayVar(&rolloutComponents, "component", []string{}, "(optional) component whose workload is restarted (repeatable), every workload by default")
+
+	rolloutCmd.AddCommand(rolloutRestartCmd)
+}
@@ -0,0 +1,67 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	scaleComponent string
+	scaleReplicas  int32
+)
+
+var scaleCmd = &cobra.Command{
+	Use:   "scale <application name|application id>",
+	Short: "Scale a component of an application",
+	Long: `Set the number of replicas of the Deployment or StatefulSet of a component of an application in the cluster.
+The application itself is left as is, so onboarding it again restores the replicas it defines.`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// Scale the reviews-v1 component of an application to 3 replicas
+mesheryctl app scale bookinfo --component reviews-v1 --replicas 3
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if scaleReplicas < 0 {
+			return errors.New("--replicas must be at least 0")
+		}
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		baseURL := mctlCfg.GetBaseMesheryURL()
+
+		app, err := fetchApplication(baseURL, strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+		payload, err := json.Marshal(models.ApplicationScaleRequest{Component: scaleComponent, Replicas: scaleReplicas})
+		if err != nil {
+			return err
+		}
+		result := &models.ApplicationOperationResult{}
+		if err := doApplicationRequest("POST", baseURL+"/api/application/"+app.ID.String()+"/scale", bytes.NewReader(payload), result); err != nil {
+			return err
+		}
+
+		for _, workload := range result.Workloads {
+			utils.Log.Info(fmt.Sprintf("%s scaled to %d replicas", workload, scaleReplicas))
+		}
+		return nil
+	},
+}
+
+func init() {
+	scaleCmd.Flags().StringVar(&scaleComponent, "component", "", "(required) component whose workload is scaled")
+	scaleCmd.Flags().Int32Var(&scaleReplicas, "replicas", 0, "(required) number of replicas")
+	_ = scaleCmd.MarkFlagRequired("component")
+	_ = scaleCmd.MarkFlagRequired("replicas")
+}
@@ -0,0 +1,129 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status <application name|application id>",
+	Short: "Display the status of an application",
+	Long: `Display the readiness of the Deployments, StatefulSets and DaemonSets of an application, as discovered by
+MeshSync in the cluster`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// Display the readiness of the workloads of an application
+mesheryctl app status bookinfo
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		baseURL := mctlCfg.GetBaseMesheryURL()
+
+		app, err := fetchApplication(baseURL, strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+		status := &models.ApplicationStatus{}
+		if err := doApplicationRequest("GET", baseURL+"/api/application/"+app.ID.String()+"/status", nil, status); err != nil {
+			return err
+		}
+
+		if len(status.Workloads) == 0 {
+			utils.Log.Info(fmt.Sprintf("Application %s has no Deployment, StatefulSet or DaemonSet", status.Name))
+			return nil
+		}
+		var data [][]string
+		for _, w := range status.Workloads {
+			ready := fmt.Sprintf("%d/%d", w.ReadyReplicas, w.Replicas)
+			if !w.Found {
+				ready = "-"
+			}
+			data = append(data, []string{w.Component, w.Kind + "/" + w.Name, w.Namespace, ready, w.Reason})
+		}
+		utils.PrintToTable([]string{"COMPONENT", "WORKLOAD", "NAMESPACE", "READY", "REASON"}, data)
+		utils.Log.Info(fmt.Sprintf("Application %s: %d/%d workloads ready", status.Name, status.ReadyWorkloads, len(status.Workloads)))
+		return nil
+	},
+}
+
+// fetchApplication returns the saved application with the given id, or with the given name
+func fetchApplication(baseURL, application string) (*models.MesheryApplication, error) {
+	isID, err := regexp.MatchString("^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}$", application)
+	if err != nil {
+		return nil, err
+	}
+	if isID {
+		app := &models.MesheryApplication{}
+		if err := doApplicationRequest("GET", baseURL+"/api/application/"+application, nil, app); err != nil {
+			return nil, err
+		}
+		return app, nil
+	}
+
+	response := &models.ApplicationsAPIResponse{}
+	if err := doApplicationRequest("GET", baseURL+"/api/application?search="+url.QueryEscape(application), nil, response); err != nil {
+		return nil, err
+	}
+	var matches []models.MesheryApplication
+	for _, app := range response.Applications {
+		if app.Name == application {
+			matches = append(matches, app)
+		}
+	}
+	switch {
+	case len(matches) == 0:
+		return nil, errors.Errorf("application %s does not exist, use `mesheryctl app list` to see a list of applications", application)
+	case len(matches) > 1:
+		return nil, errors.Errorf("%d applications are named %s, use the id of the application instead", len(matches), application)
+	}
+	return &matches[0], nil
+}
+
+// doApplicationRequest sends the request to Meshery Server and unmarshals the response into out
+func doApplicationRequest(method, url string, body io.Reader, out interface{}) error {
+	req, err := utils.NewRequest(method, url, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// failsafe for not being authenticated
+	if utils.ContentTypeIsHTML(res) {
+		return errors.New("invalid authentication token, log in with `mesheryctl system login`")
+	}
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("Response Status Code %d, %s", res.StatusCode, strings.TrimSpace(string(data)))
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return errors.Wrap(err, "failed to unmarshal response body")
+	}
+	return nil
+}
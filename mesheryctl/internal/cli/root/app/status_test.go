@@ -0,0 +1,63 @@
+package app
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestFetchApplication(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	defer func(tokenFlag string) { utils.TokenFlag = tokenFlag }(utils.TokenFlag)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "token.golden")
+	utils.SetupMeshkitLoggerTesting(t, false)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	baseURL := "http://localhost:9081"
+	applications := `{"page":0,"page_size":25,"total_count":3,"applications":[
+		{"id":"a8b7a7f2-53d5-4a2e-9a8b-0e6f4d3d2a11","name":"bookinfo","application_file":""},
+		{"id":"b2c1d0e9-8f7a-4b6c-8d5e-4f3a2b1c0d9e","name":"bookinfo-v2","application_file":""},
+		{"id":"c3d2e1f0-9a8b-4c7d-9e6f-5a4b3c2d1e0f","name":"shop","application_file":""},
+		{"id":"d4e3f2a1-0b9c-4d8e-af7a-6b5c4d3e2f1a","name":"shop","application_file":""}]}`
+	httpmock.RegisterResponder("GET", baseURL+"/api/application",
+		httpmock.NewStringResponder(200, applications))
+	httpmock.RegisterResponder("GET", baseURL+"/api/application/a8b7a7f2-53d5-4a2e-9a8b-0e6f4d3d2a11",
+		httpmock.NewStringResponder(200, `{"id":"a8b7a7f2-53d5-4a2e-9a8b-0e6f4d3d2a11","name":"bookinfo","application_file":""}`))
+
+	tests := []struct {
+		name        string
+		application string
+		wantName    string
+		expectError bool
+	}{
+		{name: "by name", application: "bookinfo", wantName: "bookinfo"},
+		{name: "by id", application: "a8b7a7f2-53d5-4a2e-9a8b-0e6f4d3d2a11", wantName: "bookinfo"},
+		{name: "not found", application: "book", expectError: true},
+		{name: "ambiguous name", application: "shop", expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, err := fetchApplication(baseURL, tt.application)
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if app.Name != tt.wantName {
+				t.Errorf("got application %s, want %s", app.Name, tt.wantName)
+			}
+		})
+	}
+}
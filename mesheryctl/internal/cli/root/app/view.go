@@ -156,4 +156,5 @@ var viewCmd = &cobra.Command{
 func init() {
 	viewCmd.Flags().BoolVarP(&viewAllFlag, "all", "a", false, "(optional) view all applications available")
 	viewCmd.Flags().StringVarP(&outFormatFlag, "output-format", "o", "yaml", "(optional) format to display in [json|yaml]")
+	utils.SetOutputFormats(viewCmd, "json", "yaml")
 }
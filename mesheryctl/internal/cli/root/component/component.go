@@ -0,0 +1,34 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+)
+
+// ComponentCmd represents the root command for component commands
+var ComponentCmd = &cobra.Command{
+	Use:   "component",
+	Short: "Explore Registered Components",
+	Long:  `Explore the components registered in Meshery which can be used in designs`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	ComponentCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+
+	availableSubcommands = []*cobra.Command{docCmd}
+	ComponentCmd.AddCommand(availableSubcommands...)
+}
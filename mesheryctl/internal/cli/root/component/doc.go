@@ -0,0 +1,398 @@
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	modelFlag        string
+	outputFormatFlag string
+)
+
+// registeredComponent is the subset of a registered workload used to document it
+type registeredComponent struct {
+	OAMDefinition struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Spec struct {
+			Metadata map[string]string `json:"metadata"`
+		} `json:"spec"`
+	} `json:"oam_definition"`
+	OAMRefSchema string            `json:"oam_ref_schema"`
+	Metadata     map[string]string `json:"metadata"`
+}
+
+// kind returns the kind of resource the component stands for
+func (c registeredComponent) kind() string {
+	if kind := c.OAMDefinition.Spec.Metadata["k8sKind"]; kind != "" {
+		return kind
+	}
+	return strings.SplitN(c.OAMDefinition.Metadata.Name, ".", 2)[0]
+}
+
+// models returns the names of the model the component belongs to, i.e. the service
+// mesh or adapter which registered it, or kubernetes for the core resources
+func (c registeredComponent) models() []string {
+	models := []string{}
+	if name := c.OAMDefinition.Spec.Metadata["meshName"]; name != "" {
+		models = append(models, name)
+	}
+	if parts := strings.SplitN(c.OAMDefinition.Metadata.Name, ".", 2); len(parts) == 2 {
+		models = append(models, parts[1])
+	}
+	if name := c.Metadata["adapter.meshery.io/name"]; name != "" {
+		models = append(models, name)
+	}
+	if c.OAMDefinition.Spec.Metadata["@type"] == "pattern.meshery.io/k8s" {
+		models = append(models, "kubernetes")
+	}
+	if len(models) == 0 {
+		models = append(models, "core")
+	}
+	return models
+}
+
+// model returns the display name of the model the component belongs to
+func (c registeredComponent) model() string {
+	return strings.ToLower(c.models()[0])
+}
+
+func (c registeredComponent) belongsTo(model string) bool {
+	for _, m := range c.models() {
+		if strings.EqualFold(m, model) {
+			return true
+		}
+	}
+	return false
+}
+
+var docCmd = &cobra.Command{
+	Use:   "doc [kind]",
+	Short: "Document the settings of a component",
+	Long: `Render the JSON schema of a registered component as field documentation listing the type,
+default and whether every field is required, so that designs can be written without the visual editor.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Document the settings of a Deployment
+mesheryctl component doc Deployment
+
+// Document a component of a given model as markdown
+mesheryctl component doc VirtualService --model istio-base -o markdown > virtualservice.md
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		if outputFormatFlag != "table" && outputFormatFlag != "markdown" {
+			return errors.Errorf("invalid output format %q, must be one of table or markdown", outputFormatFlag)
+		}
+
+		components, err := fetchComponents(mctlCfg.GetBaseMesheryURL() + "/api/oam/workload")
+		if err != nil {
+			return err
+		}
+
+		comp, err := findComponent(components, args[0], modelFlag)
+		if err != nil {
+			return err
+		}
+
+		schema := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(comp.OAMRefSchema), &schema); err != nil {
+			return errors.Wrapf(err, "failed to parse the schema of %s", comp.kind())
+		}
+		fields := schemaFields(schema)
+
+		if outputFormatFlag == "markdown" {
+			fmt.Print(markdownDoc(comp, schema, fields))
+			return nil
+		}
+
+		utils.Log.Info(fmt.Sprintf("%s (%s)", comp.kind(), comp.model()))
+		if description, _ := schema["description"].(string); description != "" {
+			utils.Log.Info(description)
+		}
+		if len(fields) == 0 {
+			utils.Log.Info("The component has no documented settings")
+			return nil
+		}
+		rows := [][]string{}
+		for _, f := range fields {
+			rows = append(rows, []string{f.Path, f.Type, yesNo(f.Required), f.Default, f.Description})
+		}
+		utils.PrintToTable([]string{"FIELD", "TYPE", "REQUIRED", "DEFAULT", "DESCRIPTION"}, rows)
+		return nil
+	},
+}
+
+// fetchComponents returns the workloads registered in Meshery
+func fetchComponents(url string) ([]registeredComponent, error) {
+	req, err := utils.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("Response Status Code %d: %s", res.StatusCode, string(data))
+	}
+
+	components := []registeredComponent{}
+	if err := json.Unmarshal(data, &components); err != nil {
+		return nil, err
+	}
+	return components, nil
+}
+
+// findComponent returns the component of the given kind, restricted to the given
+// model if any. It fails when the kind is registered by more than one model and
+// none is given.
+func findComponent(components []registeredComponent, kind, model string) (registeredComponent, error) {
+	matches := []registeredComponent{}
+	models := map[string]bool{}
+	for _, c := range components {
+		if !strings.EqualFold(c.kind(), kind) || c.OAMRefSchema == "" {
+			continue
+		}
+		if model != "" && !c.belongsTo(model) {
+			continue
+		}
+		matches = append(matches, c)
+		models[c.model()] = true
+	}
+
+	if len(matches) == 0 {
+		if model != "" {
+			return registeredComponent{}, errors.Errorf("no component %s found for model %s", kind, model)
+		}
+		return registeredComponent{}, errors.Errorf("no component %s found", kind)
+	}
+	if len(models) > 1 {
+		names := []string{}
+		for m := range models {
+			names = append(names, m)
+		}
+		sort.Strings(names)
+		return registeredComponent{}, errors.Errorf("component %s is registered by more than one model, select one of %s with --model", kind, strings.Join(names, ", "))
+	}
+	return matches[0], nil
+}
+
+// schemaField is the documentation of a single field of a component
+type schemaField struct {
+	Path        string
+	Type        string
+	Required    bool
+	Default     string
+	Description string
+}
+
+// maxSchemaDepth bounds the nesting documented, which also guards against recursive schemas
+const maxSchemaDepth = 10
+
+// schemaFields flattens the properties of the JSON schema into field documentation,
+// nested fields being named by their dotted path and array items by a [] suffix
+func schemaFields(schema map[string]interface{}) []schemaField {
+	definitions, _ := schema["definitions"].(map[string]interface{})
+	fields := []schemaField{}
+
+	var walk func(prefix string, s map[string]interface{}, depth int)
+	walk = func(prefix string, s map[string]interface{}, depth int) {
+		if depth > maxSchemaDepth {
+			return
+		}
+		s = resolveRef(s, definitions)
+
+		required := map[string]bool{}
+		if req, ok := s["required"].([]interface{}); ok {
+			for _, r := range req {
+				if name, ok := r.(string); ok {
+					required[name] = true
+				}
+			}
+		}
+
+		props, _ := s["properties"].(map[string]interface{})
+		names := make([]string, 0, len(props))
+		for name := range props {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			prop, ok := props[name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			prop = resolveRef(prop, definitions)
+
+			path := name
+			if prefix != "" {
+				path = prefix + "." + name
+			}
+			fields = append(fields, schemaField{
+				Path:        path,
+				Type:        schemaType(prop, definitions),
+				Required:    required[name],
+				Default:     schemaDefault(prop),
+				Description: schemaDescription(prop),
+			})
+
+			if _, ok := prop["properties"]; ok {
+				walk(path, prop, depth+1)
+			}
+			if items, ok := prop["items"].(map[string]interface{}); ok {
+				items = resolveRef(items, definitions)
+				if _, ok := items["properties"]; ok {
+					walk(path+"[]", items, depth+1)
+				}
+			}
+		}
+	}
+	walk("", schema, 0)
+
+	return fields
+}
+
+// resolveRef returns the schema referenced by a local $ref, or the schema itself
+func resolveRef(s map[string]interface{}, definitions map[string]interface{}) map[string]interface{} {
+	ref, ok := s["$ref"].(string)
+	if !ok || !strings.HasPrefix(ref, "#/definitions/") {
+		return s
+	}
+	if def, ok := definitions[strings.TrimPrefix(ref, "#/definitions/")].(map[string]interface{}); ok {
+		return def
+	}
+	return s
+}
+
+func schemaType(s map[string]interface{}, definitions map[string]interface{}) string {
+	typ := ""
+	switch t := s["type"].(type) {
+	case string:
+		typ = t
+	case []interface{}:
+		types := []string{}
+		for _, v := range t {
+			if name, ok := v.(string); ok && name != "null" {
+				types = append(types, name)
+			}
+		}
+		typ = strings.Join(types, "|")
+	}
+
+	switch typ {
+	case "array":
+		if items, ok := s["items"].(map[string]interface{}); ok {
+			return "[]" + schemaType(resolveRef(items, definitions), definitions)
+		}
+	case "object", "":
+		if additional, ok := s["additionalProperties"].(map[string]interface{}); ok {
+			return "map[string]" + schemaType(resolveRef(additional, definitions), definitions)
+		}
+		if _, ok := s["properties"]; ok || typ == "object" {
+			return "object"
+		}
+		if _, ok := s["x-kubernetes-int-or-string"]; ok {
+			return "integer|string"
+		}
+		return "any"
+	}
+	return typ
+}
+
+func schemaDefault(s map[string]interface{}) string {
+	def, ok := s["default"]
+	if !ok {
+		return ""
+	}
+	byt, err := json.Marshal(def)
+	if err != nil {
+		return fmt.Sprintf("%v", def)
+	}
+	return string(byt)
+}
+
+func schemaDescription(s map[string]interface{}) string {
+	description, _ := s["description"].(string)
+	description = strings.Join(strings.Fields(description), " ")
+
+	if enum, ok := s["enum"].([]interface{}); ok && len(enum) > 0 {
+		values := []string{}
+		for _, v := range enum {
+			values = append(values, fmt.Sprintf("%v", v))
+		}
+		if description != "" {
+			description += " "
+		}
+		description += "One of: " + strings.Join(values, ", ")
+	}
+	return description
+}
+
+// markdownDoc renders the documentation of the component as a markdown document
+func markdownDoc(comp registeredComponent, schema map[string]interface{}, fields []schemaField) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("# %s\n\n", comp.kind()))
+	b.WriteString(fmt.Sprintf("Model: `%s`\n\n", comp.model()))
+	if apiVersion := comp.OAMDefinition.Spec.Metadata["k8sAPIVersion"]; apiVersion != "" {
+		b.WriteString(fmt.Sprintf("API Version: `%s`\n\n", strings.TrimPrefix(apiVersion, "/")))
+	}
+	if description, _ := schema["description"].(string); description != "" {
+		b.WriteString(description + "\n\n")
+	}
+	if len(fields) == 0 {
+		b.WriteString("The component has no documented settings.\n")
+		return b.String()
+	}
+
+	b.WriteString("| Field | Type | Required | Default | Description |\n")
+	b.WriteString("| --- | --- | --- | --- | --- |\n")
+	for _, f := range fields {
+		def := ""
+		if f.Default != "" {
+			def = "`" + markdownEscaper.Replace(f.Default) + "`"
+		}
+		b.WriteString(fmt.Sprintf("| `%s` | %s | %s | %s | %s |\n",
+			f.Path, markdownEscaper.Replace(f.Type), yesNo(f.Required), def, markdownEscaper.Replace(f.Description)))
+	}
+	return b.String()
+}
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\n", " ")
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func init() {
+	docCmd.Flags().StringVarP(&modelFlag, "model", "m", "", "(optional) model the component belongs to, e.g. a service mesh or kubernetes")
+	docCmd.Flags().StringVarP(&outputFormatFlag, "output-format", "o", "table", "(optional) format to display in [table|markdown]")
+	utils.SetOutputFormats(docCmd, "table")
+}
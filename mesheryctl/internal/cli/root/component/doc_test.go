@@ -0,0 +1,90 @@
+package component
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSchema = `{
+	"description": "VirtualService settings",
+	"required": ["hosts"],
+	"definitions": {
+		"destination": {
+			"type": "object",
+			"required": ["host"],
+			"properties": {
+				"host": {"type": "string", "description": "Service to route to"},
+				"port": {"type": "integer", "default": 80}
+			}
+		}
+	},
+	"properties": {
+		"hosts": {"type": "array", "items": {"type": "string"}, "description": "Destination hosts"},
+		"http": {
+			"type": "array",
+			"items": {
+				"type": "object",
+				"properties": {
+					"route": {"$ref": "#/definitions/destination"},
+					"headers": {"type": "object", "additionalProperties": {"type": "string"}}
+				}
+			}
+		},
+		"mode": {"type": "string", "enum": ["STRICT", "PERMISSIVE"]}
+	}
+}`
+
+func TestSchemaFields(t *testing.T) {
+	schema := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(testSchema), &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	got := schemaFields(schema)
+	want := []schemaField{
+		{Path: "hosts", Type: "[]string", Required: true, Description: "Destination hosts"},
+		{Path: "http", Type: "[]object"},
+		{Path: "http[].headers", Type: "map[string]string"},
+		{Path: "http[].route", Type: "object"},
+		{Path: "http[].route.host", Type: "string", Required: true, Description: "Service to route to"},
+		{Path: "http[].route.port", Type: "integer", Default: "80"},
+		{Path: "mode", Type: "string", Description: "One of: STRICT, PERMISSIVE"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got fields\n%+v\nwant\n%+v", got, want)
+	}
+
+	md := markdownDoc(registeredComponent{}, schema, got)
+	if !strings.Contains(md, "| `http[].route.port` | integer | no | `80` |  |") {
+		t.Errorf("unexpected markdown:\n%s", md)
+	}
+}
+
+func TestFindComponent(t *testing.T) {
+	newComponent := func(name, meshName string) registeredComponent {
+		c := registeredComponent{OAMRefSchema: "{}"}
+		c.OAMDefinition.Metadata.Name = name
+		c.OAMDefinition.Spec.Metadata = map[string]string{"k8sKind": "VirtualService", "meshName": meshName}
+		return c
+	}
+	components := []registeredComponent{
+		newComponent("VirtualService.ISTIO", "istio-base"),
+		newComponent("VirtualService.OTHER", "other"),
+	}
+
+	if _, err := findComponent(components, "virtualservice", ""); err == nil {
+		t.Error("expected an error for a kind registered by more than one model")
+	}
+	c, err := findComponent(components, "VirtualService", "istio-base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.OAMDefinition.Metadata.Name != "VirtualService.ISTIO" {
+		t.Errorf("got component %s want VirtualService.ISTIO", c.OAMDefinition.Metadata.Name)
+	}
+	if _, err := findComponent(components, "Gateway", ""); err == nil {
+		t.Error("expected an error for an unknown kind")
+	}
+}
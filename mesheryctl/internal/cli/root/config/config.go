@@ -26,6 +26,17 @@ type MesheryCtlConfig struct {
 	Contexts       map[string]Context `mapstructure:"contexts"`
 	CurrentContext string             `mapstructure:"current-context"`
 	Tokens         []Token            `mapstructure:"tokens"`
+	Preferences    Preferences        `mapstructure:"preferences,omitempty"`
+	// Aliases are the user-defined shortcuts of commands, by name
+	Aliases map[string]string `mapstructure:"aliases,omitempty"`
+}
+
+// Preferences defines the user preferences of mesheryctl, which can be synced
+// with Meshery Server using `mesheryctl config sync`
+type Preferences struct {
+	OutputFormat     string `mapstructure:"output-format,omitempty" yaml:"output-format,omitempty"`
+	SkipConfirmation bool   `mapstructure:"skip-confirmation" yaml:"skip-confirmation"`
+	TelemetryOptOut  bool   `mapstructure:"telemetry-opt-out" yaml:"telemetry-opt-out"`
 }
 
 // Token defines the structure of Token stored in mesheryctl
@@ -42,6 +53,10 @@ type Context struct {
 	Components []string `mapstructure:"components,omitempty"`
 	Channel    string   `mapstructure:"channel,omitempty"`
 	Version    string   `mapstructure:"version,omitempty"`
+	// Namespace is set when Meshery is not deployed to the meshery namespace and
+	// AdapterNamespace when the adapters are not deployed along with Meshery
+	Namespace        string `mapstructure:"namespace,omitempty" yaml:"namespace,omitempty"`
+	AdapterNamespace string `mapstructure:"adapter-namespace,omitempty" yaml:"adapter-namespace,omitempty"`
 }
 
 // GetMesheryCtl returns a reference to the mesheryctl configuration object
@@ -66,6 +81,18 @@ func UpdateContextInConfig(v *viper.Viper, context *Context, name string) error
 	return nil
 }
 
+// UpdatePreferencesInConfig writes the given preferences in meshconfig
+func UpdatePreferencesInConfig(v *viper.Viper, prefs *Preferences) error {
+	v.Set("preferences", prefs)
+	return v.WriteConfig()
+}
+
+// UpdateAliasesInConfig writes the given aliases in meshconfig
+func UpdateAliasesInConfig(v *viper.Viper, aliases map[string]string) error {
+	v.Set("aliases", aliases)
+	return v.WriteConfig()
+}
+
 // CheckIfCurrentContextIsValid checks if current context is valid
 func (mc *MesheryCtlConfig) CheckIfCurrentContextIsValid() (*Context, error) {
 	if mc.CurrentContext == "" {
@@ -212,6 +239,26 @@ func (ctx *Context) SetVersion(version string) {
 	ctx.Version = version
 }
 
+// GetNamespace returns the namespace of the current context
+func (ctx *Context) GetNamespace() string {
+	return ctx.Namespace
+}
+
+// SetNamespace sets the namespace of the current context
+func (ctx *Context) SetNamespace(namespace string) {
+	ctx.Namespace = namespace
+}
+
+// GetAdapterNamespace returns the adapter namespace of the current context
+func (ctx *Context) GetAdapterNamespace() string {
+	return ctx.AdapterNamespace
+}
+
+// SetAdapterNamespace sets the adapter namespace of the current context
+func (ctx *Context) SetAdapterNamespace(namespace string) {
+	ctx.AdapterNamespace = namespace
+}
+
 // ValidateVersion checks if the version is valid, if empty sets it to default value latest. Returns an error if the version is invalid.
 func (ctx *Context) ValidateVersion() error {
 	if ctx.Version == "" {
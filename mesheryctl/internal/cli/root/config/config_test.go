@@ -91,7 +91,7 @@ func TestGetChannel(t *testing.T) {
 
 func TestGetComponents(t *testing.T) {
 	dummy := []string{"abc", "def", "ghi", "jkl", "mno", "pqr"}
-	context := Context{"", "", "", dummy, "", ""}
+	context := Context{Components: dummy}
 	got := context.GetComponents()
 	want := dummy
 	for i, j := range got {
@@ -141,7 +141,7 @@ func TestGetEndpoint(t *testing.T) {
 }
 func TestGetCurrentContextName(t *testing.T) {
 	for _, test := range tests {
-		mesherycltconfig := MesheryCtlConfig{nil, test, nil}
+		mesherycltconfig := MesheryCtlConfig{nil, test, nil, Preferences{}, nil}
 		got := mesherycltconfig.GetCurrentContextName()
 		want := test
 
@@ -152,7 +152,7 @@ func TestGetCurrentContextName(t *testing.T) {
 }
 func TestSetContext(t *testing.T) {
 	for _, test := range tests {
-		mesherycltconfig := MesheryCtlConfig{nil, test, nil}
+		mesherycltconfig := MesheryCtlConfig{nil, test, nil, Preferences{}, nil}
 		err := UpdateContextInConfig(nil, nil, test)
 		if err != nil {
 			fmt.Print("Fail") //Internal:need to be fixed
@@ -231,7 +231,7 @@ func TestSetVersion(t *testing.T) {
 
 func TestSetComponents(t *testing.T) {
 	dummy := []string{"abc", "def", "ghi", "jkl", "mno", "pqr"}
-	context := Context{"", "", "", dummy, "", ""}
+	context := Context{Components: dummy}
 	got := context.GetComponents()
 	want := dummy
 	for i, j := range got {
@@ -4,6 +4,9 @@ var (
 	version        = "Not Set"
 	commitsha      = "Not Set"
 	releasechannel = "Not Set"
+	// releasesigningkey is the cosign public key, as the base64 of its DER encoding, whose signature of
+	// the checksums of a release is verified before mesheryctl upgrades itself to the release
+	releasesigningkey = ""
 )
 
 func GetMesheryctlVersion() string {
@@ -17,3 +20,7 @@ func GetMesheryctlCommitsha() string {
 func GetMesheryctlReleaseChannel() string {
 	return releasechannel
 }
+
+func GetMesheryctlReleaseSigningKey() string {
+	return releasesigningkey
+}
@@ -0,0 +1,36 @@
+package dashboard
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+)
+
+// DashboardCmd represents the root command for the snapshots of Meshery UI
+var DashboardCmd = &cobra.Command{
+	Use:   "dashboard",
+	Short: "Capture and share the state of Meshery UI",
+	Long: `Capture what Meshery UI shows, the page, the Kubernetes contexts selected, the filters applied and the
+design open, as a snapshot shared by its ID or by the deep link to the page, for others to look at exactly
+what you are seeing, such as during an incident`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	DashboardCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+
+	availableSubcommands = []*cobra.Command{snapshotCmd, viewCmd}
+	DashboardCmd.AddCommand(availableSubcommands...)
+}
@@ -0,0 +1,26 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrDashboardSnapshotCode   = "1092"
+	ErrInvalidFilterCode       = "1093"
+	ErrInvalidOutputFormatCode = "1094"
+)
+
+func ErrDashboardSnapshot(status int, message string) error {
+	return errors.New(ErrDashboardSnapshotCode, errors.Alert, []string{"Unable to capture or retrieve the snapshot of Meshery UI"}, []string{fmt.Sprintf("Meshery Server returned status %d: %s", status, strings.TrimSpace(message))}, []string{"The page is not a path of Meshery UI", "The design or the snapshot does not exist"}, []string{"Give the path of a page of Meshery UI with --page, such as /configuration/patterns", "Check the ID of the design or of the snapshot"})
+}
+
+func ErrInvalidFilter(filter string) error {
+	return errors.New(ErrInvalidFilterCode, errors.Alert, []string{"Invalid filter " + filter}, []string{"The filter " + filter + " is not of the form key=value"}, []string{}, []string{"Give the filters as key=value, such as --filter search=bookinfo"})
+}
+
+func ErrInvalidOutputFormat(format string) error {
+	return errors.New(ErrInvalidOutputFormatCode, errors.Alert, []string{"Invalid output format " + format}, []string{"The output format " + format + " is not supported"}, []string{}, []string{"Use one of link, json or yaml"})
+}
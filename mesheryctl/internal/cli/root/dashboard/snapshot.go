@@ -0,0 +1,215 @@
+package dashboard
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	page         string
+	contexts     []string
+	filters      []string
+	designID     string
+	title        string
+	outputFormat string
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Capture a snapshot of Meshery UI",
+	Long: `Capture the page of Meshery UI, the Kubernetes contexts selected, the filters applied and the design open
+as a snapshot, and print the deep link to the page showing it. Your current Kubernetes context is captured when
+no --context is given.`,
+	Args: cobra.NoArgs,
+	Example: `
+// Share the designs page filtered down to the bookinfo designs, with a design open
+mesheryctl exp dashboard snapshot --page /configuration/patterns --filter search=bookinfo --design 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c
+
+// Share the performance results of the prod-east cluster for an incident, as JSON
+mesheryctl exp dashboard snapshot --page /performance/results --context prod-east --title "INC-1234 latency" -o json
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := checkOutputFormat(); err != nil {
+			return err
+		}
+		state, err := dashboardState()
+		if err != nil {
+			return err
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		snapshot, err := captureSnapshot(mctlCfg.GetBaseMesheryURL(), &models.DashboardSnapshot{Title: title, State: *state})
+		if err != nil {
+			return err
+		}
+		return printSnapshot(mctlCfg.GetBaseMesheryURL(), snapshot)
+	},
+}
+
+var viewCmd = &cobra.Command{
+	Use:   "view [snapshot-id]",
+	Short: "View a snapshot of Meshery UI",
+	Long:  `View a snapshot of Meshery UI shared with you and the deep link to the page showing it`,
+	Args:  cobra.ExactArgs(1),
+	Example: `
+// Print the deep link of a snapshot shared with you
+mesheryctl exp dashboard view 5a8c3c1e-2b4f-4e0a-9d7e-6f1b2c3d4e5f
+
+// Print the snapshot as JSON
+mesheryctl exp dashboard view 5a8c3c1e-2b4f-4e0a-9d7e-6f1b2c3d4e5f -o json
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := checkOutputFormat(); err != nil {
+			return err
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		snapshot, err := fetchSnapshot(mctlCfg.GetBaseMesheryURL(), args[0])
+		if err != nil {
+			return err
+		}
+		return printSnapshot(mctlCfg.GetBaseMesheryURL(), snapshot)
+	},
+}
+
+func checkOutputFormat() error {
+	if outputFormat != "link" && outputFormat != "json" && outputFormat != "yaml" {
+		return ErrInvalidOutputFormat(outputFormat)
+	}
+	return nil
+}
+
+// dashboardState returns the state of Meshery UI given by the flags
+func dashboardState() (*models.DashboardState, error) {
+	state := &models.DashboardState{
+		Page:     page,
+		Contexts: contexts,
+		DesignID: designID,
+	}
+	if !strings.HasPrefix(state.Page, "/") {
+		state.Page = "/" + state.Page
+	}
+	for _, filter := range filters {
+		kv := strings.SplitN(filter, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, ErrInvalidFilter(filter)
+		}
+		if state.Filters == nil {
+			state.Filters = map[string]string{}
+		}
+		state.Filters[kv[0]] = kv[1]
+	}
+	return state, nil
+}
+
+// captureSnapshot has Meshery Server capture the snapshot
+func captureSnapshot(baseURL string, snapshot *models.DashboardSnapshot) (*models.DashboardSnapshot, error) {
+	payload, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	req, err := utils.NewRequest("POST", baseURL+"/api/user/dashboard/snapshots", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return doSnapshotRequest(req)
+}
+
+// fetchSnapshot returns the snapshot with the given id
+func fetchSnapshot(baseURL, id string) (*models.DashboardSnapshot, error) {
+	req, err := utils.NewRequest("GET", baseURL+"/api/user/dashboard/snapshots/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return doSnapshotRequest(req)
+}
+
+func doSnapshotRequest(req *http.Request) (*models.DashboardSnapshot, error) {
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// failsafe for not being authenticated
+	if utils.ContentTypeIsHTML(resp) {
+		return nil, errors.New("invalid authentication token, log in with `mesheryctl system login`")
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrDashboardSnapshot(resp.StatusCode, string(body))
+	}
+	snapshot := &models.DashboardSnapshot{}
+	if err := json.Unmarshal(body, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// printSnapshot prints the snapshot in the output format, the deep link to Meshery UI by default
+func printSnapshot(baseURL string, snapshot *models.DashboardSnapshot) error {
+	switch outputFormat {
+	case "json", "yaml":
+		body, err := json.MarshalIndent(snapshot, "", "  ")
+		if err != nil {
+			return err
+		}
+		if outputFormat == "yaml" {
+			if body, err = yaml.JSONToYAML(body); err != nil {
+				return err
+			}
+		}
+		fmt.Println(strings.TrimSpace(string(body)))
+		return nil
+	}
+
+	utils.Log.Info(fmt.Sprintf("Snapshot %s of %s", snapshot.ID, snapshot.State.Page))
+	if snapshot.Title != "" {
+		utils.Log.Info("Title: " + snapshot.Title)
+	}
+	if len(snapshot.State.Contexts) > 0 {
+		utils.Log.Info("Kubernetes contexts: " + strings.Join(snapshot.State.Contexts, ", "))
+	}
+	if snapshot.State.DesignID != "" {
+		utils.Log.Info(fmt.Sprintf("Design: %s (%s)", snapshot.State.DesignName, snapshot.State.DesignID))
+	}
+	utils.Log.Info(strings.TrimSuffix(baseURL, "/") + snapshot.Link)
+	return nil
+}
+
+func init() {
+	snapshotCmd.Flags().StringVar(&page, "page", "/", "(optional) path of the page of Meshery UI, such as /configuration/patterns")
+	snapshotCmd.Flags().StringSliceVar(&contexts, "context", nil, "(optional) names of the Kubernetes contexts selected, your current context by default")
+	snapshotCmd.Flags().StringArrayVar(&filters, "filter", nil, "(optional) filter applied to the page as key=value, such as search=bookinfo; may be repeated")
+	snapshotCmd.Flags().StringVar(&designID, "design", "", "(optional) ID of the design open")
+	snapshotCmd.Flags().StringVar(&title, "title", "", "(optional) title of the snapshot, such as the incident it is captured for")
+
+	for _, cmd := range []*cobra.Command{snapshotCmd, viewCmd} {
+		cmd.Flags().StringVarP(&outputFormat, "output-format", "o", "link", "Format of the snapshot: link, json or yaml")
+		utils.SetOutputFormats(cmd, "json", "yaml")
+	}
+}
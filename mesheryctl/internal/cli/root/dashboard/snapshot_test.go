@@ -0,0 +1,87 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshkit/errors"
+)
+
+func TestDashboardState(t *testing.T) {
+	defer func() { page, contexts, filters, designID = "/", nil, nil, "" }()
+
+	page, contexts, designID = "configuration/patterns", []string{"prod-east"}, "8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c"
+	filters = []string{"search=bookinfo", "order=name desc"}
+	state, err := dashboardState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &models.DashboardState{
+		Page:     "/configuration/patterns",
+		Contexts: []string{"prod-east"},
+		Filters:  map[string]string{"search": "bookinfo", "order": "name desc"},
+		DesignID: "8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c",
+	}
+	if !reflect.DeepEqual(state, want) {
+		t.Errorf("dashboardState() = %+v, want %+v", state, want)
+	}
+	if link := state.Link(); link != "/configuration/patterns?contexts=prod-east&design=8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c&order=name+desc&search=bookinfo" {
+		t.Errorf("unexpected deep link %s", link)
+	}
+
+	filters = []string{"bookinfo"}
+	if _, err := dashboardState(); errors.GetCode(err) != ErrInvalidFilterCode {
+		t.Errorf("dashboardState() error = %v, want code %s", err, ErrInvalidFilterCode)
+	}
+}
+
+func TestSnapshotRequests(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	_, filename, _, _ := runtime.Caller(0)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+
+	id := uuid.Must(uuid.FromString("5a8c3c1e-2b4f-4e0a-9d7e-6f1b2c3d4e5f"))
+	snapshotsURL := "http://localhost:9081/api/user/dashboard/snapshots"
+	httpmock.RegisterResponder("POST", snapshotsURL, func(req *http.Request) (*http.Response, error) {
+		snapshot := &models.DashboardSnapshot{}
+		if err := json.NewDecoder(req.Body).Decode(snapshot); err != nil {
+			return httpmock.NewStringResponse(http.StatusBadRequest, err.Error()), nil
+		}
+		snapshot.ID = &id
+		snapshot.State.Contexts = []string{"kind-meshery"}
+		snapshot.Link = snapshot.State.Link()
+		return httpmock.NewJsonResponse(http.StatusOK, snapshot)
+	})
+	httpmock.RegisterResponder("GET", snapshotsURL+"/"+id.String(), httpmock.NewStringResponder(http.StatusOK,
+		`{"id":"`+id.String()+`","state":{"page":"/performance/results"},"link":"/performance/results"}`))
+	httpmock.RegisterResponder("GET", snapshotsURL+"/missing", httpmock.NewStringResponder(http.StatusNotFound, "record not found"))
+
+	snapshot, err := captureSnapshot("http://localhost:9081", &models.DashboardSnapshot{Title: "INC-1234", State: models.DashboardState{Page: "/configuration/patterns"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.ID == nil || *snapshot.ID != id || snapshot.Title != "INC-1234" || snapshot.Link != "/configuration/patterns?contexts=kind-meshery" {
+		t.Errorf("unexpected captured snapshot %+v", snapshot)
+	}
+
+	snapshot, err = fetchSnapshot("http://localhost:9081", id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.State.Page != "/performance/results" || snapshot.Link != "/performance/results" {
+		t.Errorf("unexpected snapshot %+v", snapshot)
+	}
+	if _, err := fetchSnapshot("http://localhost:9081", "missing"); errors.GetCode(err) != ErrDashboardSnapshotCode {
+		t.Errorf("fetchSnapshot() error = %v, want code %s", err, ErrDashboardSnapshotCode)
+	}
+}
@@ -0,0 +1,101 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	ackAll        bool
+	ackSeverities []string
+	ackCategories []string
+)
+
+var ackCmd = &cobra.Command{
+	Use:   "ack [event id...]",
+	Short: "Acknowledge events",
+	Long:  `Acknowledge the events with the given IDs or, with --all, every event matching the filters`,
+	Example: `
+// Acknowledge an event
+mesheryctl events ack 6a0b5bb6-3b8f-4a3c-9e4b-f3f1c7b0e0a2
+
+// Acknowledge every warning of the adapters
+mesheryctl events ack --all --severity warning --category adapter
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		updated, err := updateEventsState("/api/system/events/acknowledge", args, ackAll, ackSeverities, ackCategories)
+		if err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("%d event(s) acknowledged", updated))
+		return nil
+	},
+}
+
+// eventStateRequest returns the request selecting the events with the given IDs or, when all
+// is set, every event matching the severities and categories
+func eventStateRequest(args []string, all bool, severities, categories []string) (*models.EventStateRequest, error) {
+	if all == (len(args) > 0) {
+		return nil, errors.New("either the IDs of the events or --all is required")
+	}
+	req := &models.EventStateRequest{}
+	if all {
+		filter := &models.EventFilter{}
+		for _, severity := range severities {
+			filter.Severities = append(filter.Severities, models.EventSeverity(severity))
+		}
+		for _, category := range categories {
+			filter.Categories = append(filter.Categories, models.EventCategory(category))
+		}
+		if err := filter.Validate(); err != nil {
+			return nil, err
+		}
+		req.Filter = filter
+		return req, nil
+	}
+	for _, arg := range args {
+		id, err := uuid.FromString(arg)
+		if err != nil {
+			return nil, errors.Errorf("invalid event ID %s, use `mesheryctl events list` to see the IDs of the events", arg)
+		}
+		req.IDs = append(req.IDs, id)
+	}
+	return req, nil
+}
+
+// updateEventsState sends the request acknowledging or resolving the events to the given endpoint
+// and returns the number of events whose state changed
+func updateEventsState(path string, args []string, all bool, severities, categories []string) (int, error) {
+	stateReq, err := eventStateRequest(args, all, severities, categories)
+	if err != nil {
+		return 0, err
+	}
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return 0, errors.Wrap(err, "error processing config")
+	}
+	payload, err := json.Marshal(stateReq)
+	if err != nil {
+		return 0, err
+	}
+	result := &models.EventStateResult{}
+	if err := doEventsRequest("POST", mctlCfg.GetBaseMesheryURL()+path, bytes.NewReader(payload), result); err != nil {
+		return 0, err
+	}
+	return result.Updated, nil
+}
+
+func init() {
+	ackCmd.Flags().BoolVar(&ackAll, "all", false, "(optional) acknowledge every event matching the filters")
+	ackCmd.Flags().StringSliceVar(&ackSeverities, "severity", []string{}, "(optional) with --all, severities of the events, info, warning or error")
+	ackCmd.Flags().StringSliceVar(&ackCategories, "category", []string{}, "(optional) with --all, categories of the events, adapter, health, performance, design, config or kubernetes")
+}
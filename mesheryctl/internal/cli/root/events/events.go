@@ -0,0 +1,33 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var availableSubcommands []*cobra.Command
+
+// EventsCmd represents the root command for events commands
+var EventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Meshery Events Management",
+	Long: `Manage the events recorded by Meshery; list, acknowledge and resolve them.
+Events are shared by every user, who each acknowledge and resolve them on their own.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	EventsCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+
+	availableSubcommands = []*cobra.Command{listCmd, ackCmd, resolveCmd}
+	EventsCmd.AddCommand(availableSubcommands...)
+}
@@ -0,0 +1,118 @@
+package events
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestEventsQuery(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		severities     []string
+		categories     []string
+		unacknowledged bool
+		unresolved     bool
+		since          time.Duration
+		limit          int
+		want           string
+	}{
+		{name: "no filter", want: ""},
+		{name: "limit", limit: 25, want: "limit=25"},
+		{
+			name:           "every filter",
+			severities:     []string{"error", "warning"},
+			categories:     []string{"adapter"},
+			unacknowledged: true,
+			unresolved:     true,
+			since:          24 * time.Hour,
+			limit:          10,
+			want:           "acknowledged=false&category=adapter&limit=10&resolved=false&severity=error%2Cwarning&since=2022-02-28T12%3A00%3A00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := eventsQuery(tt.severities, tt.categories, tt.unacknowledged, tt.unresolved, tt.since, tt.limit, now).Encode()
+			if got != tt.want {
+				t.Errorf("got query %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventStateRequest(t *testing.T) {
+	id := "6a0b5bb6-3b8f-4a3c-9e4b-f3f1c7b0e0a2"
+	tests := []struct {
+		name        string
+		args        []string
+		all         bool
+		severities  []string
+		expectError bool
+		wantIDs     int
+	}{
+		{name: "ids", args: []string{id, id}, wantIDs: 2},
+		{name: "all", all: true, severities: []string{"error"}},
+		{name: "neither ids nor all", expectError: true},
+		{name: "both ids and all", args: []string{id}, all: true, expectError: true},
+		{name: "invalid id", args: []string{"abc"}, expectError: true},
+		{name: "invalid severity", all: true, severities: []string{"fatal"}, expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := eventStateRequest(tt.args, tt.all, tt.severities, nil)
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(req.IDs) != tt.wantIDs {
+				t.Errorf("got %d IDs, want %d", len(req.IDs), tt.wantIDs)
+			}
+			if tt.all && (req.Filter == nil || len(req.Filter.Severities) != len(tt.severities)) {
+				t.Errorf("got filter %+v, want the severities %v", req.Filter, tt.severities)
+			}
+		})
+	}
+}
+
+func TestDoEventsRequest(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	defer func(tokenFlag string) { utils.TokenFlag = tokenFlag }(utils.TokenFlag)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "token.golden")
+	utils.SetupMeshkitLoggerTesting(t, false)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	baseURL := "http://localhost:9081"
+	httpmock.RegisterResponder("GET", baseURL+"/api/system/events",
+		httpmock.NewStringResponder(200, `[{"id":"6a0b5bb6-3b8f-4a3c-9e4b-f3f1c7b0e0a2","severity":"error","category":"health","event_type":2,
+			"summary":"Adapter meshery-istio:10000 is unavailable","source":"adapter-health","acknowledged":true,"resolved":false}]`))
+	httpmock.RegisterResponder("POST", baseURL+"/api/system/events/acknowledge",
+		httpmock.NewStringResponder(400, "1 of the 1 events do not exist"))
+
+	var events []models.UserEvent
+	if err := doEventsRequest("GET", baseURL+"/api/system/events?severity=error", nil, &events); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Severity != models.EventSeverityError || eventState(events[0]) != "acknowledged" {
+		t.Errorf("got events %+v", events)
+	}
+
+	if err := doEventsRequest("POST", baseURL+"/api/system/events/acknowledge", nil, &models.EventStateResult{}); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,149 @@
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	listSeverities     []string
+	listCategories     []string
+	listUnacknowledged bool
+	listUnresolved     bool
+	listSince          time.Duration
+	listLimit          int
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the events",
+	Long:  `List the events recorded by Meshery, the latest first, along with whether you acknowledged and resolved them`,
+	Args:  cobra.NoArgs,
+	Example: `
+// List the last events
+mesheryctl events list
+
+// List the errors and warnings of the adapters you did not acknowledge yet
+mesheryctl events list --severity error,warning --category adapter --unacknowledged
+
+// List the events of the last day
+mesheryctl events list --since 24h
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		query := eventsQuery(listSeverities, listCategories, listUnacknowledged, listUnresolved, listSince, listLimit, time.Now())
+		var events []models.UserEvent
+		if err := doEventsRequest("GET", mctlCfg.GetBaseMesheryURL()+"/api/system/events?"+query.Encode(), nil, &events); err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			utils.Log.Info("No events found")
+			return nil
+		}
+		var data [][]string
+		for _, event := range events {
+			created := ""
+			if event.CreatedAt != nil {
+				created = event.CreatedAt.Local().Format("2006-01-02 15:04:05")
+			}
+			data = append(data, []string{event.ID.String(), string(event.Severity), string(event.Category), event.Summary, event.Source, created, eventState(event)})
+		}
+		utils.PrintToTable([]string{"ID", "SEVERITY", "CATEGORY", "SUMMARY", "SOURCE", "CREATED", "STATE"}, data)
+		return nil
+	},
+}
+
+// eventsQuery returns the query of the events matching the flags of the list command
+func eventsQuery(severities, categories []string, unacknowledged, unresolved bool, since time.Duration, limit int, now time.Time) url.Values {
+	query := url.Values{}
+	if len(severities) > 0 {
+		query.Set("severity", strings.Join(severities, ","))
+	}
+	if len(categories) > 0 {
+		query.Set("category", strings.Join(categories, ","))
+	}
+	if unacknowledged {
+		query.Set("acknowledged", "false")
+	}
+	if unresolved {
+		query.Set("resolved", "false")
+	}
+	if since > 0 {
+		query.Set("since", now.Add(-since).UTC().Format(time.RFC3339))
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	return query
+}
+
+func eventState(event models.UserEvent) string {
+	switch {
+	case event.Resolved:
+		return "resolved"
+	case event.Acknowledged:
+		return "acknowledged"
+	default:
+		return "new"
+	}
+}
+
+// doEventsRequest sends the request to Meshery Server and unmarshals the response into out
+func doEventsRequest(method, url string, body io.Reader, out interface{}) error {
+	req, err := utils.NewRequest(method, url, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// failsafe for not being authenticated
+	if utils.ContentTypeIsHTML(res) {
+		return errors.New("invalid authentication token, log in with `mesheryctl system login`")
+	}
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("Response Status Code %d, %s", res.StatusCode, strings.TrimSpace(string(data)))
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return errors.Wrap(err, "failed to unmarshal response body")
+	}
+	return nil
+}
+
+func init() {
+	listCmd.Flags().StringSliceVar(&listSeverities, "severity", []string{}, "(optional) severities of the events, info, warning or error")
+	listCmd.Flags().StringSliceVar(&listCategories, "category", []string{}, "(optional) categories of the events, adapter, health, performance, design, config or kubernetes")
+	listCmd.Flags().BoolVar(&listUnacknowledged, "unacknowledged", false, "(optional) list only the events you did not acknowledge")
+	listCmd.Flags().BoolVar(&listUnresolved, "unresolved", false, "(optional) list only the events you did not resolve")
+	listCmd.Flags().DurationVar(&listSince, "since", 0, "(optional) list only the events of the given duration, e.g. 24h")
+	listCmd.Flags().IntVar(&listLimit, "limit", 25, "(optional) maximum number of events, 0 for every event")
+}
@@ -0,0 +1,41 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	resolveAll        bool
+	resolveSeverities []string
+	resolveCategories []string
+)
+
+var resolveCmd = &cobra.Command{
+	Use:   "resolve [event id...]",
+	Short: "Resolve events",
+	Long:  `Resolve, and thereby acknowledge, the events with the given IDs or, with --all, every event matching the filters`,
+	Example: `
+// Resolve an event
+mesheryctl events resolve 6a0b5bb6-3b8f-4a3c-9e4b-f3f1c7b0e0a2
+
+// Resolve every event of the health of the adapters
+mesheryctl events resolve --all --category health
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		updated, err := updateEventsState("/api/system/events/resolve", args, resolveAll, resolveSeverities, resolveCategories)
+		if err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("%d event(s) resolved", updated))
+		return nil
+	},
+}
+
+func init() {
+	resolveCmd.Flags().BoolVar(&resolveAll, "all", false, "(optional) resolve every event matching the filters")
+	resolveCmd.Flags().StringSliceVar(&resolveSeverities, "severity", []string{}, "(optional) with --all, severities of the events, info, warning or error")
+	resolveCmd.Flags().StringSliceVar(&resolveCategories, "category", []string{}, "(optional) with --all, categories of the events, adapter, health, performance, design, config or kubernetes")
+}
@@ -3,8 +3,16 @@ package experimental
 import (
 	"fmt"
 
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/apispec"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/dashboard"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/features"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/filter"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/gateway"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/jobs"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/mesh"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/model"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/prometheus"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/workload"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -29,6 +37,6 @@ var ExpCmd = &cobra.Command{
 }
 
 func init() {
-	availableSubcommands = []*cobra.Command{mesh.MeshCmd, filter.FilterCmd}
+	availableSubcommands = []*cobra.Command{mesh.MeshCmd, filter.FilterCmd, workload.WorkloadCmd, prometheus.PrometheusCmd, apispec.ApispecCmd, gateway.GatewayCmd, model.ModelCmd, features.FeaturesCmd, dashboard.DashboardCmd, jobs.JobsCmd}
 	ExpCmd.AddCommand(availableSubcommands...)
 }
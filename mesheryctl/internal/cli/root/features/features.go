@@ -0,0 +1,117 @@
+package features
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+	globalFlag           bool
+)
+
+// FeaturesCmd represents the root command for the feature flags of Meshery Server
+var FeaturesCmd = &cobra.Command{
+	Use:   "features",
+	Short: "Manage the feature flags of Meshery Server",
+	Long: `List, enable and disable the feature flags gating the experimental capabilities of Meshery Server,
+for yourself or globally for every user who did not set them`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the feature flags",
+	Long:  `List the feature flags of Meshery Server, as in effect for you and as set globally and by you`,
+	Args:  cobra.NoArgs,
+	Example: `
+// List the feature flags of Meshery Server
+mesheryctl exp features list
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		flags, err := utils.GetServerFeatures(mctlCfg.GetBaseMesheryURL())
+		if err != nil {
+			return err
+		}
+		utils.PrintServerFeatures(flags)
+		return nil
+	},
+}
+
+var enableCmd = &cobra.Command{
+	Use:   "enable [flag]",
+	Short: "Enable a feature flag",
+	Long:  `Enable the experimental capability gated by the feature flag, for you or globally`,
+	Args:  cobra.ExactArgs(1),
+	Example: `
+// Enable the Gateway API inventory for yourself
+mesheryctl exp features enable gateway-api
+
+// Enable the Gateway API inventory for every user who did not disable it
+mesheryctl exp features enable gateway-api --global
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setFeature(args[0], true)
+	},
+}
+
+var disableCmd = &cobra.Command{
+	Use:   "disable [flag]",
+	Short: "Disable a feature flag",
+	Long:  `Disable the experimental capability gated by the feature flag, for you or globally`,
+	Args:  cobra.ExactArgs(1),
+	Example: `
+// Disable the Gateway API inventory for yourself
+mesheryctl exp features disable gateway-api
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setFeature(args[0], false)
+	},
+}
+
+func setFeature(name string, enabled bool) error {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return errors.Wrap(err, "error processing config")
+	}
+	flags, err := utils.SetServerFeature(mctlCfg.GetBaseMesheryURL(), name, enabled, globalFlag)
+	if err != nil {
+		return err
+	}
+	state := "disabled"
+	if enabled {
+		state = "enabled"
+	}
+	scope := "for you"
+	if globalFlag {
+		scope = "globally"
+	}
+	utils.Log.Info(fmt.Sprintf("Feature flag %s %s %s", name, state, scope))
+	utils.PrintServerFeatures(flags)
+	return nil
+}
+
+func init() {
+	FeaturesCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+	for _, cmd := range []*cobra.Command{enableCmd, disableCmd} {
+		cmd.Flags().BoolVar(&globalFlag, "global", false, "(optional) set the flag for every user who did not set it, rather than for you, only the admins of Meshery Server may")
+	}
+
+	availableSubcommands = []*cobra.Command{listCmd, enableCmd, disableCmd}
+	FeaturesCmd.AddCommand(availableSubcommands...)
+}
@@ -0,0 +1,183 @@
+package features
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestFeaturesCmd(t *testing.T) {
+	// setup current context
+	utils.SetupContextEnv(t)
+
+	// initialize mock server for handling requests
+	utils.StartMockery(t)
+
+	// create a test helper
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures")
+	testdataDir := filepath.Join(currDir, "testdata")
+	token := filepath.Join(fixturesDir, "token.golden")
+
+	featuresURL := testContext.BaseURL + "/api/system/features"
+	gatewayURL := featuresURL + "/gateway-api"
+
+	// test scenrios for fetching data
+	tests := []struct {
+		Name             string
+		Args             []string
+		ExpectedResponse string
+		URLs             []utils.MockURL
+		// Request is the request the flag is expected to be set with
+		Request     *models.FeatureFlagRequest
+		ExpectError bool
+	}{
+		{
+			Name:             "List the feature flags",
+			Args:             []string{"list"},
+			ExpectedResponse: "list.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: featuresURL, Response: "features.response.golden", ResponseCode: 200},
+			},
+		},
+		{
+			Name:             "List the feature flags of a server predating them",
+			Args:             []string{"list"},
+			ExpectedResponse: "list.notfound.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: featuresURL, Response: "features.notfound.response.golden", ResponseCode: 404},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:             "Enable a feature flag globally",
+			Args:             []string{"enable", "gateway-api", "--global"},
+			ExpectedResponse: "enable.global.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: gatewayURL, Response: "features.global.response.golden", ResponseCode: 200},
+			},
+			Request: &models.FeatureFlagRequest{Enabled: true, Global: true},
+		},
+		{
+			Name:             "Disable a feature flag for the user",
+			Args:             []string{"disable", "gateway-api"},
+			ExpectedResponse: "disable.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: gatewayURL, Response: "features.user.response.golden", ResponseCode: 200},
+			},
+			Request: &models.FeatureFlagRequest{Enabled: false},
+		},
+		{
+			Name:             "Enable a feature flag globally without being an admin",
+			Args:             []string{"enable", "gateway-api", "--global"},
+			ExpectedResponse: "enable.forbidden.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: gatewayURL, Response: "features.forbidden.response.golden", ResponseCode: 403},
+			},
+			Request:     &models.FeatureFlagRequest{Enabled: true, Global: true},
+			ExpectError: true,
+		},
+		{
+			Name:             "Enable an unknown feature flag",
+			Args:             []string{"enable", "service-mesh-interface"},
+			ExpectedResponse: "enable.unknown.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: featuresURL + "/service-mesh-interface", Response: "features.unknown.response.golden", ResponseCode: 404},
+			},
+			Request:     &models.FeatureFlagRequest{Enabled: true},
+			ExpectError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			for _, url := range tt.URLs {
+				// View api response from golden files
+				apiResponse := utils.NewGoldenFile(t, url.Response, fixturesDir).Load()
+
+				// mock response, the flag being set only when requested as expected
+				responder := httpmock.NewStringResponder(url.ResponseCode, apiResponse)
+				if url.Method == "POST" {
+					responder = func(req *http.Request) (*http.Response, error) {
+						flagReq := &models.FeatureFlagRequest{}
+						if err := json.NewDecoder(req.Body).Decode(flagReq); err != nil || *flagReq != *tt.Request {
+							return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected feature flag request"), nil
+						}
+						return httpmock.NewStringResponse(url.ResponseCode, apiResponse), nil
+					}
+				}
+				httpmock.RegisterResponder(url.Method, url.URL, responder)
+			}
+
+			// set token
+			utils.TokenFlag = token
+
+			// reset the flags of the previous scenario
+			globalFlag = false
+
+			// Expected response
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+
+			// Grab console prints
+			rescueStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			b := utils.SetupMeshkitLoggerTesting(t, false)
+			FeaturesCmd.SetArgs(tt.Args)
+			err := FeaturesCmd.Execute()
+
+			w.Close()
+			out, _ := io.ReadAll(r)
+			os.Stdout = rescueStdout
+
+			if err != nil {
+				// if we're supposed to get an error
+				if tt.ExpectError {
+					// write it in file
+					if *update {
+						golden.Write(err.Error())
+					}
+					expectedResponse := golden.Load()
+
+					utils.Equals(t, expectedResponse, err.Error())
+					return
+				}
+				t.Fatal(err)
+			}
+
+			// logs followed by the feature flags printed in console
+			actualResponse := b.String() + string(out)
+
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+
+			utils.Equals(t, expectedResponse, actualResponse)
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+	k8sContext           string
+)
+
+// GatewayCmd represents the root command for the Gateway API commands
+var GatewayCmd = &cobra.Command{
+	Use:   "gateway",
+	Short: "Inspect the Gateway API resources of the connected clusters",
+	Long: `List, validate and visualize the GatewayClasses, Gateways and HTTPRoutes discovered by MeshSync
+across the clusters connected to Meshery`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		return utils.RequireServerFeature(mctlCfg.GetBaseMesheryURL(), models.FeatureGatewayAPI)
+	},
+}
+
+// fetchGatewayAPIResources returns the Gateway API resources discovered by MeshSync, in the cluster
+// of the given context or in every cluster when it is empty
+func fetchGatewayAPIResources(k8sContext string) (*models.GatewayAPIResources, error) {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return nil, errors.Wrap(err, "error processing config")
+	}
+	path := mctlCfg.GetBaseMesheryURL() + "/api/system/meshsync/gateway"
+	if k8sContext != "" {
+		path += "?context=" + url.QueryEscape(k8sContext)
+	}
+
+	req, err := utils.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// failsafe for not being authenticated
+	if utils.ContentTypeIsHTML(res) {
+		return nil, errors.New("invalid authentication token, log in with `mesheryctl system login`")
+	}
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("Response Status Code %d, %s", res.StatusCode, strings.TrimSpace(string(data)))
+	}
+	resources := &models.GatewayAPIResources{}
+	if err := json.Unmarshal(data, resources); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal response body")
+	}
+	return resources, nil
+}
+
+// clusterName returns the name of the context connected to the cluster, or its ID
+func clusterName(cluster models.GatewayAPICluster) string {
+	if cluster.Context != "" {
+		return cluster.Context
+	}
+	return cluster.ClusterID
+}
+
+func init() {
+	GatewayCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+	GatewayCmd.PersistentFlags().StringVar(&k8sContext, "context", "", "(optional) Kubernetes context of the cluster, every connected cluster by default")
+
+	availableSubcommands = []*cobra.Command{listCmd, validateCmd, viewCmd}
+	GatewayCmd.AddCommand(availableSubcommands...)
+}
@@ -0,0 +1,80 @@
+package gateway
+
+import (
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/spf13/viper"
+)
+
+var testResources = `{
+	"gateway_classes": [{"cluster_id": "c1", "context": "kind-dev", "name": "istio", "controller_name": "istio.io/gateway-controller", "accepted": true}],
+	"gateways": [
+		{"cluster_id": "c1", "context": "kind-dev", "name": "gw", "namespace": "infra", "class_name": "istio", "addresses": ["10.0.0.1"],
+			"listeners": [{"name": "http", "port": 80, "protocol": "HTTP", "allowed_routes": "All"}]},
+		{"cluster_id": "c1", "context": "kind-dev", "name": "legacy", "namespace": "infra", "class_name": "nginx", "addresses": [], "listeners": []}
+	],
+	"http_routes": [
+		{"cluster_id": "c1", "context": "kind-dev", "name": "shop", "namespace": "shop", "hostnames": ["shop.example.com"],
+			"parents": [{"name": "gw", "namespace": "infra"}],
+			"rules": [{"matches": [{"path_type": "PathPrefix", "path_value": "/api"}], "backends": [{"kind": "Service", "name": "web", "namespace": "shop", "port": 8080}]}]},
+		{"cluster_id": "c1", "context": "kind-dev", "name": "stray", "namespace": "shop", "hostnames": [],
+			"parents": [{"name": "missing", "namespace": "shop"}], "rules": []}
+	],
+	"issues": [
+		{"cluster_id": "c1", "context": "kind-dev", "kind": "Gateway", "name": "legacy", "namespace": "infra", "severity": "error", "message": "GatewayClass nginx does not exist"}
+	],
+	"conflicts": []
+}`
+
+func TestGatewayTree(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	defer func(tokenFlag string) { utils.TokenFlag = tokenFlag }(utils.TokenFlag)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "token.golden")
+	utils.SetupContextEnv(t)
+	utils.SetupMeshkitLoggerTesting(t, false)
+
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", mctlCfg.GetBaseMesheryURL()+"/api/system/meshsync/gateway",
+		httpmock.NewStringResponder(200, testResources))
+
+	resources, err := fetchGatewayAPIResources("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `Cluster kind-dev
+├── GatewayClass istio (istio.io/gateway-controller)
+│   └── Gateway infra/gw (10.0.0.1)
+│       ├── listener http HTTP/80
+│       └── HTTPRoute shop/shop shop.example.com
+│           └── PathPrefix /api -> Service shop/web:8080
+└── Unattached
+    ├── Gateway infra/legacy [class nginx not found]
+    └── HTTPRoute shop/stray
+`
+	if got := renderTree(gatewayTree(resources)); got != want {
+		t.Errorf("got tree\n%s\nwant\n%s", got, want)
+	}
+
+	if err := validationResult(resources); err == nil || !strings.HasPrefix(err.Error(), "1 errors, 0 warnings and 0 route conflicts") {
+		t.Errorf("got validation result %v", err)
+	}
+	if err := validationResult(&models.GatewayAPIResources{}); err != nil {
+		t.Errorf("expected no error without issues, got %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package gateway
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var listKind string
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the Gateway API resources",
+	Long:  `List the GatewayClasses, Gateways and HTTPRoutes discovered by MeshSync`,
+	Args:  cobra.NoArgs,
+	Example: `
+// List the Gateway API resources of every connected cluster
+mesheryctl exp gateway list
+
+// List the HTTPRoutes of the cluster of a context
+mesheryctl exp gateway list --kind httproute --context kind-dev
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		kind := strings.ToLower(listKind)
+		if kind != "" && kind != "gatewayclass" && kind != "gateway" && kind != "httproute" {
+			return errors.Errorf("invalid kind %q, expected gatewayclass, gateway or httproute", listKind)
+		}
+		resources, err := fetchGatewayAPIResources(k8sContext)
+		if err != nil {
+			return err
+		}
+
+		if kind == "" || kind == "gatewayclass" {
+			var data [][]string
+			for _, class := range resources.GatewayClasses {
+				data = append(data, []string{clusterName(class.GatewayAPICluster), class.Name, class.ControllerName, condition(class.Accepted)})
+			}
+			printTable("GatewayClasses", []string{"CLUSTER", "NAME", "CONTROLLER", "ACCEPTED"}, data)
+		}
+		if kind == "" || kind == "gateway" {
+			var data [][]string
+			for _, gateway := range resources.Gateways {
+				data = append(data, []string{clusterName(gateway.GatewayAPICluster), gateway.Namespace, gateway.Name, gateway.ClassName,
+					listenersString(gateway.Listeners), strings.Join(gateway.Addresses, ","), condition(gateway.Programmed)})
+			}
+			printTable("Gateways", []string{"CLUSTER", "NAMESPACE", "NAME", "CLASS", "LISTENERS", "ADDRESSES", "PROGRAMMED"}, data)
+		}
+		if kind == "" || kind == "httproute" {
+			var data [][]string
+			for _, route := range resources.HTTPRoutes {
+				parents := []string{}
+				for _, parent := range route.Parents {
+					parents = append(parents, gatewayRefString(parent))
+				}
+				data = append(data, []string{clusterName(route.GatewayAPICluster), route.Namespace, route.Name,
+					strings.Join(route.Hostnames, ","), strings.Join(parents, ","), strconv.Itoa(len(route.Rules))})
+			}
+			printTable("HTTPRoutes", []string{"CLUSTER", "NAMESPACE", "NAME", "HOSTNAMES", "GATEWAYS", "RULES"}, data)
+		}
+		return nil
+	},
+}
+
+func printTable(title string, header []string, data [][]string) {
+	if len(data) == 0 {
+		utils.Log.Info(fmt.Sprintf("No %s found", title))
+		return
+	}
+	utils.Log.Info(title)
+	utils.PrintToTable(header, data)
+}
+
+// condition returns the state of a condition, - when it is not reported
+func condition(set *bool) string {
+	if set == nil {
+		return "-"
+	}
+	return strconv.FormatBool(*set)
+}
+
+func listenersString(listeners []models.GatewayListener) string {
+	result := []string{}
+	for _, l := range listeners {
+		listener := fmt.Sprintf("%s/%d", l.Protocol, l.Port)
+		if l.Hostname != "" {
+			listener += " " + l.Hostname
+		}
+		result = append(result, listener)
+	}
+	return strings.Join(result, ",")
+}
+
+func gatewayRefString(ref models.GatewayRef) string {
+	if ref.SectionName != "" {
+		return ref.Namespace + "/" + ref.Name + "#" + ref.SectionName
+	}
+	return ref.Namespace + "/" + ref.Name
+}
+
+func init() {
+	listCmd.Flags().StringVar(&listKind, "kind", "", "(optional) kind of the resources to list, gatewayclass, gateway or httproute")
+}
@@ -0,0 +1,75 @@
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate the Gateway API resources",
+	Long: `Report the references to GatewayClasses, Gateways, listeners and backend Services which do not exist,
+the HTTPRoutes not allowed to attach to their Gateways and the HTTPRoutes claiming the same requests.
+Of conflicting routes, the oldest one wins. Fails when an error or a conflict is found.`,
+	Args: cobra.NoArgs,
+	Example: `
+// Validate the Gateway API resources of every connected cluster
+mesheryctl exp gateway validate
+
+// Validate the Gateway API resources of the cluster of a context
+mesheryctl exp gateway validate --context kind-dev
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resources, err := fetchGatewayAPIResources(k8sContext)
+		if err != nil {
+			return err
+		}
+
+		var issues [][]string
+		for _, issue := range resources.Issues {
+			resource := issue.Kind + " " + issue.Name
+			if issue.Namespace != "" {
+				resource = issue.Kind + " " + issue.Namespace + "/" + issue.Name
+			}
+			issues = append(issues, []string{clusterName(issue.GatewayAPICluster), strings.ToUpper(issue.Severity), resource, issue.Message})
+		}
+		if len(issues) > 0 {
+			utils.PrintToTable([]string{"CLUSTER", "SEVERITY", "RESOURCE", "ISSUE"}, issues)
+		}
+
+		var conflicts [][]string
+		for _, conflict := range resources.Conflicts {
+			conflicts = append(conflicts, []string{clusterName(conflict.GatewayAPICluster), gatewayRefString(conflict.Gateway), conflict.Hostname,
+				conflict.Match, conflict.Routes[0], strings.Join(conflict.Routes[1:], ",")})
+		}
+		if len(conflicts) > 0 {
+			utils.PrintToTable([]string{"CLUSTER", "GATEWAY", "HOSTNAME", "MATCH", "WINNER", "IGNORED"}, conflicts)
+		}
+
+		return validationResult(resources)
+	},
+}
+
+// validationResult returns an error when the resources have errors or conflicting routes
+func validationResult(resources *models.GatewayAPIResources) error {
+	errorCount, warnings := 0, 0
+	for _, issue := range resources.Issues {
+		if issue.Severity == models.GatewayAPIIssueError {
+			errorCount++
+		} else {
+			warnings++
+		}
+	}
+	summary := fmt.Sprintf("%d errors, %d warnings and %d route conflicts found in %d Gateways and %d HTTPRoutes",
+		errorCount, warnings, len(resources.Conflicts), len(resources.Gateways), len(resources.HTTPRoutes))
+	if errorCount > 0 || len(resources.Conflicts) > 0 {
+		return errors.New(summary)
+	}
+	utils.Log.Info(summary)
+	return nil
+}
@@ -0,0 +1,179 @@
+package gateway
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/spf13/cobra"
+)
+
+var viewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "Visualize the Gateway API resources",
+	Long: `Display the Gateway API resources of every cluster as a tree, from the GatewayClasses to their Gateways,
+the HTTPRoutes attached to the Gateways and the backends of their rules. Gateways whose class does not exist
+and HTTPRoutes attached to no existing Gateway are listed as unattached.`,
+	Args: cobra.NoArgs,
+	Example: `
+// Visualize the Gateway API resources of every connected cluster
+mesheryctl exp gateway view
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resources, err := fetchGatewayAPIResources(k8sContext)
+		if err != nil {
+			return err
+		}
+		fmt.Print(renderTree(gatewayTree(resources)))
+		return nil
+	},
+}
+
+// treeNode is a node of the tree of the Gateway API resources
+type treeNode struct {
+	label    string
+	children []*treeNode
+}
+
+func (n *treeNode) add(label string) *treeNode {
+	child := &treeNode{label: label}
+	n.children = append(n.children, child)
+	return child
+}
+
+// gatewayTree returns a tree per cluster of its GatewayClasses, their Gateways, the HTTPRoutes
+// attached to the Gateways and the backends of the routes
+func gatewayTree(resources *models.GatewayAPIResources) []*treeNode {
+	clusters := map[string]*treeNode{}
+	var clusterIDs []string
+	cluster := func(c models.GatewayAPICluster) *treeNode {
+		if _, ok := clusters[c.ClusterID]; !ok {
+			clusters[c.ClusterID] = &treeNode{label: "Cluster " + clusterName(c)}
+			clusterIDs = append(clusterIDs, c.ClusterID)
+		}
+		return clusters[c.ClusterID]
+	}
+
+	classes := map[string]*treeNode{}
+	for _, class := range resources.GatewayClasses {
+		classes[class.ClusterID+"/"+class.Name] = cluster(class.GatewayAPICluster).add(fmt.Sprintf("GatewayClass %s (%s)", class.Name, class.ControllerName))
+	}
+
+	gateways := map[string]*treeNode{}
+	unattached := map[string]*treeNode{}
+	orphans := func(c models.GatewayAPICluster) *treeNode {
+		if _, ok := unattached[c.ClusterID]; !ok {
+			cluster(c)
+			unattached[c.ClusterID] = &treeNode{label: "Unattached"}
+		}
+		return unattached[c.ClusterID]
+	}
+	for _, gateway := range resources.Gateways {
+		label := fmt.Sprintf("Gateway %s/%s", gateway.Namespace, gateway.Name)
+		if len(gateway.Addresses) > 0 {
+			label += " (" + strings.Join(gateway.Addresses, ", ") + ")"
+		}
+		parent, ok := classes[gateway.ClusterID+"/"+gateway.ClassName]
+		if !ok {
+			parent = orphans(gateway.GatewayAPICluster)
+			label += fmt.Sprintf(" [class %s not found]", gateway.ClassName)
+		}
+		node := parent.add(label)
+		for _, l := range gateway.Listeners {
+			listener := fmt.Sprintf("listener %s %s/%d", l.Name, l.Protocol, l.Port)
+			if l.Hostname != "" {
+				listener += " " + l.Hostname
+			}
+			node.add(listener)
+		}
+		gateways[gateway.ClusterID+"/"+gateway.Namespace+"/"+gateway.Name] = node
+	}
+
+	for _, route := range resources.HTTPRoutes {
+		label := fmt.Sprintf("HTTPRoute %s/%s", route.Namespace, route.Name)
+		if len(route.Hostnames) > 0 {
+			label += " " + strings.Join(route.Hostnames, ", ")
+		}
+		attached := false
+		for _, parent := range route.Parents {
+			node, ok := gateways[route.ClusterID+"/"+parent.Namespace+"/"+parent.Name]
+			if !ok {
+				continue
+			}
+			attached = true
+			routeLabel := label
+			if parent.SectionName != "" {
+				routeLabel += " via listener " + parent.SectionName
+			}
+			addRules(node.add(routeLabel), route)
+		}
+		if !attached {
+			addRules(orphans(route.GatewayAPICluster).add(label), route)
+		}
+	}
+
+	roots := []*treeNode{}
+	for _, clusterID := range clusterIDs {
+		if node, ok := unattached[clusterID]; ok {
+			clusters[clusterID].children = append(clusters[clusterID].children, node)
+		}
+		roots = append(roots, clusters[clusterID])
+	}
+	sort.SliceStable(roots, func(i, j int) bool { return roots[i].label < roots[j].label })
+	return roots
+}
+
+func addRules(node *treeNode, route models.HTTPRoute) {
+	for _, rule := range route.Rules {
+		matches := []string{}
+		for _, match := range rule.Matches {
+			m := match.PathType + " " + match.PathValue
+			if match.Method != "" {
+				m = match.Method + " " + m
+			}
+			matches = append(matches, m)
+		}
+		backends := []string{}
+		for _, backend := range rule.Backends {
+			b := fmt.Sprintf("%s %s/%s", backend.Kind, backend.Namespace, backend.Name)
+			if backend.Port != 0 {
+				b += fmt.Sprintf(":%d", backend.Port)
+			}
+			if backend.Weight != nil {
+				b += fmt.Sprintf(" (weight %d)", *backend.Weight)
+			}
+			backends = append(backends, b)
+		}
+		if len(backends) == 0 {
+			backends = append(backends, "no backend")
+		}
+		node.add(strings.Join(matches, " | ") + " -> " + strings.Join(backends, ", "))
+	}
+}
+
+// renderTree renders the trees with box-drawing characters
+func renderTree(roots []*treeNode) string {
+	var b strings.Builder
+	var render func(node *treeNode, prefix string, last bool, root bool)
+	render = func(node *treeNode, prefix string, last bool, root bool) {
+		childPrefix := prefix
+		if root {
+			b.WriteString(node.label + "\n")
+		} else {
+			connector, indent := "├── ", "│   "
+			if last {
+				connector, indent = "└── ", "    "
+			}
+			b.WriteString(prefix + connector + node.label + "\n")
+			childPrefix = prefix + indent
+		}
+		for i, child := range node.children {
+			render(child, childPrefix, i == len(node.children)-1, false)
+		}
+	}
+	for _, root := range roots {
+		render(root, "", true, true)
+	}
+	return b.String()
+}
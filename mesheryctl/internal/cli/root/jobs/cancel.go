@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel [job-id]",
+	Short: "Cancel a running background job of Meshery Server",
+	Long: `Ask a running job of Meshery Server to stop, the job being cancelled once it stops. Your own jobs can be
+cancelled, and the admins of Meshery Server cancel those of every user and of the server. A cancelled performance
+test stops early with the requests sent until then; the MeshSync resyncs cannot be cancelled once started.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Cancel the deployment of a design waiting for its components to be ready
+mesheryctl exp jobs cancel 2c4b1f0e-8a3d-4f6b-9c2e-7d5a1b3e4f60
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		job, err := cancelJob(mctlCfg.GetBaseMesheryURL(), args[0])
+		if err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("Job %s (%s) is %s.", job.ID, job.Name, job.Status))
+		return nil
+	},
+}
+
+// cancelJob asks the job with the given id to stop, returning it as it is being cancelled
+func cancelJob(baseURL, id string) (*models.Job, error) {
+	req, err := utils.NewRequest(http.MethodPost, baseURL+"/api/system/jobs/"+url.PathEscape(id)+"/cancel", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := doJobsRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	job := &models.Job{}
+	if err := json.Unmarshal(body, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,21 @@
+package jobs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrJobsCode                = "1098"
+	ErrInvalidOutputFormatCode = "1099"
+)
+
+func ErrJobs(status int, message string) error {
+	return errors.New(ErrJobsCode, errors.Alert, []string{"Unable to list or cancel the background jobs of Meshery Server"}, []string{fmt.Sprintf("Meshery Server returned status %d: %s", status, strings.TrimSpace(message))}, []string{"The job does not exist or is out of the history of the server", "The job already completed or cannot be cancelled", "The job runs for another user", "The status filter is invalid"}, []string{"List the jobs with `mesheryctl exp jobs list`", "Filter by status with one of running, cancelling, succeeded, failed or cancelled"})
+}
+
+func ErrInvalidOutputFormat(format string) error {
+	return errors.New(ErrInvalidOutputFormatCode, errors.Alert, []string{"Invalid output format " + format}, []string{"The output format " + format + " is not supported"}, []string{}, []string{"Use one of table, json or yaml"})
+}
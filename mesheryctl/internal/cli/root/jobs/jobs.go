@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+)
+
+// JobsCmd represents the root command for the background jobs of Meshery Server
+var JobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "View and cancel the background jobs of Meshery Server",
+	Long: `View the work Meshery Server runs in the background, the MeshSync resyncs, the performance tests, the
+deployments of designs, scheduled or not, and the archival of the results, with their status and progress,
+along with the last jobs completed and how they completed, and cancel the running ones`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	JobsCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+
+	availableSubcommands = []*cobra.Command{listCmd, cancelCmd}
+	JobsCmd.AddCommand(availableSubcommands...)
+}
@@ -0,0 +1,172 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// maxMessageLength is the length the messages of the jobs are truncated to in the table
+const maxMessageLength = 60
+
+var (
+	kind         string
+	status       string
+	mine         bool
+	outputFormat string
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the background jobs of Meshery Server",
+	Long: `List the jobs Meshery Server runs in the background, the running ones first, along with the last ones
+completed and their outcome. The progress of a performance test is estimated from its duration until it
+completes; that of the jobs processing items is the items processed when their total is not known.`,
+	Args: cobra.NoArgs,
+	Example: `
+// List the background jobs
+mesheryctl exp jobs list
+
+// List the jobs which failed
+mesheryctl exp jobs list --status failed
+
+// List your running performance tests as YAML
+mesheryctl exp jobs list --kind performance-test --status running --mine -o yaml
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormat != "table" && outputFormat != "json" && outputFormat != "yaml" {
+			return ErrInvalidOutputFormat(outputFormat)
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		jobs, err := fetchJobs(mctlCfg.GetBaseMesheryURL(), kind, status, mine)
+		if err != nil {
+			return err
+		}
+
+		if outputFormat != "table" {
+			body, err := json.MarshalIndent(jobs, "", "  ")
+			if err != nil {
+				return err
+			}
+			if outputFormat == "yaml" {
+				if body, err = yaml.JSONToYAML(body); err != nil {
+					return err
+				}
+			}
+			fmt.Println(strings.TrimSpace(string(body)))
+			return nil
+		}
+
+		if len(jobs) == 0 {
+			utils.Log.Info("No background job found.")
+			return nil
+		}
+		utils.PrintToTable([]string{"ID", "KIND", "NAME", "STATUS", "PROGRESS", "STARTED", "MESSAGE"}, jobsRows(jobs, time.Now()))
+		return nil
+	},
+}
+
+// fetchJobs returns the background jobs of the kind and status, every one of them when empty
+func fetchJobs(baseURL, kind, status string, mine bool) ([]models.Job, error) {
+	q := url.Values{}
+	if kind != "" {
+		q.Set("kind", kind)
+	}
+	if status != "" {
+		q.Set("status", status)
+	}
+	if mine {
+		q.Set("mine", "true")
+	}
+	jobsURL := baseURL + "/api/system/jobs"
+	if len(q) > 0 {
+		jobsURL += "?" + q.Encode()
+	}
+	req, err := utils.NewRequest(http.MethodGet, jobsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := doJobsRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.JobsAPIResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, err
+	}
+	return resp.Jobs, nil
+}
+
+func doJobsRequest(req *http.Request) ([]byte, error) {
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// failsafe for not being authenticated
+	if utils.ContentTypeIsHTML(resp) {
+		return nil, errors.New("invalid authentication token, log in with `mesheryctl system login`")
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrJobs(resp.StatusCode, string(body))
+	}
+	return body, nil
+}
+
+// jobsRows returns the rows of the jobs, in the order Meshery Server lists them
+func jobsRows(jobs []models.Job, now time.Time) [][]string {
+	rows := make([][]string, 0, len(jobs))
+	for _, job := range jobs {
+		progress := "-"
+		switch {
+		case job.Progress != nil:
+			progress = fmt.Sprintf("%.0f%%", *job.Progress)
+		case job.ItemsDone > 0:
+			progress = fmt.Sprintf("%d items", job.ItemsDone)
+		}
+		message := job.Message
+		if len(message) > maxMessageLength {
+			message = strings.TrimSpace(message[:maxMessageLength-3]) + "..."
+		}
+		rows = append(rows, []string{
+			job.ID,
+			job.Kind,
+			job.Name,
+			string(job.Status),
+			progress,
+			now.Sub(job.StartedAt).Round(time.Second).String() + " ago",
+			message,
+		})
+	}
+	return rows
+}
+
+func init() {
+	listCmd.Flags().StringVar(&kind, "kind", "", "(optional) only list the jobs of the kind: meshsync-resync, performance-test, design-deployment, design-schedule, result-archive or trash-purge")
+	listCmd.Flags().StringVar(&status, "status", "", "(optional) only list the jobs of the status: running, cancelling, succeeded, failed or cancelled")
+	listCmd.Flags().BoolVar(&mine, "mine", false, "(optional) only list the jobs run for you")
+	listCmd.Flags().StringVarP(&outputFormat, "output-format", "o", "table", "Format of the jobs: table, json or yaml")
+	utils.SetOutputFormats(listCmd, "table", "json", "yaml")
+}
@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshkit/errors"
+)
+
+func TestJobsRows(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	progress := 42.5
+	rows := jobsRows([]models.Job{
+		{ID: "a1", Kind: models.JobPerformanceTest, Name: "istio soak", Status: models.JobRunning, Progress: &progress, StartedAt: now.Add(-90 * time.Second)},
+		{ID: "b2", Kind: models.JobResultArchive, Name: "Archive the performance results to s3", Status: models.JobCancelled, ItemsDone: 7, StartedAt: now.Add(-time.Hour)},
+		{ID: "c3", Kind: models.JobDesignDeployment, Name: "Deploy of design bookinfo", Status: models.JobFailed, Message: "timed out waiting for the components of the design to be ready: productpage-v1", StartedAt: now.Add(-2 * time.Minute)},
+	}, now)
+	want := [][]string{
+		{"a1", "performance-test", "istio soak", "running", "42%", "1m30s ago", ""},
+		{"b2", "result-archive", "Archive the performance results to s3", "cancelled", "7 items", "1h0m0s ago", ""},
+		{"c3", "design-deployment", "Deploy of design bookinfo", "failed", "-", "2m0s ago", "timed out waiting for the components of the design to be..."},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("jobsRows() = %v, want %v", rows, want)
+	}
+}
+
+func TestJobsRequests(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	_, filename, _, _ := runtime.Caller(0)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+
+	jobsURL := "http://localhost:9081/api/system/jobs"
+	httpmock.RegisterResponder("GET", jobsURL, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("status") != "running" || req.URL.Query().Get("mine") != "true" {
+			return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected query "+req.URL.RawQuery), nil
+		}
+		return httpmock.NewStringResponse(http.StatusOK, `{"jobs":[{"id":"a1","kind":"design-deployment","name":"Deploy of design bookinfo","status":"running","cancellable":true,"started_at":"2021-06-01T12:00:00Z"}]}`), nil
+	})
+	httpmock.RegisterResponder("POST", jobsURL+"/a1/cancel", httpmock.NewStringResponder(http.StatusOK,
+		`{"id":"a1","kind":"design-deployment","name":"Deploy of design bookinfo","status":"cancelling","cancellable":true,"started_at":"2021-06-01T12:00:00Z"}`))
+	httpmock.RegisterResponder("POST", jobsURL+"/b2/cancel", httpmock.NewStringResponder(http.StatusConflict, "the job b2 of kind meshsync-resync cannot be cancelled"))
+
+	jobs, err := fetchJobs("http://localhost:9081", "", "running", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].ID != "a1" || jobs[0].Status != models.JobRunning {
+		t.Errorf("unexpected jobs %+v", jobs)
+	}
+
+	job, err := cancelJob("http://localhost:9081", "a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != models.JobCancelling {
+		t.Errorf("expected the job to be cancelling, got %s", job.Status)
+	}
+	if _, err := cancelJob("http://localhost:9081", "b2"); errors.GetCode(err) != ErrJobsCode {
+		t.Errorf("cancelJob() error = %v, want code %s", err, ErrJobsCode)
+	}
+}
@@ -0,0 +1,243 @@
+package mesh
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	configFile   string
+	configDelete bool
+	configDryRun bool
+
+	configSubcommands []*cobra.Command
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage adapter-provided service mesh configuration",
+	Long:  `List and apply the configuration operations (mTLS, telemetry, access logs, ...) advertised by a Meshery adapter`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(configSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List configuration operations supported by an adapter",
+	Args:  cobra.NoArgs,
+	Example: `
+// List configuration operations offered by the Istio adapter
+mesheryctl exp mesh config list --adapter meshery-istio:10000
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		adapter, err := getAdapter(mctlCfg, adapterURL)
+		if err != nil {
+			return err
+		}
+
+		ops := getConfigOperations(adapter)
+		if len(ops) == 0 {
+			utils.Log.Info("No configuration operations advertised by ", adapter.Location)
+			return nil
+		}
+
+		var data [][]string
+		for _, op := range ops {
+			data = append(data, []string{op.Key, op.Value})
+		}
+		utils.PrintToTable([]string{"OPERATION", "DESCRIPTION"}, data)
+		return nil
+	},
+}
+
+var configApplyCmd = &cobra.Command{
+	Use:   "apply [operation]",
+	Short: "Apply a configuration operation through an adapter",
+	Args:  cobra.ExactArgs(1),
+	Example: `
+// Enable strict mTLS through the Istio adapter
+mesheryctl exp mesh config apply strict-mtls --adapter meshery-istio:10000 --namespace default
+
+// Preview the resources of the custom body as the cluster would apply them, without applying them
+mesheryctl exp mesh config apply access-logs --adapter meshery-istio:10000 -f overrides.yaml --dry-run
+
+// Revert a configuration operation
+mesheryctl exp mesh config apply strict-mtls --adapter meshery-istio:10000 --delete
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		adapter, err := getAdapter(mctlCfg, adapterURL)
+		if err != nil {
+			return err
+		}
+
+		opName := args[0]
+		found := false
+		for _, op := range getConfigOperations(adapter) {
+			if op.Key == opName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return ErrUnsupportedConfigOperation(opName, adapter.Location)
+		}
+
+		operation := Operation{
+			Adapter:   adapter.Location,
+			Namespace: namespace,
+			Query:     opName,
+		}
+		if configDelete {
+			operation.DeleteOp = "on"
+		}
+		if configFile != "" {
+			content, err := os.ReadFile(configFile)
+			if err != nil {
+				return errors.Wrap(err, "failed to read the configuration file")
+			}
+			operation.CustomBody = string(content)
+		}
+
+		// Adapters render the manifests of an operation only when running it, so a dry run is the
+		// server-side dry run of the custom body by the cluster
+		if configDryRun {
+			if configFile == "" {
+				return ErrDryRunWithoutManifest(opName)
+			}
+			operation.DryRun = true
+			body, err := sendOperationRequest(mctlCfg, operation)
+			if err != nil {
+				return err
+			}
+			resp := models.AdapterOperationResponse{}
+			if err := json.Unmarshal([]byte(body), &resp); err != nil {
+				return errors.Wrap(err, "failed to read the resources of the dry run")
+			}
+			docs := []string{}
+			for _, resource := range resp.Resources {
+				out, err := yaml.Marshal(resource)
+				if err != nil {
+					return errors.Wrap(err, "failed to render the resources of the dry run")
+				}
+				docs = append(docs, string(out))
+			}
+			if len(docs) == 0 {
+				utils.Log.Info("No resources would be changed")
+				return nil
+			}
+			utils.Log.Info(strings.Join(docs, "---\n"))
+			return nil
+		}
+
+		if _, err = sendOperationRequest(mctlCfg, operation); err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("Operation %s submitted to %s", opName, adapter.Location))
+		return nil
+	},
+}
+
+func init() {
+	configCmd.PersistentFlags().StringVarP(&adapterURL, "adapter", "a", "meshery-istio:10000", "Adapter to use for the configuration operation")
+	configCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token for authenticating to Meshery API")
+
+	configApplyCmd.Flags().StringVarP(&namespace, "namespace", "n", "default", "Kubernetes namespace the configuration applies to")
+	configApplyCmd.Flags().StringVarP(&configFile, "file", "f", "", "(optional) manifest passed to the adapter as the operation's custom body")
+	configApplyCmd.Flags().BoolVar(&configDelete, "delete", false, "(optional) revert the configuration operation")
+	configApplyCmd.Flags().BoolVar(&configDryRun, "dry-run", false, "(optional) print the resources of the manifest of --file as the cluster would apply, or delete, them without applying them; the adapter renders the manifests of the operation itself only when running it")
+
+	configSubcommands = []*cobra.Command{configListCmd, configApplyCmd}
+	configCmd.AddCommand(configSubcommands...)
+}
+
+// getAdapter returns the adapter registered at the given location from the session data
+func getAdapter(mctlCfg *config.MesheryCtlConfig, location string) (*models.Adapter, error) {
+	prefs, err := utils.GetSessionData(mctlCfg)
+	if err != nil {
+		return nil, ErrGettingSessionData(err)
+	}
+
+	for _, adapter := range prefs.MeshAdapters {
+		if adapter.Location == location || strings.Split(adapter.Location, ":")[0] == location {
+			return adapter, nil
+		}
+	}
+	return nil, ErrNoAdapters
+}
+
+// getConfigOperations filters the configuration operations out of the ones supported by the adapter
+func getConfigOperations(adapter *models.Adapter) []*meshes.SupportedOperation {
+	ops := []*meshes.SupportedOperation{}
+	for _, op := range adapter.Ops {
+		if op.Category == meshes.OpCategory_CONFIGURE {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// sendOperationRequest submits the given operation to the adapter through Meshery server
+func sendOperationRequest(mctlCfg *config.MesheryCtlConfig, operation Operation) (string, error) {
+	path := mctlCfg.GetBaseMesheryURL() + "/api/system/adapter/operation"
+
+	data := url.Values{}
+	data.Set("adapter", operation.Adapter)
+	data.Set("query", operation.Query)
+	data.Set("customBody", operation.CustomBody)
+	data.Set("namespace", operation.Namespace)
+	data.Set("deleteOp", operation.DeleteOp)
+	if operation.DryRun {
+		data.Set("dryRun", "true")
+	}
+
+	req, err := utils.NewRequest("POST", path, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", ErrCreatingDeployRequest(err)
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded;charset=UTF-8")
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return "", ErrCreatingDeployRequest(err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", ErrOperationFailed(res.StatusCode, string(body))
+	}
+	return string(body), nil
+}
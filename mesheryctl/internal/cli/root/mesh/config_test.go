@@ -0,0 +1,181 @@
+package mesh
+
+import (
+	"flag"
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestConfigApplyCmd(t *testing.T) {
+	// setup current context
+	utils.SetupContextEnv(t)
+
+	// initialize mock server for handling requests
+	utils.StartMockery(t)
+
+	// create a test helper
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures")
+
+	syncURL := utils.MockURL{
+		Method:       "GET",
+		URL:          testContext.BaseURL + "/api/system/sync",
+		Response:     "sync.response.golden",
+		ResponseCode: 200,
+	}
+
+	// test scenrios for fetching data
+	tests := []struct {
+		Name             string
+		Args             []string
+		ExpectedResponse string
+		URLs             []utils.MockURL
+		Token            string
+		ExpectError      bool
+	}{
+		{
+			Name:             "Dry run of a configuration operation without a manifest",
+			Args:             []string{"config", "apply", "strict-mtls", "--namespace", "istio-test", "--dry-run"},
+			ExpectedResponse: "config.apply.dryrun.nomanifest.output.golden",
+			URLs:             []utils.MockURL{syncURL},
+			Token:            filepath.Join(fixturesDir, "token.golden"),
+			ExpectError:      true,
+		},
+		{
+			Name:             "Dry run of a configuration operation with a custom body",
+			Args:             []string{"config", "apply", "access-logs", "-f", filepath.Join(fixturesDir, "overrides.golden"), "--namespace", "istio-test", "--dry-run"},
+			ExpectedResponse: "config.apply.dryrun.output.golden",
+			URLs: []utils.MockURL{
+				syncURL,
+				{
+					Method:       "POST",
+					URL:          testContext.BaseURL + "/api/system/adapter/operation",
+					Response:     "operation.dryrun.response.golden",
+					ResponseCode: 200,
+				},
+			},
+			Token:       filepath.Join(fixturesDir, "token.golden"),
+			ExpectError: false,
+		},
+		{
+			Name:             "Dry run of the revert of a configuration operation with a custom body",
+			Args:             []string{"config", "apply", "access-logs", "-f", filepath.Join(fixturesDir, "overrides.golden"), "--delete", "--dry-run"},
+			ExpectedResponse: "config.apply.dryrun.delete.output.golden",
+			URLs: []utils.MockURL{
+				syncURL,
+				{
+					Method:       "POST",
+					URL:          testContext.BaseURL + "/api/system/adapter/operation",
+					Response:     "operation.dryrun.delete.response.golden",
+					ResponseCode: 200,
+				},
+			},
+			Token:       filepath.Join(fixturesDir, "token.golden"),
+			ExpectError: false,
+		},
+		{
+			Name:             "Apply a configuration operation",
+			Args:             []string{"config", "apply", "strict-mtls"},
+			ExpectedResponse: "config.apply.output.golden",
+			URLs: []utils.MockURL{
+				syncURL,
+				{
+					Method:       "POST",
+					URL:          testContext.BaseURL + "/api/system/adapter/operation",
+					Response:     "operation.response.golden",
+					ResponseCode: 200,
+				},
+			},
+			Token:       filepath.Join(fixturesDir, "token.golden"),
+			ExpectError: false,
+		},
+		{
+			Name:             "Apply an operation other than a configuration one",
+			Args:             []string{"config", "apply", "bookinfo", "--dry-run"},
+			ExpectedResponse: "config.apply.unsupported.output.golden",
+			URLs:             []utils.MockURL{syncURL},
+			Token:            filepath.Join(fixturesDir, "token.golden"),
+			ExpectError:      true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			for _, url := range tt.URLs {
+				// View api response from golden files
+				apiResponse := utils.NewGoldenFile(t, url.Response, fixturesDir).Load()
+
+				// mock response, the dry runs being answered only when requested as such
+				responder := httpmock.NewStringResponder(url.ResponseCode, apiResponse)
+				if strings.HasPrefix(url.Response, "operation.dryrun") {
+					responder = func(req *http.Request) (*http.Response, error) {
+						if err := req.ParseForm(); err != nil || req.PostForm.Get("dryRun") != "true" {
+							return httpmock.NewStringResponse(http.StatusBadRequest, "operation submitted without dryRun"), nil
+						}
+						return httpmock.NewStringResponse(url.ResponseCode, apiResponse), nil
+					}
+				}
+				httpmock.RegisterResponder(url.Method, url.URL, responder)
+			}
+
+			// set token
+			utils.TokenFlag = tt.Token
+
+			// reset the flags of the previous scenario
+			adapterURL, namespace, configFile, configDelete, configDryRun = "meshery-istio:10000", "default", "", false, false
+
+			// Expected response
+			testdataDir := filepath.Join(currDir, "testdata")
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+
+			b := utils.SetupMeshkitLoggerTesting(t, false)
+			MeshCmd.SetOutput(b)
+			MeshCmd.SetArgs(tt.Args)
+			err := MeshCmd.Execute()
+			if err != nil {
+				// if we're supposed to get an error
+				if tt.ExpectError {
+					// write it in file
+					if *update {
+						golden.Write(err.Error())
+					}
+					expectedResponse := golden.Load()
+
+					utils.Equals(t, expectedResponse, err.Error())
+					return
+				}
+				t.Error(err)
+			}
+
+			// response being printed in console
+			actualResponse := b.String()
+
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+
+			utils.Equals(t, expectedResponse, actualResponse)
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
@@ -1,6 +1,11 @@
 package mesh
 
-import "github.com/layer5io/meshkit/errors"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshkit/errors"
+)
 
 const (
 	ErrGettingSessionDataCode                = "1009"
@@ -16,6 +21,15 @@ const (
 	ErrCreatingValidateResponseRequestCode   = "1019"
 	ErrTimeoutWaitingForValidateResponseCode = "1020"
 	ErrSMIConformanceTestsFailedCode         = "1021"
+	ErrUnsupportedConfigOperationCode        = "1044"
+	ErrOperationFailedCode                   = "1045"
+	ErrMeshRequestCode                       = "1059"
+	ErrMeshNotDetectedCode                   = "1060"
+	ErrUnsupportedOperationCode              = "1086"
+	ErrAmbiguousOperationCode                = "1087"
+	ErrTimeoutWaitingForOperationCode        = "1088"
+	ErrOperationEventCode                    = "1089"
+	ErrDryRunWithoutManifestCode             = "1100"
 )
 
 var (
@@ -67,3 +81,43 @@ func ErrCreatingValidateResponseRequest(err error) error {
 func ErrCreatingValidateResponseStream(err error) error {
 	return errors.New(ErrCreatingDeployResponseStreamCode, errors.Fatal, []string{"Error creating validate event response stream"}, []string{err.Error()}, []string{}, []string{})
 }
+
+func ErrUnsupportedConfigOperation(op, adapter string) error {
+	return errors.New(ErrUnsupportedConfigOperationCode, errors.Alert, []string{"Configuration operation not supported"}, []string{fmt.Sprintf("operation %s is not advertised as a configuration operation by %s", op, adapter)}, []string{}, []string{"Run `mesheryctl exp mesh config list` to see the available operations"})
+}
+
+func ErrOperationFailed(status int, body string) error {
+	return errors.New(ErrOperationFailedCode, errors.Alert, []string{"Adapter operation failed"}, []string{fmt.Sprintf("server responded with status %d: %s", status, body)}, []string{}, []string{"Check the adapter logs and try again"})
+}
+
+func ErrMeshRequest(status int, body string) error {
+	return errors.New(ErrMeshRequestCode, errors.Alert, []string{"Service mesh request failed"}, []string{fmt.Sprintf("server responded with status %d: %s", status, body)}, []string{"MeshSync is not running", "The namespace does not exist"}, []string{"Check that MeshSync is running and that the namespace exists"})
+}
+
+func ErrMeshNotDetected(meshes []string) error {
+	found := "no service mesh is running in the cluster"
+	if len(meshes) > 0 {
+		found = "service meshes " + strings.Join(meshes, ", ") + " are running in the cluster"
+	}
+	return errors.New(ErrMeshNotDetectedCode, errors.Alert, []string{"Unable to tell which service mesh to use"}, []string{found}, []string{"MeshSync has not discovered the control plane of the mesh", "More than one service mesh is running in the cluster"}, []string{"Give the service mesh with --mesh"})
+}
+
+func ErrUnsupportedOperation(operation, adapter string) error {
+	return errors.New(ErrUnsupportedOperationCode, errors.Alert, []string{"Operation not supported"}, []string{fmt.Sprintf("The operation %s is not advertised by %s", operation, adapter)}, []string{}, []string{"Run `mesheryctl mesh ops list` to see the operations advertised by the adapters"})
+}
+
+func ErrAmbiguousOperation(operation string, adapters []string) error {
+	return errors.New(ErrAmbiguousOperationCode, errors.Alert, []string{"Operation advertised by several adapters"}, []string{fmt.Sprintf("The operation %s is advertised by %s", operation, strings.Join(adapters, ", "))}, []string{}, []string{"Pick the adapter to run the operation with using --adapter"})
+}
+
+func ErrTimeoutWaitingForOperation(operationID string) error {
+	return errors.New(ErrTimeoutWaitingForOperationCode, errors.Alert, []string{"Timed out waiting for the outcome of the operation"}, []string{fmt.Sprintf("No event reported for the operation %s", operationID)}, []string{"The adapter is still running the operation, or is unavailable"}, []string{"Check the events in Meshery UI, or wait longer using --timeout"})
+}
+
+func ErrOperationEvent(operation, summary, details string) error {
+	return errors.New(ErrOperationEventCode, errors.Alert, []string{fmt.Sprintf("Operation %s failed", operation)}, []string{summary, details}, []string{}, []string{"Check the details reported by the adapter and try again"})
+}
+
+func ErrDryRunWithoutManifest(operation string) error {
+	return errors.New(ErrDryRunWithoutManifestCode, errors.Alert, []string{"Unable to dry run the operation"}, []string{fmt.Sprintf("The operation %s has no manifest to dry run", operation)}, []string{"The adapter renders the manifests of its operations only when running them, so only the manifest given with --file is dry run"}, []string{"Give the manifest to dry run with --file"})
+}
@@ -0,0 +1,174 @@
+package mesh
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	injectMesh     string
+	injectWorkload string
+	injectRestart  bool
+	injectDryRun   bool
+)
+
+var injectCmd = &cobra.Command{
+	Use:   "inject [namespace]",
+	Short: "Inject the proxy of a service mesh in the pods of a namespace or workload",
+	Long: `Label or annotate the namespace, or the pod template of a workload, so that the proxy of the service mesh
+is injected in its pods. The mesh running in the cluster is used unless given with --mesh; Istio and Linkerd
+are supported and no adapter is required. Pods get the proxy once restarted, which --restart does.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Inject the proxy of the mesh running in the cluster in the pods of the default namespace
+mesheryctl exp mesh inject default
+
+// Inject the Istio proxy in a deployment and restart it for its pods to get the proxy
+mesheryctl exp mesh inject bookinfo --mesh istio --workload deployment/reviews-v1 --restart
+
+// Show the labels and annotations which would change, and the workloads which would be restarted
+mesheryctl exp mesh inject shop --restart --dry-run
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runInjection(args[0], true)
+	},
+}
+
+var uninjectCmd = &cobra.Command{
+	Use:   "uninject [namespace]",
+	Short: "Stop injecting the proxy of a service mesh in the pods of a namespace or workload",
+	Long: `Remove the labels or annotations of the namespace having the proxy of the service mesh injected in its
+pods, or opt the pod template of a workload out of the injection. Pods lose the proxy once restarted,
+which --restart does.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Stop injecting the proxy of the mesh running in the cluster in the pods of the default namespace
+mesheryctl exp mesh uninject default --restart
+
+// Opt a deployment out of the injection of the Linkerd proxy
+mesheryctl exp mesh uninject shop --mesh linkerd --workload deployment/cart
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runInjection(args[0], false)
+	},
+}
+
+func runInjection(namespace string, enabled bool) error {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return errors.Wrap(err, "error processing config")
+	}
+
+	mesh := injectMesh
+	if mesh == "" {
+		if mesh, err = detectedMesh(mctlCfg.GetBaseMesheryURL()); err != nil {
+			return err
+		}
+	}
+
+	injection := models.MeshInjectionRequest{
+		Namespace: namespace,
+		Workload:  injectWorkload,
+		Enabled:   enabled,
+		Restart:   injectRestart,
+		DryRun:    injectDryRun,
+	}
+	result, err := setMeshInjection(mctlCfg.GetBaseMesheryURL(), mesh, injection)
+	if err != nil {
+		return err
+	}
+	fmt.Print(injectionDiff(result))
+	return nil
+}
+
+// detectedMesh returns the name of the only service mesh running in the cluster
+func detectedMesh(baseURL string) (string, error) {
+	meshes, err := getMeshesStatus(baseURL)
+	if err != nil {
+		return "", err
+	}
+	if len(meshes) != 1 {
+		names := make([]string, 0, len(meshes))
+		for _, mesh := range meshes {
+			names = append(names, mesh.Name)
+		}
+		return "", ErrMeshNotDetected(names)
+	}
+	return meshes[0].Name, nil
+}
+
+// setMeshInjection has Meshery server enable or disable the injection of the proxy of the mesh
+func setMeshInjection(baseURL, mesh string, injection models.MeshInjectionRequest) (*models.MeshInjectionResult, error) {
+	payload, err := json.Marshal(injection)
+	if err != nil {
+		return nil, err
+	}
+	req, err := utils.NewRequest("POST", baseURL+"/api/system/meshes/"+strings.ToLower(mesh)+"/injection", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	body, err := doMeshRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.MeshInjectionResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the injection result")
+	}
+	return result, nil
+}
+
+// injectionDiff renders the changed labels and annotations of each resource, and the restarted workloads
+func injectionDiff(result *models.MeshInjectionResult) string {
+	var b strings.Builder
+	if result.DryRun {
+		b.WriteString("Dry run, nothing was changed\n")
+	}
+	if len(result.Changes) == 0 {
+		b.WriteString("Injection already set as requested, no label or annotation changed\n")
+	}
+	resource := ""
+	for _, change := range result.Changes {
+		if change.Resource != resource {
+			resource = change.Resource
+			b.WriteString(resource + "\n")
+		}
+		switch {
+		case change.From == nil:
+			fmt.Fprintf(&b, "  + %s %s=%s\n", change.Kind, change.Key, *change.To)
+		case change.To == nil:
+			fmt.Fprintf(&b, "  - %s %s=%s\n", change.Kind, change.Key, *change.From)
+		default:
+			fmt.Fprintf(&b, "  ~ %s %s: %s -> %s\n", change.Kind, change.Key, *change.From, *change.To)
+		}
+	}
+	for _, workload := range result.Restarted {
+		if result.DryRun {
+			fmt.Fprintf(&b, "%s would be restarted\n", workload)
+		} else {
+			fmt.Fprintf(&b, "%s restarted\n", workload)
+		}
+	}
+	return b.String()
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{injectCmd, uninjectCmd} {
+		cmd.Flags().StringVarP(&injectMesh, "mesh", "m", "", "(optional) service mesh whose proxy is injected, istio or linkerd (default: the mesh running in the cluster)")
+		cmd.Flags().StringVarP(&injectWorkload, "workload", "w", "", "(optional) workload of the namespace given as <kind>/<name>, e.g. deployment/reviews-v1")
+		cmd.Flags().BoolVar(&injectRestart, "restart", false, "(optional) restart the workloads for their pods to get or lose the proxy")
+		cmd.Flags().BoolVar(&injectDryRun, "dry-run", false, "(optional) show the changes without making them")
+		cmd.Flags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token for authenticating to Meshery API")
+	}
+}
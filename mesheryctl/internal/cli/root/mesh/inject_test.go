@@ -0,0 +1,183 @@
+package mesh
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestInjectCmd(t *testing.T) {
+	// setup current context
+	utils.SetupContextEnv(t)
+
+	// initialize mock server for handling requests
+	utils.StartMockery(t)
+
+	// create a test helper
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures")
+	testdataDir := filepath.Join(currDir, "testdata")
+	token := filepath.Join(fixturesDir, "token.golden")
+
+	meshesURL := testContext.BaseURL + "/api/system/meshes"
+	istioURL := testContext.BaseURL + "/api/system/meshes/istio/injection"
+	linkerdURL := testContext.BaseURL + "/api/system/meshes/linkerd/injection"
+
+	// test scenrios for fetching data
+	tests := []struct {
+		Name             string
+		Args             []string
+		ExpectedResponse string
+		URLs             []utils.MockURL
+		// Injection is the request the injection is expected to be set with
+		Injection   *models.MeshInjectionRequest
+		ExpectError bool
+	}{
+		{
+			Name:             "Inject the proxy of the mesh running in the cluster in a namespace",
+			Args:             []string{"inject", "default"},
+			ExpectedResponse: "inject.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: meshesURL, Response: "meshes.response.golden", ResponseCode: 200},
+				{Method: "POST", URL: istioURL, Response: "inject.response.golden", ResponseCode: 200},
+			},
+			Injection: &models.MeshInjectionRequest{Namespace: "default", Enabled: true},
+		},
+		{
+			Name:             "Dry run of the injection of the proxy of a mesh in a workload",
+			Args:             []string{"inject", "shop", "--mesh", "Linkerd", "--workload", "deployment/cart", "--restart", "--dry-run"},
+			ExpectedResponse: "inject.dryrun.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: linkerdURL, Response: "inject.dryrun.response.golden", ResponseCode: 200},
+			},
+			Injection: &models.MeshInjectionRequest{Namespace: "shop", Workload: "deployment/cart", Enabled: true, Restart: true, DryRun: true},
+		},
+		{
+			Name:             "Inject the proxy in a namespace already injected",
+			Args:             []string{"inject", "default", "-m", "istio"},
+			ExpectedResponse: "inject.unchanged.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: istioURL, Response: "inject.unchanged.response.golden", ResponseCode: 200},
+			},
+			Injection: &models.MeshInjectionRequest{Namespace: "default", Enabled: true},
+		},
+		{
+			Name:             "Stop injecting the proxy in a namespace and restart its workloads",
+			Args:             []string{"uninject", "bookinfo", "--restart"},
+			ExpectedResponse: "uninject.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: meshesURL, Response: "meshes.response.golden", ResponseCode: 200},
+				{Method: "POST", URL: istioURL, Response: "uninject.response.golden", ResponseCode: 200},
+			},
+			Injection: &models.MeshInjectionRequest{Namespace: "bookinfo", Enabled: false, Restart: true},
+		},
+		{
+			Name:             "Inject the proxy with several meshes running in the cluster",
+			Args:             []string{"inject", "default"},
+			ExpectedResponse: "inject.multiple.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: meshesURL, Response: "meshes.multiple.response.golden", ResponseCode: 200},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:             "Inject the proxy without MeshSync running",
+			Args:             []string{"inject", "default"},
+			ExpectedResponse: "inject.error.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: meshesURL, Response: "meshes.error.response.golden", ResponseCode: 500},
+			},
+			ExpectError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			for _, url := range tt.URLs {
+				// View api response from golden files
+				apiResponse := utils.NewGoldenFile(t, url.Response, fixturesDir).Load()
+
+				// mock response, the injection being answered only when requested as expected
+				responder := httpmock.NewStringResponder(url.ResponseCode, apiResponse)
+				if url.Method == "POST" {
+					responder = func(req *http.Request) (*http.Response, error) {
+						injection := &models.MeshInjectionRequest{}
+						if err := json.NewDecoder(req.Body).Decode(injection); err != nil || !reflect.DeepEqual(injection, tt.Injection) {
+							return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected injection request"), nil
+						}
+						return httpmock.NewStringResponse(url.ResponseCode, apiResponse), nil
+					}
+				}
+				httpmock.RegisterResponder(url.Method, url.URL, responder)
+			}
+
+			// set token
+			utils.TokenFlag = token
+
+			// reset the flags of the previous scenario
+			injectMesh, injectWorkload, injectRestart, injectDryRun = "", "", false, false
+
+			// Expected response
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+
+			// Grab console prints
+			rescueStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			_ = utils.SetupMeshkitLoggerTesting(t, false)
+			MeshCmd.SetArgs(tt.Args)
+			err := MeshCmd.Execute()
+
+			w.Close()
+			out, _ := io.ReadAll(r)
+			os.Stdout = rescueStdout
+
+			if err != nil {
+				// if we're supposed to get an error
+				if tt.ExpectError {
+					// write it in file
+					if *update {
+						golden.Write(err.Error())
+					}
+					expectedResponse := golden.Load()
+
+					utils.Equals(t, expectedResponse, err.Error())
+					return
+				}
+				t.Fatal(err)
+			}
+
+			// response being printed in console
+			actualResponse := string(out)
+
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+
+			utils.Equals(t, expectedResponse, actualResponse)
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
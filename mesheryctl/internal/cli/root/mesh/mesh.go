@@ -27,6 +27,6 @@ var MeshCmd = &cobra.Command{
 }
 
 func init() {
-	availableSubcommands = []*cobra.Command{validateCmd, deployCmd, removeCmd}
+	availableSubcommands = []*cobra.Command{validateCmd, deployCmd, removeCmd, configCmd, statusCmd, injectCmd, uninjectCmd, opsCmd, sampleAppCmd}
 	MeshCmd.AddCommand(availableSubcommands...)
 }
@@ -0,0 +1,301 @@
+package mesh
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	opsAdapter string
+	opsFile    string
+	opsDelete  bool
+	opsDryRun  bool
+	opsWatch   bool
+	opsTimeout time.Duration
+
+	opsSubcommands []*cobra.Command
+)
+
+var opsCmd = &cobra.Command{
+	Use:   "ops",
+	Short: "List and run the operations advertised by adapters",
+	Long: `List and run any operation advertised by the Meshery adapters, such as the installation of sample
+applications or custom configurations, without dedicated commands for them`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(opsSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+var opsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the operations advertised by the adapters",
+	Args:  cobra.NoArgs,
+	Example: `
+// List the operations advertised by every adapter
+mesheryctl mesh ops list
+
+// List the operations advertised by the Istio adapter
+mesheryctl mesh ops list --adapter istio
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		prefs, err := utils.GetSessionData(mctlCfg)
+		if err != nil {
+			return ErrGettingSessionData(err)
+		}
+
+		adapters := findAdapters(prefs.MeshAdapters, opsAdapter)
+		if len(adapters) == 0 {
+			return ErrNoAdapters
+		}
+		rows := operationRows(adapters)
+		if len(rows) == 0 {
+			utils.Log.Info("No operations advertised by the adapters")
+			return nil
+		}
+		utils.PrintToTable([]string{"ADAPTER", "OPERATION", "CATEGORY", "DESCRIPTION"}, rows)
+		return nil
+	},
+}
+
+var opsRunCmd = &cobra.Command{
+	Use:   "run [operation]",
+	Short: "Run an operation advertised by an adapter",
+	Long: `Run an operation advertised by an adapter, in the current Kubernetes context. The adapter can be left out
+when a single adapter advertises the operation. The outcome of the operation is reported by the adapter
+as an event, which --watch waits for.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Install the Bookinfo sample application through the Istio adapter, and wait for the outcome
+mesheryctl mesh ops run bookinfo --adapter istio --namespace bookinfo --watch
+
+// Apply a custom configuration through the adapter advertising the custom operation
+mesheryctl mesh ops run custom --adapter meshery-istio:10000 -f virtual-service.yaml
+
+// Uninstall the Bookinfo sample application
+mesheryctl mesh ops run bookinfo --adapter istio --namespace bookinfo --delete
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		prefs, err := utils.GetSessionData(mctlCfg)
+		if err != nil {
+			return ErrGettingSessionData(err)
+		}
+
+		adapter, err := operationAdapter(prefs.MeshAdapters, opsAdapter, args[0])
+		if err != nil {
+			return err
+		}
+		operation := Operation{
+			Adapter:   adapter.Location,
+			Namespace: namespace,
+			Query:     args[0],
+		}
+		if opsDelete {
+			operation.DeleteOp = "on"
+		}
+		if opsFile != "" {
+			content, err := os.ReadFile(opsFile)
+			if err != nil {
+				return errors.Wrap(err, "failed to read the custom body file")
+			}
+			operation.CustomBody = string(content)
+		}
+
+		if opsDryRun {
+			out, err := yaml.Marshal(operation)
+			if err != nil {
+				return errors.Wrap(err, "failed to render the operation")
+			}
+			fmt.Println(string(out))
+			return nil
+		}
+
+		event, err := runAdapterOperation(mctlCfg, operation, opsWatch, opsTimeout)
+		if err != nil {
+			return err
+		}
+		if event != nil {
+			utils.Log.Info(event.Summary)
+			if event.Details != "" {
+				utils.Log.Info(event.Details)
+			}
+		}
+		return nil
+	},
+}
+
+// runAdapterOperation submits the operation to its adapter and, when wait is set, returns the event
+// the adapter reported the outcome of the operation with, failing if the operation failed
+func runAdapterOperation(mctlCfg *config.MesheryCtlConfig, operation Operation, wait bool, timeout time.Duration) (*utils.EventData, error) {
+	var events chan utils.Event
+	if wait {
+		// Subscribe before the operation is submitted, not to miss its outcome
+		req, err := utils.NewRequest("GET", mctlCfg.GetBaseMesheryURL()+"/api/events?client=cli_ops", nil)
+		if err != nil {
+			return nil, ErrCreatingDeployResponseRequest(err)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, ErrCreatingDeployResponseRequest(err)
+		}
+		defer utils.SafeClose(res.Body)
+		if events, err = utils.ConvertRespToSSE(res); err != nil {
+			return nil, ErrCreatingDeployResponseStream(err)
+		}
+	}
+
+	body, err := sendOperationRequest(mctlCfg, operation)
+	if err != nil {
+		return nil, err
+	}
+	utils.Log.Info(fmt.Sprintf("Operation %s submitted to %s", operation.Query, operation.Adapter))
+	if !wait {
+		return nil, nil
+	}
+
+	resp := models.AdapterOperationResponse{}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil || resp.OperationID == "" {
+		return nil, errors.New("Meshery Server did not return the ID of the operation, its outcome cannot be watched")
+	}
+	event, err := waitForOperation(events, resp.OperationID, timeout)
+	if err != nil {
+		return nil, err
+	}
+	if event.EventType == meshes.EventType_ERROR {
+		return nil, ErrOperationEvent(operation.Query, event.Summary, event.Details)
+	}
+	return event, nil
+}
+
+// matchAdapter tells if the adapter is the one given by location, host, or the name of its service mesh
+func matchAdapter(adapter *models.Adapter, name string) bool {
+	host := strings.Split(adapter.Location, ":")[0]
+	return adapter.Location == name ||
+		host == name ||
+		strings.EqualFold(adapter.Name, name) ||
+		strings.EqualFold(strings.TrimPrefix(host, "meshery-"), name)
+}
+
+// findAdapters returns the adapters matching the given one, all of them if none is given
+func findAdapters(adapters []*models.Adapter, name string) []*models.Adapter {
+	if name == "" {
+		return adapters
+	}
+	found := []*models.Adapter{}
+	for _, adapter := range adapters {
+		if matchAdapter(adapter, name) {
+			found = append(found, adapter)
+		}
+	}
+	return found
+}
+
+// operationRows returns the rows of the operations advertised by the adapters, sorted by adapter
+// and operation
+func operationRows(adapters []*models.Adapter) [][]string {
+	rows := [][]string{}
+	for _, adapter := range adapters {
+		for _, op := range adapter.Ops {
+			rows = append(rows, []string{adapter.Location, op.Key, op.Category.String(), op.Value})
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		for k := 0; k < 3; k++ {
+			if rows[i][k] != rows[j][k] {
+				return rows[i][k] < rows[j][k]
+			}
+		}
+		return false
+	})
+	return rows
+}
+
+// operationAdapter returns the adapter to run the operation with, among the given ones if any,
+// which must be the only one advertising it
+func operationAdapter(adapters []*models.Adapter, name, operation string) (*models.Adapter, error) {
+	candidates := findAdapters(adapters, name)
+	if len(candidates) == 0 {
+		return nil, ErrNoAdapters
+	}
+	advertising := []*models.Adapter{}
+	locations := []string{}
+	for _, adapter := range candidates {
+		for _, op := range adapter.Ops {
+			if op.Key == operation {
+				advertising = append(advertising, adapter)
+				locations = append(locations, adapter.Location)
+				break
+			}
+		}
+	}
+	switch len(advertising) {
+	case 0:
+		where := "any adapter"
+		if name != "" {
+			where = name
+		}
+		return nil, ErrUnsupportedOperation(operation, where)
+	case 1:
+		return advertising[0], nil
+	}
+	return nil, ErrAmbiguousOperation(operation, locations)
+}
+
+// waitForOperation returns the event the adapter reported the outcome of the operation with
+func waitForOperation(events chan utils.Event, operationID string, timeout time.Duration) (*utils.EventData, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			return nil, ErrTimeoutWaitingForOperation(operationID)
+		case event, ok := <-events:
+			if !ok {
+				return nil, ErrCreatingDeployResponseStream(errors.New("the event stream was closed before the outcome of the operation"))
+			}
+			if event.Data.OperationID == operationID {
+				return &event.Data, nil
+			}
+		}
+	}
+}
+
+func init() {
+	opsCmd.PersistentFlags().StringVarP(&opsAdapter, "adapter", "a", "", "(optional) adapter, by location or service mesh name, every adapter advertising the operation by default")
+	opsCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token for authenticating to Meshery API")
+
+	opsRunCmd.Flags().StringVarP(&namespace, "namespace", "n", "default", "Kubernetes namespace to run the operation in")
+	opsRunCmd.Flags().StringVarP(&opsFile, "file", "f", "", "(optional) file passed to the adapter as the operation's custom body")
+	opsRunCmd.Flags().BoolVar(&opsDelete, "delete", false, "(optional) revert the operation")
+	opsRunCmd.Flags().BoolVar(&opsDryRun, "dry-run", false, "(optional) print the operation without running it")
+	opsRunCmd.Flags().BoolVarP(&opsWatch, "watch", "w", false, "(optional) wait for the outcome of the operation")
+	opsRunCmd.Flags().DurationVar(&opsTimeout, "timeout", 20*time.Minute, "(optional) how long to wait for the outcome of the operation with --watch")
+
+	opsSubcommands = []*cobra.Command{opsListCmd, opsRunCmd}
+	opsCmd.AddCommand(opsSubcommands...)
+}
@@ -0,0 +1,206 @@
+package mesh
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestOpsCmd(t *testing.T) {
+	// setup current context
+	utils.SetupContextEnv(t)
+
+	// initialize mock server for handling requests
+	utils.StartMockery(t)
+
+	// create a test helper
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures")
+	testdataDir := filepath.Join(currDir, "testdata")
+	token := filepath.Join(fixturesDir, "token.golden")
+
+	syncURL := testContext.BaseURL + "/api/system/sync"
+	operationURL := testContext.BaseURL + "/api/system/adapter/operation"
+	eventsURL := testContext.BaseURL + "/api/events?client=cli_ops"
+
+	// test scenrios for fetching data
+	tests := []struct {
+		Name             string
+		Args             []string
+		ExpectedResponse string
+		URLs             []utils.MockURL
+		// Operation is the operation the adapter is expected to be requested to run
+		Operation   *Operation
+		ExpectError bool
+	}{
+		{
+			Name:             "List the operations of every adapter",
+			Args:             []string{"ops", "list"},
+			ExpectedResponse: "ops.list.output.golden",
+		},
+		{
+			Name:             "List the operations of an adapter not running",
+			Args:             []string{"ops", "list", "--adapter", "linkerd"},
+			ExpectedResponse: "ops.list.noadapter.output.golden",
+			ExpectError:      true,
+		},
+		{
+			Name:             "Run an operation advertised by a single adapter",
+			Args:             []string{"ops", "run", "bookinfo", "--namespace", "bookinfo"},
+			ExpectedResponse: "ops.run.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: operationURL, Response: "operation.response.golden", ResponseCode: 200},
+			},
+			Operation: &Operation{Adapter: "meshery-istio:10000", Namespace: "bookinfo", Query: "bookinfo"},
+		},
+		{
+			Name:             "Revert an operation of an adapter given by service mesh name",
+			Args:             []string{"ops", "run", "strict-mtls", "-a", "istio", "--delete"},
+			ExpectedResponse: "ops.run.delete.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: operationURL, Response: "operation.response.golden", ResponseCode: 200},
+			},
+			Operation: &Operation{Adapter: "meshery-istio:10000", Namespace: "default", Query: "strict-mtls", DeleteOp: "on"},
+		},
+		{
+			Name:             "Dry run of an operation",
+			Args:             []string{"ops", "run", "bookinfo", "-n", "bookinfo", "--dry-run"},
+			ExpectedResponse: "ops.run.dryrun.output.golden",
+		},
+		{
+			Name:             "Run an operation advertised by no adapter",
+			Args:             []string{"ops", "run", "emojivoto"},
+			ExpectedResponse: "ops.run.unsupported.output.golden",
+			ExpectError:      true,
+		},
+		{
+			Name:             "Run an operation and wait for its outcome",
+			Args:             []string{"ops", "run", "bookinfo", "-n", "bookinfo", "--watch"},
+			ExpectedResponse: "ops.run.watch.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: eventsURL, Response: "events.response.golden", ResponseCode: 200},
+				{Method: "POST", URL: operationURL, Response: "operation.watch.response.golden", ResponseCode: 200},
+			},
+			Operation: &Operation{Adapter: "meshery-istio:10000", Namespace: "bookinfo", Query: "bookinfo"},
+		},
+		{
+			Name:             "Run an operation failing while waiting for its outcome",
+			Args:             []string{"ops", "run", "bookinfo", "-n", "bookinfo", "--watch"},
+			ExpectedResponse: "ops.run.watch.error.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: eventsURL, Response: "events.error.response.golden", ResponseCode: 200},
+				{Method: "POST", URL: operationURL, Response: "operation.watch.response.golden", ResponseCode: 200},
+			},
+			Operation:   &Operation{Adapter: "meshery-istio:10000", Namespace: "bookinfo", Query: "bookinfo"},
+			ExpectError: true,
+		},
+		{
+			Name:             "Wait for the outcome of an operation without its ID",
+			Args:             []string{"ops", "run", "bookinfo", "-n", "bookinfo", "--watch"},
+			ExpectedResponse: "ops.run.watch.noid.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: eventsURL, Response: "events.response.golden", ResponseCode: 200},
+				{Method: "POST", URL: operationURL, Response: "operation.response.golden", ResponseCode: 200},
+			},
+			Operation:   &Operation{Adapter: "meshery-istio:10000", Namespace: "bookinfo", Query: "bookinfo"},
+			ExpectError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			// the adapters and their operations are read from the session data
+			syncResponse := utils.NewGoldenFile(t, "sync.response.golden", fixturesDir).Load()
+			httpmock.RegisterResponder("GET", syncURL, httpmock.NewStringResponder(200, syncResponse))
+
+			for _, url := range tt.URLs {
+				// View api response from golden files
+				apiResponse := utils.NewGoldenFile(t, url.Response, fixturesDir).Load()
+
+				// mock response, the operation being answered only when requested as expected
+				responder := httpmock.NewStringResponder(url.ResponseCode, apiResponse)
+				if url.Method == "POST" {
+					responder = func(req *http.Request) (*http.Response, error) {
+						if err := req.ParseForm(); err != nil ||
+							req.PostForm.Get("adapter") != tt.Operation.Adapter ||
+							req.PostForm.Get("query") != tt.Operation.Query ||
+							req.PostForm.Get("namespace") != tt.Operation.Namespace ||
+							req.PostForm.Get("deleteOp") != tt.Operation.DeleteOp ||
+							req.PostForm.Get("customBody") != tt.Operation.CustomBody {
+							return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected operation request"), nil
+						}
+						return httpmock.NewStringResponse(url.ResponseCode, apiResponse), nil
+					}
+				}
+				httpmock.RegisterResponder(url.Method, url.URL, responder)
+			}
+
+			// set token
+			utils.TokenFlag = token
+
+			// reset the flags of the previous scenario
+			opsAdapter, opsFile, opsDelete, opsDryRun, opsWatch = "", "", false, false, false
+			opsTimeout, namespace = 20*time.Minute, "default"
+
+			// Expected response
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+
+			// Grab console prints
+			rescueStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			b := utils.SetupMeshkitLoggerTesting(t, false)
+			MeshCmd.SetArgs(tt.Args)
+			err := MeshCmd.Execute()
+
+			w.Close()
+			out, _ := io.ReadAll(r)
+			os.Stdout = rescueStdout
+
+			if err != nil {
+				// if we're supposed to get an error
+				if tt.ExpectError {
+					// write it in file
+					if *update {
+						golden.Write(err.Error())
+					}
+					expectedResponse := golden.Load()
+
+					utils.Equals(t, expectedResponse, err.Error())
+					return
+				}
+				t.Fatal(err)
+			}
+
+			// response being printed in console, followed by the logs
+			actualResponse := string(out) + b.String()
+
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+
+			utils.Equals(t, expectedResponse, actualResponse)
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
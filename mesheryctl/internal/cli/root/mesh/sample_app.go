@@ -0,0 +1,417 @@
+package mesh
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshery/models/pattern/core"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// sampleApp is an application deployed to smoke test a service mesh and its performance
+type sampleApp struct {
+	name string
+	// manifest is the URL of the Kubernetes manifests of the application
+	manifest string
+	// namespace the application is deployed in by default
+	namespace string
+	// endpoint is the URL the performance profile of the application targets, given its namespace
+	endpoint string
+}
+
+var sampleApps = []sampleApp{
+	{
+		name:      "bookinfo",
+		manifest:  "https://raw.githubusercontent.com/istio/istio/release-1.18/samples/bookinfo/platform/kube/bookinfo.yaml",
+		namespace: "bookinfo",
+		endpoint:  "http://productpage.%s.svc.cluster.local:9080/productpage",
+	},
+	{
+		name:      "emojivoto",
+		manifest:  "https://run.linkerd.io/emojivoto.yml",
+		namespace: "emojivoto",
+		endpoint:  "http://web-svc.%s.svc.cluster.local/",
+	},
+	{
+		name:      "online-boutique",
+		manifest:  "https://raw.githubusercontent.com/GoogleCloudPlatform/microservices-demo/main/release/kubernetes-manifests.yaml",
+		namespace: "online-boutique",
+		endpoint:  "http://frontend.%s.svc.cluster.local/",
+	},
+}
+
+var (
+	sampleAppNamespace   string
+	sampleAppAdapter     string
+	sampleAppManifest    string
+	sampleAppWait        bool
+	sampleAppTimeout     time.Duration
+	sampleAppSkipProfile bool
+
+	sampleAppSubcommands []*cobra.Command
+)
+
+var sampleAppCmd = &cobra.Command{
+	Use:   "sample-app",
+	Short: "Deploy sample applications to smoke test service meshes",
+	Long: `Deploy and undeploy the sample applications bookinfo, emojivoto and online-boutique in the current Kubernetes
+context, from their manifests or through the adapter of a service mesh, along with performance profiles
+targeting them`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(sampleAppSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+var sampleAppListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the sample applications",
+	Args:  cobra.NoArgs,
+	Example: `
+// List the sample applications, with the namespace they are deployed in by default
+mesheryctl mesh sample-app list
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rows := [][]string{}
+		for _, app := range sampleApps {
+			rows = append(rows, []string{app.name, app.namespace, fmt.Sprintf(app.endpoint, app.namespace)})
+		}
+		utils.PrintToTable([]string{"NAME", "NAMESPACE", "ENDPOINT"}, rows)
+		return nil
+	},
+}
+
+var sampleAppDeployCmd = &cobra.Command{
+	Use:   "deploy [bookinfo|emojivoto|online-boutique]",
+	Short: "Deploy a sample application",
+	Long: `Deploy a sample application in the current Kubernetes context, from its manifests unless an adapter is given
+with --adapter, and wait for it to become ready. Deployed from its manifests, the application is ready once its
+workloads are; deployed through an adapter, once the adapter reports so. A performance profile targeting the
+application is created unless it exists already, or --skip-profile is given.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Deploy bookinfo from its manifests and create a performance profile targeting it
+mesheryctl mesh sample-app deploy bookinfo
+
+// Deploy emojivoto through the Linkerd adapter
+mesheryctl mesh sample-app deploy emojivoto --adapter linkerd
+
+// Deploy online-boutique in the shop namespace, from manifests kept locally
+mesheryctl mesh sample-app deploy online-boutique --namespace shop --manifest ./kubernetes-manifests.yaml
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSampleApp(args[0], false)
+	},
+}
+
+var sampleAppUndeployCmd = &cobra.Command{
+	Use:   "undeploy [bookinfo|emojivoto|online-boutique]",
+	Short: "Undeploy a sample application",
+	Long: `Undeploy a sample application deployed with mesheryctl mesh sample-app deploy, with the same flags. The namespace
+and the performance profile of the application are left in place.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Undeploy bookinfo
+mesheryctl mesh sample-app undeploy bookinfo
+
+// Undeploy emojivoto through the Linkerd adapter
+mesheryctl mesh sample-app undeploy emojivoto --adapter linkerd
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSampleApp(args[0], true)
+	},
+}
+
+func runSampleApp(name string, undeploy bool) error {
+	app, err := findSampleApp(name)
+	if err != nil {
+		return err
+	}
+	namespace := sampleAppNamespace
+	if namespace == "" {
+		namespace = app.namespace
+	}
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return errors.Wrap(err, "error processing config")
+	}
+	baseURL := mctlCfg.GetBaseMesheryURL()
+
+	mesh := ""
+	if sampleAppAdapter != "" {
+		prefs, err := utils.GetSessionData(mctlCfg)
+		if err != nil {
+			return ErrGettingSessionData(err)
+		}
+		adapter, op, err := sampleAppOperation(prefs.MeshAdapters, sampleAppAdapter, app)
+		if err != nil {
+			return err
+		}
+		operation := Operation{Adapter: adapter.Location, Namespace: namespace, Query: op}
+		if undeploy {
+			operation.DeleteOp = "on"
+		}
+		if _, err := runAdapterOperation(mctlCfg, operation, sampleAppWait, sampleAppTimeout); err != nil {
+			return err
+		}
+		mesh = adapter.Name
+	} else {
+		patternFile, err := sampleAppPattern(baseURL, app, namespace)
+		if err != nil {
+			return err
+		}
+		if err := deploySampleAppPattern(baseURL, patternFile, undeploy); err != nil {
+			return err
+		}
+	}
+
+	if undeploy {
+		utils.Log.Info(fmt.Sprintf("Sample application %s undeployed from the namespace %s", app.name, namespace))
+		return nil
+	}
+	utils.Log.Info(fmt.Sprintf("Sample application %s deployed in the namespace %s", app.name, namespace))
+	if sampleAppSkipProfile {
+		return nil
+	}
+	profile, created, err := ensureSampleAppProfile(baseURL, app, namespace, mesh)
+	if err != nil {
+		return err
+	}
+	if created {
+		utils.Log.Info(fmt.Sprintf("Performance profile %q created, targeting %s", profile.Name, profile.Endpoints[0]))
+	} else {
+		utils.Log.Info(fmt.Sprintf("Performance profile %q exists already", profile.Name))
+	}
+	return nil
+}
+
+// findSampleApp returns the sample application with the given name
+func findSampleApp(name string) (sampleApp, error) {
+	names := []string{}
+	for _, app := range sampleApps {
+		if app.name == name {
+			return app, nil
+		}
+		names = append(names, app.name)
+	}
+	return sampleApp{}, errors.Errorf("unknown sample application %q, must be one of %s", name, strings.Join(names, ", "))
+}
+
+// sampleAppOperation returns the adapter, among the given ones, advertising the operation deploying
+// the sample application, along with the operation
+func sampleAppOperation(adapters []*models.Adapter, name string, app sampleApp) (*models.Adapter, string, error) {
+	candidates := findAdapters(adapters, name)
+	if len(candidates) == 0 {
+		return nil, "", ErrNoAdapters
+	}
+	advertising := []*models.Adapter{}
+	locations := []string{}
+	operation := ""
+	for _, adapter := range candidates {
+		for _, op := range adapter.Ops {
+			if op.Category == meshes.OpCategory_SAMPLE_APPLICATION && sampleAppKey(op.Key) == sampleAppKey(app.name) {
+				advertising = append(advertising, adapter)
+				locations = append(locations, adapter.Location)
+				operation = op.Key
+				break
+			}
+		}
+	}
+	switch len(advertising) {
+	case 0:
+		return nil, "", ErrUnsupportedOperation(app.name, name)
+	case 1:
+		return advertising[0], operation, nil
+	}
+	return nil, "", ErrAmbiguousOperation(app.name, locations)
+}
+
+// sampleAppKey normalizes the name of an application, as the keys of the operations of the adapters
+// spell it in various ways, such as bookinfo_app or online-boutique
+func sampleAppKey(name string) string {
+	key := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return -1
+	}, strings.ToLower(name))
+	return strings.TrimSuffix(key, "app")
+}
+
+// sampleAppPattern converts the manifests of the sample application into a pattern deploying it in
+// the namespace, which Meshery Server creates if needed
+func sampleAppPattern(baseURL string, app sampleApp, namespace string) (string, error) {
+	source := sampleAppManifest
+	if source == "" {
+		source = app.manifest
+	}
+	manifest, err := readManifest(source)
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{"k8s_manifest": manifest})
+	if err != nil {
+		return "", err
+	}
+	req, err := utils.NewRequest("POST", baseURL+"/api/pattern", bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	body, err := doMeshRequest(req)
+	if err != nil {
+		return "", err
+	}
+	patterns := []models.MesheryPattern{}
+	if err := json.Unmarshal(body, &patterns); err != nil || len(patterns) == 0 {
+		return "", errors.Errorf("failed to convert the manifests of %s into a pattern", app.name)
+	}
+
+	pattern, err := core.NewPatternFile([]byte(patterns[0].PatternFile))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse the pattern of %s", app.name)
+	}
+	pattern.Name = app.name
+	for id, svc := range pattern.Services {
+		// Namespaces are left out, so that undeploying does not delete one shared with other applications
+		if strings.EqualFold(strings.TrimSuffix(strings.ToLower(svc.Type), ".k8s"), "namespace") {
+			delete(pattern.Services, id)
+			continue
+		}
+		svc.Namespace = namespace
+	}
+	out, err := pattern.ToYAML()
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to render the pattern of %s", app.name)
+	}
+	return string(out), nil
+}
+
+// readManifest returns the manifests at the URL or in the file
+func readManifest(source string) (string, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		content, err := os.ReadFile(source)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read the manifests %s", source)
+		}
+		return string(content), nil
+	}
+	resp, err := http.Get(source)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to download the manifests %s", source)
+	}
+	defer utils.SafeClose(resp.Body)
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to download the manifests %s", source)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to download the manifests %s: status code %d", source, resp.StatusCode)
+	}
+	return string(content), nil
+}
+
+// deploySampleAppPattern deploys, or deletes, the pattern of a sample application, waiting for its
+// workloads to become ready when so requested
+func deploySampleAppPattern(baseURL, patternFile string, undeploy bool) error {
+	method := "POST"
+	params := url.Values{}
+	if undeploy {
+		method = "DELETE"
+	} else if sampleAppWait {
+		params.Set("wait", "true")
+		params.Set("timeout", sampleAppTimeout.String())
+	}
+	deployURL := baseURL + "/api/pattern/deploy"
+	if len(params) > 0 {
+		deployURL += "?" + params.Encode()
+	}
+	req, err := utils.NewRequest(method, deployURL, strings.NewReader(patternFile))
+	if err != nil {
+		return err
+	}
+	_, err = doMeshRequest(req)
+	return err
+}
+
+// ensureSampleAppProfile returns the performance profile targeting the sample application in the
+// namespace, created unless it exists already
+func ensureSampleAppProfile(baseURL string, app sampleApp, namespace, mesh string) (*models.PerformanceProfile, bool, error) {
+	name := fmt.Sprintf("%s (%s)", app.name, namespace)
+	req, err := utils.NewRequest("GET", baseURL+"/api/user/performance/profiles?search="+url.QueryEscape(name), nil)
+	if err != nil {
+		return nil, false, err
+	}
+	body, err := doMeshRequest(req)
+	if err != nil {
+		return nil, false, err
+	}
+	existing := models.PerformanceProfilesAPIResponse{}
+	if err := json.Unmarshal(body, &existing); err != nil {
+		return nil, false, errors.Wrap(err, "failed to unmarshal the performance profiles")
+	}
+	for i := range existing.Profiles {
+		if existing.Profiles[i].Name == name {
+			return &existing.Profiles[i], false, nil
+		}
+	}
+
+	profile := &models.PerformanceProfile{
+		Name:              name,
+		Endpoints:         []string{fmt.Sprintf(app.endpoint, namespace)},
+		LoadGenerators:    []string{"fortio"},
+		ServiceMesh:       mesh,
+		ConcurrentRequest: 1,
+		Duration:          "30s",
+	}
+	payload, err := json.Marshal(profile)
+	if err != nil {
+		return nil, false, err
+	}
+	req, err = utils.NewRequest("POST", baseURL+"/api/user/performance/profiles", bytes.NewReader(payload))
+	if err != nil {
+		return nil, false, err
+	}
+	body, err = doMeshRequest(req)
+	if err != nil {
+		return nil, false, err
+	}
+	created := &models.PerformanceProfile{}
+	if err := json.Unmarshal(body, created); err != nil {
+		return nil, false, errors.Wrap(err, "failed to unmarshal the performance profile")
+	}
+	return created, true, nil
+}
+
+func init() {
+	sampleAppCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token for authenticating to Meshery API")
+
+	for _, cmd := range []*cobra.Command{sampleAppDeployCmd, sampleAppUndeployCmd} {
+		cmd.Flags().StringVarP(&sampleAppNamespace, "namespace", "n", "", "(optional) Kubernetes namespace of the application, its own namespace by default")
+		cmd.Flags().StringVarP(&sampleAppAdapter, "adapter", "a", "", "(optional) adapter, by location or service mesh name, to deploy the application through instead of its manifests")
+		cmd.Flags().StringVar(&sampleAppManifest, "manifest", "", "(optional) URL or file of the manifests of the application, the upstream ones by default")
+		cmd.Flags().BoolVar(&sampleAppWait, "wait", true, "(optional) wait for the application to become ready, or for the adapter to report the outcome of the operation")
+		cmd.Flags().DurationVar(&sampleAppTimeout, "timeout", 10*time.Minute, "(optional) how long to wait for the application")
+	}
+	sampleAppDeployCmd.Flags().BoolVar(&sampleAppSkipProfile, "skip-profile", false, "(optional) skip creating the performance profile targeting the application")
+
+	sampleAppSubcommands = []*cobra.Command{sampleAppListCmd, sampleAppDeployCmd, sampleAppUndeployCmd}
+	sampleAppCmd.AddCommand(sampleAppSubcommands...)
+}
@@ -0,0 +1,278 @@
+package mesh
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshery/models/pattern/core"
+)
+
+func TestSampleAppCmd(t *testing.T) {
+	// setup current context
+	utils.SetupContextEnv(t)
+
+	// initialize mock server for handling requests
+	utils.StartMockery(t)
+
+	// create a test helper
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures")
+	testdataDir := filepath.Join(currDir, "testdata")
+	token := filepath.Join(fixturesDir, "token.golden")
+	manifest := filepath.Join(fixturesDir, "sample-app.manifest.golden")
+
+	syncURL := testContext.BaseURL + "/api/system/sync"
+	operationURL := testContext.BaseURL + "/api/system/adapter/operation"
+	patternURL := testContext.BaseURL + "/api/pattern"
+	deployURL := testContext.BaseURL + "/api/pattern/deploy"
+	profilesURL := testContext.BaseURL + "/api/user/performance/profiles"
+
+	// test scenrios for fetching data
+	tests := []struct {
+		Name             string
+		Args             []string
+		ExpectedResponse string
+		URLs             []utils.MockURL
+		// Namespace is the namespace the workloads of the pattern are expected to be deployed in
+		Namespace string
+		// Wait tells if the pattern is expected to be deployed waiting for its workloads
+		Wait bool
+		// Operation is the operation the adapter is expected to be requested to run
+		Operation *Operation
+		// Profile is the performance profile expected to be created
+		Profile     *models.PerformanceProfile
+		ExpectError bool
+	}{
+		{
+			Name:             "List the sample applications",
+			Args:             []string{"sample-app", "list"},
+			ExpectedResponse: "sample-app.list.output.golden",
+		},
+		{
+			Name:             "Deploy a sample application from its upstream manifests",
+			Args:             []string{"sample-app", "deploy", "bookinfo"},
+			ExpectedResponse: "sample-app.deploy.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: sampleApps[0].manifest, Response: "sample-app.manifest.golden", ResponseCode: 200},
+				{Method: "POST", URL: patternURL, Response: "sample-app.pattern.response.golden", ResponseCode: 200},
+				{Method: "POST", URL: deployURL, Response: "operation.response.golden", ResponseCode: 200},
+				{Method: "GET", URL: profilesURL, Response: "profiles.empty.response.golden", ResponseCode: 200},
+				{Method: "POST", URL: profilesURL, Response: "profile.response.golden", ResponseCode: 200},
+			},
+			Namespace: "bookinfo",
+			Wait:      true,
+			Profile: &models.PerformanceProfile{
+				Name:              "bookinfo (bookinfo)",
+				Endpoints:         []string{"http://productpage.bookinfo.svc.cluster.local:9080/productpage"},
+				LoadGenerators:    []string{"fortio"},
+				ConcurrentRequest: 1,
+				Duration:          "30s",
+			},
+		},
+		{
+			Name:             "Deploy a sample application in another namespace with its performance profile existing",
+			Args:             []string{"sample-app", "deploy", "bookinfo", "-n", "shop", "--manifest", manifest, "--wait=false"},
+			ExpectedResponse: "sample-app.deploy.namespace.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: patternURL, Response: "sample-app.pattern.response.golden", ResponseCode: 200},
+				{Method: "POST", URL: deployURL, Response: "operation.response.golden", ResponseCode: 200},
+				{Method: "GET", URL: profilesURL, Response: "profiles.response.golden", ResponseCode: 200},
+			},
+			Namespace: "shop",
+		},
+		{
+			Name:             "Undeploy a sample application",
+			Args:             []string{"sample-app", "undeploy", "bookinfo", "--manifest", manifest},
+			ExpectedResponse: "sample-app.undeploy.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: patternURL, Response: "sample-app.pattern.response.golden", ResponseCode: 200},
+				{Method: "DELETE", URL: deployURL, Response: "operation.response.golden", ResponseCode: 200},
+			},
+			Namespace: "bookinfo",
+		},
+		{
+			Name:             "Deploy a sample application through an adapter",
+			Args:             []string{"sample-app", "deploy", "bookinfo", "--adapter", "istio", "--wait=false"},
+			ExpectedResponse: "sample-app.deploy.adapter.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: operationURL, Response: "operation.response.golden", ResponseCode: 200},
+				{Method: "GET", URL: profilesURL, Response: "profiles.empty.response.golden", ResponseCode: 200},
+				{Method: "POST", URL: profilesURL, Response: "profile.istio.response.golden", ResponseCode: 200},
+			},
+			Operation: &Operation{Adapter: "meshery-istio:10000", Namespace: "bookinfo", Query: "bookinfo"},
+			Profile: &models.PerformanceProfile{
+				Name:              "bookinfo (bookinfo)",
+				Endpoints:         []string{"http://productpage.bookinfo.svc.cluster.local:9080/productpage"},
+				LoadGenerators:    []string{"fortio"},
+				ServiceMesh:       "ISTIO",
+				ConcurrentRequest: 1,
+				Duration:          "30s",
+			},
+		},
+		{
+			Name:             "Undeploy a sample application through an adapter",
+			Args:             []string{"sample-app", "undeploy", "bookinfo", "-a", "meshery-istio:10000", "--wait=false"},
+			ExpectedResponse: "sample-app.undeploy.adapter.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: operationURL, Response: "operation.response.golden", ResponseCode: 200},
+			},
+			Operation: &Operation{Adapter: "meshery-istio:10000", Namespace: "bookinfo", Query: "bookinfo", DeleteOp: "on"},
+		},
+		{
+			Name:             "Deploy a sample application through an adapter not advertising it",
+			Args:             []string{"sample-app", "deploy", "emojivoto", "--adapter", "istio"},
+			ExpectedResponse: "sample-app.deploy.unsupported.output.golden",
+			ExpectError:      true,
+		},
+		{
+			Name:             "Deploy an unknown sample application",
+			Args:             []string{"sample-app", "deploy", "petstore"},
+			ExpectedResponse: "sample-app.deploy.unknown.output.golden",
+			ExpectError:      true,
+		},
+		{
+			Name:             "Deploy a sample application whose manifests fail to convert",
+			Args:             []string{"sample-app", "deploy", "bookinfo", "--manifest", manifest},
+			ExpectedResponse: "sample-app.deploy.error.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: patternURL, Response: "meshes.error.response.golden", ResponseCode: 500},
+			},
+			ExpectError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			// the adapters and their operations are read from the session data
+			syncResponse := utils.NewGoldenFile(t, "sync.response.golden", fixturesDir).Load()
+			httpmock.RegisterResponder("GET", syncURL, httpmock.NewStringResponder(200, syncResponse))
+
+			// expected tells if the request is the one expected by the scenario
+			expected := func(req *http.Request) bool {
+				switch {
+				case req.URL.Path == "/api/pattern":
+					body := map[string]string{}
+					content, _ := os.ReadFile(manifest)
+					return json.NewDecoder(req.Body).Decode(&body) == nil && body["k8s_manifest"] == string(content)
+				case req.URL.Path == "/api/pattern/deploy":
+					if (req.URL.Query().Get("wait") == "true") != tt.Wait {
+						return false
+					}
+					content, _ := io.ReadAll(req.Body)
+					pattern, err := core.NewPatternFile(content)
+					if err != nil || pattern.Name != "bookinfo" || len(pattern.Services) == 0 {
+						return false
+					}
+					for _, svc := range pattern.Services {
+						// the namespace of the application is left out of the pattern
+						if svc.Namespace != tt.Namespace || strings.EqualFold(svc.Type, "Namespace") {
+							return false
+						}
+					}
+					return true
+				case req.URL.Path == "/api/system/adapter/operation":
+					return req.ParseForm() == nil &&
+						req.PostForm.Get("adapter") == tt.Operation.Adapter &&
+						req.PostForm.Get("query") == tt.Operation.Query &&
+						req.PostForm.Get("namespace") == tt.Operation.Namespace &&
+						req.PostForm.Get("deleteOp") == tt.Operation.DeleteOp
+				case req.Method == "GET" && req.URL.Path == "/api/user/performance/profiles":
+					namespace := tt.Namespace
+					if tt.Operation != nil {
+						namespace = tt.Operation.Namespace
+					}
+					return req.URL.Query().Get("search") == "bookinfo ("+namespace+")"
+				case req.Method == "POST" && req.URL.Path == "/api/user/performance/profiles":
+					profile := &models.PerformanceProfile{}
+					return json.NewDecoder(req.Body).Decode(profile) == nil && reflect.DeepEqual(profile, tt.Profile)
+				}
+				return true
+			}
+
+			for _, url := range tt.URLs {
+				// View api response from golden files
+				apiResponse := utils.NewGoldenFile(t, url.Response, fixturesDir).Load()
+
+				// mock response, the requests being answered only when sent as expected
+				url := url
+				httpmock.RegisterResponder(url.Method, url.URL, func(req *http.Request) (*http.Response, error) {
+					if !expected(req) {
+						return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected request"), nil
+					}
+					return httpmock.NewStringResponse(url.ResponseCode, apiResponse), nil
+				})
+			}
+
+			// set token
+			utils.TokenFlag = token
+
+			// reset the flags of the previous scenario
+			sampleAppNamespace, sampleAppAdapter, sampleAppManifest = "", "", ""
+			sampleAppWait, sampleAppTimeout, sampleAppSkipProfile = true, 10*time.Minute, false
+
+			// Expected response
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+
+			// Grab console prints
+			rescueStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			b := utils.SetupMeshkitLoggerTesting(t, false)
+			MeshCmd.SetArgs(tt.Args)
+			err := MeshCmd.Execute()
+
+			w.Close()
+			out, _ := io.ReadAll(r)
+			os.Stdout = rescueStdout
+
+			if err != nil {
+				// if we're supposed to get an error
+				if tt.ExpectError {
+					// write it in file
+					if *update {
+						golden.Write(err.Error())
+					}
+					expectedResponse := golden.Load()
+
+					utils.Equals(t, expectedResponse, err.Error())
+					return
+				}
+				t.Fatal(err)
+			}
+
+			// response being printed in console, followed by the logs
+			actualResponse := string(out) + b.String()
+
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+
+			utils.Equals(t, expectedResponse, actualResponse)
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
@@ -0,0 +1,94 @@
+package mesh
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List the service meshes running in the cluster",
+	Long: `List the Istio and Linkerd meshes running in the cluster with their version, control plane
+components, number of proxies and injected namespaces, as discovered by MeshSync. No adapter is required.`,
+	Args: cobra.NoArgs,
+	Example: `
+// List the service meshes running in the cluster
+mesheryctl exp mesh status
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		meshes, err := getMeshesStatus(mctlCfg.GetBaseMesheryURL())
+		if err != nil {
+			return err
+		}
+		if len(meshes) == 0 {
+			utils.Log.Info("No service mesh found running in the cluster")
+			return nil
+		}
+
+		var data [][]string
+		for _, mesh := range meshes {
+			injected := strings.Join(mesh.InjectedNamespaces, ",")
+			if injected == "" {
+				injected = "-"
+			}
+			data = append(data, []string{mesh.Name, mesh.Version, mesh.Namespace, strconv.Itoa(len(mesh.Components)), strconv.Itoa(mesh.Proxies), injected})
+		}
+		utils.PrintToTable([]string{"MESH", "VERSION", "NAMESPACE", "COMPONENTS", "PROXIES", "INJECTED NAMESPACES"}, data)
+		return nil
+	},
+}
+
+// getMeshesStatus fetches the service meshes found running in the cluster by Meshery server
+func getMeshesStatus(baseURL string) ([]models.MeshStatus, error) {
+	req, err := utils.NewRequest("GET", baseURL+"/api/system/meshes", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := doMeshRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	meshes := []models.MeshStatus{}
+	if err := json.Unmarshal(body, &meshes); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the service meshes")
+	}
+	return meshes, nil
+}
+
+func doMeshRequest(req *http.Request) ([]byte, error) {
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, ErrMeshRequest(res.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+func init() {
+	statusCmd.Flags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token for authenticating to Meshery API")
+}
@@ -0,0 +1,140 @@
+package mesh
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestStatusCmd(t *testing.T) {
+	// setup current context
+	utils.SetupContextEnv(t)
+
+	// initialize mock server for handling requests
+	utils.StartMockery(t)
+
+	// create a test helper
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures")
+	testdataDir := filepath.Join(currDir, "testdata")
+	token := filepath.Join(fixturesDir, "token.golden")
+
+	meshesURL := testContext.BaseURL + "/api/system/meshes"
+
+	// test scenrios for fetching data
+	tests := []struct {
+		Name             string
+		Args             []string
+		ExpectedResponse string
+		URLs             []utils.MockURL
+		ExpectError      bool
+	}{
+		{
+			Name:             "Status of the mesh running in the cluster",
+			Args:             []string{"status"},
+			ExpectedResponse: "status.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: meshesURL, Response: "meshes.response.golden", ResponseCode: 200},
+			},
+		},
+		{
+			Name:             "Status of several meshes running in the cluster",
+			Args:             []string{"status"},
+			ExpectedResponse: "status.multiple.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: meshesURL, Response: "meshes.multiple.response.golden", ResponseCode: 200},
+			},
+		},
+		{
+			Name:             "Status without any mesh running in the cluster",
+			Args:             []string{"status"},
+			ExpectedResponse: "status.empty.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: meshesURL, Response: "meshes.empty.response.golden", ResponseCode: 200},
+			},
+		},
+		{
+			Name:             "Status without MeshSync running",
+			Args:             []string{"status"},
+			ExpectedResponse: "status.error.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "GET", URL: meshesURL, Response: "meshes.error.response.golden", ResponseCode: 500},
+			},
+			ExpectError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			for _, url := range tt.URLs {
+				// View api response from golden files
+				apiResponse := utils.NewGoldenFile(t, url.Response, fixturesDir).Load()
+
+				// mock response
+				httpmock.RegisterResponder(url.Method, url.URL,
+					httpmock.NewStringResponder(url.ResponseCode, apiResponse))
+			}
+
+			// set token
+			utils.TokenFlag = token
+
+			// Expected response
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+
+			// Grab console prints
+			rescueStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			b := utils.SetupMeshkitLoggerTesting(t, false)
+			MeshCmd.SetArgs(tt.Args)
+			err := MeshCmd.Execute()
+
+			w.Close()
+			out, _ := io.ReadAll(r)
+			os.Stdout = rescueStdout
+
+			if err != nil {
+				// if we're supposed to get an error
+				if tt.ExpectError {
+					// write it in file
+					if *update {
+						golden.Write(err.Error())
+					}
+					expectedResponse := golden.Load()
+
+					utils.Equals(t, expectedResponse, err.Error())
+					return
+				}
+				t.Fatal(err)
+			}
+
+			// response being printed in console, followed by the logs
+			actualResponse := string(out) + b.String()
+
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+
+			utils.Equals(t, expectedResponse, actualResponse)
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
@@ -22,6 +22,8 @@ type Operation struct {
 	DeleteOp   string `json:"deleteOp"`
 	Namespace  string `json:"namespace"`
 	Query      string `json:"query"`
+	// DryRun has the cluster dry run the custom body rather than the adapter run the operation
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
 var spec string
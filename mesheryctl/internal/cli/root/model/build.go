@@ -0,0 +1,76 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+var bundleFile string
+
+var buildCmd = &cobra.Command{
+	Use:   "build [model-dir]",
+	Short: "Package a model for import into the registry",
+	Long: `Validate a model and package its components into a single file, <name>-<version>.json by default,
+to be imported into the registry of Meshery with mesheryctl exp model import.
+The current directory is packaged when none is given.`,
+	Args: cobra.MaximumNArgs(1),
+	Example: `
+// Package the model scaffolded in ./my-operator
+mesheryctl exp model build my-operator
+
+// Package a model to a given file
+mesheryctl exp model build my-operator -o dist/my-operator.json
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := "."
+		if len(args) == 1 {
+			dir = args[0]
+		}
+		m, components, err := loadModel(dir)
+		if err != nil {
+			return err
+		}
+		if problems := validateModel(m, components); len(problems) > 0 {
+			return ErrInvalidModel(problems)
+		}
+
+		content, err := json.MarshalIndent(newBundle(m, components), "", "  ")
+		if err != nil {
+			return err
+		}
+		file := bundleFile
+		if file == "" {
+			file = m.Name + "-" + m.Version + ".json"
+		}
+		if err := os.WriteFile(file, append(content, '\n'), 0644); err != nil {
+			return ErrReadModel(file, err)
+		}
+		utils.Log.Info(fmt.Sprintf("Model %s %s packaged to %s", m.Name, m.Version, file))
+		return nil
+	},
+}
+
+// newBundle returns the bundle of the model, its components being registered as those of an adapter
+// named after the model
+func newBundle(m *Model, components []Component) *Bundle {
+	bundle := &Bundle{Model: *m, Components: make([]capability, 0, len(components))}
+	for _, c := range components {
+		bundle.Components = append(bundle.Components, capability{
+			OAMDefinition: c.Definition,
+			OAMRefSchema:  c.Schema,
+			Host:          "<none-local>",
+			Metadata: map[string]string{
+				"adapter.meshery.io/name": m.Name,
+			},
+		})
+	}
+	return bundle
+}
+
+func init() {
+	buildCmd.Flags().StringVarP(&bundleFile, "output", "o", "", "(optional) file to package the model to (default: <name>-<version>.json)")
+}
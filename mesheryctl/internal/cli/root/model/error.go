@@ -0,0 +1,26 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrInvalidModelCode = "1070"
+	ErrReadModelCode    = "1071"
+	ErrImportModelCode  = "1072"
+)
+
+func ErrInvalidModel(problems []string) error {
+	return errors.New(ErrInvalidModelCode, errors.Alert, []string{"Invalid model"}, problems, []string{"The description or the component definitions of the model were edited into an invalid state"}, []string{"Fix the problems reported, or scaffold the model again with mesheryctl exp model init"})
+}
+
+func ErrReadModel(path string, err error) error {
+	return errors.New(ErrReadModelCode, errors.Alert, []string{"Unable to read or write the model"}, []string{fmt.Sprintf("%s: %s", path, err)}, []string{"The path does not exist or is not accessible", "The file is not valid YAML or JSON"}, []string{"Check the path given, and that it holds a model scaffolded with mesheryctl exp model init"})
+}
+
+func ErrImportModel(component string, status int, message string) error {
+	return errors.New(ErrImportModelCode, errors.Alert, []string{"Unable to import the model"}, []string{fmt.Sprintf("registering %s returned status %d: %s", component, status, strings.TrimSpace(message))}, []string{"Meshery Server is not running", "The component definition is invalid"}, []string{"Check that Meshery Server is running with mesheryctl system status", "Validate the model with mesheryctl exp model validate"})
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import [bundle-file]",
+	Short: "Import a packaged model into the registry",
+	Long: `Register the components of a model packaged with mesheryctl exp model build with Meshery Server,
+making them available in designs. Registering a component again replaces it.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Import a packaged model
+mesheryctl exp model import my-operator-v0.1.0.json
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		content, err := os.ReadFile(args[0])
+		if err != nil {
+			return ErrReadModel(args[0], err)
+		}
+		bundle := &Bundle{}
+		if err := json.Unmarshal(content, bundle); err != nil {
+			return ErrReadModel(args[0], err)
+		}
+		if len(bundle.Components) == 0 {
+			return ErrReadModel(args[0], fmt.Errorf("the bundle holds no components"))
+		}
+
+		client := &http.Client{}
+		for _, c := range bundle.Components {
+			body, err := json.Marshal(c)
+			if err != nil {
+				return err
+			}
+			req, err := utils.NewRequest("POST", mctlCfg.GetBaseMesheryURL()+"/api/oam/workload", bytes.NewReader(body))
+			if err != nil {
+				return err
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return err
+			}
+			message, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				return ErrImportModel(c.OAMDefinition.Name, resp.StatusCode, string(message))
+			}
+		}
+		utils.Log.Info(fmt.Sprintf("Model %s %s imported, %d components registered", bundle.Model.Name, bundle.Model.Version, len(bundle.Components)))
+		return nil
+	},
+}
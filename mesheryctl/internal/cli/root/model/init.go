@@ -0,0 +1,230 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/spf13/cobra"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+var (
+	sourceCRDs   string
+	modelVersion string
+	outputDir    string
+)
+
+// exampleSchema is the schema of the example component of a model scaffolded without CRDs
+const exampleSchema = `{
+  "$schema": "http://json-schema.org/draft-04/schema",
+  "title": "Example",
+  "type": "object",
+  "properties": {
+    "spec": {
+      "type": "object",
+      "properties": {
+        "replicas": {
+          "type": "integer",
+          "minimum": 0
+        }
+      }
+    }
+  }
+}`
+
+var initCmd = &cobra.Command{
+	Use:   "init [model-name]",
+	Short: "Scaffold a model",
+	Long: `Scaffold a model in a directory named after it, with a component for every custom resource
+defined by the CRDs found in the directory given with --source-crds, or an example component to
+adapt otherwise. The settings of a component are those of the schema of the storage version of its CRD.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Scaffold the model of an operator from its CRDs
+mesheryctl exp model init my-operator --source-crds ./crds
+
+// Scaffold a model to fill in
+mesheryctl exp model init my-operator --version v1.2.0 -o ./models/my-operator
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		m := Model{Name: args[0], Version: modelVersion, DisplayName: displayName(args[0])}
+		if !modelNamePattern.MatchString(m.Name) {
+			return ErrInvalidModel([]string{fmt.Sprintf("name %q is not made of lowercase letters, digits and dashes", m.Name)})
+		}
+		dir := outputDir
+		if dir == "" {
+			dir = m.Name
+		}
+		if entries, err := os.ReadDir(dir); err == nil && len(entries) > 0 {
+			return ErrReadModel(dir, fmt.Errorf("directory is not empty"))
+		}
+
+		var components []Component
+		if sourceCRDs != "" {
+			crds, err := readCRDs(sourceCRDs)
+			if err != nil {
+				return err
+			}
+			if len(crds) == 0 {
+				return ErrReadModel(sourceCRDs, fmt.Errorf("no CustomResourceDefinition found"))
+			}
+			for _, crd := range crds {
+				c, err := componentFromCRD(m, crd)
+				if err != nil {
+					return err
+				}
+				components = append(components, c)
+			}
+		} else {
+			components = append(components, Component{
+				Name:       "example",
+				Definition: newDefinition(m, "example.com/v1alpha1", "Example"),
+				Schema:     exampleSchema,
+			})
+		}
+
+		if err := os.MkdirAll(filepath.Join(dir, componentsDir), 0755); err != nil {
+			return ErrReadModel(dir, err)
+		}
+		content, err := yaml.Marshal(m)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, modelFile), content, 0644); err != nil {
+			return ErrReadModel(dir, err)
+		}
+		for _, c := range components {
+			if err := writeComponent(dir, c); err != nil {
+				return ErrReadModel(dir, err)
+			}
+		}
+		utils.Log.Info(fmt.Sprintf("Model %s scaffolded in %s with %d components", m.Name, dir, len(components)))
+		return nil
+	},
+}
+
+// displayName returns the name of the model in title case, such as My Operator for my-operator
+func displayName(name string) string {
+	words := strings.Split(name, "-")
+	for i, w := range words {
+		if w != "" {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// readCRDs returns the CustomResourceDefinitions of the YAML and JSON files of the directory, or
+// of the file, ordered by kind
+func readCRDs(path string) ([]map[string]interface{}, error) {
+	var crds []map[string]interface{}
+	err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		switch strings.ToLower(filepath.Ext(file)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		decoder := yamlv3.NewDecoder(bytes.NewReader(content))
+		for {
+			var document map[string]interface{}
+			if err := decoder.Decode(&document); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return ErrReadModel(file, err)
+			}
+			if document["kind"] == "CustomResourceDefinition" {
+				crds = append(crds, document)
+			}
+		}
+	})
+	if err != nil {
+		return nil, ErrReadModel(path, err)
+	}
+	sort.SliceStable(crds, func(i, j int) bool {
+		return crdKind(crds[i]) < crdKind(crds[j])
+	})
+	return crds, nil
+}
+
+func crdKind(crd map[string]interface{}) string {
+	kind, _ := nested(crd, "spec", "names", "kind").(string)
+	return kind
+}
+
+// componentFromCRD returns the component of the model for the custom resources of the CRD, of
+// apiextensions.k8s.io/v1 or v1beta1, using the schema of its storage version
+func componentFromCRD(m Model, crd map[string]interface{}) (Component, error) {
+	kind := crdKind(crd)
+	group, _ := nested(crd, "spec", "group").(string)
+	if kind == "" || group == "" {
+		name, _ := nested(crd, "metadata", "name").(string)
+		return Component{}, ErrReadModel(name, fmt.Errorf("CRD has no group or kind"))
+	}
+
+	version, _ := nested(crd, "spec", "version").(string)
+	schema, _ := nested(crd, "spec", "validation", "openAPIV3Schema").(map[string]interface{})
+	versions, _ := nested(crd, "spec", "versions").([]interface{})
+	for i, v := range versions {
+		v, _ := v.(map[string]interface{})
+		if storage, _ := v["storage"].(bool); !storage && !(i == 0 && version == "") {
+			continue
+		}
+		version, _ = v["name"].(string)
+		if s, ok := nested(v, "schema", "openAPIV3Schema").(map[string]interface{}); ok {
+			schema = s
+		}
+	}
+	if schema == nil {
+		schema = map[string]interface{}{"type": "object"}
+	}
+
+	// the fields set by Meshery or by the cluster are not settings of the component
+	if properties, ok := schema["properties"].(map[string]interface{}); ok {
+		for _, field := range []string{"apiVersion", "kind", "metadata", "status"} {
+			delete(properties, field)
+		}
+	}
+	schema["$schema"] = "http://json-schema.org/draft-04/schema"
+	schema["title"] = kind
+	b, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return Component{}, err
+	}
+	return Component{
+		Name:       strings.ToLower(kind),
+		Definition: newDefinition(m, group+"/"+version, kind),
+		Schema:     string(b),
+	}, nil
+}
+
+func nested(v interface{}, keys ...string) interface{} {
+	for _, key := range keys {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	return v
+}
+
+func init() {
+	initCmd.Flags().StringVar(&sourceCRDs, "source-crds", "", "(optional) directory or file of the CRDs whose custom resources are the components of the model")
+	initCmd.Flags().StringVar(&modelVersion, "version", "v0.1.0", "(optional) version of the model")
+	initCmd.Flags().StringVarP(&outputDir, "output", "o", "", "(optional) directory to scaffold the model in (default: the name of the model)")
+}
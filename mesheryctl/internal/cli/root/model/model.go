@@ -0,0 +1,155 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// modelFile is the file describing the model, at the root of its directory
+	modelFile = "model.yaml"
+	// componentsDir is the directory of the component definitions of the model, a definition file
+	// <component>_definition.json and a schema file <component>.meshery.layer5.io.schema.json per
+	// component, as for the workloads of Meshery Server
+	componentsDir    = "components"
+	definitionSuffix = "_definition.json"
+	schemaSuffix     = ".meshery.layer5.io.schema.json"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+
+	// modelNamePattern is what names of models are made of, as they end the names of their components
+	modelNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+)
+
+// ModelCmd represents the root command for the model commands
+var ModelCmd = &cobra.Command{
+	Use:   "model",
+	Short: "Build models of components for the registry of Meshery",
+	Long: `Scaffold the component definitions of a model, such as the custom resources of an operator,
+validate them and package them for import into the registry of Meshery, so that they can be used in designs`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+// Model describes a model, kept as model.yaml in its directory
+type Model struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Component is a component of a model, its workload definition and the JSON schema of its settings
+type Component struct {
+	Name       string
+	Definition v1alpha1.WorkloadDefinition
+	Schema     string
+}
+
+// capability is a component as registered with Meshery Server through /api/oam/workload
+type capability struct {
+	OAMDefinition v1alpha1.WorkloadDefinition `json:"oam_definition"`
+	OAMRefSchema  string                      `json:"oam_ref_schema"`
+	Host          string                      `json:"host"`
+	Metadata      map[string]string           `json:"metadata,omitempty"`
+}
+
+// Bundle is a model packaged for import into the registry
+type Bundle struct {
+	Model      Model        `json:"model"`
+	Components []capability `json:"components"`
+}
+
+// newDefinition returns the workload definition of the component of the model standing for the
+// resources of the given kind and API version, named like the components of service meshes
+func newDefinition(m Model, apiVersion, kind string) v1alpha1.WorkloadDefinition {
+	var def v1alpha1.WorkloadDefinition
+	def.APIVersion = "core.oam.dev/v1alpha1"
+	def.Kind = "WorkloadDefinition"
+	def.Name = kind + "." + m.Name
+	def.Spec.DefinitionRef.Name = strings.ToLower(kind) + "." + m.Name + ".meshery.layer5.io"
+	def.Spec.Metadata = map[string]string{
+		"@type":         "pattern.meshery.io/mesh/workload",
+		"meshName":      m.Name,
+		"meshVersion":   m.Version,
+		"k8sAPIVersion": apiVersion,
+		"k8sKind":       kind,
+	}
+	return def
+}
+
+// loadModel reads the model and its components from its directory
+func loadModel(dir string) (*Model, []Component, error) {
+	content, err := os.ReadFile(filepath.Join(dir, modelFile))
+	if err != nil {
+		return nil, nil, ErrReadModel(filepath.Join(dir, modelFile), err)
+	}
+	m := &Model{}
+	if err := yaml.Unmarshal(content, m); err != nil {
+		return nil, nil, ErrReadModel(filepath.Join(dir, modelFile), err)
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, componentsDir, "*"+definitionSuffix))
+	if err != nil {
+		return nil, nil, ErrReadModel(dir, err)
+	}
+	sort.Strings(paths)
+	components := make([]Component, 0, len(paths))
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), definitionSuffix)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, ErrReadModel(path, err)
+		}
+		c := Component{Name: name}
+		if err := json.Unmarshal(content, &c.Definition); err != nil {
+			return nil, nil, ErrReadModel(path, err)
+		}
+		// a missing schema is reported by the validation
+		schema, err := os.ReadFile(filepath.Join(dir, componentsDir, name+schemaSuffix))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, nil, ErrReadModel(path, err)
+		}
+		c.Schema = string(schema)
+		components = append(components, c)
+	}
+	return m, components, nil
+}
+
+// writeComponent writes the definition and the schema files of the component to the components
+// directory of the model
+func writeComponent(dir string, c Component) error {
+	def, err := json.MarshalIndent(c.Definition, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, componentsDir, c.Name+definitionSuffix), append(def, '\n'), 0644); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, componentsDir, c.Name+schemaSuffix), []byte(c.Schema+"\n"), 0644)
+}
+
+func init() {
+	ModelCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+
+	availableSubcommands = []*cobra.Command{initCmd, validateCmd, buildCmd, importCmd}
+	ModelCmd.AddCommand(availableSubcommands...)
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestInitFromCRDs(t *testing.T) {
+	utils.SetupMeshkitLoggerTesting(t, false)
+	dir := filepath.Join(t.TempDir(), "my-operator")
+	defer func() { sourceCRDs, outputDir = "", "" }()
+	sourceCRDs, outputDir = filepath.Join("testdata", "crds"), dir
+	if err := initCmd.RunE(initCmd, []string{"my-operator"}); err != nil {
+		t.Fatal(err)
+	}
+
+	m, components, err := loadModel(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != "my-operator" || m.DisplayName != "My Operator" || len(components) != 2 {
+		t.Fatalf("model %+v with %d components, want my-operator with a gadget and a widget", m, len(components))
+	}
+	if problems := validateModel(m, components); len(problems) > 0 {
+		t.Fatalf("scaffolded model is invalid: %v", problems)
+	}
+
+	// the storage version of the CRD is the one kept, without the fields set by the cluster
+	widget := components[1]
+	if widget.Definition.Name != "Widget.my-operator" || widget.Definition.Spec.Metadata["k8sAPIVersion"] != "example.com/v1" {
+		t.Errorf("widget defined as %+v", widget.Definition)
+	}
+	schema := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(widget.Schema), &schema); err != nil {
+		t.Fatal(err)
+	}
+	properties := schema["properties"].(map[string]interface{})
+	if _, ok := properties["status"]; ok || properties["spec"] == nil || schema["title"] != "Widget" {
+		t.Errorf("widget schema %s", widget.Schema)
+	}
+	if gadget := components[0]; gadget.Definition.Spec.Metadata["k8sAPIVersion"] != "example.com/v1beta1" {
+		t.Errorf("v1beta1 gadget defined as %+v", gadget.Definition)
+	}
+
+	bundle := newBundle(m, components)
+	if len(bundle.Components) != 2 || bundle.Components[0].Metadata["adapter.meshery.io/name"] != "my-operator" {
+		t.Errorf("bundle %+v", bundle)
+	}
+}
+
+func TestValidateModel(t *testing.T) {
+	utils.SetupMeshkitLoggerTesting(t, false)
+	dir := filepath.Join(t.TempDir(), "broken")
+	defer func() { outputDir = "" }()
+	outputDir = dir
+	if err := initCmd.RunE(initCmd, []string{"broken"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, componentsDir, "example"+schemaSuffix), []byte(`{"type": "string"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m, components, err := loadModel(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Name = "Broken Model"
+	problems := strings.Join(validateModel(m, components), "\n")
+	for _, want := range []string{"name \"Broken Model\"", "meshName \"broken\"", "type is string", "title"} {
+		if !strings.Contains(problems, want) {
+			t.Errorf("problems %q do not report %s", problems, want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+var validateCmd = &cobra.Command{
+	Use:   "validate [model-dir]",
+	Short: "Validate a model",
+	Long: `Validate the description and the component definitions of a model, reporting every problem found.
+The current directory is validated when none is given.`,
+	Args: cobra.MaximumNArgs(1),
+	Example: `
+// Validate the model scaffolded in ./my-operator
+mesheryctl exp model validate my-operator
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := "."
+		if len(args) == 1 {
+			dir = args[0]
+		}
+		m, components, err := loadModel(dir)
+		if err != nil {
+			return err
+		}
+		if problems := validateModel(m, components); len(problems) > 0 {
+			return ErrInvalidModel(problems)
+		}
+		utils.Log.Info(fmt.Sprintf("Model %s %s is valid, with %d components", m.Name, m.Version, len(components)))
+		return nil
+	},
+}
+
+// validateModel returns the problems of the model and of its components
+func validateModel(m *Model, components []Component) []string {
+	var problems []string
+	if !modelNamePattern.MatchString(m.Name) {
+		problems = append(problems, fmt.Sprintf("%s: name %q is not made of lowercase letters, digits and dashes", modelFile, m.Name))
+	}
+	if m.Version == "" {
+		problems = append(problems, fmt.Sprintf("%s: version is missing", modelFile))
+	}
+	if len(components) == 0 {
+		problems = append(problems, fmt.Sprintf("%s: no component definitions found", componentsDir))
+	}
+
+	names := map[string]string{}
+	for _, c := range components {
+		file := componentsDir + "/" + c.Name + definitionSuffix
+		def := c.Definition
+		if def.APIVersion != "core.oam.dev/v1alpha1" || def.Kind != "WorkloadDefinition" {
+			problems = append(problems, fmt.Sprintf("%s: not a core.oam.dev/v1alpha1 WorkloadDefinition", file))
+		}
+		if def.Name == "" {
+			problems = append(problems, fmt.Sprintf("%s: metadata.name is missing", file))
+		} else if other, ok := names[def.Name]; ok {
+			problems = append(problems, fmt.Sprintf("%s: metadata.name %s is also the name of %s", file, def.Name, other))
+		} else {
+			names[def.Name] = file
+		}
+		if def.Spec.DefinitionRef.Name == "" {
+			problems = append(problems, fmt.Sprintf("%s: spec.definitionRef.name is missing", file))
+		}
+		for _, key := range []string{"@type", "k8sAPIVersion", "k8sKind"} {
+			if def.Spec.Metadata[key] == "" {
+				problems = append(problems, fmt.Sprintf("%s: spec.metadata.%s is missing", file, key))
+			}
+		}
+		if name := def.Spec.Metadata["meshName"]; name != m.Name {
+			problems = append(problems, fmt.Sprintf("%s: spec.metadata.meshName %q is not the name of the model", file, name))
+		}
+
+		schemaFile := componentsDir + "/" + c.Name + schemaSuffix
+		if c.Schema == "" {
+			problems = append(problems, fmt.Sprintf("%s: schema is missing", schemaFile))
+			continue
+		}
+		schema := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(c.Schema), &schema); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", schemaFile, err))
+			continue
+		}
+		if typ, ok := schema["type"]; ok && typ != "object" {
+			problems = append(problems, fmt.Sprintf("%s: type is %v rather than object", schemaFile, typ))
+		}
+		if title, _ := schema["title"].(string); title == "" {
+			problems = append(problems, fmt.Sprintf("%s: title, the name Meshery displays, is missing", schemaFile))
+		}
+	}
+	return problems
+}
@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/asaskevich/govalidator"
+	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
 	"github.com/layer5io/meshery/models"
@@ -21,8 +24,15 @@ import (
 )
 
 var (
-	skipSave    bool // skip saving a pattern
-	patternFile string
+	skipSave       bool // skip saving a pattern
+	patternFile    string
+	serviceAccount string
+	namespaces     []string
+	transactional  bool
+	wait           bool
+	waitTimeout    time.Duration
+	environment    string
+	components     []string
 )
 
 var applyCmd = &cobra.Command{
@@ -35,64 +45,60 @@ var applyCmd = &cobra.Command{
 
 	// deploy a saved pattern
 	mesheryctl pattern apply <pattern-name>
+
+	// apply a pattern and wait up to 5 minutes for its components to become ready
+	mesheryctl pattern apply -f <file | URL> --wait --timeout 5m
+
+	// apply a pattern as the team-a/deployer ServiceAccount, restricted to the team-a namespace
+	mesheryctl pattern apply -f <file | URL> --service-account team-a/deployer --namespaces team-a
+
+	// deploy a saved pattern to the staging environment, recording the deployment for its promotion
+	mesheryctl pattern apply <pattern-name> --environment staging
+
+	// deploy a saved pattern to the current context on the 1st of July at 2:00 UTC
+	mesheryctl pattern apply <pattern-name> --at 2024-07-01T02:00Z
+
+	// deploy only the frontend and cart components of a saved pattern
+	mesheryctl pattern apply <pattern-name> --components frontend,cart
 	`,
 	Args: cobra.MinimumNArgs(0),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var req *http.Request
 		var err error
+		var patternID *uuid.UUID
 		client := &http.Client{}
 
+		if environment != "" && skipSave {
+			return errors.New("--environment deploys a saved pattern and cannot be used with --skip-save")
+		}
+
 		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
 		if err != nil {
 			return errors.Wrap(err, "error processing config")
 		}
 
+		if schedulingRequested() {
+			if len(components) > 0 {
+				return errors.New("--components cannot be used with --at or --cron, schedules deploy the whole pattern")
+			}
+			schedule, err := newDesignSchedule(models.DesignScheduleDeploy)
+			if err != nil {
+				return err
+			}
+			return schedulePattern(mctlCfg.GetBaseMesheryURL(), args, schedule)
+		}
+
 		deployURL := mctlCfg.GetBaseMesheryURL() + "/api/pattern/deploy"
 		patternURL := mctlCfg.GetBaseMesheryURL() + "/api/pattern"
 
 		// pattern name has been passed
 		if len(args) > 0 {
-			// Merge args to get pattern-name
-			patternName := strings.Join(args, "%20")
-
-			// search and fetch patterns with pattern-name
-			utils.Log.Debug("Fetching patterns")
-
-			req, err = utils.NewRequest("GET", patternURL+"?search="+patternName, nil)
+			pattern, err := fetchPatternByName(client, patternURL, args)
 			if err != nil {
 				return err
 			}
-
-			resp, err := client.Do(req)
-			if err != nil {
-				return err
-			}
-
-			var response *models.PatternsAPIResponse
-			// failsafe (bad api call)
-			if resp.StatusCode != 200 {
-				return errors.Errorf("Response Status Code %d, possible Server Error", resp.StatusCode)
-			}
-			defer resp.Body.Close()
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return errors.Wrap(err, utils.PerfError("failed to read response body"))
-			}
-			err = json.Unmarshal(body, &response)
-			if err != nil {
-				return errors.Wrap(err, "failed to unmarshal response body")
-			}
-
-			index := 0
-			if len(response.Patterns) == 0 {
-				return errors.New("no patterns found with the given name")
-			} else if len(response.Patterns) == 1 {
-				patternFile = response.Patterns[0].PatternFile
-			} else {
-				// Multiple patterns with same name
-				index = multiplePatternsConfirmation(response.Patterns)
-				patternFile = response.Patterns[index].PatternFile
-			}
+			patternFile = pattern.PatternFile
+			patternID = pattern.ID
 		} else {
 			// Method to check if the entered file is a URL or not
 			if validURL := govalidator.IsURL(file); !validURL {
@@ -138,6 +144,9 @@ var applyCmd = &cobra.Command{
 					if err != nil {
 						return errors.Wrap(err, "failed to unmarshal response body")
 					}
+					if len(response) > 0 {
+						patternID = response[0].ID
+					}
 				}
 
 				// setup pattern file
@@ -208,9 +217,45 @@ var applyCmd = &cobra.Command{
 
 				// setup pattern file here
 				patternFile = response[0].PatternFile
+				if !skipSave {
+					patternID = response[0].ID
+				}
 			}
 		}
 
+		deployParams := url.Values{}
+		if patternID != nil {
+			// the resources are labeled with the design they belong to, for `mesheryctl design gc`
+			deployParams.Set("designId", patternID.String())
+		}
+		if serviceAccount != "" {
+			deployParams.Set("serviceAccount", serviceAccount)
+		}
+		if len(namespaces) > 0 {
+			deployParams.Set("namespaces", strings.Join(namespaces, ","))
+		}
+		if transactional {
+			deployParams.Set("transactional", "true")
+		}
+		if len(components) > 0 {
+			deployParams.Set("components", strings.Join(components, ","))
+		}
+		if wait {
+			deployParams.Set("wait", "true")
+			deployParams.Set("timeout", waitTimeout.String())
+		}
+
+		if environment != "" {
+			if patternID == nil {
+				return errors.New("pattern was not saved, it cannot be deployed to an environment")
+			}
+			return applyToEnvironment(mctlCfg.GetBaseMesheryURL(), patternID.String(), environment, deployParams)
+		}
+
+		if len(deployParams) > 0 {
+			deployURL += "?" + deployParams.Encode()
+		}
+
 		req, err = utils.NewRequest("POST", deployURL, bytes.NewBuffer([]byte(patternFile)))
 		if err != nil {
 			return err
@@ -229,12 +274,69 @@ var applyCmd = &cobra.Command{
 
 		if res.StatusCode == 200 {
 			utils.Log.Info("pattern successfully applied")
+		} else if wait {
+			// The components did not become ready, fail so that scripts can rely on the exit code
+			return errors.New(string(body))
 		}
 		utils.Log.Info(string(body))
 		return nil
 	},
 }
 
+// fetchPatternFileByName searches the saved patterns for the given name and returns
+// the pattern file of the match, prompting for a choice when several patterns match
+func fetchPatternFileByName(client *http.Client, patternURL string, args []string) (string, error) {
+	pattern, err := fetchPatternByName(client, patternURL, args)
+	if err != nil {
+		return "", err
+	}
+	return pattern.PatternFile, nil
+}
+
+// fetchPatternByName searches the saved patterns for the given name and returns the match,
+// prompting for a choice when several patterns match
+func fetchPatternByName(client *http.Client, patternURL string, args []string) (*models.MesheryPattern, error) {
+	// Merge args to get pattern-name
+	patternName := strings.Join(args, "%20")
+
+	// search and fetch patterns with pattern-name
+	utils.Log.Debug("Fetching patterns")
+
+	req, err := utils.NewRequest("GET", patternURL+"?search="+patternName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *models.PatternsAPIResponse
+	// failsafe (bad api call)
+	if resp.StatusCode != 200 {
+		return nil, errors.Errorf("Response Status Code %d, possible Server Error", resp.StatusCode)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal response body")
+	}
+
+	if len(response.Patterns) == 0 {
+		return nil, errors.New("no patterns found with the given name")
+	} else if len(response.Patterns) == 1 {
+		return &response.Patterns[0], nil
+	}
+	// Multiple patterns with same name
+	index := multiplePatternsConfirmation(response.Patterns)
+	return &response.Patterns[index], nil
+}
+
 func multiplePatternsConfirmation(profiles []models.MesheryPattern) int {
 	reader := bufio.NewReader(os.Stdin)
 
@@ -269,4 +371,13 @@ func multiplePatternsConfirmation(profiles []models.MesheryPattern) int {
 func init() {
 	applyCmd.Flags().StringVarP(&file, "file", "f", "", "Path to pattern file")
 	applyCmd.Flags().BoolVarP(&skipSave, "skip-save", "", false, "Skip saving a pattern")
+	applyCmd.Flags().StringVar(&serviceAccount, "service-account", "", "(optional) ServiceAccount, as namespace/name, to impersonate while applying the pattern")
+	applyCmd.Flags().BoolVar(&transactional, "transactional", false, "(optional) roll back the components applied so far if the pattern fails to apply")
+	applyCmd.Flags().BoolVar(&wait, "wait", false, "(optional) wait for the applied components to become ready")
+	applyCmd.Flags().DurationVar(&waitTimeout, "timeout", 5*time.Minute, "(optional) how long to wait for the components to become ready, used with --wait")
+	applyCmd.Flags().StringVar(&environment, "environment", "", "(optional) deploy the saved pattern to the given environment and record the deployment")
+	applyCmd.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "(optional) restrict the pattern to the given namespaces")
+	applyCmd.Flags().StringSliceVar(&components, "components", []string{}, "(optional) deploy only the given components of the pattern, whose dependencies must be given too or deployed already")
+	applyCmd.Flags().StringVar(&scheduleAt, "at", "", "(optional) schedule the deployment of the saved pattern at the given time, in RFC 3339 format")
+	applyCmd.Flags().StringVar(&scheduleCron, "cron", "", "(optional) schedule the deployment of the saved pattern recurringly, following the given cron expression in UTC")
 }
@@ -1,6 +1,7 @@
 package pattern
 
 import (
+	"net/http"
 	"path/filepath"
 	"runtime"
 	"testing"
@@ -129,3 +130,42 @@ func TestApplyCmd(t *testing.T) {
 	// stop mock server
 	utils.StopMockery(t)
 }
+
+func TestApplyComponents(t *testing.T) {
+	utils.SetupContextEnv(t)
+	utils.StartMockery(t)
+	defer utils.StopMockery(t)
+	testContext := utils.NewTestHelper(t)
+	defer func() {
+		components = []string{}
+		skipSave = false
+		scheduleAt = ""
+	}()
+
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	fixturesDir := filepath.Join(filepath.Dir(filename), "fixtures")
+	utils.TokenFlag = filepath.Join(fixturesDir, "token.golden")
+
+	var got string
+	httpmock.RegisterResponder("POST", testContext.BaseURL+"/api/pattern/deploy",
+		func(req *http.Request) (*http.Response, error) {
+			got = req.URL.Query().Get("components")
+			return httpmock.NewStringResponse(200, "deployed"), nil
+		})
+
+	b := utils.SetupMeshkitLoggerTesting(t, false)
+	PatternCmd.SetOutput(b)
+	PatternCmd.SetArgs([]string{"apply", "-f", filepath.Join(fixturesDir, "samplePattern.golden"), "--skip-save", "--components", "frontend,cart"})
+	if err := PatternCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	utils.Equals(t, "frontend,cart", got)
+
+	PatternCmd.SetArgs([]string{"apply", "samplePattern", "--components", "frontend", "--at", "2030-01-01T00:00:00Z"})
+	if err := PatternCmd.Execute(); err == nil {
+		t.Error("expected an error scheduling the deployment of components")
+	}
+}
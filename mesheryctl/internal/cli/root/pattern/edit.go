@@ -0,0 +1,399 @@
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+var (
+	setComponentFlags []string
+	replaceImageFlags []string
+	editDryRun        bool
+)
+
+var editCmd = &cobra.Command{
+	Use:   "edit <pattern-name>",
+	Short: "Edit the components of a pattern",
+	Long: `Edit the components of a saved pattern and save it back, for scripted modifications in pipelines.
+
+--set-component selects the components by kind, name and namespace and sets fields of their settings, e.g.
+'kind=Deployment,name=api,spec.replicas=5'. Paths starting with settings., traits., labels. or annotations.
+address those fields of the component instead, and containers[0] the first item of a list. Values are YAML,
+so 5 is a number and true a boolean.
+
+--replace-image replaces the images of every component, e.g. 'nginx:1.21=nginx:1.25'. An image given without
+tag or digest matches every tag of the image.
+
+The formatting and the comments of the pattern are kept.`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// Scale the api Deployment of a pattern to 5 replicas
+mesheryctl design edit bookinfo --set-component 'kind=Deployment,name=api,spec.replicas=5'
+
+// Label every Deployment of a pattern and upgrade nginx
+mesheryctl pattern edit bookinfo --set-component 'kind=Deployment,labels.team=web' --replace-image nginx=nginx:1.25
+
+// Print the edited pattern without saving it
+mesheryctl pattern edit bookinfo --replace-image registry.local/api:v1=registry.local/api:v2 --dry-run
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(setComponentFlags) == 0 && len(replaceImageFlags) == 0 {
+			return errors.New("nothing to edit, use --set-component or --replace-image")
+		}
+		var edits []componentEdit
+		for _, flag := range setComponentFlags {
+			edit, err := parseComponentEdit(flag)
+			if err != nil {
+				return err
+			}
+			edits = append(edits, *edit)
+		}
+		var replacements []imageReplacement
+		for _, flag := range replaceImageFlags {
+			replacement, err := parseImageReplacement(flag)
+			if err != nil {
+				return err
+			}
+			replacements = append(replacements, *replacement)
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		patternURL := mctlCfg.GetBaseMesheryURL() + "/api/pattern"
+
+		pattern, err := fetchPatternByName(&http.Client{}, patternURL, args)
+		if err != nil {
+			return err
+		}
+		content, changes, err := editPatternFile([]byte(pattern.PatternFile), edits, replacements)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			utils.Log.Info(fmt.Sprintf("Pattern %s is unchanged, no image matches", pattern.Name))
+			return nil
+		}
+		for _, change := range changes {
+			utils.Log.Info(change)
+		}
+
+		if editDryRun {
+			utils.Log.Info(string(content))
+			return nil
+		}
+
+		pattern.PatternFile = string(content)
+		payload, err := json.Marshal(map[string]interface{}{
+			"save":         true,
+			"pattern_data": pattern,
+		})
+		if err != nil {
+			return err
+		}
+		if err := doPatternRequest("POST", patternURL, bytes.NewReader(payload), nil); err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("Pattern %s saved with %d change(s)", pattern.Name, len(changes)))
+		return nil
+	},
+}
+
+// componentEdit selects components of a pattern and sets fields of them
+type componentEdit struct {
+	kind        string
+	name        string
+	namespace   string
+	assignments []fieldAssignment
+}
+
+type fieldAssignment struct {
+	path  string
+	value *yamlv3.Node
+}
+
+// imageReplacement replaces the image from by the image to
+type imageReplacement struct {
+	from string
+	to   string
+}
+
+// parseComponentEdit parses a --set-component flag, e.g. kind=Deployment,name=api,spec.replicas=5
+func parseComponentEdit(flag string) (*componentEdit, error) {
+	edit := &componentEdit{}
+	for _, part := range strings.Split(flag, ",") {
+		kv := strings.SplitN(part, "=", 2)
+		key := strings.TrimSpace(kv[0])
+		if len(kv) != 2 || key == "" {
+			return nil, errors.Errorf("invalid --set-component %q, expected key=value pairs separated by commas", flag)
+		}
+		switch key {
+		case "kind", "type":
+			edit.kind = kv[1]
+		case "name":
+			edit.name = kv[1]
+		case "namespace":
+			edit.namespace = kv[1]
+		default:
+			value := &yamlv3.Node{}
+			if err := yamlv3.Unmarshal([]byte(kv[1]), value); err != nil {
+				return nil, errors.Wrapf(err, "invalid value of %s in --set-component %q", key, flag)
+			}
+			if len(value.Content) == 0 {
+				// an empty value is the empty string rather than null
+				value.Content = []*yamlv3.Node{{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: ""}}
+			}
+			edit.assignments = append(edit.assignments, fieldAssignment{path: key, value: value.Content[0]})
+		}
+	}
+	if edit.kind == "" && edit.name == "" && edit.namespace == "" {
+		return nil, errors.Errorf("invalid --set-component %q, select the components with kind, name or namespace", flag)
+	}
+	if len(edit.assignments) == 0 {
+		return nil, errors.Errorf("invalid --set-component %q, no field to set", flag)
+	}
+	return edit, nil
+}
+
+// parseImageReplacement parses a --replace-image flag, e.g. nginx:1.21=nginx:1.25
+func parseImageReplacement(flag string) (*imageReplacement, error) {
+	kv := strings.SplitN(flag, "=", 2)
+	if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+		return nil, errors.Errorf("invalid --replace-image %q, expected old=new", flag)
+	}
+	return &imageReplacement{from: kv[0], to: kv[1]}, nil
+}
+
+// editPatternFile applies the edits and the image replacements to the pattern file, keeping its
+// formatting and comments, and returns the edited pattern file along with the changes made
+func editPatternFile(content []byte, edits []componentEdit, replacements []imageReplacement) ([]byte, []string, error) {
+	doc := &yamlv3.Node{}
+	if err := yamlv3.Unmarshal(content, doc); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse the pattern file")
+	}
+	var services *yamlv3.Node
+	if len(doc.Content) > 0 {
+		services = mappingValue(doc.Content[0], "services")
+	}
+	if services == nil || services.Kind != yamlv3.MappingNode {
+		return nil, nil, errors.New("the pattern has no components")
+	}
+
+	var changes []string
+	for _, edit := range edits {
+		matched := 0
+		for i := 0; i+1 < len(services.Content); i += 2 {
+			key, service := services.Content[i].Value, services.Content[i+1]
+			if !edit.matches(key, service) {
+				continue
+			}
+			matched++
+			for _, assignment := range edit.assignments {
+				base, path := service, assignment.path
+				if !hasPrefix(path, "settings", "traits", "labels", "annotations") {
+					path = "settings." + path
+				}
+				if err := setPath(base, path, assignment.value); err != nil {
+					return nil, nil, errors.Wrapf(err, "unable to set %s of component %s", assignment.path, key)
+				}
+				changes = append(changes, fmt.Sprintf("%s: set %s to %s", key, assignment.path, assignment.value.Value))
+			}
+		}
+		if matched == 0 {
+			return nil, nil, errors.Errorf("no component of the pattern matches kind=%q name=%q namespace=%q", edit.kind, edit.name, edit.namespace)
+		}
+	}
+
+	for i := 0; i+1 < len(services.Content); i += 2 {
+		key := services.Content[i].Value
+		walkImages(services.Content[i+1], func(image *yamlv3.Node) {
+			for _, replacement := range replacements {
+				if imageMatches(image.Value, replacement.from) && image.Value != replacement.to {
+					changes = append(changes, fmt.Sprintf("%s: replaced image %s with %s", key, image.Value, replacement.to))
+					image.Value, image.Tag, image.Style = replacement.to, "!!str", 0
+					return
+				}
+			}
+		})
+	}
+
+	var out bytes.Buffer
+	encoder := yamlv3.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to encode the pattern file")
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to encode the pattern file")
+	}
+	return out.Bytes(), changes, nil
+}
+
+// matches tells whether the component with the given key is selected, the name of a
+// component being its key unless given
+func (e componentEdit) matches(key string, service *yamlv3.Node) bool {
+	name, kind, namespace := key, "", ""
+	if n := mappingValue(service, "name"); n != nil && n.Value != "" {
+		name = n.Value
+	}
+	if t := mappingValue(service, "type"); t != nil {
+		kind = t.Value
+	}
+	if ns := mappingValue(service, "namespace"); ns != nil {
+		namespace = ns.Value
+	}
+	return (e.kind == "" || strings.EqualFold(e.kind, kind)) &&
+		(e.name == "" || e.name == name) &&
+		(e.namespace == "" || e.namespace == namespace)
+}
+
+// setPath sets the field at the path, e.g. settings.spec.template.spec.containers[0].image, creating
+// the missing maps along the way. Items of lists must exist.
+func setPath(node *yamlv3.Node, path string, value *yamlv3.Node) error {
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		key, index := segment, -1
+		if open := strings.Index(segment, "["); open >= 0 && strings.HasSuffix(segment, "]") {
+			n, err := strconv.Atoi(segment[open+1 : len(segment)-1])
+			if err != nil || n < 0 {
+				return errors.Errorf("invalid index in %s", segment)
+			}
+			key, index = segment[:open], n
+		}
+		if key == "" {
+			return errors.Errorf("invalid path %s", path)
+		}
+		if node.Kind != yamlv3.MappingNode {
+			return errors.Errorf("%s is not a map", strings.Join(segments[:i], "."))
+		}
+
+		last := i == len(segments)-1
+		child := mappingValue(node, key)
+		if child == nil {
+			if index >= 0 {
+				return errors.Errorf("%s is not a list", strings.Join(append(segments[:i:i], key), "."))
+			}
+			child = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+			if last {
+				child = cloneNode(value)
+			}
+			node.Content = append(node.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, child)
+			node = child
+			continue
+		}
+		if index >= 0 {
+			if child.Kind != yamlv3.SequenceNode || index >= len(child.Content) {
+				return errors.Errorf("%s has no item %d", strings.Join(append(segments[:i:i], key), "."), index)
+			}
+			if last {
+				replaceNode(child.Content[index], value)
+				return nil
+			}
+			node = child.Content[index]
+			continue
+		}
+		if last {
+			replaceNode(child, value)
+			return nil
+		}
+		node = child
+	}
+	return nil
+}
+
+// replaceNode replaces the node by a copy of the value, keeping the comments of the node
+func replaceNode(node, value *yamlv3.Node) {
+	head, line, foot := node.HeadComment, node.LineComment, node.FootComment
+	*node = *cloneNode(value)
+	node.HeadComment, node.LineComment, node.FootComment = head, line, foot
+}
+
+func cloneNode(node *yamlv3.Node) *yamlv3.Node {
+	clone := *node
+	clone.Content = make([]*yamlv3.Node, 0, len(node.Content))
+	for _, child := range node.Content {
+		clone.Content = append(clone.Content, cloneNode(child))
+	}
+	return &clone
+}
+
+// walkImages calls fn with the value of every image field found under the node
+func walkImages(node *yamlv3.Node, fn func(*yamlv3.Node)) {
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if value := node.Content[i+1]; node.Content[i].Value == "image" && value.Kind == yamlv3.ScalarNode {
+				fn(value)
+				continue
+			}
+			walkImages(node.Content[i+1], fn)
+		}
+	case yamlv3.SequenceNode:
+		for _, item := range node.Content {
+			walkImages(item, fn)
+		}
+	}
+}
+
+// imageMatches tells whether the image is the image from, or any tag or digest
+// of it when from is given without tag or digest
+func imageMatches(image, from string) bool {
+	if image == from {
+		return true
+	}
+	if imageRepository(from) != from {
+		return false
+	}
+	return imageRepository(image) == from
+}
+
+// imageRepository strips the tag and the digest of the image
+func imageRepository(image string) string {
+	if at := strings.Index(image, "@"); at >= 0 {
+		image = image[:at]
+	}
+	if colon := strings.LastIndex(image, ":"); colon > strings.LastIndex(image, "/") {
+		image = image[:colon]
+	}
+	return image
+}
+
+func mappingValue(node *yamlv3.Node, key string) *yamlv3.Node {
+	if node == nil || node.Kind != yamlv3.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func hasPrefix(path string, fields ...string) bool {
+	for _, field := range fields {
+		if path == field || strings.HasPrefix(path, field+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	editCmd.ValidArgsFunction = utils.CompleteNames(patternNames)
+	editCmd.Flags().StringArrayVar(&setComponentFlags, "set-component", []string{}, "select components and set fields, e.g. 'kind=Deployment,name=api,spec.replicas=5' (repeatable)")
+	editCmd.Flags().StringArrayVar(&replaceImageFlags, "replace-image", []string{}, "replace the images of the components, e.g. nginx:1.21=nginx:1.25 (repeatable)")
+	editCmd.Flags().BoolVar(&editDryRun, "dry-run", false, "(optional) print the edited pattern without saving it")
+}
@@ -0,0 +1,180 @@
+package pattern
+
+import (
+	"strings"
+	"testing"
+)
+
+const editTestPattern = `name: bookinfo
+services:
+  api:
+    type: Deployment
+    namespace: default
+    settings:
+      spec:
+        # scaled by the pipeline
+        replicas: 1
+        template:
+          spec:
+            containers:
+              - name: api
+                image: registry.local/api:v1
+  web:
+    type: Deployment
+    namespace: default
+    settings:
+      spec:
+        template:
+          spec:
+            containers:
+              - name: web
+                image: nginx:1.21
+  web-svc:
+    type: Service
+    namespace: default
+`
+
+func TestEditPatternFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		setComponent []string
+		replaceImage []string
+		contains     []string
+		notContains  []string
+		changes      int
+		wantErr      bool
+	}{
+		{
+			name:         "set replicas of a component keeping comments",
+			setComponent: []string{"kind=Deployment,name=api,spec.replicas=5"},
+			contains:     []string{"# scaled by the pipeline\n        replicas: 5"},
+			changes:      1,
+		},
+		{
+			name:         "set labels of every Deployment",
+			setComponent: []string{"kind=deployment,labels.team=web"},
+			contains:     []string{"labels:\n      team: web"},
+			changes:      2,
+		},
+		{
+			name:         "set a container field by index",
+			setComponent: []string{"name=web,spec.template.spec.containers[0].imagePullPolicy=Always"},
+			contains:     []string{"imagePullPolicy: Always"},
+			changes:      1,
+		},
+		{
+			name:         "no component matches",
+			setComponent: []string{"kind=StatefulSet,spec.replicas=5"},
+			wantErr:      true,
+		},
+		{
+			name:         "missing list item",
+			setComponent: []string{"name=web,spec.template.spec.containers[1].image=nginx"},
+			wantErr:      true,
+		},
+		{
+			name:         "replace an image with tag",
+			replaceImage: []string{"registry.local/api:v1=registry.local/api:v2"},
+			contains:     []string{"image: registry.local/api:v2", "image: nginx:1.21"},
+			changes:      1,
+		},
+		{
+			name:         "replace every tag of an image",
+			replaceImage: []string{"nginx=nginx:1.25"},
+			contains:     []string{"image: nginx:1.25", "image: registry.local/api:v1"},
+			notContains:  []string{"nginx:1.21"},
+			changes:      1,
+		},
+		{
+			name:         "no image matches",
+			replaceImage: []string{"nginx:1.19=nginx:1.25"},
+			contains:     []string{"image: nginx:1.21"},
+			changes:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var edits []componentEdit
+			for _, flag := range tt.setComponent {
+				edit, err := parseComponentEdit(flag)
+				if err != nil {
+					t.Fatal(err)
+				}
+				edits = append(edits, *edit)
+			}
+			var replacements []imageReplacement
+			for _, flag := range tt.replaceImage {
+				replacement, err := parseImageReplacement(flag)
+				if err != nil {
+					t.Fatal(err)
+				}
+				replacements = append(replacements, *replacement)
+			}
+
+			content, changes, err := editPatternFile([]byte(editTestPattern), edits, replacements)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) != tt.changes {
+				t.Errorf("expected %d changes, got %v", tt.changes, changes)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(string(content), s) {
+					t.Errorf("expected the pattern to contain %q, got\n%s", s, content)
+				}
+			}
+			for _, s := range tt.notContains {
+				if strings.Contains(string(content), s) {
+					t.Errorf("expected the pattern not to contain %q, got\n%s", s, content)
+				}
+			}
+		})
+	}
+}
+
+func TestParseComponentEdit(t *testing.T) {
+	for _, flag := range []string{"spec.replicas=5", "kind=Deployment", "kind=Deployment,spec.replicas", "kind=Deployment,spec.replicas=[1"} {
+		if _, err := parseComponentEdit(flag); err == nil {
+			t.Errorf("expected an error for %q", flag)
+		}
+	}
+
+	edit, err := parseComponentEdit("kind=Deployment,name=api,spec.replicas=5,spec.paused=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edit.kind != "Deployment" || edit.name != "api" || len(edit.assignments) != 2 {
+		t.Errorf("unexpected edit %+v", edit)
+	}
+	if tag := edit.assignments[0].value.Tag; tag != "!!int" {
+		t.Errorf("expected replicas to be an int, got %s", tag)
+	}
+}
+
+func TestImageMatches(t *testing.T) {
+	tests := []struct {
+		image string
+		from  string
+		want  bool
+	}{
+		{"nginx:1.21", "nginx:1.21", true},
+		{"nginx:1.21", "nginx", true},
+		{"nginx@sha256:abc", "nginx", true},
+		{"nginx:1.21", "nginx:1.25", false},
+		{"nginx-unprivileged:1.21", "nginx", false},
+		{"localhost:5000/nginx:1.21", "localhost:5000/nginx", true},
+		{"localhost:5000/nginx", "localhost", false},
+	}
+	for _, tt := range tests {
+		if got := imageMatches(tt.image, tt.from); got != tt.want {
+			t.Errorf("imageMatches(%q, %q) = %v, want %v", tt.image, tt.from, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	envK8sContextID      string
+	envPromotesFrom      string
+	envRequiredApprovals int
+)
+
+var environmentCmd = &cobra.Command{
+	Use:     "environment",
+	Aliases: []string{"env"},
+	Short:   "Manage the environments patterns are promoted through",
+	Long: `Manage the environments, such as staging and prod, patterns are deployed to and promoted through.
+Every environment deploys to a Kubernetes context and may require approved reviews for a pattern to be promoted to it.`,
+	Example: `
+// Create the staging environment
+mesheryctl pattern environment create staging --context <k8s-context-id>
+
+// Create the prod environment, which patterns are promoted to from staging with two approvals
+mesheryctl pattern environment create prod --context <k8s-context-id> --promotes-from staging --approvals 2
+
+// List the environments
+mesheryctl pattern environment list
+
+// Delete an environment
+mesheryctl pattern environment delete staging
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+	},
+}
+
+var environmentListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the environments",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		envs := []models.DeploymentEnvironment{}
+		if err := doPatternRequest("GET", mctlCfg.GetBaseMesheryURL()+"/api/environments", nil, &envs); err != nil {
+			return err
+		}
+		if len(envs) == 0 {
+			utils.Log.Info("No environments found")
+			return nil
+		}
+
+		var data [][]string
+		for _, env := range envs {
+			promotesFrom := env.PromotesFrom
+			if promotesFrom == "" {
+				promotesFrom = "-"
+			}
+			data = append(data, []string{env.Name, env.K8sContextID, promotesFrom, strconv.Itoa(env.RequiredApprovals)})
+		}
+		utils.PrintToTable([]string{"NAME", "K8S CONTEXT", "PROMOTES FROM", "REQUIRED APPROVALS"}, data)
+		return nil
+	},
+}
+
+var environmentCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create or update an environment",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		env := models.DeploymentEnvironment{
+			Name:              args[0],
+			K8sContextID:      envK8sContextID,
+			PromotesFrom:      envPromotesFrom,
+			RequiredApprovals: envRequiredApprovals,
+		}
+		payload, err := json.Marshal(env)
+		if err != nil {
+			return err
+		}
+		if err := doPatternRequest("POST", mctlCfg.GetBaseMesheryURL()+"/api/environments", bytes.NewReader(payload), &env); err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("Environment %s saved", env.Name))
+		return nil
+	},
+}
+
+var environmentDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete an environment",
+	Long:  `Delete an environment, the records of the patterns deployed to it are kept`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		if err := doPatternRequest("DELETE", mctlCfg.GetBaseMesheryURL()+"/api/environments/"+url.PathEscape(args[0]), nil, nil); err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("Environment %s deleted", args[0]))
+		return nil
+	},
+}
+
+// applyToEnvironment deploys the saved pattern with the given id to an environment
+func applyToEnvironment(baseURL, patternID, env string, deployParams url.Values) error {
+	path := baseURL + "/api/pattern/" + patternID + "/environments/" + url.PathEscape(env)
+	if len(deployParams) > 0 {
+		path += "?" + deployParams.Encode()
+	}
+
+	resp := models.PatternDeploymentAPIResponse{}
+	if err := doPatternRequest("POST", path, nil, &resp); err != nil {
+		return err
+	}
+	utils.Log.Info(fmt.Sprintf("pattern successfully applied to %s, deployment %s", env, resp.Deployment.ID))
+	utils.Log.Info(resp.Message)
+	return nil
+}
+
+func init() {
+	environmentCreateCmd.Flags().StringVar(&envK8sContextID, "context", "", "ID of the Kubernetes context the environment deploys to")
+	environmentCreateCmd.Flags().StringVar(&envPromotesFrom, "promotes-from", "", "(optional) environment patterns are promoted from by default")
+	environmentCreateCmd.Flags().IntVar(&envRequiredApprovals, "approvals", 0, "(optional) number of approved reviews a pattern needs to be promoted to the environment")
+	_ = environmentCreateCmd.MarkFlagRequired("context")
+
+	environmentCmd.AddCommand(environmentListCmd, environmentCreateCmd, environmentDeleteCmd)
+}
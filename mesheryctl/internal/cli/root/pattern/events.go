@@ -0,0 +1,94 @@
+package pattern
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+var eventsComponent string
+
+var eventsCmd = &cobra.Command{
+	Use:   "events [pattern-name]",
+	Short: "List the Kubernetes events of a pattern",
+	Long: `List the Kubernetes events of the resources Meshery Server deployed for a saved pattern in the current
+Kubernetes context and of their pods, the most recent last. Use --component to restrict to one component
+of the pattern.`,
+	Example: `
+// List the events of a pattern
+mesheryctl design events bookinfo
+
+// List the events of the frontend component of a pattern
+mesheryctl design events bookinfo --component frontend
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		client := &http.Client{}
+		pattern, err := fetchPatternByName(client, mctlCfg.GetBaseMesheryURL()+"/api/pattern", args)
+		if err != nil {
+			return err
+		}
+
+		params := url.Values{}
+		if eventsComponent != "" {
+			params.Set("component", eventsComponent)
+		}
+		resp, err := getDesignDebugInfo(client, fmt.Sprintf("%s/api/pattern/%s/events", mctlCfg.GetBaseMesheryURL(), pattern.ID), params)
+		if err != nil {
+			return err
+		}
+		defer utils.SafeClose(resp.Body)
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		events := []models.DesignEvent{}
+		if err := json.Unmarshal(body, &events); err != nil {
+			return errors.Wrap(err, "failed to decode the events")
+		}
+
+		if len(events) == 0 {
+			utils.Log.Info(fmt.Sprintf("No events for the pattern %s in the current Kubernetes context", pattern.Name))
+			return nil
+		}
+		utils.PrintToTable([]string{"LAST SEEN", "TYPE", "REASON", "OBJECT", "COMPONENT", "MESSAGE"}, eventRows(events, time.Now()))
+		return nil
+	},
+}
+
+// eventRows returns the rows of the events, their age being relative to now
+func eventRows(events []models.DesignEvent, now time.Time) [][]string {
+	rows := make([][]string, 0, len(events))
+	for _, e := range events {
+		lastSeen := "-"
+		if !e.LastSeen.IsZero() {
+			lastSeen = duration.HumanDuration(now.Sub(e.LastSeen))
+		}
+		object := e.Object
+		if e.Count > 1 {
+			object = fmt.Sprintf("%s (x%d)", e.Object, e.Count)
+		}
+		rows = append(rows, []string{lastSeen, e.Type, e.Reason, object, e.Component, e.Message})
+	}
+	return rows
+}
+
+func init() {
+	eventsCmd.Flags().StringVar(&eventsComponent, "component", "", "(optional) name of the component of the pattern to list the events of")
+}
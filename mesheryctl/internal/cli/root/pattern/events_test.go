@@ -0,0 +1,59 @@
+package pattern
+
+import (
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestPatternEvents(t *testing.T) {
+	utils.SetupContextEnv(t)
+	utils.StartMockery(t)
+	defer utils.StopMockery(t)
+	testContext := utils.NewTestHelper(t)
+	defer func() {
+		eventsComponent = ""
+	}()
+
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "token.golden")
+
+	id := mockPatternSearch(testContext.BaseURL)
+	httpmock.RegisterResponder("GET", testContext.BaseURL+"/api/pattern/"+id.String()+"/events?component=frontend",
+		httpmock.NewJsonResponderOrPanic(200, []models.DesignEvent{}))
+
+	b := utils.SetupMeshkitLoggerTesting(t, false)
+	PatternCmd.SetOutput(b)
+	PatternCmd.SetArgs([]string{"events", "bookinfo", "--component", "frontend"})
+	if err := PatternCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if out := b.String(); !strings.Contains(out, "No events for the pattern bookinfo") {
+		t.Errorf("expected no events to be reported, got %q", out)
+	}
+}
+
+func TestEventRows(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	rows := eventRows([]models.DesignEvent{
+		{Component: "frontend", Object: "Pod/frontend-5d4f", Type: "Warning", Reason: "BackOff", Message: "Back-off restarting failed container", Count: 4, LastSeen: now.Add(-90 * time.Second)},
+		{Component: "frontend", Object: "Deployment/frontend", Type: "Normal", Reason: "ScalingReplicaSet", Message: "Scaled up replica set frontend-5d4f to 1", Count: 1},
+	}, now)
+	want := [][]string{
+		{"90s", "Warning", "BackOff", "Pod/frontend-5d4f (x4)", "frontend", "Back-off restarting failed container"},
+		{"-", "Normal", "ScalingReplicaSet", "Deployment/frontend", "frontend", "Scaled up replica set frontend-5d4f to 1"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("expected the rows %v, got %v", want, rows)
+	}
+}
@@ -0,0 +1,215 @@
+package pattern
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+var (
+	execComponent string
+	execContainer string
+	execTTY       bool
+)
+
+// execResizeInterval is how often the size of the terminal is checked for changes
+const execResizeInterval = 250 * time.Millisecond
+
+var execCmd = &cobra.Command{
+	Use:   "exec [pattern-name] -- [command] [args...]",
+	Short: "Run a command in a component of a pattern",
+	Long: `Run a command in a container of a running pod of a component of a saved pattern deployed in the current
+Kubernetes context, through Meshery Server, as kubectl exec does but without a kubeconfig for the cluster.
+The input of mesheryctl is passed to the command, which is given a TTY when mesheryctl runs in a terminal,
+unless --tty=false is given. The Kubernetes connection of Meshery Server must be allowed to create
+pods/exec, and every command run is recorded as an event.`,
+	Example: `
+// Open a shell in the api component of a pattern
+mesheryctl design exec bookinfo --component api -- sh
+
+// List the files of the nginx container of the frontend component
+mesheryctl design exec bookinfo --component frontend --container nginx -- ls -l /etc/nginx
+
+// Pass a file to a command, without a TTY
+mesheryctl design exec bookinfo --component db --tty=false -- psql < init.sql
+	`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		dash := cmd.ArgsLenAtDash()
+		if dash < 1 || dash == len(args) {
+			return errors.New("give the name of the pattern, then the command to run after --")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dash := cmd.ArgsLenAtDash()
+		name, command := args[:dash], args[dash:]
+		tty := execTTY
+		if !cmd.Flags().Changed("tty") {
+			tty = term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		client := &http.Client{}
+		pattern, err := fetchPatternByName(client, mctlCfg.GetBaseMesheryURL()+"/api/pattern", name)
+		if err != nil {
+			return err
+		}
+
+		params := url.Values{"component": []string{execComponent}, "command": command}
+		if execContainer != "" {
+			params.Set("container", execContainer)
+		}
+		if tty {
+			params.Set("tty", "true")
+		}
+		execURL := fmt.Sprintf("%s/api/pattern/%s/exec", mctlCfg.GetBaseMesheryURL(), pattern.ID)
+		// Fail before the terminal is put in raw mode if the command cannot be run
+		resp, err := getDesignDebugInfo(client, execURL, params)
+		if err != nil {
+			return err
+		}
+		target := &models.DesignExec{}
+		err = json.NewDecoder(resp.Body).Decode(target)
+		utils.SafeClose(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode the container to run the command in")
+		}
+		utils.Log.Debug(fmt.Sprintf("Running %v in %s/%s of %s", command, target.Pod, target.Container, target.Component))
+
+		result, err := runExec(execURL+"?"+params.Encode(), tty, os.Stdin, os.Stdout, os.Stderr)
+		if err != nil {
+			return err
+		}
+		if result.Error != "" {
+			return errors.New(result.Error)
+		}
+		if result.ExitCode != 0 {
+			return errors.Errorf("command terminated with exit code %d", result.ExitCode)
+		}
+		return nil
+	},
+}
+
+// runExec runs the command through a WebSocket to Meshery Server, passing it the input and
+// writing its outputs, and returns its outcome
+func runExec(execURL string, tty bool, stdin io.Reader, stdout, stderr io.Writer) (*models.DesignExecResult, error) {
+	ws, err := dialDesignWebSocket(execURL)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	lock := &sync.Mutex{}
+	send := func(channel byte, data []byte) error {
+		lock.Lock()
+		defer lock.Unlock()
+		return ws.WriteMessage(websocket.BinaryMessage, append([]byte{channel}, data...))
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	if f, ok := stdin.(*os.File); ok && tty && term.IsTerminal(int(f.Fd())) {
+		state, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to put the terminal in raw mode")
+		}
+		defer func() {
+			_ = term.Restore(int(f.Fd()), state)
+		}()
+		go watchTerminalSize(int(f.Fd()), send, done)
+	}
+	go func() {
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := stdin.Read(buf)
+			if n > 0 {
+				if send(models.DesignExecStdin, buf[:n]) != nil {
+					return
+				}
+			}
+			if err != nil {
+				// Close the input of the command
+				_ = send(models.DesignExecStdin, nil)
+				return
+			}
+		}
+	}()
+
+	var result *models.DesignExecResult
+	for {
+		_, message, err := ws.ReadMessage()
+		if err != nil {
+			if closeErr, ok := err.(*websocket.CloseError); ok && closeErr.Code != websocket.CloseNormalClosure {
+				return nil, errors.New(closeErr.Text)
+			}
+			if result == nil {
+				return nil, errors.Wrap(err, "connection to Meshery Server lost before the command ended")
+			}
+			return result, nil
+		}
+		if len(message) == 0 {
+			continue
+		}
+		switch message[0] {
+		case models.DesignExecStdout:
+			_, _ = stdout.Write(message[1:])
+		case models.DesignExecStderr:
+			_, _ = stderr.Write(message[1:])
+		case models.DesignExecStatus:
+			result = &models.DesignExecResult{}
+			if err := json.Unmarshal(message[1:], result); err != nil {
+				return nil, errors.Wrap(err, "failed to decode the outcome of the command")
+			}
+		}
+	}
+}
+
+// watchTerminalSize sends the size of the terminal whenever it changes, until done is closed
+func watchTerminalSize(fd int, send func(byte, []byte) error, done <-chan struct{}) {
+	ticker := time.NewTicker(execResizeInterval)
+	defer ticker.Stop()
+	last := models.DesignExecSize{}
+	for {
+		if width, height, err := term.GetSize(fd); err == nil {
+			size := models.DesignExecSize{Width: uint16(width), Height: uint16(height)}
+			if size != last {
+				data, _ := json.Marshal(size)
+				if send(models.DesignExecResize, data) != nil {
+					return
+				}
+				last = size
+			}
+		}
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func init() {
+	execCmd.Flags().StringVar(&execComponent, "component", "", "name of the component of the pattern to run the command in")
+	execCmd.Flags().StringVar(&execContainer, "container", "", "(optional) name of the container to run the command in, the default container of the pod by default")
+	execCmd.Flags().BoolVar(&execTTY, "tty", false, "(optional) allocate a TTY for the command, by default when mesheryctl runs in a terminal")
+	_ = execCmd.MarkFlagRequired("component")
+}
@@ -0,0 +1,89 @@
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestRunExec(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "token.golden")
+
+	// Meshery Server running a command which outputs its input in upper case, and exits with 3
+	var command []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		command = r.URL.Query()["command"]
+		ws, err := (&websocket.Upgrader{}).Upgrade(rw, r, nil)
+		if err != nil {
+			return
+		}
+		defer ws.Close()
+		input := []byte{}
+		for {
+			_, message, err := ws.ReadMessage()
+			if err != nil {
+				return
+			}
+			if message[0] != models.DesignExecStdin {
+				continue
+			}
+			if len(message) == 1 {
+				break
+			}
+			input = append(input, message[1:]...)
+		}
+		_ = ws.WriteMessage(websocket.BinaryMessage, append([]byte{models.DesignExecStdout}, bytes.ToUpper(input)...))
+		_ = ws.WriteMessage(websocket.BinaryMessage, append([]byte{models.DesignExecStderr}, "done"...))
+		status, _ := json.Marshal(models.DesignExecResult{ExitCode: 3})
+		_ = ws.WriteMessage(websocket.BinaryMessage, append([]byte{models.DesignExecStatus}, status...))
+		_ = ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}))
+	defer server.Close()
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	result, err := runExec(server.URL+"/api/pattern/3817ec9a/exec?component=api&command=tr&command=a-z&command=A-Z", false, strings.NewReader("hello"), stdout, stderr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	utils.Equals(t, []string{"tr", "a-z", "A-Z"}, command)
+	utils.Equals(t, "HELLO", stdout.String())
+	utils.Equals(t, "done", stderr.String())
+	utils.Equals(t, 3, result.ExitCode)
+}
+
+func TestExecArgs(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Args        []string
+		ExpectError bool
+	}{
+		{Name: "no command", Args: []string{"bookinfo"}, ExpectError: true},
+		{Name: "pattern and command", Args: []string{"bookinfo", "--", "sh"}},
+		{Name: "no command after dash", Args: []string{"bookinfo", "--"}, ExpectError: true},
+		{Name: "no pattern", Args: []string{"--", "sh"}, ExpectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			if err := execCmd.Flags().Parse(tt.Args); err != nil {
+				t.Fatal(err)
+			}
+			err := execCmd.Args(execCmd, execCmd.Flags().Args())
+			if (err != nil) != tt.ExpectError {
+				t.Errorf("expected an error: %v, got %v", tt.ExpectError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package pattern
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	gcCluster string
+	gcDryRun  bool
+)
+
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Remove the resources left behind by deleted patterns",
+	Long: `List and remove the resources of a Kubernetes cluster which Meshery Server labeled as deployed for a
+pattern, but which belong to no existing pattern anymore, such as the residue of the patterns deleted
+while still deployed. The resources of the patterns deployed without being saved are tied to them by
+name. Use --dry-run to only list the resources which would be removed.`,
+	Example: `
+// List the resources of the current Kubernetes context left behind by deleted patterns
+mesheryctl design gc --dry-run
+
+// Remove the resources left behind by deleted patterns from the given cluster, without confirmation
+mesheryctl design gc --cluster prod-cluster -y
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		gcURL := mctlCfg.GetBaseMesheryURL() + "/api/patterns/gc"
+		if gcCluster != "" {
+			gcURL += "?" + url.Values{"cluster": []string{gcCluster}}.Encode()
+		}
+
+		result, err := collectGarbage(http.MethodGet, gcURL)
+		if err != nil {
+			return err
+		}
+		if len(result.Orphans) == 0 {
+			utils.Log.Info(fmt.Sprintf("No resources left behind by deleted patterns in %s", result.ContextName))
+			return nil
+		}
+		utils.PrintToTable([]string{"RESOURCE", "API VERSION", "PATTERN", "PATTERN ID"}, orphanRows(result.Orphans))
+		if gcDryRun {
+			utils.Log.Info(fmt.Sprintf("%d resource(s) of %s would be removed", len(result.Orphans), result.ContextName))
+			return nil
+		}
+		if !utils.SilentFlag && !utils.AskForConfirmation(fmt.Sprintf("Remove these %d resource(s) from %s", len(result.Orphans), result.ContextName)) {
+			return nil
+		}
+
+		result, err = collectGarbage(http.MethodDelete, gcURL)
+		if err != nil {
+			return err
+		}
+		failed := []string{}
+		for _, o := range result.Orphans {
+			if o.Error != "" {
+				failed = append(failed, fmt.Sprintf("%s: %s", orphanName(o), o.Error))
+			}
+		}
+		utils.Log.Info(fmt.Sprintf("%d resource(s) removed from %s", len(result.Orphans)-len(failed), result.ContextName))
+		if len(failed) > 0 {
+			return errors.Errorf("%d resource(s) could not be removed:\n%s", len(failed), strings.Join(failed, "\n"))
+		}
+		return nil
+	},
+}
+
+// orphanName names the resource the way kubectl does
+func orphanName(o models.OrphanedResource) string {
+	name := strings.ToLower(o.Kind) + "/" + o.Name
+	if o.Namespace != "" {
+		return o.Namespace + "/" + name
+	}
+	return name
+}
+
+// orphanRows returns the rows of the resources left behind, those of unsaved patterns having no ID
+func orphanRows(orphans []models.OrphanedResource) [][]string {
+	rows := make([][]string, 0, len(orphans))
+	for _, o := range orphans {
+		name, id := o.DesignName, o.DesignID
+		if name == "" {
+			name = "-"
+		}
+		if id == "" {
+			id = "-"
+		}
+		rows = append(rows, []string{orphanName(o), o.APIVersion, name, id})
+	}
+	return rows
+}
+
+func collectGarbage(method, gcURL string) (*models.DesignGCResult, error) {
+	req, err := utils.NewRequest(method, gcURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer utils.SafeClose(resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("server responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	result := &models.DesignGCResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the resources left behind")
+	}
+	return result, nil
+}
+
+func init() {
+	gcCmd.Flags().StringVar(&gcCluster, "cluster", "", "(optional) name or ID of the Kubernetes context to collect the garbage of, the current one by default")
+	gcCmd.Flags().BoolVar(&gcDryRun, "dry-run", false, "(optional) only list the resources which would be removed")
+	gcCmd.Flags().BoolVarP(&utils.SilentFlag, "yes", "y", false, "(optional) assume yes for user interactive prompts.")
+}
@@ -0,0 +1,82 @@
+package pattern
+
+import (
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestPatternGC(t *testing.T) {
+	utils.SetupContextEnv(t)
+	utils.StartMockery(t)
+	defer utils.StopMockery(t)
+	testContext := utils.NewTestHelper(t)
+	defer func() {
+		gcCluster, gcDryRun, utils.SilentFlag = "", false, false
+	}()
+
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "token.golden")
+
+	result := models.DesignGCResult{
+		ContextID:   "a1",
+		ContextName: "prod",
+		Orphans: []models.OrphanedResource{
+			{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "productpage", DesignID: "3817ec9a-1d83-4f6f-9154-0fd4408ba9f0", DesignName: "bookinfo"},
+		},
+	}
+	listed, deleted := 0, 0
+	httpmock.RegisterResponder("GET", testContext.BaseURL+"/api/patterns/gc?cluster=prod",
+		func(req *http.Request) (*http.Response, error) {
+			listed++
+			return httpmock.NewJsonResponse(200, result)
+		})
+	httpmock.RegisterResponder("DELETE", testContext.BaseURL+"/api/patterns/gc?cluster=prod",
+		func(req *http.Request) (*http.Response, error) {
+			deleted++
+			removed := result
+			removed.Deleted = true
+			return httpmock.NewJsonResponse(200, removed)
+		})
+
+	b := utils.SetupMeshkitLoggerTesting(t, false)
+	PatternCmd.SetOutput(b)
+
+	PatternCmd.SetArgs([]string{"gc", "--cluster", "prod", "--dry-run"})
+	if err := PatternCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	utils.Equals(t, 1, listed)
+	utils.Equals(t, 0, deleted)
+
+	gcDryRun = false
+	PatternCmd.SetArgs([]string{"gc", "--cluster", "prod", "-y"})
+	if err := PatternCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	utils.Equals(t, 2, listed)
+	utils.Equals(t, 1, deleted)
+}
+
+func TestOrphanRows(t *testing.T) {
+	rows := orphanRows([]models.OrphanedResource{
+		{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "productpage", DesignID: "3817ec9a", DesignName: "bookinfo"},
+		{APIVersion: "v1", Kind: "Namespace", Name: "httpbin", DesignName: "httpbin"},
+	})
+	want := [][]string{
+		{"default/deployment/productpage", "apps/v1", "bookinfo", "3817ec9a"},
+		{"namespace/httpbin", "v1", "httpbin", "-"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("expected the rows %v, got %v", want, rows)
+	}
+}
@@ -0,0 +1,74 @@
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	importType string
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import a pattern from other formats",
+	Long:  `Convert a Docker Compose file or Kubernetes manifests into a pattern and save it`,
+	Args:  cobra.NoArgs,
+	Example: `
+// Import a Docker Compose file as a pattern
+mesheryctl pattern import --type compose -f docker-compose.yml
+
+// Import Kubernetes manifests as a pattern
+mesheryctl pattern import --type k8s -f manifests.yaml
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return errors.New(utils.SystemError(fmt.Sprintf("failed to read file %s", file)))
+		}
+
+		body := map[string]interface{}{"save": true}
+		switch importType {
+		case "compose":
+			body["compose_file"] = string(content)
+		case "k8s":
+			body["k8s_manifest"] = string(content)
+		default:
+			return errors.Errorf("invalid type %q, must be one of compose or k8s", importType)
+		}
+
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		patterns := []models.MesheryPattern{}
+		if err = doPatternRequest("POST", mctlCfg.GetBaseMesheryURL()+"/api/pattern", bytes.NewReader(payload), &patterns); err != nil {
+			return err
+		}
+		for _, pattern := range patterns {
+			utils.Log.Info(fmt.Sprintf("Pattern %s imported with id %s", pattern.Name, pattern.ID))
+		}
+		return nil
+	},
+}
+
+func init() {
+	importCmd.Flags().StringVarP(&file, "file", "f", "", "Path to the file to import")
+	importCmd.Flags().StringVar(&importType, "type", "compose", "Type of the file to import, one of compose or k8s")
+	_ = importCmd.MarkFlagRequired("file")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"path/filepath"
 	"strings"
 
@@ -18,22 +19,45 @@ import (
 
 var (
 	verbose bool
+	// ownerFlag only lists the patterns of the user
+	ownerFlag string
+	// transferToFlag transfers the ownership of the patterns of ownerFlag to the user
+	transferToFlag string
 )
 
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List patterns",
 	Long:  `Display list of all available pattern files.`,
-	Args:  cobra.MinimumNArgs(0),
+	Example: `
+// List the patterns
+mesheryctl pattern list
+
+// List the patterns of a user, and transfer them to another user
+mesheryctl pattern list --owner alice@example.com
+mesheryctl pattern list --owner alice@example.com --transfer-to bob@example.com
+`,
+	Args: cobra.MinimumNArgs(0),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
 		if err != nil {
 			return errors.Wrap(err, "error processing config")
 		}
 
+		if transferToFlag != "" {
+			if ownerFlag == "" {
+				return errors.New("--owner is required to transfer the patterns of the user")
+			}
+			return utils.TransferOwnership(mctlCfg.GetBaseMesheryURL(), &models.OwnershipTransfer{Kind: models.OwnedPattern, From: ownerFlag, To: transferToFlag})
+		}
+
 		var response models.PatternsAPIResponse
 		client := &http.Client{}
-		req, err := utils.NewRequest("GET", mctlCfg.GetBaseMesheryURL()+"/api/pattern", nil)
+		patternURL := mctlCfg.GetBaseMesheryURL() + "/api/pattern"
+		if ownerFlag != "" {
+			patternURL += "?owner=" + url.QueryEscape(ownerFlag)
+		}
+		req, err := utils.NewRequest("GET", patternURL, nil)
 		if err != nil {
 			return err
 		}
@@ -52,6 +76,10 @@ var listCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		// a complete listing keeps the names offered by shell completion up to date
+		if ownerFlag == "" && uint(len(response.Patterns)) >= response.TotalCount {
+			utils.UpdateCompletionCache(mctlCfg.GetBaseMesheryURL(), utils.CompletionDesigns, patternNamesOf(response.Patterns))
+		}
 		tokenObj, err := utils.ReadToken(utils.TokenFlag)
 		if err != nil {
 			return err
@@ -124,4 +152,6 @@ var listCmd = &cobra.Command{
 
 func init() {
 	listCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Display full length user and pattern file identifiers")
+	listCmd.Flags().StringVarP(&ownerFlag, "owner", "", "", "(optional) List only the patterns owned by the user")
+	listCmd.Flags().StringVarP(&transferToFlag, "transfer-to", "", "", "(optional) Transfer the ownership of every pattern of --owner to the user")
 }
@@ -1,8 +1,10 @@
 package pattern
 
 import (
+	"encoding/json"
 	"flag"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/jarcoal/httpmock"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
 )
 
 var update = flag.Bool("update", false, "update golden files")
@@ -122,3 +125,42 @@ func TestPatternList(t *testing.T) {
 	// stop mock server
 	utils.StopMockery(t)
 }
+
+func TestPatternListTransfer(t *testing.T) {
+	utils.SetupContextEnv(t)
+	utils.StartMockery(t)
+	defer utils.StopMockery(t)
+	testContext := utils.NewTestHelper(t)
+
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "token.golden")
+
+	var transfer models.OwnershipTransfer
+	httpmock.RegisterResponder("POST", testContext.BaseURL+"/api/user/ownership/transfer",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&transfer); err != nil {
+				return nil, err
+			}
+			return httpmock.NewJsonResponse(200, models.OwnershipTransferResult{Transferred: map[string]int64{models.OwnedPattern: 2}})
+		})
+	defer func() {
+		ownerFlag = ""
+		transferToFlag = ""
+	}()
+
+	b := utils.SetupMeshkitLoggerTesting(t, false)
+	PatternCmd.SetOutput(b)
+	PatternCmd.SetArgs([]string{"list", "--transfer-to", "bob@example.com"})
+	if err := PatternCmd.Execute(); err == nil {
+		t.Error("expected an error transferring the patterns without --owner")
+	}
+
+	PatternCmd.SetArgs([]string{"list", "--owner", "alice@example.com", "--transfer-to", "bob@example.com"})
+	if err := PatternCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	utils.Equals(t, models.OwnershipTransfer{Kind: models.OwnedPattern, From: "alice@example.com", To: "bob@example.com"}, transfer)
+}
@@ -0,0 +1,113 @@
+package pattern
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	logsComponent string
+	logsFollow    bool
+	logsTail      int64
+	logsContainer string
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs [pattern-name]",
+	Short: "Print the logs of the pods of a pattern",
+	Long: `Print the logs of the containers of the pods run by the resources Meshery Server deployed for a saved
+pattern in the current Kubernetes context, every line being prefixed with the component, the pod and the
+container it comes from. Use --component to restrict to one component of the pattern.`,
+	Example: `
+// Print the logs of the pods of a pattern
+mesheryctl design logs bookinfo
+
+// Follow the logs of the frontend component of a pattern
+mesheryctl design logs bookinfo --component frontend --follow
+
+// Print the last 20 lines of the logs of a container of the frontend component
+mesheryctl design logs bookinfo --component frontend --container nginx --tail 20
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		client := &http.Client{}
+		pattern, err := fetchPatternByName(client, mctlCfg.GetBaseMesheryURL()+"/api/pattern", args)
+		if err != nil {
+			return err
+		}
+
+		params := url.Values{}
+		if logsComponent != "" {
+			params.Set("component", logsComponent)
+		}
+		if logsFollow {
+			params.Set("follow", "true")
+		}
+		if logsTail >= 0 {
+			params.Set("tail", strconv.FormatInt(logsTail, 10))
+		}
+		if logsContainer != "" {
+			params.Set("container", logsContainer)
+		}
+		resp, err := getDesignDebugInfo(client, fmt.Sprintf("%s/api/pattern/%s/logs", mctlCfg.GetBaseMesheryURL(), pattern.ID), params)
+		if err != nil {
+			return err
+		}
+		defer utils.SafeClose(resp.Body)
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			utils.Log.Info(scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			return errors.Wrap(err, "failed to read the logs")
+		}
+		return nil
+	},
+}
+
+// getDesignDebugInfo sends a GET request for the logs or events of a pattern, failing
+// on the responses other than 200
+func getDesignDebugInfo(client *http.Client, reqURL string, params url.Values) (*http.Response, error) {
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+	req, err := utils.NewRequest(http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer utils.SafeClose(resp.Body)
+		body, _ := io.ReadAll(resp.Body)
+		return nil, errors.Errorf("server responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return resp, nil
+}
+
+func init() {
+	logsCmd.Flags().StringVar(&logsComponent, "component", "", "(optional) name of the component of the pattern to print the logs of")
+	logsCmd.Flags().BoolVarP(&logsFollow, "follow", "f", false, "(optional) keep streaming the logs as they are written")
+	logsCmd.Flags().Int64Var(&logsTail, "tail", -1, "(optional) number of lines from the end of the logs of each container to start from, all of them by default")
+	logsCmd.Flags().StringVar(&logsContainer, "container", "", "(optional) name of the container to print the logs of")
+}
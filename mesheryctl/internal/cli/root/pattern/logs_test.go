@@ -0,0 +1,61 @@
+package pattern
+
+import (
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+// mockPatternSearch registers the response to the search of the pattern bookinfo
+func mockPatternSearch(baseURL string) *uuid.UUID {
+	id := uuid.FromStringOrNil("3817ec9a-1d83-4f6f-9154-0fd4408ba9f0")
+	httpmock.RegisterResponder("GET", baseURL+"/api/pattern?search=bookinfo",
+		httpmock.NewJsonResponderOrPanic(200, models.PatternsAPIResponse{
+			Patterns:   []models.MesheryPattern{{ID: &id, Name: "bookinfo"}},
+			TotalCount: 1,
+		}))
+	return &id
+}
+
+func TestPatternLogs(t *testing.T) {
+	utils.SetupContextEnv(t)
+	utils.StartMockery(t)
+	defer utils.StopMockery(t)
+	testContext := utils.NewTestHelper(t)
+	defer func() {
+		logsComponent, logsFollow, logsTail, logsContainer = "", false, -1, ""
+	}()
+
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "token.golden")
+
+	id := mockPatternSearch(testContext.BaseURL)
+	var query string
+	httpmock.RegisterResponder("GET", testContext.BaseURL+"/api/pattern/"+id.String()+"/logs",
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.RawQuery
+			return httpmock.NewStringResponse(200, "[frontend frontend-5d4f/nginx] started\n[frontend frontend-5d4f/nginx] ready\n"), nil
+		})
+
+	b := utils.SetupMeshkitLoggerTesting(t, false)
+	PatternCmd.SetOutput(b)
+	PatternCmd.SetArgs([]string{"logs", "bookinfo", "--component", "frontend", "--follow", "--tail", "20"})
+	if err := PatternCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	utils.Equals(t, "component=frontend&follow=true&tail=20", query)
+	if out := b.String(); !strings.Contains(out, "[frontend frontend-5d4f/nginx] started") || !strings.Contains(out, "[frontend frontend-5d4f/nginx] ready") {
+		t.Errorf("expected the logs to be printed, got %q", out)
+	}
+}
@@ -1,11 +1,17 @@
 package pattern
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var (
@@ -15,10 +21,11 @@ var (
 
 // PatternCmd represents the root command for pattern commands
 var PatternCmd = &cobra.Command{
-	Use:   "pattern",
-	Short: "Service Mesh Patterns Management",
-	Long:  `Manage service meshes using predefined patterns`,
-	Args:  cobra.MinimumNArgs(1),
+	Use:     "pattern",
+	Aliases: []string{"design"},
+	Short:   "Service Mesh Patterns Management",
+	Long:    `Manage service meshes using predefined patterns`,
+	Args:    cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
 			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
@@ -30,6 +37,47 @@ var PatternCmd = &cobra.Command{
 func init() {
 	PatternCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
 
-	availableSubcommands = []*cobra.Command{applyCmd, deleteCmd, viewCmd, listCmd}
+	availableSubcommands = []*cobra.Command{applyCmd, deleteCmd, undeployCmd, viewCmd, listCmd, importCmd, shareCmd, reviewCmd, promoteCmd, environmentCmd, editCmd, upgradeCheckCmd, scheduleCmd, restoreCmd, gcCmd, logsCmd, eventsCmd, portForwardCmd, execCmd}
 	PatternCmd.AddCommand(availableSubcommands...)
 }
+
+// patternNames returns the names of the patterns for shell completion, falling back
+// to the cached names when Meshery Server is unreachable
+func patternNames() []string {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return nil
+	}
+	baseURL := mctlCfg.GetBaseMesheryURL()
+	return utils.CompletionNames(baseURL, utils.CompletionDesigns, func() ([]string, error) {
+		req, err := utils.NewRequest("GET", baseURL+"/api/pattern?page_size=10000", nil)
+		if err != nil {
+			return nil, err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer utils.SafeClose(res.Body)
+		if res.StatusCode != http.StatusOK || utils.ContentTypeIsHTML(res) {
+			return nil, errors.Errorf("Response Status Code %d", res.StatusCode)
+		}
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		var response models.PatternsAPIResponse
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, err
+		}
+		return patternNamesOf(response.Patterns), nil
+	})
+}
+
+func patternNamesOf(patterns []models.MesheryPattern) []string {
+	names := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		names = append(names, pattern.Name)
+	}
+	return names
+}
@@ -0,0 +1,244 @@
+package pattern
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	forwardComponent string
+	forwardPorts     []string
+	forwardAddress   string
+)
+
+// portMapping is a local port forwarded to a port of a component, by number or name
+type portMapping struct {
+	local  int
+	remote string
+}
+
+var portForwardCmd = &cobra.Command{
+	Use:   "port-forward [pattern-name]",
+	Short: "Forward local ports to a component of a pattern",
+	Long: `Forward local ports to a running pod of a component of a saved pattern deployed in the current Kubernetes
+context, the connections being tunneled through Meshery Server, as kubectl port-forward does but without
+a kubeconfig for the cluster. The ports are given as [local:]remote, remote being a port of the Service
+of the component, or a container port for the other components, by number or name. A local port of 0 or
+left empty picks a free one. The pod is looked up again for every connection.`,
+	Example: `
+// Forward the local port 8080 to the port 80 of the api component of a pattern
+mesheryctl design port-forward bookinfo --component api --port 8080:80
+
+// Forward the local ports 5000 and 6000 to the same ports of the api component
+mesheryctl design port-forward bookinfo --component api --port 5000 --port 6000
+
+// Forward a free local port, on every interface, to the http port of the api component
+mesheryctl design port-forward bookinfo --component api --port :http --address 0.0.0.0
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mappings, err := parsePortMappings(forwardPorts)
+		if err != nil {
+			return err
+		}
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		client := &http.Client{}
+		pattern, err := fetchPatternByName(client, mctlCfg.GetBaseMesheryURL()+"/api/pattern", args)
+		if err != nil {
+			return err
+		}
+		forwardURL := fmt.Sprintf("%s/api/pattern/%s/port-forward", mctlCfg.GetBaseMesheryURL(), pattern.ID)
+
+		listeners := []net.Listener{}
+		defer func() {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+		}()
+		for _, m := range mappings {
+			params := url.Values{"component": []string{forwardComponent}, "port": []string{m.remote}}
+			target, err := portForwardTarget(client, forwardURL, params)
+			if err != nil {
+				return err
+			}
+			l, err := net.Listen("tcp", net.JoinHostPort(forwardAddress, strconv.Itoa(m.local)))
+			if err != nil {
+				return errors.Wrapf(err, "failed to listen on the local port %d", m.local)
+			}
+			listeners = append(listeners, l)
+			utils.Log.Info(fmt.Sprintf("Forwarding from %s -> %d (%s of %s, pod %s)", l.Addr(), target.Port, target.Object, target.Component, target.Pod))
+			go acceptForwarded(l, forwardURL+"?"+params.Encode())
+		}
+
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+		return nil
+	},
+}
+
+// parsePortMappings parses the ports given as [local:]remote
+func parsePortMappings(ports []string) ([]portMapping, error) {
+	if len(ports) == 0 {
+		return nil, errors.New("give the ports to forward with --port")
+	}
+	mappings := make([]portMapping, 0, len(ports))
+	for _, p := range ports {
+		local, remote := p, p
+		if i := strings.LastIndex(p, ":"); i >= 0 {
+			local, remote = p[:i], p[i+1:]
+		}
+		if remote == "" {
+			return nil, errors.Errorf("invalid port %q, expected [local:]remote", p)
+		}
+		m := portMapping{remote: remote}
+		if local != "" {
+			port, err := strconv.Atoi(local)
+			if err != nil || port < 0 || port > 65535 {
+				return nil, errors.Errorf("invalid local port %q, a named port can only be remote", local)
+			}
+			m.local = port
+		}
+		mappings = append(mappings, m)
+	}
+	return mappings, nil
+}
+
+// portForwardTarget returns the pod port Meshery Server forwards the connections to
+func portForwardTarget(client *http.Client, forwardURL string, params url.Values) (*models.DesignPortForward, error) {
+	resp, err := getDesignDebugInfo(client, forwardURL, params)
+	if err != nil {
+		return nil, err
+	}
+	defer utils.SafeClose(resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	target := &models.DesignPortForward{}
+	if err := json.Unmarshal(body, target); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the port forwarded to")
+	}
+	return target, nil
+}
+
+// acceptForwarded tunnels every connection accepted by the listener, until it is closed
+func acceptForwarded(l net.Listener, forwardURL string) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			if err := tunnel(conn, forwardURL); err != nil {
+				utils.Log.Error(errors.Wrapf(err, "failed to forward the connection from %s", conn.RemoteAddr()))
+			}
+		}()
+	}
+}
+
+// dialDesignWebSocket upgrades the request to the Meshery Server URL to a WebSocket
+func dialDesignWebSocket(reqURL string) (*websocket.Conn, error) {
+	req, err := utils.NewRequest(http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	wsURL := *req.URL
+	wsURL.Scheme = strings.Replace(wsURL.Scheme, "http", "ws", 1)
+	ws, resp, err := websocket.DefaultDialer.Dial(wsURL.String(), req.Header)
+	if err != nil {
+		if resp != nil {
+			defer utils.SafeClose(resp.Body)
+			body, _ := io.ReadAll(resp.Body)
+			return nil, errors.Errorf("server responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		}
+		return nil, err
+	}
+	return ws, nil
+}
+
+// tunnel exchanges the data of the connection with Meshery Server through a WebSocket, until either side closes
+func tunnel(conn net.Conn, forwardURL string) error {
+	defer func() {
+		_ = conn.Close()
+	}()
+	ws, err := dialDesignWebSocket(forwardURL)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	received := make(chan error, 1)
+	go func() {
+		for {
+			_, data, err := ws.ReadMessage()
+			if err != nil {
+				if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+					err = nil
+				} else if closeErr, ok := err.(*websocket.CloseError); ok {
+					err = errors.New(closeErr.Text)
+				}
+				received <- err
+				// Unblock the read of the connection
+				_ = conn.Close()
+				return
+			}
+			if _, err := conn.Write(data); err != nil {
+				received <- nil
+				return
+			}
+		}
+	}()
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := conn.Read(buf)
+		if n > 0 {
+			if werr := ws.WriteMessage(websocket.BinaryMessage, buf[:n]); werr != nil {
+				break
+			}
+		}
+		if err != nil {
+			_ = ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+			break
+		}
+	}
+	select {
+	case err := <-received:
+		return err
+	case <-time.After(5 * time.Second):
+		return nil
+	}
+}
+
+func init() {
+	portForwardCmd.Flags().StringVar(&forwardComponent, "component", "", "name of the component of the pattern to forward the ports to")
+	portForwardCmd.Flags().StringSliceVar(&forwardPorts, "port", []string{}, "port to forward as [local:]remote, can be given several times")
+	portForwardCmd.Flags().StringVar(&forwardAddress, "address", "localhost", "(optional) address to listen on")
+	_ = portForwardCmd.MarkFlagRequired("component")
+	_ = portForwardCmd.MarkFlagRequired("port")
+}
@@ -0,0 +1,97 @@
+package pattern
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestParsePortMappings(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Ports       []string
+		Expected    []portMapping
+		ExpectError bool
+	}{
+		{Name: "local and remote", Ports: []string{"8080:80"}, Expected: []portMapping{{local: 8080, remote: "80"}}},
+		{Name: "same port", Ports: []string{"5000", "6000"}, Expected: []portMapping{{local: 5000, remote: "5000"}, {local: 6000, remote: "6000"}}},
+		{Name: "free local port", Ports: []string{":http"}, Expected: []portMapping{{local: 0, remote: "http"}}},
+		{Name: "named local port", Ports: []string{"http"}, ExpectError: true},
+		{Name: "no remote port", Ports: []string{"8080:"}, ExpectError: true},
+		{Name: "no port", Ports: []string{}, ExpectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			mappings, err := parsePortMappings(tt.Ports)
+			if tt.ExpectError {
+				if err == nil {
+					t.Errorf("expected an error, got %v", mappings)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(mappings, tt.Expected) {
+				t.Errorf("expected %v, got %v", tt.Expected, mappings)
+			}
+		})
+	}
+}
+
+func TestTunnel(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "token.golden")
+
+	// Meshery Server echoing the data tunneled, as an echo server in the pod would
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("port") != "80" {
+			http.Error(rw, "unexpected port", http.StatusBadRequest)
+			return
+		}
+		ws, err := (&websocket.Upgrader{}).Upgrade(rw, r, nil)
+		if err != nil {
+			return
+		}
+		defer ws.Close()
+		for {
+			kind, data, err := ws.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := ws.WriteMessage(kind, data); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	local, remote := net.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		done <- tunnel(remote, server.URL+"/api/pattern/3817ec9a/port-forward?component=api&port=80")
+	}()
+
+	if _, err := local.Write([]byte("ping")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 4)
+	if _, err := local.Read(buf); err != nil {
+		t.Fatal(err)
+	}
+	utils.Equals(t, "ping", string(buf))
+	_ = local.Close()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
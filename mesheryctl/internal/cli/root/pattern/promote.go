@@ -0,0 +1,116 @@
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	promoteFrom    string
+	promoteTo      string
+	promoteHistory bool
+)
+
+var promoteCmd = &cobra.Command{
+	Use:   "promote <pattern-name>",
+	Short: "Promote a pattern from an environment to another",
+	Long: `Promote a saved pattern deployed to an environment, such as staging, to another one, such as prod.
+The pattern must not have changed since it was deployed to the environment it is promoted from, and must have
+the number of approved reviews required by the target environment. Only the approvals of the current revision of
+the pattern by reviewers other than you count. Every promotion is recorded along with the deployment it was
+promoted from and the reviewers who approved it.`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// Promote a pattern to prod from the environment prod promotes from
+mesheryctl design promote bookinfo --to prod
+
+// Promote a pattern from a given environment
+mesheryctl pattern promote bookinfo --from staging --to prod
+
+// List the deployments and promotions of a pattern
+mesheryctl pattern promote bookinfo --history
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if promoteTo == "" && !promoteHistory {
+			return errors.New("environment to promote the pattern to is required, use --to")
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		patternURL := mctlCfg.GetBaseMesheryURL() + "/api/pattern"
+
+		pattern, err := fetchPatternByName(&http.Client{}, patternURL, args)
+		if err != nil {
+			return err
+		}
+		path := patternURL + "/" + pattern.ID.String()
+
+		if promoteHistory {
+			deployments := []models.PatternDeployment{}
+			if err := doPatternRequest("GET", path+"/deployments", nil, &deployments); err != nil {
+				return err
+			}
+
+			var data [][]string
+			for _, deployment := range deployments {
+				promotedFrom := deployment.SourceEnvironment
+				if promotedFrom == "" {
+					promotedFrom = "-"
+				}
+				approvedBy := strings.ReplaceAll(deployment.ApprovedBy, ",", ", ")
+				if approvedBy == "" {
+					approvedBy = "-"
+				}
+				created := ""
+				if deployment.CreatedAt != nil {
+					created = deployment.CreatedAt.Format("2006-01-02 15:04:05")
+				}
+				data = append(data, []string{deployment.ID.String(), deployment.Environment, shortSHA(deployment.PatternSHA), deployment.DeployedBy, promotedFrom, approvedBy, created})
+			}
+			utils.PrintToTable([]string{"DEPLOYMENT ID", "ENVIRONMENT", "SHA", "DEPLOYED BY", "PROMOTED FROM", "APPROVED BY", "CREATED"}, data)
+			return nil
+		}
+
+		payload, err := json.Marshal(models.PatternPromotionRequest{From: promoteFrom, To: promoteTo})
+		if err != nil {
+			return err
+		}
+		resp := models.PatternDeploymentAPIResponse{}
+		if err := doPatternRequest("POST", path+"/promote", bytes.NewReader(payload), &resp); err != nil {
+			return err
+		}
+
+		deployment := resp.Deployment
+		utils.Log.Info(fmt.Sprintf("Pattern %s promoted from %s to %s, deployment %s", pattern.Name, deployment.SourceEnvironment, deployment.Environment, deployment.ID))
+		if deployment.ApprovedBy != "" {
+			utils.Log.Info("Approved by: ", strings.ReplaceAll(deployment.ApprovedBy, ",", ", "))
+		}
+		utils.Log.Info(resp.Message)
+		return nil
+	},
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 12 {
+		return sha[:12]
+	}
+	return sha
+}
+
+func init() {
+	promoteCmd.Flags().StringVar(&promoteTo, "to", "", "environment to promote the pattern to")
+	promoteCmd.Flags().StringVar(&promoteFrom, "from", "", "(optional) environment to promote the pattern from, defaults to the one the target environment promotes from")
+	promoteCmd.Flags().BoolVar(&promoteHistory, "history", false, "list the deployments and promotions of the pattern")
+}
@@ -0,0 +1,54 @@
+package pattern
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore [pattern-id]",
+	Short: "Restore a deleted pattern",
+	Long: `Restore a deleted pattern from the trash, or list the deleted patterns when no id is given.
+Deleted patterns are kept in the trash for the retention of Meshery Server, 30 days by default,
+before they are purged.`,
+	Args: cobra.MaximumNArgs(1),
+	Example: `
+// List the deleted patterns
+mesheryctl design restore
+
+// Restore a deleted pattern, its id being given in full or as listed
+mesheryctl design restore 3817ec9a
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		if len(args) == 0 {
+			trash, err := utils.GetTrash(mctlCfg.GetBaseMesheryURL(), models.OwnedPattern)
+			if err != nil {
+				return err
+			}
+			if len(trash.Items) == 0 {
+				utils.Log.Info("No deleted patterns to restore")
+				return nil
+			}
+			utils.PrintTrash(trash)
+			return nil
+		}
+
+		pattern, err := utils.RestoreFromTrash(mctlCfg.GetBaseMesheryURL(), models.OwnedPattern, args[0])
+		if err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("Pattern %s (%s) restored", pattern.Name, pattern.ID))
+		return nil
+	},
+}
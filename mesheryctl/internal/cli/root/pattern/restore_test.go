@@ -0,0 +1,64 @@
+package pattern
+
+import (
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestPatternRestore(t *testing.T) {
+	utils.SetupContextEnv(t)
+	utils.StartMockery(t)
+	defer utils.StopMockery(t)
+	testContext := utils.NewTestHelper(t)
+
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "token.golden")
+
+	id := uuid.FromStringOrNil("3817ec9a-1d83-4f6f-9154-0fd4408ba9f0")
+	other := uuid.FromStringOrNil("3817ffff-1d83-4f6f-9154-0fd4408ba9f0")
+	trash := models.TrashPage{Items: []*models.TrashItem{
+		{ID: &id, Kind: models.OwnedPattern, Name: "bookinfo", DeletedAt: time.Now()},
+		{ID: &other, Kind: models.OwnedPattern, Name: "httpbin", DeletedAt: time.Now()},
+	}}
+	httpmock.RegisterResponder("GET", testContext.BaseURL+"/api/user/trash?kind=pattern",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, trash)
+		})
+	restored := 0
+	httpmock.RegisterResponder("POST", testContext.BaseURL+"/api/pattern/"+id.String()+"/restore",
+		func(req *http.Request) (*http.Response, error) {
+			restored++
+			return httpmock.NewJsonResponse(200, trash.Items[0])
+		})
+
+	b := utils.SetupMeshkitLoggerTesting(t, false)
+	PatternCmd.SetOutput(b)
+
+	// the id prefix is shared by both patterns of the trash
+	PatternCmd.SetArgs([]string{"restore", "3817"})
+	if err := PatternCmd.Execute(); err == nil {
+		t.Error("expected an error restoring a pattern of an ambiguous id")
+	}
+
+	PatternCmd.SetArgs([]string{"restore", "3817ec9a"})
+	if err := PatternCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	utils.Equals(t, 1, restored)
+
+	PatternCmd.SetArgs([]string{"restore", "00000000"})
+	if err := PatternCmd.Execute(); err == nil {
+		t.Error("expected an error restoring a pattern which is not in the trash")
+	}
+}
@@ -0,0 +1,152 @@
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	reviewer             string
+	reviewApprove        string
+	reviewRequestChanges string
+	reviewRerequest      string
+	reviewComment        string
+)
+
+var reviewCmd = &cobra.Command{
+	Use:   "review <pattern-id>",
+	Short: "Request and submit reviews on a pattern",
+	Long: `Request a review on a pattern, approve it or request changes on it, or list its reviews. Only the reviewer
+approves a pattern or requests changes on it, and only the user who requested the review re-requests it.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Request a review on a pattern
+mesheryctl pattern review 8a2d5b6c-0000-4000-8000-000000000000 --reviewer jane
+
+// Approve a pattern
+mesheryctl pattern review 8a2d5b6c-0000-4000-8000-000000000000 --approve <review-id>
+
+// Request changes on a pattern
+mesheryctl pattern review 8a2d5b6c-0000-4000-8000-000000000000 --request-changes <review-id> -m "pin the istio version"
+
+// List the reviews of a pattern along with its overall status
+mesheryctl pattern review 8a2d5b6c-0000-4000-8000-000000000000
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		path := mctlCfg.GetBaseMesheryURL() + "/api/pattern/" + args[0] + "/review"
+
+		review := models.PatternReview{Comment: reviewComment}
+		reviewID := ""
+		actions := 0
+		for _, action := range []struct {
+			id     string
+			status models.PatternReviewStatus
+		}{
+			{reviewApprove, models.PatternReviewApproved},
+			{reviewRequestChanges, models.PatternReviewChangesRequested},
+			{reviewRerequest, models.PatternReviewPending},
+		} {
+			if action.id != "" {
+				reviewID = action.id
+				review.Status = action.status
+				actions++
+			}
+		}
+		if reviewer != "" {
+			actions++
+		}
+		if actions > 1 {
+			return errors.New("only one of --reviewer, --approve, --request-changes and --rerequest can be used at a time")
+		}
+
+		switch {
+		case reviewer != "":
+			review.Reviewer = reviewer
+			payload, err := json.Marshal(review)
+			if err != nil {
+				return err
+			}
+			if err = doPatternRequest("POST", path, bytes.NewReader(payload), &review); err != nil {
+				return err
+			}
+			utils.Log.Info(fmt.Sprintf("Review %s requested from %s", review.ID, review.Reviewer))
+		case reviewID != "":
+			payload, err := json.Marshal(review)
+			if err != nil {
+				return err
+			}
+			if err = doPatternRequest("PUT", path+"/"+reviewID, bytes.NewReader(payload), &review); err != nil {
+				return err
+			}
+			utils.Log.Info(fmt.Sprintf("Review %s is now %s", reviewID, review.Status))
+		default:
+			reviews := models.PatternReviewsAPIResponse{}
+			if err = doPatternRequest("GET", path, nil, &reviews); err != nil {
+				return err
+			}
+
+			var data [][]string
+			for _, review := range reviews.Reviews {
+				data = append(data, []string{review.ID.String(), review.Reviewer, string(review.Status), review.Comment})
+			}
+			utils.PrintToTable([]string{"REVIEW ID", "REVIEWER", "STATUS", "COMMENT"}, data)
+			if reviews.Status != "" {
+				utils.Log.Info("Pattern review status: ", reviews.Status)
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	reviewCmd.Flags().StringVar(&reviewer, "reviewer", "", "request a review from the given user")
+	reviewCmd.Flags().StringVar(&reviewApprove, "approve", "", "approve the pattern on the review with the given id")
+	reviewCmd.Flags().StringVar(&reviewRequestChanges, "request-changes", "", "request changes on the pattern on the review with the given id")
+	reviewCmd.Flags().StringVar(&reviewRerequest, "rerequest", "", "re-request the review with the given id once changes are made")
+	reviewCmd.Flags().StringVarP(&reviewComment, "message", "m", "", "(optional) comment for the review")
+}
+
+// doPatternRequest sends a request to the patterns API of Meshery Server
+// and decodes the response into the given value, if any
+func doPatternRequest(method, path string, body io.Reader, out interface{}) error {
+	req, err := utils.NewRequest(method, path, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("Response Status Code %d: %s", res.StatusCode, string(data))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
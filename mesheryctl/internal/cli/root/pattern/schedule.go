@@ -0,0 +1,182 @@
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	scheduleAt   string
+	scheduleCron string
+)
+
+// scheduleTimeLayouts are the layouts accepted for --at, RFC 3339 with or without seconds
+var scheduleTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04Z07:00"}
+
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Manage the scheduled deployments and undeployments of patterns",
+	Long: `Manage the deployments and undeployments of saved patterns scheduled with the --at or --cron flags of apply and undeploy.
+Meshery Server runs the scheduled operations against the Kubernetes context which was current when they were scheduled,
+publishing an event with the outcome of every run.`,
+	Example: `
+// Deploy a pattern on the 1st of July at 2:00 UTC
+mesheryctl design apply bookinfo --at 2024-07-01T02:00Z
+
+// Undeploy an ephemeral environment every weekday at 20:00 UTC
+mesheryctl design undeploy preview --cron "0 20 * * 1-5"
+
+// List the scheduled operations
+mesheryctl pattern schedule list
+
+// Cancel a scheduled operation
+mesheryctl pattern schedule delete <schedule-id>
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+	},
+}
+
+var scheduleListCmd = &cobra.Command{
+	Use:   "list [pattern-name]",
+	Short: "List the scheduled operations, of the given pattern only if any",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		scheduleURL := mctlCfg.GetBaseMesheryURL() + "/api/patterns/schedules"
+		if len(args) > 0 {
+			pattern, err := fetchPatternByName(&http.Client{}, mctlCfg.GetBaseMesheryURL()+"/api/pattern", args)
+			if err != nil {
+				return err
+			}
+			scheduleURL = mctlCfg.GetBaseMesheryURL() + "/api/pattern/" + pattern.ID.String() + "/schedules"
+		}
+
+		schedules := []models.DesignSchedule{}
+		if err := doPatternRequest("GET", scheduleURL, nil, &schedules); err != nil {
+			return err
+		}
+		if len(schedules) == 0 {
+			utils.Log.Info("No scheduled operations")
+			return nil
+		}
+		utils.PrintToTable([]string{"ID", "PATTERN", "OPERATION", "WHEN", "CONTEXT", "NEXT RUN", "LAST RUN"}, scheduleRows(schedules))
+		return nil
+	},
+}
+
+var scheduleDeleteCmd = &cobra.Command{
+	Use:   "delete <schedule-id>",
+	Short: "Cancel a scheduled operation",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		if err := doPatternRequest("DELETE", mctlCfg.GetBaseMesheryURL()+"/api/patterns/schedules/"+args[0], nil, nil); err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("Schedule %s deleted", args[0]))
+		return nil
+	},
+}
+
+// schedulingRequested tells if apply or undeploy were asked to schedule the operation rather than run it
+func schedulingRequested() bool {
+	return scheduleAt != "" || scheduleCron != ""
+}
+
+// newDesignSchedule returns the schedule of the operation described by the --at or --cron flag
+func newDesignSchedule(operation models.DesignScheduleOperation) (*models.DesignSchedule, error) {
+	if scheduleAt != "" && scheduleCron != "" {
+		return nil, errors.New("use either --at or --cron")
+	}
+	schedule := &models.DesignSchedule{Operation: operation, Cron: scheduleCron}
+	if scheduleAt != "" {
+		for _, layout := range scheduleTimeLayouts {
+			if at, err := time.Parse(layout, scheduleAt); err == nil {
+				schedule.RunAt = &at
+				break
+			}
+		}
+		if schedule.RunAt == nil {
+			return nil, errors.Errorf("invalid time %q for --at, expected RFC 3339 such as 2024-07-01T02:00Z", scheduleAt)
+		}
+	}
+	return schedule, nil
+}
+
+// schedulePattern schedules the operation on the saved pattern of the given name
+func schedulePattern(baseURL string, args []string, schedule *models.DesignSchedule) error {
+	if len(args) == 0 {
+		return errors.New("--at and --cron schedule a saved pattern, provide its name")
+	}
+	pattern, err := fetchPatternByName(&http.Client{}, baseURL+"/api/pattern", args)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(schedule)
+	if err != nil {
+		return err
+	}
+	created := &models.DesignSchedule{}
+	if err := doPatternRequest("POST", baseURL+"/api/pattern/"+pattern.ID.String()+"/schedules", bytes.NewReader(body), created); err != nil {
+		return err
+	}
+	utils.Log.Info(fmt.Sprintf("Scheduled %s of pattern %s to context %s %s, next run at %s (schedule %s)",
+		created.Operation, created.PatternName, created.K8sContextName, scheduleWhen(created), formatScheduleTime(created.NextRunAt), created.ID))
+	return nil
+}
+
+// scheduleRows returns the rows of the table of the schedules
+func scheduleRows(schedules []models.DesignSchedule) [][]string {
+	var rows [][]string
+	for _, schedule := range schedules {
+		lastRun := "-"
+		if schedule.LastRunAt != nil {
+			lastRun = fmt.Sprintf("%s (%s)", formatScheduleTime(schedule.LastRunAt), schedule.LastStatus)
+		}
+		id := ""
+		if schedule.ID != nil {
+			id = schedule.ID.String()
+		}
+		rows = append(rows, []string{id, schedule.PatternName, string(schedule.Operation), scheduleWhen(&schedule),
+			schedule.K8sContextName, formatScheduleTime(schedule.NextRunAt), lastRun})
+	}
+	return rows
+}
+
+func scheduleWhen(schedule *models.DesignSchedule) string {
+	if schedule.Cron != "" {
+		return fmt.Sprintf("cron %q", schedule.Cron)
+	}
+	return "at " + formatScheduleTime(schedule.RunAt)
+}
+
+func formatScheduleTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.UTC().Format("2006-01-02 15:04 MST")
+}
+
+func init() {
+	scheduleCmd.AddCommand(scheduleListCmd, scheduleDeleteCmd)
+	scheduleListCmd.ValidArgsFunction = utils.CompleteNames(patternNames)
+}
@@ -0,0 +1,71 @@
+package pattern
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestNewDesignSchedule(t *testing.T) {
+	defer func() { scheduleAt, scheduleCron = "", "" }()
+
+	tests := []struct {
+		name    string
+		at      string
+		cron    string
+		runAt   string
+		wantErr bool
+	}{
+		{name: "time without seconds", at: "2024-07-01T02:00Z", runAt: "2024-07-01T02:00:00Z"},
+		{name: "time with seconds and offset", at: "2024-07-01T04:00:30+02:00", runAt: "2024-07-01T02:00:30Z"},
+		{name: "cron expression", cron: "0 20 * * 1-5"},
+		{name: "invalid time", at: "tomorrow", wantErr: true},
+		{name: "both time and cron", at: "2024-07-01T02:00Z", cron: "0 20 * * *", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduleAt, scheduleCron = tt.at, tt.cron
+			schedule, err := newDesignSchedule(models.DesignScheduleDeploy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if schedule.Operation != models.DesignScheduleDeploy || schedule.Cron != tt.cron {
+				t.Errorf("unexpected schedule %+v", schedule)
+			}
+			if tt.runAt == "" {
+				if schedule.RunAt != nil {
+					t.Errorf("run at = %v, want none", schedule.RunAt)
+				}
+				return
+			}
+			if schedule.RunAt == nil || schedule.RunAt.UTC().Format(time.RFC3339) != tt.runAt {
+				t.Errorf("run at = %v, want %s", schedule.RunAt, tt.runAt)
+			}
+		})
+	}
+}
+
+func TestScheduleRows(t *testing.T) {
+	id := uuid.Must(uuid.FromString("8f3daf25-e58e-4c59-8bf8-f474b76463ec"))
+	runAt := time.Date(2024, 7, 1, 2, 0, 0, 0, time.UTC)
+	lastRun := time.Date(2024, 6, 28, 20, 0, 0, 0, time.UTC)
+	nextRun := time.Date(2024, 7, 1, 20, 0, 0, 0, time.UTC)
+	schedules := []models.DesignSchedule{
+		{ID: &id, PatternName: "bookinfo", Operation: models.DesignScheduleDeploy, RunAt: &runAt, NextRunAt: &runAt, K8sContextName: "staging"},
+		{ID: &id, PatternName: "preview", Operation: models.DesignScheduleUndeploy, Cron: "0 20 * * 1-5", K8sContextName: "dev",
+			NextRunAt: &nextRun, LastRunAt: &lastRun, LastStatus: models.DesignScheduleFailed},
+	}
+	want := [][]string{
+		{id.String(), "bookinfo", "deploy", "at 2024-07-01 02:00 UTC", "staging", "2024-07-01 02:00 UTC", "-"},
+		{id.String(), "preview", "undeploy", `cron "0 20 * * 1-5"`, "dev", "2024-07-01 20:00 UTC", "2024-06-28 20:00 UTC (failed)"},
+	}
+	if got := scheduleRows(schedules); !reflect.DeepEqual(got, want) {
+		t.Errorf("got rows %v, want %v", got, want)
+	}
+}
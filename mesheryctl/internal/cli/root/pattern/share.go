@@ -0,0 +1,87 @@
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	shareUser       string
+	shareTeam       string
+	sharePermission string
+)
+
+var shareCmd = &cobra.Command{
+	Use:   "share <pattern-id>",
+	Short: "Share a pattern with a user or a team",
+	Long:  `Share a pattern with a user or a team, or list the users and teams it is shared with`,
+	Args:  cobra.ExactArgs(1),
+	Example: `
+// Share a pattern with a user, allowing them to edit it
+mesheryctl pattern share 8a2d5b6c-0000-4000-8000-000000000000 --user jane --permission edit
+
+// Share a pattern with a team
+mesheryctl pattern share 8a2d5b6c-0000-4000-8000-000000000000 --team platform
+
+// List the users and teams a pattern is shared with
+mesheryctl pattern share 8a2d5b6c-0000-4000-8000-000000000000
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		path := mctlCfg.GetBaseMesheryURL() + "/api/pattern/" + args[0] + "/share"
+
+		if shareUser == "" && shareTeam == "" {
+			shares := []models.PatternShare{}
+			if err = doPatternRequest("GET", path, nil, &shares); err != nil {
+				return err
+			}
+
+			var data [][]string
+			for _, share := range shares {
+				data = append(data, []string{string(share.Kind), share.SharedWith, share.Permission, share.SharedBy})
+			}
+			utils.PrintToTable([]string{"KIND", "SHARED WITH", "PERMISSION", "SHARED BY"}, data)
+			return nil
+		}
+		if shareUser != "" && shareTeam != "" {
+			return errors.New("--user and --team cannot be used together")
+		}
+
+		share := models.PatternShare{
+			Kind:       models.PatternShareUser,
+			SharedWith: shareUser,
+			Permission: sharePermission,
+		}
+		if shareTeam != "" {
+			share.Kind = models.PatternShareTeam
+			share.SharedWith = shareTeam
+		}
+
+		payload, err := json.Marshal(share)
+		if err != nil {
+			return err
+		}
+		if err = doPatternRequest("POST", path, bytes.NewReader(payload), &share); err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("Pattern shared with %s %s (%s)", share.Kind, share.SharedWith, share.Permission))
+		return nil
+	},
+}
+
+func init() {
+	shareCmd.Flags().StringVar(&shareUser, "user", "", "user to share the pattern with")
+	shareCmd.Flags().StringVar(&shareTeam, "team", "", "team to share the pattern with")
+	shareCmd.Flags().StringVar(&sharePermission, "permission", "view", "permission granted on the pattern, either view or edit")
+}
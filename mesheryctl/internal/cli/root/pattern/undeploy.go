@@ -0,0 +1,117 @@
+package pattern
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var keepNamespace bool
+
+var undeployCmd = &cobra.Command{
+	Use:   "undeploy",
+	Short: "Undeploy pattern",
+	Long:  `Undeploy the components of a pattern which Meshery recorded as deployed`,
+	Example: `
+	// undeploy a saved pattern
+	mesheryctl pattern undeploy <pattern-name>
+
+	// undeploy a pattern file, leaving its namespaces in place
+	mesheryctl pattern undeploy -f <file> --keep-namespace
+
+	// undeploy only the frontend component of a saved pattern
+	mesheryctl pattern undeploy <pattern-name> --components frontend
+
+	// undeploy a saved pattern from the current context every day at 20:00 UTC
+	mesheryctl pattern undeploy <pattern-name> --cron "0 20 * * *"
+	`,
+	Args: cobra.MinimumNArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		if schedulingRequested() {
+			if len(components) > 0 {
+				return errors.New("--components cannot be used with --at or --cron, schedules undeploy the whole pattern")
+			}
+			schedule, err := newDesignSchedule(models.DesignScheduleUndeploy)
+			if err != nil {
+				return err
+			}
+			schedule.KeepNamespace = keepNamespace
+			return schedulePattern(mctlCfg.GetBaseMesheryURL(), args, schedule)
+		}
+
+		if len(args) == 0 && file == "" {
+			return errors.New("provide a pattern name or a pattern file with -f")
+		}
+
+		client := &http.Client{}
+
+		var content []byte
+		if len(args) > 0 {
+			patternFile, err := fetchPatternFileByName(client, mctlCfg.GetBaseMesheryURL()+"/api/pattern", args)
+			if err != nil {
+				return err
+			}
+			content = []byte(patternFile)
+		} else {
+			content, err = os.ReadFile(file)
+			if err != nil {
+				return errors.New(utils.SystemError(fmt.Sprintf("failed to read file %s", file)))
+			}
+		}
+
+		params := url.Values{}
+		params.Set("recordedOnly", "true")
+		if keepNamespace {
+			params.Set("keepNamespace", "true")
+		}
+		if len(components) > 0 {
+			params.Set("components", strings.Join(components, ","))
+		}
+
+		req, err := utils.NewRequest("DELETE", mctlCfg.GetBaseMesheryURL()+"/api/pattern/deploy?"+params.Encode(), bytes.NewBuffer(content))
+		if err != nil {
+			return err
+		}
+
+		res, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return err
+		}
+
+		if res.StatusCode == http.StatusOK {
+			utils.Log.Info("pattern successfully undeployed")
+		}
+		utils.Log.Info(string(body))
+		return nil
+	},
+}
+
+func init() {
+	undeployCmd.Flags().StringVarP(&file, "file", "f", "", "Path to pattern file")
+	undeployCmd.Flags().BoolVar(&keepNamespace, "keep-namespace", false, "(optional) leave the namespaces of the pattern in place")
+	undeployCmd.Flags().StringSliceVar(&components, "components", []string{}, "(optional) undeploy only the given components of the pattern, the deployed components depending on them must be given too")
+	undeployCmd.Flags().StringVar(&scheduleAt, "at", "", "(optional) schedule the undeployment of the saved pattern at the given time, in RFC 3339 format")
+	undeployCmd.Flags().StringVar(&scheduleCron, "cron", "", "(optional) schedule the undeployment of the saved pattern recurringly, following the given cron expression in UTC")
+}
@@ -0,0 +1,112 @@
+package pattern
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models/pattern/core"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	upgradeApply  bool
+	upgradeDryRun bool
+)
+
+var upgradeCheckCmd = &cobra.Command{
+	Use:   "upgrade-check <pattern-name>",
+	Short: "Check a pattern for deprecated component fields",
+	Long: `Report the fields of the components of a saved pattern deprecated by newer versions of their models,
+according to the migration rules registered with Meshery Server by Meshery and the adapters.
+
+With --apply, the fields which the rules can rewrite, such as renamed fields, are rewritten and the pattern
+is saved. The other fields are reported along with the change to make by hand.`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// Report the deprecated fields used by a pattern
+mesheryctl design upgrade-check bookinfo
+
+// Print the pattern with the deprecated fields rewritten, without saving it
+mesheryctl pattern upgrade-check bookinfo --apply --dry-run
+
+// Rewrite the deprecated fields and save the pattern
+mesheryctl pattern upgrade-check bookinfo --apply
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if upgradeDryRun && !upgradeApply {
+			return errors.New("--dry-run requires --apply")
+		}
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		patternURL := mctlCfg.GetBaseMesheryURL() + "/api/pattern"
+
+		pattern, err := fetchPatternByName(&http.Client{}, patternURL, args)
+		if err != nil {
+			return err
+		}
+		method := "GET"
+		if upgradeApply && !upgradeDryRun {
+			method = "POST"
+		}
+		check := &core.PatternUpgradeCheck{}
+		if err := doPatternRequest(method, patternURL+"/"+pattern.ID.String()+"/upgrade", nil, check); err != nil {
+			return err
+		}
+
+		if len(check.Findings) == 0 {
+			utils.Log.Info(fmt.Sprintf("Pattern %s uses no deprecated field", check.Name))
+			return nil
+		}
+		utils.PrintToTable([]string{"COMPONENT", "TYPE", "FIELD", "DEPRECATED IN", "UPGRADE"}, upgradeFindingRows(check.Findings))
+
+		rewritable := 0
+		for _, finding := range check.Findings {
+			if finding.Rewritable {
+				rewritable++
+			}
+		}
+		switch {
+		case upgradeDryRun:
+			utils.Log.Info(check.PatternFile)
+		case check.Saved:
+			utils.Log.Info(fmt.Sprintf("Pattern %s saved with %d of the %d deprecated fields rewritten", check.Name, rewritable, len(check.Findings)))
+		default:
+			utils.Log.Info(fmt.Sprintf("%d of the %d deprecated fields of pattern %s can be rewritten with --apply", rewritable, len(check.Findings), check.Name))
+		}
+		return nil
+	},
+}
+
+// upgradeFindingRows returns the rows of the table of the deprecated fields, with how to upgrade them
+func upgradeFindingRows(findings []core.UpgradeFinding) [][]string {
+	var rows [][]string
+	for _, finding := range findings {
+		deprecatedIn := finding.Model
+		if finding.Version != "" {
+			deprecatedIn += " " + finding.Version
+		}
+		upgrade := finding.Message
+		switch {
+		case finding.Rewritable && finding.Action == core.MigrationRemove:
+			upgrade = "remove"
+		case finding.Rewritable:
+			upgrade = "rename to " + finding.To
+		case finding.Reason != "" && finding.Action == core.MigrationRename:
+			upgrade = fmt.Sprintf("rename to %s by hand, %s", finding.To, finding.Reason)
+		}
+		rows = append(rows, []string{finding.Component, finding.Type, finding.Field, deprecatedIn, upgrade})
+	}
+	return rows
+}
+
+func init() {
+	upgradeCheckCmd.ValidArgsFunction = utils.CompleteNames(patternNames)
+	upgradeCheckCmd.Flags().BoolVar(&upgradeApply, "apply", false, "(optional) rewrite the deprecated fields the migration rules can rewrite and save the pattern")
+	upgradeCheckCmd.Flags().BoolVar(&upgradeDryRun, "dry-run", false, "(optional) with --apply, print the rewritten pattern without saving it")
+}
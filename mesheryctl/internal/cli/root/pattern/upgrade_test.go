@@ -0,0 +1,30 @@
+package pattern
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/models/pattern/core"
+)
+
+func TestUpgradeFindingRows(t *testing.T) {
+	findings := []core.UpgradeFinding{
+		{Component: "api", Type: "Deployment", Model: "kubernetes", Version: "1.0", Field: "spec.template.spec.serviceAccount",
+			Action: core.MigrationRename, To: "spec.template.spec.serviceAccountName", Rewritable: true},
+		{Component: "web", Type: "Ingress", Model: "kubernetes", Version: "1.19", Field: "spec.rules[0].http.paths[0].backend.serviceName",
+			Action: core.MigrationRename, To: "spec.rules[0].http.paths[0].backend.service.name", Reason: "spec.rules[0].http.paths[0].backend.service.name is already set"},
+		{Component: "reviews", Type: "VirtualService", Model: "istio", Version: "1.9", Field: "spec.http[0].mirror_percent",
+			Action: core.MigrationReport, Message: "mirror_percent was replaced by mirrorPercentage", Reason: "requires a manual change"},
+		{Component: "filter", Type: "EnvoyFilter", Model: "istio", Field: "spec.legacy", Action: core.MigrationRemove, Rewritable: true},
+	}
+	want := [][]string{
+		{"api", "Deployment", "spec.template.spec.serviceAccount", "kubernetes 1.0", "rename to spec.template.spec.serviceAccountName"},
+		{"web", "Ingress", "spec.rules[0].http.paths[0].backend.serviceName", "kubernetes 1.19",
+			"rename to spec.rules[0].http.paths[0].backend.service.name by hand, spec.rules[0].http.paths[0].backend.service.name is already set"},
+		{"reviews", "VirtualService", "spec.http[0].mirror_percent", "istio 1.9", "mirror_percent was replaced by mirrorPercentage"},
+		{"filter", "EnvoyFilter", "spec.legacy", "istio", "remove"},
+	}
+	if got := upgradeFindingRows(findings); !reflect.DeepEqual(got, want) {
+		t.Errorf("got rows %v, want %v", got, want)
+	}
+}
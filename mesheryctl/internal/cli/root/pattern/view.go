@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/ghodss/yaml"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
@@ -16,15 +19,30 @@ import (
 )
 
 var (
-	viewAllFlag   bool
-	outFormatFlag string
+	viewAllFlag        bool
+	outFormatFlag      string
+	redactFlag         bool
+	redactionRulesFlag string
+	imageFlag          bool
 )
 
 var viewCmd = &cobra.Command{
 	Use:   "view <pattern name>",
 	Short: "Display pattern(s)",
-	Long:  `Displays the contents of a specific pattern based on name or id`,
-	Args:  cobra.MaximumNArgs(1),
+	Long: `Displays the contents of a specific pattern based on name or id.
+With --image, the topology of the pattern is rendered by Meshery Server to an SVG or PNG image,
+depending on the extension of the file given with -o, <pattern name>.svg by default.`,
+	Example: `
+// View a pattern
+mesheryctl pattern view bookInfo
+
+// Render the topology of a pattern to an SVG image, for embedding in docs and pull requests
+mesheryctl design view bookInfo --image -o design.svg
+
+// Render the topology of a pattern to a PNG image
+mesheryctl design view bookInfo --image -o design.png
+	`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
 		if err != nil {
@@ -44,6 +62,9 @@ var viewCmd = &cobra.Command{
 				return err
 			}
 		}
+		if imageFlag && len(pattern) == 0 {
+			return errors.New("[pattern-name|pattern-id] not specified, --image renders a single pattern")
+		}
 		url := mctlCfg.GetBaseMesheryURL()
 		if len(pattern) == 0 {
 			if viewAllFlag {
@@ -85,6 +106,24 @@ var viewCmd = &cobra.Command{
 			return errors.Wrap(err, "failed to unmarshal response body")
 		}
 
+		if imageFlag {
+			if !isID {
+				arr, _ := dat["patterns"].([]interface{})
+				if len(arr) == 0 {
+					utils.Log.Info(fmt.Sprintf("pattern with name: %s not found", pattern))
+					return nil
+				}
+				dat, _ = arr[0].(map[string]interface{})
+			}
+			id, _ := dat["id"].(string)
+			name, _ := dat["name"].(string)
+			file := name + ".svg"
+			if cmd.Flags().Changed("output-format") {
+				file = outFormatFlag
+			}
+			return writePatternImage(mctlCfg.GetBaseMesheryURL(), id, file)
+		}
+
 		if isID {
 			if body, err = json.MarshalIndent(dat, "", "  "); err != nil {
 				return err
@@ -106,6 +145,16 @@ var viewCmd = &cobra.Command{
 			}
 		}
 
+		if redactFlag {
+			redactor, err := utils.NewRedactorFromFile(redactionRulesFlag)
+			if err != nil {
+				return err
+			}
+			if body, err = redactor.RedactJSON(body); err != nil {
+				return err
+			}
+		}
+
 		if outFormatFlag == "yaml" {
 			if body, err = yaml.JSONToYAML(body); err != nil {
 				return errors.Wrap(err, "failed to convert json to yaml")
@@ -118,7 +167,44 @@ var viewCmd = &cobra.Command{
 	},
 }
 
+// writePatternImage writes the image of the topology of the pattern rendered by Meshery Server
+// to the file, in the format of its extension
+func writePatternImage(baseURL, id, file string) error {
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(file)), ".")
+	if format != "svg" && format != "png" {
+		return errors.Errorf("image file %s is neither a .svg nor a .png file", file)
+	}
+
+	req, err := utils.NewRequest("GET", baseURL+"/api/pattern/"+id+"/snapshot?format="+format, nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("Response Status Code %d, unable to render the pattern: %s", res.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if err := os.WriteFile(file, body, 0644); err != nil {
+		return errors.Wrap(err, "failed to write the image of the pattern")
+	}
+	utils.Log.Info(fmt.Sprintf("Image of the pattern written to %s", file))
+	return nil
+}
+
 func init() {
+	viewCmd.ValidArgsFunction = utils.CompleteNames(patternNames)
 	viewCmd.Flags().BoolVarP(&viewAllFlag, "all", "a", false, "(optional) view all patterns available")
-	viewCmd.Flags().StringVarP(&outFormatFlag, "output-format", "o", "yaml", "(optional) format to display in [json|yaml]")
+	viewCmd.Flags().StringVarP(&outFormatFlag, "output-format", "o", "yaml", "(optional) format to display in [json|yaml], or the .svg or .png file to write the image to with --image")
+	utils.SetOutputFormats(viewCmd, "json", "yaml")
+	viewCmd.Flags().BoolVarP(&redactFlag, "redact", "", false, "(optional) strip secrets, tokens, IP addresses and internal hostnames before sharing")
+	viewCmd.Flags().StringVarP(&redactionRulesFlag, "redaction-rules", "", "", "(optional) file of redaction rules extending the defaults")
+	viewCmd.Flags().BoolVarP(&imageFlag, "image", "", false, "(optional) render the topology of the pattern to an SVG or PNG image")
 }
@@ -1,6 +1,8 @@
 package pattern
 
 import (
+	"net/http"
+	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
@@ -101,3 +103,48 @@ func TestPatternView(t *testing.T) {
 	// stop mock server
 	utils.StopMockery(t)
 }
+
+func TestPatternViewImage(t *testing.T) {
+	utils.SetupContextEnv(t)
+	utils.StartMockery(t)
+	defer utils.StopMockery(t)
+	testContext := utils.NewTestHelper(t)
+
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	fixturesDir := filepath.Join(filepath.Dir(filename), "fixtures")
+	utils.TokenFlag = filepath.Join(fixturesDir, "token.golden")
+
+	apiResponse := utils.NewGoldenFile(t, "view.pattern.api.response.golden", fixturesDir).Load()
+	httpmock.RegisterResponder("GET", testContext.BaseURL+"/api/pattern",
+		httpmock.NewStringResponder(200, apiResponse))
+	httpmock.RegisterResponder("GET", testContext.BaseURL+"/api/pattern/3817ec9a-1d83-4f6f-9154-0fd4408ba9f0/snapshot",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(200, "<svg format=\""+req.URL.Query().Get("format")+"\"/>"), nil
+		})
+	defer func() {
+		imageFlag = false
+		outFormatFlag = "yaml"
+		viewCmd.Flags().Lookup("output-format").Changed = false
+	}()
+
+	file := filepath.Join(t.TempDir(), "design.svg")
+	b := utils.SetupMeshkitLoggerTesting(t, false)
+	PatternCmd.SetOutput(b)
+	PatternCmd.SetArgs([]string{"view", "design", "--image", "-o", file})
+	if err := PatternCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	utils.Equals(t, `<svg format="svg"/>`, string(content))
+
+	PatternCmd.SetArgs([]string{"view", "design", "--image", "-o", filepath.Join(t.TempDir(), "design.gif")})
+	if err := PatternCmd.Execute(); err == nil {
+		t.Error("expected an error rendering the pattern to a gif image")
+	}
+}
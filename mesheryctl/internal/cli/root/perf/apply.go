@@ -3,14 +3,19 @@ package perf
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/ghodss/yaml"
+	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
 	"github.com/layer5io/meshery/models"
@@ -32,25 +37,57 @@ var (
 	testDuration       string
 	loadGenerator      string
 	filePath           string
+	fromResultID       string
+	bodyTemplateFile   string
+	dataFile           string
+	wsRampUp           string
+	wsMessageRate      string
+	wsMessageSize      string
+	clientIndex        int
+	smpTestConfig      *models.PerformanceTestConfigFile
 	profileID          string
 	req                *http.Request
+	grafanaSnapshot    bool
+	grafanaBoards      []string
+	adaptive           bool
+	targetP99          string
+	unixSocket         string
+	requests           []string
+	testRequestMix     models.RequestMix
+	testMethod         string
+	templateName       string
+	checkpoint         string
+	expectStatus       []int
+	skipProbe          bool
+	assertStatus       []int
+	assertBodyContains []string
+	assertJSONPath     []string
+	assertMaxSize      int64
+	testAssertions     *models.LoadTestAssertions
 )
 
 var applyCmd = &cobra.Command{
 	Use:   "apply [profile-name | --file] --flags",
 	Short: "Run a Performance test",
-	Long:  `Run Performance test using existing profiles or using flags`,
-	Args:  cobra.MinimumNArgs(0),
+	Long: `Run Performance test using existing profiles or using flags.
+Before the test is launched, the number of requests, the data transferred and the duration of the test are
+estimated from its queries per second, connections and duration. A test against what appears to be a
+production host, the URL giving no port and its host being a public DNS name, only runs with --yes.`,
+	Args: cobra.MinimumNArgs(0),
 	Example: `
 // Execute a Performance test with the specified performance profile
 mesheryctl perf apply meshery-profile --flags
 
-// Execute a Performance test with creating a new performance profile
-mesheryctl perf apply meshery-profile-new --url "https://google.com"
+// Execute a Performance test with creating a new performance profile, --yes confirming the test of a production host
+mesheryctl perf apply meshery-profile-new --url "https://google.com" --yes
 
 // Run Performance test using SMP compatible test configuration
 mesheryctl perf apply -f perf-config.yaml
 
+// Run performance test using SMP compatible test configuration from a URL or the standard input
+mesheryctl perf apply -f https://example.com/perf-config.yaml
+cat perf-config.yaml | mesheryctl perf apply -f -
+
 // Run performance test using SMP compatible test configuration and override values with flags
 mesheryctl perf apply -f <filepath> --flags
 
@@ -62,6 +99,29 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --qps 30
 
 // Execute a Performance test with specified service mesh
 mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh istio
+
+// Send a distinct body with every request, rendered from a template using the rows of a CSV file in turn
+// e.g. {"id": {{.Seq}}, "order": "{{uuid}}", "email": "{{.Row.email}}"}
+mesheryctl perf apply local-perf --url https://192.168.1.15/orders --body-template order.tmpl --data-file customers.csv
+
+// Execute a WebSocket test opening 100 connections over 10s, each sending 5 messages of 256 bytes per second
+mesheryctl perf apply ws-perf --url wss://192.168.1.15/chat --concurrent-requests 100 --ws-ramp-up 10s --ws-message-rate 5 --ws-message-size 256
+
+// Capture snapshots of the Grafana boards selected in the settings, or of the given boards, over the run
+mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --grafana-snapshot
+mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --grafana-snapshot --grafana-board istio-mesh,istio-workload
+
+// Send 70% of the requests to GET /products, 20% to GET /product/<sequence number> and 10% to POST /cart
+mesheryctl perf apply shop-perf --url https://192.168.1.15/ --request "70:GET /products" --request "20:GET /product/{{.Seq}}" --request '10:POST /cart {"sku": "{{uuid}}"}'
+
+// Re-run the exact configuration of a past result
+mesheryctl perf apply --profile-from-result 7d5ba9c6-2ea5-4f43-8f1e-b8ed39a3e2c6
+
+// Fail fast unless the probe requests sent before the test are answered with 200, rather than testing a 404 for the whole duration
+mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --expect-status 200
+
+// Soak test the endpoint for an hour with the soak-1h template, at 100 requests per second rather than its 50
+mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --template soak-1h --qps 100
 	`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		client := &http.Client{}
@@ -75,13 +135,46 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh i
 			return ErrMesheryConfig(err)
 		}
 
+		// the request options of the test configuration or of the reproduced run
+		var run *runConfiguration
+		smpTestConfig = nil
+
+		// the request mix given with flags takes precedence over the one of the test configuration
+		testRequestMix, err = requestMixFromFlags()
+		if err != nil {
+			return err
+		}
+
+		// the method given with --method takes precedence over the one of the test configuration
+		testMethod, err = methodFromFlags()
+		if err != nil {
+			return err
+		}
+
+		// the assertions given with flags take precedence over those of the test configuration
+		testAssertions, err = assertionsFromFlags()
+		if err != nil {
+			return err
+		}
+
+		// the template expands into the flags the user did not give
+		if templateName != "" {
+			if err := applyTestTemplate(cmd, templateName); err != nil {
+				return err
+			}
+		}
+
 		// Importing SMP Configuration from the file
 		// TODO: Refactor: Move checks to a single location and consolidate for file, flags and performance profile
 		if filePath != "" {
-			// Read the test configuration file
-			smpConfig, err := os.ReadFile(filePath)
+			// Read the test configuration from the file, URL or standard input
+			smpConfig, err := readTestConfiguration(filePath)
 			if err != nil {
-				return ErrReadFilepath(err)
+				return err
+			}
+
+			if err := validateTestConfiguration(smpConfig); err != nil {
+				return err
 			}
 
 			testConfig := models.PerformanceTestConfigFile{}
@@ -94,8 +187,30 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh i
 			if testConfig.Config == nil || testConfig.ServiceMesh == nil {
 				return ErrInvalidTestConfigFile()
 			}
+			if len(testRequestMix) == 0 {
+				testRequestMix = testConfig.RequestMix
+			}
+			if testMethod == "" {
+				testMethod = strings.ToUpper(testConfig.Method)
+			}
+			if testAssertions.Empty() {
+				testAssertions = testConfig.Assertions
+			}
 
-			testClient := testConfig.Config.Clients[0]
+			clients := testConfig.Config.Clients
+			if clientIndex < 0 || clientIndex >= len(clients) {
+				return ErrInvalidTestConfig([]string{fmt.Sprintf("--client %d is out of range, the test configuration has %d client(s)", clientIndex, len(clients))})
+			}
+			// Every client is run concurrently unless one is selected, the flags
+			// overriding the values of each of them
+			if len(clients) > 1 && !cmd.Flags().Changed("client") {
+				if err := overrideSMPClients(clients); err != nil {
+					return err
+				}
+				smpTestConfig = &testConfig
+				utils.Log.Info(fmt.Sprintf("The test configuration has %d clients, running them concurrently. Select a single one with --client", len(clients)))
+			}
+			testClient := clients[clientIndex]
 
 			// Override values from configuration if passed on as flags
 			if testName == "" {
@@ -125,6 +240,61 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh i
 			if loadGenerator == "" {
 				loadGenerator = testClient.LoadGenerator
 			}
+
+			// the request options of the client are sent along with the test
+			run = &runConfiguration{
+				Headers:     testClient.Headers,
+				Cookies:     testClient.Cookies,
+				Body:        testClient.Body,
+				ContentType: testClient.ContentType,
+			}
+		}
+
+		// Reproduce the configuration of a past result, flags taking precedence
+		if fromResultID != "" {
+			run, err = fetchRunConfiguration(mctlCfg.GetBaseMesheryURL(), fromResultID)
+			if err != nil {
+				return err
+			}
+			if len(testRequestMix) == 0 {
+				testRequestMix = run.RequestMix
+			}
+			if testMethod == "" {
+				testMethod = run.Method
+			}
+			if testAssertions.Empty() {
+				testAssertions = run.Assertions
+			}
+			if testName == "" {
+				testName = run.Name
+			}
+			if testURL == "" {
+				testURL = run.URL
+			}
+			if testMesh == "" {
+				testMesh = run.Mesh
+			}
+			if qps == "" {
+				qps = run.QPS
+			}
+			if concurrentRequests == "" {
+				concurrentRequests = run.ConcurrentRequests
+			}
+			if testDuration == "" {
+				testDuration = run.Duration
+			}
+			if loadGenerator == "" {
+				loadGenerator = run.LoadGenerator
+			}
+			if wsRampUp == "" {
+				wsRampUp = run.WSRampUp
+			}
+			if wsMessageRate == "" {
+				wsMessageRate = run.WSMessageRate
+			}
+			if wsMessageSize == "" {
+				wsMessageSize = run.WSMessageSize
+			}
 		}
 
 		// Run test based on flags
@@ -134,10 +304,15 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh i
 			utils.Log.Debug("Using random test name: ", testName)
 		}
 
-		// Throw error if a profile name is not provided
-		if len(args) == 0 {
+		// Throw error if a profile name is not provided, unless the test re-runs a
+		// result in which case the profile of the result is used
+		if len(args) == 0 && (run == nil || run.ProfileID == "") {
 			return ErrNoProfileName()
 		}
+		if len(args) == 0 {
+			profileID = run.ProfileID
+			return runPerformanceTest(client, mctlCfg, run)
+		}
 
 		// handles spaces in args if quoted args passed
 		for i, arg := range args {
@@ -148,7 +323,7 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh i
 
 		// Check if the profile name is valid, if not prompt the user to create a new one
 		log.Debug("Fetching performance profile")
-		profiles, _, err := fetchPerformanceProfiles(mctlCfg.GetBaseMesheryURL(), profileName, pageSize, pageNumber-1)
+		profiles, _, err := fetchPerformanceProfiles(mctlCfg.GetBaseMesheryURL(), profileName, "", pageSize, pageNumber-1)
 		if err != nil {
 			return err
 		}
@@ -192,7 +367,7 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh i
 
 			// reset profile name without %20
 			// pull test configuration from the profile only if a test configuration is not provided
-			if filePath == "" {
+			if filePath == "" && run == nil && templateName == "" {
 				profileName = profiles[index].Name
 				loadGenerator = profiles[index].LoadGenerators[0]
 				concurrentRequests = strconv.Itoa(profiles[index].ConcurrentRequest)
@@ -200,69 +375,382 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh i
 				testDuration = profiles[index].Duration
 				testMesh = profiles[index].ServiceMesh
 			}
+			// with a template, the profile only provides what the template and the flags leave unset
+			if filePath == "" && run == nil && templateName != "" {
+				profileName = profiles[index].Name
+				if loadGenerator == "" {
+					loadGenerator = profiles[index].LoadGenerators[0]
+				}
+				if testMesh == "" {
+					testMesh = profiles[index].ServiceMesh
+				}
+			}
 		}
 
-		if testURL == "" {
-			return ErrNoTestURL()
-		}
+		return runPerformanceTest(client, mctlCfg, run)
+	},
+}
 
-		log.Debugf("performance profile is: %s", profileName)
-		log.Debugf("test-url set to %s", testURL)
+// runPerformanceTest runs the test configured by the flags against the profile,
+// sending the request options of the reproduced run if any
+func runPerformanceTest(client *http.Client, mctlCfg *config.MesheryCtlConfig, run *runConfiguration) error {
+	if testURL == "" {
+		return ErrNoTestURL()
+	}
 
-		// Method to check if the entered Test URL is valid or not
-		if validURL := govalidator.IsURL(testURL); !validURL {
-			return ErrNotValidURL()
-		}
+	log.Debugf("performance profile is: %s", profileName)
+	log.Debugf("test-url set to %s", testURL)
+
+	// Method to check if the entered Test URL is valid or not
+	if !isValidTestURL(testURL) {
+		return ErrNotValidURL()
+	}
+
+	if smpTestConfig != nil {
+		return runSMPPerformanceTest(client, mctlCfg, smpTestConfig)
+	}
+
+	estimate, err := estimateTest(testURL, qps, concurrentRequests, testDuration)
+	if err != nil {
+		return err
+	}
+	if err := checkTestEstimate([]string{testURL}, []*testEstimate{estimate}); err != nil {
+		return err
+	}
+
+	req, err = utils.NewRequest("GET", mctlCfg.GetBaseMesheryURL()+"/api/user/performance/profiles/"+profileID+"/run", nil)
+	if err != nil {
+		return err
+	}
+
+	q := req.URL.Query()
+
+	q.Add("name", testName)
+	q.Add("loadGenerator", loadGenerator)
+	q.Add("c", concurrentRequests)
+	q.Add("url", testURL)
+	q.Add("qps", qps)
+
+	durLen := len(testDuration)
 
-		req, err = utils.NewRequest("GET", mctlCfg.GetBaseMesheryURL()+"/api/user/performance/profiles/"+profileID+"/run", nil)
+	q.Add("dur", string(testDuration[durLen-1]))
+	q.Add("t", string(testDuration[:durLen-1]))
+
+	if testMesh != "" {
+		q.Add("mesh", testMesh)
+	}
+	if wsRampUp != "" {
+		q.Add("wsRampUp", wsRampUp)
+	}
+	if wsMessageRate != "" {
+		q.Add("wsMessageRate", wsMessageRate)
+	}
+	if wsMessageSize != "" {
+		q.Add("wsMessageSize", wsMessageSize)
+	}
+	if run != nil {
+		// the request options of the reproduced run, a body template given with flags taking precedence
+		if bodyTemplateFile == "" && run.BodyTemplate {
+			q.Add("bodyTemplate", "true")
+			if run.TemplateData != "" && dataFile == "" {
+				q.Add("templateData", run.TemplateData)
+			}
+		}
+		if len(run.Headers) > 0 {
+			headers, _ := json.Marshal(run.Headers)
+			q.Add("headers", string(headers))
+		}
+		if len(run.Cookies) > 0 {
+			cookies, _ := json.Marshal(run.Cookies)
+			q.Add("cookies", string(cookies))
+		}
+		if run.ContentType != "" {
+			q.Add("contentType", run.ContentType)
+		}
+		if run.Body != "" && bodyTemplateFile == "" {
+			q.Add("reqBody", run.Body)
+		}
+	}
+	if bodyTemplateFile != "" {
+		body, err := os.ReadFile(bodyTemplateFile)
 		if err != nil {
-			return err
+			return ErrReadFilepath(err)
+		}
+		q.Set("reqBody", string(body))
+		q.Set("bodyTemplate", "true")
+	}
+	if dataFile != "" {
+		if bodyTemplateFile == "" && (run == nil || !run.BodyTemplate) {
+			return errors.New(utils.PerfError("--data-file requires --body-template"))
+		}
+		data, err := os.ReadFile(dataFile)
+		if err != nil {
+			return ErrReadFilepath(err)
+		}
+		q.Set("templateData", string(data))
+	}
+	addGrafanaSnapshotQuery(q)
+	if adaptive && loadGenerator != "nighthawk" {
+		return errors.New(utils.PerfError("--adaptive requires --load-generator nighthawk"))
+	}
+	if err := addAdaptiveQuery(q); err != nil {
+		return err
+	}
+	if unixSocket != "" && loadGenerator != "" && loadGenerator != "fortio" {
+		return errors.New(utils.PerfError("--unix-socket requires --load-generator fortio"))
+	}
+	addUnixSocketQuery(q)
+	addProbeQuery(q)
+	if err := addAssertionsQuery(q); err != nil {
+		return err
+	}
+	checkpointTestID, err := addCheckpointQuery(q)
+	if err != nil {
+		return err
+	}
+	if len(testRequestMix) > 0 {
+		if loadGenerator != "" && loadGenerator != "fortio" {
+			return errors.New(utils.PerfError("request mixes are sent by the fortio load generator only"))
+		}
+		mix, err := json.Marshal(testRequestMix)
+		if err != nil {
+			return ErrFailMarshal(err)
+		}
+		q.Set("requestMix", string(mix))
+	}
+	if testMethod != "" {
+		if len(testRequestMix) > 0 {
+			return errors.New(utils.PerfError("--method cannot be given with a request mix, whose requests have their own methods"))
 		}
+		q.Set("method", testMethod)
+	}
+	req.URL.RawQuery = q.Encode()
 
-		q := req.URL.Query()
+	utils.Log.Info("Initiating Performance test ...")
+	logCheckpoints(checkpointTestID)
 
-		q.Add("name", testName)
-		q.Add("loadGenerator", loadGenerator)
-		q.Add("c", concurrentRequests)
-		q.Add("url", testURL)
-		q.Add("qps", qps)
+	resp, err := client.Do(req)
+	if err != nil {
+		return ErrFailRequest(err)
+	}
+	if utils.ContentTypeIsHTML(resp) {
+		return ErrFailTestRun()
+	}
+	if resp.StatusCode != 200 {
+		return testRunError(resp)
+	}
 
-		durLen := len(testDuration)
+	defer utils.SafeClose(resp.Body)
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	utils.Log.Debug(string(data))
 
-		q.Add("dur", string(testDuration[durLen-1]))
-		q.Add("t", string(testDuration[:durLen-1]))
+	utils.Log.Info("Test Completed Successfully!")
+	return nil
+}
 
-		if testMesh != "" {
-			q.Add("mesh", testMesh)
+// requestMixFromFlags returns the request mix given with --request, none when not given
+func requestMixFromFlags() (models.RequestMix, error) {
+	var mix models.RequestMix
+	for _, spec := range requests {
+		request, err := models.ParseWeightedRequest(spec)
+		if err != nil {
+			return nil, errors.Wrap(err, utils.PerfError("invalid --request"))
 		}
-		req.URL.RawQuery = q.Encode()
+		mix = append(mix, request)
+	}
+	return mix, nil
+}
 
-		utils.Log.Info("Initiating Performance test ...")
+// methodFromFlags returns the HTTP method given with --method, none when not given
+func methodFromFlags() (string, error) {
+	if testMethod == "" {
+		return "", nil
+	}
+	if err := models.ValidateHTTPMethod(testMethod); err != nil {
+		return "", errors.Wrap(err, utils.PerfError("invalid --method"))
+	}
+	return strings.ToUpper(testMethod), nil
+}
 
-		resp, err := client.Do(req)
-		if err != nil {
-			return ErrFailRequest(err)
+// testRunError returns the error of a test Meshery Server did not run, giving the reason
+// when the test was rejected for exceeding the limits of the server or the quotas of the workspace
+func testRunError(resp *http.Response) error {
+	defer utils.SafeClose(resp.Body)
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		message, err := io.ReadAll(resp.Body)
+		if err == nil {
+			return ErrTestRejected(string(message))
 		}
-		if utils.ContentTypeIsHTML(resp) {
-			return ErrFailTestRun()
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		message, err := io.ReadAll(resp.Body)
+		if err == nil {
+			return ErrWorkspaceQuota(string(message))
 		}
-		if resp.StatusCode != 200 {
-			return ErrFailTestRun()
+	}
+	if resp.StatusCode == http.StatusFailedDependency {
+		message, err := io.ReadAll(resp.Body)
+		if err == nil {
+			return ErrTargetProbe(string(message))
+		}
+	}
+	return ErrFailTestRun()
+}
+
+// addGrafanaSnapshotQuery asks Meshery to capture snapshots of Grafana boards over the run
+// when --grafana-snapshot is given
+func addGrafanaSnapshotQuery(q url.Values) {
+	if !grafanaSnapshot {
+		return
+	}
+	q.Set("grafanaSnapshot", "true")
+	if len(grafanaBoards) > 0 {
+		q.Set("grafanaBoards", strings.Join(grafanaBoards, ","))
+	}
+}
+
+// addAdaptiveQuery asks Meshery to search for the maximum rate sustainable under the p99 latency
+// budget when --adaptive is given, the rate of the test being the rate the search starts at
+func addAdaptiveQuery(q url.Values) error {
+	if !adaptive {
+		return nil
+	}
+	target, err := time.ParseDuration(targetP99)
+	if err != nil || target <= 0 {
+		return errors.New(utils.PerfError("--adaptive requires a p99 latency budget, such as --target-p99 100ms"))
+	}
+	q.Set("adaptive", "true")
+	q.Set("targetP99", target.String())
+	return nil
+}
+
+// addUnixSocketQuery asks Meshery to send the requests of the test over the Unix domain socket
+// given as --unix-socket, the host of the URL being sent as the Host of the requests
+func addUnixSocketQuery(q url.Values) {
+	if unixSocket != "" {
+		q.Set("unixSocket", unixSocket)
+	}
+}
+
+// addProbeQuery asks Meshery to expect the statuses given as --expect-status from the probe requests
+// sent to the target before the test, or not to probe the target with --skip-probe
+func addProbeQuery(q url.Values) {
+	if skipProbe {
+		q.Set("skipProbe", "true")
+		return
+	}
+	if len(expectStatus) > 0 {
+		statuses := make([]string, 0, len(expectStatus))
+		for _, s := range expectStatus {
+			statuses = append(statuses, strconv.Itoa(s))
 		}
+		q.Set("expectStatus", strings.Join(statuses, ","))
+	}
+}
 
-		defer utils.SafeClose(resp.Body)
-		data, err := io.ReadAll(resp.Body)
+// assertionsFromFlags returns the assertions given with --assert-status, --assert-body-contains,
+// --assert-json-path and --assert-max-size, nil when none is given
+func assertionsFromFlags() (*models.LoadTestAssertions, error) {
+	if assertMaxSize < 0 {
+		return nil, errors.New(utils.PerfError("--assert-max-size must not be negative"))
+	}
+	assertions := &models.LoadTestAssertions{
+		Status:          assertStatus,
+		BodyContains:    assertBodyContains,
+		MaxResponseSize: assertMaxSize,
+	}
+	for _, spec := range assertJSONPath {
+		a, err := models.ParseJSONPathAssertion(spec)
 		if err != nil {
-			return errors.Wrap(err, utils.PerfError("failed to read response body"))
+			return nil, errors.Wrap(err, utils.PerfError("invalid --assert-json-path"))
 		}
-		utils.Log.Debug(string(data))
+		assertions.JSONPath = append(assertions.JSONPath, a)
+	}
+	if assertions.Empty() {
+		return nil, nil
+	}
+	if err := assertions.Validate(); err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("invalid assertions"))
+	}
+	return assertions, nil
+}
 
-		utils.Log.Info("Test Completed Successfully!")
+// addAssertionsQuery asks Meshery to validate the responses of the test with the assertions,
+// the responses failing any of them counting as errors
+func addAssertionsQuery(q url.Values) error {
+	if testAssertions.Empty() {
 		return nil
-	},
+	}
+	if loadGenerator != "" && loadGenerator != "fortio" {
+		return errors.New(utils.PerfError("assertions are evaluated by the fortio load generator only"))
+	}
+	data, err := json.Marshal(testAssertions)
+	if err != nil {
+		return ErrFailMarshal(err)
+	}
+	q.Set("assertions", string(data))
+	return nil
+}
+
+// addCheckpointQuery asks Meshery to persist checkpoints of the aggregates of the test every interval
+// given as --checkpoint, returning the ID of the test the checkpoints are kept under
+func addCheckpointQuery(q url.Values) (string, error) {
+	if checkpoint == "" {
+		return "", nil
+	}
+	interval, err := time.ParseDuration(checkpoint)
+	if err != nil || interval < time.Minute {
+		return "", errors.New(utils.PerfError("--checkpoint requires an interval of at least a minute, such as --checkpoint 10m"))
+	}
+	if adaptive {
+		return "", errors.New(utils.PerfError("adaptive tests cannot be checkpointed"))
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", errors.Wrap(err, utils.PerfError("failed to generate the ID of the test"))
+	}
+	q.Set("checkpoint", interval.String())
+	q.Set("uuid", id.String())
+	return id.String(), nil
+}
+
+// logCheckpoints tells how to follow the checkpoints of the test while it runs
+func logCheckpoints(testID string) {
+	if testID != "" {
+		utils.Log.Info("Checkpoints of the test are listed while it runs with: mesheryctl perf result --checkpoints " + testID)
+	}
+}
+
+// isValidTestURL reports whether the URL can be tested. govalidator rejects the IPv6 literals in
+// brackets of URLs such as http://[::1]:8080/, which are checked here instead.
+func isValidTestURL(rawURL string) bool {
+	withScheme := rawURL
+	if !strings.Contains(rawURL, "://") {
+		withScheme = "http://" + rawURL
+	}
+	u, err := url.Parse(withScheme)
+	if err != nil || !strings.HasPrefix(u.Host, "[") {
+		return govalidator.IsURL(rawURL)
+	}
+	switch u.Scheme {
+	case "http", "https", "ws", "wss":
+	default:
+		return false
+	}
+	if port := u.Port(); port != "" {
+		if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+			return false
+		}
+	}
+	// the zone of link-local addresses, such as %eth0, is not part of the address
+	return net.ParseIP(strings.SplitN(u.Hostname(), "%", 2)[0]) != nil
 }
 
 func init() {
+	applyCmd.ValidArgsFunction = utils.CompleteNames(profileNames)
 	applyCmd.Flags().StringVar(&testURL, "url", "", "(optional) Endpoint URL to test (required with --profile)")
 	applyCmd.Flags().StringVar(&testName, "name", "", "(optional) Name of the Test")
 	applyCmd.Flags().StringVar(&testMesh, "mesh", "", "(optional) Name of the Service Mesh")
@@ -270,7 +758,146 @@ func init() {
 	applyCmd.Flags().StringVar(&concurrentRequests, "concurrent-requests", "", "(optional) Number of Parallel Requests")
 	applyCmd.Flags().StringVar(&testDuration, "duration", "", "(optional) Length of test (e.g. 10s, 5m, 2h). For more, see https://golang.org/pkg/time/#ParseDuration")
 	applyCmd.Flags().StringVar(&loadGenerator, "load-generator", "", "(optional) Load-Generator to be used (fortio/wrk2)")
-	applyCmd.Flags().StringVarP(&filePath, "file", "f", "", "(optional) file containing SMP-compatible test configuration. For more, see https://github.com/layer5io/service-mesh-performance-specification")
+	applyCmd.Flags().StringVar(&bodyTemplateFile, "body-template", "", "(optional) file containing a template rendered as the body of every request, using {{.Seq}}, {{uuid}} and {{.Row.<column>}}")
+	applyCmd.Flags().StringVar(&dataFile, "data-file", "", "(optional) CSV file with a header row whose rows the body template uses in turn")
+	applyCmd.Flags().StringVar(&wsRampUp, "ws-ramp-up", "", "(optional) Time over which the connections of a WebSocket (ws:// or wss://) test are opened (e.g. 10s)")
+	applyCmd.Flags().StringVar(&wsMessageRate, "ws-message-rate", "", "(optional) Messages sent per second on every connection of a WebSocket test (default: as fast as replies arrive)")
+	applyCmd.Flags().StringVar(&wsMessageSize, "ws-message-size", "", "(optional) Size in bytes of the messages of a WebSocket test (default: 64)")
+	applyCmd.Flags().StringVar(&fromResultID, "profile-from-result", "", "(optional) ID of a past result whose exact test configuration is run again")
+	applyCmd.Flags().BoolVar(&grafanaSnapshot, "grafana-snapshot", false, "(optional) Capture snapshots of Grafana boards over the run, kept with its result")
+	applyCmd.Flags().StringSliceVar(&grafanaBoards, "grafana-board", []string{}, "(optional) UIDs of the Grafana boards to snapshot (default: the boards selected in the Grafana settings)")
+	applyCmd.Flags().StringArrayVar(&requests, "request", []string{}, "(optional) Weighted request of a request mix, as <weight>:<method> <path>[ <body>] with the path resolved against --url (e.g. \"70:GET /products\"), repeated for every request of the mix (fortio only)")
+	applyCmd.Flags().StringVar(&testMethod, "method", "", "(optional) HTTP method of the requests of the test, such as POST, PUT or DELETE (default: POST with a request body, GET otherwise)")
+	applyCmd.Flags().BoolVar(&adaptive, "adaptive", false, "(optional) Search for the maximum requests per second sustainable under the p99 latency budget over the duration of the test, rather than running at a fixed rate (nighthawk only)")
+	applyCmd.Flags().IntSliceVar(&expectStatus, "expect-status", []int{}, "(optional) Statuses the probe requests sent to the target before the test must be answered with (default: any below 400)")
+	applyCmd.Flags().BoolVar(&skipProbe, "skip-probe", false, "(optional) Start the test without probing its target first")
+	applyCmd.Flags().IntSliceVar(&assertStatus, "assert-status", []int{}, "(optional) Statuses the responses of the test must be answered with, those answered otherwise counting as errors (fortio only)")
+	applyCmd.Flags().StringArrayVar(&assertBodyContains, "assert-body-contains", []string{}, "(optional) Substring the body of every response must contain, repeated for every substring (fortio only)")
+	applyCmd.Flags().StringArrayVar(&assertJSONPath, "assert-json-path", []string{}, "(optional) JSONPath expression which must find a value in the JSON body of every response, as <path>[=<value>] (e.g. \"$.status=up\"), repeated for every expression (fortio only)")
+	applyCmd.Flags().Int64Var(&assertMaxSize, "assert-max-size", 0, "(optional) Size in bytes the body of every response must not exceed (fortio only)")
+	applyCmd.Flags().StringVar(&unixSocket, "unix-socket", "", "(optional) Path of the Unix domain socket the requests are sent over, the host of --url being sent as their Host (fortio only, http:// and ws:// URLs)")
+	applyCmd.Flags().StringVar(&targetP99, "target-p99", "", "(optional) p99 latency budget of an adaptive test (e.g. 100ms)")
+	applyCmd.Flags().IntVar(&clientIndex, "client", 0, "(optional) Index of the only client to run of a test configuration with multiple clients, which otherwise run concurrently")
+	applyCmd.Flags().StringVar(&checkpoint, "checkpoint", "", "(optional) Interval at which the aggregates of a long running test are persisted as checkpoints, listed with perf result --checkpoints while it runs and kept if the run dies (e.g. 10m, at least 1m)")
+	applyCmd.Flags().StringVar(&templateName, "template", "", "(optional) Name of a test template shipped with mesheryctl, expanding into the flags of the test which flags given along override: "+strings.Join(testTemplateNames(), ", "))
+	applyCmd.Long += "\n\nTest templates, selected with --template:\n" + testTemplatesHelp()
+	applyCmd.Flags().StringVarP(&filePath, "file", "f", "", "(optional) file, http(s) URL or - for stdin containing SMP-compatible test configuration. For more, see https://github.com/layer5io/service-mesh-performance-specification")
+}
+
+// overrideSMPClients sets the values of the flags given on every client of the test configuration
+func overrideSMPClients(clients []*SMP.PerformanceTestConfig_Client) error {
+	for _, testClient := range clients {
+		if testURL != "" {
+			testClient.EndpointUrls = []string{testURL}
+		}
+		if qps != "" {
+			rps, err := strconv.ParseInt(qps, 10, 64)
+			if err != nil {
+				return errors.Wrap(err, utils.PerfError("invalid --qps"))
+			}
+			testClient.Rps = rps
+		}
+		if concurrentRequests != "" {
+			connections, err := strconv.ParseInt(concurrentRequests, 10, 32)
+			if err != nil {
+				return errors.Wrap(err, utils.PerfError("invalid --concurrent-requests"))
+			}
+			testClient.Connections = int32(connections)
+		}
+		if loadGenerator != "" {
+			testClient.LoadGenerator = loadGenerator
+		}
+	}
+	return nil
+}
+
+// runSMPPerformanceTest runs every client of the test configuration concurrently against
+// the profile, Meshery storing the results of each client along with the result of the run
+func runSMPPerformanceTest(client *http.Client, mctlCfg *config.MesheryCtlConfig, testConfig *models.PerformanceTestConfigFile) error {
+	testConfig.Config.Id = profileID
+	testConfig.Config.Name = testName
+	if len(testRequestMix) > 0 {
+		testConfig.RequestMix = testRequestMix
+	}
+	if testMethod != "" {
+		testConfig.Method = testMethod
+	}
+	if !testAssertions.Empty() {
+		if loadGenerator != "" && loadGenerator != "fortio" {
+			return errors.New(utils.PerfError("assertions are evaluated by the fortio load generator only"))
+		}
+		testConfig.Assertions = testAssertions
+	}
+	testConfig.Config.Duration = testDuration
+	if testConfig.Config.Duration == "" {
+		testConfig.Config.Duration = "30s"
+	}
+	if meshType, ok := SMP.ServiceMesh_Type_value[strings.ToUpper(testMesh)]; ok {
+		testConfig.ServiceMesh.Type = SMP.ServiceMesh_Type(meshType)
+	}
+
+	var urls []string
+	var estimates []*testEstimate
+	for _, testClient := range testConfig.Config.Clients {
+		if len(testClient.EndpointUrls) == 0 {
+			continue
+		}
+		estimate, err := estimateTest(testClient.EndpointUrls[0], strconv.FormatInt(testClient.Rps, 10), strconv.Itoa(int(testClient.Connections)), testConfig.Config.Duration)
+		if err != nil {
+			return err
+		}
+		urls = append(urls, testClient.EndpointUrls...)
+		estimates = append(estimates, estimate)
+	}
+	if err := checkTestEstimate(urls, estimates); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(testConfig)
+	if err != nil {
+		return ErrFailMarshal(err)
+	}
+	req, err = utils.NewRequest("GET", mctlCfg.GetBaseMesheryURL()+"/api/user/performance/profiles/"+profileID+"/run", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	q := req.URL.Query()
+	addGrafanaSnapshotQuery(q)
+	if err := addAdaptiveQuery(q); err != nil {
+		return err
+	}
+	addUnixSocketQuery(q)
+	addProbeQuery(q)
+	checkpointTestID, err := addCheckpointQuery(q)
+	if err != nil {
+		return err
+	}
+	req.URL.RawQuery = q.Encode()
+	logCheckpoints(checkpointTestID)
+
+	utils.Log.Info("Initiating Performance test ...")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return ErrFailRequest(err)
+	}
+	if utils.ContentTypeIsHTML(resp) {
+		return ErrFailTestRun()
+	}
+	if resp.StatusCode != 200 {
+		return testRunError(resp)
+	}
+
+	defer utils.SafeClose(resp.Body)
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	utils.Log.Debug(string(data))
+
+	utils.Log.Info("Test Completed Successfully!")
+	return nil
 }
 
 func createPerformanceProfile(client *http.Client, mctlCfg *config.MesheryCtlConfig) (string, string, error) {
@@ -286,7 +913,7 @@ func createPerformanceProfile(client *http.Client, mctlCfg *config.MesheryCtlCon
 	}
 
 	// Method to check if the entered Test URL is valid or not
-	if validURL := govalidator.IsURL(testURL); !validURL {
+	if !isValidTestURL(testURL) {
 		return "", "", ErrNotValidURL()
 	}
 
@@ -331,6 +958,12 @@ func createPerformanceProfile(client *http.Client, mctlCfg *config.MesheryCtlCon
 		"request_headers":    "",
 		"content_type":       "",
 	}
+	if len(testRequestMix) > 0 {
+		values["request_mix"] = testRequestMix
+	}
+	if testMethod != "" {
+		values["method"] = testMethod
+	}
 
 	jsonValue, err := json.Marshal(values)
 	if err != nil {
@@ -347,6 +980,13 @@ func createPerformanceProfile(client *http.Client, mctlCfg *config.MesheryCtlCon
 	}
 
 	var response *models.PerformanceProfile
+	// the profile is not created when the workspace of the user owns as many as its quota allows
+	if resp.StatusCode == http.StatusForbidden {
+		defer resp.Body.Close()
+		if message, err := io.ReadAll(resp.Body); err == nil {
+			return "", "", ErrWorkspaceQuota(string(message))
+		}
+	}
 	// failsafe for the case when a valid uuid v4 is not an id of any pattern (bad api call)
 	if resp.StatusCode != 200 {
 		return "", "", ErrFailReqStatus(resp.StatusCode)
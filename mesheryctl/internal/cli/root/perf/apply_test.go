@@ -1,12 +1,21 @@
 package perf
 
 import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
 	"path/filepath"
+	"reflect"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	SMP "github.com/layer5io/service-mesh-performance/spec"
 )
 
 var existingProfileID = "8f3daf25-e58e-4c59-8bf8-f474b76463ec"
@@ -62,7 +71,7 @@ func TestApplyCmd(t *testing.T) {
 
 	// test scenrios for fetching data
 	tests := []tempTestStruct{
-		{"Run Test with Existing profile", []string{"apply", "new"},
+		{"Run Test with Existing profile", []string{"apply", "new", "--yes"},
 			[]utils.MockURL{
 				{Method: "GET", URL: profileURL, Response: apply1001, ResponseCode: 200},
 				{Method: "GET", URL: existingProfileRunTest, Response: apply1002, ResponseCode: 200},
@@ -70,7 +79,7 @@ func TestApplyCmd(t *testing.T) {
 			apply1001output,
 			testToken, false,
 		},
-		{"Run Test with Existing profile with --url", []string{"apply", "new", "--url", "https://www.google.com"},
+		{"Run Test with Existing profile with --url", []string{"apply", "new", "--url", "https://www.google.com", "--yes"},
 			[]utils.MockURL{
 				{Method: "GET", URL: profileURL, Response: apply1001, ResponseCode: 200},
 				{Method: "GET", URL: existingProfileRunTest, Response: apply1002, ResponseCode: 200},
@@ -78,7 +87,7 @@ func TestApplyCmd(t *testing.T) {
 			apply1001output,
 			testToken, false,
 		},
-		{"Run Test with Existing profile with --url without protocol", []string{"apply", "new", "--url", "www.google.com"},
+		{"Run Test with Existing profile with --url without protocol", []string{"apply", "new", "--url", "www.google.com", "--yes"},
 			[]utils.MockURL{
 				{Method: "GET", URL: profileURL, Response: apply1001, ResponseCode: 200},
 				{Method: "GET", URL: existingProfileRunTest, Response: apply1003, ResponseCode: 400},
@@ -86,7 +95,7 @@ func TestApplyCmd(t *testing.T) {
 			apply1002output,
 			testToken, true,
 		},
-		{"Run Test with new profile with --url without protocol", []string{"apply", "test", "--url", "www.google.com"},
+		{"Run Test with new profile with --url without protocol", []string{"apply", "test", "--url", "www.google.com", "--yes"},
 			[]utils.MockURL{
 				{Method: "POST", URL: profileURL, Response: apply1004, ResponseCode: 200},
 				{Method: "GET", URL: newProfileRunTest, Response: apply1003, ResponseCode: 400},
@@ -173,4 +182,298 @@ func resetVariables() {
 	outputFormatFlag = ""
 	viewSingleProfile = false
 	viewSingleResult = false
+	adaptive = false
+	targetP99 = ""
+	unixSocket = ""
+	expectStatus = nil
+	skipProbe = false
+	assertStatus = nil
+	assertBodyContains = nil
+	assertJSONPath = nil
+	assertMaxSize = 0
+	testAssertions = nil
+	requests = nil
+	testRequestMix = nil
+	testMethod = ""
+	templateName = ""
+	checkpoint = ""
+	checkpointsFlag = ""
+	rawDownloadFlag = false
+}
+
+func TestRequestMixFromFlags(t *testing.T) {
+	defer resetVariables()
+
+	requests = []string{"70:GET /products", "20:get /product/{{.Seq}}", `10:POST /cart {"sku": 1}`}
+	mix, err := requestMixFromFlags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := models.RequestMix{
+		{Weight: 70, Method: "GET", Path: "/products"},
+		{Weight: 20, Method: "GET", Path: "/product/{{.Seq}}"},
+		{Weight: 10, Method: "POST", Path: "/cart", Body: `{"sku": 1}`},
+	}
+	if len(mix) != len(want) {
+		t.Fatalf("request mix = %+v, want %+v", mix, want)
+	}
+	for i := range want {
+		if mix[i] != want[i] {
+			t.Errorf("request %d = %+v, want %+v", i, mix[i], want[i])
+		}
+	}
+
+	for _, spec := range []string{"GET /products", "0:GET /products", "10:FETCH /products", "10:GET"} {
+		requests = []string{spec}
+		if _, err := requestMixFromFlags(); err == nil {
+			t.Errorf("--request %q accepted", spec)
+		}
+	}
+}
+
+func TestMethodFromFlags(t *testing.T) {
+	defer resetVariables()
+
+	for given, want := range map[string]string{"": "", "put": "PUT", "DELETE": "DELETE"} {
+		testMethod = given
+		method, err := methodFromFlags()
+		if err != nil {
+			t.Fatalf("methodFromFlags() of %q error = %v", given, err)
+		}
+		if method != want {
+			t.Errorf("methodFromFlags() of %q = %q, want %q", given, method, want)
+		}
+	}
+
+	testMethod = "FETCH"
+	if _, err := methodFromFlags(); err == nil {
+		t.Error("--method FETCH accepted")
+	}
+}
+
+func TestAddAdaptiveQuery(t *testing.T) {
+	defer resetVariables()
+
+	// fixed rate tests are left as is
+	q := url.Values{}
+	if err := addAdaptiveQuery(q); err != nil || len(q) != 0 {
+		t.Errorf("query = %v, error = %v, want none", q, err)
+	}
+
+	adaptive = true
+	for _, target := range []string{"", "100", "-1s"} {
+		targetP99 = target
+		if err := addAdaptiveQuery(url.Values{}); err == nil {
+			t.Errorf("--target-p99 %q accepted", target)
+		}
+	}
+
+	targetP99 = "0.1s"
+	if err := addAdaptiveQuery(q); err != nil {
+		t.Fatal(err)
+	}
+	if q.Get("adaptive") != "true" || q.Get("targetP99") != "100ms" {
+		t.Errorf("query = %v, want adaptive with a target of 100ms", q)
+	}
+}
+
+func TestAddProbeQuery(t *testing.T) {
+	defer resetVariables()
+
+	// the server expects any status below 400 by default
+	q := url.Values{}
+	addProbeQuery(q)
+	if len(q) != 0 {
+		t.Errorf("query = %v, want none", q)
+	}
+
+	expectStatus = []int{200, 204}
+	addProbeQuery(q)
+	if q.Get("expectStatus") != "200,204" {
+		t.Errorf("query = %v, want the expected statuses", q)
+	}
+
+	skipProbe = true
+	q = url.Values{}
+	addProbeQuery(q)
+	if q.Get("skipProbe") != "true" || q.Has("expectStatus") {
+		t.Errorf("query = %v, want the probe skipped", q)
+	}
+}
+
+func TestAssertionsFromFlags(t *testing.T) {
+	defer resetVariables()
+
+	assertions, err := assertionsFromFlags()
+	if err != nil || assertions != nil {
+		t.Fatalf("assertionsFromFlags() = %v, %v, want none", assertions, err)
+	}
+
+	assertStatus = []int{200}
+	assertBodyContains = []string{"ok"}
+	assertJSONPath = []string{"$.status=up", "$.items[0].id"}
+	assertMaxSize = 1024
+	assertions, err = assertionsFromFlags()
+	if err != nil {
+		t.Fatalf("assertionsFromFlags() returned %v", err)
+	}
+	want := &models.LoadTestAssertions{
+		Status:       []int{200},
+		BodyContains: []string{"ok"},
+		JSONPath: []models.JSONPathAssertion{
+			{Path: "$.status", Value: "up"},
+			{Path: "$.items[0].id"},
+		},
+		MaxResponseSize: 1024,
+	}
+	if !reflect.DeepEqual(assertions, want) {
+		t.Errorf("assertionsFromFlags() = %+v, want %+v", assertions, want)
+	}
+
+	assertJSONPath = []string{"$.items[0"}
+	if _, err := assertionsFromFlags(); err == nil {
+		t.Error("assertionsFromFlags() accepted an invalid JSONPath")
+	}
+
+	assertJSONPath = nil
+	assertMaxSize = -1
+	if _, err := assertionsFromFlags(); err == nil {
+		t.Error("assertionsFromFlags() accepted a negative maximum size")
+	}
+}
+
+func TestAddAssertionsQuery(t *testing.T) {
+	defer resetVariables()
+
+	q := url.Values{}
+	if err := addAssertionsQuery(q); err != nil || len(q) != 0 {
+		t.Fatalf("query = %v, %v, want none", q, err)
+	}
+
+	testAssertions = &models.LoadTestAssertions{Status: []int{200}, BodyContains: []string{"ok"}}
+	if err := addAssertionsQuery(q); err != nil {
+		t.Fatalf("addAssertionsQuery() returned %v", err)
+	}
+	if got := q.Get("assertions"); got != `{"status":[200],"body_contains":["ok"]}` {
+		t.Errorf("assertions = %s", got)
+	}
+
+	loadGenerator = "wrk2"
+	if err := addAssertionsQuery(url.Values{}); err == nil {
+		t.Error("addAssertionsQuery() accepted assertions with wrk2")
+	}
+}
+
+func TestTestRunErrorProbe(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusFailedDependency,
+		Body:       io.NopCloser(strings.NewReader("GET http://localhost:9080/ was answered with 404 Not Found, expected 200\n")),
+	}
+	err := testRunError(resp)
+	if err == nil || !strings.Contains(err.Error(), "404 Not Found, expected 200") {
+		t.Errorf("expected the diagnosis of the probe, got %v", err)
+	}
+}
+
+func TestTestRunErrorWorkspaceQuota(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusForbidden,
+		Body:       io.NopCloser(strings.NewReader("workspace payments runs 2 of its 2 concurrent performance tests\n")),
+	}
+	err := testRunError(resp)
+	if err == nil || !strings.Contains(err.Error(), "quota of the workspace exceeded: workspace payments runs 2 of its 2 concurrent performance tests") {
+		t.Errorf("expected the quota exceeded, got %v", err)
+	}
+}
+
+func TestIsValidTestURL(t *testing.T) {
+	for rawURL, want := range map[string]bool{
+		"http://localhost:2323/productpage": true,
+		"localhost:2323":                    true,
+		"invalid-url":                       false,
+		"https://[::1]:8443/":               true,
+		"http://[fe80::1%25eth0]/":          true,
+		"ws://[2001:db8::1]/socket":         true,
+		"http://[::1:8080/":                 false,
+		"http://[not:an:ip]/":               false,
+		"http://[::1]:99999":                false,
+		"ftp://[::1]/":                      false,
+		"http://":                           false,
+	} {
+		if got := isValidTestURL(rawURL); got != want {
+			t.Errorf("isValidTestURL(%q) = %v, want %v", rawURL, got, want)
+		}
+	}
+}
+
+func TestRunSMPPerformanceTest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	defer resetVariables()
+
+	_, filename, _, _ := runtime.Caller(0)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+
+	testConfig := models.PerformanceTestConfigFile{
+		Config: &SMP.PerformanceTestConfig{
+			Clients: []*SMP.PerformanceTestConfig_Client{
+				{LoadGenerator: "fortio", Rps: 10, EndpointUrls: []string{"http://localhost:2323/productpage"}},
+				{LoadGenerator: "wrk2", Rps: 50, EndpointUrls: []string{"http://localhost:2323/reviews"}},
+			},
+		},
+		ServiceMesh: &SMP.ServiceMesh{},
+	}
+
+	// flags given override the values of every client
+	resetVariables()
+	qps = ""
+	concurrentRequests = "4"
+	loadGenerator = ""
+	if err := overrideSMPClients(testConfig.Config.Clients); err != nil {
+		t.Fatal(err)
+	}
+
+	profileID = existingProfileID
+	testName = "multi-client"
+	testMesh = "istio"
+	var sent models.PerformanceTestConfigFile
+	httpmock.RegisterResponder("GET", "http://localhost:9081/api/user/performance/profiles/"+existingProfileID+"/run",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	mctlCfg := &config.MesheryCtlConfig{
+		Contexts:       map[string]config.Context{"local": {Endpoint: "http://localhost:9081"}},
+		CurrentContext: "local",
+	}
+	if err := runSMPPerformanceTest(&http.Client{}, mctlCfg, &testConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	if sent.Config.Id != existingProfileID || sent.Config.Name != "multi-client" || sent.Config.Duration != "30s" {
+		t.Errorf("unexpected test configuration sent: %+v", sent.Config)
+	}
+	if sent.ServiceMesh.Type != SMP.ServiceMesh_ISTIO {
+		t.Errorf("mesh type sent = %v, want %v", sent.ServiceMesh.Type, SMP.ServiceMesh_ISTIO)
+	}
+	if len(sent.Config.Clients) != 2 {
+		t.Fatalf("clients sent = %d, want 2", len(sent.Config.Clients))
+	}
+	for i, rps := range []int64{10, 50} {
+		client := sent.Config.Clients[i]
+		if client.Rps != rps || client.Connections != 4 {
+			t.Errorf("client %d sent with rps %d and connections %d, want %d and 4", i, client.Rps, client.Connections, rps)
+		}
+	}
+
+	// tests exceeding the limits of the server are rejected with the reason
+	httpmock.RegisterResponder("GET", "http://localhost:9081/api/user/performance/profiles/"+existingProfileID+"/run",
+		httpmock.NewStringResponder(http.StatusUnprocessableEntity, "1000000 requests per second exceed the limit of 500\n"))
+	err := runSMPPerformanceTest(&http.Client{}, mctlCfg, &testConfig)
+	if err == nil || !strings.Contains(err.Error(), "exceed the limit of 500") {
+		t.Errorf("error = %v, want the reason of the rejection", err)
+	}
 }
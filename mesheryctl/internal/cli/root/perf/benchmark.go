@@ -0,0 +1,347 @@
+package perf
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	benchmarkMesh               string
+	benchmarkNamespace          string
+	benchmarkQPS                string
+	benchmarkConcurrentRequests string
+	benchmarkDuration           string
+	benchmarkLoadGenerator      string
+)
+
+// meshOverheadStages are the stages of the mesh overhead benchmark, each one turning on one more
+// feature of the mesh so that the overhead of every feature is the difference with the stage before
+var meshOverheadStages = []struct {
+	name   string
+	config models.PerfTargetMeshConfiguration
+}{
+	{name: "baseline", config: models.PerfTargetMeshConfiguration{}},
+	{name: "sidecar", config: models.PerfTargetMeshConfiguration{Sidecar: true}},
+	{name: "mtls", config: models.PerfTargetMeshConfiguration{Sidecar: true, MTLS: true}},
+	{name: "telemetry", config: models.PerfTargetMeshConfiguration{Sidecar: true, MTLS: true, Telemetry: true}},
+}
+
+// benchmarkLatencies are latencies in milliseconds
+type benchmarkLatencies struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+}
+
+// meshOverheadStage is the result of a stage of the mesh overhead benchmark
+type meshOverheadStage struct {
+	Name     string `json:"name"`
+	ResultID string `json:"result_id,omitempty"`
+	// AlwaysOn is set when the feature of the stage comes with the sidecar, the stage not being run
+	AlwaysOn    bool               `json:"always_on,omitempty"`
+	QPS         float64            `json:"qps,omitempty"`
+	LatenciesMs benchmarkLatencies `json:"latencies_ms"`
+	// OverheadMs is the overhead of the feature of the stage, compared to the stage run before it,
+	// and CumulativeOverheadMs the overhead of the mesh up to the stage, compared to the baseline
+	OverheadMs           *benchmarkLatencies `json:"overhead_ms,omitempty"`
+	CumulativeOverheadMs *benchmarkLatencies `json:"cumulative_overhead_ms,omitempty"`
+}
+
+// meshOverheadReport is the report of the mesh overhead benchmark
+type meshOverheadReport struct {
+	Mesh   string              `json:"mesh"`
+	URL    string              `json:"url"`
+	Stages []meshOverheadStage `json:"stages"`
+}
+
+var benchmarkCmd = &cobra.Command{
+	Use:   "benchmark",
+	Short: "Run standardized benchmark suites",
+	Long:  `Run standardized suites of performance tests against the performance test target deployed by Meshery`,
+	Example: `
+// Measure the overhead of the sidecar, mTLS and telemetry of Istio
+mesheryctl perf benchmark mesh-overhead --mesh istio
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var benchmarkMeshOverheadCmd = &cobra.Command{
+	Use:   "mesh-overhead",
+	Short: "Measure the overhead of the features of a service mesh",
+	Long: `Measure the latency the features of a service mesh add, running the same performance test against the
+echo server deployed with "mesheryctl perf target deploy" without sidecar, with the sidecar, with mTLS and with
+telemetry in turn. The overhead of every feature is reported against the stage before it and against the baseline.
+Features the mesh enables along with the sidecar are reported as always on. The requests go through the proxy
+deployed in front of the echo server, so that they cross the mesh between two sidecars.`,
+	Args: cobra.NoArgs,
+	Example: `
+// Measure the overhead of Istio with the echo server deployed in the meshery-perf namespace
+mesheryctl perf benchmark mesh-overhead --mesh istio
+
+// Run every stage at 500 queries per second with 4 connections for a minute, reporting in JSON
+mesheryctl perf benchmark mesh-overhead --mesh linkerd --namespace perf --qps 500 --concurrent-requests 4 --duration 1m -o json
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+		if _, err := time.ParseDuration(benchmarkDuration); err != nil {
+			return errors.Wrap(err, utils.PerfError("invalid --duration"))
+		}
+		baseURL := mctlCfg.GetBaseMesheryURL()
+
+		targets, err := fetchPerfTargets(baseURL)
+		if err != nil {
+			return err
+		}
+		var target *models.PerfTarget
+		for i := range targets {
+			if targets[i].Namespace == benchmarkNamespace {
+				target = &targets[i]
+			}
+		}
+		if target == nil {
+			return errors.New(utils.PerfError(fmt.Sprintf("no performance test target in namespace %s, deploy one with: mesheryctl perf target deploy --namespace %s", benchmarkNamespace, benchmarkNamespace)))
+		}
+		if target.MeshURL == "" {
+			return errors.New(utils.PerfError(fmt.Sprintf("the performance test target in namespace %s has no proxy, deploy it again with: mesheryctl perf target deploy --namespace %s", benchmarkNamespace, benchmarkNamespace)))
+		}
+
+		// the profile of the benchmark is created with the flags of the first run
+		client := &http.Client{}
+		profileName = "mesh-overhead-" + strings.ToLower(benchmarkMesh)
+		testURL = target.MeshURL
+		testMesh = benchmarkMesh
+		qps = benchmarkQPS
+		concurrentRequests = benchmarkConcurrentRequests
+		testDuration = benchmarkDuration
+		loadGenerator = benchmarkLoadGenerator
+		profiles, _, err := fetchPerformanceProfiles(baseURL, profileName, "", pageSize, 0)
+		if err != nil {
+			return err
+		}
+		profileID = ""
+		for _, profile := range profiles {
+			if profile.Name == profileName {
+				profileID = profile.ID.String()
+			}
+		}
+		if profileID == "" {
+			if profileID, _, err = createPerformanceProfile(client, mctlCfg); err != nil {
+				return err
+			}
+		}
+
+		var stages []meshOverheadStage
+		var alwaysOn []string
+		for _, stage := range meshOverheadStages {
+			if contains(alwaysOn, stage.name) {
+				utils.Log.Info(fmt.Sprintf("Skipping stage %s, %s always enables it along with the sidecar", stage.name, benchmarkMesh))
+				stages = append(stages, meshOverheadStage{Name: stage.name, AlwaysOn: true})
+				continue
+			}
+
+			meshConfig := stage.config
+			meshConfig.Mesh = benchmarkMesh
+			utils.Log.Info(fmt.Sprintf("Configuring stage %s ...", stage.name))
+			result := &models.PerfTargetMeshConfigurationResult{}
+			if err := configurePerfTargetMesh(baseURL, target.ID.String(), meshConfig, result); err != nil {
+				return err
+			}
+			if !result.Ready {
+				return errors.New(utils.PerfError(fmt.Sprintf("the performance test target in namespace %s did not roll out for stage %s", target.Namespace, stage.name)))
+			}
+			alwaysOn = result.AlwaysOn
+
+			utils.Log.Info(fmt.Sprintf("Running stage %s ...", stage.name))
+			measured, err := runBenchmarkStage(client, baseURL, stage.name)
+			if err != nil {
+				return err
+			}
+			stages = append(stages, *measured)
+		}
+
+		report := &meshOverheadReport{Mesh: benchmarkMesh, URL: target.MeshURL, Stages: computeMeshOverhead(stages)}
+		if outputFormatFlag != "" {
+			return printOutputFormat(report)
+		}
+		printMeshOverheadReport(report)
+		return nil
+	},
+}
+
+// configurePerfTargetMesh has Meshery Server configure the mesh for the performance test target
+func configurePerfTargetMesh(baseURL, targetID string, meshConfig models.PerfTargetMeshConfiguration, out *models.PerfTargetMeshConfigurationResult) error {
+	payload, err := json.Marshal(meshConfig)
+	if err != nil {
+		return ErrFailMarshal(err)
+	}
+	req, err := utils.NewRequest("PUT", baseURL+"/api/perf/targets/"+targetID+"/mesh", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return doPerfTargetRequest(req, out)
+}
+
+// runBenchmarkStage runs a test against the profile and returns the latencies of its result
+func runBenchmarkStage(client *http.Client, baseURL, stage string) (*meshOverheadStage, error) {
+	req, err := utils.NewRequest("GET", baseURL+"/api/user/performance/profiles/"+profileID+"/run", nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Add("name", fmt.Sprintf("%s-%s", profileName, stage))
+	q.Add("loadGenerator", loadGenerator)
+	q.Add("c", concurrentRequests)
+	q.Add("url", testURL)
+	q.Add("qps", qps)
+	q.Add("dur", testDuration[len(testDuration)-1:])
+	q.Add("t", testDuration[:len(testDuration)-1])
+	q.Add("mesh", testMesh)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ErrFailRequest(err)
+	}
+	defer utils.SafeClose(resp.Body)
+	if utils.ContentTypeIsHTML(resp) || resp.StatusCode != http.StatusOK {
+		return nil, ErrFailTestRun()
+	}
+
+	// the run streams its progress as events, the last one holding the result
+	resultID := ""
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		data := strings.TrimPrefix(scanner.Text(), "data: ")
+		if data == scanner.Text() {
+			continue
+		}
+		event := models.LoadTestResponse{}
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return nil, ErrFailUnmarshal(err)
+		}
+		utils.Log.Debug(event.Message)
+		switch event.Status {
+		case models.LoadTestError:
+			return nil, errors.New(utils.PerfError(fmt.Sprintf("stage %s failed: %s", stage, event.Message)))
+		case models.LoadTestSuccess:
+			if event.Result != nil {
+				resultID = event.Result.ID.String()
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	if resultID == "" {
+		return nil, ErrFailTestRun()
+	}
+
+	result, err := fetchPerformanceResult(baseURL, resultID)
+	if err != nil {
+		return nil, err
+	}
+	measured := &meshOverheadStage{Name: stage, ResultID: resultID, QPS: result.RunnerResults.QPS}
+	for _, p := range result.RunnerResults.DurationHistogram.Percentiles {
+		// the durations of fortio are in seconds
+		switch p.Percentile {
+		case 50:
+			measured.LatenciesMs.P50 = p.Value * 1000
+		case 90:
+			measured.LatenciesMs.P90 = p.Value * 1000
+		case 99:
+			measured.LatenciesMs.P99 = p.Value * 1000
+		}
+	}
+	return measured, nil
+}
+
+// computeMeshOverhead sets the overhead of every measured stage, compared to the last measured stage
+// before it and to the first stage, the baseline
+func computeMeshOverhead(stages []meshOverheadStage) []meshOverheadStage {
+	var baseline, previous *meshOverheadStage
+	for i := range stages {
+		stage := &stages[i]
+		if stage.AlwaysOn {
+			continue
+		}
+		if baseline == nil {
+			baseline = stage
+		} else {
+			stage.OverheadMs = subtractLatencies(stage.LatenciesMs, previous.LatenciesMs)
+			stage.CumulativeOverheadMs = subtractLatencies(stage.LatenciesMs, baseline.LatenciesMs)
+		}
+		previous = stage
+	}
+	return stages
+}
+
+func subtractLatencies(a, b benchmarkLatencies) *benchmarkLatencies {
+	return &benchmarkLatencies{P50: a.P50 - b.P50, P90: a.P90 - b.P90, P99: a.P99 - b.P99}
+}
+
+func printMeshOverheadReport(report *meshOverheadReport) {
+	utils.Log.Info(fmt.Sprintf("Overhead of %s measured against %s, latencies in ms", report.Mesh, report.URL))
+	var data [][]string
+	for _, stage := range report.Stages {
+		if stage.AlwaysOn {
+			data = append(data, []string{stage.Name, "always on", "", "", "", "", ""})
+			continue
+		}
+		overhead, cumulative := "", ""
+		if stage.OverheadMs != nil {
+			overhead = fmt.Sprintf("%+.3f / %+.3f", stage.OverheadMs.P50, stage.OverheadMs.P99)
+			cumulative = fmt.Sprintf("%+.3f / %+.3f", stage.CumulativeOverheadMs.P50, stage.CumulativeOverheadMs.P99)
+		}
+		data = append(data, []string{
+			stage.Name,
+			fmt.Sprintf("%.1f", stage.QPS),
+			fmt.Sprintf("%.3f", stage.LatenciesMs.P50),
+			fmt.Sprintf("%.3f", stage.LatenciesMs.P90),
+			fmt.Sprintf("%.3f", stage.LatenciesMs.P99),
+			overhead,
+			cumulative,
+		})
+	}
+	utils.PrintToTable([]string{"STAGE", "QPS", "P50", "P90", "P99", "OVERHEAD P50/P99", "VS BASELINE P50/P99"}, data)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	benchmarkMeshOverheadCmd.Flags().StringVar(&benchmarkMesh, "mesh", "", "(required) Service mesh to measure [istio|linkerd]")
+	benchmarkMeshOverheadCmd.Flags().StringVarP(&benchmarkNamespace, "namespace", "n", models.DefaultPerfTargetNamespace, "(optional) namespace of the performance test target")
+	benchmarkMeshOverheadCmd.Flags().StringVar(&benchmarkQPS, "qps", "0", "(optional) Queries per second of every stage, 0 for as many as possible")
+	benchmarkMeshOverheadCmd.Flags().StringVar(&benchmarkConcurrentRequests, "concurrent-requests", "1", "(optional) Number of parallel requests of every stage")
+	benchmarkMeshOverheadCmd.Flags().StringVar(&benchmarkDuration, "duration", "30s", "(optional) Length of every stage (e.g. 30s, 5m)")
+	benchmarkMeshOverheadCmd.Flags().StringVar(&benchmarkLoadGenerator, "load-generator", "fortio", "(optional) Load-Generator to be used (fortio/wrk2)")
+	_ = benchmarkMeshOverheadCmd.MarkFlagRequired("mesh")
+
+	benchmarkCmd.AddCommand(benchmarkMeshOverheadCmd)
+}
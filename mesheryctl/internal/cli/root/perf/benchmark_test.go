@@ -0,0 +1,89 @@
+package perf
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestComputeMeshOverhead(t *testing.T) {
+	stages := computeMeshOverhead([]meshOverheadStage{
+		{Name: "baseline", LatenciesMs: benchmarkLatencies{P50: 1, P90: 2, P99: 4}},
+		{Name: "sidecar", LatenciesMs: benchmarkLatencies{P50: 1.5, P90: 3, P99: 6}},
+		{Name: "mtls", AlwaysOn: true},
+		{Name: "telemetry", LatenciesMs: benchmarkLatencies{P50: 2, P90: 3.5, P99: 7}},
+	})
+
+	if stages[0].OverheadMs != nil || stages[0].CumulativeOverheadMs != nil {
+		t.Errorf("baseline has an overhead: %+v", stages[0])
+	}
+	if stages[2].OverheadMs != nil {
+		t.Errorf("always on stage has an overhead: %+v", stages[2])
+	}
+	want := map[string][2]benchmarkLatencies{
+		"sidecar":   {{P50: 0.5, P90: 1, P99: 2}, {P50: 0.5, P90: 1, P99: 2}},
+		"telemetry": {{P50: 0.5, P90: 0.5, P99: 1}, {P50: 1, P90: 1.5, P99: 3}},
+	}
+	for _, stage := range stages {
+		w, ok := want[stage.Name]
+		if !ok {
+			continue
+		}
+		if stage.OverheadMs == nil || *stage.OverheadMs != w[0] {
+			t.Errorf("stage %s: got overhead %+v, want %+v", stage.Name, stage.OverheadMs, w[0])
+		}
+		if stage.CumulativeOverheadMs == nil || *stage.CumulativeOverheadMs != w[1] {
+			t.Errorf("stage %s: got cumulative overhead %+v, want %+v", stage.Name, stage.CumulativeOverheadMs, w[1])
+		}
+	}
+}
+
+func TestRunBenchmarkStage(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	defer func(tokenFlag string) { utils.TokenFlag = tokenFlag }(utils.TokenFlag)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+	_ = utils.SetupMeshkitLoggerTesting(t, false)
+
+	resultID := "6b9c1a55-3bd5-4f1b-8d2f-1f3c43e1c6a4"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/run"):
+			if r.URL.Query().Get("name") == "mesh-overhead-istio-mtls" {
+				fmt.Fprint(w, "data: {\"status\": \"error\", \"message\": \"connection refused\"}\n\n")
+				return
+			}
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, "data: {\"status\": \"info\", \"message\": \"Initiating load test\"}\n\n")
+			fmt.Fprintf(w, "data: {\"status\": \"success\", \"result\": {\"meshery_id\": \"%s\"}}\n\n", resultID)
+		case r.URL.Path == "/api/perf/profile/result/"+resultID:
+			fmt.Fprint(w, `{"runner_results": {"ActualQPS": 99.5, "DurationHistogram": {"Percentiles": [
+				{"Percentile": 50, "Value": 0.001}, {"Percentile": 75, "Value": 0.0015},
+				{"Percentile": 90, "Value": 0.002}, {"Percentile": 99, "Value": 0.004}]}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	profileID, profileName, testDuration = "profile", "mesh-overhead-istio", "30s"
+	stage, err := runBenchmarkStage(&http.Client{}, server.URL, "sidecar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stage.ResultID != resultID || stage.QPS != 99.5 || stage.LatenciesMs != (benchmarkLatencies{P50: 1, P90: 2, P99: 4}) {
+		t.Errorf("got %+v", stage)
+	}
+
+	if _, err := runBenchmarkStage(&http.Client{}, server.URL, "mtls"); err == nil || !strings.Contains(err.Error(), "connection refused") {
+		t.Errorf("got error %v, want the error of the run", err)
+	}
+}
@@ -0,0 +1,396 @@
+package perf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	bundleLabels  []string
+	bundleResults []string
+)
+
+// bundleComparison is the comparison of the results of comparison bundles, listing how the
+// environments the results were run in differ
+type bundleComparison struct {
+	Results     []bundleComparisonResult `json:"results"`
+	Differences []environmentDifference  `json:"environment_differences"`
+}
+
+// bundleComparisonResult is a result of the comparison, latencies in ms
+type bundleComparisonResult struct {
+	Source      string                    `json:"source"`
+	ResultID    string                    `json:"result_id"`
+	Name        string                    `json:"name,omitempty"`
+	Mesh        string                    `json:"mesh,omitempty"`
+	ActualQPS   float64                   `json:"actual_qps"`
+	P50         float64                   `json:"p50"`
+	P99         float64                   `json:"p99"`
+	Environment *models.ResultEnvironment `json:"environment"`
+}
+
+// environmentDifference is a property of the environments which differs between the results,
+// with its value for every result
+type environmentDifference struct {
+	Field  string   `json:"field"`
+	Values []string `json:"values"`
+}
+
+var bundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Export and compare results along with the environment they were run in",
+	Long: `Export results to a comparison bundle, holding the results along with the environment each of them
+was run in: the versions of Meshery, Kubernetes and the service meshes, and the number and types of the
+nodes of the cluster. Bundles exported from different clusters or Meshery instances are compared with the
+differences of their environments listed, for the results to be compared fairly.`,
+	Example: `
+// Export results to a bundle, then compare them with the results of a bundle of another cluster
+mesheryctl perf result bundle export 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c -o kind.yaml
+mesheryctl perf result bundle compare kind.yaml gke.yaml
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var bundleExportCmd = &cobra.Command{
+	Use:   "export result-id...",
+	Short: "Export results to a comparison bundle",
+	Long: `Export results, along with the environment each of them was run in, to a comparison bundle written
+to the file given with -o, comparison_bundle.yaml by default, or to the standard output with -o -.
+Labels tell about the environment what Meshery does not record, such as the region of the cluster.`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// Export two results to comparison_bundle.yaml
+mesheryctl perf result bundle export 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c 2c0f9a4e-6d7b-4a3e-9c0b-2b8f3f0e1d5a
+
+// Export a result run on a GKE cluster, labeling its environment
+mesheryctl perf result bundle export 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c --label cloud=gke --label region=us-east1 -o gke.yaml
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// setting up for error formatting
+		cmdUsed = "result"
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+		labels, err := parseBundleLabels(bundleLabels)
+		if err != nil {
+			return err
+		}
+
+		bundle := &models.ComparisonBundle{
+			Version:    models.ComparisonBundleVersion,
+			ExportedAt: time.Now().UTC(),
+			Source:     mctlCfg.GetBaseMesheryURL(),
+		}
+		for _, id := range args {
+			result, err := fetchMesheryResult(mctlCfg.GetBaseMesheryURL(), id)
+			if err != nil {
+				return err
+			}
+			env := models.NewResultEnvironment(result)
+			env.Labels = labels
+			bundle.Results = append(bundle.Results, &models.BundledResult{Environment: env, Result: result})
+		}
+
+		body, err := yaml.Marshal(bundle)
+		if err != nil {
+			return ErrFailMarshal(err)
+		}
+		// The persistent -o flag names the file the bundle is written to
+		file := outputFormatFlag
+		if file == "" {
+			file = "comparison_bundle.yaml"
+		}
+		if file == "-" {
+			fmt.Print(string(body))
+			return nil
+		}
+		if err := os.WriteFile(file, body, 0644); err != nil {
+			return errors.Wrap(err, utils.PerfError(fmt.Sprintf("failed to write %s", file)))
+		}
+		utils.Log.Info(fmt.Sprintf("%d result(s) exported to %s", len(bundle.Results), file))
+		return nil
+	},
+}
+
+var bundleCompareCmd = &cobra.Command{
+	Use:   "compare bundle...",
+	Short: "Compare the results of comparison bundles",
+	Long: `Compare the results of comparison bundles exported from any Meshery instance, given as files, http(s)
+URLs or - for the standard input, with one another and with the results of this Meshery instance given
+with --result. The properties of the environments of the results which differ are listed along with them.`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// Compare the results of bundles exported from two clusters
+mesheryctl perf result bundle compare gke.yaml eks.yaml
+
+// Compare the results of a bundle with a result of this Meshery instance, as JSON
+mesheryctl perf result bundle compare https://example.com/baseline.yaml --result 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c -o json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// setting up for error formatting
+		cmdUsed = "result"
+
+		var results []bundleComparisonResult
+		for _, source := range args {
+			content, err := readTestConfiguration(source)
+			if err != nil {
+				return err
+			}
+			bundle, err := parseComparisonBundle(content, source)
+			if err != nil {
+				return err
+			}
+			for _, bundled := range bundle.Results {
+				results = append(results, compareBundledResult(bundle.Source, bundled))
+			}
+		}
+		if len(bundleResults) > 0 {
+			mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+			if err != nil {
+				return ErrMesheryConfig(err)
+			}
+			for _, id := range bundleResults {
+				result, err := fetchMesheryResult(mctlCfg.GetBaseMesheryURL(), id)
+				if err != nil {
+					return err
+				}
+				bundled := &models.BundledResult{Environment: models.NewResultEnvironment(result), Result: result}
+				results = append(results, compareBundledResult(mctlCfg.GetBaseMesheryURL(), bundled))
+			}
+		}
+
+		comparison := &bundleComparison{Results: results, Differences: environmentDifferences(results)}
+		if outputFormatFlag != "" {
+			return printOutputFormat(comparison)
+		}
+		utils.PrintToTable([]string{"#", "SOURCE", "RESULT", "NAME", "MESH", "QPS", "P50 (MS)", "P99 (MS)"}, bundleComparisonRows(results))
+		if len(comparison.Differences) == 0 {
+			utils.Log.Info("\nThe results were run in the same environment")
+			return nil
+		}
+		utils.Log.Info("\nThe environments of the results differ:")
+		header := []string{"FIELD"}
+		for i := range results {
+			header = append(header, "#"+strconv.Itoa(i+1))
+		}
+		utils.PrintToTable(header, environmentDifferenceRows(comparison.Differences))
+		return nil
+	},
+}
+
+// fetchMesheryResult returns the result with the given id as stored by Meshery
+func fetchMesheryResult(baseURL, resultID string) (*models.MesheryResult, error) {
+	req, err := utils.NewRequest("GET", baseURL+"/api/perf/profile/result/"+resultID+"?output=json", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, ErrFailRequest(err)
+	}
+	defer utils.SafeClose(resp.Body)
+	if utils.ContentTypeIsHTML(resp) {
+		return nil, ErrUnauthenticated()
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrFailReqStatus(resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	result := &models.MesheryResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, ErrFailUnmarshal(err)
+	}
+	return result, nil
+}
+
+// parseBundleLabels parses the labels given as key=value
+func parseBundleLabels(specs []string) (map[string]string, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	labels := map[string]string{}
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, errors.New(utils.PerfError(fmt.Sprintf("invalid --label %q, expected key=value", spec)))
+		}
+		labels[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return labels, nil
+}
+
+// parseComparisonBundle parses the comparison bundle of a YAML or JSON document
+func parseComparisonBundle(content []byte, source string) (*models.ComparisonBundle, error) {
+	bundle := &models.ComparisonBundle{}
+	if err := yaml.Unmarshal(content, bundle); err != nil {
+		return nil, ErrFailUnmarshalFile(err)
+	}
+	if bundle.Version != models.ComparisonBundleVersion || len(bundle.Results) == 0 {
+		return nil, ErrInvalidComparisonBundle(source)
+	}
+	for _, bundled := range bundle.Results {
+		if bundled == nil || bundled.Result == nil {
+			return nil, ErrInvalidComparisonBundle(source)
+		}
+		if bundled.Environment == nil {
+			bundled.Environment = models.NewResultEnvironment(bundled.Result)
+		}
+	}
+	return bundle, nil
+}
+
+// compareBundledResult returns the result of the comparison of a bundled result
+func compareBundledResult(source string, bundled *models.BundledResult) bundleComparisonResult {
+	compared := bundleComparisonResult{
+		Source:      source,
+		ResultID:    bundled.Result.ID.String(),
+		Name:        bundled.Result.Name,
+		Mesh:        bundled.Result.Mesh,
+		Environment: bundled.Environment,
+	}
+	// The stored result is summarized as the results of this Meshery instance are
+	performance := &models.PerformanceResult{}
+	if data, err := json.Marshal(bundled.Result); err == nil && json.Unmarshal(data, performance) == nil {
+		summary := summarizeResult(performance)
+		compared.ActualQPS = summary.ActualQPS
+		compared.P50 = summary.LatenciesMs.P50
+		compared.P99 = summary.LatenciesMs.P99
+	}
+	return compared
+}
+
+// environmentField is a property of the environments the results are compared by
+type environmentField struct {
+	name  string
+	value func(env *models.ResultEnvironment) string
+}
+
+// environmentDifferences returns the properties of the environments whose values differ between
+// the results, the versions of every service mesh and the labels being compared one by one
+func environmentDifferences(results []bundleComparisonResult) []environmentDifference {
+	meshes, labels := map[string]bool{}, map[string]bool{}
+	for _, r := range results {
+		for mesh := range r.Environment.MeshVersions {
+			meshes[mesh] = true
+		}
+		for label := range r.Environment.Labels {
+			labels[label] = true
+		}
+	}
+
+	fields := []environmentField{
+		{"meshery_version", func(env *models.ResultEnvironment) string { return env.MesheryVersion }},
+		{"kubernetes_version", func(env *models.ResultEnvironment) string { return env.KubernetesVersion }},
+		{"load_generator", func(env *models.ResultEnvironment) string { return env.LoadGenerator }},
+		{"nodes", func(env *models.ResultEnvironment) string { return strconv.Itoa(env.Nodes) }},
+		{"node_types", func(env *models.ResultEnvironment) string { return formatNodeTypes(env.NodeTypes) }},
+	}
+	for _, mesh := range sortedKeys(meshes) {
+		mesh := mesh
+		fields = append(fields, environmentField{"mesh_version." + mesh, func(env *models.ResultEnvironment) string {
+			version, ok := env.MeshVersions[mesh]
+			if !ok {
+				return "not installed"
+			}
+			return version
+		}})
+	}
+	for _, label := range sortedKeys(labels) {
+		label := label
+		fields = append(fields, environmentField{"label." + label, func(env *models.ResultEnvironment) string { return env.Labels[label] }})
+	}
+
+	differences := []environmentDifference{}
+	for _, field := range fields {
+		values := make([]string, 0, len(results))
+		differ := false
+		for _, r := range results {
+			value := field.value(r.Environment)
+			if value == "" {
+				value = "unknown"
+			}
+			differ = differ || (len(values) > 0 && value != values[0])
+			values = append(values, value)
+		}
+		if differ {
+			differences = append(differences, environmentDifference{Field: field.name, Values: values})
+		}
+	}
+	return differences
+}
+
+// formatNodeTypes returns the number of nodes of every type, as 3 x m5.large, 1 x c5.xlarge
+func formatNodeTypes(types map[string]int) string {
+	counts := make([]string, 0, len(types))
+	for t, n := range types {
+		counts = append(counts, fmt.Sprintf("%d x %s", n, t))
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return strings.SplitN(counts[i], " x ", 2)[1] < strings.SplitN(counts[j], " x ", 2)[1]
+	})
+	return strings.Join(counts, ", ")
+}
+
+// sortedKeys returns the keys of the set, sorted
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func bundleComparisonRows(results []bundleComparisonResult) [][]string {
+	var data [][]string
+	for i, r := range results {
+		data = append(data, []string{
+			strconv.Itoa(i + 1),
+			r.Source,
+			r.ResultID,
+			r.Name,
+			r.Mesh,
+			fmt.Sprintf("%.1f", r.ActualQPS),
+			fmt.Sprintf("%.3f", r.P50),
+			fmt.Sprintf("%.3f", r.P99),
+		})
+	}
+	return data
+}
+
+func environmentDifferenceRows(differences []environmentDifference) [][]string {
+	var data [][]string
+	for _, d := range differences {
+		data = append(data, append([]string{d.Field}, d.Values...))
+	}
+	return data
+}
+
+func init() {
+	bundleExportCmd.Flags().StringArrayVar(&bundleLabels, "label", []string{}, "(optional) label of the environment of the results, as key=value (e.g. region=us-east1), repeated for every label")
+	bundleCompareCmd.Flags().StringArrayVar(&bundleResults, "result", []string{}, "(optional) ID of a result of this Meshery instance to compare along with the bundles, repeated for every result")
+	bundleCmd.AddCommand(bundleExportCmd, bundleCompareCmd)
+}
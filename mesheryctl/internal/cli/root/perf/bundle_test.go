@@ -0,0 +1,120 @@
+package perf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestCompareBundles(t *testing.T) {
+	kind, err := parseComparisonBundle([]byte(`
+version: v1
+source: http://localhost:9081
+results:
+  - result:
+      meshery_id: 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c
+      name: bookinfo
+      mesh: istio
+      runner_results:
+        ActualQPS: 90
+        DurationHistogram:
+          Percentiles: [{Percentile: 50, Value: 0.002}, {Percentile: 99, Value: 0.01}]
+        load-generator: fortio
+        meshery-version: v0.6.0
+        kubernetes:
+          server_version: v1.23.4
+          nodes:
+            - {architecture: amd64, capacity_cpu: "4", capacity_memory: 8Gi}
+        detected-meshes:
+          istio:
+            - spec: {containers: [{image: docker.io/istio/pilot:1.12.2}]}
+`), "kind.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the environment of results bundled without one is derived from the result
+	env := kind.Results[0].Environment
+	want := &models.ResultEnvironment{
+		MesheryVersion:    "v0.6.0",
+		KubernetesVersion: "v1.23.4",
+		LoadGenerator:     "fortio",
+		Nodes:             1,
+		NodeTypes:         map[string]int{"amd64, 4 CPU, 8Gi": 1},
+		MeshVersions:      map[string]string{"istio": "1.12.2"},
+	}
+	if !reflect.DeepEqual(env, want) {
+		t.Fatalf("environment = %+v, want %+v", env, want)
+	}
+
+	gke := &models.ComparisonBundle{
+		Version: models.ComparisonBundleVersion,
+		Source:  "https://meshery.example.com",
+		Results: []*models.BundledResult{{
+			Environment: &models.ResultEnvironment{
+				MesheryVersion:    "v0.6.0",
+				KubernetesVersion: "v1.22.8-gke.200",
+				LoadGenerator:     "fortio",
+				Nodes:             3,
+				NodeTypes:         map[string]int{"e2-standard-4": 2, "e2-standard-8": 1},
+				MeshVersions:      map[string]string{"istio": "1.12.2", "linkerd": "stable-2.11.1"},
+				Labels:            map[string]string{"region": "us-east1"},
+			},
+			Result: &models.MesheryResult{Name: "bookinfo", Mesh: "istio"},
+		}},
+	}
+	// bundles round trip through the YAML they are exported as
+	data, err := yaml.Marshal(gke)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gke, err = parseComparisonBundle(data, "gke.yaml"); err != nil {
+		t.Fatal(err)
+	}
+
+	results := []bundleComparisonResult{
+		compareBundledResult(kind.Source, kind.Results[0]),
+		compareBundledResult(gke.Source, gke.Results[0]),
+	}
+	if results[0].ActualQPS != 90 || results[0].P50 != 2 || results[0].P99 != 10 {
+		t.Errorf("compared result = %+v, want 90 QPS, p50 of 2ms and p99 of 10ms", results[0])
+	}
+
+	differences := environmentDifferences(results)
+	wantDifferences := []environmentDifference{
+		{Field: "kubernetes_version", Values: []string{"v1.23.4", "v1.22.8-gke.200"}},
+		{Field: "nodes", Values: []string{"1", "3"}},
+		{Field: "node_types", Values: []string{"1 x amd64, 4 CPU, 8Gi", "2 x e2-standard-4, 1 x e2-standard-8"}},
+		{Field: "mesh_version.linkerd", Values: []string{"not installed", "stable-2.11.1"}},
+		{Field: "label.region", Values: []string{"unknown", "us-east1"}},
+	}
+	if !reflect.DeepEqual(differences, wantDifferences) {
+		t.Errorf("environment differences = %+v, want %+v", differences, wantDifferences)
+	}
+	if differences := environmentDifferences(results[:1]); len(differences) != 0 {
+		t.Errorf("environment differences of a single result = %+v, want none", differences)
+	}
+
+	for _, invalid := range []string{`{"version": "v1"}`, `{"version": "v2", "results": [{"result": {}}]}`, `{"version": "v1", "results": [{}]}`} {
+		if _, err := parseComparisonBundle([]byte(invalid), "invalid.json"); err == nil {
+			t.Errorf("bundle %s accepted", invalid)
+		}
+	}
+}
+
+func TestParseBundleLabels(t *testing.T) {
+	labels, err := parseBundleLabels([]string{"region=us-east1", "cloud = gke", "note=a=b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"region": "us-east1", "cloud": "gke", "note": "a=b"}
+	if !reflect.DeepEqual(labels, want) {
+		t.Errorf("labels = %v, want %v", labels, want)
+	}
+	for _, spec := range []string{"region", "=us-east1"} {
+		if _, err := parseBundleLabels([]string{spec}); err == nil {
+			t.Errorf("--label %q accepted", spec)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package perf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	compareAgainst string
+	reportPR       bool
+)
+
+// smpResultSummary is the summary of an SMP result written by an SMP compliant tool, the part
+// of it compared with the results of Meshery. SMP latencies are in milliseconds.
+type smpResultSummary struct {
+	SMPVersion  string        `json:"smp_version,omitempty"`
+	ActualQPS   float64       `json:"actual_qps,omitempty"`
+	LatenciesMs *smpLatencies `json:"latencies_ms,omitempty"`
+}
+
+type smpLatencies struct {
+	Min     float64 `json:"min,omitempty"`
+	Average float64 `json:"average,omitempty"`
+	P50     float64 `json:"p50,omitempty"`
+	P90     float64 `json:"p90,omitempty"`
+	P99     float64 `json:"p99,omitempty"`
+	Max     float64 `json:"max,omitempty"`
+}
+
+// resultComparison is the comparison of a result with an external baseline
+type resultComparison struct {
+	ResultID string           `json:"result_id"`
+	Baseline string           `json:"baseline"`
+	Metrics  []comparedMetric `json:"metrics"`
+}
+
+// comparedMetric is a metric of the result and of the baseline, the change being relative to the baseline
+type comparedMetric struct {
+	Metric        string  `json:"metric"`
+	Result        float64 `json:"result"`
+	Baseline      float64 `json:"baseline"`
+	Difference    float64 `json:"difference"`
+	ChangePercent float64 `json:"change_percent"`
+}
+
+var compareCmd = &cobra.Command{
+	Use:   "compare result-id --against file",
+	Short: "Compare a performance test result with an external baseline",
+	Long: `Compare a performance test result with an SMP result produced by another SMP compliant tool,
+or kept in an archive outside of this Meshery instance. The baseline is a YAML or JSON SMP result file,
+an http(s) URL or - for the standard input. The metrics found in the baseline are compared.
+With --report-pr, the comparison is also posted as a comment of the GitHub pull request or GitLab
+merge request the CI job runs for, with the token of GITHUB_TOKEN or GITLAB_TOKEN.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Compare a result with a result of another tool
+mesheryctl perf result compare 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c --against external.smp.yaml
+
+// Compare a result with a result downloaded from another Meshery instance, as JSON
+mesheryctl perf result compare 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c --against result_2c0f9a4e.yaml -o json
+
+// Compare a result with the baseline of the main branch in CI, reporting to the pull request
+mesheryctl perf result compare 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c --against baseline.smp.yaml --report-pr
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// setting up for error formatting
+		cmdUsed = "result"
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		content, err := readTestConfiguration(compareAgainst)
+		if err != nil {
+			return err
+		}
+		baseline, err := parseSMPResult(content, compareAgainst)
+		if err != nil {
+			return err
+		}
+		result, err := fetchPerformanceResult(mctlCfg.GetBaseMesheryURL(), args[0])
+		if err != nil {
+			return err
+		}
+
+		comparison := compareWithBaseline(args[0], compareAgainst, summarizeResult(result), baseline)
+		if outputFormatFlag != "" {
+			if err := printOutputFormat(comparison); err != nil {
+				return err
+			}
+		} else {
+			utils.Log.Info(fmt.Sprintf("Result %s compared with %s, latencies in ms", args[0], compareAgainst))
+			utils.PrintToTable([]string{"METRIC", "RESULT", "BASELINE", "DIFFERENCE", "CHANGE"}, comparisonToStringArrays(comparison))
+		}
+		if reportPR {
+			return utils.ReportToPullRequest(comparisonToMarkdown(comparison))
+		}
+		return nil
+	},
+}
+
+// parseSMPResult parses the SMP result of a YAML or JSON document, failing when it holds
+// neither latencies nor a rate to compare
+func parseSMPResult(content []byte, source string) (*smpResultSummary, error) {
+	summary := &smpResultSummary{}
+	if err := yaml.Unmarshal(content, summary); err != nil {
+		return nil, ErrFailUnmarshalFile(err)
+	}
+	if summary.LatenciesMs == nil && summary.ActualQPS == 0 {
+		return nil, ErrInvalidBaseline(source)
+	}
+	return summary, nil
+}
+
+// summarizeResult returns the summary of the result as an SMP result
+func summarizeResult(result *models.PerformanceResult) *smpResultSummary {
+	histogram := result.RunnerResults.DurationHistogram
+	// the durations of fortio are in seconds
+	summary := &smpResultSummary{
+		ActualQPS: result.RunnerResults.QPS,
+		LatenciesMs: &smpLatencies{
+			Min:     histogram.Min * 1000,
+			Average: histogram.Average * 1000,
+			Max:     histogram.Max * 1000,
+		},
+	}
+	for _, p := range histogram.Percentiles {
+		switch p.Percentile {
+		case 50:
+			summary.LatenciesMs.P50 = p.Value * 1000
+		case 90:
+			summary.LatenciesMs.P90 = p.Value * 1000
+		case 99:
+			summary.LatenciesMs.P99 = p.Value * 1000
+		}
+	}
+	return summary
+}
+
+// compareWithBaseline compares the metrics of the result with those found in the baseline
+func compareWithBaseline(resultID, source string, result, baseline *smpResultSummary) *resultComparison {
+	comparison := &resultComparison{ResultID: resultID, Baseline: source}
+	add := func(metric string, r, b float64) {
+		if b == 0 {
+			return
+		}
+		comparison.Metrics = append(comparison.Metrics, comparedMetric{
+			Metric:        metric,
+			Result:        r,
+			Baseline:      b,
+			Difference:    r - b,
+			ChangePercent: (r - b) / b * 100,
+		})
+	}
+	add("actual_qps", result.ActualQPS, baseline.ActualQPS)
+	if baseline.LatenciesMs != nil {
+		r, b := result.LatenciesMs, baseline.LatenciesMs
+		add("min", r.Min, b.Min)
+		add("average", r.Average, b.Average)
+		add("p50", r.P50, b.P50)
+		add("p90", r.P90, b.P90)
+		add("p99", r.P99, b.P99)
+		add("max", r.Max, b.Max)
+	}
+	return comparison
+}
+
+func comparisonToStringArrays(comparison *resultComparison) [][]string {
+	var data [][]string
+	for _, m := range comparison.Metrics {
+		data = append(data, []string{
+			m.Metric,
+			fmt.Sprintf("%.3f", m.Result),
+			fmt.Sprintf("%.3f", m.Baseline),
+			fmt.Sprintf("%+.3f", m.Difference),
+			fmt.Sprintf("%+.1f%%", m.ChangePercent),
+		})
+	}
+	return data
+}
+
+// comparisonToMarkdown returns the comparison as a markdown table, for pull request comments
+func comparisonToMarkdown(comparison *resultComparison) string {
+	var b strings.Builder
+	b.WriteString("### Meshery performance comparison\n\n")
+	fmt.Fprintf(&b, "Result `%s` compared with `%s`, latencies in ms.\n\n", comparison.ResultID, comparison.Baseline)
+	b.WriteString("| Metric | Result | Baseline | Difference | Change |\n")
+	b.WriteString("| --- | ---: | ---: | ---: | ---: |\n")
+	for _, row := range comparisonToStringArrays(comparison) {
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(row, " | "))
+	}
+	return b.String()
+}
+
+func init() {
+	compareCmd.Flags().StringVar(&compareAgainst, "against", "", "(required) SMP result file, http(s) URL or - for stdin to compare the result with")
+	_ = compareCmd.MarkFlagRequired("against")
+	compareCmd.Flags().BoolVar(&reportPR, "report-pr", false, "(optional) post the comparison as a comment of the pull or merge request the CI job runs for")
+}
@@ -0,0 +1,51 @@
+package perf
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/models"
+)
+
+func TestCompareWithBaseline(t *testing.T) {
+	baseline, err := parseSMPResult([]byte(`
+smp_version: v0.0.1
+actual_qps: 100
+latencies_ms:
+  p50: 2
+  p99: 10
+`), "external.smp.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := &models.PerformanceResult{}
+	if err := json.Unmarshal([]byte(`{"runner_results": {"ActualQPS": 90, "DurationHistogram": {"Avg": 0.003,
+		"Percentiles": [{"Percentile": 50, "Value": 0.0025}, {"Percentile": 99, "Value": 0.008}]}}}`), result); err != nil {
+		t.Fatal(err)
+	}
+
+	// only the metrics of the baseline are compared, the latencies in milliseconds
+	comparison := compareWithBaseline("id", "external.smp.yaml", summarizeResult(result), baseline)
+	want := map[string][2]float64{"actual_qps": {90, -10}, "p50": {2.5, 25}, "p99": {8, -20}}
+	if len(comparison.Metrics) != len(want) {
+		t.Fatalf("compared %v, want %v", comparison.Metrics, want)
+	}
+	for _, m := range comparison.Metrics {
+		w, ok := want[m.Metric]
+		if !ok || math.Abs(m.Result-w[0]) > 1e-9 || math.Abs(m.ChangePercent-w[1]) > 1e-9 {
+			t.Errorf("%s = %v (%+.1f%%), want %v (%+.1f%%)", m.Metric, m.Result, m.ChangePercent, w[0], w[1])
+		}
+	}
+
+	markdown := comparisonToMarkdown(comparison)
+	if !strings.Contains(markdown, "| actual_qps | 90.000 | 100.000 | -10.000 | -10.0% |") {
+		t.Errorf("markdown report without the actual_qps row:\n%s", markdown)
+	}
+
+	if _, err := parseSMPResult([]byte(`{"smp_version": "v0.0.1"}`), "empty.json"); err == nil {
+		t.Error("result without metrics accepted as baseline")
+	}
+}
@@ -0,0 +1,134 @@
+package perf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var rawDownloadFlag bool
+
+var downloadCmd = &cobra.Command{
+	Use:   "download result-id",
+	Short: "Download a performance test result",
+	Long: `Download a performance test result in SMP format, or with --raw the raw data of the run.
+The raw data is written as HdrHistogram percentile distribution when the file given with -o ends in .hgrm,
+to be read by the HdrHistogram plotter and similar tools, and as the JSON of the load generator otherwise.
+Use -o - to write to the standard output.`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Download a result in SMP format to result_<result-id>.yaml
+mesheryctl perf result download 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c
+
+// Download the latency histogram of a result for HdrHistogram tooling
+mesheryctl perf result download 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c --raw -o run.hgrm
+
+// Download the raw results of the load generator
+mesheryctl perf result download 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c --raw -o run.json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// setting up for error formatting
+		cmdUsed = "result"
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		// The persistent -o flag names the file the result is written to
+		resultID := args[0]
+		file := outputFormatFlag
+		if file == "" {
+			file = "result_" + resultID + ".yaml"
+			if rawDownloadFlag {
+				file = "result_" + resultID + ".json"
+			}
+		}
+
+		output := ""
+		if rawDownloadFlag {
+			output = "json"
+			if strings.EqualFold(filepath.Ext(file), ".hgrm") {
+				output = "hgrm"
+			}
+		}
+		body, err := downloadResult(mctlCfg.GetBaseMesheryURL(), resultID, output)
+		if err != nil {
+			return err
+		}
+
+		if file == "-" {
+			fmt.Print(string(body))
+			return nil
+		}
+		if err := os.WriteFile(file, body, 0644); err != nil {
+			return errors.Wrap(err, utils.PerfError(fmt.Sprintf("failed to write %s", file)))
+		}
+		utils.Log.Info("Result downloaded to ", file)
+		return nil
+	},
+}
+
+// downloadResult fetches the result with the given id in SMP format, or when the
+// output is json the results of the load generator and when hgrm its latency histogram
+func downloadResult(baseURL, resultID, output string) ([]byte, error) {
+	client := &http.Client{}
+	url := baseURL + "/api/perf/profile/result/" + resultID
+	if output != "" {
+		url += "?output=" + output
+	}
+	req, err := utils.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ErrFailRequest(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrFailReqStatus(resp.StatusCode)
+	}
+	defer utils.SafeClose(resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	if output != "json" {
+		return body, nil
+	}
+
+	// Only the results of the load generator are kept, leaving out the metadata
+	// Meshery stores along with them
+	result := &models.MesheryResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, ErrFailUnmarshal(err)
+	}
+	delete(result.Result, "run-configuration")
+	raw, err := json.Marshal(result.Result)
+	if err != nil {
+		return nil, ErrFailMarshal(err)
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, raw, "", "  "); err != nil {
+		return nil, ErrFailUnmarshal(err)
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+func init() {
+	downloadCmd.Flags().BoolVarP(&rawDownloadFlag, "raw", "", false, "(optional) download the raw data of the run instead of the SMP result")
+}
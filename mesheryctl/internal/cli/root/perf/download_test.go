@@ -0,0 +1,111 @@
+package perf
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestDownloadCmd(t *testing.T) {
+	utils.SetupContextEnv(t)
+	utils.StartMockery(t)
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures", "result")
+	testToken := filepath.Join(currDir, "fixtures", "auth.json")
+	testdataDir := filepath.Join(currDir, "testdata", "result")
+
+	resultID := "8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c"
+	resultURL := testContext.BaseURL + "/api/perf/profile/result/" + resultID
+	hgrmFile := filepath.Join(t.TempDir(), "run.hgrm")
+
+	tests := []struct {
+		Name             string
+		Args             []string
+		URLs             []utils.MockURL
+		ExpectedResponse string
+		// File is the file the result is downloaded to, the standard output when empty
+		File        string
+		ExpectError bool
+	}{
+		{"SMP result", []string{"result", "download", resultID, "-o", "-"}, []utils.MockURL{
+			{Method: "GET", URL: resultURL, Response: "download.smp.golden", ResponseCode: 200},
+		}, "download.smp.output.golden", "", false},
+		// the metadata stored by Meshery along with the results of the load generator is left out
+		{"raw result", []string{"result", "download", resultID, "--raw", "-o", "-"}, []utils.MockURL{
+			{Method: "GET", URL: resultURL + "?output=json", Response: "download.raw.golden", ResponseCode: 200},
+		}, "download.raw.output.golden", "", false},
+		{"latency histogram of the raw result", []string{"result", "download", resultID, "--raw", "-o", hgrmFile}, []utils.MockURL{
+			{Method: "GET", URL: resultURL + "?output=hgrm", Response: "download.hgrm.golden", ResponseCode: 200},
+		}, "download.hgrm.output.golden", hgrmFile, false},
+		{"result not found", []string{"result", "download", resultID, "-o", "-"}, []utils.MockURL{
+			{Method: "GET", URL: resultURL, Response: "download.smp.golden", ResponseCode: 404},
+		}, "download.notfound.output.golden", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			utils.TokenFlag = testToken
+			defer resetVariables()
+
+			for _, mock := range tt.URLs {
+				apiResponse := utils.NewGoldenFile(t, mock.Response, fixturesDir).Load()
+				httpmock.RegisterResponder(mock.Method, mock.URL,
+					httpmock.NewStringResponder(mock.ResponseCode, apiResponse))
+			}
+
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+			_ = utils.SetupMeshkitLoggerTesting(t, false)
+
+			// Grab console prints
+			rescueStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			PerfCmd.SetArgs(tt.Args)
+			PerfCmd.SetOutput(rescueStdout)
+			err := PerfCmd.Execute()
+
+			w.Close()
+			out, _ := io.ReadAll(r)
+			os.Stdout = rescueStdout
+
+			if err != nil {
+				if tt.ExpectError {
+					if *update {
+						golden.Write(err.Error())
+					}
+					utils.Equals(t, golden.Load(), err.Error())
+					return
+				}
+				t.Fatal(err)
+			}
+
+			actualResponse := string(out)
+			if tt.File != "" {
+				data, err := os.ReadFile(tt.File)
+				if err != nil {
+					t.Fatal(err)
+				}
+				actualResponse = string(data)
+			}
+			if *update {
+				golden.Write(actualResponse)
+			}
+			utils.Equals(t, golden.Load(), actualResponse)
+		})
+	}
+
+	utils.StopMockery(t)
+}
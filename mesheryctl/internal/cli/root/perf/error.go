@@ -3,6 +3,7 @@ package perf
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/layer5io/meshkit/errors"
 )
@@ -24,6 +25,20 @@ const (
 	ErrUnauthenticatedCode       = "1040"
 	ErrFailUnmarshalFileCode     = "1041"
 	ErrInvalidTestConfigFileCode = "1042"
+	ErrNoRunConfigurationCode    = "1052"
+	ErrTestConfigTooLargeCode    = "1054"
+	ErrTestConfigContentTypeCode = "1055"
+	ErrInvalidTestConfigCode     = "1056"
+	ErrPerfTargetCode            = "1064"
+	ErrTestRejectedCode          = "1066"
+	ErrInvalidBaselineCode       = "1069"
+	ErrUnknownTemplateCode       = "1078"
+	ErrProductionTargetCode      = "1083"
+	ErrTargetProbeCode           = "1084"
+	ErrComparisonBundleCode      = "1090"
+	ErrResultHookCode            = "1091"
+	ErrTrendReportCode           = "1095"
+	ErrWorkspaceQuotaCode        = "1096"
 )
 
 func ErrMesheryConfig(err error) error {
@@ -105,6 +120,48 @@ func ErrInvalidTestConfigFile() error {
 		[]string{"invalid test conffigration file", formatErrorWithReference()}, []string{"the test configuration is outdated or incorrect"}, []string{"see https://docs.meshery.io/guides/performance-management#running-performance-benchmarks-through-mesheryctl for a valid configuration file"})
 }
 
+func ErrInvalidTestConfig(problems []string) error {
+	return errors.New(ErrInvalidTestConfigCode, errors.Alert, []string{},
+		[]string{"invalid test configuration:\n  " + strings.Join(problems, "\n  "), formatErrorWithReference()},
+		[]string{"the test configuration does not follow the SMP specification"}, []string{"see https://docs.meshery.io/guides/performance-management#running-performance-benchmarks-through-mesheryctl for a valid configuration file"})
+}
+
+func ErrNoRunConfiguration(resultID string) error {
+	return errors.New(ErrNoRunConfigurationCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("unable to reconstruct the test configuration of result %s", resultID), formatErrorWithReference()},
+		[]string{"the result does not record the endpoint it was run against"}, []string{"pass the test configuration with flags or use a performance profile instead"})
+}
+
+func ErrTestConfigTooLarge(source string) error {
+	return errors.New(ErrTestConfigTooLargeCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("test configuration from %s exceeds %d bytes", source, maxTestConfigSize), formatErrorWithReference()},
+		[]string{"the source does not hold a single SMP test configuration"}, []string{"check that the URL or the input points to an SMP test configuration"})
+}
+
+func ErrInvalidTestConfigContentType(url, contentType string) error {
+	return errors.New(ErrTestConfigContentTypeCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("test configuration at %s is served as %s", url, contentType), formatErrorWithReference()},
+		[]string{"the URL points to a web page rather than to the raw test configuration"}, []string{"use the URL of the raw YAML or JSON file, e.g. raw.githubusercontent.com for files on GitHub"})
+}
+
+func ErrPerfTarget(statusCode int, message string) error {
+	return errors.New(ErrPerfTargetCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("Response Status Code %d, unable to manage the performance test target: %s", statusCode, strings.TrimSpace(message)), formatErrorWithReference()},
+		[]string{"the Kubernetes context does not exist or Meshery is not allowed to deploy to the namespace"}, []string{"check the --cluster and --namespace flags, and the permissions of Meshery in the cluster"})
+}
+
+func ErrTestRejected(message string) error {
+	return errors.New(ErrTestRejectedCode, errors.Alert, []string{},
+		[]string{"performance test rejected by Meshery Server: " + strings.TrimSpace(message), formatErrorWithReference()},
+		[]string{"the QPS, duration or concurrent connections of the test exceed the limits of Meshery Server"}, []string{"lower the --qps, --duration or --concurrent-requests of the test, or ask the operator of Meshery Server to raise its limits"})
+}
+
+func ErrInvalidBaseline(source string) error {
+	return errors.New(ErrInvalidBaselineCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("%s holds no SMP result to compare with", source), formatErrorWithReference()},
+		[]string{"the file is not an SMP result, or its result has neither latencies_ms nor actual_qps"}, []string{"give an SMP result file, such as a file written by mesheryctl perf result download"})
+}
+
 func formatErrorWithReference() string {
 	baseURL := "https://docs.meshery.io/reference/mesheryctl/perf"
 	switch cmdUsed {
@@ -117,3 +174,47 @@ func formatErrorWithReference() string {
 	}
 	return fmt.Sprintf("\nSee %s for usage details\n", baseURL)
 }
+
+func ErrUnknownTemplate(name string, available []string) error {
+	return errors.New(ErrUnknownTemplateCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("no test template named %s", name), formatErrorWithReference()},
+		[]string{"the name of the template is misspelled"}, []string{"use one of the templates shipped with mesheryctl: " + strings.Join(available, ", ")})
+}
+
+func ErrProductionTarget(host string) error {
+	return errors.New(ErrProductionTargetCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("%s appears to be a production host, the test URL giving no port and the host being a public DNS name, run the test with --yes to proceed", host), formatErrorWithReference()},
+		[]string{"the test targets a service serving users, which the load of the test could disrupt"}, []string{"check the estimated load of the test and run it again with --yes to proceed"})
+}
+
+func ErrTargetProbe(message string) error {
+	return errors.New(ErrTargetProbeCode, errors.Alert, []string{},
+		[]string{"the target of the test failed the probe requests sent before it started: " + strings.TrimSpace(message), formatErrorWithReference()},
+		[]string{"the target cannot be reached from Meshery Server, its certificate is not trusted, or it answers with an unexpected status"},
+		[]string{"check the URL, headers and cookies of the test, give the statuses the target answers with as --expect-status, or skip the probe with --skip-probe"})
+}
+
+func ErrInvalidComparisonBundle(source string) error {
+	return errors.New(ErrComparisonBundleCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("%s holds no comparison bundle of results", source), formatErrorWithReference()},
+		[]string{"the file is not a comparison bundle, was written by a newer mesheryctl, or holds no result"}, []string{"give a file written by mesheryctl perf result bundle export"})
+}
+
+func ErrResultHook(statusCode int, message string) error {
+	return errors.New(ErrResultHookCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("Response Status Code %d, unable to manage the result hook: %s", statusCode, strings.TrimSpace(message)), formatErrorWithReference()},
+		[]string{"the hook or the result does not exist, the URL of the hook is invalid, or the hook failed"}, []string{"list the hooks with mesheryctl perf hook list and check that the URL of the hook is reachable from Meshery Server"})
+}
+
+func ErrTrendReport(statusCode int, message string) error {
+	return errors.New(ErrTrendReportCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("Response Status Code %d, unable to get the trend report of the profile: %s", statusCode, strings.TrimSpace(message)), formatErrorWithReference()},
+		[]string{"the profile does not exist, or Meshery Server does not generate trend reports"}, []string{"check the name of the profile with mesheryctl perf profile, and that Meshery Server is up to date"})
+}
+
+func ErrWorkspaceQuota(message string) error {
+	return errors.New(ErrWorkspaceQuotaCode, errors.Alert, []string{},
+		[]string{"quota of the workspace exceeded: " + strings.TrimSpace(message), formatErrorWithReference()},
+		[]string{"the members of your workspace own as many performance profiles or results, or run as many concurrent tests, as its quotas allow"},
+		[]string{"delete the profiles or results your workspace no longer needs, wait for its tests to complete, or ask the operator of Meshery Server to raise its quotas"})
+}
@@ -0,0 +1,154 @@
+package perf
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	// estimatedExchangeBytes is the size assumed for an HTTP request along with its response, headers
+	// included, when estimating the data a test transfers
+	estimatedExchangeBytes = 2048
+	// defaultWSMessageSize is the size of the messages of a WebSocket test when not given
+	defaultWSMessageSize = 64
+)
+
+// privateHostSuffixes are the suffixes of the names which cannot be resolved by public DNS
+var privateHostSuffixes = []string{".local", ".localhost", ".localdomain", ".internal", ".svc", ".lan", ".home.arpa", ".test", ".example", ".invalid"}
+
+// testEstimate is the load a test is expected to put on its target
+type testEstimate struct {
+	// Requests is the number of requests, or messages of a WebSocket test, -1 when the test is not
+	// rate limited and sends as many as the target serves
+	Requests    int64
+	Bytes       int64
+	Duration    time.Duration
+	Connections int64
+}
+
+// estimateTest returns the load the test of the URL puts on its target, from its rate, connections
+// and duration. A WebSocket test sends the messages at the rate of every connection, each echoed back.
+func estimateTest(rawURL, rawQPS, rawConnections, rawDuration string) (*testEstimate, error) {
+	if rawDuration == "" {
+		rawDuration = "30s"
+	}
+	duration, err := time.ParseDuration(rawDuration)
+	if err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("invalid --duration"))
+	}
+	estimate := &testEstimate{Duration: duration, Connections: 1, Requests: -1}
+	if rawConnections != "" {
+		if estimate.Connections, err = strconv.ParseInt(rawConnections, 10, 64); err != nil {
+			return nil, errors.Wrap(err, utils.PerfError("invalid --concurrent-requests"))
+		}
+		if estimate.Connections < 1 {
+			estimate.Connections = 1
+		}
+	}
+
+	rate, exchangeBytes := 0.0, int64(estimatedExchangeBytes)
+	if isWebSocketURL(rawURL) {
+		if wsMessageRate != "" {
+			perConnection, err := strconv.ParseFloat(wsMessageRate, 64)
+			if err != nil {
+				return nil, errors.Wrap(err, utils.PerfError("invalid --ws-message-rate"))
+			}
+			rate = perConnection * float64(estimate.Connections)
+		}
+		exchangeBytes = 2 * defaultWSMessageSize
+		if wsMessageSize != "" {
+			size, err := strconv.ParseInt(wsMessageSize, 10, 64)
+			if err != nil {
+				return nil, errors.Wrap(err, utils.PerfError("invalid --ws-message-size"))
+			}
+			exchangeBytes = 2 * size
+		}
+	} else if rawQPS != "" {
+		if rate, err = strconv.ParseFloat(rawQPS, 64); err != nil {
+			return nil, errors.Wrap(err, utils.PerfError("invalid --qps"))
+		}
+	}
+
+	// an adaptive test searches for the highest rate the target sustains
+	if rate > 0 && !adaptive {
+		estimate.Requests = int64(math.Round(rate * duration.Seconds()))
+		estimate.Bytes = estimate.Requests * exchangeBytes
+	}
+	return estimate, nil
+}
+
+// String describes the load of the test
+func (e *testEstimate) String() string {
+	load := "no rate limit, as many requests as the target serves"
+	if e.Requests >= 0 {
+		load = fmt.Sprintf("%d requests, about %s transferred,", e.Requests, formatBytes(e.Bytes))
+	}
+	return fmt.Sprintf("Estimated load: %s over %s with %d connection(s)", load, e.Duration, e.Connections)
+}
+
+// checkTestEstimate logs the load of the test of every URL and requires --yes to run a test against
+// a target which appears to be a production host
+func checkTestEstimate(urls []string, estimates []*testEstimate) error {
+	for _, estimate := range estimates {
+		utils.Log.Info(estimate.String())
+	}
+	for _, rawURL := range urls {
+		if host, ok := productionHost(rawURL); ok && !utils.SilentFlag {
+			return ErrProductionTarget(host)
+		}
+	}
+	return nil
+}
+
+// productionHost returns the host of the URL when it appears to be a production host: a name
+// resolved by public DNS, the URL giving no port. Tests of local and in-cluster services, of IP
+// addresses and of services on a port of their own are not considered production.
+func productionHost(rawURL string) (string, bool) {
+	if unixSocket != "" {
+		return "", false
+	}
+	withScheme := rawURL
+	if !strings.Contains(rawURL, "://") {
+		withScheme = "http://" + rawURL
+	}
+	u, err := url.Parse(withScheme)
+	if err != nil || u.Port() != "" {
+		return "", false
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" || net.ParseIP(host) != nil || !strings.Contains(host, ".") {
+		return "", false
+	}
+	for _, suffix := range privateHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return "", false
+		}
+	}
+	return host, true
+}
+
+func isWebSocketURL(rawURL string) bool {
+	return strings.HasPrefix(rawURL, "ws://") || strings.HasPrefix(rawURL, "wss://")
+}
+
+// formatBytes returns the size in the largest binary unit it reaches
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit && exp < 4; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTP"[exp])
+}
@@ -0,0 +1,89 @@
+package perf
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestEstimateTest(t *testing.T) {
+	defer resetVariables()
+
+	tests := []struct {
+		name        string
+		url         string
+		qps         string
+		connections string
+		duration    string
+		wsRate      string
+		wsSize      string
+		want        testEstimate
+	}{
+		{name: "a rate limited test", url: "http://localhost:9080", qps: "50", connections: "4", duration: "10m",
+			want: testEstimate{Requests: 30000, Bytes: 30000 * estimatedExchangeBytes, Duration: 10 * time.Minute, Connections: 4}},
+		{name: "a test without rate limit", url: "http://localhost:9080", qps: "0", connections: "2", duration: "30s",
+			want: testEstimate{Requests: -1, Duration: 30 * time.Second, Connections: 2}},
+		{name: "a WebSocket test", url: "ws://localhost:9080/chat", connections: "10", duration: "1m", wsRate: "5", wsSize: "256",
+			want: testEstimate{Requests: 3000, Bytes: 3000 * 512, Duration: time.Minute, Connections: 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wsMessageRate, wsMessageSize = tt.wsRate, tt.wsSize
+			got, err := estimateTest(tt.url, tt.qps, tt.connections, tt.duration)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *got != tt.want {
+				t.Errorf("estimateTest() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+	wsMessageRate, wsMessageSize = "", ""
+
+	if _, err := estimateTest("http://localhost:9080", "10", "1", "forever"); err == nil {
+		t.Error("expected an invalid duration to fail")
+	}
+	estimate := testEstimate{Requests: 30000, Bytes: 30000 * estimatedExchangeBytes, Duration: 10 * time.Minute, Connections: 4}
+	if want := "30000 requests, about 58.6 MiB transferred, over 10m0s with 4 connection(s)"; !strings.Contains(estimate.String(), want) {
+		t.Errorf("expected the estimate to read %q, got %q", want, estimate.String())
+	}
+}
+
+func TestProductionHost(t *testing.T) {
+	tests := map[string]bool{
+		"https://shop.example.com/cart":         true,
+		"www.google.com":                        true,
+		"https://shop.example.com:8443/cart":    false,
+		"http://localhost/productpage":          false,
+		"http://192.168.1.15/productpage":       false,
+		"http://[2001:db8::1]/productpage":      false,
+		"http://productpage/reviews":            false,
+		"http://web.shop.svc.cluster.local/api": false,
+		"http://printer.local":                  false,
+	}
+	for rawURL, want := range tests {
+		if _, got := productionHost(rawURL); got != want {
+			t.Errorf("productionHost(%q) = %v, want %v", rawURL, got, want)
+		}
+	}
+}
+
+func TestCheckTestEstimate(t *testing.T) {
+	defer func(silent bool) { utils.SilentFlag = silent }(utils.SilentFlag)
+	utils.SetupMeshkitLoggerTesting(t, false)
+
+	estimates := []*testEstimate{{Requests: -1, Duration: 30 * time.Second, Connections: 1}}
+	utils.SilentFlag = false
+	if err := checkTestEstimate([]string{"https://shop.example.com"}, estimates); err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("expected a production host to require --yes, got %v", err)
+	}
+	if err := checkTestEstimate([]string{"http://localhost:9080"}, estimates); err != nil {
+		t.Errorf("expected a local host to run, got %v", err)
+	}
+	utils.SilentFlag = true
+	if err := checkTestEstimate([]string{"https://shop.example.com"}, estimates); err != nil {
+		t.Errorf("expected --yes to run against a production host, got %v", err)
+	}
+}
@@ -0,0 +1,154 @@
+package perf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+// exposedPercentiles are the percentiles of the latencies exposed as the quantiles of the latency gauge
+var exposedPercentiles = []float64{50, 95, 99}
+
+// exposedLabelValue escapes the value of a label of the Prometheus exposition format
+var exposedLabelValue = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// latestResult is the most recent result of a performance profile
+type latestResult struct {
+	Profile models.PerformanceProfile
+	Result  models.PerformanceResult
+}
+
+// fetchLatestPerformanceResults returns the most recent result of every profile found with the search
+// string, of every profile when it is empty, the profiles not run yet being left out
+func fetchLatestPerformanceResults(baseURL, searchString string) ([]latestResult, error) {
+	var latest []latestResult
+	for page := 0; ; page++ {
+		profiles, _, err := fetchPerformanceProfiles(baseURL, searchString, "", pageSize, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, profile := range profiles {
+			if profile.ID == nil {
+				continue
+			}
+			results, _, err := fetchPerformanceProfileResults(baseURL, profile.ID.String(), "", 1, 0, "test_start_time desc")
+			if err != nil {
+				return nil, err
+			}
+			if len(results) == 0 {
+				continue
+			}
+			// an archived result is retrieved from the archive through Meshery Server
+			if err := restoreArchivedResults(baseURL, results); err != nil {
+				return nil, err
+			}
+			latest = append(latest, latestResult{Profile: profile, Result: results[0]})
+		}
+		if len(profiles) < pageSize {
+			return latest, nil
+		}
+	}
+}
+
+// printResultsExposition prints the most recent result of the profiles found with the search string
+// as gauges of the Prometheus exposition format, for the textfile collector or a Pushgateway
+func printResultsExposition(baseURL, searchString string) error {
+	latest, err := fetchLatestPerformanceResults(baseURL, searchString)
+	if err != nil {
+		return err
+	}
+	utils.Log.Info(strings.TrimSuffix(resultsExposition(latest), "\n"))
+	return nil
+}
+
+// resultsExposition renders the results in the Prometheus exposition format, the latencies in seconds
+func resultsExposition(latest []latestResult) string {
+	var b strings.Builder
+	header := func(name, help string) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+	}
+	sample := func(name, labels string, value float64) {
+		fmt.Fprintf(&b, "%s{%s} %s\n", name, labels, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+
+	header("meshery_perf_latency_seconds", "Latency percentiles of the most recent result of the performance profile.")
+	for _, l := range latest {
+		for _, percentile := range exposedPercentiles {
+			if value, ok := resultPercentile(&l.Result, percentile); ok {
+				quantile := strconv.FormatFloat(percentile/100, 'g', -1, 64)
+				sample("meshery_perf_latency_seconds", resultLabels(l)+`,quantile="`+quantile+`"`, value)
+			}
+		}
+	}
+	header("meshery_perf_requests_per_second", "Requests per second of the most recent result of the performance profile.")
+	for _, l := range latest {
+		sample("meshery_perf_requests_per_second", resultLabels(l), l.Result.RunnerResults.QPS)
+	}
+	header("meshery_perf_error_ratio", "Ratio of the requests of the most recent result of the performance profile which failed.")
+	for _, l := range latest {
+		if ratio, ok := resultErrorRatio(&l.Result); ok {
+			sample("meshery_perf_error_ratio", resultLabels(l), ratio)
+		}
+	}
+	header("meshery_perf_result_timestamp_seconds", "Start time of the most recent result of the performance profile, in seconds since the epoch.")
+	for _, l := range latest {
+		if start := l.Result.RunnerResults.StartTime; start != nil {
+			sample("meshery_perf_result_timestamp_seconds", resultLabels(l), float64(start.Unix()))
+		}
+	}
+	return b.String()
+}
+
+// resultLabels returns the labels of the samples of the result
+func resultLabels(l latestResult) string {
+	id := ""
+	if l.Profile.ID != nil {
+		id = l.Profile.ID.String()
+	}
+	return fmt.Sprintf(`profile="%s",profile_id="%s",mesh="%s",load_generator="%s"`,
+		exposedLabelValue.Replace(l.Profile.Name),
+		id,
+		exposedLabelValue.Replace(l.Result.Mesh),
+		exposedLabelValue.Replace(l.Result.RunnerResults.LoadGenerator))
+}
+
+// resultPercentile returns the percentile of the latencies of the result, interpolated within the
+// buckets of the histogram when the load generator did not compute it
+func resultPercentile(result *models.PerformanceResult, percentile float64) (float64, bool) {
+	histogram := result.RunnerResults.DurationHistogram
+	for _, p := range histogram.Percentiles {
+		if p.Percentile == percentile {
+			return p.Value, true
+		}
+	}
+	previous := 0.0
+	for _, bucket := range histogram.Data {
+		if bucket.Percent >= percentile {
+			if bucket.Percent == previous {
+				return bucket.End, true
+			}
+			return bucket.Start + (bucket.End-bucket.Start)*(percentile-previous)/(bucket.Percent-previous), true
+		}
+		previous = bucket.Percent
+	}
+	return 0, false
+}
+
+// resultErrorRatio returns the ratio of the requests of the result answered with other than a 2xx
+// status, or the SERVING status of gRPC health checks, false when the codes were not recorded
+func resultErrorRatio(result *models.PerformanceResult) (float64, bool) {
+	var total, failed int64
+	for code, count := range result.RunnerResults.RetCodes {
+		total += count
+		if !(len(code) == 3 && code[0] == '2') && code != "SERVING" {
+			failed += count
+		}
+	}
+	if total == 0 {
+		return 0, false
+	}
+	return float64(failed) / float64(total), true
+}
@@ -0,0 +1,47 @@
+package perf
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestResultsExposition(t *testing.T) {
+	id := uuid.FromStringOrNil(tempProfileID)
+	results := []models.PerformanceResult{{}, {}}
+	if err := json.Unmarshal([]byte(`[{"mesh": "istio", "runner_results": {"load-generator": "fortio", "ActualQPS": 99.5,
+		"StartTime": "2021-06-01T10:00:00Z", "RetCodes": {"200": 990, "503": 10},
+		"DurationHistogram": {"Percentiles": [{"Percentile": 50, "Value": 0.0025}, {"Percentile": 99, "Value": 0.008}],
+			"Data": [{"Start": 0.001, "End": 0.003, "Percent": 60}, {"Start": 0.003, "End": 0.005, "Percent": 98}, {"Start": 0.005, "End": 0.01, "Percent": 100}]}}},
+		{"runner_results": {"load-generator": "wrk2", "ActualQPS": 20}}]`), &results); err != nil {
+		t.Fatal(err)
+	}
+	latest := []latestResult{
+		{Profile: models.PerformanceProfile{ID: &id, Name: `shop "prod"`}, Result: results[0]},
+		{Profile: models.PerformanceProfile{ID: &id, Name: "no codes"}, Result: results[1]},
+	}
+
+	labels := `profile="shop \"prod\"",profile_id="` + tempProfileID + `",mesh="istio",load_generator="fortio"`
+	noCodes := `profile="no codes",profile_id="` + tempProfileID + `",mesh="",load_generator="wrk2"`
+	want := `# HELP meshery_perf_latency_seconds Latency percentiles of the most recent result of the performance profile.
+# TYPE meshery_perf_latency_seconds gauge
+meshery_perf_latency_seconds{` + labels + `,quantile="0.5"} 0.0025
+meshery_perf_latency_seconds{` + labels + `,quantile="0.95"} 0.004842105263157895
+meshery_perf_latency_seconds{` + labels + `,quantile="0.99"} 0.008
+# HELP meshery_perf_requests_per_second Requests per second of the most recent result of the performance profile.
+# TYPE meshery_perf_requests_per_second gauge
+meshery_perf_requests_per_second{` + labels + `} 99.5
+meshery_perf_requests_per_second{` + noCodes + `} 20
+# HELP meshery_perf_error_ratio Ratio of the requests of the most recent result of the performance profile which failed.
+# TYPE meshery_perf_error_ratio gauge
+meshery_perf_error_ratio{` + labels + `} 0.01
+# HELP meshery_perf_result_timestamp_seconds Start time of the most recent result of the performance profile, in seconds since the epoch.
+# TYPE meshery_perf_result_timestamp_seconds gauge
+meshery_perf_result_timestamp_seconds{` + labels + `} 1622541600
+`
+	if got := resultsExposition(latest); got != want {
+		t.Errorf("resultsExposition() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,298 @@
+package perf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	hookURL      string
+	hookSecret   string
+	hookDisabled bool
+	hookResultID string
+)
+
+var hookCmd = &cobra.Command{
+	Use:   "hook",
+	Short: "Manage the hooks post-processing performance results",
+	Long: `Manage result hooks, webhooks Meshery Server invokes after every performance result of yours is persisted.
+A hook receives the result, as an SMP document for HTTP tests, in a POST request signed with the secret of the hook in the
+X-Meshery-Signature header, and answers with annotations attached to the result, such as custom scores or
+business KPIs: {"annotations": {"apdex": 0.94}}. The annotations are returned along with the JSON of the
+result and exported with it by "mesheryctl perf result bundle export".`,
+	Example: `
+// Post-process every result with a webhook
+mesheryctl perf hook create kpis --url https://kpis.example.com/meshery --secret s3cr3t
+
+// List the result hooks
+mesheryctl perf hook list
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var hookCreateCmd = &cobra.Command{
+	Use:   "create name",
+	Short: "Create or update a result hook",
+	Long: `Create the result hook with the given name, or update it when it exists. The secret of a hook is kept
+when it is updated without one. Disabled hooks are only invoked by "mesheryctl perf hook run".`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Post-process every result with a webhook, signing its requests with a secret
+mesheryctl perf hook create kpis --url https://kpis.example.com/meshery --secret s3cr3t
+
+// Disable the hook
+mesheryctl perf hook create kpis --url https://kpis.example.com/meshery --disabled
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		hook, err := saveResultHook(mctlCfg.GetBaseMesheryURL(), &models.ResultHook{
+			Name:     args[0],
+			URL:      hookURL,
+			Secret:   hookSecret,
+			Disabled: hookDisabled,
+		})
+		if err != nil {
+			return err
+		}
+		if outputFormatFlag != "" {
+			return printOutputFormat(hook)
+		}
+		utils.Log.Info(fmt.Sprintf("Result hook %s saved with ID %s", hook.Name, hook.ID))
+		return nil
+	},
+}
+
+var hookListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the result hooks",
+	Long:  `List the result hooks along with when they were last invoked and the error they then failed with, if any`,
+	Args:  cobra.NoArgs,
+	Example: `
+// List the result hooks
+mesheryctl perf hook list
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		hooks, err := fetchResultHooks(mctlCfg.GetBaseMesheryURL())
+		if err != nil {
+			return err
+		}
+		if outputFormatFlag != "" {
+			return printOutputFormat(hooks)
+		}
+		if len(hooks) == 0 {
+			utils.Log.Info("No result hooks to display")
+			return nil
+		}
+
+		var data [][]string
+		for _, hook := range hooks {
+			enabled := "true"
+			if hook.Disabled {
+				enabled = "false"
+			}
+			invoked := ""
+			if hook.LastInvokedAt != nil {
+				invoked = hook.LastInvokedAt.Format("2006-01-02 15:04:05")
+			}
+			data = append(data, []string{hook.ID.String(), hook.Name, hook.URL, enabled, invoked, hook.LastError})
+		}
+		utils.PrintToTable([]string{"ID", "NAME", "URL", "ENABLED", "LAST-INVOKED", "LAST-ERROR"}, data)
+		return nil
+	},
+}
+
+var hookDeleteCmd = &cobra.Command{
+	Use:   "delete hook-id",
+	Short: "Delete a result hook",
+	Long:  `Delete a result hook, the annotations it attached to results are kept`,
+	Args:  cobra.ExactArgs(1),
+	Example: `
+// Delete a result hook
+mesheryctl perf hook delete 5a8c3c1e-2b4f-4e0a-9d7e-6f1b2c3d4e5f
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		if err := deleteResultHook(mctlCfg.GetBaseMesheryURL(), args[0]); err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("Result hook %s deleted", args[0]))
+		return nil
+	},
+}
+
+var hookRunCmd = &cobra.Command{
+	Use:   "run hook-id",
+	Short: "Run a result hook on a result",
+	Long: `Invoke a result hook, even when disabled, with the result given by --result and attach the annotations
+it answers with to the result, to try a hook or to annotate the results persisted before it was created`,
+	Args: cobra.ExactArgs(1),
+	Example: `
+// Annotate a result with a hook
+mesheryctl perf hook run 5a8c3c1e-2b4f-4e0a-9d7e-6f1b2c3d4e5f --result 8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		annotations, err := runResultHook(mctlCfg.GetBaseMesheryURL(), args[0], hookResultID)
+		if err != nil {
+			return err
+		}
+		if outputFormatFlag != "" {
+			return printOutputFormat(annotations)
+		}
+		if len(annotations) == 0 {
+			utils.Log.Info("The hook attached no annotations to the result")
+			return nil
+		}
+		var data [][]string
+		for _, key := range sortedAnnotationKeys(annotations) {
+			value, err := json.Marshal(annotations[key])
+			if err != nil {
+				return ErrFailMarshal(err)
+			}
+			data = append(data, []string{key, string(value)})
+		}
+		utils.PrintToTable([]string{"ANNOTATION", "VALUE"}, data)
+		return nil
+	},
+}
+
+// saveResultHook has Meshery Server create or update the hook
+func saveResultHook(baseURL string, hook *models.ResultHook) (*models.ResultHook, error) {
+	payload, err := json.Marshal(hook)
+	if err != nil {
+		return nil, ErrFailMarshal(err)
+	}
+	req, err := utils.NewRequest("POST", baseURL+"/api/perf/hooks", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	saved := &models.ResultHook{}
+	if err := doResultHookRequest(req, saved); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// fetchResultHooks returns the result hooks of the user
+func fetchResultHooks(baseURL string) ([]models.ResultHook, error) {
+	req, err := utils.NewRequest("GET", baseURL+"/api/perf/hooks", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := []models.ResultHook{}
+	if err := doResultHookRequest(req, &hooks); err != nil {
+		return nil, err
+	}
+	return hooks, nil
+}
+
+// deleteResultHook has Meshery Server delete the hook with the given id
+func deleteResultHook(baseURL, id string) error {
+	req, err := utils.NewRequest("DELETE", baseURL+"/api/perf/hooks/"+url.PathEscape(id), nil)
+	if err != nil {
+		return err
+	}
+	return doResultHookRequest(req, nil)
+}
+
+// runResultHook has Meshery Server run the hook with the given id on the result and returns the
+// annotations the hook attached to it
+func runResultHook(baseURL, id, resultID string) (models.ResultAnnotations, error) {
+	q := url.Values{}
+	q.Set("result_id", resultID)
+	req, err := utils.NewRequest("POST", baseURL+"/api/perf/hooks/"+url.PathEscape(id)+"/run?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := models.ResultAnnotations{}
+	if err := doResultHookRequest(req, &annotations); err != nil {
+		return nil, err
+	}
+	return annotations, nil
+}
+
+func doResultHookRequest(req *http.Request, out interface{}) error {
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return ErrFailRequest(err)
+	}
+	defer resp.Body.Close()
+
+	// failsafe for not being authenticated
+	if utils.ContentTypeIsHTML(resp) {
+		return ErrUnauthenticated()
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ErrFailRequest(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return ErrResultHook(resp.StatusCode, string(body))
+	}
+	if out == nil || len(strings.TrimSpace(string(body))) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return ErrFailUnmarshal(err)
+	}
+	return nil
+}
+
+// sortedAnnotationKeys returns the names of the annotations in order
+func sortedAnnotationKeys(annotations models.ResultAnnotations) []string {
+	keys := make([]string, 0, len(annotations))
+	for k := range annotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func init() {
+	hookCreateCmd.Flags().StringVar(&hookURL, "url", "", "(required) http(s) URL the results are posted to")
+	hookCreateCmd.Flags().StringVar(&hookSecret, "secret", "", "(optional) secret the requests to the hook are signed with, as the HMAC-SHA256 of their body")
+	hookCreateCmd.Flags().BoolVar(&hookDisabled, "disabled", false, "(optional) only invoke the hook when run explicitly")
+	_ = hookCreateCmd.MarkFlagRequired("url")
+
+	hookRunCmd.Flags().StringVar(&hookResultID, "result", "", "(required) ID of the result to post-process")
+	_ = hookRunCmd.MarkFlagRequired("result")
+
+	hookCmd.AddCommand(hookCreateCmd, hookListCmd, hookDeleteCmd, hookRunCmd)
+}
@@ -0,0 +1,87 @@
+package perf
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestResultHookRequests(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	defer func(tokenFlag string) { utils.TokenFlag = tokenFlag }(utils.TokenFlag)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+
+	id := uuid.Must(uuid.FromString("5a8c3c1e-2b4f-4e0a-9d7e-6f1b2c3d4e5f"))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/perf/hooks" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode([]models.ResultHook{{ID: &id, Name: "kpis", URL: "https://kpis.example.com"}})
+		case r.URL.Path == "/api/perf/hooks" && r.Method == http.MethodPost:
+			hook := models.ResultHook{}
+			_ = json.NewDecoder(r.Body).Decode(&hook)
+			if err := hook.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			hook.ID = &id
+			hook.Secret = ""
+			_ = json.NewEncoder(w).Encode(hook)
+		case r.URL.Path == "/api/perf/hooks/"+id.String() && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusOK)
+		case r.URL.Path == "/api/perf/hooks/"+id.String()+"/run" && r.Method == http.MethodPost:
+			_ = json.NewEncoder(w).Encode(models.ResultAnnotations{"result": r.URL.Query().Get("result_id"), "apdex": 0.94})
+		default:
+			http.Error(w, "no result hook", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	hook, err := saveResultHook(server.URL, &models.ResultHook{Name: "kpis", URL: "https://kpis.example.com", Secret: "s3cr3t"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hook.ID == nil || *hook.ID != id || hook.Secret != "" {
+		t.Errorf("unexpected saved hook %+v", hook)
+	}
+	_, err = saveResultHook(server.URL, &models.ResultHook{Name: "kpis", URL: "ftp://kpis.example.com"})
+	if err == nil || !strings.Contains(err.Error(), "invalid URL") {
+		t.Errorf("expected the error of the server, got %v", err)
+	}
+
+	hooks, err := fetchResultHooks(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hooks) != 1 || hooks[0].Name != "kpis" {
+		t.Errorf("unexpected hooks %+v", hooks)
+	}
+
+	annotations, err := runResultHook(server.URL, id.String(), "8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if annotations["result"] != "8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c" || annotations["apdex"] != 0.94 {
+		t.Errorf("unexpected annotations %v", annotations)
+	}
+	if keys := sortedAnnotationKeys(annotations); strings.Join(keys, ",") != "apdex,result" {
+		t.Errorf("annotation keys = %v, want apdex and result", keys)
+	}
+
+	if err := deleteResultHook(server.URL, id.String()); err != nil {
+		t.Fatal(err)
+	}
+	if err := deleteResultHook(server.URL, "missing"); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected a 404 deleting a missing hook, got %v", err)
+	}
+}
@@ -50,6 +50,12 @@ mesheryctl perf result sam-test
 // Display Perf profile in JSON or YAML
 mesheryctl perf result -o json
 mesheryctl perf result -o yaml
+
+// Deploy an echo server as a known-good target of performance tests
+mesheryctl perf target deploy --cluster kind-meshery --namespace perf
+
+// Measure the overhead of the sidecar, mTLS and telemetry of a service mesh against the deployed echo server
+mesheryctl perf benchmark mesh-overhead --mesh istio
 	`,
 	Args: cobra.MinimumNArgs(1),
 	PreRunE: func(cmd *cobra.Command, args []string) error {
@@ -75,9 +81,14 @@ mesheryctl perf result -o yaml
 
 func init() {
 	PerfCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "(required) Path to meshery auth config")
-	PerfCmd.PersistentFlags().StringVarP(&outputFormatFlag, "output-format", "o", "", "(optional) format to display in [json|yaml]")
+	PerfCmd.PersistentFlags().StringVarP(&outputFormatFlag, "output-format", "o", "", "(optional) format to display in [json|yaml], or prometheus for the most recent results of perf result")
 	PerfCmd.PersistentFlags().BoolVarP(&utils.SilentFlag, "yes", "y", false, "(optional) assume yes for user interactive prompts.")
 
-	availableSubcommands = []*cobra.Command{profileCmd, resultCmd, applyCmd}
+	availableSubcommands = []*cobra.Command{profileCmd, resultCmd, applyCmd, targetCmd, benchmarkCmd, hookCmd}
 	PerfCmd.AddCommand(availableSubcommands...)
+
+	// the other commands write to the file given with --output-format
+	for _, cmd := range []*cobra.Command{profileCmd, profileTrendCmd, resultCmd, compareCmd, bundleCompareCmd, targetDeployCmd, targetListCmd, benchmarkMeshOverheadCmd, hookCreateCmd, hookListCmd, hookRunCmd} {
+		utils.SetOutputFormats(cmd, "json", "yaml")
+	}
 }
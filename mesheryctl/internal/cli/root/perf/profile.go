@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	neturl "net/url"
 	"strconv"
 	"strings"
 
@@ -23,6 +24,10 @@ import (
 var (
 	pageSize          = 25
 	viewSingleProfile bool
+	// ownerFlag only lists the profiles or results of the user
+	ownerFlag string
+	// transferToFlag transfers the ownership of the listed profiles or results to the user
+	transferToFlag string
 )
 
 var profileCmd = &cobra.Command{
@@ -39,6 +44,16 @@ mesheryctl perf profile test 2
 
 // View single performance profile with detailed information
 mesheryctl perf profile test --view
+
+// Restore a deleted performance profile
+mesheryctl perf profile restore 8a4f4b48
+
+// View the weekly trend report of the p99 latency of a performance profile
+mesheryctl perf profile trend saturday-profile
+
+// List the performance profiles of a user, and transfer them to another user
+mesheryctl perf profile --owner alice@example.com
+mesheryctl perf profile --owner alice@example.com --transfer-to bob@example.com
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// used for searching performance profile
@@ -58,16 +73,32 @@ mesheryctl perf profile test --view
 		// Merge args to get profile-name
 		searchString = strings.Join(args, "%20")
 
-		profiles, _, err := fetchPerformanceProfiles(mctlCfg.GetBaseMesheryURL(), searchString, pageSize, pageNumber-1)
+		profiles, _, err := fetchPerformanceProfiles(mctlCfg.GetBaseMesheryURL(), searchString, ownerFlag, pageSize, pageNumber-1)
 		if err != nil {
 			return err
 		}
 
+		// a complete listing keeps the names offered by shell completion up to date
+		if searchString == "" && ownerFlag == "" && pageNumber == 1 && len(profiles) < pageSize {
+			utils.UpdateCompletionCache(mctlCfg.GetBaseMesheryURL(), utils.CompletionProfiles, profileNamesOf(profiles))
+		}
+
 		if len(profiles) == 0 {
 			utils.Log.Info("No Performance Profiles to display")
 			return nil
 		}
 
+		if transferToFlag != "" {
+			transfer := &models.OwnershipTransfer{Kind: models.OwnedPerformanceProfile, From: ownerFlag, To: transferToFlag}
+			// the profiles matching the name, or every profile of the owner
+			if searchString != "" {
+				for _, profile := range profiles {
+					transfer.IDs = append(transfer.IDs, profile.ID.String())
+				}
+			}
+			return utils.TransferOwnership(mctlCfg.GetBaseMesheryURL(), transfer)
+		}
+
 		// get profiles as string arrays for printing tabular format profiles
 		data := profilesToStringArrays(profiles)
 
@@ -102,6 +133,9 @@ mesheryctl perf profile test --view
 			fmt.Printf("Test run duration: %v\n", a.Duration)
 			fmt.Printf("QPS: %d\n", a.QPS)
 			fmt.Printf("Service Mesh: %v\n", a.ServiceMesh)
+			if a.UserID != "" {
+				fmt.Printf("Owner: %v\n", a.UserID)
+			}
 			if a.LastRun != nil {
 				fmt.Printf("Last Run: %v\n", a.LastRun.Time.Format("2006-01-02 15:04:05"))
 			} else {
@@ -114,7 +148,7 @@ mesheryctl perf profile test --view
 }
 
 // Fetch performance profiles
-func fetchPerformanceProfiles(baseURL, searchString string, pageSize, pageNumber int) ([]models.PerformanceProfile, []byte, error) {
+func fetchPerformanceProfiles(baseURL, searchString, owner string, pageSize, pageNumber int) ([]models.PerformanceProfile, []byte, error) {
 	client := &http.Client{}
 	var response *models.PerformanceProfilesAPIResponse
 
@@ -125,6 +159,9 @@ func fetchPerformanceProfiles(baseURL, searchString string, pageSize, pageNumber
 	if searchString != "" {
 		url = url + "&search=" + searchString
 	}
+	if owner != "" {
+		url = url + "&owner=" + neturl.QueryEscape(owner)
+	}
 
 	utils.Log.Debug(url)
 
@@ -161,6 +198,31 @@ func fetchPerformanceProfiles(baseURL, searchString string, pageSize, pageNumber
 	return response.Profiles, body, nil
 }
 
+// profileNames returns the names of the performance profiles for shell completion,
+// falling back to the cached names when Meshery Server is unreachable
+func profileNames() []string {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return nil
+	}
+	baseURL := mctlCfg.GetBaseMesheryURL()
+	return utils.CompletionNames(baseURL, utils.CompletionProfiles, func() ([]string, error) {
+		profiles, _, err := fetchPerformanceProfiles(baseURL, "", "", 100, 0)
+		if err != nil {
+			return nil, err
+		}
+		return profileNamesOf(profiles), nil
+	})
+}
+
+func profileNamesOf(profiles []models.PerformanceProfile) []string {
+	names := make([]string, 0, len(profiles))
+	for _, profile := range profiles {
+		names = append(names, profile.Name)
+	}
+	return names
+}
+
 // add profiles as string arrays to print in a tabular format
 func profilesToStringArrays(profiles []models.PerformanceProfile) [][]string {
 	var data [][]string
@@ -226,6 +288,11 @@ func userPrompt(key string, label string, data [][]string) (int, error) {
 }
 
 func init() {
+	profileCmd.ValidArgsFunction = utils.CompleteNames(profileNames)
+	profileCmd.AddCommand(profileRestoreCmd)
+	profileCmd.AddCommand(profileTrendCmd)
 	profileCmd.Flags().BoolVarP(&viewSingleProfile, "view", "", false, "(optional) View single performance profile with more info")
 	profileCmd.Flags().IntVarP(&pageNumber, "page", "p", 1, "(optional) List next set of performance results with --page (default = 1)")
+	profileCmd.Flags().StringVarP(&ownerFlag, "owner", "", "", "(optional) List only the performance profiles owned by the user")
+	profileCmd.Flags().StringVarP(&transferToFlag, "transfer-to", "", "", "(optional) Transfer the ownership of the performance profiles matching the name, or of every profile of --owner, to the user")
 }
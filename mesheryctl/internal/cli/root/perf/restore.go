@@ -0,0 +1,56 @@
+package perf
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var profileRestoreCmd = &cobra.Command{
+	Use:   "restore [profile-id]",
+	Short: "Restore a deleted performance profile",
+	Long: `Restore a deleted performance profile from the trash, with its results, or list the deleted
+profiles when no id is given. Deleted profiles are kept in the trash for the retention of Meshery Server,
+30 days by default, before they are purged.`,
+	Args: cobra.MaximumNArgs(1),
+	Example: `
+// List the deleted performance profiles
+mesheryctl perf profile restore
+
+// Restore a deleted performance profile, its id being given in full or as listed
+mesheryctl perf profile restore 8a4f4b48
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// setting up for error formatting
+		cmdUsed = "profile"
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		if len(args) == 0 {
+			trash, err := utils.GetTrash(mctlCfg.GetBaseMesheryURL(), models.OwnedPerformanceProfile)
+			if err != nil {
+				return err
+			}
+			if len(trash.Items) == 0 {
+				utils.Log.Info("No deleted performance profiles to restore")
+				return nil
+			}
+			utils.PrintTrash(trash)
+			return nil
+		}
+
+		profile, err := utils.RestoreFromTrash(mctlCfg.GetBaseMesheryURL(), models.OwnedPerformanceProfile, args[0])
+		if err != nil {
+			return err
+		}
+		utils.Log.Info(fmt.Sprintf("Performance profile %s (%s) restored", profile.Name, profile.ID))
+		return nil
+	},
+}
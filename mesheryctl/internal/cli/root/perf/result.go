@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	neturl "net/url"
 	"strings"
 	"time"
 
@@ -26,15 +27,21 @@ type resultStruct struct {
 	LatenciesMs   *models.LatenciesMs
 	QPS           int
 	URL           string
-	UserID        *uuid.UUID
+	UserID        *string
 	Duration      string
 	MesheryID     *uuid.UUID
 	LoadGenerator string
+	Snapshots     []models.GrafanaSnapshot
+	Assertions    *models.LoadTestAssertionsResult
 }
 
 var (
-	pageNumber       int
-	viewSingleResult bool
+	pageNumber         int
+	viewSingleResult   bool
+	redactFlag         bool
+	redactionRulesFlag string
+	anomaliesFlag      bool
+	checkpointsFlag    string
 )
 
 var resultCmd = &cobra.Command{
@@ -51,6 +58,21 @@ mesheryctl perf result saturday-profile --page 2
 
 // View single performance result with detailed information
 mesheryctl perf result saturday-profile --view
+
+// List the results of the profile run by a user, and transfer them to another user
+mesheryctl perf result saturday-profile --owner alice@example.com
+mesheryctl perf result saturday-profile --owner alice@example.com --transfer-to bob@example.com
+
+// List the results whose p99 latency deviates from the history of the profile, or of every profile
+mesheryctl perf result saturday-profile --anomalies
+mesheryctl perf result --anomalies
+
+// Expose the most recent result of every profile, or of the profile, as Prometheus gauges
+mesheryctl perf result -o prometheus > /var/lib/node_exporter/textfile/meshery_perf.prom
+mesheryctl perf result saturday-profile -o prometheus | curl --data-binary @- http://pushgateway:9091/metrics/job/meshery
+
+// List the checkpoints of a test run with perf apply --checkpoint, while it runs or after it died
+mesheryctl perf result --checkpoints 0a3c0d6e-8d27-4d0c-9f7e-c6f5b2b6a3a1
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// used for searching performance profile
@@ -63,6 +85,20 @@ mesheryctl perf result saturday-profile --view
 			return ErrMesheryConfig(err)
 		}
 
+		if checkpointsFlag != "" {
+			return listLoadTestCheckpoints(mctlCfg.GetBaseMesheryURL(), checkpointsFlag)
+		}
+
+		// the most recent result of the profiles found by name, of every profile when none is given
+		if outputFormatFlag == "prometheus" {
+			return printResultsExposition(mctlCfg.GetBaseMesheryURL(), strings.ReplaceAll(strings.Join(args, " "), " ", "%20"))
+		}
+
+		// the anomalies of every profile are listed when no profile name is provided
+		if len(args) == 0 && anomaliesFlag {
+			return listResultAnomalies(mctlCfg.GetBaseMesheryURL(), "")
+		}
+
 		// Throw error if a profile name is not provided
 		if len(args) == 0 {
 			return ErrNoProfileName()
@@ -75,7 +111,7 @@ mesheryctl perf result saturday-profile --view
 		// Merge args to get profile-name
 		searchString = strings.Join(args, "%20")
 
-		profiles, _, err := fetchPerformanceProfiles(mctlCfg.GetBaseMesheryURL(), searchString, pageSize, pageNumber-1)
+		profiles, _, err := fetchPerformanceProfiles(mctlCfg.GetBaseMesheryURL(), searchString, "", pageSize, pageNumber-1)
 		if err != nil {
 			return err
 		}
@@ -100,11 +136,29 @@ mesheryctl perf result saturday-profile --view
 			profileID = data[selectedProfileIndex][2]
 		}
 
-		results, _, err := fetchPerformanceProfileResults(mctlCfg.GetBaseMesheryURL(), profileID, pageSize, pageNumber-1)
+		if anomaliesFlag {
+			return listResultAnomalies(mctlCfg.GetBaseMesheryURL(), profileID)
+		}
+
+		results, _, err := fetchPerformanceProfileResults(mctlCfg.GetBaseMesheryURL(), profileID, ownerFlag, pageSize, pageNumber-1, "")
 		if err != nil {
 			return err
 		}
 
+		if transferToFlag != "" {
+			if len(results) == 0 {
+				utils.Log.Info("No Test Results to transfer")
+				return nil
+			}
+			transfer := &models.OwnershipTransfer{Kind: models.OwnedResult, From: ownerFlag, To: transferToFlag}
+			for _, result := range results {
+				if result.MesheryID != nil {
+					transfer.IDs = append(transfer.IDs, result.MesheryID.String())
+				}
+			}
+			return utils.TransferOwnership(mctlCfg.GetBaseMesheryURL(), transfer)
+		}
+
 		if len(data) == 0 {
 			utils.Log.Info("No Test Results to display")
 			return nil
@@ -113,7 +167,19 @@ mesheryctl perf result saturday-profile --view
 		// get performance results in format of string arrays and resultStruct
 		data, expandedData := performanceResultsToStringArrays(results)
 		if outputFormatFlag != "" {
+			if err := restoreArchivedResults(mctlCfg.GetBaseMesheryURL(), results); err != nil {
+				return err
+			}
 			body, _ := json.Marshal(results)
+			if redactFlag {
+				redactor, err := utils.NewRedactorFromFile(redactionRulesFlag)
+				if err != nil {
+					return err
+				}
+				if body, err = redactor.RedactJSON(body); err != nil {
+					return err
+				}
+			}
 			if outputFormatFlag == "yaml" {
 				body, _ = yaml.JSONToYAML(body)
 			} else if outputFormatFlag != "json" {
@@ -131,9 +197,18 @@ mesheryctl perf result saturday-profile --view
 					return err
 				}
 			}
+			// an archived result is retrieved from the archive through Meshery Server
+			if results[index].RunnerResults.Archived != nil {
+				if err := restoreArchivedResults(mctlCfg.GetBaseMesheryURL(), results[index:index+1]); err != nil {
+					return err
+				}
+				_, expandedData = performanceResultsToStringArrays(results)
+			}
 			a := expandedData[index]
 			fmt.Printf("Name: %v\n", a.Name)
-			fmt.Printf("UserID: %s\n", a.UserID.String())
+			if a.UserID != nil {
+				fmt.Printf("UserID: %s\n", *a.UserID)
+			}
 			fmt.Printf("Endpoint: %v\n", a.URL)
 			fmt.Printf("QPS: %v\n", a.QPS)
 			fmt.Printf("Test run duration: %v\n", a.Duration)
@@ -141,19 +216,34 @@ mesheryctl perf result saturday-profile --view
 			fmt.Printf("Start Time: %v\n", fmt.Sprintf("%d-%d-%d %d:%d:%d", int(a.StartTime.Month()), a.StartTime.Day(), a.StartTime.Year(), a.StartTime.Hour(), a.StartTime.Minute(), a.StartTime.Second()))
 			fmt.Printf("Meshery ID: %v\n", a.MesheryID.String())
 			fmt.Printf("Load Generator: %v\n", a.LoadGenerator)
+			for _, snapshot := range a.Snapshots {
+				fmt.Printf("Grafana Snapshot: %v: %v\n", snapshot.Board, snapshot.URL)
+			}
+			if a.Assertions != nil {
+				fmt.Printf("Assertions: %d of %d responses failed\n", a.Assertions.Failed, a.Assertions.Requests)
+				for _, assertion := range a.Assertions.Assertions {
+					fmt.Printf("  %v: %d failed\n", assertion.Assertion, assertion.Failed)
+				}
+			}
 		}
 		return nil
 	},
 }
 
-// Fetch results for a specific profile
-func fetchPerformanceProfileResults(baseURL, profileID string, pageSize, pageNumber int) ([]models.PerformanceResult, []byte, error) {
+// Fetch results for a specific profile, in the order given if any
+func fetchPerformanceProfileResults(baseURL, profileID, owner string, pageSize, pageNumber int, order string) ([]models.PerformanceResult, []byte, error) {
 	client := &http.Client{}
 	var response *models.PerformanceResultsAPIResponse
 
 	url := baseURL + "/api/user/performance/profiles/" + profileID + "/results"
 
 	tempURL := fmt.Sprintf("%s?pageSize=%d&page=%d", url, pageSize, pageNumber)
+	if owner != "" {
+		tempURL = tempURL + "&owner=" + neturl.QueryEscape(owner)
+	}
+	if order != "" {
+		tempURL = tempURL + "&order=" + neturl.QueryEscape(order)
+	}
 
 	req, err := utils.NewRequest("GET", tempURL, nil)
 	if err != nil {
@@ -183,6 +273,198 @@ func fetchPerformanceProfileResults(baseURL, profileID string, pageSize, pageNum
 	return response.Results, body, nil
 }
 
+// restoreArchivedResults replaces the summaries of the archived results, as listed, with the
+// results retrieved from the archive
+func restoreArchivedResults(baseURL string, results []models.PerformanceResult) error {
+	for i, result := range results {
+		if result.RunnerResults.Archived == nil || result.MesheryID == nil {
+			continue
+		}
+		restored, err := fetchPerformanceResult(baseURL, result.MesheryID.String())
+		if err != nil {
+			return err
+		}
+		if restored.UserID == nil {
+			restored.UserID = result.UserID
+		}
+		results[i] = *restored
+	}
+	return nil
+}
+
+// fetchPerformanceResult fetches the result with the given id as stored by Meshery
+func fetchPerformanceResult(baseURL, resultID string) (*models.PerformanceResult, error) {
+	client := &http.Client{}
+	req, err := utils.NewRequest("GET", baseURL+"/api/perf/profile/result/"+resultID+"?output=json", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ErrFailRequest(err)
+	}
+	if utils.ContentTypeIsHTML(resp) {
+		return nil, ErrUnauthenticated()
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrFailReqStatus(resp.StatusCode)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	result := &models.PerformanceResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, ErrFailUnmarshal(err)
+	}
+	return result, nil
+}
+
+// fetchResultAnomalies returns the results found to be anomalies, of the given profile or of every profile
+func fetchResultAnomalies(baseURL, profileID string) ([]models.ResultAnalysis, error) {
+	client := &http.Client{}
+	req, err := utils.NewRequest("GET", baseURL+"/api/perf/results/anomalies", nil)
+	if err != nil {
+		return nil, err
+	}
+	if profileID != "" {
+		q := req.URL.Query()
+		q.Add("profile_id", profileID)
+		req.URL.RawQuery = q.Encode()
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ErrFailRequest(err)
+	}
+	if utils.ContentTypeIsHTML(resp) {
+		return nil, ErrUnauthenticated()
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrFailReqStatus(resp.StatusCode)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	anomalies := []models.ResultAnalysis{}
+	if err := json.Unmarshal(body, &anomalies); err != nil {
+		return nil, ErrFailUnmarshal(err)
+	}
+	return anomalies, nil
+}
+
+// listResultAnomalies prints the results found to be anomalies, in the format given by --output-format if any
+func listResultAnomalies(baseURL, profileID string) error {
+	anomalies, err := fetchResultAnomalies(baseURL, profileID)
+	if err != nil {
+		return err
+	}
+	if outputFormatFlag != "" {
+		return printOutputFormat(anomalies)
+	}
+	if len(anomalies) == 0 {
+		utils.Log.Info("No anomalous results to display")
+		return nil
+	}
+	utils.PrintToTable([]string{"NAME", "RESULT-ID", "P99", "MEAN", "STD-DEV", "DEVIATIONS", "SAMPLES", "DETECTED"}, resultAnomaliesToStringArrays(anomalies))
+	return nil
+}
+
+// resultAnomaliesToStringArrays changes the anomalies into string arrays for tabular format printing,
+// the latencies in milliseconds
+func resultAnomaliesToStringArrays(anomalies []models.ResultAnalysis) [][]string {
+	var data [][]string
+	for _, a := range anomalies {
+		detected := ""
+		if a.CreatedAt != nil {
+			detected = a.CreatedAt.Format("2006-01-02 15:04:05")
+		}
+		data = append(data, []string{
+			a.Name,
+			a.ResultID,
+			fmt.Sprintf("%.3fms", a.P99*1000),
+			fmt.Sprintf("%.3fms", a.Mean*1000),
+			fmt.Sprintf("%.3fms", a.StdDev*1000),
+			fmt.Sprintf("%+.1f", a.Deviations),
+			fmt.Sprintf("%d", a.Samples),
+			detected,
+		})
+	}
+	return data
+}
+
+// fetchLoadTestCheckpoints returns the checkpoints of the test, by client and in the order of the run
+func fetchLoadTestCheckpoints(baseURL, testID string) ([]models.LoadTestCheckpoint, error) {
+	client := &http.Client{}
+	req, err := utils.NewRequest("GET", baseURL+"/api/perf/checkpoints", nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Add("test_id", testID)
+	req.URL.RawQuery = q.Encode()
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ErrFailRequest(err)
+	}
+	if utils.ContentTypeIsHTML(resp) {
+		return nil, ErrUnauthenticated()
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrFailReqStatus(resp.StatusCode)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	checkpoints := []models.LoadTestCheckpoint{}
+	if err := json.Unmarshal(body, &checkpoints); err != nil {
+		return nil, ErrFailUnmarshal(err)
+	}
+	return checkpoints, nil
+}
+
+// listLoadTestCheckpoints prints the checkpoints of the test, in the format given by --output-format if any
+func listLoadTestCheckpoints(baseURL, testID string) error {
+	checkpoints, err := fetchLoadTestCheckpoints(baseURL, testID)
+	if err != nil {
+		return err
+	}
+	if outputFormatFlag != "" {
+		return printOutputFormat(checkpoints)
+	}
+	if len(checkpoints) == 0 {
+		utils.Log.Info("No checkpoints of the test to display")
+		return nil
+	}
+	utils.PrintToTable([]string{"CLIENT", "SEQ", "ELAPSED", "REQUESTS", "QPS", "P50", "P99", "MAX", "INTERVAL-QPS", "INTERVAL-P99"}, loadTestCheckpointsToStringArrays(checkpoints))
+	return nil
+}
+
+// loadTestCheckpointsToStringArrays changes the checkpoints into string arrays for tabular format
+// printing, the latencies in milliseconds
+func loadTestCheckpointsToStringArrays(checkpoints []models.LoadTestCheckpoint) [][]string {
+	var data [][]string
+	for _, c := range checkpoints {
+		data = append(data, []string{
+			fmt.Sprintf("%d", c.Client),
+			fmt.Sprintf("%d", c.Seq),
+			c.Duration.Round(time.Second).String(),
+			fmt.Sprintf("%d", c.Requests),
+			fmt.Sprintf("%.1f", c.QPS),
+			fmt.Sprintf("%.3fms", c.P50*1000),
+			fmt.Sprintf("%.3fms", c.P99*1000),
+			fmt.Sprintf("%.3fms", c.Max*1000),
+			fmt.Sprintf("%.1f", c.SegmentQPS),
+			fmt.Sprintf("%.3fms", c.SegmentP99*1000),
+		})
+	}
+	return data
+}
+
 // change performance results into string arrays(for tabular format printing) and profileStruct (to print single performance result)
 func performanceResultsToStringArrays(results []models.PerformanceResult) ([][]string, []resultStruct) {
 	var data [][]string
@@ -231,6 +513,8 @@ func performanceResultsToStringArrays(results []models.PerformanceResult) ([][]s
 			StartTime:     result.TestStartTime,
 			MesheryID:     result.MesheryID,
 			LoadGenerator: result.RunnerResults.LoadGenerator,
+			Snapshots:     result.RunnerResults.GrafanaSnapshots,
+			Assertions:    result.RunnerResults.Assertions,
 		}
 
 		expendedData = append(expendedData, a)
@@ -240,6 +524,16 @@ func performanceResultsToStringArrays(results []models.PerformanceResult) ([][]s
 }
 
 func init() {
+	resultCmd.ValidArgsFunction = utils.CompleteNames(profileNames)
+	resultCmd.AddCommand(downloadCmd)
+	resultCmd.AddCommand(compareCmd)
+	resultCmd.AddCommand(bundleCmd)
 	resultCmd.Flags().BoolVarP(&viewSingleResult, "view", "", false, "(optional) View single performance results with more info")
 	resultCmd.Flags().IntVarP(&pageNumber, "page", "p", 1, "(optional) List next set of performance results with --page (default = 1)")
+	resultCmd.Flags().BoolVarP(&redactFlag, "redact", "", false, "(optional) strip secrets, tokens, IP addresses and internal hostnames from the -o output before sharing")
+	resultCmd.Flags().StringVarP(&redactionRulesFlag, "redaction-rules", "", "", "(optional) file of redaction rules extending the defaults")
+	resultCmd.Flags().StringVarP(&ownerFlag, "owner", "", "", "(optional) List only the results of the tests run by the user")
+	resultCmd.Flags().StringVarP(&transferToFlag, "transfer-to", "", "", "(optional) Transfer the ownership of the listed results to the user")
+	resultCmd.Flags().StringVarP(&checkpointsFlag, "checkpoints", "", "", "(optional) List the checkpoints of the test with the ID, run with perf apply --checkpoint")
+	resultCmd.Flags().BoolVarP(&anomaliesFlag, "anomalies", "", false, "(optional) List only the results whose p99 latency deviates from the history of the profile, of every profile when none is given")
 }
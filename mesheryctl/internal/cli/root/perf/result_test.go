@@ -1,14 +1,21 @@
 package perf
 
 import (
+	"encoding/json"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/jarcoal/httpmock"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
 )
 
 var tempProfileID = "a2a555cf-ae16-479c-b5d2-a35656ba741e"
@@ -198,3 +205,116 @@ func TestResultCmd(t *testing.T) {
 	// stop mock server
 	utils.StopMockery(t)
 }
+
+func TestRestoreArchivedResults(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	_, filename, _, _ := runtime.Caller(0)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+
+	archivedID := uuid.Must(uuid.NewV4())
+	liveID := uuid.Must(uuid.NewV4())
+	results := []models.PerformanceResult{
+		{MesheryID: &archivedID, Name: "archived", RunnerResults: models.RunnerResults{
+			URL:      "http://localhost:2323/productpage",
+			Archived: &models.ArchivedResult{Archive: "s3://results", Key: "results/none/" + archivedID.String() + ".smp.json.gz"},
+		}},
+		{MesheryID: &liveID, Name: "live"},
+	}
+	httpmock.RegisterResponder("GET", "http://localhost:9081/api/perf/profile/result/"+archivedID.String(),
+		httpmock.NewStringResponder(http.StatusOK, `{"meshery_id":"`+archivedID.String()+`","name":"archived","runner_results":{"URL":"http://localhost:2323/productpage","ActualQPS":99.5}}`))
+
+	if err := restoreArchivedResults("http://localhost:9081", results); err != nil {
+		t.Fatal(err)
+	}
+	if results[0].RunnerResults.Archived != nil || results[0].RunnerResults.QPS != 99.5 {
+		t.Errorf("restoreArchivedResults() result = %+v, want the result retrieved from the archive", results[0].RunnerResults)
+	}
+	if info := httpmock.GetCallCountInfo(); len(info) != 1 || info["GET http://localhost:9081/api/perf/profile/result/"+archivedID.String()] != 1 {
+		t.Errorf("restoreArchivedResults() requests = %v, want only the archived result fetched", info)
+	}
+}
+
+func TestFetchResultAnomalies(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	defer func(tokenFlag string) { utils.TokenFlag = tokenFlag }(utils.TokenFlag)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/perf/results/anomalies" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		anomalies := []models.ResultAnalysis{
+			{ResultID: "r1", ProfileID: tempProfileID, Name: "slow", P99: 0.03, Mean: 0.01, StdDev: 0.0005, Deviations: 40, Samples: 6, Anomaly: true},
+			{ResultID: "r2", ProfileID: "other", Name: "fast", P99: 0.001, Mean: 0.01, StdDev: 0.002, Deviations: -4.5, Samples: 20, Anomaly: true},
+		}
+		if profileID := r.URL.Query().Get("profile_id"); profileID != "" {
+			anomalies = anomalies[:1]
+		}
+		_ = json.NewEncoder(w).Encode(anomalies)
+	}))
+	defer server.Close()
+
+	anomalies, err := fetchResultAnomalies(server.URL, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(anomalies) != 2 {
+		t.Fatalf("got %d anomalies, want 2", len(anomalies))
+	}
+	anomalies, err = fetchResultAnomalies(server.URL, tempProfileID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(anomalies) != 1 {
+		t.Fatalf("got %d anomalies of the profile, want 1", len(anomalies))
+	}
+
+	want := []string{"slow", "r1", "30.000ms", "10.000ms", "0.500ms", "+40.0", "6", ""}
+	if got := resultAnomaliesToStringArrays(anomalies)[0]; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got row %v, want %v", got, want)
+	}
+}
+
+func TestFetchLoadTestCheckpoints(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	defer func(tokenFlag string) { utils.TokenFlag = tokenFlag }(utils.TokenFlag)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+
+	testID := "0a3c0d6e-8d27-4d0c-9f7e-c6f5b2b6a3a1"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/perf/checkpoints" || r.URL.Query().Get("test_id") != testID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]models.LoadTestCheckpoint{
+			{TestID: testID, Seq: 1, Duration: 10 * time.Minute, Requests: 30000, QPS: 50, P50: 0.004, P99: 0.02, Max: 0.1, SegmentQPS: 50, SegmentP99: 0.02},
+			{TestID: testID, Seq: 2, Duration: 20*time.Minute + 400*time.Millisecond, Requests: 59000, QPS: 49.2, P50: 0.005, P99: 0.03, Max: 0.25, SegmentQPS: 48.3, SegmentP99: 0.045},
+		})
+	}))
+	defer server.Close()
+
+	checkpoints, err := fetchLoadTestCheckpoints(server.URL, testID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checkpoints) != 2 {
+		t.Fatalf("got %d checkpoints, want 2", len(checkpoints))
+	}
+	if _, err := fetchLoadTestCheckpoints(server.URL, "unknown"); err == nil {
+		t.Error("expected an error for a test the server does not know")
+	}
+
+	want := []string{"0", "2", "20m0s", "59000", "49.2", "5.000ms", "30.000ms", "250.000ms", "48.3", "45.000ms"}
+	if got := loadTestCheckpointsToStringArrays(checkpoints)[1]; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got row %v, want %v", got, want)
+	}
+}
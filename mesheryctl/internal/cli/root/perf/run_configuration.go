@@ -0,0 +1,183 @@
+package perf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+)
+
+// runConfiguration is the configuration a performance test was run with
+type runConfiguration struct {
+	ProfileID          string
+	Name               string
+	Mesh               string
+	URL                string
+	QPS                string
+	ConcurrentRequests string
+	Duration           string
+	LoadGenerator      string
+	Headers            map[string]string
+	Cookies            map[string]string
+	ContentType        string
+	Method             string
+	Body               string
+	BodyTemplate       bool
+	TemplateData       string
+	WSRampUp           string
+	WSMessageRate      string
+	WSMessageSize      string
+	RequestMix         models.RequestMix
+	Assertions         *models.LoadTestAssertions
+}
+
+// fetchRunConfiguration returns the configuration the result with the given id was run with
+func fetchRunConfiguration(baseURL, resultID string) (*runConfiguration, error) {
+	client := &http.Client{}
+	req, err := utils.NewRequest("GET", baseURL+"/api/perf/profile/result/"+resultID+"?output=json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ErrFailRequest(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrFailReqStatus(resp.StatusCode)
+	}
+	defer utils.SafeClose(resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+
+	result := &models.MesheryResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, ErrFailUnmarshal(err)
+	}
+	return runConfigurationFromResult(result, resultID)
+}
+
+// runConfigurationFromResult reconstructs the configuration of the run from the
+// metadata stored with the result. Results recorded before the run configuration
+// was stored fall back to the options echoed back by the load generator, which
+// leave out the request headers, cookies and body.
+func runConfigurationFromResult(result *models.MesheryResult, resultID string) (*runConfiguration, error) {
+	run := &runConfiguration{
+		Name: result.Name,
+		Mesh: result.Mesh,
+	}
+	if result.PerformanceProfile != nil {
+		run.ProfileID = result.PerformanceProfile.String()
+	}
+
+	runner := result.Result
+	if stored, ok := runner["run-configuration"].(map[string]interface{}); ok {
+		run.URL, _ = stored["url"].(string)
+		run.QPS = numberString(stored["qps"])
+		run.ConcurrentRequests = numberString(stored["concurrent_requests"])
+		run.Duration, _ = stored["duration"].(string)
+		run.LoadGenerator, _ = stored["load_generator"].(string)
+		run.Headers = stringMap(stored["headers"])
+		run.Cookies = stringMap(stored["cookies"])
+		run.ContentType, _ = stored["content_type"].(string)
+		run.Method, _ = stored["method"].(string)
+		run.Body, _ = stored["body"].(string)
+		run.BodyTemplate, _ = stored["body_template"].(bool)
+		run.TemplateData, _ = stored["template_data"].(string)
+		if mix, ok := stored["request_mix"]; ok {
+			if data, err := json.Marshal(mix); err == nil {
+				_ = json.Unmarshal(data, &run.RequestMix)
+			}
+		}
+		if assertions, ok := stored["assertions"].(map[string]interface{}); ok {
+			if data, err := json.Marshal(assertions); err == nil {
+				_ = json.Unmarshal(data, &run.Assertions)
+			}
+		}
+		if rampUp, ok := stored["ws_ramp_up"].(string); ok {
+			run.WSRampUp = rampUp
+			if rate, ok := stored["ws_message_rate"].(float64); ok && rate > 0 {
+				run.WSMessageRate = strconv.FormatFloat(rate, 'f', -1, 64)
+			}
+			run.WSMessageSize = numberString(stored["ws_message_size"])
+		}
+	} else {
+		run.URL, _ = runner["URL"].(string)
+		run.QPS = numberString(runner["RequestedQPS"])
+		run.ConcurrentRequests = numberString(runner["NumThreads"])
+		run.Duration, _ = runner["RequestedDuration"].(string)
+		run.LoadGenerator, _ = runner["load-generator"].(string)
+	}
+
+	if run.URL == "" {
+		return nil, ErrNoRunConfiguration(resultID)
+	}
+	if run.QPS == "" || run.QPS == "max" {
+		run.QPS = "0"
+	}
+	if run.ConcurrentRequests == "" {
+		run.ConcurrentRequests = "1"
+	}
+	if run.LoadGenerator == "" {
+		run.LoadGenerator = "fortio"
+	}
+	duration, err := compactDuration(run.Duration)
+	if err != nil {
+		return nil, ErrNoRunConfiguration(resultID)
+	}
+	run.Duration = duration
+	return run, nil
+}
+
+// compactDuration formats a duration such as 1m0s with a single unit, e.g. 1m,
+// as the run endpoint expects a count followed by one of h, m or s
+func compactDuration(d string) (string, error) {
+	if d == "" {
+		return "30s", nil
+	}
+	duration, err := time.ParseDuration(d)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case duration >= time.Hour && duration%time.Hour == 0:
+		return fmt.Sprintf("%dh", duration/time.Hour), nil
+	case duration >= time.Minute && duration%time.Minute == 0:
+		return fmt.Sprintf("%dm", duration/time.Minute), nil
+	}
+	seconds := int64(duration / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	return fmt.Sprintf("%ds", seconds), nil
+}
+
+func numberString(v interface{}) string {
+	switch n := v.(type) {
+	case float64:
+		return strconv.Itoa(int(n))
+	case string:
+		return n
+	}
+	return ""
+}
+
+func stringMap(v interface{}) map[string]string {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	result := map[string]string{}
+	for k, val := range m {
+		result[k] = fmt.Sprintf("%v", val)
+	}
+	return result
+}
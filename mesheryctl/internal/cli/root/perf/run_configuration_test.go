@@ -0,0 +1,94 @@
+package perf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestRunConfigurationFromResult(t *testing.T) {
+	profile := uuid.FromStringOrNil(existingProfileID)
+
+	tests := []struct {
+		name    string
+		result  *models.MesheryResult
+		want    *runConfiguration
+		wantErr bool
+	}{
+		{
+			name: "stored run configuration",
+			result: &models.MesheryResult{
+				Name:               "bookinfo",
+				Mesh:               "istio",
+				PerformanceProfile: &profile,
+				Result: map[string]interface{}{
+					"run-configuration": map[string]interface{}{
+						"url":                 "http://productpage.default.svc:9080/productpage",
+						"qps":                 float64(20),
+						"concurrent_requests": float64(4),
+						"duration":            "1m0s",
+						"load_generator":      "wrk2",
+						"headers":             map[string]interface{}{"x-user": "jason"},
+						"content_type":        "application/json",
+						"method":              "PUT",
+						"body":                `{"id":1}`,
+					},
+				},
+			},
+			want: &runConfiguration{
+				ProfileID:          existingProfileID,
+				Name:               "bookinfo",
+				Mesh:               "istio",
+				URL:                "http://productpage.default.svc:9080/productpage",
+				QPS:                "20",
+				ConcurrentRequests: "4",
+				Duration:           "1m",
+				LoadGenerator:      "wrk2",
+				Headers:            map[string]string{"x-user": "jason"},
+				ContentType:        "application/json",
+				Method:             "PUT",
+				Body:               `{"id":1}`,
+			},
+		},
+		{
+			name: "load generator options of older results",
+			result: &models.MesheryResult{
+				Name: "legacy",
+				Result: map[string]interface{}{
+					"URL":               "https://meshery.io",
+					"RequestedQPS":      "max",
+					"RequestedDuration": "1h30m0s",
+					"NumThreads":        float64(2),
+					"load-generator":    "fortio",
+				},
+			},
+			want: &runConfiguration{
+				Name:               "legacy",
+				URL:                "https://meshery.io",
+				QPS:                "0",
+				ConcurrentRequests: "2",
+				Duration:           "90m",
+				LoadGenerator:      "fortio",
+			},
+		},
+		{
+			name:    "result without endpoint",
+			result:  &models.MesheryResult{Result: map[string]interface{}{}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runConfigurationFromResult(tt.result, "id")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("runConfigurationFromResult error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("runConfigurationFromResult got = %+v want = %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package perf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/qri-io/jsonschema"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// smpTestConfigSchema is the JSON schema SMP test configuration files are validated against.
+// Objects closed with additionalProperties false report unknown fields, which are mostly typos.
+const smpTestConfigSchema = `{
+  "type": "object",
+  "required": ["test", "mesh"],
+  "additionalProperties": false,
+  "properties": {
+    "test": {
+      "type": "object",
+      "required": ["clients"],
+      "additionalProperties": false,
+      "properties": {
+        "smp_version": {"type": "string"},
+        "id": {"type": "string"},
+        "name": {"type": "string"},
+        "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+        "duration": {"type": "string", "pattern": "^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"},
+        "clients": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "object",
+            "required": ["endpoint_urls"],
+            "additionalProperties": false,
+            "properties": {
+              "internal": {"type": "boolean"},
+              "load_generator": {"enum": ["", "fortio", "wrk2", "nighthawk"]},
+              "protocol": {"type": "integer", "minimum": 0, "maximum": 4},
+              "connections": {"type": "integer", "minimum": 0},
+              "rps": {"type": "integer", "minimum": 0},
+              "headers": {"type": "object", "additionalProperties": {"type": "string"}},
+              "cookies": {"type": "object", "additionalProperties": {"type": "string"}},
+              "body": {"type": "string"},
+              "content_type": {"type": "string"},
+              "endpoint_urls": {
+                "type": "array",
+                "minItems": 1,
+                "items": {"type": "string", "pattern": "^(https?|wss?)://"}
+              }
+            }
+          }
+        }
+      }
+    },
+    "method": {"enum": ["GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "get", "head", "post", "put", "patch", "delete", "options"]},
+    "request_mix": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["weight", "path"],
+        "additionalProperties": false,
+        "properties": {
+          "weight": {"type": "integer", "minimum": 1},
+          "method": {"enum": ["GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "get", "head", "post", "put", "patch", "delete", "options"]},
+          "path": {"type": "string", "minLength": 1},
+          "body": {"type": "string"}
+        }
+      }
+    },
+    "assertions": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "status": {"type": "array", "items": {"type": "integer", "minimum": 100, "maximum": 599}},
+        "body_contains": {"type": "array", "items": {"type": "string", "minLength": 1}},
+        "json_path": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": ["path"],
+            "additionalProperties": false,
+            "properties": {
+              "path": {"type": "string", "minLength": 1},
+              "value": {"type": "string"}
+            }
+          }
+        },
+        "max_response_size": {"type": "integer", "minimum": 0}
+      }
+    },
+    "mesh": {
+      "type": "object",
+      "required": ["type"],
+      "additionalProperties": false,
+      "properties": {
+        "type": {"type": "integer", "minimum": 0, "maximum": 11},
+        "version": {"type": "string"},
+        "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+        "annotations": {"type": "object", "additionalProperties": {"type": "string"}}
+      }
+    }
+  }
+}`
+
+// smpPatternHints replace the regular expressions the schema reports for fields failing
+// their pattern with a description of the values expected
+var smpPatternHints = map[string]string{
+	"duration":      "must be a duration such as 30s, 5m or 1h",
+	"endpoint_urls": "must be an http(s) or ws(s) URL",
+}
+
+// smpProblem is a problem found in a test configuration, at a line of its YAML document
+type smpProblem struct {
+	Line    int
+	Path    string
+	Message string
+}
+
+func (p smpProblem) String() string {
+	return fmt.Sprintf("line %d: %s: %s", p.Line, p.Path, p.Message)
+}
+
+// validateTestConfiguration validates an SMP test configuration against its schema,
+// reporting every problem found along with its line rather than stopping at the first one
+func validateTestConfiguration(content []byte) error {
+	var document yamlv3.Node
+	if err := yamlv3.Unmarshal(content, &document); err != nil {
+		return ErrFailUnmarshalFile(err)
+	}
+	if len(document.Content) == 0 {
+		return ErrInvalidTestConfig([]string{"the test configuration is empty"})
+	}
+	root := document.Content[0]
+
+	data, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return ErrFailUnmarshalFile(err)
+	}
+
+	schemaDoc := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(smpTestConfigSchema), &schemaDoc); err != nil {
+		return ErrFailUnmarshal(err)
+	}
+	schema := &jsonschema.Schema{}
+	if err := json.Unmarshal([]byte(smpTestConfigSchema), schema); err != nil {
+		return ErrFailUnmarshal(err)
+	}
+	keyErrors, err := schema.ValidateBytes(context.Background(), data)
+	if err != nil {
+		return ErrFailUnmarshalFile(err)
+	}
+
+	// Unknown fields are reported by name from the document itself, as the schema
+	// only reports that the object holding them has additional properties
+	problems := unknownFields(root, schemaDoc, "")
+	for _, keyError := range keyErrors {
+		if strings.Contains(keyError.Message, "additional properties") {
+			continue
+		}
+		path := keyError.PropertyPath
+		if path == "" {
+			path = "/"
+		}
+		message := keyError.Message
+		if strings.HasPrefix(message, "regexp pattern") {
+			segments := strings.Split(path, "/")
+			for _, segment := range segments[len(segments)-2:] {
+				if hint, ok := smpPatternHints[segment]; ok {
+					message = fmt.Sprintf("%v %s", keyError.InvalidValue, hint)
+				}
+			}
+		}
+		problems = append(problems, smpProblem{Line: lineOf(root, path), Path: path, Message: message})
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool { return problems[i].Line < problems[j].Line })
+	messages := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		messages = append(messages, problem.String())
+	}
+	return ErrInvalidTestConfig(messages)
+}
+
+// unknownFields returns the fields of mappings in the document which the schema closes
+// to additional properties but does not define
+func unknownFields(node *yamlv3.Node, schema map[string]interface{}, path string) []smpProblem {
+	var problems []smpProblem
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		properties, _ := schema["properties"].(map[string]interface{})
+		closed := schema["additionalProperties"] == false
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			property, ok := properties[key.Value].(map[string]interface{})
+			if !ok {
+				if closed {
+					problems = append(problems, smpProblem{Line: key.Line, Path: path + "/" + key.Value, Message: "unknown field"})
+				}
+				continue
+			}
+			problems = append(problems, unknownFields(value, property, path+"/"+key.Value)...)
+		}
+	case yamlv3.SequenceNode:
+		items, _ := schema["items"].(map[string]interface{})
+		for i, item := range node.Content {
+			problems = append(problems, unknownFields(item, items, path+"/"+strconv.Itoa(i))...)
+		}
+	}
+	return problems
+}
+
+// lineOf returns the line of the node at the JSON pointer path of the document, or of
+// its closest ancestor present when the path leads to a missing field
+func lineOf(node *yamlv3.Node, path string) int {
+	line := node.Line
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		var next *yamlv3.Node
+		switch node.Kind {
+		case yamlv3.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == segment {
+					line = node.Content[i].Line
+					next = node.Content[i+1]
+					break
+				}
+			}
+		case yamlv3.SequenceNode:
+			if i, err := strconv.Atoi(segment); err == nil && i < len(node.Content) {
+				next = node.Content[i]
+				line = next.Line
+			}
+		}
+		if next == nil {
+			break
+		}
+		node = next
+	}
+	return line
+}
@@ -0,0 +1,99 @@
+package perf
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+func TestValidateTestConfiguration(t *testing.T) {
+	valid := `test:
+  smp_version: v0.0.1
+  name: Istio Performance Test
+  clients:
+    - load_generator: fortio
+      protocol: 1
+      connections: 2
+      rps: 10
+      headers:
+        Authorization: Bearer token
+      endpoint_urls:
+        - 'http://localhost:2323/productpage'
+    - load_generator: wrk2
+      rps: 50
+      endpoint_urls:
+        - 'http://localhost:2323/reviews'
+  duration: '30m'
+mesh:
+  type: 3
+request_mix:
+  - weight: 70
+    path: /products
+  - weight: 10
+    method: POST
+    path: /cart
+    body: '{"sku": "{{uuid}}"}'
+`
+	if err := validateTestConfiguration([]byte(valid)); err != nil {
+		t.Fatalf("validateTestConfiguration() of a valid configuration error = %v", err)
+	}
+
+	method := "test:\n  clients:\n    - endpoint_urls: ['http://localhost:2323/']\nmesh:\n  type: 3\nmethod: put\n"
+	if err := validateTestConfiguration([]byte(method)); err != nil {
+		t.Errorf("validateTestConfiguration() of a configuration with a method error = %v", err)
+	}
+	if err := validateTestConfiguration([]byte(strings.Replace(method, "put", "fetch", 1))); err == nil || !strings.Contains(err.Error(), "/method") {
+		t.Errorf("validateTestConfiguration() error = %v, want the unknown method reported", err)
+	}
+
+	invalid := `test:
+  name: Broken Test
+  clients:
+    - load_generator: jmeter
+      rps: -5
+      endpoint_url: http://localhost:2323
+  duration: 30 minutes
+mesh:
+  type: 3
+`
+	err := validateTestConfiguration([]byte(invalid))
+	if code := errors.GetCode(err); code != ErrInvalidTestConfigCode {
+		t.Fatalf("validateTestConfiguration() error code = %s, want %s", code, ErrInvalidTestConfigCode)
+	}
+	for _, want := range []string{
+		"line 4: /test/clients/0",
+		"line 4: /test/clients/0/load_generator",
+		"line 5: /test/clients/0/rps",
+		"line 6: /test/clients/0/endpoint_url: unknown field",
+		"line 7: /test/duration: 30 minutes must be a duration",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("validateTestConfiguration() error = %v, want it to report %q", err, want)
+		}
+	}
+
+	mix := `test:
+  clients:
+    - endpoint_urls: ['http://localhost:2323/']
+mesh:
+  type: 3
+request_mix:
+  - weight: 0
+    method: FETCH
+`
+	err = validateTestConfiguration([]byte(mix))
+	for _, want := range []string{
+		"/request_mix/0/weight",
+		"/request_mix/0/method",
+		`"path" value is required`,
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("validateTestConfiguration() error = %v, want it to report %q", err, want)
+		}
+	}
+
+	if err := validateTestConfiguration([]byte("test:\n  clients: []\n")); !strings.Contains(err.Error(), `"mesh" value is required`) {
+		t.Errorf("validateTestConfiguration() error = %v, want missing mesh reported", err)
+	}
+}
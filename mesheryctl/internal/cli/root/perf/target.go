@@ -0,0 +1,210 @@
+package perf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	targetCluster   string
+	targetNamespace string
+	targetImage     string
+)
+
+var targetCmd = &cobra.Command{
+	Use:   "target",
+	Short: "Manage performance test targets",
+	Long:  `Deploy echo servers in clusters as known-good targets of performance tests and list the deployed ones`,
+	Example: `
+// Deploy the fortio echo server in the perf namespace of a cluster
+mesheryctl perf target deploy --cluster kind-meshery --namespace perf
+
+// List the performance test targets
+mesheryctl perf target list
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var targetDeployCmd = &cobra.Command{
+	Use:   "deploy",
+	Short: "Deploy an echo server as performance test target",
+	Long: `Deploy the fortio echo server in a namespace of a cluster and register its URL with Meshery, giving a
+known-good target to measure the overhead of a service mesh without touching your applications. The URL is
+reachable from within the cluster, e.g. by Meshery Server deployed in it. Deploying again updates the server.
+A fortio proxy is deployed in front of the echo server, so that requests sent to it cross the mesh between two
+sidecars, as used by "mesheryctl perf benchmark mesh-overhead".`,
+	Args: cobra.NoArgs,
+	Example: `
+// Deploy the echo server in the meshery-perf namespace of the current Kubernetes context
+mesheryctl perf target deploy
+
+// Deploy the echo server in the perf namespace of the Kubernetes context with the given name or ID
+mesheryctl perf target deploy --cluster kind-meshery --namespace perf
+
+// Run a performance test against the echo server
+mesheryctl perf apply overhead --url http://fortio-server.perf.svc.cluster.local:8080/echo
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		response, err := deployPerfTarget(mctlCfg.GetBaseMesheryURL(), models.PerfTargetDeployRequest{
+			Cluster:   targetCluster,
+			Namespace: targetNamespace,
+			Image:     targetImage,
+		})
+		if err != nil {
+			return err
+		}
+
+		if outputFormatFlag != "" {
+			return printOutputFormat(response)
+		}
+		utils.Log.Info(fmt.Sprintf("Echo server deployed in namespace %s, registered as %s", response.Target.Namespace, response.Target.URL))
+		if !response.Ready {
+			utils.Log.Warn(fmt.Errorf("echo server is not available yet, check the pods of the %s deployment in namespace %s", models.PerfTargetName, response.Target.Namespace))
+		}
+		utils.Log.Info(fmt.Sprintf("Run a performance test against it with: mesheryctl perf apply <profile-name> --url %s", response.Target.URL))
+		return nil
+	},
+}
+
+var targetListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List performance test targets",
+	Long:  `List the echo servers deployed by Meshery as targets of performance tests`,
+	Args:  cobra.NoArgs,
+	Example: `
+// List the performance test targets
+mesheryctl perf target list
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		targets, err := fetchPerfTargets(mctlCfg.GetBaseMesheryURL())
+		if err != nil {
+			return err
+		}
+		if outputFormatFlag != "" {
+			return printOutputFormat(targets)
+		}
+		if len(targets) == 0 {
+			utils.Log.Info("No performance test targets to display")
+			return nil
+		}
+
+		var data [][]string
+		for _, target := range targets {
+			deployed := ""
+			if target.UpdatedAt != nil {
+				deployed = target.UpdatedAt.Format("2006-01-02 15:04:05")
+			}
+			data = append(data, []string{target.Namespace, target.K8sContextID, target.URL, deployed})
+		}
+		utils.PrintToTable([]string{"NAMESPACE", "CONTEXT-ID", "URL", "DEPLOYED"}, data)
+		return nil
+	},
+}
+
+// deployPerfTarget has Meshery Server deploy the echo server
+func deployPerfTarget(baseURL string, deployReq models.PerfTargetDeployRequest) (*models.PerfTargetAPIResponse, error) {
+	payload, err := json.Marshal(deployReq)
+	if err != nil {
+		return nil, ErrFailMarshal(err)
+	}
+	req, err := utils.NewRequest("POST", baseURL+"/api/perf/targets", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	response := &models.PerfTargetAPIResponse{}
+	if err := doPerfTargetRequest(req, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// fetchPerfTargets returns the echo servers deployed as performance test targets
+func fetchPerfTargets(baseURL string) ([]models.PerfTarget, error) {
+	req, err := utils.NewRequest("GET", baseURL+"/api/perf/targets", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []models.PerfTarget{}
+	if err := doPerfTargetRequest(req, &targets); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+func doPerfTargetRequest(req *http.Request, out interface{}) error {
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return ErrFailRequest(err)
+	}
+	defer resp.Body.Close()
+
+	// failsafe for not being authenticated
+	if utils.ContentTypeIsHTML(resp) {
+		return ErrUnauthenticated()
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ErrFailRequest(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return ErrPerfTarget(resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return ErrFailUnmarshal(err)
+	}
+	return nil
+}
+
+// printOutputFormat prints the value in the format given by --output-format
+func printOutputFormat(v interface{}) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return ErrFailMarshal(err)
+	}
+	switch outputFormatFlag {
+	case "json":
+	case "yaml":
+		if body, err = yaml.JSONToYAML(body); err != nil {
+			return ErrFailMarshal(err)
+		}
+	default:
+		return ErrInvalidOutputChoice()
+	}
+	utils.Log.Info(string(body))
+	return nil
+}
+
+func init() {
+	targetDeployCmd.Flags().StringVar(&targetCluster, "cluster", "", "(optional) ID or name of the Kubernetes context to deploy to, the current context by default")
+	targetDeployCmd.Flags().StringVarP(&targetNamespace, "namespace", "n", models.DefaultPerfTargetNamespace, "(optional) namespace to deploy the echo server to")
+	targetDeployCmd.Flags().StringVar(&targetImage, "image", models.DefaultPerfTargetImage, "(optional) image of the fortio echo server")
+
+	targetCmd.AddCommand(targetDeployCmd, targetListCmd)
+}
@@ -0,0 +1,66 @@
+package perf
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+)
+
+func TestPerfTargetRequests(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	defer func(tokenFlag string) { utils.TokenFlag = tokenFlag }(utils.TokenFlag)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/perf/targets" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode([]models.PerfTarget{{Namespace: "perf", URL: models.PerfTargetURL("perf")}})
+			return
+		}
+		deployReq := models.PerfTargetDeployRequest{}
+		_ = json.NewDecoder(r.Body).Decode(&deployReq)
+		if deployReq.Cluster == "missing" {
+			http.Error(w, "kubernetes context missing not found", http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(models.PerfTargetAPIResponse{
+			Target: models.PerfTarget{K8sContextID: deployReq.Cluster, Namespace: deployReq.Namespace, URL: models.PerfTargetURL(deployReq.Namespace)},
+			Ready:  true,
+		})
+	}))
+	defer server.Close()
+
+	response, err := deployPerfTarget(server.URL, models.PerfTargetDeployRequest{Cluster: "kind-meshery", Namespace: "perf"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !response.Ready || response.Target.URL != "http://fortio-server.perf.svc.cluster.local:8080/echo" {
+		t.Errorf("unexpected deployment %+v", response)
+	}
+
+	_, err = deployPerfTarget(server.URL, models.PerfTargetDeployRequest{Cluster: "missing"})
+	if err == nil || !strings.Contains(err.Error(), "kubernetes context missing not found") {
+		t.Errorf("expected the error of the server, got %v", err)
+	}
+
+	targets, err := fetchPerfTargets(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 1 || targets[0].Namespace != "perf" {
+		t.Errorf("unexpected targets %+v", targets)
+	}
+}
@@ -0,0 +1,98 @@
+package perf
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// bundledTemplates are the test templates shipped with mesheryctl, so that the tests of every team
+// mean the same by a smoke or a soak test
+//
+//go:embed templates.yaml
+var bundledTemplates []byte
+
+// testTemplate is a named set of flags of perf apply
+type testTemplate struct {
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	QPS                string `json:"qps,omitempty"`
+	ConcurrentRequests string `json:"concurrent-requests,omitempty"`
+	Duration           string `json:"duration,omitempty"`
+	LoadGenerator      string `json:"load-generator,omitempty"`
+	Adaptive           bool   `json:"adaptive,omitempty"`
+	TargetP99          string `json:"target-p99,omitempty"`
+}
+
+// testTemplates returns the test templates shipped with mesheryctl
+func testTemplates() ([]*testTemplate, error) {
+	templates := []*testTemplate{}
+	if err := yaml.Unmarshal(bundledTemplates, &templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// testTemplateNames returns the names of the test templates, for the help of --template
+func testTemplateNames() []string {
+	templates, _ := testTemplates()
+	names := []string{}
+	for _, t := range templates {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// applyTestTemplate sets the flags of the test to the values of the template of the given name,
+// leaving the flags given by the user unchanged
+func applyTestTemplate(cmd *cobra.Command, name string) error {
+	templates, err := testTemplates()
+	if err != nil {
+		return err
+	}
+	var template *testTemplate
+	for _, t := range templates {
+		if t.Name == name {
+			template = t
+			break
+		}
+	}
+	if template == nil {
+		return ErrUnknownTemplate(name, testTemplateNames())
+	}
+
+	if qps == "" {
+		qps = template.QPS
+	}
+	if concurrentRequests == "" {
+		concurrentRequests = template.ConcurrentRequests
+	}
+	if testDuration == "" {
+		testDuration = template.Duration
+	}
+	if loadGenerator == "" {
+		loadGenerator = template.LoadGenerator
+	}
+	if !cmd.Flags().Changed("adaptive") {
+		adaptive = template.Adaptive
+	}
+	if targetP99 == "" {
+		targetP99 = template.TargetP99
+	}
+	log.Debugf("Using the %s test template: %s", template.Name, template.Description)
+	return nil
+}
+
+// testTemplatesHelp describes the test templates, for the help of perf apply
+func testTemplatesHelp() string {
+	templates, _ := testTemplates()
+	lines := []string{}
+	for _, t := range templates {
+		lines = append(lines, fmt.Sprintf("  %-16s %s", t.Name, t.Description))
+	}
+	return strings.Join(lines, "\n")
+}
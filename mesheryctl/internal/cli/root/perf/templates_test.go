@@ -0,0 +1,78 @@
+package perf
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestTestTemplates(t *testing.T) {
+	templates, err := testTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := map[string]bool{}
+	for _, tt := range templates {
+		if names[tt.Name] {
+			t.Errorf("template %s is bundled twice", tt.Name)
+		}
+		names[tt.Name] = true
+		if tt.Description == "" {
+			t.Errorf("template %s has no description", tt.Name)
+		}
+		if _, err := time.ParseDuration(tt.Duration); err != nil {
+			t.Errorf("template %s has an invalid duration: %v", tt.Name, err)
+		}
+		if tt.Adaptive && tt.LoadGenerator != "nighthawk" {
+			t.Errorf("template %s is adaptive, which requires the nighthawk load generator", tt.Name)
+		}
+	}
+	for _, name := range []string{"smoke", "soak-1h", "spike", "capacity-sweep"} {
+		if !names[name] {
+			t.Errorf("template %s is not bundled", name)
+		}
+	}
+}
+
+func TestApplyTestTemplate(t *testing.T) {
+	defer resetVariables()
+
+	tests := []struct {
+		name        string
+		template    string
+		qps         string
+		wantQPS     string
+		wantConc    string
+		wantDur     string
+		wantGen     string
+		wantAdapt   bool
+		wantErrText string
+	}{
+		{name: "the flags of the template", template: "soak-1h", wantQPS: "50", wantConc: "10", wantDur: "1h"},
+		{name: "a flag overriding the template", template: "soak-1h", qps: "100", wantQPS: "100", wantConc: "10", wantDur: "1h"},
+		{name: "an adaptive template", template: "capacity-sweep", wantQPS: "50", wantConc: "64", wantDur: "10m", wantGen: "nighthawk", wantAdapt: true},
+		{name: "an unknown template", template: "soak-2h", wantErrText: "no test template named soak-2h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qps, concurrentRequests, testDuration, loadGenerator, adaptive, targetP99 = tt.qps, "", "", "", false, ""
+			err := applyTestTemplate(applyCmd, tt.template)
+			if tt.wantErrText != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrText) {
+					t.Fatalf("expected error %q, got %v", tt.wantErrText, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			utils.Equals(t, tt.wantQPS, qps)
+			utils.Equals(t, tt.wantConc, concurrentRequests)
+			utils.Equals(t, tt.wantDur, testDuration)
+			utils.Equals(t, tt.wantGen, loadGenerator)
+			utils.Equals(t, tt.wantAdapt, adaptive)
+		})
+	}
+}
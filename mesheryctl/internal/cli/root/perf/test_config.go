@@ -0,0 +1,84 @@
+package perf
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// maxTestConfigSize is the largest test configuration read from a URL or the standard input
+	maxTestConfigSize = 1 << 20
+	// testConfigFetchTimeout is how long fetching a test configuration from a URL may take
+	testConfigFetchTimeout = 30 * time.Second
+)
+
+// testConfigContentTypes are the content types a test configuration is accepted with from a URL.
+// Raw files are commonly served as text/plain or application/octet-stream.
+var testConfigContentTypes = map[string]bool{
+	"application/yaml":         true,
+	"application/x-yaml":       true,
+	"text/yaml":                true,
+	"text/x-yaml":              true,
+	"application/json":         true,
+	"text/plain":               true,
+	"application/octet-stream": true,
+}
+
+// testConfigStdin is where a test configuration given as -f - is read from
+var testConfigStdin io.Reader = os.Stdin
+
+// readTestConfiguration reads the SMP test configuration at path, which is a local file,
+// an http(s) URL or - for the standard input
+func readTestConfiguration(path string) ([]byte, error) {
+	switch {
+	case path == "-":
+		return readLimited(testConfigStdin, "the standard input")
+	case strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://"):
+		return fetchTestConfiguration(path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, ErrReadFilepath(err)
+	}
+	return content, nil
+}
+
+func fetchTestConfiguration(url string) ([]byte, error) {
+	client := &http.Client{Timeout: testConfigFetchTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, ErrReadFilepath(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrReadFilepath(fmt.Errorf("fetching %s returned status %d", url, resp.StatusCode))
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || !testConfigContentTypes[mediaType] {
+			return nil, ErrInvalidTestConfigContentType(url, contentType)
+		}
+	}
+	if resp.ContentLength > maxTestConfigSize {
+		return nil, ErrTestConfigTooLarge(url)
+	}
+	return readLimited(resp.Body, url)
+}
+
+// readLimited reads the test configuration from source, failing when it exceeds maxTestConfigSize
+func readLimited(r io.Reader, source string) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(r, maxTestConfigSize+1))
+	if err != nil {
+		return nil, ErrReadFilepath(err)
+	}
+	if len(content) > maxTestConfigSize {
+		return nil, ErrTestConfigTooLarge(source)
+	}
+	return content, nil
+}
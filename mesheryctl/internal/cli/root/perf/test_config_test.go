@@ -0,0 +1,64 @@
+package perf
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshkit/errors"
+)
+
+func TestReadTestConfiguration(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const smp = "test:\n  name: load-test\n"
+	serve := func(contentType, body string) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusOK, body)
+			resp.Header.Set("Content-Type", contentType)
+			return resp, nil
+		}
+	}
+	httpmock.RegisterResponder("GET", "https://example.com/perf.yaml", serve("text/plain; charset=utf-8", smp))
+	httpmock.RegisterResponder("GET", "https://example.com/perf.html", serve("text/html", "<html></html>"))
+	httpmock.RegisterResponder("GET", "https://example.com/huge.yaml", serve("application/yaml", strings.Repeat("a", maxTestConfigSize+1)))
+	httpmock.RegisterResponder("GET", "https://example.com/missing.yaml", httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+	stdin := testConfigStdin
+	defer func() { testConfigStdin = stdin }()
+
+	tests := []struct {
+		name    string
+		path    string
+		stdin   string
+		want    string
+		wantErr string
+	}{
+		{name: "url", path: "https://example.com/perf.yaml", want: smp},
+		{name: "stdin", path: "-", stdin: smp, want: smp},
+		{name: "web page", path: "https://example.com/perf.html", wantErr: ErrTestConfigContentTypeCode},
+		{name: "too large", path: "https://example.com/huge.yaml", wantErr: ErrTestConfigTooLargeCode},
+		{name: "too large stdin", path: "-", stdin: strings.Repeat("a", maxTestConfigSize+1), wantErr: ErrTestConfigTooLargeCode},
+		{name: "not found", path: "https://example.com/missing.yaml", wantErr: ErrReadFilepathCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testConfigStdin = strings.NewReader(tt.stdin)
+			got, err := readTestConfiguration(tt.path)
+			if tt.wantErr != "" {
+				if code := errors.GetCode(err); code != tt.wantErr {
+					t.Fatalf("readTestConfiguration() error code = %s, want %s", code, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readTestConfiguration() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("readTestConfiguration() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
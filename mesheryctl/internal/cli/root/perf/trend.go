@@ -0,0 +1,138 @@
+package perf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var trendHTMLFlag bool
+
+var profileTrendCmd = &cobra.Command{
+	Use:   "trend [profile-name]",
+	Short: "View the performance trend report of a profile",
+	Long: `View the weekly trend report of a performance profile: the mean, variance and slope of the p99 latency of
+its results over the last weeks, and whether it grew beyond the regression threshold of Meshery Server. The report
+is printed as markdown, or as HTML with --html; the week in progress is reported on when the report of the last
+week completed is not generated yet.`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// View the trend report of a profile
+mesheryctl perf profile trend saturday-profile
+
+// Save the trend report of a profile as HTML
+mesheryctl perf profile trend saturday-profile --html > saturday-profile-trend.html
+
+// View the statistics of the trend report as JSON
+mesheryctl perf profile trend saturday-profile -o json
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var profileID string
+		// setting up for error formatting
+		cmdUsed = "profile"
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		// handles spaces in args if quoted args passed
+		for i, arg := range args {
+			args[i] = strings.ReplaceAll(arg, " ", "%20")
+		}
+		// Merge args to get profile-name
+		searchString := strings.Join(args, "%20")
+
+		profiles, _, err := fetchPerformanceProfiles(mctlCfg.GetBaseMesheryURL(), searchString, "", pageSize, 0)
+		if err != nil {
+			return err
+		}
+		if len(profiles) == 0 {
+			utils.Log.Info("No Performance Profiles found with the given name")
+			return nil
+		}
+
+		data := profilesToStringArrays(profiles)
+		if len(profiles) == 1 {
+			// found only one profile with matching name
+			profileID = data[0][1]
+		} else {
+			// user prompt to select profile
+			selectedProfileIndex, err := userPrompt("profile", "Found multiple profiles with given name, select a profile", data)
+			if err != nil {
+				return err
+			}
+			// ids got shifted with 1 in userPrompt()
+			profileID = data[selectedProfileIndex][2]
+		}
+
+		if outputFormatFlag != "" {
+			report := &models.TrendReport{}
+			if _, err := fetchTrendReport(mctlCfg.GetBaseMesheryURL(), profileID, "json", report); err != nil {
+				return err
+			}
+			return printOutputFormat(report)
+		}
+
+		format := "markdown"
+		if trendHTMLFlag {
+			format = "html"
+		}
+		body, err := fetchTrendReport(mctlCfg.GetBaseMesheryURL(), profileID, format, nil)
+		if err != nil {
+			return err
+		}
+		fmt.Print(body)
+		return nil
+	},
+}
+
+// fetchTrendReport returns the trend report of the profile rendered in the given format, decoding it
+// into out when given
+func fetchTrendReport(baseURL, profileID, format string, out interface{}) (string, error) {
+	q := url.Values{}
+	q.Set("format", format)
+	req, err := utils.NewRequest("GET", baseURL+"/api/user/performance/profiles/"+url.PathEscape(profileID)+"/trend?"+q.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", ErrFailRequest(err)
+	}
+	defer resp.Body.Close()
+
+	// failsafe for not being authenticated, the HTML report being told apart from the login page the
+	// request is redirected to
+	if utils.ContentTypeIsHTML(resp) && (format != "html" || resp.Request.URL.Path != req.URL.Path) {
+		return "", ErrUnauthenticated()
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", ErrFailRequest(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", ErrTrendReport(resp.StatusCode, string(body))
+	}
+	if out != nil {
+		if err := json.Unmarshal(body, out); err != nil {
+			return "", ErrFailUnmarshal(err)
+		}
+	}
+	return string(body), nil
+}
+
+func init() {
+	profileTrendCmd.Flags().BoolVar(&trendHTMLFlag, "html", false, "(optional) print the report as HTML rather than markdown")
+}
@@ -0,0 +1,78 @@
+package perf
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/layer5io/meshkit/errors"
+)
+
+func TestFetchTrendReport(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	defer func(tokenFlag string) { utils.TokenFlag = tokenFlag }(utils.TokenFlag)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+
+	profileID := "8a4f4b48-4b8b-4bfb-9a8a-d6e4e09f8b7c"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/user/login":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte("<html>login</html>"))
+		case r.URL.Path == "/api/user/performance/profiles/expired/trend":
+			http.Redirect(w, r, "/user/login", http.StatusFound)
+		case r.URL.Path != "/api/user/performance/profiles/"+profileID+"/trend":
+			http.Error(w, "performance profile not found", http.StatusNotFound)
+		case r.URL.Query().Get("format") == "markdown":
+			w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+			_, _ = w.Write([]byte("# Performance trend of saturday-profile\n"))
+		case r.URL.Query().Get("format") == "html":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte("<h1>Performance trend of saturday-profile</h1>\n"))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(models.TrendReport{ProfileID: profileID, Name: "saturday-profile", P99Slope: 0.0007, Regression: true})
+		}
+	}))
+	defer server.Close()
+
+	body, err := fetchTrendReport(server.URL, profileID, "markdown", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != "# Performance trend of saturday-profile\n" {
+		t.Errorf("unexpected markdown report %q", body)
+	}
+	body, err = fetchTrendReport(server.URL, profileID, "html", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != "<h1>Performance trend of saturday-profile</h1>\n" {
+		t.Errorf("unexpected HTML report %q", body)
+	}
+
+	report := &models.TrendReport{}
+	if _, err := fetchTrendReport(server.URL, profileID, "json", report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Name != "saturday-profile" || report.P99Slope != 0.0007 || !report.Regression {
+		t.Errorf("unexpected report %+v", report)
+	}
+
+	if _, err := fetchTrendReport(server.URL, "missing", "markdown", nil); errors.GetCode(err) != ErrTrendReportCode {
+		t.Errorf("fetchTrendReport() error = %v, want code %s", err, ErrTrendReportCode)
+	}
+	// the login page the request is redirected to is not taken for the HTML report
+	if _, err := fetchTrendReport(server.URL, "expired", "html", nil); err == nil || !strings.Contains(err.Error(), "expired authetication token") {
+		t.Errorf("expected the error of an expired token, got %v", err)
+	}
+}
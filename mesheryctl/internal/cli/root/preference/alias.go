@@ -0,0 +1,107 @@
+package preference
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var unsetAliasFlag bool
+
+var aliasCmd = &cobra.Command{
+	Use:   "alias [name] [command]",
+	Short: "Manage the aliases of mesheryctl commands",
+	Long: `List the aliases of mesheryctl commands, or define the alias with the name as a shortcut of the command.
+The arguments given after an alias follow the command it stands for. Aliases never shadow the commands of
+mesheryctl, and the aliases of the config override the built-in ones.`,
+	Args: cobra.MaximumNArgs(2),
+	Example: `
+// List the aliases
+mesheryctl config alias
+
+// Define an alias, run as mesheryctl smoke shop-perf --url https://shop.example.com
+mesheryctl config alias smoke "perf apply --template smoke"
+
+// Remove an alias
+mesheryctl config alias smoke --unset
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		if len(args) == 0 {
+			utils.PrintToTable([]string{"ALIAS", "COMMAND", "SOURCE"}, aliasesToStringArrays(mctlCfg.Aliases))
+			return nil
+		}
+
+		aliases := map[string]string{}
+		for name, command := range mctlCfg.Aliases {
+			aliases[name] = command
+		}
+		name := args[0]
+		switch {
+		case unsetAliasFlag:
+			if _, ok := aliases[name]; !ok {
+				return ErrInvalidAlias(name, "no alias with the name is defined in the config")
+			}
+			delete(aliases, name)
+		case len(args) != 2:
+			return ErrInvalidAlias(name, "the command the alias stands for is missing")
+		case !utils.IsValidAliasName(name):
+			return ErrInvalidAlias(name, "names of aliases are made of lower case letters, digits, - and _")
+		case isCommandName(cmd.Root(), name):
+			return ErrInvalidAlias(name, "a command of mesheryctl has the name")
+		case strings.TrimSpace(args[1]) == "":
+			return ErrInvalidAlias(name, "the command the alias stands for is empty")
+		default:
+			aliases[name] = strings.TrimSpace(args[1])
+		}
+
+		if err = config.UpdateAliasesInConfig(viper.GetViper(), aliases); err != nil {
+			return err
+		}
+		if unsetAliasFlag {
+			utils.Log.Info("Alias ", name, " removed")
+		} else {
+			utils.Log.Info("Alias ", name, " set to ", aliases[name])
+		}
+		return nil
+	},
+}
+
+// isCommandName reports whether a command of mesheryctl has the name or the alias
+func isCommandName(root *cobra.Command, name string) bool {
+	for _, cmd := range root.Commands() {
+		if cmd.Name() == name || cmd.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// aliasesToStringArrays changes the aliases of the config, along with the built-in aliases they do
+// not override, into string arrays sorted by name for tabular format printing
+func aliasesToStringArrays(configured map[string]string) [][]string {
+	var data [][]string
+	for name, command := range configured {
+		data = append(data, []string{name, command, "config"})
+	}
+	for name, command := range utils.BuiltinAliases {
+		if _, ok := configured[name]; !ok {
+			data = append(data, []string{name, command, "built-in"})
+		}
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i][0] < data[j][0] })
+	return data
+}
+
+func init() {
+	aliasCmd.Flags().BoolVar(&unsetAliasFlag, "unset", false, "(optional) Remove the alias with the name from the config")
+}
@@ -0,0 +1,32 @@
+package preference
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestAliasesToStringArrays(t *testing.T) {
+	data := aliasesToStringArrays(map[string]string{"pa": "perf apply --template smoke", "smoke": "perf apply --template smoke"})
+	if len(data) != len(utils.BuiltinAliases)+1 {
+		t.Fatalf("got %d aliases, want the built-in aliases and smoke", len(data))
+	}
+	for i := 1; i < len(data); i++ {
+		if data[i-1][0] > data[i][0] {
+			t.Errorf("aliases are not sorted by name: %v", data)
+		}
+	}
+	for _, row := range data {
+		switch row[0] {
+		case "pa", "smoke":
+			if want := []string{row[0], "perf apply --template smoke", "config"}; !reflect.DeepEqual(row, want) {
+				t.Errorf("got row %v, want %v", row, want)
+			}
+		default:
+			if want := []string{row[0], utils.BuiltinAliases[row[0]], "built-in"}; !reflect.DeepEqual(row, want) {
+				t.Errorf("got row %v, want %v", row, want)
+			}
+		}
+	}
+}
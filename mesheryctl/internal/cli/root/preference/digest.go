@@ -0,0 +1,138 @@
+package preference
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	digestFrequency string
+	digestEmail     string
+	digestSendNow   bool
+)
+
+var digestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "View and change the email digest of Meshery",
+	Long: `View and change how often Meshery Server emails you a digest of the performance results, their
+anomalies and the warnings and errors you did not acknowledge. Meshery Server must be configured with an SMTP server.`,
+	Args: cobra.NoArgs,
+	Example: `
+// View your digest preferences
+mesheryctl config digest
+
+// Receive the digest every day
+mesheryctl config digest --frequency daily --email jane@example.com
+
+// Stop receiving the digest
+mesheryctl config digest --frequency off
+
+// Receive the digest right away
+mesheryctl config digest --send-now
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		path := mctlCfg.GetBaseMesheryURL() + "/api/user/prefs/digest"
+
+		subscription := &models.DigestSubscription{}
+		if err := doDigestRequest("GET", path, nil, subscription); err != nil {
+			return err
+		}
+
+		if cmd.Flags().Changed("frequency") || cmd.Flags().Changed("email") {
+			updateDigestSubscription(subscription, digestFrequency, digestEmail)
+			if err := subscription.Validate(); err != nil {
+				return ErrDigestPreferences(err)
+			}
+			payload, err := json.Marshal(subscription)
+			if err != nil {
+				return err
+			}
+			if err := doDigestRequest("POST", path, bytes.NewReader(payload), subscription); err != nil {
+				return err
+			}
+			utils.Log.Info("Digest preferences updated")
+		}
+
+		if digestSendNow {
+			digest := &models.Digest{}
+			if err := doDigestRequest("POST", path+"/send", nil, digest); err != nil {
+				return err
+			}
+			if digest.Empty() {
+				utils.Log.Info("Nothing happened since the last digest, no email was sent")
+			} else {
+				utils.Log.Info(fmt.Sprintf("Digest of %d results, %d anomalies and %d events sent to %s", len(digest.Results), len(digest.Anomalies), len(digest.Events), subscription.Email))
+			}
+			return nil
+		}
+
+		lastSent := "never"
+		if subscription.LastSentAt != nil {
+			lastSent = subscription.LastSentAt.Local().Format("2006-01-02 15:04:05")
+		}
+		utils.PrintToTable([]string{"FREQUENCY", "EMAIL", "LAST SENT"}, [][]string{{string(subscription.Frequency), subscription.Email, lastSent}})
+		return nil
+	},
+}
+
+// updateDigestSubscription applies the frequency and the email address given as flags, an empty
+// value keeping the current one
+func updateDigestSubscription(subscription *models.DigestSubscription, frequency, email string) {
+	if frequency != "" {
+		subscription.Frequency = models.DigestFrequency(strings.ToLower(frequency))
+	}
+	if email != "" {
+		subscription.Email = email
+	}
+}
+
+// doDigestRequest sends the request to Meshery Server and unmarshals the response into out
+func doDigestRequest(method, url string, body io.Reader, out interface{}) error {
+	req, err := utils.NewRequest(method, url, body)
+	if err != nil {
+		return ErrDigestPreferences(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return ErrDigestPreferences(err)
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return ErrDigestPreferences(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return ErrDigestPreferences(fmt.Errorf("server responded with status %d: %s", res.StatusCode, strings.TrimSpace(string(data))))
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return ErrDigestPreferences(err)
+	}
+	return nil
+}
+
+func init() {
+	digestCmd.Flags().StringVar(&digestFrequency, "frequency", "", "how often to receive the digest, daily, weekly or off")
+	digestCmd.Flags().StringVar(&digestEmail, "email", "", "email address the digest is sent to")
+	digestCmd.Flags().BoolVar(&digestSendNow, "send-now", false, "send the digest right away")
+}
@@ -0,0 +1,64 @@
+package preference
+
+import (
+	"testing"
+
+	"github.com/layer5io/meshery/models"
+)
+
+func TestUpdateDigestSubscription(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Frequency string
+		Email     string
+		Expected  models.DigestSubscription
+		ExpectErr bool
+	}{
+		{
+			Name:      "subscribe daily",
+			Frequency: "Daily",
+			Email:     "jane@example.com",
+			Expected:  models.DigestSubscription{Frequency: models.DigestDaily, Email: "jane@example.com"},
+		},
+		{
+			Name:      "keep the email address",
+			Frequency: "weekly",
+			Expected:  models.DigestSubscription{Frequency: models.DigestWeekly, Email: "john@example.com"},
+		},
+		{
+			Name:      "unsubscribe",
+			Frequency: "off",
+			Expected:  models.DigestSubscription{Frequency: models.DigestOff, Email: "john@example.com"},
+		},
+		{
+			Name:      "invalid frequency",
+			Frequency: "hourly",
+			ExpectErr: true,
+		},
+		{
+			Name:      "invalid email address",
+			Email:     "john",
+			ExpectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			subscription := models.DigestSubscription{Frequency: models.DigestDaily, Email: "john@example.com"}
+			updateDigestSubscription(&subscription, tt.Frequency, tt.Email)
+			err := subscription.Validate()
+			if tt.ExpectErr {
+				if err == nil {
+					t.Fatalf("expected an error for --frequency %q --email %q", tt.Frequency, tt.Email)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if subscription != tt.Expected {
+				t.Errorf("got %+v want %+v", subscription, tt.Expected)
+			}
+		})
+	}
+}
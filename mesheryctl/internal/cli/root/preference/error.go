@@ -0,0 +1,35 @@
+package preference
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrInvalidPreferenceCode = "1049"
+	ErrSyncPreferencesCode   = "1050"
+	ErrSyncDirectionCode     = "1051"
+	ErrDigestPreferencesCode = "1065"
+	ErrInvalidAliasCode      = "1079"
+)
+
+var (
+	ErrSyncDirection = errors.New(ErrSyncDirectionCode, errors.Alert, []string{"Sync direction not specified"}, []string{"exactly one of --push or --pull must be given"}, []string{}, []string{"Use --push to upload the local preferences or --pull to download the ones stored under your account"})
+)
+
+func ErrInvalidPreference(key string) error {
+	return errors.New(ErrInvalidPreferenceCode, errors.Alert, []string{"Invalid preference"}, []string{fmt.Sprintf("%s is not a valid preference or value", key)}, []string{}, []string{"Run `mesheryctl config set --help` to see the available preferences"})
+}
+
+func ErrSyncPreferences(err error) error {
+	return errors.New(ErrSyncPreferencesCode, errors.Alert, []string{"Unable to sync preferences with Meshery Server"}, []string{err.Error()}, []string{}, []string{"Check that Meshery Server is running and that you are logged in"})
+}
+
+func ErrDigestPreferences(err error) error {
+	return errors.New(ErrDigestPreferencesCode, errors.Alert, []string{"Unable to update the digest preferences"}, []string{err.Error()}, []string{"The frequency or the email address is invalid", "Meshery Server is not configured to send emails"}, []string{"Use --frequency daily, weekly or off along with a valid --email", "Check that Meshery Server is running, that you are logged in and that its SMTP server is configured"})
+}
+
+func ErrInvalidAlias(name, reason string) error {
+	return errors.New(ErrInvalidAliasCode, errors.Alert, []string{"Invalid alias"}, []string{fmt.Sprintf("%s: %s", name, reason)}, []string{}, []string{"Run `mesheryctl config alias --help` to see how aliases are defined"})
+}
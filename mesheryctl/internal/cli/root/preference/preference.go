@@ -0,0 +1,34 @@
+package preference
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+)
+
+// ConfigCmd represents the root command for managing the preferences of mesheryctl
+var ConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage mesheryctl preferences",
+	Long:  `View, set and sync the preferences of mesheryctl with the ones stored under your Meshery account, and manage the aliases of its commands`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	ConfigCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+
+	availableSubcommands = []*cobra.Command{viewCmd, setCmd, syncCmd, digestCmd, aliasCmd}
+	ConfigCmd.AddCommand(availableSubcommands...)
+}
@@ -0,0 +1,68 @@
+package preference
+
+import (
+	"strconv"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var setCmd = &cobra.Command{
+	Use:   "set [preference] [value]",
+	Short: "Set a local preference of mesheryctl",
+	Long: `Set a local preference of mesheryctl. Available preferences:
+  output-format      default output format of the commands which accept it (table, json, yaml)
+  skip-confirmation  skip the confirmation prompts (true, false)
+  telemetry-opt-out  opt your account out of the anonymous usage statistics and performance
+                     results Meshery sends, once pushed with config sync (true, false)`,
+	Args: cobra.ExactArgs(2),
+	Example: `
+// Skip the confirmation prompts
+mesheryctl config set skip-confirmation true
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		prefs := mctlCfg.Preferences
+		if err = setPreference(&prefs, args[0], args[1]); err != nil {
+			return err
+		}
+		if err = config.UpdatePreferencesInConfig(viper.GetViper(), &prefs); err != nil {
+			return err
+		}
+		utils.Log.Info("Preference ", args[0], " set to ", args[1])
+		return nil
+	},
+}
+
+// setPreference sets the preference with the given key to the given value
+func setPreference(prefs *config.Preferences, key, value string) error {
+	switch key {
+	case "output-format":
+		switch value {
+		case "table", "json", "yaml":
+			prefs.OutputFormat = value
+		default:
+			return ErrInvalidPreference(key)
+		}
+	case "skip-confirmation", "telemetry-opt-out":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return ErrInvalidPreference(key)
+		}
+		if key == "skip-confirmation" {
+			prefs.SkipConfirmation = b
+		} else {
+			prefs.TelemetryOptOut = b
+		}
+	default:
+		return ErrInvalidPreference(key)
+	}
+	return nil
+}
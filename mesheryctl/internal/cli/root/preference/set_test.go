@@ -0,0 +1,73 @@
+package preference
+
+import (
+	"testing"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+)
+
+func TestSetPreference(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Key       string
+		Value     string
+		Expected  config.Preferences
+		ExpectErr bool
+	}{
+		{
+			Name:     "set output format",
+			Key:      "output-format",
+			Value:    "json",
+			Expected: config.Preferences{OutputFormat: "json"},
+		},
+		{
+			Name:     "skip confirmation prompts",
+			Key:      "skip-confirmation",
+			Value:    "true",
+			Expected: config.Preferences{SkipConfirmation: true},
+		},
+		{
+			Name:     "opt out of telemetry",
+			Key:      "telemetry-opt-out",
+			Value:    "1",
+			Expected: config.Preferences{TelemetryOptOut: true},
+		},
+		{
+			Name:      "invalid boolean",
+			Key:       "skip-confirmation",
+			Value:     "maybe",
+			ExpectErr: true,
+		},
+		{
+			Name:      "unsupported output format",
+			Key:       "output-format",
+			Value:     "xml",
+			ExpectErr: true,
+		},
+		{
+			Name:      "unknown preference",
+			Key:       "color",
+			Value:     "true",
+			ExpectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			prefs := config.Preferences{}
+			err := setPreference(&prefs, tt.Key, tt.Value)
+			if tt.ExpectErr {
+				if err == nil {
+					t.Fatalf("expected an error for %s=%s", tt.Key, tt.Value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if prefs != tt.Expected {
+				t.Errorf("got %+v want %+v", prefs, tt.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package preference
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	push bool
+	pull bool
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Sync the preferences of mesheryctl with Meshery Server",
+	Long:  `Push the local preferences of mesheryctl to your Meshery account or pull the ones stored under it, so that they follow you across machines`,
+	Args:  cobra.NoArgs,
+	Example: `
+// Store the local preferences under your account
+mesheryctl config sync --push
+
+// Replace the local preferences with the ones stored under your account
+mesheryctl config sync --pull
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if push == pull {
+			return ErrSyncDirection
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		path := mctlCfg.GetBaseMesheryURL() + "/api/user/prefs/cli"
+
+		var req *http.Request
+		if push {
+			payload, err := json.Marshal(toCLIPreferences(mctlCfg.Preferences))
+			if err != nil {
+				return err
+			}
+			req, err = utils.NewRequest("POST", path, bytes.NewReader(payload))
+			if err != nil {
+				return ErrSyncPreferences(err)
+			}
+		} else {
+			req, err = utils.NewRequest("GET", path, nil)
+			if err != nil {
+				return ErrSyncPreferences(err)
+			}
+		}
+
+		client := &http.Client{}
+		res, err := client.Do(req)
+		if err != nil {
+			return ErrSyncPreferences(err)
+		}
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return ErrSyncPreferences(err)
+		}
+		if res.StatusCode != http.StatusOK {
+			return ErrSyncPreferences(fmt.Errorf("server responded with status %d: %s", res.StatusCode, string(body)))
+		}
+
+		if push {
+			utils.Log.Info("Preferences stored under your Meshery account")
+			return nil
+		}
+
+		cliPrefs := models.CLIPreferences{}
+		if err = json.Unmarshal(body, &cliPrefs); err != nil {
+			return ErrSyncPreferences(err)
+		}
+		prefs := fromCLIPreferences(cliPrefs)
+		if err = config.UpdatePreferencesInConfig(viper.GetViper(), &prefs); err != nil {
+			return err
+		}
+		utils.Log.Info("Preferences pulled from your Meshery account")
+		return nil
+	},
+}
+
+func init() {
+	syncCmd.Flags().BoolVar(&push, "push", false, "store the local preferences under your Meshery account")
+	syncCmd.Flags().BoolVar(&pull, "pull", false, "replace the local preferences with the ones stored under your Meshery account")
+}
+
+func toCLIPreferences(prefs config.Preferences) models.CLIPreferences {
+	return models.CLIPreferences{
+		OutputFormat:     prefs.OutputFormat,
+		SkipConfirmation: prefs.SkipConfirmation,
+		TelemetryOptOut:  prefs.TelemetryOptOut,
+	}
+}
+
+func fromCLIPreferences(cliPrefs models.CLIPreferences) config.Preferences {
+	return config.Preferences{
+		OutputFormat:     cliPrefs.OutputFormat,
+		SkipConfirmation: cliPrefs.SkipConfirmation,
+		TelemetryOptOut:  cliPrefs.TelemetryOptOut,
+	}
+}
@@ -0,0 +1,21 @@
+package prometheus
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrPrometheusQueryCode     = "1057"
+	ErrInvalidOutputFormatCode = "1058"
+)
+
+func ErrPrometheusQuery(status int, message string) error {
+	return errors.New(ErrPrometheusQueryCode, errors.Alert, []string{"Unable to run the PromQL query"}, []string{fmt.Sprintf("Meshery Server returned status %d: %s", status, strings.TrimSpace(message))}, []string{"The query is not valid PromQL", "No Prometheus connection is registered with the given name", "Prometheus is not reachable from Meshery Server"}, []string{"Check the query and the name of the connection given with --connection"})
+}
+
+func ErrInvalidOutputFormat(format string) error {
+	return errors.New(ErrInvalidOutputFormatCode, errors.Alert, []string{"Invalid output format " + format}, []string{"The output format " + format + " is not supported"}, []string{}, []string{"Use one of table, json or yaml"})
+}
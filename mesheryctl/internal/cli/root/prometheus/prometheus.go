@@ -0,0 +1,34 @@
+package prometheus
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+)
+
+// PrometheusCmd represents the root command for prometheus commands
+var PrometheusCmd = &cobra.Command{
+	Use:   "prometheus",
+	Short: "Query the Prometheus connections of Meshery",
+	Long:  `Run PromQL queries through Meshery against the Prometheus connections registered with it, without direct access to Prometheus`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	PrometheusCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+
+	availableSubcommands = []*cobra.Command{queryCmd}
+	PrometheusCmd.AddCommand(availableSubcommands...)
+}
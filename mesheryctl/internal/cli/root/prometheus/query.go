@@ -0,0 +1,294 @@
+package prometheus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// sparklineWidth is the largest number of points a series is drawn with
+	sparklineWidth = 40
+	// rangePoints is the number of points a range query returns when no --step is given
+	rangePoints = 120
+)
+
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+var (
+	connection   string
+	since        time.Duration
+	step         time.Duration
+	outputFormat string
+)
+
+// promResponse is the response of the Prometheus query API
+type promResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// promSeries is a series of an instant (vector) or range (matrix) query result
+type promSeries struct {
+	Metric map[string]string `json:"metric"`
+	Value  []interface{}     `json:"value"`
+	Values [][]interface{}   `json:"values"`
+}
+
+var queryCmd = &cobra.Command{
+	Use:   "query [promql]",
+	Short: "Run a PromQL query against a Prometheus connection",
+	Long: `Run an ad hoc PromQL query through Meshery against one of its Prometheus connections.
+The query is an instant query unless --since is given, in which case the series over that
+period are drawn as sparklines along with their minimum, maximum and last values.`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// Run an instant query against the default Prometheus connection
+mesheryctl exp prometheus query 'up'
+
+// Draw the 99th percentile latency over the last hour from the prod connection
+mesheryctl exp prometheus query --connection prod --since 1h 'histogram_quantile(0.99, sum(rate(istio_request_duration_milliseconds_bucket[5m])) by (le, destination_service))'
+
+// Print the response of Prometheus as JSON
+mesheryctl exp prometheus query 'sum(rate(istio_requests_total[1m]))' -o json
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormat != "table" && outputFormat != "json" && outputFormat != "yaml" {
+			return ErrInvalidOutputFormat(outputFormat)
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		body, err := runQuery(mctlCfg.GetBaseMesheryURL(), strings.Join(args, " "), time.Now())
+		if err != nil {
+			return err
+		}
+
+		switch outputFormat {
+		case "json":
+			var buf bytes.Buffer
+			if err := json.Indent(&buf, body, "", "  "); err != nil {
+				return err
+			}
+			fmt.Println(buf.String())
+			return nil
+		case "yaml":
+			out, err := yaml.JSONToYAML(body)
+			if err != nil {
+				return err
+			}
+			fmt.Print(string(out))
+			return nil
+		}
+
+		header, rows, err := resultTable(body)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			utils.Log.Info("The query returned no data")
+			return nil
+		}
+		utils.PrintToTable(header, rows)
+		return nil
+	},
+}
+
+// runQuery runs the query against the Prometheus connection through Meshery Server,
+// as a range query ending at the given time when --since is given
+func runQuery(baseURL, query string, now time.Time) ([]byte, error) {
+	q := url.Values{}
+	q.Set("query", query)
+	if since > 0 {
+		s := step
+		if s <= 0 {
+			s = since / rangePoints
+			if s < time.Second {
+				s = time.Second
+			}
+		}
+		q.Set("start", strconv.FormatInt(now.Add(-since).Unix(), 10))
+		q.Set("end", strconv.FormatInt(now.Unix(), 10))
+		q.Set("step", strconv.FormatFloat(s.Seconds(), 'f', -1, 64))
+	}
+
+	req, err := utils.NewRequest("GET", baseURL+"/api/telemetry/metrics/connections/"+url.PathEscape(connection)+"/query?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, ErrPrometheusQuery(res.StatusCode, string(body))
+	}
+	return body, nil
+}
+
+// resultTable returns the rows rendering the query result in the response of Prometheus
+func resultTable(body []byte) ([]string, [][]string, error) {
+	resp := promResponse{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	switch resp.Data.ResultType {
+	case "scalar", "string":
+		value := []interface{}{}
+		if err := json.Unmarshal(resp.Data.Result, &value); err != nil {
+			return nil, nil, err
+		}
+		if len(value) < 2 {
+			return nil, nil, nil
+		}
+		return []string{"VALUE"}, [][]string{{fmt.Sprint(value[1])}}, nil
+	case "vector", "matrix":
+	default:
+		return nil, nil, errors.Errorf("unsupported result type %q", resp.Data.ResultType)
+	}
+
+	series := []promSeries{}
+	if err := json.Unmarshal(resp.Data.Result, &series); err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(series, func(i, j int) bool { return metricName(series[i].Metric) < metricName(series[j].Metric) })
+
+	rows := [][]string{}
+	if resp.Data.ResultType == "vector" {
+		for _, s := range series {
+			if len(s.Value) < 2 {
+				continue
+			}
+			rows = append(rows, []string{metricName(s.Metric), fmt.Sprint(s.Value[1])})
+		}
+		return []string{"METRIC", "VALUE"}, rows, nil
+	}
+
+	for _, s := range series {
+		values := make([]float64, 0, len(s.Values))
+		for _, point := range s.Values {
+			if len(point) < 2 {
+				continue
+			}
+			v, err := strconv.ParseFloat(fmt.Sprint(point[1]), 64)
+			if err != nil {
+				v = math.NaN()
+			}
+			values = append(values, v)
+		}
+		min, max, last := math.NaN(), math.NaN(), math.NaN()
+		for _, v := range values {
+			if math.IsNaN(v) {
+				continue
+			}
+			if math.IsNaN(min) || v < min {
+				min = v
+			}
+			if math.IsNaN(max) || v > max {
+				max = v
+			}
+			last = v
+		}
+		rows = append(rows, []string{metricName(s.Metric), formatValue(min), formatValue(max), formatValue(last), sparkline(values, sparklineWidth)})
+	}
+	return []string{"METRIC", "MIN", "MAX", "LAST", "TREND"}, rows, nil
+}
+
+// metricName renders the labels of a series the way PromQL selects it, e.g. up{job="prometheus"}
+func metricName(metric map[string]string) string {
+	labels := make([]string, 0, len(metric))
+	for k, v := range metric {
+		if k != "__name__" {
+			labels = append(labels, fmt.Sprintf("%s=%q", k, v))
+		}
+	}
+	sort.Strings(labels)
+	return metric["__name__"] + "{" + strings.Join(labels, ", ") + "}"
+}
+
+func formatValue(v float64) string {
+	if math.IsNaN(v) {
+		return "-"
+	}
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
+
+// sparkline draws the values with at most width block characters, averaging neighbouring
+// values when there are more values than that. Missing (NaN) values are drawn as spaces.
+func sparkline(values []float64, width int) string {
+	if len(values) > width {
+		buckets := make([]float64, width)
+		for i := range buckets {
+			from, to := i*len(values)/width, (i+1)*len(values)/width
+			sum, n := 0.0, 0
+			for _, v := range values[from:to] {
+				if !math.IsNaN(v) {
+					sum += v
+					n++
+				}
+			}
+			buckets[i] = math.NaN()
+			if n > 0 {
+				buckets[i] = sum / float64(n)
+			}
+		}
+		values = buckets
+	}
+
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			min = math.Min(min, v)
+			max = math.Max(max, v)
+		}
+	}
+	var b strings.Builder
+	for _, v := range values {
+		switch {
+		case math.IsNaN(v):
+			b.WriteRune(' ')
+		case max == min:
+			b.WriteRune(sparklineTicks[len(sparklineTicks)/2])
+		default:
+			b.WriteRune(sparklineTicks[int((v-min)/(max-min)*float64(len(sparklineTicks)-1)+0.5)])
+		}
+	}
+	return b.String()
+}
+
+func init() {
+	queryCmd.Flags().StringVarP(&connection, "connection", "c", "default", "Name of the Prometheus connection to query")
+	queryCmd.Flags().DurationVar(&since, "since", 0, "Run a range query over this period up to now, e.g. 1h")
+	queryCmd.Flags().DurationVar(&step, "step", 0, "Resolution of a range query (default: the period given with --since divided in 120 points)")
+	queryCmd.Flags().StringVarP(&outputFormat, "output-format", "o", "table", "Format of the result: table, json or yaml")
+	utils.SetOutputFormats(queryCmd, "table", "json", "yaml")
+}
@@ -0,0 +1,92 @@
+package prometheus
+
+import (
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshkit/errors"
+)
+
+func TestRunQuery(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	_, filename, _, _ := runtime.Caller(0)
+	utils.TokenFlag = filepath.Join(filepath.Dir(filename), "fixtures", "auth.json")
+
+	queryURL := "http://localhost:9081/api/telemetry/metrics/connections/prod/query"
+	var got map[string]string
+	httpmock.RegisterResponder("GET", queryURL, func(req *http.Request) (*http.Response, error) {
+		got = map[string]string{}
+		for key := range req.URL.Query() {
+			got[key] = req.URL.Query().Get(key)
+		}
+		return httpmock.NewStringResponse(http.StatusOK, `{"status":"success"}`), nil
+	})
+
+	connection, since, step = "prod", time.Hour, 0
+	defer func() { connection, since, step = "default", 0, 0 }()
+	now := time.Unix(1700000000, 0)
+	if _, err := runQuery("http://localhost:9081", "up", now); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"query": "up", "start": "1699996400", "end": "1700000000", "step": "30"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("runQuery() sent %v, want %v", got, want)
+	}
+
+	httpmock.RegisterResponder("GET", queryURL, httpmock.NewStringResponder(http.StatusNotFound, "Prometheus connection prod not found"))
+	if _, err := runQuery("http://localhost:9081", "up", now); errors.GetCode(err) != ErrPrometheusQueryCode {
+		t.Errorf("runQuery() error = %v, want code %s", err, ErrPrometheusQueryCode)
+	}
+}
+
+func TestResultTable(t *testing.T) {
+	vector := `{"status":"success","data":{"resultType":"vector","result":[
+		{"metric":{"__name__":"up","job":"node"},"value":[1700000000,"0"]},
+		{"metric":{"__name__":"up","job":"api","instance":"a:80"},"value":[1700000000,"1"]}
+	]}}`
+	header, rows, err := resultTable([]byte(vector))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRows := [][]string{{`up{instance="a:80", job="api"}`, "1"}, {`up{job="node"}`, "0"}}
+	if !reflect.DeepEqual(header, []string{"METRIC", "VALUE"}) || !reflect.DeepEqual(rows, wantRows) {
+		t.Errorf("resultTable() of a vector = %v %v, want %v", header, rows, wantRows)
+	}
+
+	matrix := `{"status":"success","data":{"resultType":"matrix","result":[
+		{"metric":{"destination_service":"reviews"},"values":[[1,"1"],[2,"NaN"],[3,"8"],[4,"4.5"]]}
+	]}}`
+	_, rows, err = resultTable([]byte(matrix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRows = [][]string{{`{destination_service="reviews"}`, "1", "8", "4.5", "▁ █▅"}}
+	if !reflect.DeepEqual(rows, wantRows) {
+		t.Errorf("resultTable() of a matrix = %v, want %v", rows, wantRows)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		values []float64
+		width  int
+		want   string
+	}{
+		{values: []float64{0, 1, 2, 3, 4, 5, 6, 7}, width: 10, want: "▁▂▃▄▅▆▇█"},
+		{values: []float64{3, 3, 3}, width: 10, want: "▅▅▅"},
+		{values: []float64{0, 0, 10, 10}, width: 2, want: "▁█"},
+	}
+	for _, tt := range tests {
+		if got := sparkline(tt.values, tt.width); got != tt.want {
+			t.Errorf("sparkline(%v, %d) = %q, want %q", tt.values, tt.width, got, tt.want)
+		}
+	}
+}
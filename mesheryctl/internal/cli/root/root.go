@@ -18,13 +18,20 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/adapter"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/app"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/component"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/constants"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/events"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/experimental"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/mesh"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/pattern"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/perf"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/preference"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/system"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
 	log "github.com/sirupsen/logrus"
@@ -34,8 +41,12 @@ import (
 )
 
 var (
-	cfgFile string
-	verbose = false
+	cfgFile   string
+	verbose   = 0
+	debugHTTP string
+	// the command mesheryctl runs, resolved as cobra resolves it from the arguments with the
+	// aliases expanded
+	runCmd *cobra.Command
 )
 
 var (
@@ -65,9 +76,74 @@ var RootCmd = &cobra.Command{
 func Execute() {
 	//log formatter for improved UX
 	utils.SetupLogrusFormatter()
+	// the aliases of the config are resolved before the command is, as they stand for it
+	args := utils.ExpandAliases(os.Args[1:], utils.AliasesFromConfig(os.Args[1:]), isCommand)
+	RootCmd.SetArgs(args)
+	runCmd = resolveCommand(args)
 	_ = RootCmd.Execute()
 }
 
+// resolveCommand returns the command cobra runs for the arguments, nil when there is none
+func resolveCommand(args []string) *cobra.Command {
+	find := RootCmd.Find
+	if RootCmd.TraverseChildren {
+		find = RootCmd.Traverse
+	}
+	cmd, _, err := find(args)
+	if err != nil {
+		return nil
+	}
+	return cmd
+}
+
+// verbosityValue is the value of the --verbose flag, counting the times the flag is given as
+// -v, -vv or -vvv, while --verbose=true and --verbose=false set the verbosity to that of -v or
+// turn it off as they did when the flag was a boolean
+type verbosityValue int
+
+func (v *verbosityValue) Set(s string) error {
+	// "+1" is set when the flag is given without a value
+	if s == "+1" {
+		*v++
+		return nil
+	}
+	if b, err := strconv.ParseBool(s); err == nil {
+		*v = 0
+		if b {
+			*v = utils.VerbosityRequests
+		}
+		return nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid verbosity %q, expected true, false or the level of verbosity", s)
+	}
+	*v = verbosityValue(n)
+	return nil
+}
+
+func (v *verbosityValue) Type() string {
+	return "count"
+}
+
+func (v *verbosityValue) String() string {
+	return strconv.Itoa(int(*v))
+}
+
+// isCommand reports whether the name is the name or an alias of a command of mesheryctl
+func isCommand(name string) bool {
+	// the help and completion commands are added by cobra as the command runs
+	if name == "help" || name == "completion" {
+		return true
+	}
+	for _, cmd := range RootCmd.Commands() {
+		if cmd.Name() == name || cmd.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
 func init() {
 	err := utils.SetFileLocation()
 	if err != nil {
@@ -77,14 +153,21 @@ func init() {
 	cobra.OnInitialize(initConfig)
 	cobra.OnInitialize(setVerbose)
 	cobra.OnInitialize(setupLogger)
+	cobra.OnInitialize(setupHTTPDebug)
+	cobra.OnInitialize(setupVerboseHTTP)
+	cobra.OnInitialize(checkVersionSkew)
 
 	RootCmd.PersistentFlags().StringVar(&cfgFile, "config", utils.DefaultConfigPath, "path to config file")
 
 	// Preparing for an "edge" channel
 	// RootCmd.PersistentFlags().StringVar(&cfgFile, "edge", "", "flag to run Meshery as edge (one-time)")
 
-	// global verbose flag for verbose logs
-	RootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
+	// global verbose flag for verbose logs, repeated to raise the verbosity
+	RootCmd.PersistentFlags().VarP((*verbosityValue)(&verbose), "verbose", "v", "verbose output, -v showing request summaries, -vv full HTTP traces and -vvv the server-side debug")
+	RootCmd.PersistentFlags().Lookup("verbose").NoOptDefVal = "+1"
+
+	// global flag recording the HTTP requests to Meshery Server for bug reports
+	RootCmd.PersistentFlags().StringVar(&debugHTTP, "debug-http", "", "record the HTTP requests made and their responses, with the tokens redacted, into the given HAR file")
 
 	availableSubcommands = []*cobra.Command{
 		versionCmd,
@@ -93,6 +176,10 @@ func init() {
 		perf.PerfCmd,
 		mesh.MeshCmd,
 		app.AppCmd,
+		adapter.AdapterCmd,
+		preference.ConfigCmd,
+		component.ComponentCmd,
+		events.EventsCmd,
 		experimental.ExpCmd,
 	}
 
@@ -178,17 +265,103 @@ func initConfig() {
 	if err := viper.ReadInConfig(); err == nil {
 		log.Debug("Using config file:", viper.ConfigFileUsed())
 	}
+
+	if mctlCfg, err := config.GetMesheryCtl(viper.GetViper()); err == nil {
+		// Honor the preference to skip the confirmation prompts
+		if mctlCfg.Preferences.SkipConfirmation {
+			utils.SilentFlag = true
+		}
+		// and the preferred output format of the commands
+		utils.ApplyOutputFormat(runCmd, mctlCfg.Preferences.OutputFormat)
+		// Resolve the components in the namespaces of the current context
+		if currCtx, err := mctlCfg.CheckIfCurrentContextIsValid(); err == nil {
+			utils.SetNamespaces(currCtx)
+		}
+	}
 }
 
 // setVerbose sets the log level to debug if the -v flag is set
 func setVerbose() {
 	log.SetLevel(log.InfoLevel)
+	utils.Verbosity = verbose
 
-	if verbose {
+	if verbose >= utils.VerbosityRequests {
 		log.SetLevel(log.DebugLevel)
 	}
 }
 
 func setupLogger() {
-	utils.SetupMeshkitLogger(verbose, nil)
+	utils.SetupMeshkitLogger(verbose >= utils.VerbosityRequests, nil)
+}
+
+// setupHTTPDebug records the HTTP requests made by mesheryctl into the HAR file given by --debug-http
+func setupHTTPDebug() {
+	if debugHTTP == "" {
+		return
+	}
+	if err := utils.EnableHTTPDebug(debugHTTP); err != nil {
+		log.Fatal(err)
+	}
+	log.Debugf("Recording the HTTP requests into %s", debugHTTP)
+}
+
+// setupVerboseHTTP logs the HTTP requests made by mesheryctl at the verbosity set with -v, -vv or -vvv
+func setupVerboseHTTP() {
+	if verbose < utils.VerbosityRequests {
+		return
+	}
+	if err := utils.EnableVerboseHTTP(verbose); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// commands skipping the version skew check, as they report the versions themselves
+// or run while Meshery Server is not expected to be up
+var skipVersionSkewCheck = []string{
+	"mesheryctl version",
+	"mesheryctl system check",
+	"mesheryctl system native-run",
+	"mesheryctl system completion",
+	"mesheryctl system context",
+	"mesheryctl system reset",
+	"mesheryctl system start",
+	"mesheryctl system stop",
+}
+
+// checkVersionSkew warns when the major or minor version of mesheryctl differs from the one
+// of Meshery Server, as their APIs may not match. The version of the server is cached
+// so that commands do not query it every time.
+func checkVersionSkew() {
+	if skipsVersionSkewCheck(runCmd) {
+		return
+	}
+
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return
+	}
+	currCtx, err := mctlCfg.CheckIfCurrentContextIsValid()
+	if err != nil || currCtx.GetEndpoint() == "" {
+		return
+	}
+
+	client := constants.GetMesheryctlVersion()
+	server := utils.CachedServerVersion(currCtx.GetEndpoint())
+	if utils.IsVersionSkewed(client, server) {
+		log.Warnf("mesheryctl %s and Meshery Server %s differ in version, which may lead to unexpected API errors. Run `mesheryctl system check --version-skew` for details.", client, server)
+	}
+}
+
+// skipsVersionSkewCheck reports whether the command skips the version skew check, as do the
+// commands of skipVersionSkewCheck and those only printing help
+func skipsVersionSkewCheck(cmd *cobra.Command) bool {
+	if cmd == nil || cmd == RootCmd || !cmd.Runnable() || cmd.Name() == "help" || strings.HasPrefix(cmd.Name(), cobra.ShellCompRequestCmd) {
+		return true
+	}
+	for _, path := range skipVersionSkewCheck {
+		if strings.HasPrefix(cmd.CommandPath(), path) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,70 @@
+package root
+
+import (
+	"testing"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestVerboseFlag(t *testing.T) {
+	tests := []struct {
+		args    []string
+		want    int
+		wantErr bool
+	}{
+		{args: []string{}, want: 0},
+		{args: []string{"-v"}, want: 1},
+		{args: []string{"-vv"}, want: 2},
+		{args: []string{"-vvv"}, want: 3},
+		{args: []string{"-v", "-v"}, want: 2},
+		{args: []string{"--verbose"}, want: 1},
+		{args: []string{"--verbose=true"}, want: 1},
+		{args: []string{"--verbose=false"}, want: 0},
+		{args: []string{"-vv", "--verbose=false"}, want: 0},
+		{args: []string{"--verbose=3"}, want: 3},
+		{args: []string{"--verbose=loud"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		verbose = 0
+		err := RootCmd.PersistentFlags().Parse(tt.args)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%v parsed as verbosity %d, want an error", tt.args, verbose)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %v", tt.args, err)
+			continue
+		}
+		if verbose != tt.want {
+			t.Errorf("%v parsed as verbosity %d, want %d", tt.args, verbose, tt.want)
+		}
+	}
+	verbose = 0
+}
+
+func TestSkipsVersionSkewCheck(t *testing.T) {
+	aliases := map[string]string{"ss": "system status", "ctx": "system context", "da": "design apply", "up": "system start"}
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{args: []string{"perf", "result"}, want: false},
+		{args: []string{"version"}, want: true},
+		{args: []string{"system", "check", "--preflight"}, want: true},
+		// the aliases of the config are resolved to the commands they stand for
+		{args: []string{"ss"}, want: false},
+		{args: []string{"ctx", "list"}, want: true},
+		{args: []string{"-v", "up"}, want: true},
+		// as are the aliases of the commands themselves
+		{args: []string{"da", "-f", "bookinfo.yaml"}, want: false},
+		{args: []string{"unknown"}, want: true},
+	}
+	for _, tt := range tests {
+		cmd := resolveCommand(utils.ExpandAliases(tt.args, aliases, isCommand))
+		if got := skipsVersionSkewCheck(cmd); got != tt.want {
+			t.Errorf("%v skips the version skew check: %t, want %t", tt.args, got, tt.want)
+		}
+	}
+}
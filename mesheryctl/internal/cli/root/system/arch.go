@@ -0,0 +1,232 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	meshkitkube "github.com/layer5io/meshkit/utils/kubernetes"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// archLabel is the well-known label of the nodes telling their architecture
+const archLabel = "kubernetes.io/arch"
+
+// imageTagURL is the Docker Hub API describing a tag of an image, along with the platforms it is published for
+var imageTagURL = "https://hub.docker.com/v2/repositories/%s/tags/%s"
+
+// skipArchCheck starts Meshery without checking that its images are published for the architectures
+// of the host or the nodes, e.g. when they run under emulation
+var skipArchCheck bool
+
+// imageArchitectures returns the architectures the image is published for on Docker Hub
+func imageArchitectures(image string) ([]string, error) {
+	repository, tag := image, "latest"
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		repository, tag = image[:i], image[i+1:]
+	}
+	if !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	resp, err := httpClient.Get(fmt.Sprintf(imageTagURL, repository, tag))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to describe the image %s: %s", image, resp.Status)
+	}
+
+	var described struct {
+		Images []struct {
+			Architecture string `json:"architecture"`
+			OS           string `json:"os"`
+		} `json:"images"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&described); err != nil {
+		return nil, err
+	}
+	archs := []string{}
+	for _, i := range described.Images {
+		if (i.OS == "" || i.OS == "linux") && !utils.StringInSlice(i.Architecture, archs) {
+			archs = append(archs, i.Architecture)
+		}
+	}
+	return archs, nil
+}
+
+// componentArchitectures returns, for every component, the architectures among the given ones its
+// image is published for, along with the components none of the architectures can run. The image of
+// a component which cannot be described is assumed to run on every architecture.
+func componentArchitectures(images map[string]string, archs []string, describe func(string) ([]string, error)) (map[string][]string, []string) {
+	supported := map[string][]string{}
+	unsupported := []string{}
+	for component, image := range images {
+		published, err := describe(image)
+		if err != nil || len(published) == 0 {
+			log.Debugf("unable to check the architectures of %s, assuming it runs on %s: %v", image, strings.Join(archs, ", "), err)
+			supported[component] = archs
+			continue
+		}
+		for _, arch := range archs {
+			if utils.StringInSlice(arch, published) {
+				supported[component] = append(supported[component], arch)
+			}
+		}
+		if len(supported[component]) == 0 {
+			unsupported = append(unsupported, fmt.Sprintf("%s (published for %s)", component, strings.Join(published, ", ")))
+		}
+	}
+	sort.Strings(unsupported)
+	return supported, unsupported
+}
+
+// dockerArchitecture returns the architecture of the host the containers run on, as named by images
+func dockerArchitecture() string {
+	cli, err := client.NewClientWithOpts(client.FromEnv)
+	if err != nil {
+		return runtime.GOARCH
+	}
+	info, err := cli.Info(context.Background())
+	if err != nil {
+		return runtime.GOARCH
+	}
+	return normalizeArchitecture(info.Architecture)
+}
+
+// normalizeArchitecture names an architecture reported by the kernel as images name it
+func normalizeArchitecture(arch string) string {
+	switch arch {
+	case "x86_64", "x86-64":
+		return "amd64"
+	case "aarch64", "armv8", "armv8l":
+		return "arm64"
+	case "armv7l", "armv7", "armv6l":
+		return "arm"
+	case "":
+		return runtime.GOARCH
+	}
+	return arch
+}
+
+// checkDockerArchitecture fails when the image of a service, given by service name, is not published
+// for the architecture of the host, before the images are pulled
+func checkDockerArchitecture(images map[string]string) error {
+	if skipArchCheck {
+		return nil
+	}
+	arch := dockerArchitecture()
+	if _, unsupported := componentArchitectures(images, []string{arch}, imageArchitectures); len(unsupported) > 0 {
+		return ErrUnsupportedArchitecture(unsupported, []string{arch})
+	}
+	return nil
+}
+
+// nodeArchitectures returns the architecture of every node of the cluster by name
+func nodeArchitectures(kubeClient *meshkitkube.Client) (map[string]string, error) {
+	nodes, err := kubeClient.KubeClient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	archs := map[string]string{}
+	for _, node := range nodes.Items {
+		arch := node.Labels[archLabel]
+		if arch == "" {
+			arch = node.Status.NodeInfo.Architecture
+		}
+		archs[node.Name] = arch
+	}
+	return archs, nil
+}
+
+// distinctArchitectures returns the architectures of the nodes, sorted
+func distinctArchitectures(nodes map[string]string) []string {
+	archs := []string{}
+	for _, arch := range nodes {
+		if arch != "" && !utils.StringInSlice(arch, archs) {
+			archs = append(archs, arch)
+		}
+	}
+	sort.Strings(archs)
+	return archs
+}
+
+// kubernetesImages returns the images of Meshery and of the adapters of the context deployed by the
+// helm chart, keyed by the values of the chart they are configured with
+func kubernetesImages(currCtx *config.Context, mesheryImageVersion string) map[string]string {
+	images := map[string]string{
+		"meshery": "layer5/meshery:" + currCtx.GetChannel() + "-" + mesheryImageVersion,
+	}
+	for _, component := range currCtx.GetComponents() {
+		images[component] = "layer5/" + component + ":stable-latest"
+	}
+	return images
+}
+
+// placeByArchitecture schedules the components of Meshery on the nodes of the architectures their
+// images are published for, through the override values of the helm chart, when the cluster mixes
+// architectures. It fails when the image of a component runs on none of the nodes.
+func placeByArchitecture(kubeClient *meshkitkube.Client, currCtx *config.Context, mesheryImageVersion string, overrideValues map[string]interface{}) error {
+	if skipArchCheck {
+		return nil
+	}
+	nodes, err := nodeArchitectures(kubeClient)
+	if err != nil {
+		log.Debug("unable to list the architectures of the nodes: ", err)
+		return nil
+	}
+	archs := distinctArchitectures(nodes)
+	if len(archs) == 0 {
+		return nil
+	}
+
+	supported, unsupported := componentArchitectures(kubernetesImages(currCtx, mesheryImageVersion), archs, imageArchitectures)
+	if len(unsupported) > 0 {
+		return ErrUnsupportedArchitecture(unsupported, archs)
+	}
+	for component, componentArchs := range supported {
+		if len(componentArchs) == len(archs) {
+			continue
+		}
+		affinity := architectureAffinity(componentArchs)
+		if component == "meshery" {
+			overrideValues["affinity"] = affinity
+		} else if values, ok := overrideValues[component].(map[string]interface{}); ok {
+			values["affinity"] = affinity
+		}
+		log.Infof("%s is scheduled on the nodes of %s only, its image not being published for the others", component, strings.Join(componentArchs, ", "))
+	}
+	return nil
+}
+
+// architectureAffinity returns the affinity of a pod requiring the nodes of the architectures
+func architectureAffinity(archs []string) map[string]interface{} {
+	return map[string]interface{}{
+		"nodeAffinity": map[string]interface{}{
+			"requiredDuringSchedulingIgnoredDuringExecution": map[string]interface{}{
+				"nodeSelectorTerms": []interface{}{
+					map[string]interface{}{
+						"matchExpressions": []interface{}{
+							map[string]interface{}{
+								"key":      archLabel,
+								"operator": "In",
+								"values":   archs,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package system
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestImageArchitectures(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/repositories/layer5/meshery-istio/tags/stable-latest" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"images":[{"architecture":"amd64","os":"linux"},{"architecture":"arm64","os":"linux"},{"architecture":"amd64","os":"windows"}]}`)
+	}))
+	defer ts.Close()
+	defer func(url string) { imageTagURL = url }(imageTagURL)
+	imageTagURL = ts.URL + "/v2/repositories/%s/tags/%s"
+
+	archs, err := imageArchitectures("layer5/meshery-istio:stable-latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(archs, []string{"amd64", "arm64"}) {
+		t.Errorf("expected the linux architectures of the image, got %v", archs)
+	}
+	if _, err := imageArchitectures("layer5/meshery-kuma:stable-latest"); err == nil {
+		t.Error("expected an unknown image to fail")
+	}
+}
+
+func TestComponentArchitectures(t *testing.T) {
+	published := map[string][]string{
+		"layer5/meshery:stable-latest":       {"amd64", "arm64"},
+		"layer5/meshery-istio:stable-latest": {"amd64"},
+		"layer5/meshery-cpx:stable-latest":   {"s390x"},
+	}
+	describe := func(image string) ([]string, error) {
+		if archs, ok := published[image]; ok {
+			return archs, nil
+		}
+		return nil, fmt.Errorf("unknown image %s", image)
+	}
+	images := map[string]string{
+		"meshery":       "layer5/meshery:stable-latest",
+		"meshery-istio": "layer5/meshery-istio:stable-latest",
+		"meshery-cpx":   "layer5/meshery-cpx:stable-latest",
+		"meshery-kuma":  "layer5/meshery-kuma:stable-latest",
+	}
+
+	supported, unsupported := componentArchitectures(images, []string{"amd64", "arm64"}, describe)
+	want := map[string][]string{
+		"meshery":       {"amd64", "arm64"},
+		"meshery-istio": {"amd64"},
+		"meshery-kuma":  {"amd64", "arm64"},
+	}
+	if !reflect.DeepEqual(supported, want) {
+		t.Errorf("expected the architectures %v, got %v", want, supported)
+	}
+	if !reflect.DeepEqual(unsupported, []string{"meshery-cpx (published for s390x)"}) {
+		t.Errorf("expected meshery-cpx to be unsupported, got %v", unsupported)
+	}
+}
+
+func TestNormalizeArchitecture(t *testing.T) {
+	for arch, want := range map[string]string{"x86_64": "amd64", "aarch64": "arm64", "armv7l": "arm", "s390x": "s390x"} {
+		if got := normalizeArchitecture(arch); got != want {
+			t.Errorf("normalizeArchitecture(%s) = %s, want %s", arch, got, want)
+		}
+	}
+}
@@ -31,6 +31,7 @@ var mctlCfg *config.MesheryCtlConfig
 var err error
 
 var showForAllContext bool
+var showChannelVersions bool
 
 // PrintChannelAndVersionToStdout to return curren release channel details
 func PrintChannelAndVersionToStdout(ctx config.Context, contextName string) string {
@@ -42,6 +43,101 @@ func IsBetaOrStable(str string) bool {
 	return str == "edge" || str == "stable"
 }
 
+// parseChannelVersion splits an argument such as stable, stable-v0.6.0 or edge-latest into its
+// release channel and version, the version defaulting to latest
+func parseChannelVersion(channelVersion string) (string, string, error) {
+	channelNameSeperated := strings.SplitN(channelVersion, "-", 2)
+
+	if !IsBetaOrStable(channelNameSeperated[0]) {
+		return "", "", errors.New("No release channel subscription found." +
+			"Please subscribe to either the 'stable' or 'edge' release channel")
+	}
+
+	version := "latest"
+	if len(channelNameSeperated) > 1 {
+		if channelNameSeperated[0] == "edge" && channelNameSeperated[1] != "latest" {
+			return "", "", errors.New("edge channel only supports latest as version argument")
+		}
+		version = channelNameSeperated[1]
+	}
+	return channelNameSeperated[0], version, nil
+}
+
+// channelImages returns the container images deployed for the context, Meshery first and then
+// its components, following the image tags of Meshery's helm chart and docker-compose file:
+// Meshery is tagged with the channel and version, the adapters with the latest of the channel
+func channelImages(ctx config.Context) [][2]string {
+	images := [][2]string{{"meshery", "layer5/meshery:" + ctx.GetChannel() + "-" + ctx.GetVersion()}}
+	for _, component := range ctx.GetComponents() {
+		images = append(images, [2]string{component, "layer5/" + component + ":" + ctx.GetChannel() + "-latest"})
+	}
+	return images
+}
+
+// channelImageChanges returns, for every image which differs between the contexts, the rows of
+// component, current image and new image
+func channelImageChanges(from, to config.Context) [][]string {
+	current := map[string]string{}
+	for _, image := range channelImages(from) {
+		current[image[0]] = image[1]
+	}
+	var rows [][]string
+	for _, image := range channelImages(to) {
+		if current[image[0]] != image[1] {
+			rows = append(rows, []string{image[0], current[image[0]], image[1]})
+		}
+	}
+	return rows
+}
+
+// channelTarget returns the context in focus subscribed to the release channel and version of
+// the argument, along with the name of the context
+func channelTarget(channelVersion string) (config.Context, config.Context, string, error) {
+	focusedContext := tempContext
+	if focusedContext == "" {
+		focusedContext = mctlCfg.CurrentContext
+	}
+	currCtx, ok := mctlCfg.Contexts[focusedContext]
+	if !ok {
+		return config.Context{}, config.Context{}, "", errors.New("error while trying to fetch context content")
+	}
+	if currCtx.Version == "" {
+		currCtx.Version = "latest"
+	}
+
+	channel, version, err := parseChannelVersion(channelVersion)
+	if err != nil {
+		return config.Context{}, config.Context{}, "", err
+	}
+	target := currCtx
+	target.Channel = channel
+	target.Version = version
+	return currCtx, target, focusedContext, nil
+}
+
+// printChannelDiff prints the release channels and versions of the contexts and the image changes
+// switching from one to the other entails
+func printChannelDiff(from, to config.Context, contextName string) {
+	log.Printf("Context: %v\nChannel: %v-%v → %v-%v", contextName, from.Channel, from.Version, to.Channel, to.Version)
+	rows := channelImageChanges(from, to)
+	if len(rows) == 0 {
+		log.Println("No image changes")
+		return
+	}
+	utils.PrintToTable([]string{"COMPONENT", "CURRENT IMAGE", "NEW IMAGE"}, rows)
+}
+
+// printChannelVersions prints the release each channel currently points to
+func printChannelVersions() {
+	for _, channel := range []string{"stable", "edge"} {
+		_, version, err := utils.GetChannelAndVersion(&config.Context{Channel: channel, Version: "latest"})
+		if err != nil {
+			version = "unknown (" + err.Error() + ")"
+		}
+		log.Printf("%s-latest points to: %s", channel, version)
+	}
+}
+
 var viewCmd = &cobra.Command{
 	Use:   "view",
 	Short: "view release channel and version",
@@ -77,6 +173,9 @@ var viewCmd = &cobra.Command{
 		}
 		log.Print(PrintChannelAndVersionToStdout(*currCtx, focusedContext))
 		log.Println()
+		if showChannelVersions {
+			printChannelVersions()
+		}
 		return nil
 	},
 }
@@ -99,29 +198,9 @@ var setCmd = &cobra.Command{
 			focusedContext = tempContext
 		}
 
-		channelVersion := args[0]
-
-		channelNameSeperated := strings.SplitN(channelVersion, "-", 2)
-
-		if !IsBetaOrStable(channelNameSeperated[0]) {
-			return errors.New("No release channel subscription found." +
-				"Please subscribe to either the 'stable' or 'edge' release channel")
-		}
-
-		version := "latest"
-
-		if len(channelNameSeperated) > 1 {
-			if channelNameSeperated[0] == "edge" {
-				if channelNameSeperated[1] != "latest" {
-					return errors.New("edge channel only supports latest as version argument")
-				}
-			} else if channelNameSeperated[0] == "stable" {
-				if channelNameSeperated[1] != "latest" {
-					currCtx := mctlCfg.Contexts[focusedContext]
-					currCtx.Version = channelNameSeperated[1]
-				}
-			}
-			version = channelNameSeperated[1]
+		channel, version, err := parseChannelVersion(args[0])
+		if err != nil {
+			return err
 		}
 
 		ContextContent, ok := mctlCfg.Contexts[focusedContext]
@@ -130,7 +209,7 @@ var setCmd = &cobra.Command{
 		}
 
 		ContextContent.Version = version
-		ContextContent.Channel = channelNameSeperated[0]
+		ContextContent.Channel = channel
 
 		err = ContextContent.ValidateVersion()
 		if err != nil {
@@ -148,6 +227,32 @@ var setCmd = &cobra.Command{
 	},
 }
 
+var diffCmd = &cobra.Command{
+	Use:   "diff [stable|stable-version|edge|edge-version]",
+	Short: "show the changes of switching release channel and version",
+	Long:  `Show the container images which would change when switching the context in focus to the given release channel and version`,
+	Example: `
+// Show what switching to the edge channel would change
+mesheryctl system channel diff edge
+
+// Show what pinning the stable channel to a release would change
+mesheryctl system channel diff stable-v0.6.0
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err = config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		currCtx, target, focusedContext, err := channelTarget(args[0])
+		if err != nil {
+			return err
+		}
+		printChannelDiff(currCtx, target, focusedContext)
+		return nil
+	},
+}
+
 var switchCmd = &cobra.Command{
 	Use:   "switch [stable|stable-version|edge|edge-version]",
 	Short: "switch release channel and version",
@@ -173,10 +278,11 @@ var switchCmd = &cobra.Command{
 		if err != nil {
 			log.Fatalln(err, "error processing config")
 		}
-		focusedContext := tempContext
-		if focusedContext == "" {
-			focusedContext = mctlCfg.CurrentContext
+		currCtx, target, focusedContext, err := channelTarget(args[0])
+		if err != nil {
+			return err
 		}
+		printChannelDiff(currCtx, target, focusedContext)
 
 		//skip asking confirmation if -y flag used
 		if utils.SilentFlag {
@@ -227,5 +333,6 @@ var channelCmd = &cobra.Command{
 
 func init() {
 	viewCmd.Flags().BoolVarP(&showForAllContext, "all", "a", false, "Show release channel for all contexts")
-	channelCmd.AddCommand(viewCmd, setCmd, switchCmd)
+	viewCmd.Flags().BoolVarP(&showChannelVersions, "channels", "", false, "Show the release each channel currently points to")
+	channelCmd.AddCommand(viewCmd, setCmd, diffCmd, switchCmd)
 }
@@ -125,3 +125,52 @@ func TestRunChannelWithNoCmdOrFlag(t *testing.T) {
 	}
 	BreakupFunc(t)
 }
+
+func TestParseChannelVersion(t *testing.T) {
+	tests := []struct {
+		arg     string
+		channel string
+		version string
+		wantErr bool
+	}{
+		{arg: "stable", channel: "stable", version: "latest"},
+		{arg: "stable-v0.6.0", channel: "stable", version: "v0.6.0"},
+		{arg: "edge-latest", channel: "edge", version: "latest"},
+		{arg: "edge-v0.6.0", wantErr: true},
+		{arg: "beta", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			channel, version, err := parseChannelVersion(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if channel != tt.channel || version != tt.version {
+				t.Errorf("expected %s %s, got %s %s", tt.channel, tt.version, channel, version)
+			}
+		})
+	}
+}
+
+func TestChannelImageChanges(t *testing.T) {
+	from := config.Context{Channel: "stable", Version: "v0.5.10", Components: []string{"meshery-istio"}}
+
+	rows := channelImageChanges(from, config.Context{Channel: "edge", Version: "latest", Components: []string{"meshery-istio"}})
+	expected := [][]string{
+		{"meshery", "layer5/meshery:stable-v0.5.10", "layer5/meshery:edge-latest"},
+		{"meshery-istio", "layer5/meshery-istio:stable-latest", "layer5/meshery-istio:edge-latest"},
+	}
+	if fmt.Sprint(rows) != fmt.Sprint(expected) {
+		t.Errorf("expected changes %v, got %v", expected, rows)
+	}
+
+	rows = channelImageChanges(from, config.Context{Channel: "stable", Version: "latest", Components: []string{"meshery-istio"}})
+	expected = [][]string{{"meshery", "layer5/meshery:stable-v0.5.10", "layer5/meshery:stable-latest"}}
+	if fmt.Sprint(rows) != fmt.Sprint(expected) {
+		t.Errorf("expected changes %v, got %v", expected, rows)
+	}
+
+	if rows := channelImageChanges(from, from); len(rows) != 0 {
+		t.Errorf("expected no changes, got %v", rows)
+	}
+}
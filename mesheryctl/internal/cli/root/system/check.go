@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -27,10 +28,12 @@ import (
 )
 
 var (
-	preflight      bool
-	pre            bool
-	componentsFlag bool
-	failure        int
+	preflight       bool
+	pre             bool
+	componentsFlag  bool
+	versionSkewFlag bool
+	clustersFlag    bool
+	failure         int
 )
 
 type HealthCheckOptions struct {
@@ -76,9 +79,10 @@ func NewHealthChecker(options *HealthCheckOptions) (*HealthChecker, error) {
 	if err != nil {
 		return nil, err
 	}
+	utils.SetNamespaces(currCtx)
 
 	if utils.PlatformFlag != "" {
-		if utils.PlatformFlag == "docker" || utils.PlatformFlag == "kubernetes" {
+		if utils.PlatformFlag == "docker" || utils.PlatformFlag == "kubernetes" || utils.PlatformFlag == "native" {
 			currCtx.SetPlatform(utils.PlatformFlag)
 		} else {
 			return nil, ErrUnsupportedPlatform(utils.PlatformFlag, utils.CfgFile)
@@ -123,6 +127,10 @@ var checkCmd = &cobra.Command{
 			return nil
 		} else if componentsFlag { // if --components has been passed we run checks related to components
 			return hc.runComponentsHealthChecks()
+		} else if versionSkewFlag { // if --version-skew has been passed we report the versions of the components
+			return hc.runVersionSkewChecks()
+		} else if clustersFlag { // if --clusters has been passed we check the connections to the Kubernetes contexts
+			return hc.runClusterHealthChecks()
 		}
 
 		// if no flags passed we run complete system check
@@ -452,6 +460,135 @@ func (hc *HealthChecker) runMesheryVersionHealthChecks() error {
 	return nil
 }
 
+// runVersionSkewChecks reports the versions of mesheryctl, Meshery Server and the adapters,
+// flagging the components whose major or minor version differs from Meshery Server
+func (hc *HealthChecker) runVersionSkewChecks() error {
+	log.Info("\nVersion Skew \n--------------")
+
+	url := hc.mctlCfg.GetBaseMesheryURL()
+	client := constants.GetMesheryctlVersion()
+	serverVersion, err := utils.FetchServerVersion(url, 10*time.Second)
+	if err != nil {
+		log.Info("!! failed to check Meshery Server version. try starting Meshery with `mesheryctl system start`")
+		return nil
+	}
+	server := serverVersion.GetBuild()
+
+	skewed := 0
+	compatibility := func(version string) string {
+		if _, _, ok := utils.ParseMajorMinor(version); !ok {
+			return "unknown"
+		}
+		if utils.IsVersionSkewed(version, server) {
+			skewed++
+			return "!! differs from Meshery Server"
+		}
+		return "✓"
+	}
+	rows := [][]string{
+		{"mesheryctl", client, compatibility(client)},
+		{"Meshery Server", server, "-"},
+	}
+
+	req, err := utils.NewRequest("GET", fmt.Sprintf("%s/api/system/adapters", url), nil)
+	if err != nil {
+		log.Info("!! Authentication token not found, adapters are left out. Login with `mesheryctl system login`")
+	} else {
+		var adapters []*models.Adapter
+		resp, err := http.DefaultClient.Do(req)
+		if err == nil && resp.StatusCode == http.StatusOK {
+			err = json.NewDecoder(resp.Body).Decode(&adapters)
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			log.Info("!! Failed to connect to Meshery Adapters")
+		}
+		for _, adapter := range adapters {
+			name := strings.Split(adapter.Location, ":")[0]
+			if adapter.Version == "" {
+				rows = append(rows, []string{name, "unavailable", "unknown"})
+				continue
+			}
+			rows = append(rows, []string{name, adapter.Version, compatibility(adapter.Version)})
+		}
+	}
+
+	utils.PrintToTable([]string{"Component", "Version", "Compatibility"}, rows)
+	if skewed > 0 {
+		log.Infof("\n!! %d component(s) differ in major or minor version from Meshery Server (%s), which may lead to API mismatches", skewed, server)
+	} else {
+		log.Info("\n✓ No version skew detected")
+	}
+	return nil
+}
+
+// runClusterHealthChecks reports the health of the connections of Meshery Server to the Kubernetes contexts
+func (hc *HealthChecker) runClusterHealthChecks() error {
+	log.Info("\nKubernetes Contexts \n--------------")
+
+	req, err := utils.NewRequest("GET", fmt.Sprintf("%s/api/system/kubernetes/contexts/health", hc.mctlCfg.GetBaseMesheryURL()), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("failed to check the Kubernetes contexts: %s", strings.TrimSpace(string(body)))
+	}
+	var health []models.ClusterHealth
+	if err := json.NewDecoder(resp.Body).Decode(&health); err != nil {
+		return errors.Wrap(err, "failed to decode the health of the Kubernetes contexts")
+	}
+
+	if len(health) == 0 {
+		log.Info("!! No Kubernetes context is registered with Meshery Server. Upload a kubeconfig from the Settings of Meshery UI")
+		return nil
+	}
+	lines, unhealthy := clusterHealthReport(health)
+	for _, line := range lines {
+		log.Info(line)
+	}
+	if unhealthy > 0 {
+		log.Infof("\n!! %d of %d Kubernetes context(s) unhealthy", unhealthy, len(health))
+	} else {
+		log.Info("\n✓✓ All Kubernetes contexts healthy")
+	}
+	return nil
+}
+
+// clusterHealthReport formats the checks of every Kubernetes context, with the remedy of the failing ones,
+// and counts the unhealthy contexts
+func clusterHealthReport(health []models.ClusterHealth) ([]string, int) {
+	lines := []string{}
+	unhealthy := 0
+	for _, cluster := range health {
+		if !cluster.Healthy {
+			unhealthy++
+		}
+		lines = append(lines, fmt.Sprintf("\n%s (%s)", cluster.ContextName, cluster.Server))
+		for _, check := range cluster.Checks {
+			switch check.Status {
+			case models.ClusterHealthPassed:
+				lines = append(lines, fmt.Sprintf("✓ %s: %s", check.Name, check.Message))
+			case models.ClusterHealthSkipped:
+				lines = append(lines, fmt.Sprintf("- %s: %s", check.Name, check.Message))
+			default:
+				lines = append(lines, fmt.Sprintf("!! %s: %s", check.Name, check.Message))
+				if check.Remedy != "" {
+					lines = append(lines, fmt.Sprintf("   remedy: %s", check.Remedy))
+				}
+			}
+		}
+	}
+	return lines, unhealthy
+}
+
 // runComponentsHealthChecks runs health checks for Adapters, Operator, and all deployments in meshery ecosystem
 func (hc *HealthChecker) runComponentsHealthChecks() error {
 	if hc.Options.PrintLogs {
@@ -585,4 +722,6 @@ func init() {
 	checkCmd.Flags().BoolVarP(&preflight, "preflight", "", false, "Verify environment readiness to deploy Meshery")
 	checkCmd.Flags().BoolVarP(&pre, "pre", "", false, "Verify environment readiness to deploy Meshery")
 	checkCmd.Flags().BoolVarP(&componentsFlag, "components", "", false, "Check status of Meshery components")
+	checkCmd.Flags().BoolVarP(&versionSkewFlag, "version-skew", "", false, "Report the versions of mesheryctl, Meshery Server and the adapters, flagging the ones which differ")
+	checkCmd.Flags().BoolVarP(&clustersFlag, "clusters", "", false, "Check the connections of Meshery Server to the Kubernetes contexts, with remediation hints for the failing checks")
 }
@@ -5,9 +5,11 @@ import (
 	"flag"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -72,3 +74,40 @@ func TestPreflightCmdIntegration(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterHealthReport(t *testing.T) {
+	health := []models.ClusterHealth{
+		{
+			ContextName: "kind-meshery",
+			Server:      "https://127.0.0.1:6443",
+			Healthy:     true,
+			Checks: []models.ClusterHealthCheck{
+				{Name: models.ClusterHealthCheckAPI, Status: models.ClusterHealthPassed, Message: "Kubernetes API is reachable"},
+			},
+		},
+		{
+			ContextName: "gke-prod",
+			Server:      "https://10.0.0.1",
+			Checks: []models.ClusterHealthCheck{
+				{Name: models.ClusterHealthCheckAPI, Status: models.ClusterHealthFailed, Message: "Kubernetes API is not reachable", Remedy: "upload a new kubeconfig"},
+				{Name: models.ClusterHealthCheckRBAC, Status: models.ClusterHealthSkipped, Message: "skipped"},
+			},
+		},
+	}
+
+	lines, unhealthy := clusterHealthReport(health)
+	if unhealthy != 1 {
+		t.Errorf("expected 1 unhealthy context, got %d", unhealthy)
+	}
+	expected := []string{
+		"\nkind-meshery (https://127.0.0.1:6443)",
+		"✓ api: Kubernetes API is reachable",
+		"\ngke-prod (https://10.0.0.1)",
+		"!! api: Kubernetes API is not reachable",
+		"   remedy: upload a new kubeconfig",
+		"- rbac: skipped",
+	}
+	if strings.Join(lines, "|") != strings.Join(expected, "|") {
+		t.Errorf("expected report %q, got %q", expected, lines)
+	}
+}
@@ -21,6 +21,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const completionLong = `Output shell completion code.
+Names of performance profiles and patterns are completed from a cache in ~/.meshery/completion-cache.json,
+refreshed whenever they are listed or found stale, so completion keeps working while Meshery Server is unreachable.`
+
 const example = `  # bash <= 3.2
   source /dev/stdin <<< "$(mesheryctl system completion bash)"
 
@@ -56,7 +60,7 @@ const example = `  # bash <= 3.2
 var completionCmd = &cobra.Command{
 	Use:                   "completion [bash|zsh|fish]",
 	Short:                 "Output shell completion code",
-	Long:                  "Output shell completion code",
+	Long:                  completionLong,
 	Example:               example,
 	Args:                  cobra.ExactValidArgs(1),
 	DisableFlagsInUseLine: true,
@@ -21,10 +21,14 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
@@ -35,6 +39,12 @@ import (
 	"github.com/spf13/viper"
 )
 
+var (
+	kubeconfigPath     string
+	kubeconfigContexts []string
+	kubeconfigResync   bool
+)
+
 func getContexts(configFile string) ([]string, error) {
 	client := &http.Client{}
 
@@ -68,7 +78,9 @@ func getContexts(configFile string) ([]string, error) {
 
 	var contexts []string
 	for _, item := range results {
-		contexts = append(contexts, item["contextName"].(string))
+		if name, ok := item["name"].(string); ok {
+			contexts = append(contexts, name)
+		}
 	}
 	return contexts, nil
 }
@@ -76,7 +88,7 @@ func getContexts(configFile string) ([]string, error) {
 func setContext(configFile, cname string) error {
 	client := &http.Client{}
 	extraParams1 := map[string]string{
-		"contextName": cname,
+		"contexts": cname,
 	}
 	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
 	if err != nil {
@@ -292,10 +304,145 @@ var minikubeConfigCmd = &cobra.Command{
 	},
 }
 
+var kubernetesConfigCmd = &cobra.Command{
+	Use:   "kubernetes",
+	Short: "Configure Meshery to use the contexts of a kubeconfig",
+	Long: `Configure Meshery to connect to the clusters of the contexts of a kubeconfig selected with --context, which takes
+the place of the context of mesheryctl for this command, the current context of the kubeconfig if none is selected. Only the selected contexts are uploaded to Meshery Server. With --resync, the connections already
+registered for the contexts take the credentials of the kubeconfig, e.g. once they are rotated.`,
+	Example: `
+// Connect Meshery to the current context of ~/.kube/config
+mesheryctl system config kubernetes
+
+// Connect Meshery to two contexts of a kubeconfig
+mesheryctl system config kubernetes --kubeconfig ./kubeconfig.yaml --context ctx1,ctx2
+
+// Update the connection of a context after its credentials rotated
+mesheryctl system config kubernetes --context ctx1 --resync
+	`,
+	Args: cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if kubeconfigPath == "" {
+			kubeconfigPath = utils.KubeConfig
+		}
+		cfg, err := clientcmd.LoadFromFile(kubeconfigPath)
+		if err != nil {
+			return ErrKubeconfigContexts(err)
+		}
+
+		names := kubeconfigContexts
+		if len(names) == 0 {
+			if cfg.CurrentContext == "" {
+				return ErrKubeconfigContexts(fmt.Errorf("no context selected with --context and %s has no current context", kubeconfigPath))
+			}
+			names = []string{cfg.CurrentContext}
+		}
+
+		selected, err := selectKubeconfigContexts(cfg, names)
+		if err != nil {
+			return ErrKubeconfigContexts(err)
+		}
+		// Inline the certificates and keys, which Meshery Server cannot read from the filesystem
+		if err = clientcmdapi.FlattenConfig(selected); err != nil {
+			return ErrKubeconfigContexts(err)
+		}
+		if err = clientcmd.WriteToFile(*selected, utils.ConfigPath); err != nil {
+			return ErrKubeconfigContexts(err)
+		}
+		log.Debugf("Kubeconfig of the contexts %s is written to: %s", strings.Join(names, ", "), utils.ConfigPath)
+
+		connected, err := connectContexts(utils.ConfigPath, names, kubeconfigResync)
+		if err != nil {
+			return ErrKubeconfigContexts(err)
+		}
+		if len(connected) == 0 {
+			return ErrKubeconfigContexts(fmt.Errorf("Meshery Server registered none of the contexts %s", strings.Join(names, ", ")))
+		}
+
+		rows := [][]string{}
+		for _, c := range connected {
+			rows = append(rows, []string{c.Name, c.Server, c.ID})
+		}
+		utils.PrintToTable([]string{"CONTEXT", "SERVER", "CONNECTION ID"}, rows)
+		return nil
+	},
+}
+
+// selectKubeconfigContexts returns a kubeconfig with only the contexts named, along with their
+// clusters and users
+func selectKubeconfigContexts(cfg *clientcmdapi.Config, names []string) (*clientcmdapi.Config, error) {
+	selected := clientcmdapi.NewConfig()
+	unknown := []string{}
+	for _, name := range names {
+		ctx, ok := cfg.Contexts[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		selected.Contexts[name] = ctx
+		if cluster, ok := cfg.Clusters[ctx.Cluster]; ok {
+			selected.Clusters[ctx.Cluster] = cluster
+		}
+		if user, ok := cfg.AuthInfos[ctx.AuthInfo]; ok {
+			selected.AuthInfos[ctx.AuthInfo] = user
+		}
+	}
+	if len(unknown) > 0 {
+		available := []string{}
+		for name := range cfg.Contexts {
+			available = append(available, name)
+		}
+		sort.Strings(available)
+		return nil, fmt.Errorf("contexts %s not found in the kubeconfig, which has the contexts %s", strings.Join(unknown, ", "), strings.Join(available, ", "))
+	}
+
+	selected.CurrentContext = names[0]
+	if _, ok := selected.Contexts[cfg.CurrentContext]; ok {
+		selected.CurrentContext = cfg.CurrentContext
+	}
+	return selected, nil
+}
+
+// connectContexts uploads the kubeconfig to Meshery Server, registering the contexts named as
+// connections, and returns the connections
+func connectContexts(configFile string, names []string, resync bool) ([]models.K8sContext, error) {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return nil, errors.Wrap(err, "error processing config")
+	}
+
+	params := map[string]string{
+		"contexts": strings.Join(names, ","),
+		"resync":   strconv.FormatBool(resync),
+	}
+	req, err := utils.UploadFileWithParams(mctlCfg.GetBaseMesheryURL()+"/api/system/kubernetes", params, utils.ParamName, configFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer utils.SafeClose(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server responded with status code %d: %s", res.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	connected := []models.K8sContext{}
+	if err := json.Unmarshal(body, &connected); err != nil {
+		return nil, err
+	}
+	return connected, nil
+}
+
 var configCmd = &cobra.Command{
 	Use:   "config",
 	Short: "Configure Meshery",
-	Long:  `Configure the Kubernetes cluster used by Meshery.`,
+	Long:  `Configure the Kubernetes cluster used by Meshery and the rate limit of its connections, or reload the configuration of Meshery Server.`,
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -312,12 +459,19 @@ func init() {
 		eksConfigCmd,
 		gkeConfigCmd,
 		minikubeConfigCmd,
+		kubernetesConfigCmd,
+		reloadConfigCmd,
+		rateLimitConfigCmd,
 	}
 
 	aksConfigCmd.Flags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token for authenticating to Meshery API")
 	eksConfigCmd.Flags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token for authenticating to Meshery API")
 	gkeConfigCmd.Flags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token for authenticating to Meshery API")
 	minikubeConfigCmd.Flags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token for authenticating to Meshery API")
+	kubernetesConfigCmd.Flags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token for authenticating to Meshery API")
+	kubernetesConfigCmd.Flags().StringVar(&kubeconfigPath, "kubeconfig", "", "(optional) path to the kubeconfig, ~/.kube/config by default")
+	kubernetesConfigCmd.Flags().StringSliceVar(&kubeconfigContexts, "context", []string{}, "(optional) comma separated contexts of the kubeconfig to connect Meshery to, the current context by default")
+	kubernetesConfigCmd.Flags().BoolVar(&kubeconfigResync, "resync", false, "(optional) update the connections of the contexts with the credentials of the kubeconfig")
 
 	configCmd.AddCommand(availableSubcommands...)
 }
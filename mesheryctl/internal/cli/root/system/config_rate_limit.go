@@ -0,0 +1,223 @@
+package system
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	rateLimitQPS   float32
+	rateLimitBurst int
+)
+
+var rateLimitConfigCmd = &cobra.Command{
+	Use:   "rate-limit [context]",
+	Short: "View or set the rate limit of the Kubernetes connections",
+	Long: `View the rate limit of the requests Meshery Server sends to the Kubernetes API of every connection, or of the
+given one, along with the requests it held since the server started, or set it with --qps and --burst. Design
+deploys and the discovery of MeshSync on large clusters are slowed down by the default rate limit of 50 queries
+per second with a burst of 100; an event is recorded whenever a request is held for a second or more.`,
+	Example: `
+// View the rate limit of every Kubernetes connection and how many requests were throttled
+mesheryctl system config rate-limit
+
+// Raise the rate limit of the connection to a large cluster
+mesheryctl system config rate-limit prod-cluster --qps 200 --burst 400
+
+// Restore the default rate limit of the connection
+mesheryctl system config rate-limit prod-cluster --qps 0 --burst 0
+	`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		set := cmd.Flags().Changed("qps") || cmd.Flags().Changed("burst")
+		if set && len(args) == 0 {
+			return ErrK8sRateLimit(fmt.Errorf("the connection to set the rate limit of is required with --qps and --burst"))
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		baseURL := mctlCfg.GetBaseMesheryURL()
+
+		contexts, err := fetchK8sContexts(baseURL)
+		if err != nil {
+			return ErrK8sRateLimit(err)
+		}
+		if len(args) == 1 {
+			kc, err := matchK8sContext(contexts, args[0])
+			if err != nil {
+				return ErrK8sRateLimit(err)
+			}
+			contexts = []*models.K8sContext{kc}
+		}
+		if len(contexts) == 0 {
+			utils.Log.Info("No Kubernetes connections found.")
+			return nil
+		}
+
+		statuses := make([]models.K8sRateLimitStatus, 0, len(contexts))
+		for _, kc := range contexts {
+			var status *models.K8sRateLimitStatus
+			if set {
+				status, err = setK8sRateLimit(baseURL, kc.ID, rateLimitUpdate(cmd, kc))
+			} else {
+				status, err = fetchK8sRateLimit(baseURL, kc.ID)
+			}
+			if err != nil {
+				return ErrK8sRateLimit(err)
+			}
+			statuses = append(statuses, *status)
+		}
+		utils.PrintToTable([]string{"CONTEXT", "ID", "QPS", "BURST", "REQUESTS", "THROTTLED", "WAITED", "MAX WAIT", "LAST THROTTLED"}, rateLimitRows(statuses))
+		return nil
+	},
+}
+
+// rateLimitUpdate returns the rate limit of the connection with the settings given with the flags
+func rateLimitUpdate(cmd *cobra.Command, kc *models.K8sContext) models.K8sRateLimit {
+	limit := models.K8sRateLimit{QPS: kc.QPS, Burst: kc.Burst}
+	if cmd.Flags().Changed("qps") {
+		limit.QPS = rateLimitQPS
+	}
+	if cmd.Flags().Changed("burst") {
+		limit.Burst = rateLimitBurst
+	}
+	return limit
+}
+
+// matchK8sContext returns the connection with the given ID or, when there is a single one, name
+func matchK8sContext(contexts []*models.K8sContext, nameOrID string) (*models.K8sContext, error) {
+	var matches []*models.K8sContext
+	for _, kc := range contexts {
+		if kc.ID == nameOrID {
+			return kc, nil
+		}
+		if kc.Name == nameOrID {
+			matches = append(matches, kc)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no Kubernetes connection named %s", nameOrID)
+	case 1:
+		return matches[0], nil
+	}
+	ids := make([]string, 0, len(matches))
+	for _, kc := range matches {
+		ids = append(ids, kc.ID)
+	}
+	return nil, fmt.Errorf("%d Kubernetes connections are named %s, give the ID of one of them: %s", len(matches), nameOrID, strings.Join(ids, ", "))
+}
+
+// rateLimitRows returns the rows of the rate limits, the defaults being marked as such
+func rateLimitRows(statuses []models.K8sRateLimitStatus) [][]string {
+	rows := make([][]string, 0, len(statuses))
+	for _, s := range statuses {
+		qps := strconv.FormatFloat(float64(s.Effective.QPS), 'f', -1, 32)
+		if s.QPS <= 0 {
+			qps += " (default)"
+		}
+		burst := strconv.Itoa(s.Effective.Burst)
+		if s.Burst <= 0 {
+			burst += " (default)"
+		}
+		last := "never"
+		if s.LastThrottledAt != nil {
+			last = s.LastThrottledAt.Local().Format("2006-01-02 15:04:05")
+		}
+		rows = append(rows, []string{
+			s.Name,
+			s.ContextID,
+			qps,
+			burst,
+			strconv.FormatInt(s.Requests, 10),
+			strconv.FormatInt(s.Throttled, 10),
+			s.Waited.Round(time.Millisecond).String(),
+			s.MaxWait.Round(time.Millisecond).String(),
+			last,
+		})
+	}
+	return rows
+}
+
+// fetchK8sContexts returns every Kubernetes connection of Meshery Server
+func fetchK8sContexts(baseURL string) ([]*models.K8sContext, error) {
+	contexts := []*models.K8sContext{}
+	for page := 0; ; page++ {
+		body, err := doK8sRateLimitRequest(http.MethodGet, fmt.Sprintf("%s/api/system/kubernetes/contexts?page=%d&pageSize=100", baseURL, page), nil)
+		if err != nil {
+			return nil, err
+		}
+		contextPage := models.MesheryK8sContextPage{}
+		if err := json.Unmarshal(body, &contextPage); err != nil {
+			return nil, err
+		}
+		contexts = append(contexts, contextPage.Contexts...)
+		if len(contextPage.Contexts) == 0 || len(contexts) >= contextPage.TotalCount {
+			return contexts, nil
+		}
+	}
+}
+
+// fetchK8sRateLimit returns the rate limit of the connection along with the requests it held
+func fetchK8sRateLimit(baseURL, contextID string) (*models.K8sRateLimitStatus, error) {
+	body, err := doK8sRateLimitRequest(http.MethodGet, baseURL+"/api/system/kubernetes/contexts/"+contextID+"/rate-limit", nil)
+	if err != nil {
+		return nil, err
+	}
+	status := &models.K8sRateLimitStatus{}
+	return status, json.Unmarshal(body, status)
+}
+
+// setK8sRateLimit sets the rate limit of the connection, returning it once set
+func setK8sRateLimit(baseURL, contextID string, limit models.K8sRateLimit) (*models.K8sRateLimitStatus, error) {
+	data, err := json.Marshal(limit)
+	if err != nil {
+		return nil, err
+	}
+	body, err := doK8sRateLimitRequest(http.MethodPost, baseURL+"/api/system/kubernetes/contexts/"+contextID+"/rate-limit", data)
+	if err != nil {
+		return nil, err
+	}
+	status := &models.K8sRateLimitStatus{}
+	return status, json.Unmarshal(body, status)
+}
+
+func doK8sRateLimitRequest(method, url string, data []byte) ([]byte, error) {
+	req, err := utils.NewRequest(method, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer utils.SafeClose(resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+func init() {
+	rateLimitConfigCmd.Flags().Float32Var(&rateLimitQPS, "qps", 0, "(optional) queries per second sustained by the requests to the Kubernetes API of the connection, 0 for the default of 50")
+	rateLimitConfigCmd.Flags().IntVar(&rateLimitBurst, "burst", 0, "(optional) requests sent at once above the queries per second, 0 for the default of 100")
+}
@@ -0,0 +1,78 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/spf13/pflag"
+)
+
+func TestMatchK8sContext(t *testing.T) {
+	contexts := []*models.K8sContext{
+		{ID: "a1", Name: "prod"},
+		{ID: "b2", Name: "staging"},
+		{ID: "c3", Name: "staging"},
+	}
+
+	if kc, err := matchK8sContext(contexts, "prod"); err != nil || kc.ID != "a1" {
+		t.Errorf("expected the connection a1 by name, got %v, %v", kc, err)
+	}
+	if kc, err := matchK8sContext(contexts, "c3"); err != nil || kc.ID != "c3" {
+		t.Errorf("expected the connection c3 by ID, got %v, %v", kc, err)
+	}
+	if _, err := matchK8sContext(contexts, "staging"); err == nil {
+		t.Error("expected a name shared by two connections to be rejected")
+	}
+	if _, err := matchK8sContext(contexts, "dev"); err == nil {
+		t.Error("expected an unknown connection to be rejected")
+	}
+}
+
+func TestRateLimitUpdate(t *testing.T) {
+	defer func() {
+		rateLimitQPS, rateLimitBurst = 0, 0
+		rateLimitConfigCmd.Flags().VisitAll(func(f *pflag.Flag) { f.Changed = false })
+	}()
+
+	if err := rateLimitConfigCmd.ParseFlags([]string{"--qps", "200"}); err != nil {
+		t.Fatal(err)
+	}
+	limit := rateLimitUpdate(rateLimitConfigCmd, &models.K8sContext{QPS: 100, Burst: 300})
+	want := models.K8sRateLimit{QPS: 200, Burst: 300}
+	if limit != want {
+		t.Errorf("expected the rate limit %+v, got %+v", want, limit)
+	}
+}
+
+func TestRateLimitRows(t *testing.T) {
+	throttledAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.Local)
+	rows := rateLimitRows([]models.K8sRateLimitStatus{
+		{
+			ContextID: "a1",
+			Name:      "prod",
+			QPS:       200,
+			Effective: models.K8sRateLimit{QPS: 200, Burst: 100},
+			K8sThrottleStats: models.K8sThrottleStats{
+				Requests:        1000,
+				Throttled:       12,
+				Waited:          3200 * time.Millisecond,
+				MaxWait:         1500 * time.Millisecond,
+				LastThrottledAt: &throttledAt,
+			},
+		},
+		{
+			ContextID: "b2",
+			Name:      "staging",
+			Effective: models.K8sRateLimit{QPS: 50, Burst: 100},
+		},
+	})
+	want := [][]string{
+		{"prod", "a1", "200", "100 (default)", "1000", "12", "3.2s", "1.5s", "2023-05-01 10:00:00"},
+		{"staging", "b2", "50 (default)", "100 (default)", "0", "0", "0s", "0s", "never"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("expected the rows %v, got %v", want, rows)
+	}
+}
@@ -0,0 +1,132 @@
+package system
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	reloadAdapterURLs  []string
+	reloadProviderURLs []string
+	reloadLogLevel     string
+	reloadFeatureFlags map[string]string
+)
+
+var reloadConfigCmd = &cobra.Command{
+	Use:   "reload",
+	Short: "Reload the configuration of Meshery Server",
+	Long: `Apply the changed settings of Meshery Server without restarting it: the remote providers, the addresses
+of the adapters, the log level and the feature flags. The configuration file of the server, set with
+SERVER_CONFIG_FILE, e.g. mounted from a ConfigMap, is read again, the settings given with the flags
+taking precedence. The settings which changed are printed and recorded as an event. Only the admins of
+Meshery Server may reload its configuration.`,
+	Example: `
+// Apply the configuration file of Meshery Server again, e.g. once its ConfigMap is edited
+mesheryctl system config reload
+
+// Log the debug of Meshery Server
+mesheryctl system config reload --log-level debug
+
+// Point Meshery Server to the adapters for Istio and Linkerd only
+mesheryctl system config reload --adapter-urls meshery-istio:10000,meshery-linkerd:10001
+
+// Let performance tests against the same service run concurrently
+mesheryctl system config reload --feature-flag perf_test_isolation=false
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		update, err := serverConfigUpdate(cmd)
+		if err != nil {
+			return ErrReloadServerConfig(err)
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		data, err := json.Marshal(update)
+		if err != nil {
+			return ErrReloadServerConfig(err)
+		}
+		req, err := utils.NewRequest(http.MethodPost, mctlCfg.GetBaseMesheryURL()+"/api/system/config/reload", bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return ErrReloadServerConfig(err)
+		}
+		defer utils.SafeClose(resp.Body)
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return ErrReloadServerConfig(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return ErrReloadServerConfig(fmt.Errorf("server responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body))))
+		}
+
+		reload := &models.ServerConfigReload{}
+		if err := json.Unmarshal(body, reload); err != nil {
+			return ErrReloadServerConfig(err)
+		}
+		if len(reload.Changes) == 0 {
+			utils.Log.Info("The configuration of Meshery Server is unchanged.")
+			return nil
+		}
+		utils.PrintToTable([]string{"SETTING", "OLD", "NEW"}, serverConfigChangesRows(reload.Changes))
+		utils.Log.Info("The configuration of Meshery Server is reloaded.")
+		return nil
+	},
+}
+
+// serverConfigUpdate returns the settings given with the flags, the lists given empty being cleared
+func serverConfigUpdate(cmd *cobra.Command) (*models.ServerConfig, error) {
+	update := &models.ServerConfig{LogLevel: reloadLogLevel}
+	if cmd.Flags().Changed("adapter-urls") {
+		update.AdapterURLs = append([]string{}, reloadAdapterURLs...)
+	}
+	if cmd.Flags().Changed("provider-urls") {
+		update.ProviderURLs = append([]string{}, reloadProviderURLs...)
+	}
+	if len(reloadFeatureFlags) > 0 {
+		update.FeatureFlags = map[string]bool{}
+		for flag, value := range reloadFeatureFlags {
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q of the feature flag %s, expected true or false", value, flag)
+			}
+			update.FeatureFlags[flag] = enabled
+		}
+	}
+	return update, nil
+}
+
+// serverConfigChangesRows returns the rows of the settings which changed, sorted by setting
+func serverConfigChangesRows(changes []models.ServerConfigChange) [][]string {
+	rows := make([][]string, 0, len(changes))
+	for _, c := range changes {
+		rows = append(rows, []string{c.Setting, c.Old, c.New})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i][0] < rows[j][0] })
+	return rows
+}
+
+func init() {
+	reloadConfigCmd.Flags().StringSliceVar(&reloadAdapterURLs, "adapter-urls", []string{}, "(optional) comma separated addresses of the adapters, replacing those configured")
+	reloadConfigCmd.Flags().StringSliceVar(&reloadProviderURLs, "provider-urls", []string{}, "(optional) comma separated base URLs of the remote providers, replacing those configured")
+	reloadConfigCmd.Flags().StringVar(&reloadLogLevel, "log-level", "", "(optional) level of the logs of Meshery Server: trace, debug, info, warning, error, fatal or panic")
+	reloadConfigCmd.Flags().StringToStringVar(&reloadFeatureFlags, "feature-flag", map[string]string{}, "(optional) feature flags to enable or disable, as name=true or name=false")
+}
@@ -0,0 +1,48 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/spf13/pflag"
+)
+
+func TestServerConfigUpdate(t *testing.T) {
+	defer func() {
+		reloadAdapterURLs, reloadProviderURLs, reloadLogLevel, reloadFeatureFlags = []string{}, []string{}, "", map[string]string{}
+		reloadConfigCmd.Flags().VisitAll(func(f *pflag.Flag) { f.Changed = false })
+	}()
+
+	if err := reloadConfigCmd.ParseFlags([]string{"--adapter-urls", "meshery-istio:10000", "--log-level", "debug", "--feature-flag", "perf_test_isolation=false"}); err != nil {
+		t.Fatal(err)
+	}
+	update, err := serverConfigUpdate(reloadConfigCmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &models.ServerConfig{
+		AdapterURLs:  []string{"meshery-istio:10000"},
+		LogLevel:     "debug",
+		FeatureFlags: map[string]bool{"perf_test_isolation": false},
+	}
+	if !reflect.DeepEqual(update, want) {
+		t.Errorf("expected the update %+v, got %+v", want, update)
+	}
+
+	reloadFeatureFlags = map[string]string{"perf_test_isolation": "maybe"}
+	if _, err := serverConfigUpdate(reloadConfigCmd); err == nil {
+		t.Error("expected an invalid feature flag value to be rejected")
+	}
+}
+
+func TestServerConfigChangesRows(t *testing.T) {
+	rows := serverConfigChangesRows([]models.ServerConfigChange{
+		{Setting: "log_level", Old: "info", New: "debug"},
+		{Setting: "adapter_urls", Old: "a:1", New: "b:2"},
+	})
+	want := [][]string{{"adapter_urls", "a:1", "b:2"}, {"log_level", "info", "debug"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("expected the rows %v, got %v", want, rows)
+	}
+}
@@ -0,0 +1,69 @@
+package system
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestSelectKubeconfigContexts(t *testing.T) {
+	cfg := clientcmdapi.NewConfig()
+	for _, name := range []string{"ctx1", "ctx2", "ctx3"} {
+		cfg.Clusters["cluster-"+name] = &clientcmdapi.Cluster{Server: "https://" + name + ".example.com"}
+		cfg.AuthInfos["user-"+name] = &clientcmdapi.AuthInfo{Token: "token-" + name}
+		cfg.Contexts[name] = &clientcmdapi.Context{Cluster: "cluster-" + name, AuthInfo: "user-" + name}
+	}
+	cfg.CurrentContext = "ctx2"
+
+	tests := []struct {
+		name        string
+		names       []string
+		wantCurrent string
+		wantErr     string
+	}{
+		{name: "the current context among those selected", names: []string{"ctx1", "ctx2"}, wantCurrent: "ctx2"},
+		{name: "the first context without the current one", names: []string{"ctx3", "ctx1"}, wantCurrent: "ctx3"},
+		{name: "unknown contexts", names: []string{"ctx1", "ctx4"}, wantErr: "contexts ctx4 not found in the kubeconfig, which has the contexts ctx1, ctx2, ctx3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectKubeconfigContexts(cfg, tt.names)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := append([]string{}, tt.names...)
+			sort.Strings(want)
+			var contexts, clusters, users []string
+			for name := range got.Contexts {
+				contexts = append(contexts, name)
+				clusters = append(clusters, strings.TrimPrefix(got.Contexts[name].Cluster, "cluster-"))
+				users = append(users, strings.TrimPrefix(got.Contexts[name].AuthInfo, "user-"))
+			}
+			sort.Strings(contexts)
+			if !reflect.DeepEqual(contexts, want) {
+				t.Errorf("contexts = %v, want %v", contexts, want)
+			}
+			if len(got.Clusters) != len(want) || len(got.AuthInfos) != len(want) {
+				t.Errorf("expected only the clusters and users of %v, got %d clusters and %d users", want, len(got.Clusters), len(got.AuthInfos))
+			}
+			for i := range clusters {
+				if got.Clusters["cluster-"+clusters[i]] == nil || got.AuthInfos["user-"+users[i]] == nil {
+					t.Errorf("expected the cluster and user of %s to be kept", contexts[i])
+				}
+			}
+			if got.CurrentContext != tt.wantCurrent {
+				t.Errorf("current context = %s, want %s", got.CurrentContext, tt.wantCurrent)
+			}
+		})
+	}
+}
@@ -403,6 +403,28 @@ func init() {
 	viewContextCmd.Flags().BoolVar(&allContext, "all", false, "Show configs for all of the context")
 	ContextCmd.PersistentFlags().StringVarP(&tempCntxt, "context", "c", "", "(optional) temporarily change the current context.")
 	ContextCmd.AddCommand(availableSubcommands...)
+
+	for _, cmd := range []*cobra.Command{deleteContextCmd, switchContextCmd, viewContextCmd} {
+		cmd.ValidArgsFunction = utils.CompleteNames(contextNames)
+	}
+	_ = viewContextCmd.RegisterFlagCompletionFunc("context", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return contextNames(), cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// contextNames returns the names of the contexts for shell completion. Contexts are read
+// from the Meshery config file, so they complete without reaching Meshery Server.
+func contextNames() []string {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(mctlCfg.Contexts))
+	for name := range mctlCfg.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // getYAML takes in a struct and converts it into yaml
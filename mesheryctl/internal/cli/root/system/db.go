@@ -0,0 +1,209 @@
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	migrateTo  int
+	rollbackTo int
+)
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Manage the schema of the database of Meshery Server",
+	Long: `View the version of the schema of the database of Meshery Server, apply its pending migrations or roll back
+the last ones. Meshery Server applies the pending migrations as it starts unless DB_AUTO_MIGRATE is false, in which
+case they are applied with "mesheryctl system db migrate" once the upgrade is ready.`,
+	Example: `
+// View the version of the schema and its migrations
+mesheryctl system db status
+
+// Apply every pending migration
+mesheryctl system db migrate
+
+// Roll back the last migration applied
+mesheryctl system db rollback
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var dbStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "View the version of the schema of the database",
+	Long:  `View the version of the schema of the database of Meshery Server and whether each of its migrations is applied.`,
+	Example: `
+// View the version of the schema and its migrations
+mesheryctl system db status
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		body, err := doDatabaseSchemaRequest(http.MethodGet, "", -1)
+		if err != nil {
+			return ErrDatabaseSchema(err)
+		}
+		status := &models.SchemaStatus{}
+		if err := json.Unmarshal(body, status); err != nil {
+			return ErrDatabaseSchema(err)
+		}
+		printSchemaStatus(status)
+		return nil
+	},
+}
+
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply the pending migrations of the schema of the database",
+	Long: `Apply the pending migrations of the schema of the database of Meshery Server, every one of them or those up to
+the version given with --to. Each migration is applied in a transaction of its own; the migrations applied
+before one which fails are kept.`,
+	Example: `
+// Apply every pending migration
+mesheryctl system db migrate
+
+// Apply the pending migrations up to version 2
+mesheryctl system db migrate --to 2
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if migrateTo < 0 {
+			return ErrDatabaseSchema(fmt.Errorf("invalid version %d", migrateTo))
+		}
+		return runSchemaMigrations("migrate", migrateTo, "applied")
+	},
+}
+
+var dbRollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Roll back the migrations of the schema of the database",
+	Long: `Roll back the last migration applied to the schema of the database of Meshery Server, or every one above the
+version given with --to. Nothing is rolled back when one of these migrations cannot be, and the data of the
+tables or columns they created is lost. Back up the database before rolling back.`,
+	Example: `
+// Roll back the last migration applied
+mesheryctl system db rollback
+
+// Roll back every migration above version 1, without asking for confirmation
+mesheryctl system db rollback --to 1 -y
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		to := -1
+		if cmd.Flags().Changed("to") {
+			if rollbackTo < 0 {
+				return ErrDatabaseSchema(fmt.Errorf("invalid version %d", rollbackTo))
+			}
+			to = rollbackTo
+		}
+
+		if !utils.SilentFlag && !utils.AskForConfirmation("The schema of the database of Meshery Server will be rolled back and the data of the migrations rolled back lost. Are you sure you want to continue") {
+			utils.Log.Info("Rollback aborted.")
+			return nil
+		}
+		return runSchemaMigrations("rollback", to, "rolled back")
+	},
+}
+
+// runSchemaMigrations migrates or rolls back the schema to the version, printing the migrations run
+// and the status of the schema afterwards
+func runSchemaMigrations(action string, to int, done string) error {
+	body, err := doDatabaseSchemaRequest(http.MethodPost, "/"+action, to)
+	if err != nil {
+		return ErrDatabaseSchema(err)
+	}
+	result := &models.SchemaMigrationResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return ErrDatabaseSchema(err)
+	}
+	if len(result.Migrations) == 0 {
+		utils.Log.Info("No migration to be " + done + ".")
+	}
+	for _, m := range result.Migrations {
+		utils.Log.Info(fmt.Sprintf("Migration %d (%s) %s.", m.Version, m.Description, done))
+	}
+	printSchemaStatus(&result.Status)
+	return nil
+}
+
+// printSchemaStatus prints the version of the schema and its migrations
+func printSchemaStatus(status *models.SchemaStatus) {
+	utils.PrintToTable([]string{"VERSION", "DESCRIPTION", "STATE", "APPLIED AT", "REVERSIBLE"}, schemaMigrationsRows(status.Migrations))
+	utils.Log.Info(fmt.Sprintf("\nThe schema is at version %d of %d, %d migration(s) pending.", status.Version, status.LatestVersion, status.Pending))
+	if status.Version > status.LatestVersion {
+		utils.Log.Info("The schema was migrated by a later version of Meshery Server.")
+	}
+}
+
+// schemaMigrationsRows returns the rows of the migrations, in the order of their versions
+func schemaMigrationsRows(migrations []models.MigrationStatus) [][]string {
+	rows := make([][]string, 0, len(migrations))
+	for _, m := range migrations {
+		state, appliedAt, reversible := "pending", "", "no"
+		if m.Applied {
+			state = "applied"
+		}
+		if m.Unknown {
+			state = "applied (unknown)"
+		}
+		if m.AppliedAt != nil {
+			appliedAt = m.AppliedAt.Local().Format(time.RFC3339)
+		}
+		if m.Reversible {
+			reversible = "yes"
+		}
+		rows = append(rows, []string{strconv.Itoa(m.Version), m.Description, state, appliedAt, reversible})
+	}
+	return rows
+}
+
+// doDatabaseSchemaRequest sends the request to the database schema API of Meshery Server, with the
+// version to migrate or roll back to unless it is negative
+func doDatabaseSchemaRequest(method, path string, to int) ([]byte, error) {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return nil, errors.Wrap(err, "error processing config")
+	}
+	url := mctlCfg.GetBaseMesheryURL() + "/api/system/database/schema" + path
+	if to >= 0 && path != "" {
+		url += "?to=" + strconv.Itoa(to)
+	}
+	req, err := utils.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer utils.SafeClose(resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+func init() {
+	dbMigrateCmd.Flags().IntVar(&migrateTo, "to", 0, "(optional) version to migrate the schema to, 0 for the latest")
+	dbRollbackCmd.Flags().IntVar(&rollbackTo, "to", 0, "(optional) version to roll the schema back to, the one before the last migration applied by default")
+	dbCmd.AddCommand(dbStatusCmd, dbMigrateCmd, dbRollbackCmd)
+}
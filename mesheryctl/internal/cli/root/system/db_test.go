@@ -0,0 +1,26 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+)
+
+func TestSchemaMigrationsRows(t *testing.T) {
+	appliedAt := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	rows := schemaMigrationsRows([]models.MigrationStatus{
+		{Version: 1, Description: "Create the tables", Applied: true, AppliedAt: &appliedAt},
+		{Version: 2, Description: "Index the results", Reversible: true},
+		{Version: 3, Description: "Add a column", Applied: true, AppliedAt: &appliedAt, Unknown: true},
+	})
+	want := [][]string{
+		{"1", "Create the tables", "applied", appliedAt.Local().Format(time.RFC3339), "no"},
+		{"2", "Index the results", "pending", "", "yes"},
+		{"3", "Add a column", "applied (unknown)", appliedAt.Local().Format(time.RFC3339), "no"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("expected the rows %v, got %v", want, rows)
+	}
+}
@@ -2,6 +2,8 @@ package system
 
 import (
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/layer5io/meshkit/errors"
 )
@@ -24,6 +26,14 @@ const (
 	ErrRestartMesheryCode           = "1026"
 	ErrK8sQueryCode                 = "1041"
 	ErrK8sConfigCode                = "1042"
+	ErrComponentsNotReadyCode       = "1053"
+	ErrNativeServerCode             = "1068"
+	ErrTelemetryCode                = "1076"
+	ErrKubeconfigContextsCode       = "1077"
+	ErrUnsupportedArchitectureCode  = "1080"
+	ErrReloadServerConfigCode       = "1081"
+	ErrK8sRateLimitCode             = "1085"
+	ErrDatabaseSchemaCode           = "1097"
 )
 
 func ErrHealthCheckFailed(err error) error {
@@ -63,7 +73,7 @@ func ErrUnmarshal(err error, obj string) error {
 }
 
 func ErrUnsupportedPlatform(platform string, config string) error {
-	return errors.New(ErrUnsupportedPlatformCode, errors.Alert, []string{}, []string{"the platform ", platform, " is not supported. Supported platforms are:\n\n- docker\n- kubernetes\n\nVerify this setting in your meshconfig at ", config, " or verify by executing `mesheryctl system context view`"}, []string{}, []string{})
+	return errors.New(ErrUnsupportedPlatformCode, errors.Alert, []string{}, []string{"the platform ", platform, " is not supported. Supported platforms are:\n\n- docker\n- kubernetes\n- native\n\nVerify this setting in your meshconfig at ", config, " or verify by executing `mesheryctl system context view`"}, []string{}, []string{})
 }
 
 func ErrRetrievingCurrentContext(err error) error {
@@ -93,3 +103,35 @@ func ErrK8SQuery(err error) error {
 func ErrK8sConfig(err error) error {
 	return errors.New(ErrK8sConfigCode, errors.Alert, []string{"The Kubernetes cluster is not accessible."}, []string{err.Error(), " The Kubernetes cluster is not accessible", " Please confirm that the token is valid", " See https://docs.meshery.io/installation/quick-start for additional instructions"}, []string{"Kubernetes cluster is unavailable and that the token is invalid"}, []string{"Please confirm that your cluster is available and that the token is valid. See https://docs.meshery.io/installation/quick-start for additional instructions"})
 }
+
+func ErrComponentsNotReady(err error, timeout time.Duration) error {
+	return errors.New(ErrComponentsNotReadyCode, errors.Alert, []string{"Meshery components are not ready after ", timeout.String()}, []string{err.Error()}, []string{"Meshery components are still starting or failed to start"}, []string{"Check the status of the components with `mesheryctl system status` and their logs with `mesheryctl system logs`, or wait longer with --timeout"})
+}
+
+func ErrNativeServer(err error) error {
+	return errors.New(ErrNativeServerCode, errors.Alert, []string{"Error running Meshery Server natively"}, []string{err.Error()}, []string{"The Meshery Server binary is not found or cannot be run on this host"}, []string{"Build Meshery Server with `go build -o meshery` in the cmd folder of Meshery and pass it with --server-binary, then check its output with `mesheryctl system logs`"})
+}
+
+func ErrTelemetry(err error) error {
+	return errors.New(ErrTelemetryCode, errors.Alert, []string{"Unable to get or update the telemetry of Meshery"}, []string{err.Error()}, []string{"Meshery Server is not reachable", "Meshery Server is of a version without the telemetry report"}, []string{"Check that Meshery Server is running with `mesheryctl system status`", "Update Meshery Server with `mesheryctl system update`"})
+}
+
+func ErrKubeconfigContexts(err error) error {
+	return errors.New(ErrKubeconfigContextsCode, errors.Alert, []string{"Unable to connect Meshery to the contexts of the kubeconfig"}, []string{err.Error()}, []string{"The contexts selected are not in the kubeconfig", "Meshery Server could not reach the clusters of the contexts"}, []string{"Select the contexts among those listed by `kubectl config get-contexts`", "Check the reason given for every context in the logs of Meshery Server"})
+}
+
+func ErrUnsupportedArchitecture(components, archs []string) error {
+	return errors.New(ErrUnsupportedArchitectureCode, errors.Alert, []string{"Meshery components are not published for the architecture ", strings.Join(archs, ", ")}, []string{"the images of " + strings.Join(components, "; ") + " cannot run on " + strings.Join(archs, ", ")}, []string{"The images of the components are not built for the architecture of the host or of the nodes of the cluster"}, []string{"Remove the components from the context with `mesheryctl system context` or add nodes of an architecture they are published for", "Pass --skip-arch-check to start them anyway, e.g. when the host emulates other architectures"})
+}
+
+func ErrReloadServerConfig(err error) error {
+	return errors.New(ErrReloadServerConfigCode, errors.Alert, []string{"Unable to reload the configuration of Meshery Server"}, []string{err.Error()}, []string{"Meshery Server is not reachable", "A setting given or of the configuration file of the server is invalid"}, []string{"Check that Meshery Server is running with `mesheryctl system status`", "Fix the settings reported; none is applied until all of them are valid"})
+}
+
+func ErrK8sRateLimit(err error) error {
+	return errors.New(ErrK8sRateLimitCode, errors.Alert, []string{"Unable to get or set the rate limit of the Kubernetes connection"}, []string{err.Error()}, []string{"Meshery Server is not reachable", "No Kubernetes connection has the given name or ID", "The queries per second or the burst are negative"}, []string{"Check that Meshery Server is running with `mesheryctl system status`", "List the connections with `mesheryctl system config rate-limit`", "Give a positive --qps and --burst, or 0 for the defaults"})
+}
+
+func ErrDatabaseSchema(err error) error {
+	return errors.New(ErrDatabaseSchemaCode, errors.Alert, []string{"Unable to view, migrate or roll back the schema of the database of Meshery Server"}, []string{err.Error()}, []string{"Meshery Server is not reachable", "The version given is invalid", "A migration failed or cannot be rolled back"}, []string{"Check that Meshery Server is running with `mesheryctl system status`", "View the versions of the migrations with `mesheryctl system db status`", "Check the logs of Meshery Server for the migration which failed"})
+}
@@ -134,6 +134,19 @@ It also shows the logs of a specific component.`,
 			if err := cmdlog.Wait(); err != nil {
 				return errors.Wrap(err, utils.SystemError("failed to wait for exec process"))
 			}
+		case "native":
+			ok, err := utils.AreMesheryComponentsRunning(currPlatform)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				log.Error("No logs to show. Meshery is not running.")
+				return nil
+			}
+			log.Info("Starting Meshery logging...")
+
+			// follow the output of the Meshery Server running on the host
+			return followNativeLogs()
 		case "kubernetes":
 			// if the platform is kubernetes, use kubernetes go-client to
 			// display pod status in the MesheryNamespace
@@ -155,8 +168,8 @@ It also shows the logs of a specific component.`,
 				return err
 			}
 
-			// List the pods in the MesheryNamespace
-			podList, err := utils.GetPodList(client, utils.MesheryNamespace)
+			// List the pods in the namespaces of Meshery and its adapters
+			podList, err := utils.GetMesheryPodList(client)
 			availablePods := podList.Items
 
 			if err != nil {
@@ -199,7 +212,7 @@ It also shows the logs of a specific component.`,
 						Container: containerName,
 					}
 
-					req := client.KubeClient.CoreV1().Pods(utils.MesheryNamespace).GetLogs(name, &podLogOpts)
+					req := client.KubeClient.CoreV1().Pods(pod.GetNamespace()).GetLogs(name, &podLogOpts)
 
 					logs, err := req.Stream(context.TODO())
 					if err != nil {
@@ -0,0 +1,393 @@
+package system
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	serverBinaryFlag     string
+	nativeKubeconfigFlag string
+	nativePortFlag       string
+)
+
+const (
+	// nativeReloadInterval is how often the binary, kubeconfig and environment of Meshery Server are checked for changes
+	nativeReloadInterval = 2 * time.Second
+	// nativeStopTimeout is how long Meshery Server is given to shut down before it is killed
+	nativeStopTimeout = 10 * time.Second
+)
+
+// defaultNativeEnv is the environment file written on the first native start of Meshery Server
+const defaultNativeEnv = `# Environment of Meshery Server run natively by mesheryctl, one KEY=VALUE per line.
+# Meshery Server is restarted whenever this file changes. PORT follows the endpoint of the context
+# and KUBECONFIG_FOLDER the kubeconfig given to mesheryctl system start.
+PROVIDER_BASE_URLS=https://meshery.layer5.io
+ADAPTER_URLS=
+DEBUG=false
+`
+
+// nativeRunCmd supervises Meshery Server run natively, in the background of mesheryctl system start
+var nativeRunCmd = &cobra.Command{
+	Use:    "native-run",
+	Short:  "Supervise Meshery Server run natively",
+	Hidden: true,
+	Args:   cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return superviseNativeServer(&utils.NativeServer{
+			PID:        os.Getpid(),
+			Binary:     serverBinaryFlag,
+			Kubeconfig: nativeKubeconfigFlag,
+			Port:       nativePortFlag,
+			StartedAt:  time.Now(),
+		})
+	},
+}
+
+// startNative runs the Meshery Server binary on the host in the background, supervised by mesheryctl
+func startNative(currCtx *config.Context) error {
+	previous, err := utils.ReadNativeServer()
+	if err != nil {
+		return err
+	}
+	if previous != nil && previous.Running() {
+		log.Infof("Meshery Server is already running natively with PID %d", previous.ServerPID)
+		return nil
+	}
+
+	binary, err := nativeServerBinary(previous)
+	if err != nil {
+		return ErrNativeServer(err)
+	}
+	kubeconfig := nativeKubeconfigFlag
+	if kubeconfig == "" && previous != nil {
+		kubeconfig = previous.Kubeconfig
+	}
+	if kubeconfig == "" {
+		kubeconfig = utils.KubeConfig
+	}
+	if kubeconfig, err = filepath.Abs(kubeconfig); err != nil {
+		return ErrNativeServer(err)
+	}
+	port, err := endpointPort(currCtx.GetEndpoint())
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(utils.MesheryFolder, utils.NativeFolder), 0755); err != nil {
+		return ErrCreateDir(err, filepath.Join(utils.MesheryFolder, utils.NativeFolder))
+	}
+	envFile := utils.NativePath(utils.NativeEnvFile)
+	if _, err := os.Stat(envFile); os.IsNotExist(err) {
+		if err := os.WriteFile(envFile, []byte(defaultNativeEnv), 0644); err != nil {
+			return ErrNativeServer(err)
+		}
+	}
+	logFile, err := os.OpenFile(utils.NativePath(utils.NativeLogFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return ErrNativeServer(err)
+	}
+	defer utils.SafeClose(logFile)
+
+	self, err := os.Executable()
+	if err != nil {
+		return ErrNativeServer(err)
+	}
+	supervisor := exec.Command(self, "system", "native-run", "--server-binary", binary, "--kubeconfig", kubeconfig, "--port", port)
+	supervisor.Stdout = logFile
+	supervisor.Stderr = logFile
+	if err := supervisor.Start(); err != nil {
+		return ErrNativeServer(err)
+	}
+	pid := supervisor.Process.Pid
+	if err := supervisor.Process.Release(); err != nil {
+		return ErrNativeServer(err)
+	}
+
+	// wait for the supervisor to start Meshery Server
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(200 * time.Millisecond) {
+		server, err := utils.ReadNativeServer()
+		if err == nil && server != nil && server.PID == pid && server.ServerPID != 0 {
+			log.Infof("Meshery Server %s is running natively with PID %d on port %s", binary, server.ServerPID, port)
+			log.Infof("Edit %s to change its environment, and run `mesheryctl system logs` for its output", envFile)
+			return nil
+		}
+		if !utils.IsProcessRunning(pid) {
+			break
+		}
+	}
+	return ErrNativeServer(fmt.Errorf("Meshery Server did not start, see %s", utils.NativePath(utils.NativeLogFile)))
+}
+
+// nativeServerBinary returns the absolute path of the Meshery Server binary: the one given with
+// --server-binary, else the one last run natively, else meshery in the PATH
+func nativeServerBinary(previous *utils.NativeServer) (string, error) {
+	binary := serverBinaryFlag
+	if binary == "" && previous != nil {
+		binary = previous.Binary
+	}
+	if binary == "" {
+		path, err := exec.LookPath("meshery")
+		if err != nil {
+			return "", errors.New("Meshery Server binary not found, pass it with --server-binary")
+		}
+		binary = path
+	}
+	if _, err := os.Stat(binary); err != nil {
+		return "", err
+	}
+	return filepath.Abs(binary)
+}
+
+// endpointPort returns the port of the endpoint of the context, which Meshery Server listens on
+func endpointPort(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid endpoint %s", endpoint)
+	}
+	if port := u.Port(); port != "" {
+		return port, nil
+	}
+	if u.Scheme == "https" {
+		return "443", nil
+	}
+	return "80", nil
+}
+
+// superviseNativeServer runs Meshery Server until interrupted, restarting it whenever its binary,
+// kubeconfig or environment change. A server which exits is started again on the next change only.
+func superviseNativeServer(state *utils.NativeServer) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	ticker := time.NewTicker(nativeReloadInterval)
+	defer ticker.Stop()
+	defer func() {
+		state.PID, state.ServerPID = 0, 0
+		if err := utils.WriteNativeServer(state); err != nil {
+			log.Error(err)
+		}
+	}()
+
+	for {
+		fingerprint := nativeFingerprint(state)
+		server, err := startNativeServer(state)
+		var exited chan error
+		if err != nil {
+			log.Error(ErrNativeServer(err))
+			state.ServerPID = 0
+		} else {
+			state.ServerPID = server.Process.Pid
+			exited = make(chan error, 1)
+			go func() {
+				exited <- server.Wait()
+			}()
+		}
+		if err := utils.WriteNativeServer(state); err != nil {
+			log.Error(err)
+		}
+
+	wait:
+		for {
+			select {
+			case <-signals:
+				if exited != nil {
+					stopNativeServer(server, exited)
+				}
+				return nil
+			case err := <-exited:
+				log.Errorf("Meshery Server exited: %v. It is started again once its binary, kubeconfig or environment change.", err)
+				exited = nil
+				state.ServerPID = 0
+				if err := utils.WriteNativeServer(state); err != nil {
+					log.Error(err)
+				}
+			case <-ticker.C:
+				if nativeFingerprint(state) == fingerprint {
+					continue
+				}
+				log.Info("Meshery Server binary, kubeconfig or environment changed, restarting Meshery Server...")
+				if exited != nil {
+					stopNativeServer(server, exited)
+				}
+				state.Restarts++
+				break wait
+			}
+		}
+	}
+}
+
+// startNativeServer starts Meshery Server with the environment of mesheryctl, the port and
+// kubeconfig of the state and the environment file, the latter taking precedence
+func startNativeServer(state *utils.NativeServer) (*exec.Cmd, error) {
+	env, err := utils.ReadEnvFile(utils.NativePath(utils.NativeEnvFile))
+	if err != nil {
+		return nil, err
+	}
+	kubeconfigFolder, err := nativeKubeconfigFolder(state.Kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	server := exec.Command(state.Binary)
+	server.Dir = filepath.Dir(state.Binary)
+	server.Env = append(os.Environ(), "PORT="+state.Port, "KUBECONFIG_FOLDER="+kubeconfigFolder)
+	server.Env = append(server.Env, env...)
+	server.Stdout = os.Stdout
+	server.Stderr = os.Stderr
+	if err := server.Start(); err != nil {
+		return nil, err
+	}
+	log.Infof("Started Meshery Server with PID %d", server.Process.Pid)
+	return server, nil
+}
+
+// nativeKubeconfigFolder returns the folder Meshery Server reads the kubeconfig of, as a file named
+// config, from. A kubeconfig of another name is copied into the NativeFolder.
+func nativeKubeconfigFolder(kubeconfig string) (string, error) {
+	if filepath.Base(kubeconfig) == "config" {
+		return filepath.Dir(kubeconfig), nil
+	}
+	folder := utils.NativePath("kube")
+	if err := os.MkdirAll(folder, 0700); err != nil {
+		return "", err
+	}
+	src, err := os.Open(kubeconfig)
+	if err != nil {
+		return "", err
+	}
+	defer utils.SafeClose(src)
+	dst, err := os.OpenFile(filepath.Join(folder, "config"), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer utils.SafeClose(dst)
+	if _, err := io.Copy(dst, src); err != nil {
+		return "", err
+	}
+	return folder, nil
+}
+
+// nativeFingerprint returns the modification times and sizes of the binary, kubeconfig and
+// environment file of Meshery Server, which change along with them
+func nativeFingerprint(state *utils.NativeServer) string {
+	fingerprint := ""
+	for _, file := range []string{state.Binary, state.Kubeconfig, utils.NativePath(utils.NativeEnvFile)} {
+		if info, err := os.Stat(file); err == nil {
+			fingerprint += fmt.Sprintf("%s:%d:%d;", file, info.ModTime().UnixNano(), info.Size())
+		}
+	}
+	return fingerprint
+}
+
+// stopNativeServer interrupts Meshery Server, killing it when it does not exit in time
+func stopNativeServer(server *exec.Cmd, exited chan error) {
+	if runtime.GOOS == "windows" {
+		_ = server.Process.Kill()
+	} else {
+		_ = server.Process.Signal(os.Interrupt)
+	}
+	select {
+	case <-exited:
+	case <-time.After(nativeStopTimeout):
+		_ = server.Process.Kill()
+		<-exited
+	}
+}
+
+// stopNative stops Meshery Server run natively along with its supervisor
+func stopNative() error {
+	server, err := utils.ReadNativeServer()
+	if err != nil {
+		return err
+	}
+	if server == nil || !server.Running() {
+		return nil
+	}
+
+	supervisor, err := os.FindProcess(server.PID)
+	if err != nil {
+		return ErrStopMeshery(err)
+	}
+	// processes cannot be interrupted on Windows, the server is killed along with its supervisor
+	if runtime.GOOS == "windows" {
+		if process, err := os.FindProcess(server.ServerPID); err == nil && server.ServerPID != 0 {
+			_ = process.Kill()
+		}
+		return supervisor.Kill()
+	}
+
+	if err := supervisor.Signal(os.Interrupt); err != nil {
+		return ErrStopMeshery(err)
+	}
+	for deadline := time.Now().Add(nativeStopTimeout + 5*time.Second); time.Now().Before(deadline); time.Sleep(200 * time.Millisecond) {
+		if !utils.IsProcessRunning(server.PID) {
+			return nil
+		}
+	}
+	return ErrStopMeshery(fmt.Errorf("Meshery Server supervisor with PID %d did not stop", server.PID))
+}
+
+// printNativeStatus prints the status of Meshery Server run natively
+func printNativeStatus(currCtx *config.Context) error {
+	server, err := utils.ReadNativeServer()
+	if err != nil {
+		return err
+	}
+	if server == nil {
+		return nil
+	}
+	status := "Running"
+	if server.ServerPID == 0 || !utils.IsProcessRunning(server.ServerPID) {
+		status = "Exited"
+	}
+	utils.PrintToTable([]string{"Name", "PID", "Status", "Restarts", "Age"}, [][]string{{
+		"meshery", fmt.Sprint(server.ServerPID), status, fmt.Sprint(server.Restarts), time.Since(server.StartedAt).Round(time.Second).String(),
+	}})
+	log.Info("\nMeshery Server binary is " + server.Binary)
+	log.Info("Meshery endpoint is " + currCtx.GetEndpoint())
+	return nil
+}
+
+// followNativeLogs prints the output of Meshery Server run natively, then follows it until interrupted
+func followNativeLogs() error {
+	file, err := os.Open(utils.NativePath(utils.NativeLogFile))
+	if err != nil {
+		return ErrNativeServer(err)
+	}
+	defer utils.SafeClose(file)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+	for {
+		if _, err := io.Copy(os.Stdout, file); err != nil {
+			return err
+		}
+		select {
+		case <-signals:
+			return nil
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+func init() {
+	nativeRunCmd.Flags().StringVar(&serverBinaryFlag, "server-binary", "", "Meshery Server binary")
+	nativeRunCmd.Flags().StringVar(&nativeKubeconfigFlag, "kubeconfig", "", "kubeconfig of Meshery Server")
+	nativeRunCmd.Flags().StringVar(&nativePortFlag, "port", "9081", "port of Meshery Server")
+	SystemCmd.AddCommand(nativeRunCmd)
+}
@@ -0,0 +1,140 @@
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/constants"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	reportOutputFile     string
+	reportNoRedact       bool
+	reportRedactionRules string
+)
+
+// diagnosticsReport holds the details of the environment attached to bug reports
+type diagnosticsReport struct {
+	GeneratedAt time.Time              `json:"generatedAt"`
+	Client      map[string]string      `json:"client"`
+	Server      map[string]interface{} `json:"server"`
+	Context     map[string]interface{} `json:"context"`
+}
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate a diagnostics report",
+	Long: `Collect the versions of mesheryctl and Meshery Server along with the current context into a report
+which can be attached to issues. Tokens, secrets, IP addresses and internal hostnames are redacted unless
+--no-redact is given. Additional rules are read from ~/.meshery/redaction.yaml or the file given with --redaction-rules.`,
+	Args: cobra.NoArgs,
+	Example: `
+// Print a redacted diagnostics report
+mesheryctl system report
+
+// Write the report to a file using additional redaction rules
+mesheryctl system report --redaction-rules ./rules.yaml -o report.yaml
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		report := diagnosticsReport{
+			GeneratedAt: time.Now().UTC(),
+			Client: map[string]string{
+				"version":   constants.GetMesheryctlVersion(),
+				"commitSHA": constants.GetMesheryctlCommitsha(),
+				"os":        runtime.GOOS,
+				"arch":      runtime.GOARCH,
+				"config":    utils.DefaultConfigPath,
+			},
+			Server:  fetchServerDiagnostics(mctlCfg.GetBaseMesheryURL()),
+			Context: map[string]interface{}{"name": mctlCfg.GetCurrentContextName()},
+		}
+
+		if currCtx, err := mctlCfg.GetCurrentContext(); err == nil {
+			report.Context["endpoint"] = currCtx.Endpoint
+			report.Context["token"] = currCtx.Token
+			report.Context["platform"] = currCtx.Platform
+			report.Context["components"] = currCtx.Components
+			report.Context["channel"] = currCtx.Channel
+			report.Context["version"] = currCtx.Version
+		} else {
+			report.Context["error"] = err.Error()
+		}
+
+		body, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		if !reportNoRedact {
+			redactor, err := utils.NewRedactorFromFile(reportRedactionRules)
+			if err != nil {
+				return err
+			}
+			if body, err = redactor.RedactJSON(body); err != nil {
+				return err
+			}
+		}
+		if body, err = yaml.JSONToYAML(body); err != nil {
+			return errors.Wrap(err, "failed to convert json to yaml")
+		}
+
+		if reportOutputFile == "" {
+			utils.Log.Info(string(body))
+			return nil
+		}
+		if err := os.WriteFile(reportOutputFile, body, 0600); err != nil {
+			return errors.Wrapf(err, "failed to write the report to %s", reportOutputFile)
+		}
+		utils.Log.Info("Diagnostics report written to ", reportOutputFile)
+		return nil
+	},
+}
+
+// fetchServerDiagnostics returns the version of Meshery Server, or why it could not be reached
+func fetchServerDiagnostics(url string) map[string]interface{} {
+	server := map[string]interface{}{"endpoint": url, "reachable": false}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get(fmt.Sprintf("%s/api/system/version", url))
+	if err != nil {
+		server["error"] = err.Error()
+		return server
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		server["error"] = fmt.Sprintf("unexpected response status %d", resp.StatusCode)
+		return server
+	}
+	version := config.Version{}
+	if err := json.Unmarshal(data, &version); err != nil {
+		server["error"] = err.Error()
+		return server
+	}
+	server["reachable"] = true
+	server["version"] = version.GetBuild()
+	server["commitSHA"] = version.GetCommitSHA()
+	return server
+}
+
+func init() {
+	reportCmd.Flags().StringVarP(&reportOutputFile, "output-file", "o", "", "(optional) file the report is written to instead of stdout")
+	reportCmd.Flags().BoolVar(&reportNoRedact, "no-redact", false, "(optional) include tokens, IP addresses and hostnames as they are")
+	reportCmd.Flags().StringVar(&reportRedactionRules, "redaction-rules", "", "(optional) file of redaction rules extending the defaults")
+}
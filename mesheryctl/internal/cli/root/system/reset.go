@@ -15,28 +15,208 @@
 package system
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	meshkitkube "github.com/layer5io/meshkit/utils/kubernetes"
 
 	log "github.com/sirupsen/logrus"
+	kubeerror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+var (
+	// keepDataFlag recreates the deployments of Meshery along with its configuration, keeping its data
+	keepDataFlag bool
+	// purgeDataFlag recreates the deployments of Meshery along with its configuration, wiping its data
+	purgeDataFlag bool
+)
+
 // resetCmd represents the reset command
 var resetCmd = &cobra.Command{
 	Use:   "reset",
 	Short: "Reset Meshery's configuration",
-	Long:  `Reset Meshery to it's default configuration.`,
-	Args:  cobra.NoArgs,
+	Long: `Reset Meshery to it's default configuration.
+
+With --keep-data, the deployments of Meshery are recreated as well, keeping the data it persisted
+(performance profiles and results, designs, preferences). With --purge-data, the deployments are
+recreated after everything, including the volumes of the data, is wiped.`,
+	Example: `
+// Reset the configuration of Meshery
+mesheryctl system reset
+
+// Recreate the deployments of Meshery, keeping its data
+mesheryctl system reset --keep-data
+
+// Wipe Meshery along with its data and deploy it anew
+mesheryctl system reset --purge-data
+	`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if keepDataFlag && purgeDataFlag {
+			return fmt.Errorf("--keep-data and --purge-data cannot be used together")
+		}
+		if keepDataFlag || purgeDataFlag {
+			return resetMesheryDeployments(purgeDataFlag)
+		}
 		return resetMesheryConfig()
 	},
 }
 
+// resetMesheryDeployments stops Meshery, resets its configuration and starts it again. Its data is kept
+// unless purge is set, in which case what will be destroyed is listed for confirmation beforehand.
+func resetMesheryDeployments(purge bool) error {
+	// Get viper instance used for context
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return ErrProcessingMctlConfig(err)
+	}
+	if tempContext != "" {
+		err = mctlCfg.SetCurrentContext(tempContext)
+		if err != nil {
+			return ErrSettingTemporaryContext(err)
+		}
+	}
+	currCtx, err := mctlCfg.GetCurrentContext()
+	if err != nil {
+		return ErrRetrievingCurrentContext(err)
+	}
+	platform := currCtx.GetPlatform()
+
+	var destroyed []string
+	if purge {
+		destroyed, err = purgedData(platform)
+		if err != nil {
+			return err
+		}
+		log.Info("The following will be destroyed, along with the deployments of Meshery:")
+		for _, d := range destroyed {
+			log.Info("  - " + d)
+		}
+	}
+
+	userResponse := false
+	if utils.SilentFlag {
+		userResponse = true
+	} else if purge {
+		userResponse = utils.AskForConfirmation("Meshery will be reset and all of its data, including performance profiles, results and designs, destroyed. This cannot be undone. Are you sure you want to continue")
+	} else {
+		userResponse = utils.AskForConfirmation("Meshery deployments will be recreated and its config reset to system defaults, its data being kept. Are you sure you want to continue")
+	}
+	if !userResponse {
+		log.Info("Reset aborted.")
+		return nil
+	}
+
+	// the confirmation above stands for those of stopping, resetting and starting Meshery
+	silent, keepNamespace := utils.SilentFlag, utils.KeepNamespace
+	utils.SilentFlag = true
+	// the namespace of Meshery is kept along with the persistent volume claims in it, unless purged
+	utils.KeepNamespace = !purge
+	defer func() {
+		utils.SilentFlag, utils.KeepNamespace = silent, keepNamespace
+	}()
+
+	if err := stop(); err != nil {
+		return ErrStopMeshery(err)
+	}
+	if purge {
+		if err := purgeData(platform); err != nil {
+			return err
+		}
+		log.Info("Meshery data is purged.")
+	}
+	if platform != "native" {
+		if err := resetMesheryConfig(); err != nil {
+			return ErrResetMeshconfig(err)
+		}
+	}
+	if err := start(); err != nil {
+		return ErrRestartMeshery(err)
+	}
+	return nil
+}
+
+// nativeDataFolder is where Meshery Server run natively persists its data
+func nativeDataFolder() string {
+	return filepath.Join(utils.MesheryFolder, "config")
+}
+
+// purgedData lists the data of Meshery on the platform which purging destroys
+func purgedData(platform string) ([]string, error) {
+	switch platform {
+	case "docker":
+		out, err := exec.Command("docker-compose", "-f", utils.DockerComposeFile, "config", "--volumes").Output()
+		if err != nil {
+			return []string{"the containers and volumes of " + utils.DockerComposeFile}, nil
+		}
+		destroyed := []string{"the containers of " + utils.DockerComposeFile}
+		for _, volume := range strings.Fields(string(out)) {
+			destroyed = append(destroyed, "docker volume "+volume)
+		}
+		return destroyed, nil
+	case "kubernetes":
+		client, err := meshkitkube.New([]byte(""))
+		if err != nil {
+			return nil, ErrK8SQuery(err)
+		}
+		var destroyed []string
+		for _, namespace := range utils.MesheryNamespaces() {
+			destroyed = append(destroyed, "namespace "+namespace+" and everything in it")
+			pvcs, err := client.KubeClient.CoreV1().PersistentVolumeClaims(namespace).List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				continue
+			}
+			for _, pvc := range pvcs.Items {
+				destroyed = append(destroyed, "persistent volume claim "+namespace+"/"+pvc.Name)
+			}
+		}
+		return destroyed, nil
+	case "native":
+		return []string{"the data folder " + nativeDataFolder()}, nil
+	default:
+		return nil, fmt.Errorf("the platform %s is not supported currently. The supported platforms are:\ndocker\nkubernetes\nnative\nPlease check %s/config.yaml file", platform, utils.MesheryFolder)
+	}
+}
+
+// purgeData wipes the data of Meshery left once it is stopped on the platform, the namespaces of
+// Meshery on Kubernetes being left by stopping it when it was not running
+func purgeData(platform string) error {
+	switch platform {
+	case "docker":
+		down := exec.Command("docker-compose", "-f", utils.DockerComposeFile, "down", "--volumes", "--remove-orphans")
+		down.Stdout = os.Stdout
+		down.Stderr = os.Stderr
+		if err := down.Run(); err != nil {
+			return ErrStopMeshery(err)
+		}
+	case "kubernetes":
+		client, err := meshkitkube.New([]byte(""))
+		if err != nil {
+			return ErrK8SQuery(err)
+		}
+		for _, namespace := range utils.MesheryNamespaces() {
+			if err := deleteNs(namespace, client.KubeClient); err != nil && !kubeerror.IsNotFound(err) {
+				return ErrStopMeshery(err)
+			}
+		}
+	case "native":
+		if err := os.RemoveAll(nativeDataFolder()); err != nil {
+			return ErrStopMeshery(err)
+		}
+	}
+	return nil
+}
+
 // resets meshery config, skips conirmation if skipConfirmation is true
 func resetMesheryConfig() error {
 	userResponse := false
@@ -117,3 +297,8 @@ func resetMesheryConfig() error {
 	}
 	return nil
 }
+
+func init() {
+	resetCmd.Flags().BoolVarP(&keepDataFlag, "keep-data", "", false, "(optional) recreate the deployments of Meshery as well, keeping its data")
+	resetCmd.Flags().BoolVarP(&purgeDataFlag, "purge-data", "", false, "(optional) recreate the deployments of Meshery as well, wiping its data including the volumes")
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
@@ -81,3 +82,26 @@ func RemoveFileAndDirectory() {
 		log.Fatal(removeMesheryHiddenDirectory)
 	}
 }
+
+func TestResetDataFlags(t *testing.T) {
+	defer func() {
+		keepDataFlag, purgeDataFlag = false, false
+	}()
+	SystemCmd.SetArgs([]string{"reset", "--keep-data", "--purge-data"})
+	if err := SystemCmd.Execute(); err == nil {
+		t.Error("expected --keep-data along with --purge-data to be rejected")
+	}
+}
+
+func TestPurgedData(t *testing.T) {
+	destroyed, err := purgedData("native")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(destroyed) != 1 || !strings.Contains(destroyed[0], nativeDataFolder()) {
+		t.Errorf("expected the data folder of Meshery Server to be destroyed, got %v", destroyed)
+	}
+	if _, err := purgedData("unknown"); err == nil {
+		t.Error("expected an unknown platform to be rejected")
+	}
+}
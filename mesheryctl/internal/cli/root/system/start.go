@@ -42,8 +42,10 @@ import (
 )
 
 var (
-	skipUpdateFlag  bool
-	skipBrowserFlag bool
+	skipUpdateFlag       bool
+	skipBrowserFlag      bool
+	namespaceFlag        string
+	adapterNamespaceFlag string
 )
 
 // startCmd represents the start command
@@ -131,7 +133,7 @@ func start() error {
 	}
 
 	if utils.PlatformFlag != "" {
-		if utils.PlatformFlag == "docker" || utils.PlatformFlag == "kubernetes" {
+		if utils.PlatformFlag == "docker" || utils.PlatformFlag == "kubernetes" || utils.PlatformFlag == "native" {
 			currCtx.SetPlatform(utils.PlatformFlag)
 
 			// update the context to config
@@ -144,6 +146,25 @@ func start() error {
 		}
 	}
 
+	// persist the namespaces in the context so that later commands find the components there
+	if namespaceFlag != "" || adapterNamespaceFlag != "" {
+		if namespaceFlag != "" {
+			currCtx.SetNamespace(namespaceFlag)
+		}
+		if adapterNamespaceFlag != "" {
+			currCtx.SetAdapterNamespace(adapterNamespaceFlag)
+		}
+		utils.SetNamespaces(currCtx)
+		if utils.MesheryAdapterNamespace == utils.MesheryNamespace {
+			currCtx.SetAdapterNamespace("")
+		}
+
+		err = config.UpdateContextInConfig(viper.GetViper(), currCtx, mctlCfg.GetCurrentContextName())
+		if err != nil {
+			return err
+		}
+	}
+
 	// Reset Meshery config file to default settings
 	if utils.ResetFlag {
 		err := resetMesheryConfig()
@@ -225,6 +246,15 @@ func start() error {
 			AllowedServices[v] = services[v]
 		}
 
+		// fail before pulling the images when one is not published for the architecture of the host
+		images := map[string]string{}
+		for name, service := range AllowedServices {
+			images[name] = service.Image
+		}
+		if err := checkDockerArchitecture(images); err != nil {
+			return err
+		}
+
 		utils.ViperCompose.Set("services", AllowedServices)
 		err = utils.ViperCompose.WriteConfig()
 		if err != nil {
@@ -350,9 +380,22 @@ func start() error {
 			return err
 		}
 
+		// the namespace of the adapters is not created along with the helm release
+		if utils.MesheryAdapterNamespace != utils.MesheryNamespace {
+			if err := utils.CreateNamespace(kubeClient, utils.MesheryAdapterNamespace); err != nil {
+				return err
+			}
+		}
+
 		// get value overrides to install the helm chart
 		overrideValues := utils.SetOverrideValues(currCtx, mesheryImageVersion)
 
+		// schedule the components on the nodes of the architectures their images are published for
+		if err := placeByArchitecture(kubeClient, currCtx, mesheryImageVersion, overrideValues); err != nil {
+			spinner.Stop()
+			return err
+		}
+
 		// install the helm charts with specified override values
 		var chartVersion string
 		if mesheryImageVersion != "latest" {
@@ -389,9 +432,15 @@ func start() error {
 		}
 		log.Info("Meshery is starting...")
 
+	case "native":
+		// run the Meshery Server binary on the host, pointing it to the kubeconfig
+		if err := startNative(currCtx); err != nil {
+			return err
+		}
+
 		// switch to default case if the platform specified is not supported
 	default:
-		return fmt.Errorf("the platform %s is not supported currently. The supported platforms are:\ndocker\nkubernetes\nPlease check %s/config.yaml file", currCtx.GetPlatform(), utils.MesheryFolder)
+		return fmt.Errorf("the platform %s is not supported currently. The supported platforms are:\ndocker\nkubernetes\nnative\nPlease check %s/config.yaml file", currCtx.GetPlatform(), utils.MesheryFolder)
 	}
 
 	// execute dashboard command to fetch and navigate to Meshery UI
@@ -399,8 +448,13 @@ func start() error {
 }
 
 func init() {
-	startCmd.PersistentFlags().StringVarP(&utils.PlatformFlag, "platform", "p", "", "platform to deploy Meshery to.")
+	startCmd.PersistentFlags().StringVarP(&utils.PlatformFlag, "platform", "p", "", "platform to deploy Meshery to: docker, kubernetes or native to run the Meshery Server binary on the host.")
+	startCmd.Flags().StringVarP(&serverBinaryFlag, "server-binary", "", "", "(optional) Meshery Server binary to run on the native platform. (default the binary last run, else meshery in the PATH)")
+	startCmd.Flags().StringVarP(&nativeKubeconfigFlag, "kubeconfig", "", "", "(optional) kubeconfig of Meshery Server run on the native platform. (default the kubeconfig last used, else ~/.kube/config)")
 	startCmd.Flags().BoolVarP(&skipUpdateFlag, "skip-update", "", false, "(optional) skip checking for new Meshery's container images.")
 	startCmd.Flags().BoolVarP(&utils.ResetFlag, "reset", "", false, "(optional) reset Meshery's configuration file to default settings.")
 	startCmd.Flags().BoolVarP(&skipBrowserFlag, "skip-browser", "", false, "(optional) skip opening of MesheryUI in browser.")
+	startCmd.Flags().StringVarP(&namespaceFlag, "namespace", "", "", "(optional) namespace to deploy Meshery to on Kubernetes, saved in the current context. (default \"meshery\")")
+	startCmd.Flags().BoolVarP(&skipArchCheck, "skip-arch-check", "", false, "(optional) skip checking that the images of Meshery are published for the architecture of the host or of the nodes of the cluster.")
+	startCmd.Flags().StringVarP(&adapterNamespaceFlag, "adapter-namespace", "", "", "(optional) namespace to deploy the adapters to on Kubernetes when not the one of Meshery, saved in the current context.")
 }
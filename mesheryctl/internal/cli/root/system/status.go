@@ -15,7 +15,9 @@
 package system
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os/exec"
 	"strings"
 	"time"
@@ -24,14 +26,24 @@ import (
 
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
 	meshkitkube "github.com/layer5io/meshkit/utils/kubernetes"
+	v1 "k8s.io/api/core/v1"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
-var verboseStatus bool
+// statusRefreshInterval is the interval at which the status is refreshed in watch and wait modes
+const statusRefreshInterval = 2 * time.Second
+
+var (
+	verboseStatus bool
+	watchStatus   bool
+	waitStatus    bool
+	statusTimeout time.Duration
+)
 
 // statusCmd represents the status command
 var statusCmd = &cobra.Command{
@@ -39,6 +51,16 @@ var statusCmd = &cobra.Command{
 	Short: "Check Meshery status",
 	Args:  cobra.NoArgs,
 	Long:  `Check status of Meshery and Meshery components.`,
+	Example: `
+// Check status of Meshery and Meshery components
+mesheryctl system status
+
+// Refresh the status until all the components are ready
+mesheryctl system status --watch
+
+// Wait up to 10 minutes for all the components to be ready, e.g. after mesheryctl system start in scripts
+mesheryctl system status --wait --timeout 10m
+`,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		//Check prerequisite
 		hcOptions := &HealthCheckOptions{
@@ -78,9 +100,11 @@ var statusCmd = &cobra.Command{
 			return err
 		}
 
-		currPlatform := currCtx.GetPlatform()
+		if watchStatus || waitStatus {
+			return waitForComponents(mctlCfg, currCtx)
+		}
 
-		ok, err := utils.AreMesheryComponentsRunning(currPlatform)
+		ok, err := utils.AreMesheryComponentsRunning(currCtx.GetPlatform())
 		if err != nil {
 			return err
 		}
@@ -89,110 +113,307 @@ var statusCmd = &cobra.Command{
 			return nil
 		}
 
-		switch currPlatform {
-		case "docker":
-			// List the running Meshery containers
-			start := exec.Command("docker-compose", "-f", utils.DockerComposeFile, "ps")
+		return printStatus(mctlCfg, currCtx)
+	},
+}
 
-			outputStd, err := start.Output()
-			if err != nil {
-				return errors.Wrap(err, utils.SystemError("failed to get Meshery status"))
-			}
+// printStatus prints the status of the Meshery components of the current context
+func printStatus(mctlCfg *config.MesheryCtlConfig, currCtx *config.Context) error {
+	switch currCtx.GetPlatform() {
+	case "docker":
+		// List the running Meshery containers
+		start := exec.Command("docker-compose", "-f", utils.DockerComposeFile, "ps")
 
-			outputString := string(outputStd)
+		outputStd, err := start.Output()
+		if err != nil {
+			return errors.Wrap(err, utils.SystemError("failed to get Meshery status"))
+		}
 
-			if strings.Contains(outputString, "meshery") {
-				log.Info(outputString)
-			}
+		outputString := string(outputStd)
+
+		if strings.Contains(outputString, "meshery") {
+			log.Info(outputString)
+		}
+
+		hcOptions := &HealthCheckOptions{
+			PrintLogs:           false,
+			IsPreRunE:           false,
+			Subcommand:          "status",
+			RunKubernetesChecks: true,
+		}
+		hc, err := NewHealthChecker(hcOptions)
+		if err != nil {
+			return errors.Wrapf(err, "failed to initialize healthchecker")
+
+		}
+		// If k8s is available print the status of pods in the MesheryNamespace
+		if err = hc.Run(); err != nil {
+			return nil
+		}
 
-			hcOptions := &HealthCheckOptions{
-				PrintLogs:           false,
-				IsPreRunE:           false,
-				Subcommand:          "status",
-				RunKubernetesChecks: true,
+		fallthrough
+	case "kubernetes":
+		// if the platform is kubernetes, use kubernetes go-client to
+		// display pod status in the MesheryNamespace
+
+		// create an kubernetes client
+		client, err := meshkitkube.New([]byte(""))
+
+		if err != nil {
+			return err
+		}
+
+		// List the pods in the namespaces of Meshery and its adapters
+		podList, err := utils.GetMesheryPodList(client)
+
+		if err != nil {
+			return err
+		}
+
+		// the architecture of the node of every pod is shown when the cluster mixes architectures
+		nodes, err := nodeArchitectures(client)
+		if err != nil {
+			log.Debug("unable to list the architectures of the nodes: ", err)
+		}
+		showArch := len(distinctArchitectures(nodes)) > 1
+
+		var data [][]string
+		columnNames := []string{"Name", "Ready", "Status", "Restarts", "Age"}
+		if showArch {
+			columnNames = append(columnNames, "Arch")
+		}
+		// List all the pods similar to kubectl get pods -n MesheryNamespace
+		for _, pod := range podList.Items {
+			// Calculate the age of the pod
+			podCreationTime := pod.GetCreationTimestamp()
+			age := time.Since(podCreationTime.Time).Round(time.Second)
+
+			// Get the status of each of the pods
+			podStatus := pod.Status
+			var containerRestarts int32
+			var containerReady int
+			var totalContainers int
+
+			if len(pod.Spec.Containers) > 0 && len(podStatus.ContainerStatuses) > 0 {
+				// If a pod has multiple containers, get the status from all
+				for container := range pod.Spec.Containers {
+					containerRestarts += podStatus.ContainerStatuses[container].RestartCount
+					if podStatus.ContainerStatuses[container].Ready {
+						containerReady++
+					}
+					totalContainers++
+				}
 			}
-			hc, err := NewHealthChecker(hcOptions)
-			if err != nil {
-				return errors.Wrapf(err, "failed to initialize healthchecker")
 
+			// Get the values from the pod status
+			name := utils.GetCleanPodName(pod.GetName())
+			ready := fmt.Sprintf("%v/%v", containerReady, totalContainers)
+			status := fmt.Sprintf("%v", podStatus.Phase)
+			restarts := fmt.Sprintf("%v", containerRestarts)
+			ageS := age.String()
+			row := []string{name, ready, status, restarts, ageS}
+			if showArch {
+				row = append(row, nodes[pod.Spec.NodeName])
 			}
-			// If k8s is available print the status of pods in the MesheryNamespace
-			if err = hc.Run(); err != nil {
-				return nil
+
+			// Append this to data to be printed in a table
+			if verboseStatus {
+				row = append(row, pod.Name)
+				row = append(row, podStatus.PodIP)
 			}
+			data = append(data, row)
+		}
+		if verboseStatus {
+			columnNames = append(columnNames, "Pod-Names")
+			columnNames = append(columnNames, "Pod-IP")
+		}
+		// Print the data to a table for readability
+		utils.PrintToTable(columnNames, data)
 
-			fallthrough
-		case "kubernetes":
-			// if the platform is kubernetes, use kubernetes go-client to
-			// display pod status in the MesheryNamespace
+		log.Info("\nMeshery endpoint is " + currCtx.GetEndpoint())
 
-			// create an kubernetes client
-			client, err := meshkitkube.New([]byte(""))
+		// Print the availability of the adapters as observed by the server
+		if err = printAdaptersHealth(mctlCfg); err != nil {
+			log.Debug("unable to fetch adapters health: ", err)
+		}
+	case "native":
+		// Print the process of the Meshery Server running on the host
+		if err := printNativeStatus(currCtx); err != nil {
+			return err
+		}
+		if err := printAdaptersHealth(mctlCfg); err != nil {
+			log.Debug("unable to fetch adapters health: ", err)
+		}
+	}
+	return nil
+}
 
-			if err != nil {
-				return err
-			}
+// waitForComponents checks the status of the Meshery components every statusRefreshInterval
+// until all of them are ready or the timeout passes. In watch mode the status is printed
+// on every refresh, otherwise once when the components are ready.
+func waitForComponents(mctlCfg *config.MesheryCtlConfig, currCtx *config.Context) error {
+	deadline := time.Now().Add(statusTimeout)
+	if !watchStatus {
+		log.Info("Waiting for Meshery components to be ready...")
+	}
 
-			// List the pods in the MesheryNamespace
-			podList, err := utils.GetPodList(client, utils.MesheryNamespace)
+	for {
+		pending, err := pendingComponents(currCtx)
+		if err != nil {
+			log.Debug("unable to check the status of Meshery components: ", err)
+		}
 
-			if err != nil {
-				return err
+		if watchStatus {
+			fmt.Printf("\nEvery %s: %s\n\n", statusRefreshInterval, time.Now().Format(time.RFC1123))
+			if running, _ := utils.AreMesheryComponentsRunning(currCtx.GetPlatform()); !running {
+				log.Info("Meshery is not running yet")
+			} else if err := printStatus(mctlCfg, currCtx); err != nil {
+				log.Debug("unable to print the status of Meshery components: ", err)
 			}
+		}
 
-			var data [][]string
-			columnNames := []string{"Name", "Ready", "Status", "Restarts", "Age"}
-			// List all the pods similar to kubectl get pods -n MesheryNamespace
-			for _, pod := range podList.Items {
-				// Calculate the age of the pod
-				podCreationTime := pod.GetCreationTimestamp()
-				age := time.Since(podCreationTime.Time).Round(time.Second)
-
-				// Get the status of each of the pods
-				podStatus := pod.Status
-				var containerRestarts int32
-				var containerReady int
-				var totalContainers int
-
-				if len(pod.Spec.Containers) > 0 && len(podStatus.ContainerStatuses) > 0 {
-					// If a pod has multiple containers, get the status from all
-					for container := range pod.Spec.Containers {
-						containerRestarts += podStatus.ContainerStatuses[container].RestartCount
-						if podStatus.ContainerStatuses[container].Ready {
-							containerReady++
-						}
-						totalContainers++
-					}
+		if err == nil && len(pending) == 0 {
+			if !watchStatus {
+				if err := printStatus(mctlCfg, currCtx); err != nil {
+					return err
 				}
+			}
+			log.Info("All Meshery components are ready")
+			return nil
+		}
 
-				// Get the values from the pod status
-				name := utils.GetCleanPodName(pod.GetName())
-				ready := fmt.Sprintf("%v/%v", containerReady, containerReady)
-				status := fmt.Sprintf("%v", podStatus.Phase)
-				restarts := fmt.Sprintf("%v", containerRestarts)
-				ageS := age.String()
-				row := []string{name, ready, status, restarts, ageS}
-
-				// Append this to data to be printed in a table
-				if verboseStatus {
-					row = append(row, pod.Name)
-					row = append(row, podStatus.PodIP)
-				}
-				data = append(data, row)
+		if time.Now().After(deadline) {
+			if err == nil {
+				err = fmt.Errorf("waiting for %s", strings.Join(pending, ", "))
 			}
-			if verboseStatus {
-				columnNames = append(columnNames, "Pod-Names")
-				columnNames = append(columnNames, "Pod-IP")
+			return ErrComponentsNotReady(err, statusTimeout)
+		}
+		log.Debug("waiting for ", strings.Join(pending, ", "))
+		time.Sleep(statusRefreshInterval)
+	}
+}
+
+// pendingComponents returns the Meshery components of the current context which are not
+// ready yet: the server as long as it does not serve its API, and the pods which are not
+// Running with all their containers ready or the containers which are not up.
+func pendingComponents(currCtx *config.Context) ([]string, error) {
+	pending := []string{}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Get(currCtx.GetEndpoint() + "/api/system/version")
+	if err == nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil || resp.StatusCode != http.StatusOK {
+		pending = append(pending, "meshery server")
+	}
+
+	switch currCtx.GetPlatform() {
+	case "docker":
+		output, err := exec.Command("docker-compose", "-f", utils.DockerComposeFile, "ps").Output()
+		if err != nil {
+			return nil, errors.Wrap(err, utils.SystemError("failed to get Meshery status"))
+		}
+		pending = append(pending, pendingContainers(string(output))...)
+	case "kubernetes":
+		kubeClient, err := meshkitkube.New([]byte(""))
+		if err != nil {
+			return nil, err
+		}
+		podList, err := utils.GetMesheryPodList(kubeClient)
+		if err != nil {
+			return nil, err
+		}
+		if len(podList.Items) == 0 {
+			pending = append(pending, "meshery pods")
+		}
+		for _, pod := range podList.Items {
+			if !isPodReady(pod) {
+				pending = append(pending, utils.GetCleanPodName(pod.GetName()))
 			}
-			// Print the data to a table for readability
-			utils.PrintToTable(columnNames, data)
+		}
+	}
+	return pending, nil
+}
+
+// isPodReady returns true when the pod is Running with all its containers ready
+func isPodReady(pod v1.Pod) bool {
+	if pod.Status.Phase != v1.PodRunning || len(pod.Status.ContainerStatuses) < len(pod.Spec.Containers) {
+		return false
+	}
+	for _, container := range pod.Status.ContainerStatuses {
+		if !container.Ready {
+			return false
+		}
+	}
+	return true
+}
 
-			log.Info("\nMeshery endpoint is " + currCtx.GetEndpoint())
+// pendingContainers returns the Meshery containers listed by docker-compose ps which are
+// not up, or a placeholder when none is listed yet
+func pendingContainers(ps string) []string {
+	pending := []string{}
+	listed := false
+	for _, line := range strings.Split(ps, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || !strings.Contains(fields[0], "meshery") {
+			continue
+		}
+		listed = true
+		if !strings.Contains(line, " Up") && !strings.Contains(line, " running") {
+			pending = append(pending, fields[0])
 		}
+	}
+	if !listed {
+		pending = append(pending, "meshery containers")
+	}
+	return pending
+}
+
+// printAdaptersHealth fetches the availability of the adapters from the server and prints it as a table
+func printAdaptersHealth(mctlCfg *config.MesheryCtlConfig) error {
+	req, err := utils.NewRequest("GET", mctlCfg.GetBaseMesheryURL()+"/api/system/adapters/health", nil)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	health := []models.AdapterHealth{}
+	if err = json.NewDecoder(resp.Body).Decode(&health); err != nil {
+		return err
+	}
+	if len(health) == 0 {
 		return nil
-	},
+	}
+
+	var data [][]string
+	for _, adapter := range health {
+		status := "Unavailable"
+		if adapter.Available {
+			status = "Available"
+		}
+		since := time.Since(adapter.Since).Round(time.Second).String()
+		data = append(data, []string{adapter.Location, adapter.Name, status, since, fmt.Sprintf("%.2f%%", adapter.Uptime)})
+	}
+
+	fmt.Println()
+	utils.PrintToTable([]string{"Adapter", "Name", "Status", "Since", "Uptime"}, data)
+	return nil
 }
 
 func init() {
 	statusCmd.Flags().BoolVarP(&verboseStatus, "verbose", "v", false, "(optional) Extra data in status table")
+	statusCmd.Flags().BoolVarP(&watchStatus, "watch", "w", false, "(optional) refresh the status until all the components are Running and Ready")
+	statusCmd.Flags().BoolVarP(&waitStatus, "wait", "", false, "(optional) wait for all the components to be Running and Ready, exiting with an error on timeout")
+	statusCmd.Flags().DurationVarP(&statusTimeout, "timeout", "", 5*time.Minute, "(optional) time to wait for the components with --watch and --wait")
 }
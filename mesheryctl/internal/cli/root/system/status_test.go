@@ -0,0 +1,72 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestPendingContainers(t *testing.T) {
+	tests := []struct {
+		name string
+		ps   string
+		want []string
+	}{
+		{
+			name: "all containers up",
+			ps: `        Name                       Command               State           Ports
+-------------------------------------------------------------------------------------
+meshery_meshery_1         /bin/sh -c ./meshery   Up      0.0.0.0:9081->8080/tcp
+meshery_meshery-istio_1   ./meshery-istio        Up      0.0.0.0:10000->10000/tcp
+`,
+			want: []string{},
+		},
+		{
+			name: "container restarting",
+			ps: `        Name                       Command               State           Ports
+-------------------------------------------------------------------------------------
+meshery_meshery_1         /bin/sh -c ./meshery   Up           0.0.0.0:9081->8080/tcp
+meshery_meshery-istio_1   ./meshery-istio        Restarting
+`,
+			want: []string{"meshery_meshery-istio_1"},
+		},
+		{
+			name: "no containers listed",
+			ps: `Name   Command   State   Ports
+------------------------------
+`,
+			want: []string{"meshery containers"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pendingContainers(tt.ps); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pendingContainers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPodReady(t *testing.T) {
+	pod := func(phase v1.PodPhase, ready ...bool) v1.Pod {
+		p := v1.Pod{Status: v1.PodStatus{Phase: phase}}
+		for range ready {
+			p.Spec.Containers = append(p.Spec.Containers, v1.Container{})
+		}
+		for _, r := range ready {
+			p.Status.ContainerStatuses = append(p.Status.ContainerStatuses, v1.ContainerStatus{Ready: r})
+		}
+		return p
+	}
+
+	if !isPodReady(pod(v1.PodRunning, true, true)) {
+		t.Error("expected running pod with ready containers to be ready")
+	}
+	if isPodReady(pod(v1.PodRunning, true, false)) {
+		t.Error("expected running pod with a container not ready to not be ready")
+	}
+	if isPodReady(pod(v1.PodPending, true)) {
+		t.Error("expected pending pod to not be ready")
+	}
+}
@@ -175,8 +175,10 @@ func stop() error {
 
 		if !utils.KeepNamespace {
 			log.Info("Deleting Meshery Namespace...")
-			if err = deleteNs(utils.MesheryNamespace, client.KubeClient); err != nil {
-				return err
+			for _, namespace := range utils.MesheryNamespaces() {
+				if err = deleteNs(namespace, client.KubeClient); err != nil {
+					return err
+				}
 			}
 			// Wait for the namespace to be deleted
 			deleted, err := utils.CheckMesheryNsDelete()
@@ -188,6 +190,13 @@ func stop() error {
 		} else {
 			log.Info("Meshery is stopped.")
 		}
+	case "native":
+		// interrupt the Meshery Server running on the host along with its supervisor
+		log.Info("Stopping Meshery...")
+		if err := stopNative(); err != nil {
+			return err
+		}
+		log.Info("Meshery is stopped.")
 	}
 
 	// Reset Meshery config file to default settings
@@ -69,6 +69,9 @@ func init() {
 		logoutCmd,
 		tokenCmd,
 		dashboardCmd,
+		reportCmd,
+		telemetryCmd,
+		dbCmd,
 	}
 	// --context flag to temporarily change context. This is global to all system commands
 	SystemCmd.PersistentFlags().StringVarP(&tempContext, "context", "c", "", "(optional) temporarily change the current context.")
@@ -0,0 +1,208 @@
+package system
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	telemetryOutputFormat string
+	telemetryUsageStats   bool
+	telemetryPerfResults  bool
+)
+
+var telemetryCmd = &cobra.Command{
+	Use:   "telemetry",
+	Short: "Manage the telemetry of Meshery",
+	Long: `Report which data Meshery sends, where to and whether you enabled it, and enable or disable
+the anonymous usage statistics and performance results.`,
+	Args: cobra.MinimumNArgs(1),
+	Example: `
+// Show whether the telemetry is enabled
+mesheryctl system telemetry status
+
+// Show the data as it would be sent next
+mesheryctl system telemetry status -o yaml
+
+// Stop sending the anonymous performance results
+mesheryctl system telemetry disable --perf-results
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(cmd.Commands(), args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+var telemetryStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the telemetry report",
+	Long: `Show the categories of data Meshery sends, who sends them, where to and whether they are enabled.
+With -o json or -o yaml, the full report is printed along with samples of the data as it would be sent
+next, built from your latest data.`,
+	Args: cobra.NoArgs,
+	Example: `
+// Show whether the telemetry is enabled
+mesheryctl system telemetry status
+
+// Show the data as it would be sent next
+mesheryctl system telemetry status -o yaml
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTelemetry(http.MethodGet, nil)
+	},
+}
+
+var telemetryEnableCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Enable the anonymous telemetry",
+	Long: `Enable the anonymous usage statistics and performance results, or only those selected with
+--usage-stats or --perf-results.`,
+	Args: cobra.NoArgs,
+	Example: `
+// Enable the anonymous usage statistics and performance results
+mesheryctl system telemetry enable
+
+// Only enable the anonymous usage statistics
+mesheryctl system telemetry enable --usage-stats
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTelemetry(http.MethodPost, telemetryPreferences(true, telemetryUsageStats, telemetryPerfResults))
+	},
+}
+
+var telemetryDisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Disable the anonymous telemetry",
+	Long: `Disable the anonymous usage statistics and performance results, or only those selected with
+--usage-stats or --perf-results.`,
+	Args: cobra.NoArgs,
+	Example: `
+// Disable the anonymous usage statistics and performance results
+mesheryctl system telemetry disable
+
+// Only disable the anonymous performance results
+mesheryctl system telemetry disable --perf-results
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTelemetry(http.MethodPost, telemetryPreferences(false, telemetryUsageStats, telemetryPerfResults))
+	},
+}
+
+// telemetryPreferences returns the preferences setting the selected categories to enabled, every
+// category if none is selected
+func telemetryPreferences(enabled, usageStats, perfResults bool) *models.TelemetryPreferences {
+	prefs := &models.TelemetryPreferences{}
+	if usageStats || !perfResults {
+		prefs.AnonymousUsageStats = &enabled
+	}
+	if perfResults || !usageStats {
+		prefs.AnonymousPerfResults = &enabled
+	}
+	return prefs
+}
+
+// runTelemetry requests the telemetry report, updating the telemetry preferences first if given,
+// and prints it
+func runTelemetry(method string, prefs *models.TelemetryPreferences) error {
+	if telemetryOutputFormat != "table" && telemetryOutputFormat != "json" && telemetryOutputFormat != "yaml" {
+		return errors.Errorf("invalid output format %s, expected table, json or yaml", telemetryOutputFormat)
+	}
+
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return errors.Wrap(err, "error processing config")
+	}
+
+	var body io.Reader
+	if prefs != nil {
+		data, err := json.Marshal(prefs)
+		if err != nil {
+			return ErrTelemetry(err)
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := utils.NewRequest(method, mctlCfg.GetBaseMesheryURL()+"/api/system/telemetry", body)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return ErrTelemetry(err)
+	}
+	defer utils.SafeClose(resp.Body)
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ErrTelemetry(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return ErrTelemetry(fmt.Errorf("server responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(data))))
+	}
+
+	switch telemetryOutputFormat {
+	case "json":
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			return ErrTelemetry(err)
+		}
+		fmt.Println(buf.String())
+		return nil
+	case "yaml":
+		out, err := yaml.JSONToYAML(data)
+		if err != nil {
+			return ErrTelemetry(err)
+		}
+		fmt.Print(string(out))
+		return nil
+	}
+
+	report := &models.TelemetryReport{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return ErrTelemetry(err)
+	}
+	utils.Log.Info(fmt.Sprintf("Provider: %s", report.Provider))
+	utils.PrintToTable([]string{"CATEGORY", "ENABLED", "ANONYMOUS", "SENDER", "DESTINATION"}, telemetryRows(report))
+	utils.Log.Info("Run `mesheryctl system telemetry status -o yaml` to see the data as it would be sent.")
+	return nil
+}
+
+// telemetryRows returns the rows of the categories of the report
+func telemetryRows(report *models.TelemetryReport) [][]string {
+	var rows [][]string
+	for _, c := range report.Categories {
+		rows = append(rows, []string{c.Name, yesNo(c.Enabled), yesNo(c.Anonymous), c.Sender, c.Destination})
+	}
+	return rows
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func init() {
+	telemetryCmd.PersistentFlags().StringVarP(&telemetryOutputFormat, "output-format", "o", "table", "(optional) format of the report: table, json or yaml")
+	for _, cmd := range []*cobra.Command{telemetryCmd, telemetryStatusCmd, telemetryEnableCmd, telemetryDisableCmd} {
+		utils.SetOutputFormats(cmd, "table", "json", "yaml")
+	}
+	for _, cmd := range []*cobra.Command{telemetryEnableCmd, telemetryDisableCmd} {
+		cmd.Flags().BoolVar(&telemetryUsageStats, "usage-stats", false, "(optional) only the anonymous usage statistics of Meshery UI")
+		cmd.Flags().BoolVar(&telemetryPerfResults, "perf-results", false, "(optional) only the anonymous results and metrics of the performance tests")
+	}
+	telemetryCmd.AddCommand(telemetryStatusCmd, telemetryEnableCmd, telemetryDisableCmd)
+}
@@ -0,0 +1,56 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/models"
+)
+
+func TestTelemetryPreferences(t *testing.T) {
+	tests := []struct {
+		name        string
+		enabled     bool
+		usageStats  bool
+		perfResults bool
+		wantUsage   *bool
+		wantPerf    *bool
+	}{
+		{name: "every category when none is selected", enabled: false, wantUsage: boolPtr(false), wantPerf: boolPtr(false)},
+		{name: "only the usage statistics", enabled: true, usageStats: true, wantUsage: boolPtr(true)},
+		{name: "only the performance results", enabled: false, perfResults: true, wantPerf: boolPtr(false)},
+		{name: "both categories selected", enabled: true, usageStats: true, perfResults: true, wantUsage: boolPtr(true), wantPerf: boolPtr(true)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := telemetryPreferences(tt.enabled, tt.usageStats, tt.perfResults)
+			if !reflect.DeepEqual(got.AnonymousUsageStats, tt.wantUsage) {
+				t.Errorf("anonymousUsageStats = %v, want %v", got.AnonymousUsageStats, tt.wantUsage)
+			}
+			if !reflect.DeepEqual(got.AnonymousPerfResults, tt.wantPerf) {
+				t.Errorf("anonymousPerfResults = %v, want %v", got.AnonymousPerfResults, tt.wantPerf)
+			}
+		})
+	}
+}
+
+func TestTelemetryRows(t *testing.T) {
+	report := &models.TelemetryReport{
+		Provider: "None",
+		Categories: []*models.TelemetryCategory{
+			{Name: models.TelemetryUsageStatistics, Enabled: true, Anonymous: true, Sender: "Meshery UI", Destination: "https://www.google-analytics.com"},
+			{Name: models.TelemetryPerformanceResults, Enabled: false, Anonymous: true, Sender: "Meshery Server", Destination: "https://meshery.layer5.io/result"},
+		},
+	}
+	want := [][]string{
+		{models.TelemetryUsageStatistics, "yes", "yes", "Meshery UI", "https://www.google-analytics.com"},
+		{models.TelemetryPerformanceResults, "no", "yes", "Meshery Server", "https://meshery.layer5.io/result"},
+	}
+	if got := telemetryRows(report); !reflect.DeepEqual(got, want) {
+		t.Errorf("telemetryRows() = %v, want %v", got, want)
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,81 @@
+package root
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/constants"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	upgradeChannel string
+	upgradeVersion string
+)
+
+// upgradeCmd represents the version upgrade command
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade mesheryctl",
+	Long: `Upgrade mesheryctl to the latest release of its channel, or to the pinned version, replacing the running executable.
+The signature of the SHA-256 checksums published with the release is verified with the cosign public key mesheryctl was released with, then the release
+archive for your OS and architecture against the checksums, before the executable is replaced. Builds of mesheryctl from source, without the key, cannot upgrade.`,
+	Example: `
+// Upgrade to the latest stable release
+mesheryctl version upgrade
+
+// Upgrade to the latest edge release
+mesheryctl version upgrade --channel edge
+
+// Pin mesheryctl to the release of Meshery Server
+mesheryctl version upgrade --version v0.6.0
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tag := upgradeVersion
+		if tag == "" {
+			var err error
+			tag, err = utils.GetLatestReleaseTag(upgradeChannel)
+			if err != nil {
+				return utils.ErrSelfUpdate(err)
+			}
+		}
+
+		current := constants.GetMesheryctlVersion()
+		if tag == current {
+			utils.Log.Info(fmt.Sprintf("mesheryctl %s is already installed", current))
+			return nil
+		}
+		if !utils.SilentFlag && !utils.AskForConfirmation(fmt.Sprintf("Replace mesheryctl %s with %s", current, tag)) {
+			return errors.New("upgrade aborted")
+		}
+
+		utils.Log.Info(fmt.Sprintf("Downloading mesheryctl %s...", tag))
+		binary, err := utils.DownloadMesheryctl(tag)
+		if err != nil {
+			return utils.ErrSelfUpdate(err)
+		}
+		path, err := utils.ReplaceExecutable(binary)
+		if err != nil {
+			return utils.ErrSelfUpdate(err)
+		}
+		utils.Log.Info(fmt.Sprintf("mesheryctl upgraded from %s to %s at %s", current, tag, path))
+		return nil
+	},
+}
+
+// defaultUpgradeChannel is the channel mesheryctl was released on, stable for builds from source
+func defaultUpgradeChannel() string {
+	if channel := constants.GetMesheryctlReleaseChannel(); channel == "edge" {
+		return channel
+	}
+	return "stable"
+}
+
+func init() {
+	upgradeCmd.Flags().StringVar(&upgradeChannel, "channel", defaultUpgradeChannel(), "release channel to upgrade to the latest release of: stable or edge")
+	upgradeCmd.Flags().StringVar(&upgradeVersion, "version", "", "(optional) release to upgrade to, such as v0.6.0, instead of the latest of the channel")
+	upgradeCmd.Flags().BoolVarP(&utils.SilentFlag, "yes", "y", false, "(optional) assume yes for user interactive prompts.")
+	versionCmd.AddCommand(upgradeCmd)
+}
@@ -163,7 +163,7 @@ func checkMesheryctlClientVersion(build string) {
 	}
 	// If user is running an outdated release, let them know.
 	if res != build {
-		utils.Log.Info("\n  ", build, " is not the latest release. Update to ", res, ".")
+		utils.Log.Info("\n  ", build, " is not the latest release. Update to ", res, " with `mesheryctl version upgrade`.")
 	} else { // If user is running the latest release, let them know.
 		utils.Log.Info("\n  ", res, " is the latest release.")
 	}
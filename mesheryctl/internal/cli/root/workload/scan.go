@@ -0,0 +1,119 @@
+package workload
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	namespaces    []string
+	labelSelector string
+	patternName   string
+	save          bool
+	outputFile    string
+)
+
+var scanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Create a design from the workloads running in the cluster",
+	Long: `Select the resources discovered by MeshSync by namespace and/or label selector and turn them into a design.
+Relationships between the resources, such as Services selecting the pods of a Deployment or Deployments mounting
+ConfigMaps, are recorded as dependencies of the design's services.`,
+	Args: cobra.NoArgs,
+	Example: `
+// Print a design of the workloads of the shop namespace
+mesheryctl exp workload scan --namespace shop
+
+// Save a design of the workloads labelled app=web across namespaces
+mesheryctl exp workload scan --selector app=web --name web --save
+
+// Write the design to a file
+mesheryctl exp workload scan -n shop -n payments -o shop.yaml
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(namespaces) == 0 && labelSelector == "" {
+			return errors.New("at least one --namespace or a --selector is required")
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+
+		payload, err := json.Marshal(map[string]interface{}{
+			"save": save,
+			"workload_scan": map[string]interface{}{
+				"name":           patternName,
+				"namespaces":     namespaces,
+				"label_selector": labelSelector,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		req, err := utils.NewRequest("POST", mctlCfg.GetBaseMesheryURL()+"/api/pattern", bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		client := &http.Client{}
+		res, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		data, err := io.ReadAll(res.Body)
+		if err != nil {
+			return err
+		}
+		if res.StatusCode != http.StatusOK {
+			return errors.Errorf("Response Status Code %d: %s", res.StatusCode, string(data))
+		}
+
+		patterns := []models.MesheryPattern{}
+		if err = json.Unmarshal(data, &patterns); err != nil {
+			return err
+		}
+		if len(patterns) == 0 {
+			return errors.New("no design was generated from the cluster workloads")
+		}
+		pattern := patterns[0]
+
+		if save {
+			utils.Log.Info(fmt.Sprintf("Design %s saved with id %s", pattern.Name, pattern.ID))
+		}
+		if outputFile != "" {
+			if err = os.WriteFile(outputFile, []byte(pattern.PatternFile), 0600); err != nil {
+				return errors.Wrap(err, "failed to write the design")
+			}
+			utils.Log.Info(fmt.Sprintf("Design written to %s", outputFile))
+			return nil
+		}
+		if !save {
+			fmt.Print(pattern.PatternFile)
+		}
+		return nil
+	},
+}
+
+func init() {
+	scanCmd.Flags().StringSliceVarP(&namespaces, "namespace", "n", []string{}, "Namespaces to select the workloads from")
+	scanCmd.Flags().StringVarP(&labelSelector, "selector", "l", "", "Label selector the workloads must match, e.g. app=web,tier!=cache")
+	scanCmd.Flags().StringVar(&patternName, "name", "", "Name of the generated design")
+	scanCmd.Flags().BoolVar(&save, "save", false, "Save the generated design in Meshery")
+	scanCmd.Flags().StringVarP(&outputFile, "output-file", "o", "", "Write the generated design to the given file")
+}
@@ -0,0 +1,206 @@
+package workload
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// scanRequest is the request the design is expected to be generated with
+type scanRequest struct {
+	Save         bool `json:"save"`
+	WorkloadScan struct {
+		Name          string   `json:"name"`
+		Namespaces    []string `json:"namespaces"`
+		LabelSelector string   `json:"label_selector"`
+	} `json:"workload_scan"`
+}
+
+func TestScanCmd(t *testing.T) {
+	// setup current context
+	utils.SetupContextEnv(t)
+
+	// initialize mock server for handling requests
+	utils.StartMockery(t)
+
+	// create a test helper
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures")
+	testdataDir := filepath.Join(currDir, "testdata")
+	token := filepath.Join(fixturesDir, "token.golden")
+
+	patternURL := testContext.BaseURL + "/api/pattern"
+	designFile := filepath.Join(t.TempDir(), "shop.yaml")
+
+	shopScan := scanRequest{}
+	shopScan.WorkloadScan.Namespaces = []string{"shop"}
+	webScan := scanRequest{Save: true}
+	webScan.WorkloadScan.Name = "web"
+	webScan.WorkloadScan.Namespaces = []string{}
+	webScan.WorkloadScan.LabelSelector = "app=web"
+	shopPaymentsScan := scanRequest{}
+	shopPaymentsScan.WorkloadScan.Namespaces = []string{"shop", "payments"}
+
+	// test scenrios for fetching data
+	tests := []struct {
+		Name             string
+		Args             []string
+		ExpectedResponse string
+		URLs             []utils.MockURL
+		Request          scanRequest
+		// File is the file the design is written to, the standard output when empty
+		File        string
+		ExpectError bool
+	}{
+		{
+			Name:             "Scan without selecting the workloads",
+			Args:             []string{"scan"},
+			ExpectedResponse: "scan.noselection.output.golden",
+			ExpectError:      true,
+		},
+		{
+			Name:             "Print the design of the workloads of a namespace",
+			Args:             []string{"scan", "--namespace", "shop"},
+			ExpectedResponse: "scan.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: patternURL, Response: "scan.response.golden", ResponseCode: 200},
+			},
+			Request: shopScan,
+		},
+		{
+			Name:             "Save the design of the workloads matching a label selector",
+			Args:             []string{"scan", "-l", "app=web", "--name", "web", "--save"},
+			ExpectedResponse: "scan.save.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: patternURL, Response: "scan.save.response.golden", ResponseCode: 200},
+			},
+			Request: webScan,
+		},
+		{
+			Name:             "Write the design of the workloads of several namespaces to a file",
+			Args:             []string{"scan", "-n", "shop", "-n", "payments", "-o", designFile},
+			ExpectedResponse: "scan.file.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: patternURL, Response: "scan.response.golden", ResponseCode: 200},
+			},
+			Request: shopPaymentsScan,
+			File:    designFile,
+		},
+		{
+			Name:             "Scan without workloads matching",
+			Args:             []string{"scan", "--namespace", "shop"},
+			ExpectedResponse: "scan.empty.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: patternURL, Response: "scan.empty.response.golden", ResponseCode: 200},
+			},
+			Request:     shopScan,
+			ExpectError: true,
+		},
+		{
+			Name:             "Scan without MeshSync data",
+			Args:             []string{"scan", "--namespace", "shop"},
+			ExpectedResponse: "scan.error.output.golden",
+			URLs: []utils.MockURL{
+				{Method: "POST", URL: patternURL, Response: "scan.error.response.golden", ResponseCode: 400},
+			},
+			Request:     shopScan,
+			ExpectError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			for _, url := range tt.URLs {
+				// View api response from golden files
+				apiResponse := utils.NewGoldenFile(t, url.Response, fixturesDir).Load()
+
+				// mock response, the design being generated only when requested as expected
+				url := url
+				httpmock.RegisterResponder(url.Method, url.URL, func(req *http.Request) (*http.Response, error) {
+					scan := scanRequest{}
+					if err := json.NewDecoder(req.Body).Decode(&scan); err != nil || !reflect.DeepEqual(scan, tt.Request) {
+						return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected workload scan request"), nil
+					}
+					return httpmock.NewStringResponse(url.ResponseCode, apiResponse), nil
+				})
+			}
+
+			// set token
+			utils.TokenFlag = token
+
+			// reset the flags of the previous scenario
+			namespaces, labelSelector, patternName, save, outputFile = []string{}, "", "", false, ""
+
+			// Expected response
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+
+			// Grab console prints
+			rescueStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			b := utils.SetupMeshkitLoggerTesting(t, false)
+			WorkloadCmd.SetArgs(tt.Args)
+			err := WorkloadCmd.Execute()
+
+			w.Close()
+			out, _ := io.ReadAll(r)
+			os.Stdout = rescueStdout
+
+			if err != nil {
+				// if we're supposed to get an error
+				if tt.ExpectError {
+					// write it in file
+					if *update {
+						golden.Write(err.Error())
+					}
+					expectedResponse := golden.Load()
+
+					utils.Equals(t, expectedResponse, err.Error())
+					return
+				}
+				t.Fatal(err)
+			}
+
+			// response being printed in console, followed by the logs
+			actualResponse := string(out) + b.String()
+			if tt.File != "" {
+				data, err := os.ReadFile(tt.File)
+				if err != nil {
+					t.Fatal(err)
+				}
+				actualResponse = string(data)
+			}
+
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+
+			utils.Equals(t, expectedResponse, actualResponse)
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
@@ -0,0 +1,34 @@
+package workload
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+)
+
+// WorkloadCmd represents the root command for workload commands
+var WorkloadCmd = &cobra.Command{
+	Use:   "workload",
+	Short: "Onboard Cluster Workloads",
+	Long:  `Manage the workloads already running in the cluster`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.SystemError(fmt.Sprintf("invalid command: \"%s\"", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	WorkloadCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+
+	availableSubcommands = []*cobra.Command{scanCmd}
+	WorkloadCmd.AddCommand(availableSubcommands...)
+}
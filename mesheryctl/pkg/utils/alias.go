@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// BuiltinAliases are the short aliases of the commands mesheryctl is run with the most, which the
+// aliases of the config override
+var BuiltinAliases = map[string]string{
+	"pa":  "perf apply",
+	"pr":  "perf result",
+	"pp":  "perf profile",
+	"ss":  "system status",
+	"ctx": "system context",
+	"da":  "design apply",
+	"dl":  "design list",
+}
+
+// validAliasName matches the names of the aliases, which the config keeps in lower case
+var validAliasName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// IsValidAliasName reports whether the name can be given to an alias
+func IsValidAliasName(name string) bool {
+	return validAliasName.MatchString(name)
+}
+
+// flagsWithValue are the global flags of mesheryctl taking their value as the next argument
+var flagsWithValue = map[string]bool{"--config": true, "--debug-http": true}
+
+// AliasesFromConfig returns the aliases of the config file given with --config among the arguments,
+// else of the default config file, along with the built-in aliases
+func AliasesFromConfig(args []string) map[string]string {
+	path := DefaultConfigPath
+	for i, arg := range args {
+		if arg == "--config" && i+1 < len(args) {
+			path = args[i+1]
+		} else if strings.HasPrefix(arg, "--config=") {
+			path = strings.TrimPrefix(arg, "--config=")
+		}
+	}
+
+	aliases := map[string]string{}
+	for name, command := range BuiltinAliases {
+		aliases[name] = command
+	}
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return aliases
+	}
+	for name, command := range v.GetStringMapString("aliases") {
+		aliases[name] = command
+	}
+	return aliases
+}
+
+// ExpandAliases replaces the alias the command starts with among the arguments by the command it
+// stands for, the arguments following it being kept. Commands are never shadowed by aliases, and
+// aliases are not expanded recursively.
+func ExpandAliases(args []string, aliases map[string]string, isCommand func(string) bool) []string {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if strings.HasPrefix(arg, "-") {
+			if flagsWithValue[arg] {
+				i++
+			}
+			continue
+		}
+		command, ok := aliases[arg]
+		if !ok || isCommand(arg) {
+			return args
+		}
+		expanded := append([]string{}, args[:i]...)
+		expanded = append(expanded, strings.Fields(command)...)
+		return append(expanded, args[i+1:]...)
+	}
+	return args
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestExpandAliases(t *testing.T) {
+	aliases := map[string]string{
+		"pa":     "perf apply",
+		"smoke":  "perf apply --template smoke",
+		"system": "perf result",
+		"ds":     "design status",
+		"loop":   "pa",
+	}
+	isCommand := func(name string) bool {
+		return name == "system" || name == "perf" || name == "design"
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{name: "an alias", args: []string{"pa", "shop", "--url", "http://shop"}, want: []string{"perf", "apply", "shop", "--url", "http://shop"}},
+		{name: "an alias with flags", args: []string{"smoke", "shop"}, want: []string{"perf", "apply", "--template", "smoke", "shop"}},
+		{name: "an alias after global flags", args: []string{"-v", "--config", "pa", "ds"}, want: []string{"-v", "--config", "pa", "design", "status"}},
+		{name: "a command shadowed by an alias", args: []string{"system", "status"}, want: []string{"system", "status"}},
+		{name: "an alias among the arguments of a command", args: []string{"perf", "pa"}, want: []string{"perf", "pa"}},
+		{name: "an alias of an alias", args: []string{"loop"}, want: []string{"pa"}},
+		{name: "no command", args: []string{"--help"}, want: []string{"--help"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandAliases(tt.args, aliases, isCommand); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExpandAliases(%v) = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAliasesFromConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("aliases:\n  pa: perf apply --template smoke\n  ds: design status\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	aliases := AliasesFromConfig([]string{"--config", path, "ds"})
+	if aliases["pa"] != "perf apply --template smoke" || aliases["ds"] != "design status" {
+		t.Errorf("expected the aliases of the config, got %v", aliases)
+	}
+	if aliases["pr"] != BuiltinAliases["pr"] {
+		t.Errorf("expected the built-in aliases along, got %v", aliases)
+	}
+	if aliases := AliasesFromConfig([]string{"--config=" + filepath.Join(t.TempDir(), "missing.yaml")}); !reflect.DeepEqual(aliases, BuiltinAliases) {
+		t.Errorf("expected the built-in aliases only without a config, got %v", aliases)
+	}
+}
@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// CompletionCacheFile is the file within the MesheryFolder caching the names of the
+	// entities of Meshery Server offered by shell completion
+	CompletionCacheFile = "completion-cache.json"
+	// completionCacheTTL is how long the cached names are used before they are refreshed
+	completionCacheTTL = 5 * time.Minute
+	// completionRefreshTimeout is how long completion waits on Meshery Server before
+	// falling back to the cached names
+	completionRefreshTimeout = 800 * time.Millisecond
+)
+
+// Kinds of the entities cached for shell completion
+const (
+	CompletionProfiles = "profiles"
+	CompletionDesigns  = "designs"
+)
+
+// completionCache holds the names of the entities, by kind, last seen at an endpoint
+type completionCache struct {
+	Endpoint string                     `json:"endpoint"`
+	Entries  map[string]completionEntry `json:"entries"`
+}
+
+type completionEntry struct {
+	Names     []string  `json:"names"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func readCompletionCache(endpoint string) *completionCache {
+	cache := &completionCache{}
+	data, err := os.ReadFile(filepath.Join(MesheryFolder, CompletionCacheFile))
+	if err != nil || json.Unmarshal(data, cache) != nil || cache.Endpoint != endpoint {
+		cache = &completionCache{Endpoint: endpoint}
+	}
+	if cache.Entries == nil {
+		cache.Entries = map[string]completionEntry{}
+	}
+	return cache
+}
+
+// UpdateCompletionCache stores the names of the entities of a kind seen at the endpoint,
+// so that commands listing them keep shell completion up to date
+func UpdateCompletionCache(endpoint, kind string, names []string) {
+	cache := readCompletionCache(endpoint)
+	unique := []string{}
+	seen := map[string]bool{}
+	for _, name := range names {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	sort.Strings(unique)
+	cache.Entries[kind] = completionEntry{Names: unique, UpdatedAt: time.Now()}
+
+	if data, err := json.Marshal(cache); err == nil {
+		_ = os.WriteFile(filepath.Join(MesheryFolder, CompletionCacheFile), data, 0644)
+	}
+}
+
+// CompletionNames returns the names of the entities of a kind at the endpoint for shell
+// completion. Cached names are returned as long as they are fresh. Stale names are
+// refreshed with fetch in the background, and returned as they are when Meshery Server
+// does not answer in time, so that completion stays fast when the server is unreachable.
+func CompletionNames(endpoint, kind string, fetch func() ([]string, error)) []string {
+	entry, ok := readCompletionCache(endpoint).Entries[kind]
+	if ok && time.Since(entry.UpdatedAt) < completionCacheTTL {
+		return entry.Names
+	}
+
+	refreshed := make(chan []string, 1)
+	go func() {
+		names, err := fetch()
+		if err != nil {
+			refreshed <- nil
+			return
+		}
+		UpdateCompletionCache(endpoint, kind, names)
+		refreshed <- readCompletionCache(endpoint).Entries[kind].Names
+	}()
+
+	select {
+	case names := <-refreshed:
+		if names != nil {
+			return names
+		}
+	case <-time.After(completionRefreshTimeout):
+	}
+	return entry.Names
+}
+
+// CompleteNames is a cobra.ValidArgsFunction completing the first argument of a command
+// with the names returned by names, leaving out file completion
+func CompleteNames(names func() []string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return names(), cobra.ShellCompDirectiveNoFileComp
+	}
+}
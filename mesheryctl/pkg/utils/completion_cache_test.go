@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCompletionNames(t *testing.T) {
+	folder := MesheryFolder
+	MesheryFolder = t.TempDir()
+	defer func() { MesheryFolder = folder }()
+
+	const endpoint = "http://localhost:9081"
+	unreachable := func() ([]string, error) { return nil, errors.New("connection refused") }
+
+	if got := CompletionNames(endpoint, CompletionProfiles, unreachable); len(got) != 0 {
+		t.Errorf("CompletionNames() without cache = %v, want none", got)
+	}
+
+	fetched := func() ([]string, error) { return []string{"soak", "load", "soak"}, nil }
+	want := []string{"load", "soak"}
+	if got := CompletionNames(endpoint, CompletionProfiles, fetched); !reflect.DeepEqual(got, want) {
+		t.Errorf("CompletionNames() = %v, want %v", got, want)
+	}
+
+	// fresh names are served from the cache without reaching the server
+	if got := CompletionNames(endpoint, CompletionProfiles, unreachable); !reflect.DeepEqual(got, want) {
+		t.Errorf("CompletionNames() from cache = %v, want %v", got, want)
+	}
+
+	// names cached for another endpoint are not offered
+	if got := CompletionNames("http://meshery.example.com", CompletionProfiles, unreachable); len(got) != 0 {
+		t.Errorf("CompletionNames() for another endpoint = %v, want none", got)
+	}
+}
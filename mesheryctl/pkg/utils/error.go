@@ -7,7 +7,13 @@ import (
 )
 
 var (
-	ErrAttachAuthTokenCode = "1043"
+	ErrAttachAuthTokenCode   = "1043"
+	ErrHTTPDebugCode         = "1063"
+	ErrSelfUpdateCode        = "1067"
+	ErrReportPRCode          = "1073"
+	ErrTransferOwnershipCode = "1074"
+	ErrTrashCode             = "1075"
+	ErrServerFeatureCode     = "1082"
 )
 
 // RootError returns a formatted error message with a link to 'root' command usage page at
@@ -52,3 +58,38 @@ func ErrAttachAuthToken(err error) error {
 	return errors.New(ErrAttachAuthTokenCode, errors.Alert, []string{err.Error()},
 		[]string{"authentication token not found. please supply a valid user token with the --token (or -t) flag. or login with `mesheryctl system login`"}, []string{}, []string{})
 }
+
+func ErrHTTPDebug(err error) error {
+	return errors.New(ErrHTTPDebugCode, errors.Alert, []string{"Unable to record the HTTP requests"}, []string{err.Error()},
+		[]string{"The HAR file given to --debug-http cannot be written"}, []string{"Make sure the directory of the HAR file exists and is writable"})
+}
+
+func ErrSelfUpdate(err error) error {
+	return errors.New(ErrSelfUpdateCode, errors.Alert, []string{"Unable to upgrade mesheryctl"}, []string{err.Error()},
+		[]string{"The release could not be downloaded or verified, or the mesheryctl executable cannot be written"},
+		[]string{"Check the release exists for your OS and architecture and that you can write to the directory of mesheryctl, running with elevated privileges if needed"})
+}
+
+func ErrReportPR(err error) error {
+	return errors.New(ErrReportPRCode, errors.Alert, []string{"Unable to post the report to the pull request"}, []string{err.Error()},
+		[]string{"The command does not run in a GitHub Actions or GitLab CI job for a pull or merge request", "The token is missing or is not allowed to comment"},
+		[]string{"Run the command in the job of a pull or merge request, with GITHUB_TOKEN, or GITLAB_TOKEN with the api scope, in its environment"})
+}
+
+func ErrTransferOwnership(err error) error {
+	return errors.New(ErrTransferOwnershipCode, errors.Alert, []string{"Unable to transfer the ownership"}, []string{err.Error()},
+		[]string{"Neither the artifacts nor their owner are given", "The provider does not support ownership transfers"},
+		[]string{"Filter the artifacts to transfer with --owner, or by name", "Log in with a provider supporting ownership transfers"})
+}
+
+func ErrTrash(err error) error {
+	return errors.New(ErrTrashCode, errors.Alert, []string{"Unable to restore from the trash"}, []string{err.Error()},
+		[]string{"The id does not match a single item of the trash", "The item was purged from the trash"},
+		[]string{"List the trash with the restore command without an id and give the id of the item to restore"})
+}
+
+func ErrServerFeature(err error) error {
+	return errors.New(ErrServerFeatureCode, errors.Alert, []string{"The experimental capability is not available"}, []string{err.Error()},
+		[]string{"Meshery Server predates the capability or its feature flags", "The feature flag of the capability is disabled"},
+		[]string{"Upgrade Meshery Server with `mesheryctl system update`", "Enable the capability with `mesheryctl exp features enable <flag>`"})
+}
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// errNoFeatureFlags is returned when Meshery Server predates the feature flags
+var errNoFeatureFlags = fmt.Errorf("Meshery Server has no feature flags, upgrade it to use the experimental commands")
+
+// GetServerFeatures returns the feature flags of Meshery Server as in effect for the user
+func GetServerFeatures(baseURL string) ([]models.FeatureFlagState, error) {
+	req, err := NewRequest("GET", baseURL+"/api/system/features", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := doFeatureRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	flags := []models.FeatureFlagState{}
+	if err := json.Unmarshal(body, &flags); err != nil {
+		return nil, ErrServerFeature(err)
+	}
+	return flags, nil
+}
+
+// SetServerFeature enables or disables the feature flag for the user or, with global, for every user
+// who did not set it themselves, returning the feature flags once set
+func SetServerFeature(baseURL, name string, enabled, global bool) ([]models.FeatureFlagState, error) {
+	data, err := json.Marshal(models.FeatureFlagRequest{Enabled: enabled, Global: global})
+	if err != nil {
+		return nil, ErrServerFeature(err)
+	}
+	req, err := NewRequest("POST", baseURL+"/api/system/features/"+name, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	body, err := doFeatureRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	flags := []models.FeatureFlagState{}
+	if err := json.Unmarshal(body, &flags); err != nil {
+		return nil, ErrServerFeature(err)
+	}
+	return flags, nil
+}
+
+// RequireServerFeature fails, telling why, unless Meshery Server supports the experimental capability
+// gated by the feature flag and it is enabled for the user
+func RequireServerFeature(baseURL, name string) error {
+	flags, err := GetServerFeatures(baseURL)
+	if err != nil {
+		return err
+	}
+	for _, flag := range flags {
+		if flag.Name != name {
+			continue
+		}
+		if !flag.Enabled {
+			return ErrServerFeature(fmt.Errorf("the feature flag %s is disabled, enable it with `mesheryctl exp features enable %s`", name, name))
+		}
+		return nil
+	}
+	return ErrServerFeature(fmt.Errorf("Meshery Server does not support %s, upgrade it to use this command", name))
+}
+
+// PrintServerFeatures prints the feature flags in a table
+func PrintServerFeatures(flags []models.FeatureFlagState) {
+	var data [][]string
+	for _, flag := range flags {
+		data = append(data, []string{flag.Name, fmt.Sprint(flag.Enabled), featureSetting(flag.Global), featureSetting(flag.User), flag.Description})
+	}
+	PrintToTable([]string{"NAME", "ENABLED", "GLOBAL", "USER", "DESCRIPTION"}, data)
+}
+
+// featureSetting returns how a feature flag is set, - when it is not
+func featureSetting(enabled *bool) string {
+	if enabled == nil {
+		return "-"
+	}
+	return fmt.Sprint(*enabled)
+}
+
+func doFeatureRequest(req *http.Request) ([]byte, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, ErrServerFeature(err)
+	}
+	defer SafeClose(resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrServerFeature(err)
+	}
+	if resp.StatusCode == http.StatusNotFound && req.Method == "GET" {
+		return nil, ErrServerFeature(errNoFeatureFlags)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrServerFeature(fmt.Errorf("server responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body))))
+	}
+	return body, nil
+}
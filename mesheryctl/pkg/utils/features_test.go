@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRequireServerFeature(t *testing.T) {
+	defer func(tokenFlag string) { TokenFlag = tokenFlag }(TokenFlag)
+	TokenFlag = filepath.Join(fixturesDir, "auth.json")
+
+	features := `[{"name": "gateway-api", "enabled": true}, {"name": "chaos", "enabled": false, "global": false}]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/system/features" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(features))
+	}))
+	defer server.Close()
+	legacy := httptest.NewServer(http.NotFoundHandler())
+	defer legacy.Close()
+
+	tests := []struct {
+		name    string
+		baseURL string
+		feature string
+		wantErr string
+	}{
+		{name: "an enabled feature", baseURL: server.URL, feature: "gateway-api"},
+		{name: "a disabled feature", baseURL: server.URL, feature: "chaos", wantErr: "mesheryctl exp features enable chaos"},
+		{name: "an unknown feature", baseURL: server.URL, feature: "mesh-sync-v2", wantErr: "does not support mesh-sync-v2"},
+		{name: "a server without feature flags", baseURL: legacy.URL, feature: "gateway-api", wantErr: "has no feature flags"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RequireServerFeature(tt.baseURL, tt.feature)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected %s to be available, got %v", tt.feature, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
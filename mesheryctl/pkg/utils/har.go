@@ -0,0 +1,348 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/constants"
+)
+
+// maxHARBodySize is the size beyond which the bodies of requests and responses are truncated in the HAR file
+const maxHARBodySize = 1 << 20
+
+// harTokenKeys are the names of the headers, cookies, query parameters and body fields whose values are redacted
+var harTokenKeys = []string{"token", "authorization", "cookie", "password", "secret", "apikey", "api_key", "api-key", "credential"}
+
+// HAR holds the exchanges recorded in the HTTP Archive format, http://www.softwareishard.com/blog/har-12-spec
+type HAR struct {
+	Log HARLog `json:"log"`
+}
+
+type HARLog struct {
+	Version string     `json:"version"`
+	Creator HARCreator `json:"creator"`
+	Entries []HAREntry `json:"entries"`
+}
+
+type HARCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type HAREntry struct {
+	StartedDateTime time.Time   `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         HARRequest  `json:"request"`
+	Response        HARResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         HARTimings  `json:"timings"`
+	// Error is the error of a request which got no response
+	Error string `json:"_error,omitempty"`
+}
+
+type HARRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []HARNameValue `json:"cookies"`
+	Headers     []HARNameValue `json:"headers"`
+	QueryString []HARNameValue `json:"queryString"`
+	PostData    *HARPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type HARResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []HARNameValue `json:"cookies"`
+	Headers     []HARNameValue `json:"headers"`
+	Content     HARContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type HARNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type HARPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type HARContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+type HARTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// HARRecorder records the HTTP requests made by mesheryctl and their responses into a HAR file,
+// redacting the tokens, so that they can be attached to bug reports. The file is rewritten after
+// every exchange so that it is complete even when mesheryctl exits abruptly.
+type HARRecorder struct {
+	mu       sync.Mutex
+	path     string
+	redactor *Redactor
+	har      HAR
+}
+
+// NewHARRecorder returns a recorder writing to the HAR file at the given path
+func NewHARRecorder(path string) (*HARRecorder, error) {
+	redactor, err := NewRedactor(RedactionRules{Keys: harTokenKeys})
+	if err != nil {
+		return nil, err
+	}
+	h := &HARRecorder{
+		path:     path,
+		redactor: redactor,
+		har: HAR{Log: HARLog{
+			Version: "1.2",
+			Creator: HARCreator{Name: "mesheryctl", Version: constants.GetMesheryctlVersion()},
+			Entries: []HAREntry{},
+		}},
+	}
+	if err := h.write(); err != nil {
+		return nil, ErrHTTPDebug(err)
+	}
+	return h, nil
+}
+
+// EnableHTTPDebug records the requests made by the HTTP clients of mesheryctl using the
+// default transport into the HAR file at the given path
+func EnableHTTPDebug(path string) error {
+	recorder, err := NewHARRecorder(path)
+	if err != nil {
+		return err
+	}
+	http.DefaultTransport = recorder.Transport(http.DefaultTransport)
+	return nil
+}
+
+// Transport returns a RoundTripper recording the exchanges made through the next one
+func (h *HARRecorder) Transport(next http.RoundTripper) http.RoundTripper {
+	return &harTransport{recorder: h, next: next}
+}
+
+type harTransport struct {
+	recorder *HARRecorder
+	next     http.RoundTripper
+}
+
+func (t *harTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	started := time.Now()
+
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		reqBody = body
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	waited := time.Since(started)
+
+	index := t.recorder.add(t.recorder.entry(req, reqBody, resp, err, started, waited))
+	if err != nil {
+		return resp, err
+	}
+
+	// The body is recorded as it is read, as responses may be streams of events which never end
+	resp.Body = &harBody{
+		ReadCloser: resp.Body,
+		onDone: func(body []byte, truncated bool) {
+			t.recorder.complete(index, body, truncated, time.Since(started)-waited)
+		},
+	}
+	return resp, nil
+}
+
+// harBody keeps a copy of the body read from the response
+type harBody struct {
+	io.ReadCloser
+	buf       bytes.Buffer
+	truncated bool
+	once      sync.Once
+	onDone    func(body []byte, truncated bool)
+}
+
+func (b *harBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		kept := n
+		if room := maxHARBodySize - b.buf.Len(); kept > room {
+			kept = room
+			b.truncated = true
+		}
+		b.buf.Write(p[:kept])
+	}
+	if err == io.EOF {
+		b.done()
+	}
+	return n, err
+}
+
+func (b *harBody) Close() error {
+	b.done()
+	return b.ReadCloser.Close()
+}
+
+func (b *harBody) done() {
+	b.once.Do(func() {
+		b.onDone(b.buf.Bytes(), b.truncated)
+	})
+}
+
+// entry builds the entry of the exchange, without the body of the response
+func (h *HARRecorder) entry(req *http.Request, reqBody []byte, resp *http.Response, err error, started time.Time, waited time.Duration) HAREntry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entry := HAREntry{
+		StartedDateTime: started,
+		Time:            milliseconds(waited),
+		Request: HARRequest{
+			Method:      req.Method,
+			URL:         h.redactor.RedactString(req.URL.String()),
+			HTTPVersion: req.Proto,
+			Cookies:     h.cookies(req.Cookies()),
+			Headers:     h.headers(req.Header),
+			QueryString: []HARNameValue{},
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Timings: HARTimings{Wait: milliseconds(waited)},
+	}
+	if entry.Request.HTTPVersion == "" {
+		entry.Request.HTTPVersion = "HTTP/1.1"
+	}
+	for name, values := range req.URL.Query() {
+		for _, value := range values {
+			entry.Request.QueryString = append(entry.Request.QueryString, h.nameValue(name, value))
+		}
+	}
+	if len(reqBody) > 0 {
+		text, _ := h.bodyText(reqBody)
+		entry.Request.PostData = &HARPostData{MimeType: req.Header.Get("Content-Type"), Text: text}
+	}
+
+	if err != nil {
+		entry.Error = h.redactor.RedactString(err.Error())
+		entry.Response = HARResponse{Cookies: []HARNameValue{}, Headers: []HARNameValue{}, HeadersSize: -1, BodySize: -1}
+		return entry
+	}
+	entry.Response = HARResponse{
+		Status:      resp.StatusCode,
+		StatusText:  http.StatusText(resp.StatusCode),
+		HTTPVersion: resp.Proto,
+		Cookies:     h.cookies(resp.Cookies()),
+		Headers:     h.headers(resp.Header),
+		Content:     HARContent{Size: -1, MimeType: resp.Header.Get("Content-Type")},
+		RedirectURL: h.redactor.RedactString(resp.Header.Get("Location")),
+		HeadersSize: -1,
+		BodySize:    -1,
+	}
+	return entry
+}
+
+// add appends the entry to the HAR file and returns its index
+func (h *HARRecorder) add(entry HAREntry) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.har.Log.Entries = append(h.har.Log.Entries, entry)
+	h.writeOrLog()
+	return len(h.har.Log.Entries) - 1
+}
+
+// complete sets the body of the response of the entry with the given index
+func (h *HARRecorder) complete(index int, body []byte, truncated bool, received time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entry := &h.har.Log.Entries[index]
+	text, encoding := h.bodyText(body)
+	entry.Response.Content.Size = len(body)
+	entry.Response.Content.Text = text
+	entry.Response.Content.Encoding = encoding
+	if truncated {
+		entry.Response.Content.Comment = "truncated"
+	}
+	entry.Response.BodySize = len(body)
+	entry.Timings.Receive = milliseconds(received)
+	entry.Time = entry.Timings.Wait + entry.Timings.Receive
+	h.writeOrLog()
+}
+
+// bodyText returns the redacted text of the body, or the body encoded in base64 when it is binary
+func (h *HARRecorder) bodyText(body []byte) (text, encoding string) {
+	if !utf8.Valid(body) {
+		return base64.StdEncoding.EncodeToString(body), "base64"
+	}
+	return h.redactor.RedactString(string(body)), ""
+}
+
+func (h *HARRecorder) headers(header http.Header) []HARNameValue {
+	headers := []HARNameValue{}
+	for name, values := range header {
+		for _, value := range values {
+			headers = append(headers, h.nameValue(name, value))
+		}
+	}
+	return headers
+}
+
+func (h *HARRecorder) cookies(cookies []*http.Cookie) []HARNameValue {
+	result := []HARNameValue{}
+	for _, c := range cookies {
+		result = append(result, h.nameValue(c.Name, c.Value))
+	}
+	return result
+}
+
+func (h *HARRecorder) nameValue(name, value string) HARNameValue {
+	if h.redactor.isSensitiveKey(name) {
+		return HARNameValue{Name: name, Value: Redacted}
+	}
+	return HARNameValue{Name: name, Value: h.redactor.RedactString(value)}
+}
+
+func (h *HARRecorder) write() error {
+	content, err := json.MarshalIndent(h.har, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(h.path, content, 0600)
+}
+
+func (h *HARRecorder) writeOrLog() {
+	if err := h.write(); err != nil {
+		Log.Error(ErrHTTPDebug(err))
+	}
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
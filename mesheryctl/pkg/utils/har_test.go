@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHARRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/events" {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		http.SetCookie(w, &http.Cookie{Name: "token", Value: "set-cookie-secret"})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"echo": ` + string(body) + `, "name": "bookinfo"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "dump.har")
+	recorder, err := NewHARRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: recorder.Transport(http.DefaultTransport)}
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/pattern?token=query-secret&page=1", strings.NewReader(`{"token": "body-secret"}`))
+	req.Header.Set("Authorization", "Bearer header-secret")
+	req.AddCookie(&http.Cookie{Name: "token", Value: "cookie-secret"})
+	req.AddCookie(&http.Cookie{Name: "meshery-provider", Value: "None"})
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if !strings.Contains(string(body), "body-secret") {
+		t.Errorf("the response read by the client must not be redacted, got %s", body)
+	}
+
+	// a stream of events is recorded without waiting for it to end
+	stream, err := client.Get(server.URL + "/api/events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Body.Close()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"query-secret", "body-secret", "header-secret", "cookie-secret", "set-cookie-secret"} {
+		if strings.Contains(string(content), secret) {
+			t.Errorf("HAR file holds the secret %s", secret)
+		}
+	}
+
+	har := HAR{}
+	if err := json.Unmarshal(content, &har); err != nil {
+		t.Fatal(err)
+	}
+	if len(har.Log.Entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(har.Log.Entries))
+	}
+	entry := har.Log.Entries[0]
+	if entry.Request.Method != http.MethodPost || entry.Request.PostData == nil {
+		t.Errorf("request was not recorded: %+v", entry.Request)
+	}
+	if entry.Response.Status != http.StatusOK || !strings.Contains(entry.Response.Content.Text, "bookinfo") {
+		t.Errorf("response was not recorded: %+v", entry.Response)
+	}
+	cookies := map[string]string{}
+	for _, c := range entry.Request.Cookies {
+		cookies[c.Name] = c.Value
+	}
+	if cookies["token"] != Redacted || cookies["meshery-provider"] != "None" {
+		t.Errorf("got cookies %v, want only the token redacted", cookies)
+	}
+	if events := har.Log.Entries[1]; events.Response.Status != http.StatusOK || events.Response.Content.MimeType != "text/event-stream" {
+		t.Errorf("stream was not recorded: %+v", events.Response)
+	}
+}
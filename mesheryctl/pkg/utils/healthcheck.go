@@ -210,6 +210,14 @@ func AreMesheryComponentsRunning(currPlatform string) (bool, error) {
 
 			return false, err
 		}
+	case "native":
+		{
+			server, err := ReadNativeServer()
+			if err != nil {
+				return false, err
+			}
+			return server != nil && server.Running(), nil
+		}
 	}
 
 	return false, nil
@@ -241,15 +249,17 @@ func AreAllPodsRunning() (bool, error) {
 	return true, nil
 }
 
-// CheckMesheryNsDelete waits for Meshery namespace to be deleted, returns (done, error)
+// CheckMesheryNsDelete waits for the Meshery namespaces to be deleted, returns (done, error)
 func CheckMesheryNsDelete() (bool, error) {
 	client, err := meshkitkube.New([]byte(""))
 	if err != nil {
 		return false, err
 	}
 
-	if err := WaitForNamespaceDeleted(client, MesheryNamespace, 300); err != nil {
-		return false, err
+	for _, namespace := range MesheryNamespaces() {
+		if err := WaitForNamespaceDeleted(client, namespace, 300); err != nil {
+			return false, err
+		}
 	}
 
 	return true, nil
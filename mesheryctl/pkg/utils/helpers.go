@@ -67,6 +67,8 @@ const (
 const (
 	HelmChartURL  = "https://meshery.io/charts/"
 	HelmChartName = "meshery"
+	// DefaultMesheryNamespace is the namespace Meshery is deployed to when the context sets none
+	DefaultMesheryNamespace = "meshery"
 )
 
 var (
@@ -86,8 +88,12 @@ var (
 	AuthConfigFile = "auth.json"
 	// DefaultConfigPath is the detail path to mesheryctl config
 	DefaultConfigPath = "config.yaml"
-	// MesheryNamespace is the namespace to which Meshery is deployed in the Kubernetes cluster
-	MesheryNamespace = "meshery"
+	// MesheryNamespace is the namespace to which Meshery is deployed in the Kubernetes cluster,
+	// set from the namespace of the current context
+	MesheryNamespace = DefaultMesheryNamespace
+	// MesheryAdapterNamespace is the namespace to which the Meshery adapters are deployed in
+	// the Kubernetes cluster, set from the adapter namespace of the current context
+	MesheryAdapterNamespace = DefaultMesheryNamespace
 	// MesheryDeployment is the name of a Kubernetes manifest file required to setup Meshery
 	// check https://github.com/layer5io/meshery/tree/master/install/deployment_yamls/k8s
 	MesheryDeployment = "meshery-deployment.yaml"
@@ -136,6 +142,21 @@ var CfgFile string
 // ListOfComponents returns the list of components available
 var ListOfComponents = []string{"meshery-app-mesh", "meshery-istio", "meshery-linkerd", "meshery-consul", "meshery-nsm", "meshery-kuma", "meshery-cpx", "meshery-osm", "meshery-traefik-mesh", "meshery-nginx-sm"}
 
+// AdapterPorts are the ports the adapters listen on, as listed in the ADAPTER_URLS of
+// install/kubernetes/helm/meshery/values.yaml
+var AdapterPorts = map[string]string{
+	"meshery-istio":        "10000",
+	"meshery-linkerd":      "10001",
+	"meshery-consul":       "10002",
+	"meshery-nsm":          "10004",
+	"meshery-app-mesh":     "10005",
+	"meshery-traefik-mesh": "10006",
+	"meshery-kuma":         "10007",
+	"meshery-cpx":          "10008",
+	"meshery-osm":          "10009",
+	"meshery-nginx-sm":     "10010",
+}
+
 // TemplateContext is the template context provided when creating a config file
 var TemplateContext = config.Context{
 	Endpoint:   EndpointProtocol + "://localhost:9081",
@@ -809,6 +830,16 @@ func SetOverrideValues(ctx *config.Context, mesheryImageVersion string) map[stri
 		"tag": ctx.GetChannel() + "-" + mesheryImageVersion,
 	}
 
+	// place the adapters in their own namespace, pointing Meshery to their services there
+	if MesheryAdapterNamespace != MesheryNamespace {
+		valueOverrides["global"] = map[string]interface{}{
+			"adapterNamespace": MesheryAdapterNamespace,
+		}
+		valueOverrides["env"] = map[string]interface{}{
+			"ADAPTER_URLS": AdapterURLs(MesheryAdapterNamespace),
+		}
+	}
+
 	return valueOverrides
 }
 
@@ -823,3 +854,36 @@ func CheckFileExists(name string) (bool, error) {
 	}
 	return false, errors.Wrap(err, fmt.Sprintf("Failed to read/fetch the file %s", name))
 }
+
+// AdapterURLs returns the addresses of the adapters deployed to the given namespace in
+// the format of the ADAPTER_URLS environment variable of Meshery
+func AdapterURLs(namespace string) string {
+	urls := []string{}
+	for _, adapter := range ListOfComponents {
+		if port, ok := AdapterPorts[adapter]; ok {
+			urls = append(urls, fmt.Sprintf("%s.%s.svc:%s", adapter, namespace, port))
+		}
+	}
+	return strings.Join(urls, " ")
+}
+
+// SetNamespaces sets the namespaces Meshery and its adapters are deployed to from the given
+// context, the adapters being deployed along with Meshery unless the context says otherwise
+func SetNamespaces(ctx *config.Context) {
+	MesheryNamespace = DefaultMesheryNamespace
+	if ctx.GetNamespace() != "" {
+		MesheryNamespace = ctx.GetNamespace()
+	}
+	MesheryAdapterNamespace = MesheryNamespace
+	if ctx.GetAdapterNamespace() != "" {
+		MesheryAdapterNamespace = ctx.GetAdapterNamespace()
+	}
+}
+
+// MesheryNamespaces returns the namespaces Meshery and its adapters are deployed to
+func MesheryNamespaces() []string {
+	if MesheryAdapterNamespace == MesheryNamespace {
+		return []string{MesheryNamespace}
+	}
+	return []string{MesheryNamespace, MesheryAdapterNamespace}
+}
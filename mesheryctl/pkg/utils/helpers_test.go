@@ -659,3 +659,30 @@ func TestSetOverrideValues(t *testing.T) {
 		}
 	}
 }
+
+func TestSetNamespaces(t *testing.T) {
+	defer SetNamespaces(&config.Context{})
+
+	tests := []struct {
+		name             string
+		ctx              *config.Context
+		namespace        string
+		adapterNamespace string
+		namespaces       []string
+	}{
+		{"default namespace", &config.Context{}, "meshery", "meshery", []string{"meshery"}},
+		{"custom namespace", &config.Context{Namespace: "meshery-system"}, "meshery-system", "meshery-system", []string{"meshery-system"}},
+		{"adapter namespace", &config.Context{Namespace: "meshery-system", AdapterNamespace: "mesh-adapters"}, "meshery-system", "mesh-adapters", []string{"meshery-system", "mesh-adapters"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetNamespaces(tt.ctx)
+			if MesheryNamespace != tt.namespace || MesheryAdapterNamespace != tt.adapterNamespace {
+				t.Errorf("got namespaces %q and %q, want %q and %q", MesheryNamespace, MesheryAdapterNamespace, tt.namespace, tt.adapterNamespace)
+			}
+			if got := MesheryNamespaces(); !reflect.DeepEqual(got, tt.namespaces) {
+				t.Errorf("MesheryNamespaces() = %v, want %v", got, tt.namespaces)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// NativeFolder is the folder within the MesheryFolder of Meshery Server run natively on the host
+	NativeFolder = "native"
+	// NativeStateFile is the file of the NativeServer, within the NativeFolder
+	NativeStateFile = "server.json"
+	// NativeLogFile is the file the output of Meshery Server is written to, within the NativeFolder
+	NativeLogFile = "server.log"
+	// NativeEnvFile is the file of the environment of Meshery Server, within the NativeFolder.
+	// Meshery Server is restarted with the new environment whenever the file changes.
+	NativeEnvFile = "server.env"
+)
+
+// NativeServer is Meshery Server run natively on the host: the mesheryctl process supervising
+// it, restarting it whenever its binary, kubeconfig or environment change, and the server itself
+type NativeServer struct {
+	PID        int       `json:"pid"`
+	ServerPID  int       `json:"server_pid"`
+	Binary     string    `json:"binary"`
+	Kubeconfig string    `json:"kubeconfig"`
+	Port       string    `json:"port"`
+	StartedAt  time.Time `json:"started_at"`
+	Restarts   int       `json:"restarts"`
+}
+
+// NativePath returns the path of the file within the NativeFolder
+func NativePath(file string) string {
+	return filepath.Join(MesheryFolder, NativeFolder, file)
+}
+
+// ReadNativeServer returns the natively run Meshery Server, nil when it was never started. The state of
+// a stopped server is kept, without its PIDs, for the binary and kubeconfig to be reused on the next start.
+func ReadNativeServer() (*NativeServer, error) {
+	data, err := os.ReadFile(NativePath(NativeStateFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the state of the native Meshery Server")
+	}
+	server := &NativeServer{}
+	if err := json.Unmarshal(data, server); err != nil {
+		return nil, errors.Wrap(err, "failed to read the state of the native Meshery Server")
+	}
+	return server, nil
+}
+
+// WriteNativeServer saves the state of the natively run Meshery Server
+func WriteNativeServer(server *NativeServer) error {
+	if err := os.MkdirAll(filepath.Join(MesheryFolder, NativeFolder), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(server, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(NativePath(NativeStateFile), data, 0644)
+}
+
+// Running returns true when the process supervising Meshery Server is alive
+func (s *NativeServer) Running() bool {
+	return IsProcessRunning(s.PID)
+}
+
+// IsProcessRunning returns true when a process of the given PID is alive
+func IsProcessRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	// FindProcess only succeeds for live processes on Windows, while it always does on Unix
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+// ReadEnvFile reads the KEY=VALUE lines of an environment file, skipping blank lines and comments
+func ReadEnvFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer SafeClose(file)
+
+	env := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !strings.Contains(line, "=") {
+			return nil, errors.Errorf("invalid line %q in %s, expected KEY=VALUE", line, path)
+		}
+		env = append(env, line)
+	}
+	return env, scanner.Err()
+}
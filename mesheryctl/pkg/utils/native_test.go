@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadEnvFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.env")
+	if err := os.WriteFile(path, []byte("# comment\nPORT=9081\n\n  DEBUG=true  \nADAPTER_URLS=\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	env, err := ReadEnvFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"PORT=9081", "DEBUG=true", "ADAPTER_URLS="}; !reflect.DeepEqual(env, expected) {
+		t.Errorf("expected %v, got %v", expected, env)
+	}
+
+	if err := os.WriteFile(path, []byte("DEBUG\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadEnvFile(path); err == nil {
+		t.Error("expected an error for a line without =")
+	}
+
+	if env, err := ReadEnvFile(filepath.Join(t.TempDir(), "missing.env")); err != nil || env != nil {
+		t.Errorf("expected no environment for a missing file, got %v, %v", env, err)
+	}
+}
+
+func TestNativeServerState(t *testing.T) {
+	folder := MesheryFolder
+	MesheryFolder = t.TempDir()
+	defer func() { MesheryFolder = folder }()
+
+	if server, err := ReadNativeServer(); err != nil || server != nil {
+		t.Fatalf("expected no native server, got %v, %v", server, err)
+	}
+	if running, err := AreMesheryComponentsRunning("native"); err != nil || running {
+		t.Fatalf("expected Meshery not to be running, got %v, %v", running, err)
+	}
+
+	if err := WriteNativeServer(&NativeServer{PID: os.Getpid(), Binary: "/usr/local/bin/meshery", Port: "9081"}); err != nil {
+		t.Fatal(err)
+	}
+	server, err := ReadNativeServer()
+	if err != nil || server.Binary != "/usr/local/bin/meshery" {
+		t.Fatalf("expected the saved native server, got %v, %v", server, err)
+	}
+	if running, err := AreMesheryComponentsRunning("native"); err != nil || !running {
+		t.Errorf("expected Meshery to be running, got %v, %v", running, err)
+	}
+}
@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// OutputFormatsAnnotation annotates a command with the formats its --output-format flag
+// accepts, to which the output-format preference of the config applies
+const OutputFormatsAnnotation = "mesheryctl_output_formats"
+
+// SetOutputFormats records the formats the --output-format flag of the command accepts
+func SetOutputFormats(cmd *cobra.Command, formats ...string) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[OutputFormatsAnnotation] = strings.Join(formats, ",")
+}
+
+// ApplyOutputFormat sets the --output-format flag of the command to the preferred format, unless
+// the flag is given or the command does not accept the format
+func ApplyOutputFormat(cmd *cobra.Command, format string) {
+	if cmd == nil || format == "" {
+		return
+	}
+	flag := cmd.Flag("output-format")
+	if flag == nil || flag.Changed {
+		return
+	}
+	for _, f := range strings.Split(cmd.Annotations[OutputFormatsAnnotation], ",") {
+		if f == format {
+			// the value is set without the flag being changed, as the commands tell apart
+			// the format given on the command line, e.g. pattern view --image
+			_ = flag.Value.Set(format)
+			return
+		}
+	}
+}
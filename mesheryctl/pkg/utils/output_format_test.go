@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestApplyOutputFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		args       []string
+		persistent bool
+		annotated  bool
+		want       string
+	}{
+		{name: "preferred format", format: "json", annotated: true, want: "json"},
+		{name: "no preference", format: "", annotated: true, want: "table"},
+		{name: "format given on the command line", format: "json", args: []string{"--output-format", "yaml"}, annotated: true, want: "yaml"},
+		{name: "format the command does not accept", format: "markdown", annotated: true, want: "table"},
+		{name: "flag of the parent command", format: "yaml", persistent: true, annotated: true, want: "yaml"},
+		// e.g. perf result download, to which --output-format is the file the result is written to
+		{name: "command giving the flag another meaning", format: "yaml", persistent: true, want: "table"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var format string
+			parent := &cobra.Command{Use: "parent"}
+			cmd := &cobra.Command{Use: "cmd", Run: func(*cobra.Command, []string) {}}
+			parent.AddCommand(cmd)
+			if tt.persistent {
+				parent.PersistentFlags().StringVarP(&format, "output-format", "o", "table", "")
+			} else {
+				cmd.Flags().StringVarP(&format, "output-format", "o", "table", "")
+			}
+			if tt.annotated {
+				SetOutputFormats(cmd, "table", "json", "yaml")
+			}
+			// the flags of the parents are merged into those of the command as it parses them
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			ApplyOutputFormat(cmd, tt.format)
+			if format != tt.want {
+				t.Errorf("output format is %q, want %q", format, tt.want)
+			}
+			if cmd.Flag("output-format").Changed != (len(tt.args) > 0) {
+				t.Error("preferred format marked the flag as given on the command line")
+			}
+		})
+	}
+
+	// commands without the flag are left as they are
+	ApplyOutputFormat(&cobra.Command{Use: "version"}, "json")
+	ApplyOutputFormat(nil, "json")
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// TransferOwnership transfers the ownership of the artifacts selected by the transfer to
+// another user and reports the number of artifacts transferred
+func TransferOwnership(baseURL string, transfer *models.OwnershipTransfer) error {
+	if err := transfer.Validate(); err != nil {
+		return ErrTransferOwnership(err)
+	}
+	body, err := json.Marshal(transfer)
+	if err != nil {
+		return ErrTransferOwnership(err)
+	}
+
+	req, err := NewRequest("POST", baseURL+"/api/user/ownership/transfer", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return ErrTransferOwnership(err)
+	}
+	defer SafeClose(resp.Body)
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ErrTransferOwnership(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return ErrTransferOwnership(fmt.Errorf("server responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(content))))
+	}
+
+	result := &models.OwnershipTransferResult{}
+	if err := json.Unmarshal(content, result); err != nil {
+		return ErrTransferOwnership(err)
+	}
+	var transferred int64
+	for _, count := range result.Transferred {
+		transferred += count
+	}
+	Log.Info(fmt.Sprintf("%d %s(s) transferred to %s", transferred, transfer.Kind, transfer.To))
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	v1core "k8s.io/api/core/v1"
+	kubeerror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	meshkitutils "github.com/layer5io/meshkit/utils"
@@ -588,6 +589,30 @@ func GetPodList(client *meshkitkube.Client, namespace string) (*v1core.PodList,
 	return podList, nil
 }
 
+// CreateNamespace creates the given namespace unless it exists already
+func CreateNamespace(client *meshkitkube.Client, namespace string) error {
+	_, err := client.KubeClient.CoreV1().Namespaces().Create(context.TODO(), &v1core.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: namespace},
+	}, metav1.CreateOptions{})
+	if err != nil && !kubeerror.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "failed to create namespace %s", namespace)
+	}
+	return nil
+}
+
+// GetMesheryPodList lists the pods in the namespaces Meshery and its adapters are deployed to
+func GetMesheryPodList(client *meshkitkube.Client) (*v1core.PodList, error) {
+	podList := &v1core.PodList{}
+	for _, namespace := range MesheryNamespaces() {
+		pods, err := GetPodList(client, namespace)
+		if err != nil {
+			return nil, err
+		}
+		podList.Items = append(podList.Items, pods.Items...)
+	}
+	return podList, nil
+}
+
 // GetRequiredPods checks if the pods specified by the user is valid returns a list of the required pods
 func GetRequiredPods(specifiedPods []string, availablePods []v1core.Pod) ([]string, error) {
 	var requiredPods []string
@@ -718,50 +743,53 @@ func ForceCleanupCluster() error {
 
 	deletePolicy := metav1.DeletePropagationForeground
 
-	deploymentInterface := client.KubeClient.AppsV1().Deployments(MesheryNamespace)
-	deploymentList, err := deploymentInterface.List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		return err
-	}
+	// the adapters may be deployed to a namespace of their own
+	for _, namespace := range MesheryNamespaces() {
+		deploymentInterface := client.KubeClient.AppsV1().Deployments(namespace)
+		deploymentList, err := deploymentInterface.List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
 
-	for _, deployment := range deploymentList.Items {
-		if strings.Contains(string(deployment.GetName()), "meshery") {
-			if err := deploymentInterface.Delete(context.TODO(), deployment.GetName(), metav1.DeleteOptions{
-				PropagationPolicy: &deletePolicy,
-			}); err != nil {
-				log.Debug(err)
+		for _, deployment := range deploymentList.Items {
+			if strings.Contains(string(deployment.GetName()), "meshery") {
+				if err := deploymentInterface.Delete(context.TODO(), deployment.GetName(), metav1.DeleteOptions{
+					PropagationPolicy: &deletePolicy,
+				}); err != nil {
+					log.Debug(err)
+				}
 			}
 		}
-	}
 
-	serviceInterface := client.KubeClient.CoreV1().Services(MesheryNamespace)
-	serviceList, err := serviceInterface.List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		return err
-	}
+		serviceInterface := client.KubeClient.CoreV1().Services(namespace)
+		serviceList, err := serviceInterface.List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
 
-	for _, service := range serviceList.Items {
-		if strings.Contains(string(service.GetName()), "meshery") {
-			if err := serviceInterface.Delete(context.TODO(), service.GetName(), metav1.DeleteOptions{
-				PropagationPolicy: &deletePolicy,
-			}); err != nil {
-				log.Debug(err)
+		for _, service := range serviceList.Items {
+			if strings.Contains(string(service.GetName()), "meshery") {
+				if err := serviceInterface.Delete(context.TODO(), service.GetName(), metav1.DeleteOptions{
+					PropagationPolicy: &deletePolicy,
+				}); err != nil {
+					log.Debug(err)
+				}
 			}
 		}
-	}
 
-	statefulSetInterface := client.KubeClient.AppsV1().StatefulSets(MesheryNamespace)
-	statefulSetList, err := statefulSetInterface.List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		return err
-	}
+		statefulSetInterface := client.KubeClient.AppsV1().StatefulSets(namespace)
+		statefulSetList, err := statefulSetInterface.List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
 
-	for _, statefulSet := range statefulSetList.Items {
-		if strings.Contains(string(statefulSet.GetName()), "meshery") {
-			if err := statefulSetInterface.Delete(context.TODO(), statefulSet.GetName(), metav1.DeleteOptions{
-				PropagationPolicy: &deletePolicy,
-			}); err != nil {
-				log.Debug(err)
+		for _, statefulSet := range statefulSetList.Items {
+			if strings.Contains(string(statefulSet.GetName()), "meshery") {
+				if err := statefulSetInterface.Delete(context.TODO(), statefulSet.GetName(), metav1.DeleteOptions{
+					PropagationPolicy: &deletePolicy,
+				}); err != nil {
+					log.Debug(err)
+				}
 			}
 		}
 	}
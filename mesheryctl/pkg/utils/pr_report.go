@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PullRequest is the pull request of GitHub, or merge request of GitLab, a CI job runs for
+type PullRequest struct {
+	// Platform is github or gitlab
+	Platform string
+	// APIURL is the base URL of the REST API of the platform
+	APIURL string
+	// Repository is the owner/name of the GitHub repository, or the ID of the GitLab project
+	Repository string
+	// Number is the number of the pull request, or the IID of the merge request
+	Number string
+	Token  string
+}
+
+// DetectPullRequest returns the pull request of the CI job from the environment variables
+// of GitHub Actions or GitLab CI. The token is GITHUB_TOKEN, or GITLAB_TOKEN as the job
+// token of GitLab cannot comment on merge requests.
+func DetectPullRequest(getenv func(string) string) (*PullRequest, error) {
+	switch {
+	case getenv("GITHUB_ACTIONS") == "true":
+		pr := &PullRequest{
+			Platform:   "github",
+			APIURL:     getenv("GITHUB_API_URL"),
+			Repository: getenv("GITHUB_REPOSITORY"),
+			Token:      getenv("GITHUB_TOKEN"),
+		}
+		if pr.APIURL == "" {
+			pr.APIURL = "https://api.github.com"
+		}
+		// refs/pull/<number>/merge for the pull_request events, the event payload otherwise
+		if ref := strings.Split(getenv("GITHUB_REF"), "/"); len(ref) == 4 && ref[1] == "pull" {
+			pr.Number = ref[2]
+		} else if number := pullRequestNumberOfEvent(getenv("GITHUB_EVENT_PATH")); number != 0 {
+			pr.Number = fmt.Sprint(number)
+		}
+		if pr.Number == "" {
+			return nil, ErrReportPR(fmt.Errorf("the GitHub Actions workflow does not run for a pull request"))
+		}
+		if pr.Token == "" {
+			return nil, ErrReportPR(fmt.Errorf("GITHUB_TOKEN is not set, pass secrets.GITHUB_TOKEN to the environment of the step"))
+		}
+		return pr, nil
+	case getenv("GITLAB_CI") == "true":
+		pr := &PullRequest{
+			Platform:   "gitlab",
+			APIURL:     getenv("CI_API_V4_URL"),
+			Repository: getenv("CI_PROJECT_ID"),
+			Number:     getenv("CI_MERGE_REQUEST_IID"),
+			Token:      getenv("GITLAB_TOKEN"),
+		}
+		if pr.Number == "" {
+			return nil, ErrReportPR(fmt.Errorf("the GitLab CI pipeline is not a merge request pipeline"))
+		}
+		if pr.Token == "" {
+			return nil, ErrReportPR(fmt.Errorf("GITLAB_TOKEN is not set, set it to a token with the api scope"))
+		}
+		return pr, nil
+	}
+	return nil, ErrReportPR(fmt.Errorf("neither GitHub Actions nor GitLab CI was detected"))
+}
+
+// pullRequestNumberOfEvent returns the number of the pull request of the GitHub Actions
+// event payload, 0 if the event is not about a pull request
+func pullRequestNumberOfEvent(path string) int {
+	if path == "" {
+		return 0
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	event := struct {
+		PullRequest struct {
+			Number int `json:"number"`
+		} `json:"pull_request"`
+	}{}
+	if err := json.Unmarshal(content, &event); err != nil {
+		return 0
+	}
+	return event.PullRequest.Number
+}
+
+// Comment posts the markdown as a comment of the pull request
+func (pr *PullRequest) Comment(markdown string) error {
+	apiURL := strings.TrimSuffix(pr.APIURL, "/")
+	headers := map[string]string{"Content-Type": "application/json"}
+	var endpoint string
+	switch pr.Platform {
+	case "github":
+		endpoint = fmt.Sprintf("%s/repos/%s/issues/%s/comments", apiURL, pr.Repository, pr.Number)
+		headers["Authorization"] = "Bearer " + pr.Token
+		headers["Accept"] = "application/vnd.github+json"
+	case "gitlab":
+		endpoint = fmt.Sprintf("%s/projects/%s/merge_requests/%s/notes", apiURL, url.PathEscape(pr.Repository), pr.Number)
+		headers["PRIVATE-TOKEN"] = pr.Token
+	default:
+		return ErrReportPR(fmt.Errorf("unknown platform %s", pr.Platform))
+	}
+
+	body, err := json.Marshal(map[string]string{"body": markdown})
+	if err != nil {
+		return ErrReportPR(err)
+	}
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return ErrReportPR(err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return ErrReportPR(err)
+	}
+	defer SafeClose(resp.Body)
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(resp.Body)
+		return ErrReportPR(errors.Errorf("%s responded with status code %d: %s", endpoint, resp.StatusCode, strings.TrimSpace(string(message))))
+	}
+	return nil
+}
+
+// ReportToPullRequest posts the markdown as a comment of the pull request the CI job runs for
+func ReportToPullRequest(markdown string) error {
+	pr, err := DetectPullRequest(os.Getenv)
+	if err != nil {
+		return err
+	}
+	if err := pr.Comment(markdown); err != nil {
+		return err
+	}
+	Log.Info(fmt.Sprintf("Report posted to %s pull request %s#%s", pr.Platform, pr.Repository, pr.Number))
+	return nil
+}
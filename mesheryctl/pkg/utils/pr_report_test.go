@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDetectPullRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		env    map[string]string
+		want   *PullRequest
+		hasErr bool
+	}{
+		{
+			name: "github pull request",
+			env: map[string]string{
+				"GITHUB_ACTIONS":    "true",
+				"GITHUB_REPOSITORY": "meshery/meshery",
+				"GITHUB_REF":        "refs/pull/42/merge",
+				"GITHUB_TOKEN":      "token",
+			},
+			want: &PullRequest{Platform: "github", APIURL: "https://api.github.com", Repository: "meshery/meshery", Number: "42", Token: "token"},
+		},
+		{
+			name: "gitlab merge request",
+			env: map[string]string{
+				"GITLAB_CI":            "true",
+				"CI_API_V4_URL":        "https://gitlab.example.com/api/v4",
+				"CI_PROJECT_ID":        "7",
+				"CI_MERGE_REQUEST_IID": "3",
+				"GITLAB_TOKEN":         "token",
+			},
+			want: &PullRequest{Platform: "gitlab", APIURL: "https://gitlab.example.com/api/v4", Repository: "7", Number: "3", Token: "token"},
+		},
+		{
+			name:   "github push",
+			env:    map[string]string{"GITHUB_ACTIONS": "true", "GITHUB_REF": "refs/heads/master", "GITHUB_TOKEN": "token"},
+			hasErr: true,
+		},
+		{
+			name:   "gitlab without token",
+			env:    map[string]string{"GITLAB_CI": "true", "CI_MERGE_REQUEST_IID": "3"},
+			hasErr: true,
+		},
+		{
+			name:   "no CI",
+			env:    map[string]string{},
+			hasErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectPullRequest(func(key string) string { return tt.env[key] })
+			if tt.hasErr {
+				if err == nil {
+					t.Errorf("detected %+v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *got != *tt.want {
+				t.Errorf("detected %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPullRequestComment(t *testing.T) {
+	var path, auth, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, auth = r.URL.Path, r.Header.Get("Authorization")+r.Header.Get("PRIVATE-TOKEN")
+		comment := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&comment)
+		body = comment["body"]
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	pr := &PullRequest{Platform: "github", APIURL: server.URL, Repository: "meshery/meshery", Number: "42", Token: "token"}
+	if err := pr.Comment("### Report"); err != nil {
+		t.Fatal(err)
+	}
+	Equals(t, "/repos/meshery/meshery/issues/42/comments", path)
+	Equals(t, "Bearer token", auth)
+	Equals(t, "### Report", body)
+
+	pr = &PullRequest{Platform: "gitlab", APIURL: server.URL + "/api/v4", Repository: "7", Number: "3", Token: "token"}
+	if err := pr.Comment("### Report"); err != nil {
+		t.Fatal(err)
+	}
+	Equals(t, "/api/v4/projects/7/merge_requests/3/notes", path)
+	Equals(t, "token", auth)
+}
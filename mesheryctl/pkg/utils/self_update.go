@@ -0,0 +1,264 @@
+package utils
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	rootconstants "github.com/layer5io/meshery/mesheryctl/internal/cli/root/constants"
+	"github.com/layer5io/meshery/mesheryctl/pkg/constants"
+	"github.com/pkg/errors"
+)
+
+// releaseChecksumsFile is the file of the SHA-256 checksums of the artifacts of a release, and
+// releaseSignatureFile the cosign signature of the checksums file
+const (
+	releaseChecksumsFile = "checksums.txt"
+	releaseSignatureFile = releaseChecksumsFile + ".sig"
+)
+
+// rename renames the executables, replaced by the tests to fail renames
+var rename = os.Rename
+
+// archive names of goreleaser, following the replacements of .goreleaser.yml
+var (
+	releaseOSNames   = map[string]string{"darwin": "Darwin", "linux": "Linux", "windows": "Windows"}
+	releaseArchNames = map[string]string{"386": "i386", "amd64": "x86_64", "arm": "armv6"}
+)
+
+// MesheryctlArchiveName returns the name of the release archive of mesheryctl for the version
+// and platform, such as mesheryctl_0.6.0_Linux_x86_64.zip
+func MesheryctlArchiveName(version, goos, goarch string) string {
+	osName, ok := releaseOSNames[goos]
+	if !ok {
+		osName = goos
+	}
+	archName, ok := releaseArchNames[goarch]
+	if !ok {
+		archName = goarch
+	}
+	return fmt.Sprintf("mesheryctl_%s_%s_%s.zip", strings.TrimPrefix(version, "v"), osName, archName)
+}
+
+// GetLatestReleaseTag returns the tag of the latest release of the channel: the latest stable
+// release for stable, and the latest release, pre-releases included, for edge
+func GetLatestReleaseTag(channel string) (string, error) {
+	switch channel {
+	case "stable":
+		return GetLatestStableReleaseTag()
+	case "edge":
+	default:
+		return "", errors.Errorf("unknown channel %s, expected stable or edge", channel)
+	}
+
+	url := "https://api.github.com/repos/" + constants.GetMesheryGitHubOrg() + "/" + constants.GetMesheryGitHubRepo() + "/releases?per_page=1"
+	resp, err := http.Get(url)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to make GET request to %s", url)
+	}
+	defer SafeClose(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to get latest release tag, status %s", resp.Status)
+	}
+
+	var releases []struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return "", errors.Wrap(err, "failed to read releases")
+	}
+	if len(releases) == 0 {
+		return "", errors.New("no release found")
+	}
+	return releases[0].TagName, nil
+}
+
+// DownloadMesheryctl downloads the mesheryctl binary of the release for the platform mesheryctl
+// runs on, verifying the signature of the checksums published with the release with the signing
+// key mesheryctl was built with, then the archive against the checksums
+func DownloadMesheryctl(tag string) ([]byte, error) {
+	releaseURL := "https://github.com/" + constants.GetMesheryGitHubOrg() + "/" + constants.GetMesheryGitHubRepo() + "/releases/download/" + tag + "/"
+	return downloadMesheryctl(releaseURL, MesheryctlArchiveName(tag, runtime.GOOS, runtime.GOARCH), runtime.GOOS, rootconstants.GetMesheryctlReleaseSigningKey())
+}
+
+func downloadMesheryctl(releaseURL, archiveName, goos, signingKey string) ([]byte, error) {
+	if signingKey == "" {
+		return nil, errors.New("mesheryctl was built without the key verifying the signatures of the releases, install the release with the installation script or your package manager instead")
+	}
+
+	checksums, err := downloadReleaseAsset(releaseURL + releaseChecksumsFile)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := downloadReleaseAsset(releaseURL + releaseSignatureFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyChecksumsSignature(checksums, signature, signingKey); err != nil {
+		return nil, err
+	}
+	archive, err := downloadReleaseAsset(releaseURL + archiveName)
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyChecksum(archive, checksums, archiveName); err != nil {
+		return nil, err
+	}
+	return ExtractMesheryctl(archive, goos)
+}
+
+func downloadReleaseAsset(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to make GET request to %s", url)
+	}
+	defer SafeClose(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to download %s, status %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// VerifyChecksum verifies the SHA-256 checksum of the file of the given name against the
+// checksums file of the release, in the format of sha256sum
+func VerifyChecksum(data, checksums []byte, name string) error {
+	sum := sha256.Sum256(data)
+	actual := hex.EncodeToString(sum[:])
+
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.TrimPrefix(fields[1], "*") != name {
+			continue
+		}
+		if !strings.EqualFold(fields[0], actual) {
+			return errors.Errorf("checksum mismatch for %s: expected %s, got %s", name, fields[0], actual)
+		}
+		return nil
+	}
+	return errors.Errorf("no checksum found for %s", name)
+}
+
+// VerifyChecksumsSignature verifies the signature of the checksums file of a release made by cosign
+// sign-blob, the base64 of an ECDSA signature of its SHA-256 digest, against the public key given as
+// the base64 of its DER encoding
+func VerifyChecksumsSignature(checksums, signature []byte, publicKey string) error {
+	der, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return errors.Wrap(err, "invalid release signing key")
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return errors.Wrap(err, "invalid release signing key")
+	}
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return errors.Errorf("release signing key is a %T, not an ECDSA key", key)
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil {
+		return errors.Wrapf(err, "invalid signature of %s", releaseChecksumsFile)
+	}
+	digest := sha256.Sum256(checksums)
+	if !ecdsa.VerifyASN1(ecdsaKey, digest[:], sig) {
+		return errors.Errorf("signature of %s does not match the release signing key", releaseChecksumsFile)
+	}
+	return nil
+}
+
+// ExtractMesheryctl returns the mesheryctl binary for the OS from the release archive
+func ExtractMesheryctl(archive []byte, goos string) ([]byte, error) {
+	binaryName := "mesheryctl"
+	if goos == "windows" {
+		binaryName += ".exe"
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read release archive")
+	}
+	for _, file := range reader.File {
+		if filepath.Base(file.Name) != binaryName || file.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read release archive")
+		}
+		defer SafeClose(rc)
+		return io.ReadAll(rc)
+	}
+	return nil, errors.Errorf("%s not found in release archive", binaryName)
+}
+
+// ReplaceExecutable replaces the executable of the running mesheryctl with the given binary,
+// returning its path. The binary is written next to the executable and renamed over it, so that
+// a failure leaves the executable untouched.
+func ReplaceExecutable(binary []byte) (string, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to locate the mesheryctl executable")
+	}
+	executable, err = filepath.EvalSymlinks(executable)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to locate the mesheryctl executable")
+	}
+	if err := replaceExecutable(executable, binary, runtime.GOOS); err != nil {
+		return "", err
+	}
+	return executable, nil
+}
+
+func replaceExecutable(executable string, binary []byte, goos string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(executable), ".mesheryctl-upgrade-*")
+	if err != nil {
+		return errors.Wrapf(err, "failed to write to %s", filepath.Dir(executable))
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+	if _, err := tmp.Write(binary); err != nil {
+		SafeClose(tmp)
+		return errors.Wrap(err, "failed to write the new mesheryctl")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed to write the new mesheryctl")
+	}
+	if err := os.Chmod(tmp.Name(), 0755); err != nil {
+		return errors.Wrap(err, "failed to make the new mesheryctl executable")
+	}
+
+	// a running executable cannot be replaced on Windows, though it can be moved aside, and moved
+	// back when the new one cannot take its place
+	if goos == "windows" {
+		old := executable + ".old"
+		_ = os.Remove(old)
+		if err := rename(executable, old); err != nil {
+			return errors.Wrap(err, "failed to move the current mesheryctl aside")
+		}
+		if err := rename(tmp.Name(), executable); err != nil {
+			if restoreErr := rename(old, executable); restoreErr != nil {
+				return errors.Wrapf(err, "failed to replace %s, and to restore it from %s: %v", executable, old, restoreErr)
+			}
+			return errors.Wrapf(err, "failed to replace %s", executable)
+		}
+		return nil
+	}
+	if err := rename(tmp.Name(), executable); err != nil {
+		return errors.Wrapf(err, "failed to replace %s", executable)
+	}
+	return nil
+}
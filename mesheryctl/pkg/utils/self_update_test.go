@@ -0,0 +1,210 @@
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMesheryctlArchiveName(t *testing.T) {
+	tests := []struct {
+		goos, goarch string
+		expected     string
+	}{
+		{"linux", "amd64", "mesheryctl_0.6.0_Linux_x86_64.zip"},
+		{"darwin", "arm64", "mesheryctl_0.6.0_Darwin_arm64.zip"},
+		{"windows", "386", "mesheryctl_0.6.0_Windows_i386.zip"},
+		{"linux", "arm", "mesheryctl_0.6.0_Linux_armv6.zip"},
+		{"freebsd", "amd64", "mesheryctl_0.6.0_freebsd_x86_64.zip"},
+	}
+	for _, tt := range tests {
+		if name := MesheryctlArchiveName("v0.6.0", tt.goos, tt.goarch); name != tt.expected {
+			t.Errorf("expected %s for %s/%s, got %s", tt.expected, tt.goos, tt.goarch, name)
+		}
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	data := []byte("mesheryctl")
+	sum := sha256.Sum256(data)
+	checksums := []byte("0000  mesheryctl_0.6.0_Darwin_x86_64.zip\n" + hex.EncodeToString(sum[:]) + "  mesheryctl_0.6.0_Linux_x86_64.zip\n")
+
+	if err := VerifyChecksum(data, checksums, "mesheryctl_0.6.0_Linux_x86_64.zip"); err != nil {
+		t.Errorf("expected checksum to match, got %v", err)
+	}
+	if err := VerifyChecksum(data, checksums, "mesheryctl_0.6.0_Darwin_x86_64.zip"); err == nil {
+		t.Error("expected a checksum mismatch")
+	}
+	if err := VerifyChecksum(data, checksums, "mesheryctl_0.6.0_Windows_i386.zip"); err == nil {
+		t.Error("expected a missing checksum")
+	}
+}
+
+func TestExtractMesheryctl(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	for name, content := range map[string]string{"README.md": "readme", "mesheryctl": "binary"} {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = f.Write([]byte(content))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := ExtractMesheryctl(buf.Bytes(), "linux")
+	if err != nil || string(binary) != "binary" {
+		t.Errorf("expected the binary, got %q, %v", binary, err)
+	}
+	if _, err := ExtractMesheryctl(buf.Bytes(), "windows"); err == nil {
+		t.Error("expected mesheryctl.exe to be missing")
+	}
+}
+
+// newTestSigningKey returns a cosign key pair, the public key as the base64 of its DER encoding
+func newTestSigningKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, base64.StdEncoding.EncodeToString(der)
+}
+
+// signTestBlob signs the blob as cosign sign-blob does
+func signTestBlob(t *testing.T, key *ecdsa.PrivateKey, blob []byte) []byte {
+	t.Helper()
+	digest := sha256.Sum256(blob)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []byte(base64.StdEncoding.EncodeToString(sig) + "\n")
+}
+
+func TestVerifyChecksumsSignature(t *testing.T) {
+	key, publicKey := newTestSigningKey(t)
+	otherKey, _ := newTestSigningKey(t)
+	checksums := []byte("0000  mesheryctl_0.6.0_Linux_x86_64.zip\n")
+
+	if err := VerifyChecksumsSignature(checksums, signTestBlob(t, key, checksums), publicKey); err != nil {
+		t.Errorf("expected the signature to match, got %v", err)
+	}
+	if err := VerifyChecksumsSignature([]byte("1111  mesheryctl_0.6.0_Linux_x86_64.zip\n"), signTestBlob(t, key, checksums), publicKey); err == nil {
+		t.Error("expected the signature of other checksums to mismatch")
+	}
+	if err := VerifyChecksumsSignature(checksums, signTestBlob(t, otherKey, checksums), publicKey); err == nil {
+		t.Error("expected the signature of another key to mismatch")
+	}
+	if err := VerifyChecksumsSignature(checksums, []byte("not a signature"), publicKey); err == nil {
+		t.Error("expected an invalid signature")
+	}
+}
+
+func TestDownloadMesheryctl(t *testing.T) {
+	key, publicKey := newTestSigningKey(t)
+	otherKey, _ := newTestSigningKey(t)
+
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	f, err := w.Create("mesheryctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.Write([]byte("binary"))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	archive := buf.Bytes()
+	sum := sha256.Sum256(archive)
+	checksums := []byte(hex.EncodeToString(sum[:]) + "  mesheryctl_0.6.0_Linux_x86_64.zip\n")
+
+	serve := func(signature []byte) string {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/checksums.txt", func(w http.ResponseWriter, r *http.Request) { _, _ = w.Write(checksums) })
+		mux.HandleFunc("/checksums.txt.sig", func(w http.ResponseWriter, r *http.Request) { _, _ = w.Write(signature) })
+		mux.HandleFunc("/mesheryctl_0.6.0_Linux_x86_64.zip", func(w http.ResponseWriter, r *http.Request) { _, _ = w.Write(archive) })
+		srv := httptest.NewServer(mux)
+		t.Cleanup(srv.Close)
+		return srv.URL + "/"
+	}
+
+	binary, err := downloadMesheryctl(serve(signTestBlob(t, key, checksums)), "mesheryctl_0.6.0_Linux_x86_64.zip", "linux", publicKey)
+	if err != nil || string(binary) != "binary" {
+		t.Errorf("expected the binary, got %q, %v", binary, err)
+	}
+	if _, err := downloadMesheryctl(serve(signTestBlob(t, otherKey, checksums)), "mesheryctl_0.6.0_Linux_x86_64.zip", "linux", publicKey); err == nil {
+		t.Error("expected checksums signed with another key to be rejected")
+	}
+	if _, err := downloadMesheryctl(serve(signTestBlob(t, key, checksums)), "mesheryctl_0.6.0_Linux_x86_64.zip", "linux", ""); err == nil {
+		t.Error("expected a build without a release signing key to refuse to upgrade")
+	}
+}
+
+func TestReplaceExecutableOnWindows(t *testing.T) {
+	setup := func(t *testing.T) string {
+		executable := filepath.Join(t.TempDir(), "mesheryctl.exe")
+		if err := os.WriteFile(executable, []byte("current"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		return executable
+	}
+	readFile := func(t *testing.T, name string) string {
+		content, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(content)
+	}
+
+	t.Run("replaced", func(t *testing.T) {
+		executable := setup(t)
+		if err := replaceExecutable(executable, []byte("new"), "windows"); err != nil {
+			t.Fatal(err)
+		}
+		if content := readFile(t, executable); content != "new" {
+			t.Errorf("expected the new executable, got %q", content)
+		}
+		if content := readFile(t, executable+".old"); content != "current" {
+			t.Errorf("expected the current executable to be moved aside, got %q", content)
+		}
+	})
+
+	t.Run("rolled back", func(t *testing.T) {
+		executable := setup(t)
+		rename = func(oldpath, newpath string) error {
+			if newpath == executable && oldpath != executable+".old" {
+				return os.ErrPermission
+			}
+			return os.Rename(oldpath, newpath)
+		}
+		defer func() { rename = os.Rename }()
+
+		if err := replaceExecutable(executable, []byte("new"), "windows"); err == nil {
+			t.Fatal("expected the replacement to fail")
+		}
+		if content := readFile(t, executable); content != "current" {
+			t.Errorf("expected the current executable to be restored, got %q", content)
+		}
+		if _, err := os.Stat(executable + ".old"); !os.IsNotExist(err) {
+			t.Errorf("expected the executable moved aside to be moved back, got %v", err)
+		}
+	})
+}
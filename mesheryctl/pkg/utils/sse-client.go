@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+
+	"github.com/layer5io/meshery/meshes"
 )
 
 // Event represents a Server-Sent Event
@@ -17,9 +19,10 @@ type Event struct {
 }
 
 type EventData struct {
-	Details     string `json:"details"`
-	OperationID string `json:"operation_id"`
-	Summary     string `json:"summary"`
+	Details     string           `json:"details"`
+	EventType   meshes.EventType `json:"event_type"`
+	OperationID string           `json:"operation_id"`
+	Summary     string           `json:"summary"`
 }
 
 // ConvertRespToSSE converts a connection to a stream of server sent events
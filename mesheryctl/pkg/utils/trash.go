@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+)
+
+// trashRestorePaths are the API paths restoring the items of every kind from the trash
+var trashRestorePaths = map[string]string{
+	models.OwnedPattern:            "/api/pattern/%s/restore",
+	models.OwnedPerformanceProfile: "/api/user/performance/profiles/%s/restore",
+}
+
+// GetTrash returns the deleted items of the kind which can still be restored
+func GetTrash(baseURL, kind string) (*models.TrashPage, error) {
+	req, err := NewRequest("GET", baseURL+"/api/user/trash?kind="+kind, nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := doTrashRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	page := &models.TrashPage{}
+	if err := json.Unmarshal(body, page); err != nil {
+		return nil, ErrTrash(err)
+	}
+	return page, nil
+}
+
+// RestoreFromTrash restores the item of the kind whose id starts with the given id, as
+// listed with truncated ids, from the trash
+func RestoreFromTrash(baseURL, kind, id string) (*models.TrashItem, error) {
+	page, err := GetTrash(baseURL, kind)
+	if err != nil {
+		return nil, err
+	}
+	var matches []*models.TrashItem
+	for _, item := range page.Items {
+		if item.ID != nil && strings.HasPrefix(item.ID.String(), id) {
+			matches = append(matches, item)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, ErrTrash(fmt.Errorf("no %s of id %s in the trash", kind, id))
+	}
+	if len(matches) > 1 {
+		return nil, ErrTrash(fmt.Errorf("%d items of the trash have an id starting with %s, give the full id", len(matches), id))
+	}
+
+	req, err := NewRequest("POST", baseURL+fmt.Sprintf(trashRestorePaths[kind], matches[0].ID.String()), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := doTrashRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	item := &models.TrashItem{}
+	if err := json.Unmarshal(body, item); err != nil {
+		return nil, ErrTrash(err)
+	}
+	return item, nil
+}
+
+// PrintTrash prints the items of the trash in a table
+func PrintTrash(page *models.TrashPage) {
+	var data [][]string
+	for _, item := range page.Items {
+		purgeAt := "never"
+		if item.PurgeAt != nil {
+			purgeAt = item.PurgeAt.Local().Format(time.RFC822)
+		}
+		data = append(data, []string{TruncateID(item.ID.String()), item.Name, item.DeletedAt.Local().Format(time.RFC822), purgeAt})
+	}
+	PrintToTable([]string{"ID", "NAME", "DELETED", "PURGED"}, data)
+}
+
+func doTrashRequest(req *http.Request) ([]byte, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, ErrTrash(err)
+	}
+	defer SafeClose(resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrTrash(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrTrash(fmt.Errorf("server responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body))))
+	}
+	return body, nil
+}
@@ -0,0 +1,236 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/layer5io/meshery/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// Levels of verbosity of mesheryctl, raised by repeating the -v flag
+const (
+	// VerbosityRequests logs a summary of every HTTP request made, -v
+	VerbosityRequests = 1
+	// VerbosityHTTP also logs the headers and bodies of the requests and of their responses, -vv
+	VerbosityHTTP = 2
+	// VerbosityServerDebug also logs the entries Meshery Server logged while serving the requests, -vvv
+	VerbosityServerDebug = 3
+)
+
+// maxTraceBodySize is the size beyond which the bodies are truncated in the HTTP traces
+const maxTraceBodySize = 64 << 10
+
+// serverDebugPath is the endpoint of Meshery Server returning the entries it logged
+const serverDebugPath = "/api/system/debug"
+
+// Verbosity is the level of verbosity set with the -v flag
+var Verbosity int
+
+// EnableVerboseHTTP logs the requests made by the HTTP clients of mesheryctl using the default
+// transport at the given level of verbosity
+func EnableVerboseHTTP(level int) error {
+	transport, err := NewVerboseTransport(level, http.DefaultTransport)
+	if err != nil {
+		return err
+	}
+	http.DefaultTransport = transport
+	return nil
+}
+
+// NewVerboseTransport returns a RoundTripper logging the exchanges made through the next one at the
+// given level of verbosity, with the tokens redacted
+func NewVerboseTransport(level int, next http.RoundTripper) (http.RoundTripper, error) {
+	redactor, err := NewRedactor(RedactionRules{Keys: harTokenKeys})
+	if err != nil {
+		return nil, err
+	}
+	return &verboseTransport{
+		level:    level,
+		redactor: redactor,
+		next:     next,
+		cursors:  map[string]uint64{},
+		noDebug:  map[string]bool{},
+	}, nil
+}
+
+type verboseTransport struct {
+	level    int
+	redactor *Redactor
+	next     http.RoundTripper
+
+	mu sync.Mutex
+	// cursors are those of the server-side debug pulled from every server so far
+	cursors map[string]uint64
+	// noDebug holds the servers not serving their debug
+	noDebug map[string]bool
+}
+
+func (t *verboseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	started := time.Now()
+
+	var reqBody []byte
+	if t.level >= VerbosityHTTP && req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		reqBody = body
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(started).Round(time.Millisecond)
+	target := t.redactor.RedactString(req.URL.String())
+	if err != nil {
+		log.Debugf("%s %s failed after %s: %s", req.Method, target, elapsed, t.redactor.RedactString(err.Error()))
+		return resp, err
+	}
+	log.Debugf("%s %s %s in %s", req.Method, target, resp.Status, elapsed)
+	if t.level < VerbosityHTTP {
+		return resp, nil
+	}
+
+	var trace strings.Builder
+	fmt.Fprintf(&trace, "> %s %s %s\n", req.Method, req.URL.RequestURI(), req.Proto)
+	fmt.Fprintf(&trace, "> Host: %s\n", req.URL.Host)
+	t.writeHeaders(&trace, "> ", req.Header)
+	t.writeBody(&trace, "> ", reqBody, false)
+	fmt.Fprintf(&trace, "< %s %s\n", resp.Proto, resp.Status)
+	t.writeHeaders(&trace, "< ", resp.Header)
+	log.Debug(strings.TrimSuffix(trace.String(), "\n"))
+
+	// The body is logged once read, as responses may be streams of events which never end
+	resp.Body = &harBody{
+		ReadCloser: resp.Body,
+		onDone: func(body []byte, truncated bool) {
+			var trace strings.Builder
+			t.writeBody(&trace, "< ", body, truncated)
+			if trace.Len() > 0 {
+				log.Debug(strings.TrimSuffix(trace.String(), "\n"))
+			}
+			if t.level >= VerbosityServerDebug {
+				t.pullServerDebug(req, started)
+			}
+		},
+	}
+	return resp, nil
+}
+
+func (t *verboseTransport) writeHeaders(w io.Writer, prefix string, header http.Header) {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range header[name] {
+			if t.redactor.isSensitiveKey(name) {
+				value = Redacted
+			} else {
+				value = t.redactor.RedactString(value)
+			}
+			fmt.Fprintf(w, "%s%s: %s\n", prefix, name, value)
+		}
+	}
+}
+
+func (t *verboseTransport) writeBody(w io.Writer, prefix string, body []byte, truncated bool) {
+	if len(body) == 0 {
+		return
+	}
+	if len(body) > maxTraceBodySize {
+		body = body[:maxTraceBodySize]
+		truncated = true
+	}
+	fmt.Fprintf(w, "%s\n", prefix)
+	if !utf8.Valid(body) {
+		fmt.Fprintf(w, "%s[%d bytes of binary data]\n", prefix, len(body))
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(t.redactor.RedactString(string(body)), "\n"), "\n") {
+		fmt.Fprintf(w, "%s%s\n", prefix, line)
+	}
+	if truncated {
+		fmt.Fprintf(w, "%s[truncated]\n", prefix)
+	}
+}
+
+// pullServerDebug logs the entries the server of the request logged since the entries pulled
+// previously or, on the first request to the server, since the request started
+func (t *verboseTransport) pullServerDebug(req *http.Request, started time.Time) {
+	if !strings.HasPrefix(req.URL.Path, "/api/") {
+		return
+	}
+	server := req.URL.Host
+	t.mu.Lock()
+	cursor, skip := t.cursors[server], t.noDebug[server]
+	t.mu.Unlock()
+	if skip {
+		return
+	}
+
+	q := url.Values{}
+	if cursor == 0 {
+		q.Set("since", started.UTC().Format(time.RFC3339Nano))
+	} else {
+		q.Set("after", strconv.FormatUint(cursor, 10))
+	}
+	debugURL := url.URL{Scheme: req.URL.Scheme, Host: server, Path: serverDebugPath, RawQuery: q.Encode()}
+	debugReq, err := http.NewRequest(http.MethodGet, debugURL.String(), nil)
+	if err != nil {
+		return
+	}
+	// The request is authenticated as the one it pulls the debug of
+	debugReq.Header = req.Header.Clone()
+	debugReq.Header.Del("Content-Type")
+
+	resp, err := t.next.RoundTrip(debugReq)
+	if err != nil {
+		log.Debugf("Unable to pull the server-side debug: %s", t.redactor.RedactString(err.Error()))
+		return
+	}
+	defer SafeClose(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.mu.Lock()
+		t.noDebug[server] = true
+		t.mu.Unlock()
+		log.Debugf("Meshery Server at %s does not serve its debug, responding with %s", server, resp.Status)
+		return
+	}
+
+	debug := &models.DebugLog{}
+	if err := json.NewDecoder(resp.Body).Decode(debug); err != nil {
+		log.Debugf("Unable to read the server-side debug: %v", err)
+		return
+	}
+	t.mu.Lock()
+	if debug.Next > t.cursors[server] {
+		t.cursors[server] = debug.Next
+	}
+	t.mu.Unlock()
+
+	for _, entry := range debug.Entries {
+		line := fmt.Sprintf("[server] %s %s %s", entry.Time.Local().Format(time.RFC3339), entry.Level, entry.Message)
+		keys := make([]string, 0, len(entry.Fields))
+		for k := range entry.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			line += fmt.Sprintf(" %s=%s", k, entry.Fields[k])
+		}
+		log.Debug(t.redactor.RedactString(line))
+	}
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/models"
+	"github.com/sirupsen/logrus"
+)
+
+func TestVerboseTransport(t *testing.T) {
+	debugPulls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == serverDebugPath {
+			debugPulls++
+			if r.Header.Get("Authorization") == "" {
+				t.Error("the server-side debug must be pulled with the credentials of the request")
+			}
+			debug := &models.DebugLog{Next: 7, Entries: []*models.DebugLogEntry{}}
+			if r.URL.Query().Get("after") == "" {
+				debug.Entries = append(debug.Entries, &models.DebugLogEntry{
+					Seq: 7, Time: time.Now(), Level: "debug", Message: "pattern bookinfo loaded",
+					Fields: map[string]string{"code": "2240"},
+				})
+			}
+			_ = json.NewEncoder(w).Encode(debug)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "bookinfo"}`))
+	}))
+	defer server.Close()
+
+	level := logrus.GetLevel()
+	defer logrus.SetLevel(level)
+	logrus.SetLevel(logrus.DebugLevel)
+
+	tests := []struct {
+		level    int
+		contains []string
+		excludes []string
+		pulls    int
+	}{
+		{
+			level:    VerbosityRequests,
+			contains: []string{"GET " + server.URL + "/api/pattern 200 OK in"},
+			excludes: []string{"> Authorization", `< {"name": "bookinfo"}`, "[server]"},
+		},
+		{
+			level:    VerbosityHTTP,
+			contains: []string{"> GET /api/pattern HTTP/1.1", "> Authorization: " + Redacted, "< HTTP/1.1 200 OK", `< {"name": "bookinfo"}`},
+			excludes: []string{"header-secret", "[server]"},
+		},
+		{
+			level:    VerbosityServerDebug,
+			contains: []string{`< {"name": "bookinfo"}`, "[server]", "debug pattern bookinfo loaded code=2240"},
+			pulls:    2,
+		},
+	}
+	for _, tt := range tests {
+		debugPulls = 0
+		b := SetupLogrusGrabTesting(t, true)
+		transport, err := NewVerboseTransport(tt.level, http.DefaultTransport)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client := &http.Client{Transport: transport}
+
+		// the second request pulls the entries logged after the first one
+		for i := 0; i < 2; i++ {
+			req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/pattern", nil)
+			req.Header.Set("Authorization", "Bearer header-secret")
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, _ = io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		out := b.String()
+		for _, s := range tt.contains {
+			if !strings.Contains(out, s) {
+				t.Errorf("verbosity %d: expected the output to contain %q, got:\n%s", tt.level, s, out)
+			}
+		}
+		for _, s := range tt.excludes {
+			if strings.Contains(out, s) {
+				t.Errorf("verbosity %d: expected the output not to contain %q, got:\n%s", tt.level, s, out)
+			}
+		}
+		if strings.Count(out, "[server]") > 1 {
+			t.Errorf("verbosity %d: expected the server-side debug to be logged once, got:\n%s", tt.level, out)
+		}
+		Equals(t, tt.pulls, debugPulls)
+	}
+}
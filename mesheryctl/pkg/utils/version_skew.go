@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+)
+
+const (
+	// VersionSkewCacheFile is the file within the MesheryFolder caching the version of Meshery Server
+	VersionSkewCacheFile = "version-cache.json"
+	// versionCacheTTL is how long the version of Meshery Server is cached for
+	versionCacheTTL = time.Hour
+	// versionCacheFailureTTL is how long an unreachable Meshery Server is cached for, so that
+	// commands do not wait on the server every time when it is down
+	versionCacheFailureTTL = time.Minute
+)
+
+var majorMinor = regexp.MustCompile(`v?(\d+)\.(\d+)(\.\d+)?`)
+
+// versionCache is the last version of Meshery Server seen at an endpoint
+type versionCache struct {
+	Endpoint      string    `json:"endpoint"`
+	ServerVersion string    `json:"server_version"`
+	CheckedAt     time.Time `json:"checked_at"`
+}
+
+// ParseMajorMinor returns the major and minor version of versions such as v0.6.0,
+// stable-v0.6.0 or v0.6.0-rc.1. It returns false for versions without a number,
+// such as edge-latest or builds from source.
+func ParseMajorMinor(version string) (int, int, bool) {
+	match := majorMinor.FindStringSubmatch(version)
+	if match == nil {
+		return 0, 0, false
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	return major, minor, true
+}
+
+// IsVersionSkewed returns true when the versions differ in their major or minor version.
+// Versions which cannot be compared are not considered skewed.
+func IsVersionSkewed(a, b string) bool {
+	aMajor, aMinor, ok := ParseMajorMinor(a)
+	if !ok {
+		return false
+	}
+	bMajor, bMinor, ok := ParseMajorMinor(b)
+	if !ok {
+		return false
+	}
+	return aMajor != bMajor || aMinor != bMinor
+}
+
+// FetchServerVersion returns the version of the Meshery Server running at the endpoint
+func FetchServerVersion(endpoint string, timeout time.Duration) (*config.Version, error) {
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Get(endpoint + "/api/system/version")
+	if err != nil {
+		return nil, err
+	}
+	defer SafeClose(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	version := &config.Version{}
+	if err := json.NewDecoder(resp.Body).Decode(version); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// CachedServerVersion returns the version of the Meshery Server running at the endpoint,
+// fetching it only when the cached version is stale. An empty version is returned when
+// the server is not reachable.
+func CachedServerVersion(endpoint string) string {
+	cacheFile := filepath.Join(MesheryFolder, VersionSkewCacheFile)
+
+	cache := &versionCache{}
+	if data, err := os.ReadFile(cacheFile); err == nil && json.Unmarshal(data, cache) == nil && cache.Endpoint == endpoint {
+		ttl := versionCacheTTL
+		if cache.ServerVersion == "" {
+			ttl = versionCacheFailureTTL
+		}
+		if time.Since(cache.CheckedAt) < ttl {
+			return cache.ServerVersion
+		}
+	}
+
+	cache = &versionCache{Endpoint: endpoint, CheckedAt: time.Now()}
+	if version, err := FetchServerVersion(endpoint, 2*time.Second); err == nil {
+		cache.ServerVersion = version.GetBuild()
+	}
+	if data, err := json.Marshal(cache); err == nil {
+		_ = os.WriteFile(cacheFile, data, 0644)
+	}
+	return cache.ServerVersion
+}
@@ -0,0 +1,23 @@
+package utils
+
+import "testing"
+
+func TestIsVersionSkewed(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"v0.6.0", "v0.6.3", false},
+		{"v0.6.0", "stable-v0.6.1", false},
+		{"v0.6.0-rc.1", "v0.6.0", false},
+		{"v0.5.9", "v0.6.0", true},
+		{"v1.0.0", "v0.6.0", true},
+		{"edge-latest", "v0.6.0", false},
+		{"v0.6.0", "", false},
+	}
+	for _, tt := range tests {
+		if got := IsVersionSkewed(tt.a, tt.b); got != tt.want {
+			t.Errorf("IsVersionSkewed(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -2,9 +2,24 @@ package meshes
 
 import (
 	context "context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	// adapterCAs holds the CA bundles of the adapters which serve over TLS, keyed by their location
+	adapterCAs     = map[string]*x509.CertPool{}
+	adapterCAsLock sync.RWMutex
+
+	// mtlsConfig, when set, is used to authenticate Meshery to every adapter
+	mtlsConfig *tls.Config
 )
 
 // MeshClient represents a gRPC adapter client
@@ -15,16 +30,7 @@ type MeshClient struct {
 
 // CreateClient creates a MeshClient for the given params
 func CreateClient(ctx context.Context, k8sConfigBytes []byte, contextName, meshLocationURL string) (*MeshClient, error) {
-	var opts []grpc.DialOption
-	// creds, err := credentials.NewClientTLSFromFile(*caFile, *serverHostOverride)
-	// 	if err != nil {
-	// 		logrus.Errorf("Failed to create TLS credentials %v", err)
-	// 	}
-	// 	opts = append(opts, grpc.WithTransportCredentials(creds))
-	// } else {
-	opts = append(opts, grpc.WithInsecure())
-	// }
-	conn, err := grpc.Dial(meshLocationURL, opts...)
+	conn, err := grpc.Dial(meshLocationURL, dialOptions(meshLocationURL)...)
 	if err != nil {
 		logrus.Errorf("fail to dial: %v", err)
 	}
@@ -51,3 +57,119 @@ func (m *MeshClient) Close() error {
 	}
 	return nil
 }
+
+// PingAdapter checks whether the adapter at the given location is reachable
+// and serving requests, without initializing a mesh instance on it
+func PingAdapter(ctx context.Context, meshLocationURL string) (*ComponentInfoResponse, error) {
+	return pingAdapter(ctx, meshLocationURL, dialOptions(meshLocationURL))
+}
+
+// PingAdapterWithCA checks whether the adapter at the given location is reachable and serving
+// requests, verifying it against the given PEM encoded CA bundle, or over plaintext when none is
+// given, leaving the CA registered for the adapter, if any, untouched
+func PingAdapterWithCA(ctx context.Context, meshLocationURL string, caPEM []byte) (*ComponentInfoResponse, error) {
+	var pool *x509.CertPool
+	if len(caPEM) > 0 {
+		var err error
+		if pool, err = parseAdapterCA(caPEM); err != nil {
+			return nil, err
+		}
+	}
+	return pingAdapter(ctx, meshLocationURL, dialOptionsWithCA(pool))
+}
+
+func pingAdapter(ctx context.Context, meshLocationURL string, opts []grpc.DialOption) (*ComponentInfoResponse, error) {
+	conn, err := grpc.DialContext(ctx, meshLocationURL, append(opts, grpc.WithBlock())...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	return NewMeshServiceClient(conn).ComponentInfo(ctx, &ComponentInfoRequest{})
+}
+
+// RegisterAdapterCA makes the connections to the adapter at the given location
+// use TLS, verifying the adapter against the given PEM encoded CA bundle
+func RegisterAdapterCA(meshLocationURL string, caPEM []byte) error {
+	pool, err := parseAdapterCA(caPEM)
+	if err != nil {
+		return err
+	}
+
+	adapterCAsLock.Lock()
+	defer adapterCAsLock.Unlock()
+	adapterCAs[meshLocationURL] = pool
+	return nil
+}
+
+func parseAdapterCA(caPEM []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("no valid certificates found in the CA bundle")
+	}
+	return pool, nil
+}
+
+// UnregisterAdapterCA reverts the connections to the adapter at the given location to plaintext
+func UnregisterAdapterCA(meshLocationURL string) {
+	adapterCAsLock.Lock()
+	defer adapterCAsLock.Unlock()
+	delete(adapterCAs, meshLocationURL)
+}
+
+// ConfigureMTLS makes the connections to all the adapters use mutual TLS, presenting the
+// given client certificate and verifying the adapters against the given CA bundle
+func ConfigureMTLS(certFile, keyFile, caFile string) error {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return err
+	}
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return errors.New("no valid certificates found in the CA bundle")
+	}
+
+	adapterCAsLock.Lock()
+	defer adapterCAsLock.Unlock()
+	mtlsConfig = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}
+	return nil
+}
+
+func dialOptions(meshLocationURL string) []grpc.DialOption {
+	adapterCAsLock.RLock()
+	pool := adapterCAs[meshLocationURL]
+	adapterCAsLock.RUnlock()
+	return dialOptionsWithCA(pool)
+}
+
+// dialOptionsWithCA returns the options to dial an adapter verified against the given CA, or against
+// the common CA of mutual TLS when nil
+func dialOptionsWithCA(pool *x509.CertPool) []grpc.DialOption {
+	adapterCAsLock.RLock()
+	base := mtlsConfig
+	adapterCAsLock.RUnlock()
+
+	if pool == nil && base == nil {
+		return []grpc.DialOption{grpc.WithInsecure()}
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if base != nil {
+		config = base.Clone()
+	}
+	// a CA registered for a specific adapter takes precedence over the common one
+	if pool != nil {
+		config.RootCAs = pool
+	}
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(config))}
+}
@@ -2,6 +2,11 @@ package models
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/layer5io/meshery/meshes"
 )
@@ -15,9 +20,81 @@ type Adapter struct {
 	Ops          []*meshes.SupportedOperation `json:"ops"`
 }
 
+// AdapterRegistration holds the details needed to register an adapter with Meshery at runtime,
+// persisted for the adapter to be registered again once Meshery Server restarts
+type AdapterRegistration struct {
+	Name    string `json:"name,omitempty"`
+	Address string `json:"address,omitempty" gorm:"primaryKey"`
+	// PEM encoded CA bundle used to verify the adapter when it serves over TLS
+	TLSCA string `json:"tls_ca,omitempty"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// adapterHostname matches the DNS names adapters are registered at
+var adapterHostname = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+
+// Validate checks that the address of the adapter is a host and a port, the host being a DNS name
+// or an IP address, as the serving certificate of the adapters is made valid for it
+func (r *AdapterRegistration) Validate() error {
+	host, port, err := net.SplitHostPort(r.Address)
+	if err != nil {
+		return fmt.Errorf("invalid address %q, expected host:port", r.Address)
+	}
+	if ip := net.ParseIP(host); ip == nil && (len(host) > 253 || !adapterHostname.MatchString(host)) {
+		return fmt.Errorf("invalid host %q, expected a DNS name or an IP address", host)
+	} else if ip != nil && ip.IsUnspecified() {
+		return fmt.Errorf("invalid host %q, the address of the adapter is unspecified", host)
+	}
+	if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+		return fmt.Errorf("invalid port %q, expected a port between 1 and 65535", port)
+	}
+	return nil
+}
+
+// AdapterOperationResponse identifies an operation submitted to an adapter, the events the adapter
+// reports its progress with carrying the same ID
+type AdapterOperationResponse struct {
+	OperationID string `json:"operation_id"`
+	// Resources are, on a dry run, the resources of the custom body as the cluster would apply them,
+	// or those it would delete
+	Resources []map[string]interface{} `json:"resources,omitempty"`
+}
+
 // AdaptersTrackerInterface defines the methods a type should implement to be an adapter tracker
 type AdaptersTrackerInterface interface {
 	AddAdapter(context.Context, Adapter)
 	RemoveAdapter(context.Context, Adapter)
 	GetAdapters(context.Context) []Adapter
 }
+
+// AdapterCertsInterface keeps the certificates of mutual TLS between Meshery and its adapters valid
+// for the hosts of the adapters
+type AdapterCertsInterface interface {
+	// AddHosts makes the serving certificate of the adapters valid for the given hosts too
+	AddHosts(ctx context.Context, hosts ...string) error
+}
+
+// AdapterHealthCheck is the outcome of a single health check against an adapter
+type AdapterHealthCheck struct {
+	Time      time.Time `json:"time"`
+	Available bool      `json:"available"`
+}
+
+// AdapterHealth represents the availability of an adapter as observed by Meshery
+type AdapterHealth struct {
+	Location    string               `json:"adapter_location"`
+	Name        string               `json:"name"`
+	Version     string               `json:"version"`
+	Available   bool                 `json:"available"`
+	Since       time.Time            `json:"since"`
+	LastChecked time.Time            `json:"last_checked"`
+	Uptime      float64              `json:"uptime"`
+	History     []AdapterHealthCheck `json:"history"`
+}
+
+// AdapterHealthTrackerInterface defines the methods a type should implement to track adapter health
+type AdapterHealthTrackerInterface interface {
+	GetAdapterHealth(context.Context) []AdapterHealth
+	Subscribe() (<-chan *meshes.EventsResponse, func())
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"github.com/layer5io/meshkit/database"
+)
+
+// AdapterRegistrationPersister is the persister for persisting
+// the adapters registered at runtime on the database
+type AdapterRegistrationPersister struct {
+	DB *database.Handler
+}
+
+// SaveAdapterRegistration persists the registration, replacing that of the adapter at the same address
+func (arp *AdapterRegistrationPersister) SaveAdapterRegistration(registration *AdapterRegistration) error {
+	return arp.DB.Save(registration).Error
+}
+
+// GetAdapterRegistrations returns the registrations of the adapters registered at runtime
+func (arp *AdapterRegistrationPersister) GetAdapterRegistrations() ([]AdapterRegistration, error) {
+	registrations := []AdapterRegistration{}
+	if err := arp.DB.Order("address").Find(&registrations).Error; err != nil {
+		return nil, err
+	}
+	return registrations, nil
+}
+
+// DeleteAdapterRegistration deletes the registration of the adapter at the given address
+func (arp *AdapterRegistrationPersister) DeleteAdapterRegistration(address string) error {
+	return arp.DB.Where("address = ?", address).Delete(&AdapterRegistration{}).Error
+}
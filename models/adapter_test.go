@@ -0,0 +1,30 @@
+package models
+
+import "testing"
+
+func TestAdapterRegistrationValidate(t *testing.T) {
+	tests := []struct {
+		address string
+		wantErr bool
+	}{
+		{address: "istio-adapter.meshery.svc.cluster.local:10000"},
+		{address: "localhost:10000"},
+		{address: "10.0.0.12:10000"},
+		{address: "[fd00::12]:10000"},
+		{address: "istio-adapter", wantErr: true},
+		{address: "*.example.com:10000", wantErr: true},
+		{address: "istio_adapter:10000", wantErr: true},
+		{address: "-istio.example.com:10000", wantErr: true},
+		{address: "0.0.0.0:10000", wantErr: true},
+		{address: "[::]:10000", wantErr: true},
+		{address: "localhost:0", wantErr: true},
+		{address: "localhost:65536", wantErr: true},
+		{address: "localhost:grpc", wantErr: true},
+	}
+	for _, tt := range tests {
+		err := (&AdapterRegistration{Address: tt.address}).Validate()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Validate() of the adapter at %s returned %v, want an error: %t", tt.address, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package models
+
+import "github.com/gofrs/uuid"
+
+// ApplicationWorkloadStatus is the readiness of the workload created for a component of an application,
+// as discovered by MeshSync
+type ApplicationWorkloadStatus struct {
+	Component string `json:"component"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Found is false when MeshSync has not discovered the workload in the cluster
+	Found         bool  `json:"found"`
+	Replicas      int64 `json:"replicas"`
+	ReadyReplicas int64 `json:"ready_replicas"`
+	Ready         bool  `json:"ready"`
+	// Reason tells why the workload is not ready
+	Reason string `json:"reason,omitempty"`
+}
+
+// ApplicationStatus is the aggregated readiness of the workloads of an application
+type ApplicationStatus struct {
+	ID             *uuid.UUID                  `json:"id,omitempty"`
+	Name           string                      `json:"name"`
+	Ready          bool                        `json:"ready"`
+	ReadyWorkloads int                         `json:"ready_workloads"`
+	Workloads      []ApplicationWorkloadStatus `json:"workloads"`
+}
+
+// ApplicationRolloutRequest selects the components of an application whose workloads are
+// restarted, every workload of the application when none is given
+type ApplicationRolloutRequest struct {
+	Components []string `json:"components,omitempty"`
+}
+
+// ApplicationScaleRequest sets the number of replicas of the workload of a component
+type ApplicationScaleRequest struct {
+	Component string `json:"component"`
+	Replicas  int32  `json:"replicas"`
+}
+
+// ApplicationOperationResult lists the workloads an operation changed, as <kind>/<name>
+type ApplicationOperationResult struct {
+	Workloads []string `json:"workloads"`
+}
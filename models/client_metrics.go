@@ -0,0 +1,33 @@
+package models
+
+// ClientMetricsTestLabel is the label identifying the test of the series written by load generators
+// through Prometheus remote_write, when the test is not given in the URL written to
+const ClientMetricsTestLabel = "meshery_test_id"
+
+// ClientMetricSeries is a series of client-side metrics, such as connection errors or DNS timing,
+// written by a load generator during a test through Prometheus remote_write
+type ClientMetricSeries struct {
+	Labels  map[string]string    `json:"labels"`
+	Samples []ClientMetricSample `json:"samples"`
+}
+
+// ClientMetricSample is a sample of a series, its timestamp in milliseconds since the epoch
+type ClientMetricSample struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// RemoteWriteReceiverInterface collects the client-side metrics load generators write through
+// Prometheus remote_write while their test runs
+type RemoteWriteReceiverInterface interface {
+	// Open starts collecting the series written for the test, returning the token the writers of
+	// the test authenticate with
+	Open(testID string) (string, error)
+	// Authorize checks whether the token is the write token of the test, while it runs
+	Authorize(testID, token string) bool
+	// Append adds the series written for the test, failing when the test is not collecting
+	Append(testID string, series []ClientMetricSeries) error
+	// Close stops collecting for the test, returning the series written along with the count of
+	// samples dropped over the limits of a test
+	Close(testID string) ([]ClientMetricSeries, int)
+}
@@ -0,0 +1,34 @@
+package models
+
+// Statuses of a cluster health check
+const (
+	ClusterHealthPassed  = "passed"
+	ClusterHealthFailed  = "failed"
+	ClusterHealthSkipped = "skipped"
+)
+
+// Cluster health checks run against every Kubernetes context
+const (
+	ClusterHealthCheckAPI      = "api"
+	ClusterHealthCheckRBAC     = "rbac"
+	ClusterHealthCheckMeshSync = "meshsync"
+	ClusterHealthCheckBroker   = "broker"
+)
+
+// ClusterHealthCheck is the outcome of a health check of a Kubernetes context
+type ClusterHealthCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	// Remedy tells how to fix a failing check
+	Remedy string `json:"remedy,omitempty"`
+}
+
+// ClusterHealth is the health of the connection of Meshery Server to a Kubernetes context
+type ClusterHealth struct {
+	ContextID   string               `json:"context_id"`
+	ContextName string               `json:"context_name"`
+	Server      string               `json:"server"`
+	Healthy     bool                 `json:"healthy"`
+	Checks      []ClusterHealthCheck `json:"checks"`
+}
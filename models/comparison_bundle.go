@@ -0,0 +1,129 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ComparisonBundleVersion is the version of the format of comparison bundles
+const ComparisonBundleVersion = "v1"
+
+// ComparisonBundle is a set of results exported along with the environment each of them was
+// run in, for results of different clusters or Meshery instances to be compared knowing how
+// their environments differ
+type ComparisonBundle struct {
+	Version    string    `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	// Source is the Meshery Server the results were exported from
+	Source  string           `json:"source,omitempty"`
+	Results []*BundledResult `json:"results"`
+}
+
+// BundledResult is a result of a comparison bundle, as stored by Meshery
+type BundledResult struct {
+	Environment *ResultEnvironment `json:"environment"`
+	Result      *MesheryResult     `json:"result"`
+}
+
+// ResultEnvironment is the environment a result was run in
+type ResultEnvironment struct {
+	MesheryVersion    string `json:"meshery_version,omitempty"`
+	KubernetesVersion string `json:"kubernetes_version,omitempty"`
+	LoadGenerator     string `json:"load_generator,omitempty"`
+	Nodes             int    `json:"nodes"`
+	// NodeTypes counts the nodes of the cluster by instance type, or by architecture, CPUs and
+	// memory when their cloud provider did not label them with their instance type
+	NodeTypes map[string]int `json:"node_types,omitempty"`
+	// MeshVersions are the versions of the service meshes found in the cluster, by service mesh
+	MeshVersions map[string]string `json:"mesh_versions,omitempty"`
+	// Labels are what the user exporting the result told about its environment, such as the
+	// region of the cluster
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// NewResultEnvironment returns the environment of the result, as recorded with it. Results
+// recorded before the version of Meshery was stored with them have none.
+func NewResultEnvironment(result *MesheryResult) *ResultEnvironment {
+	env := &ResultEnvironment{}
+	if result == nil || result.Result == nil {
+		return env
+	}
+	env.MesheryVersion, _ = result.Result["meshery-version"].(string)
+	env.LoadGenerator, _ = result.Result["load-generator"].(string)
+
+	var kubernetes struct {
+		ServerVersion string     `json:"server_version"`
+		Nodes         []*K8SNode `json:"nodes"`
+	}
+	if decodeResultValue(result.Result["kubernetes"], &kubernetes) == nil {
+		env.KubernetesVersion = kubernetes.ServerVersion
+		env.Nodes = len(kubernetes.Nodes)
+		for _, node := range kubernetes.Nodes {
+			if env.NodeTypes == nil {
+				env.NodeTypes = map[string]int{}
+			}
+			env.NodeTypes[nodeType(node)]++
+		}
+	}
+
+	// The versions of the meshes are the tags of the images of their control planes
+	var meshes map[string][]struct {
+		Spec struct {
+			Containers []struct {
+				Image string `json:"image"`
+			} `json:"containers"`
+		} `json:"spec"`
+	}
+	if decodeResultValue(result.Result["detected-meshes"], &meshes) == nil {
+		for mesh, pods := range meshes {
+			version := ""
+			for _, pod := range pods {
+				for _, container := range pod.Spec.Containers {
+					if version = imageTag(container.Image); version != "" {
+						break
+					}
+				}
+				if version != "" {
+					break
+				}
+			}
+			if env.MeshVersions == nil {
+				env.MeshVersions = map[string]string{}
+			}
+			env.MeshVersions[mesh] = version
+		}
+	}
+	return env
+}
+
+// decodeResultValue decodes a value of the runner results into v
+func decodeResultValue(value interface{}, v interface{}) error {
+	if value == nil {
+		return fmt.Errorf("no value")
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// nodeType returns the instance type of the node, else its architecture, CPUs and memory
+func nodeType(node *K8SNode) string {
+	if node.InstanceType != "" {
+		return node.InstanceType
+	}
+	return fmt.Sprintf("%s, %s CPU, %s", node.Architecture, node.CapacityCPU, node.CapacityMemory)
+}
+
+// imageTag returns the tag of the image, none when it is referenced by digest only
+func imageTag(image string) string {
+	image = strings.SplitN(image, "@", 2)[0]
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image[i:], "/") {
+		return ""
+	}
+	return image[i+1:]
+}
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// DashboardState is what a user sees on Meshery UI: the page, the Kubernetes contexts selected,
+// the filters applied and the design open
+type DashboardState struct {
+	// Page is the path of the page of Meshery UI, such as /configuration/patterns
+	Page string `json:"page"`
+	// Contexts are the names of the Kubernetes contexts selected
+	Contexts []string `json:"contexts,omitempty"`
+	// Filters are the filters applied to the page, such as search or order, as query parameters
+	Filters map[string]string `json:"filters,omitempty"`
+	// DesignID and DesignName identify the design open, if any
+	DesignID   string `json:"design_id,omitempty"`
+	DesignName string `json:"design_name,omitempty"`
+}
+
+// Scan reads the state from its JSON in the database
+func (s *DashboardState) Scan(src interface{}) error {
+	var b []byte
+	switch t := src.(type) {
+	case nil:
+		*s = DashboardState{}
+		return nil
+	case []byte:
+		b = t
+	case string:
+		b = []byte(t)
+	default:
+		return fmt.Errorf("scan source was not []byte nor string but %T", src)
+	}
+	if len(b) == 0 {
+		*s = DashboardState{}
+		return nil
+	}
+	return json.Unmarshal(b, s)
+}
+
+// Value stores the state as JSON in the database
+func (s DashboardState) Value() (driver.Value, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Validate checks the page of the state is a path of Meshery UI
+func (s *DashboardState) Validate() error {
+	if s.Page == "" {
+		s.Page = "/"
+	}
+	u, err := url.Parse(s.Page)
+	if err != nil || u.IsAbs() || u.Host != "" || !strings.HasPrefix(u.Path, "/") || u.RawQuery != "" {
+		return fmt.Errorf("invalid page %q, expected a path of Meshery UI such as /configuration/patterns", s.Page)
+	}
+	return nil
+}
+
+// Link returns the path and query of the page of Meshery UI showing the state, the filters in order
+func (s *DashboardState) Link() string {
+	q := url.Values{}
+	for k, v := range s.Filters {
+		q.Set(k, v)
+	}
+	if len(s.Contexts) > 0 {
+		contexts := append([]string{}, s.Contexts...)
+		sort.Strings(contexts)
+		q.Set("contexts", strings.Join(contexts, ","))
+	}
+	if s.DesignID != "" {
+		q.Set("design", s.DesignID)
+	}
+	if len(q) == 0 {
+		return s.Page
+	}
+	return s.Page + "?" + q.Encode()
+}
+
+// DashboardSnapshot is the state of Meshery UI a user captured to share it, such as during an incident
+// for others to look at exactly what they see
+type DashboardSnapshot struct {
+	ID     *uuid.UUID `json:"id,omitempty"`
+	UserID string     `json:"user_id"`
+	// Title describes the snapshot, such as the incident it was captured for
+	Title string         `json:"title,omitempty"`
+	State DashboardState `json:"state" gorm:"type:text"`
+	// Link is the deep link to the page of Meshery UI showing the state, relative to Meshery Server
+	Link string `json:"link" gorm:"-"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// DashboardSnapshotPersister is the persister for persisting
+// the snapshots of Meshery UI on the database
+type DashboardSnapshotPersister struct {
+	DB *database.Handler
+}
+
+// SaveSnapshot persists the snapshot under a new id
+func (dsp *DashboardSnapshotPersister) SaveSnapshot(snapshot *DashboardSnapshot) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ErrGenerateUUID(err)
+	}
+	snapshot.ID = &id
+	if err := dsp.DB.Create(snapshot).Error; err != nil {
+		return err
+	}
+	snapshot.Link = snapshot.State.Link()
+	return nil
+}
+
+// GetSnapshot returns the snapshot with the given id, whoever captured it, snapshots being
+// shared by their id
+func (dsp *DashboardSnapshotPersister) GetSnapshot(id uuid.UUID) (*DashboardSnapshot, error) {
+	snapshot := &DashboardSnapshot{}
+	if err := dsp.DB.Where("id = ?", id).First(snapshot).Error; err != nil {
+		return nil, err
+	}
+	snapshot.Link = snapshot.State.Link()
+	return snapshot, nil
+}
+
+// GetSnapshots returns the snapshots the user captured, the most recent first
+func (dsp *DashboardSnapshotPersister) GetSnapshots(userID string) ([]DashboardSnapshot, error) {
+	snapshots := []DashboardSnapshot{}
+	if err := dsp.DB.Where("user_id = ?", userID).Order("created_at desc").Find(&snapshots).Error; err != nil {
+		return nil, err
+	}
+	for i := range snapshots {
+		snapshots[i].Link = snapshots[i].State.Link()
+	}
+	return snapshots, nil
+}
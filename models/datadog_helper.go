@@ -0,0 +1,118 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultDatadogSite is the Datadog site used when none is configured
+const DefaultDatadogSite = "datadoghq.com"
+
+// DatadogSites are the Datadog sites Meshery sends the API and application keys to
+var DatadogSites = []string{
+	"datadoghq.com",
+	"us3.datadoghq.com",
+	"us5.datadoghq.com",
+	"ap1.datadoghq.com",
+	"datadoghq.eu",
+	"ddog-gov.com",
+}
+
+// DatadogAPIURL returns the URL of the API of the given Datadog site, which is one of
+// DatadogSites, with or without its api. prefix
+func DatadogAPIURL(site string) (string, error) {
+	site = strings.TrimSpace(site)
+	if site == "" {
+		site = DefaultDatadogSite
+	}
+	name := strings.TrimPrefix(strings.ToLower(site), "api.")
+	for _, s := range DatadogSites {
+		if name == s {
+			return "https://api." + s, nil
+		}
+	}
+	return "", ErrDatadogSite(site)
+}
+
+// DatadogClient represents a Datadog metrics client in Meshery
+type DatadogClient struct {
+	httpClient *http.Client
+}
+
+// NewDatadogClient returns a DatadogClient
+func NewDatadogClient() *DatadogClient {
+	return NewDatadogClientWithHTTPClient(&http.Client{})
+}
+
+// NewDatadogClientWithHTTPClient returns a DatadogClient with a given http.Client
+func NewDatadogClientWithHTTPClient(client *http.Client) *DatadogClient {
+	return &DatadogClient{
+		httpClient: client,
+	}
+}
+
+// Validate checks that the API key of the given config is accepted by Datadog
+func (d *DatadogClient) Validate(ctx context.Context, cfg *Datadog) error {
+	if _, err := d.makeRequest(ctx, cfg, "/api/v1/validate", nil); err != nil {
+		return ErrDatadogQuery(err)
+	}
+	return nil
+}
+
+// QueryRange runs a Datadog metrics query over the given time range and returns the raw response
+func (d *DatadogClient) QueryRange(ctx context.Context, cfg *Datadog, query string, start, end time.Time) ([]byte, error) {
+	q := url.Values{}
+	q.Set("query", query)
+	q.Set("from", strconv.FormatInt(start.Unix(), 10))
+	q.Set("to", strconv.FormatInt(end.Unix(), 10))
+
+	data, err := d.makeRequest(ctx, cfg, "/api/v1/query", q)
+	if err != nil {
+		return nil, ErrDatadogQuery(err)
+	}
+	return data, nil
+}
+
+func (d *DatadogClient) makeRequest(ctx context.Context, cfg *Datadog, path string, query url.Values) ([]byte, error) {
+	// the keys are only sent to the API of a Datadog site
+	apiURL, err := DatadogAPIURL(cfg.Site)
+	if err != nil {
+		return nil, err
+	}
+	reqURL := apiURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("DD-API-KEY", cfg.APIKey)
+	if cfg.ApplicationKey != "" {
+		req.Header.Set("DD-APPLICATION-KEY", cfg.ApplicationKey)
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch data from %s due to status code: %d", path, resp.StatusCode)
+	}
+	return data, nil
+}
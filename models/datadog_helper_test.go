@@ -0,0 +1,66 @@
+package models
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// roundTripFunc records the requests of an http.Client in place of sending them
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestDatadogAPIURL(t *testing.T) {
+	tests := []struct {
+		site    string
+		want    string
+		wantErr bool
+	}{
+		{site: "", want: "https://api.datadoghq.com"},
+		{site: "datadoghq.eu", want: "https://api.datadoghq.eu"},
+		{site: " api.us5.datadoghq.com ", want: "https://api.us5.datadoghq.com"},
+		{site: "DDOG-GOV.com", want: "https://api.ddog-gov.com"},
+		{site: "attacker.example.com", wantErr: true},
+		{site: "datadoghq.com.attacker.example.com", wantErr: true},
+		{site: "attacker.example.com/datadoghq.com", wantErr: true},
+		{site: "datadoghq.com@attacker.example.com", wantErr: true},
+		{site: "datadoghq.com:8443", wantErr: true},
+		{site: "localhost", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := DatadogAPIURL(tt.site)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q: got error %v, want an error: %t", tt.site, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.site, got, tt.want)
+		}
+	}
+}
+
+func TestDatadogClientSendsKeysToDatadogOnly(t *testing.T) {
+	var requests []*http.Request
+	client := NewDatadogClientWithHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"valid": true}`))}, nil
+	})})
+
+	if err := client.Validate(context.Background(), &Datadog{Site: "datadoghq.eu", APIKey: "api-key"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 1 || requests[0].URL.String() != "https://api.datadoghq.eu/api/v1/validate" || requests[0].Header.Get("DD-API-KEY") != "api-key" {
+		t.Fatalf("API key was validated with %v, want a request to the API of datadoghq.eu", requests)
+	}
+
+	requests = nil
+	if err := client.Validate(context.Background(), &Datadog{Site: "attacker.example.com", APIKey: "api-key"}); err == nil {
+		t.Error("API key of a site other than the ones of Datadog was validated")
+	}
+	if len(requests) != 0 {
+		t.Errorf("API key was sent to %s", requests[0].URL.Host)
+	}
+}
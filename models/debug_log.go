@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// DebugLogEntry is an entry logged by Meshery Server
+type DebugLogEntry struct {
+	// Seq orders the entries, it increases with every entry logged
+	Seq     uint64            `json:"seq"`
+	Time    time.Time         `json:"time"`
+	Level   string            `json:"level"`
+	Message string            `json:"message"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
+
+// DebugLog holds the entries logged by Meshery Server after a cursor
+type DebugLog struct {
+	Entries []*DebugLogEntry `json:"entries"`
+	// Next is the cursor to pull the entries logged after these ones with
+	Next uint64 `json:"next"`
+}
+
+// DebugLogRecorderInterface keeps the latest entries logged by Meshery Server, debug ones
+// included, for clients to pull them while troubleshooting their requests
+type DebugLogRecorderInterface interface {
+	// After returns the entries logged after the cursor, or at or after the given time when
+	// the cursor is zero
+	After(cursor uint64, since time.Time) *DebugLog
+}
@@ -41,9 +41,11 @@ type DefaultLocalProvider struct {
 	PerformanceProfilesPersister    *PerformanceProfilePersister
 	MesheryPatternPersister         *MesheryPatternPersister
 	MesheryPatternResourcePersister *PatternResourcePersister
+	PatternCollaborationPersister   *PatternCollaborationPersister
 	MesheryApplicationPersister     *MesheryApplicationPersister
 	MesheryFilterPersister          *MesheryFilterPersister
 	MesheryK8sContextPersister      *MesheryK8sContextPersister
+	TrashPersister                  *TrashPersister
 	GenericPersister                database.Handler
 	KubeClient                      *mesherykube.Client
 }
@@ -63,8 +65,11 @@ func (l *DefaultLocalProvider) Initialize() {
 	l.Extensions = Extensions{}
 	l.Capabilities = Capabilities{
 		{Feature: PersistMesheryPatterns},
+		{Feature: ShareMesheryPatterns},
 		{Feature: PersistMesheryApplications},
 		{Feature: PersistMesheryFilters},
+		{Feature: TransferOwnership},
+		{Feature: PersistTrash},
 	}
 }
 
@@ -216,7 +221,7 @@ func (l *DefaultLocalProvider) GetCurrentContext(token string) (K8sContext, erro
 }
 
 // FetchResults - fetches results from provider backend
-func (l *DefaultLocalProvider) FetchResults(tokenVal, page, pageSize, search, order, profileID string) ([]byte, error) {
+func (l *DefaultLocalProvider) FetchResults(tokenVal, page, pageSize, search, order, profileID, owner string) ([]byte, error) {
 	pg, err := strconv.ParseUint(page, 10, 32)
 	if err != nil {
 		return nil, ErrPageNumber(err)
@@ -225,11 +230,11 @@ func (l *DefaultLocalProvider) FetchResults(tokenVal, page, pageSize, search, or
 	if err != nil {
 		return nil, ErrPageSize(err)
 	}
-	return l.ResultPersister.GetResults(pg, pgs, profileID)
+	return l.ResultPersister.GetResults(pg, pgs, profileID, owner, order)
 }
 
 // FetchResults - fetches results from provider backend
-func (l *DefaultLocalProvider) FetchAllResults(tokenString string, page, pageSize, search, order, from, to string) ([]byte, error) {
+func (l *DefaultLocalProvider) FetchAllResults(tokenString string, page, pageSize, search, order, from, to, owner string) ([]byte, error) {
 	if page == "" {
 		page = "0"
 	}
@@ -245,7 +250,7 @@ func (l *DefaultLocalProvider) FetchAllResults(tokenString string, page, pageSiz
 		return nil, ErrPageSize(err)
 	}
 
-	return l.ResultPersister.GetAllResults(pg, pgs)
+	return l.ResultPersister.GetAllResults(pg, pgs, owner)
 }
 
 // GetResult - fetches result from provider backend for the given result id
@@ -534,7 +539,7 @@ func (l *DefaultLocalProvider) SaveMesheryPattern(tokenString string, pattern *M
 }
 
 // GetMesheryPatterns gives the patterns stored with the provider
-func (l *DefaultLocalProvider) GetMesheryPatterns(tokenString, page, pageSize, search, order string) ([]byte, error) {
+func (l *DefaultLocalProvider) GetMesheryPatterns(tokenString, page, pageSize, search, order, owner string) ([]byte, error) {
 	if page == "" {
 		page = "0"
 	}
@@ -552,7 +557,7 @@ func (l *DefaultLocalProvider) GetMesheryPatterns(tokenString, page, pageSize, s
 		return nil, ErrPageSize(err)
 	}
 
-	return l.MesheryPatternPersister.GetMesheryPatterns(search, order, pg, pgs)
+	return l.MesheryPatternPersister.GetMesheryPatterns(search, order, owner, pg, pgs)
 }
 
 // GetMesheryPattern gets pattern for the given patternID
@@ -572,6 +577,37 @@ func (l *DefaultLocalProvider) DeleteMesheryPatterns(req *http.Request, patterns
 	return l.MesheryPatternPersister.DeleteMesheryPatterns(patterns)
 }
 
+// SharePattern shares the pattern with the given id with a user or a team
+func (l *DefaultLocalProvider) SharePattern(req *http.Request, patternID string, share *PatternShare) ([]byte, error) {
+	id, err := uuid.FromString(patternID)
+	if err != nil {
+		return nil, err
+	}
+	share.PatternID = &id
+	return l.PatternCollaborationPersister.SavePatternShare(share)
+}
+
+// GetPatternShares returns the users and teams the pattern with the given id is shared with
+func (l *DefaultLocalProvider) GetPatternShares(req *http.Request, patternID string) ([]byte, error) {
+	id, err := uuid.FromString(patternID)
+	if err != nil {
+		return nil, err
+	}
+	return l.PatternCollaborationPersister.GetPatternShares(id)
+}
+
+// SavePatternReview requests a review on the pattern with the given id, or updates an existing one
+func (l *DefaultLocalProvider) SavePatternReview(req *http.Request, patternID string, review *PatternReview) ([]byte, error) {
+	id := uuid.FromStringOrNil(patternID)
+	review.PatternID = &id
+	return l.PatternCollaborationPersister.SavePatternReview(review)
+}
+
+// GetPatternReviews returns the reviews of the pattern with the given id
+func (l *DefaultLocalProvider) GetPatternReviews(req *http.Request, patternID string) ([]byte, error) {
+	return l.PatternCollaborationPersister.GetPatternReviews(uuid.FromStringOrNil(patternID))
+}
+
 // RemotePatternFile takes in the
 func (l *DefaultLocalProvider) RemotePatternFile(req *http.Request, resourceURL, path string, save bool) ([]byte, error) {
 	parsedURL, err := url.Parse(resourceURL)
@@ -606,6 +642,7 @@ func (l *DefaultLocalProvider) RemotePatternFile(req *http.Request, resourceURL,
 		}
 
 		if save {
+			l.ownPatterns(req, pfs)
 			return l.MesheryPatternPersister.SaveMesheryPatterns(pfs)
 		}
 
@@ -618,12 +655,26 @@ func (l *DefaultLocalProvider) RemotePatternFile(req *http.Request, resourceURL,
 		return nil, err
 	}
 	if save {
+		l.ownPatterns(req, pfs)
 		return l.MesheryPatternPersister.SaveMesheryPatterns(pfs)
 	}
 
 	return json.Marshal(pfs)
 }
 
+// ownPatterns records the user of the request as the owner of the imported patterns
+func (l *DefaultLocalProvider) ownPatterns(req *http.Request, patterns []MesheryPattern) {
+	user, err := l.GetUserDetails(req)
+	if err != nil {
+		return
+	}
+	for i := range patterns {
+		if patterns[i].UserID == nil {
+			patterns[i].UserID = &user.UserID
+		}
+	}
+}
+
 // SaveMesheryFilter saves given filter with the provider
 func (l *DefaultLocalProvider) SaveMesheryFilter(tokenString string, filter *MesheryFilter) ([]byte, error) {
 	return l.MesheryFilterPersister.SaveMesheryFilter(filter)
@@ -834,7 +885,7 @@ func (l *DefaultLocalProvider) SavePerformanceProfile(tokenString string, perfor
 }
 
 // GetPerformanceProfiles gives the performance profiles stored with the provider
-func (l *DefaultLocalProvider) GetPerformanceProfiles(tokenString string, page, pageSize, search, order string) ([]byte, error) {
+func (l *DefaultLocalProvider) GetPerformanceProfiles(tokenString string, page, pageSize, search, order, owner string) ([]byte, error) {
 	if page == "" {
 		page = "0"
 	}
@@ -852,7 +903,71 @@ func (l *DefaultLocalProvider) GetPerformanceProfiles(tokenString string, page,
 		return nil, ErrPageSize(err)
 	}
 
-	return l.PerformanceProfilesPersister.GetPerformanceProfiles("", "", "", pg, pgs)
+	return l.PerformanceProfilesPersister.GetPerformanceProfiles("", "", "", owner, pg, pgs)
+}
+
+// TransferOwnership transfers the ownership of the designs, performance profiles and results
+// selected by the transfer
+func (l *DefaultLocalProvider) TransferOwnership(tokenString string, transfer *OwnershipTransfer) (*OwnershipTransferResult, error) {
+	return transferOwnership(l.GetGenericPersister(), transfer)
+}
+
+// GetTrash returns the designs or performance profiles in the trash, both if kind is empty
+func (l *DefaultLocalProvider) GetTrash(tokenString, kind string) ([]byte, error) {
+	page, err := l.TrashPersister.GetTrash(kind)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(page)
+}
+
+// RestoreFromTrash restores the design or performance profile of the given id from the trash
+func (l *DefaultLocalProvider) RestoreFromTrash(tokenString, kind, id string) ([]byte, error) {
+	uid, err := uuid.FromString(id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := l.TrashPersister.Restore(kind, uid)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(item)
+}
+
+// GetTelemetryReport returns the data sent by Meshery with the preferences of the user, the results
+// and metrics of the performance tests being shipped anonymously to the provider base URL
+func (l *DefaultLocalProvider) GetTelemetryReport(user *User, pref *Preference) (*TelemetryReport, error) {
+	latest, err := l.ResultPersister.GetLatestResult(user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	result, metrics := anonymousResultSamples(latest)
+	return &TelemetryReport{
+		Provider: l.Name(),
+		Categories: []*TelemetryCategory{
+			usageStatisticsCategory(pref),
+			{
+				Name:        TelemetryPerformanceResults,
+				Preference:  "anonymousPerfResults",
+				Enabled:     pref.AnonymousPerfResults,
+				Anonymous:   true,
+				Sender:      "Meshery Server",
+				Destination: l.ProviderBaseURL + "/result",
+				Description: "The result of every performance test, with its latencies, throughput and load generator options, sent once the test completes.",
+				Sample:      result,
+			},
+			{
+				Name:        TelemetryPerformanceMetrics,
+				Preference:  "anonymousPerfResults",
+				Enabled:     pref.AnonymousPerfResults,
+				Anonymous:   true,
+				Sender:      "Meshery Server",
+				Destination: l.ProviderBaseURL + "/result/metrics",
+				Description: "The metrics of the service mesh queried from Prometheus, Datadog or New Relic over every performance test, sent once the test completes if a metrics source is configured.",
+				Sample:      metrics,
+			},
+		},
+	}, nil
 }
 
 // GetPerformanceProfile gets performance profile for the given performance profileID
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// DesignEvent is a Kubernetes event of a resource deployed for a design, or of one of its pods
+type DesignEvent struct {
+	// Component is the service of the design the resource was deployed for
+	Component string `json:"component"`
+	// Object is the resource the event is about, as kind/name
+	Object    string    `json:"object"`
+	Namespace string    `json:"namespace"`
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+	Count     int32     `json:"count"`
+	LastSeen  time.Time `json:"last_seen"`
+}
@@ -0,0 +1,39 @@
+package models
+
+// DesignExec is the container of a component of a design the commands are run in
+type DesignExec struct {
+	// Component is the service of the design the command is run in
+	Component string `json:"component"`
+	// Object is the resource the pod was selected through, as kind/name
+	Object    string `json:"object"`
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+}
+
+// Channels of the messages exchanged over the WebSocket of a command run in a design, given by
+// their first byte, the rest of the message being the data of the channel
+const (
+	// DesignExecStdin carries the input of the command, an empty message closing it
+	DesignExecStdin byte = iota
+	// DesignExecStdout carries the output of the command, along with its error output on a TTY
+	DesignExecStdout
+	// DesignExecStderr carries the error output of the command
+	DesignExecStderr
+	// DesignExecStatus carries the DesignExecResult of the command once it ended
+	DesignExecStatus
+	// DesignExecResize carries the DesignExecSize of the terminal whenever it changes
+	DesignExecResize
+)
+
+// DesignExecResult is the outcome of a command run in a design
+type DesignExecResult struct {
+	ExitCode int    `json:"exit_code"`
+	Error    string `json:"error,omitempty"`
+}
+
+// DesignExecSize is the size of the terminal a command run in a design with a TTY outputs to
+type DesignExecSize struct {
+	Width  uint16 `json:"width"`
+	Height uint16 `json:"height"`
+}
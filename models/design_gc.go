@@ -0,0 +1,25 @@
+package models
+
+// OrphanedResource is a resource of a Kubernetes cluster labeled as applied by Meshery Server for a
+// design which no longer exists
+type OrphanedResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	// DesignID is the ID of the deleted design, empty for the designs deployed without being saved
+	DesignID   string `json:"design_id,omitempty"`
+	DesignName string `json:"design_name,omitempty"`
+	// Error is why the resource could not be deleted, if it could not
+	Error string `json:"error,omitempty"`
+}
+
+// DesignGCResult is the garbage collection of the resources of a Kubernetes context left behind by
+// the designs which no longer exist
+type DesignGCResult struct {
+	ContextID   string             `json:"context_id"`
+	ContextName string             `json:"context_name"`
+	Orphans     []OrphanedResource `json:"orphans"`
+	// Deleted tells whether the orphans were deleted or only listed
+	Deleted bool `json:"deleted"`
+}
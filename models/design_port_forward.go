@@ -0,0 +1,12 @@
+package models
+
+// DesignPortForward is the pod port the connections to a component of a design are forwarded to
+type DesignPortForward struct {
+	// Component is the service of the design the connections are forwarded to
+	Component string `json:"component"`
+	// Object is the resource the pod was selected through, as kind/name
+	Object    string `json:"object"`
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Port      int32  `json:"port"`
+}
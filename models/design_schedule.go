@@ -0,0 +1,128 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// DesignScheduleOperation is the operation a schedule runs on its design
+type DesignScheduleOperation string
+
+// Operations of the design schedules
+const (
+	DesignScheduleDeploy   DesignScheduleOperation = "deploy"
+	DesignScheduleUndeploy DesignScheduleOperation = "undeploy"
+)
+
+// Statuses of the last run of a design schedule
+const (
+	DesignScheduleSucceeded = "succeeded"
+	DesignScheduleFailed    = "failed"
+)
+
+// DesignSchedule deploys or undeploys a design once at a given time, or recurringly following a cron
+// expression evaluated in UTC. The design is run as it was when scheduled, against the Kubernetes
+// context which was current then, by the scheduler of Meshery Server on behalf of the user.
+type DesignSchedule struct {
+	ID *uuid.UUID `json:"id,omitempty"`
+
+	UserID      string                  `json:"-" gorm:"index"`
+	PatternID   string                  `json:"pattern_id" gorm:"index"`
+	PatternName string                  `json:"pattern_name"`
+	Operation   DesignScheduleOperation `json:"operation"`
+	// KeepNamespace leaves the namespaces of the design in place on undeployment
+	KeepNamespace bool `json:"keep_namespace,omitempty"`
+
+	// RunAt is the time of a schedule running once and Cron the expression of a recurring one
+	RunAt *time.Time `json:"run_at,omitempty"`
+	Cron  string     `json:"cron,omitempty"`
+
+	K8sContextID   string `json:"k8s_context_id"`
+	K8sContextName string `json:"k8s_context_name,omitempty"`
+
+	// NextRunAt is when the schedule runs next, nil once a schedule running once has run
+	NextRunAt   *time.Time `json:"next_run_at,omitempty" gorm:"index"`
+	LastRunAt   *time.Time `json:"last_run_at,omitempty"`
+	LastStatus  string     `json:"last_status,omitempty"`
+	LastMessage string     `json:"last_message,omitempty"`
+
+	// PatternFile is the design as it was when scheduled, and Provider and Token the session
+	// of the user the operation is run with
+	PatternFile string `json:"-"`
+	Provider    string `json:"-"`
+	Token       string `json:"-"`
+
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// Validate checks the operation of the schedule and that it has either a time to run at or a cron expression
+func (s *DesignSchedule) Validate() error {
+	switch s.Operation {
+	case DesignScheduleDeploy, DesignScheduleUndeploy:
+	default:
+		return fmt.Errorf("invalid operation %q, expected %s or %s", s.Operation, DesignScheduleDeploy, DesignScheduleUndeploy)
+	}
+	if (s.RunAt == nil) == (s.Cron == "") {
+		return fmt.Errorf("either a time to run at or a cron expression is required")
+	}
+	return nil
+}
+
+// DesignSchedulePersister is the persister for persisting
+// the design schedules of the users on the database
+type DesignSchedulePersister struct {
+	DB *database.Handler
+}
+
+// SaveSchedule creates the schedule
+func (dsp *DesignSchedulePersister) SaveSchedule(schedule *DesignSchedule) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ErrGenerateUUID(err)
+	}
+	schedule.ID = &id
+	return dsp.DB.Create(schedule).Error
+}
+
+// GetSchedules returns the schedules of the user, only the ones of the design when patternID is given,
+// the next to run first
+func (dsp *DesignSchedulePersister) GetSchedules(userID, patternID string) ([]DesignSchedule, error) {
+	schedules := []DesignSchedule{}
+	query := dsp.DB.Where("user_id = ?", userID)
+	if patternID != "" {
+		query = query.Where("pattern_id = ?", patternID)
+	}
+	if err := query.Order("next_run_at IS NULL, next_run_at, created_at").Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// DeleteSchedule deletes the schedule of the user, reporting whether it existed
+func (dsp *DesignSchedulePersister) DeleteSchedule(userID, id string) (bool, error) {
+	result := dsp.DB.Where("user_id = ? AND id = ?", userID, id).Delete(&DesignSchedule{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetDueSchedules returns the schedules due to run at the given time
+func (dsp *DesignSchedulePersister) GetDueSchedules(now time.Time) ([]DesignSchedule, error) {
+	schedules := []DesignSchedule{}
+	if err := dsp.DB.Where("next_run_at IS NOT NULL AND next_run_at <= ?", now).Order("next_run_at").Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// RecordRun records the outcome of the last run of the schedule along with its next run
+func (dsp *DesignSchedulePersister) RecordRun(schedule *DesignSchedule) error {
+	return dsp.DB.Model(&DesignSchedule{}).Where("id = ?", schedule.ID).Updates(map[string]interface{}{
+		"next_run_at":  schedule.NextRunAt,
+		"last_run_at":  schedule.LastRunAt,
+		"last_status":  schedule.LastStatus,
+		"last_message": schedule.LastMessage,
+	}).Error
+}
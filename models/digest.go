@@ -0,0 +1,134 @@
+package models
+
+import (
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm/clause"
+)
+
+// DigestFrequency tells how often a user receives the digest
+type DigestFrequency string
+
+// Frequencies of the digest
+const (
+	DigestOff    DigestFrequency = "off"
+	DigestDaily  DigestFrequency = "daily"
+	DigestWeekly DigestFrequency = "weekly"
+)
+
+// Period returns the time covered by a digest of the frequency, zero when the digest is off
+func (f DigestFrequency) Period() time.Duration {
+	switch f {
+	case DigestDaily:
+		return 24 * time.Hour
+	case DigestWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// DigestSubscription is the digest preference of a user: the address the digest is emailed to and how often
+type DigestSubscription struct {
+	UserID    string          `json:"-" gorm:"primaryKey"`
+	Email     string          `json:"email"`
+	Frequency DigestFrequency `json:"frequency"`
+	// LastSentAt is the end of the period covered by the last digest sent
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+	UpdatedAt  time.Time  `json:"updated_at,omitempty"`
+}
+
+// Validate checks the frequency and, unless the digest is off, the email address of the subscription
+func (s *DigestSubscription) Validate() error {
+	switch s.Frequency {
+	case DigestOff:
+		return nil
+	case DigestDaily, DigestWeekly:
+	default:
+		return fmt.Errorf("invalid frequency %q, expected one of %s, %s or %s", s.Frequency, DigestDaily, DigestWeekly, DigestOff)
+	}
+	if _, err := mail.ParseAddress(s.Email); err != nil {
+		return fmt.Errorf("invalid email address %q: %s", s.Email, err)
+	}
+	return nil
+}
+
+// Due tells if the digest of the subscription is due at the given time, a period after the
+// last digest or, for the first one, after the subscription
+func (s *DigestSubscription) Due(now time.Time) bool {
+	period := s.Frequency.Period()
+	if period == 0 {
+		return false
+	}
+	last := s.UpdatedAt
+	if s.LastSentAt != nil {
+		last = *s.LastSentAt
+	}
+	return !now.Before(last.Add(period))
+}
+
+// Digest summarizes what happened in Meshery during a period: the performance results, the ones
+// deviating from the history of their profile and the warnings and errors the user did not acknowledge
+type Digest struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	Results   []ResultAnalysis `json:"results"`
+	Anomalies []ResultAnalysis `json:"anomalies"`
+	Events    []UserEvent      `json:"events"`
+}
+
+// Empty tells if nothing happened during the period of the digest
+func (d *Digest) Empty() bool {
+	return len(d.Results) == 0 && len(d.Events) == 0
+}
+
+// DigestSenderInterface defines the methods a type should implement to send digests
+type DigestSenderInterface interface {
+	// SendDigest sends the digest of the period ending at the given time to the subscriber
+	SendDigest(subscription *DigestSubscription, now time.Time) (*Digest, error)
+}
+
+// DigestSubscriptionPersister is the persister for persisting
+// the digest subscriptions of the users on the database
+type DigestSubscriptionPersister struct {
+	DB *database.Handler
+}
+
+// GetSubscription returns the subscription of the user, the digest being off when the user has none
+func (dsp *DigestSubscriptionPersister) GetSubscription(userID string) (*DigestSubscription, error) {
+	subscriptions := []DigestSubscription{}
+	if err := dsp.DB.Where("user_id = ?", userID).Limit(1).Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	if len(subscriptions) == 0 {
+		return &DigestSubscription{UserID: userID, Frequency: DigestOff}, nil
+	}
+	return &subscriptions[0], nil
+}
+
+// SaveSubscription creates or replaces the subscription of the user, keeping the time the last digest was sent
+func (dsp *DigestSubscriptionPersister) SaveSubscription(subscription *DigestSubscription) error {
+	subscription.UpdatedAt = time.Now()
+	return dsp.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"email", "frequency", "updated_at"}),
+	}).Omit("last_sent_at").Create(subscription).Error
+}
+
+// GetActiveSubscriptions returns the subscriptions whose digest is not off
+func (dsp *DigestSubscriptionPersister) GetActiveSubscriptions() ([]DigestSubscription, error) {
+	subscriptions := []DigestSubscription{}
+	if err := dsp.DB.Where("frequency <> ?", DigestOff).Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// MarkSent records the end of the period covered by the digest sent to the user
+func (dsp *DigestSubscriptionPersister) MarkSent(userID string, at time.Time) error {
+	return dsp.DB.Model(&DigestSubscription{}).Where("user_id = ?", userID).Update("last_sent_at", at).Error
+}
@@ -0,0 +1,149 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// DeploymentEnvironment represents a Kubernetes context designs are deployed to, such as
+// "staging" or "prod", and the conditions for a design to be promoted to it
+type DeploymentEnvironment struct {
+	ID *uuid.UUID `json:"id,omitempty"`
+
+	Name         string `json:"name,omitempty" gorm:"uniqueIndex"`
+	K8sContextID string `json:"k8s_context_id,omitempty"`
+	// PromotesFrom is the environment designs are promoted from by default, e.g. "staging" for "prod"
+	PromotesFrom string `json:"promotes_from,omitempty"`
+	// RequiredApprovals is the number of approved reviews a design needs to be promoted to the environment
+	RequiredApprovals int `json:"required_approvals"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// PatternDeployment records the deployment of a design to an environment, providing
+// the provenance of the designs running in every environment
+type PatternDeployment struct {
+	ID        *uuid.UUID `json:"id,omitempty"`
+	PatternID *uuid.UUID `json:"pattern_id,omitempty" gorm:"index"`
+
+	PatternName string `json:"pattern_name,omitempty"`
+	Environment string `json:"environment,omitempty"`
+	// PatternSHA is the sha256 checksum of the pattern file which was deployed
+	PatternSHA string `json:"pattern_sha,omitempty"`
+	DeployedBy string `json:"deployed_by,omitempty"`
+	// PromotedFrom is the id of the deployment the design was promoted from, if any
+	PromotedFrom      *uuid.UUID `json:"promoted_from,omitempty"`
+	SourceEnvironment string     `json:"source_environment,omitempty"`
+	// ApprovedBy lists the reviewers who approved the design for the promotion, comma separated
+	ApprovedBy string `json:"approved_by,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// PatternPromotionRequest is the request to promote a design from an environment to another
+type PatternPromotionRequest struct {
+	// From defaults to the environment the target environment promotes from
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+}
+
+// PatternDeploymentAPIResponse is the response for the deployment or the promotion of a design to an environment
+type PatternDeploymentAPIResponse struct {
+	Deployment PatternDeployment `json:"deployment"`
+	// Message holds the logs of the deployment
+	Message string `json:"message"`
+}
+
+// Validate checks whether the environment can be persisted
+func (e *DeploymentEnvironment) Validate() error {
+	e.Name = strings.TrimSpace(e.Name)
+	if e.Name == "" || e.K8sContextID == "" || e.RequiredApprovals < 0 || e.PromotesFrom == e.Name {
+		return ErrInvalidEnvironment
+	}
+	return nil
+}
+
+// PatternFileSHA returns the checksum identifying the given pattern file in the provenance records
+func PatternFileSHA(patternFile string) string {
+	sum := sha256.Sum256([]byte(patternFile))
+	return hex.EncodeToString(sum[:])
+}
+
+// EnvironmentPersister is the persister for persisting
+// the environments and the deployments of designs to them on the database
+type EnvironmentPersister struct {
+	DB *database.Handler
+}
+
+// SaveEnvironment persists the given environment, replacing the one with the same name if any
+func (ep *EnvironmentPersister) SaveEnvironment(env *DeploymentEnvironment) error {
+	existing := DeploymentEnvironment{}
+	if err := ep.DB.Where("name = ?", env.Name).First(&existing).Error; err == nil {
+		env.ID = existing.ID
+		env.CreatedAt = existing.CreatedAt
+	} else {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		env.ID = &id
+	}
+	return ep.DB.Save(env).Error
+}
+
+// GetEnvironment returns the environment with the given name
+func (ep *EnvironmentPersister) GetEnvironment(name string) (*DeploymentEnvironment, error) {
+	env := &DeploymentEnvironment{}
+	if err := ep.DB.Where("name = ?", name).First(env).Error; err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// GetEnvironments returns all the environments
+func (ep *EnvironmentPersister) GetEnvironments() ([]DeploymentEnvironment, error) {
+	envs := []DeploymentEnvironment{}
+	if err := ep.DB.Order("name").Find(&envs).Error; err != nil {
+		return nil, err
+	}
+	return envs, nil
+}
+
+// DeleteEnvironment deletes the environment with the given name, keeping the deployments made to it
+func (ep *EnvironmentPersister) DeleteEnvironment(name string) error {
+	return ep.DB.Where("name = ?", name).Delete(&DeploymentEnvironment{}).Error
+}
+
+// SavePatternDeployment persists the given deployment record
+func (ep *EnvironmentPersister) SavePatternDeployment(deployment *PatternDeployment) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ErrGenerateUUID(err)
+	}
+	deployment.ID = &id
+	return ep.DB.Create(deployment).Error
+}
+
+// GetPatternDeployments returns the deployments of the pattern with the given id, the latest first
+func (ep *EnvironmentPersister) GetPatternDeployments(patternID uuid.UUID) ([]PatternDeployment, error) {
+	deployments := []PatternDeployment{}
+	if err := ep.DB.Where("pattern_id = ?", patternID).Order("created_at desc").Find(&deployments).Error; err != nil {
+		return nil, err
+	}
+	return deployments, nil
+}
+
+// GetLatestPatternDeployment returns the latest deployment of the pattern with the given id to an environment
+func (ep *EnvironmentPersister) GetLatestPatternDeployment(patternID uuid.UUID, environment string) (*PatternDeployment, error) {
+	deployment := &PatternDeployment{}
+	if err := ep.DB.Where("pattern_id = ? AND environment = ?", patternID, environment).Order("created_at desc").First(deployment).Error; err != nil {
+		return nil, err
+	}
+	return deployment, nil
+}
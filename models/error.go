@@ -3,6 +3,7 @@ package models
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/layer5io/meshkit/errors"
@@ -81,6 +82,25 @@ const (
 	ErrContextIDCode                   = "2155"
 	ErrMesheryInstanceIDCode           = "2156"
 	ErrMesheryNotInClusterCode         = "2157"
+	ErrInvalidPatternShareCode         = "2178"
+	ErrInvalidPatternReviewCode        = "2179"
+	ErrDatadogQueryCode                = "2184"
+	ErrNewRelicQueryCode               = "2185"
+	ErrGrafanaSnapshotCode             = "2198"
+	ErrInvalidEnvironmentCode          = "2203"
+	ErrInvalidWorkloadIdentityCode     = "2208"
+	ErrExecPluginCode                  = "2216"
+	ErrExchangeExecCredentialCode      = "2217"
+	ErrUnsupportedAuthProviderCode     = "2218"
+	ErrSchemaMigrationCode             = "2272"
+	ErrInvalidSchemaVersionCode        = "2273"
+	ErrJobNotFoundCode                 = "2274"
+	ErrCancelJobCode                   = "2275"
+	ErrPatternReviewNotAllowedCode     = "2276"
+	ErrSelfPatternReviewCode           = "2277"
+	ErrTokenCipherCode                 = "2278"
+	ErrDuplicateWorkloadIdentityCode   = "2279"
+	ErrDatadogSiteCode                 = "2284"
 )
 
 var (
@@ -90,6 +110,10 @@ var (
 	ErrApplicationFileName  = errors.New(ErrApplicationFileNameCode, errors.Alert, []string{"Invalid Applicationfile"}, []string{"Name field is either not present or is not valid"}, []string{}, []string{})
 	ErrFilterFileName       = errors.New(ErrFilterFileNameCode, errors.Alert, []string{"Invalid Filterfile"}, []string{"Name field is either not present or is not valid"}, []string{}, []string{})
 	ErrPatternFileName      = errors.New(ErrPatternFileNameCode, errors.Alert, []string{"Invalid Patternfile"}, []string{"Name field is either not present or is not valid"}, []string{}, []string{})
+	ErrInvalidPatternShare  = errors.New(ErrInvalidPatternShareCode, errors.Alert, []string{"Invalid pattern share"}, []string{"A pattern can only be shared with a user or a team, with either view or edit permission"}, []string{}, []string{})
+	ErrSelfPatternReview    = errors.New(ErrSelfPatternReviewCode, errors.Alert, []string{"Invalid pattern review"}, []string{"A review cannot be requested from the user requesting it"}, []string{}, []string{"Request the review from another user"})
+	ErrInvalidEnvironment   = errors.New(ErrInvalidEnvironmentCode, errors.Alert, []string{"Invalid environment"}, []string{"An environment needs a name and the ID of the Kubernetes context its designs are deployed to"}, []string{}, []string{})
+	ErrWorkloadIdentity     = errors.New(ErrInvalidWorkloadIdentityCode, errors.Alert, []string{"Invalid workload identity"}, []string{"A workload identity needs the ID of a Kubernetes context along with the namespace and name of a ServiceAccount"}, []string{}, []string{})
 	ErrUserID               = errors.New(ErrUserIDCode, errors.Alert, []string{"User ID is empty"}, []string{}, []string{}, []string{})
 	ErrDBConnection         = errors.New(ErrDBConnectionCode, errors.Alert, []string{"Connection to DataBase does not exist"}, []string{}, []string{}, []string{})
 	ErrNilConfigData        = errors.New(ErrNilConfigDataCode, errors.Alert, []string{"Given config data is nil"}, []string{}, []string{}, []string{})
@@ -198,6 +222,10 @@ func ErrGrafanaDataSource(err error, ds string) error {
 	return errors.New(ErrGrafanaDataSourceCode, errors.Alert, []string{"Error getting Grafana Board's Datasource", ds}, []string{err.Error()}, []string{}, []string{})
 }
 
+func ErrGrafanaSnapshot(err error, UID string) error {
+	return errors.New(ErrGrafanaSnapshotCode, errors.Alert, []string{"Unable to create a snapshot of the Grafana board", UID}, []string{err.Error()}, []string{"The Grafana API key is not allowed to create snapshots", "Snapshots are disabled in Grafana"}, []string{"Make sure the API key has the Editor role and snapshots are enabled in Grafana"})
+}
+
 func ErrGrafanaData(err error, apiEndpoint string) error {
 	return errors.New(ErrGrafanaDataCode, errors.Alert, []string{"Error getting data from Grafana API", apiEndpoint}, []string{err.Error()}, []string{}, []string{})
 }
@@ -301,3 +329,59 @@ func ErrSavingSeededComponents(err error, content string) error {
 func ErrDownloadingSeededComponents(err error, content string) error {
 	return errors.New(ErrDownloadingSeededComponentsCode, errors.Alert, []string{"Could not download seed content for" + content}, []string{err.Error()}, []string{"The content is not present at the specified url endpoint", "HTTP requests failed"}, []string{"Make sure the content is available at the endpoints", "Make sure that Github is reachable and the http requests are not failing"})
 }
+
+func ErrInvalidPatternReviewTransition(from, to PatternReviewStatus) error {
+	return errors.New(ErrInvalidPatternReviewCode, errors.Alert, []string{"Invalid pattern review status"}, []string{fmt.Sprintf("a review cannot move from %q to %q", from, to)}, []string{}, []string{"Pending reviews can be approved or have changes requested, and can be re-requested once changes are made"})
+}
+
+func ErrDatadogQuery(err error) error {
+	return errors.New(ErrDatadogQueryCode, errors.Alert, []string{"Unable to query Datadog"}, []string{err.Error()}, []string{"Invalid Datadog site, API key or application key", "Datadog query is invalid"}, []string{"Update your Datadog site and keys from the settings page in the UI", "Check if your Datadog query is correct"})
+}
+
+func ErrDatadogSite(site string) error {
+	return errors.New(ErrDatadogSiteCode, errors.Alert, []string{"Unknown Datadog site"}, []string{fmt.Sprintf("%s is not a Datadog site, the API and application keys are only sent to %s", site, strings.Join(DatadogSites, ", "))}, []string{"The Datadog site is misspelled or is not one of the sites of Datadog"}, []string{"Use the site of your Datadog account, such as datadoghq.com or datadoghq.eu"})
+}
+
+func ErrNewRelicQuery(err error) error {
+	return errors.New(ErrNewRelicQueryCode, errors.Alert, []string{"Unable to query New Relic"}, []string{err.Error()}, []string{"Invalid New Relic region, account ID or API key", "NRQL query is invalid"}, []string{"Update your New Relic account and API key from the settings page in the UI", "Check if your NRQL query is correct"})
+}
+
+func ErrExecPlugin(contextName, command, reason string) error {
+	return errors.New(ErrExecPluginCode, errors.Alert, []string{"Unable to use the credential plugin", command, "of context", contextName}, []string{reason}, []string{"The user of the context authenticates with an exec credential plugin, such as aws eks get-token or gke-gcloud-auth-plugin, which Meshery Server is not allowed to or cannot run"}, []string{"Generate a kubeconfig authenticating with the token of a ServiceAccount, e.g. with mesheryctl system config eks|gke|aks, and upload it instead", "Set KUBECONFIG_EXEC_PLUGINS to exchange for Meshery Server to run the plugin once and create a ServiceAccount whose expiring token replaces it", "Set KUBECONFIG_EXEC_PLUGINS to allow and install the plugin where Meshery Server runs for Meshery Server to run it whenever it connects to the cluster", "Add the plugin to KUBECONFIG_EXEC_COMMANDS when it is trusted", "Remove the env of the plugin, and the arguments other than those choosing the cluster, from the kubeconfig"})
+}
+
+func ErrExchangeExecCredential(contextName string, err error) error {
+	return errors.New(ErrExchangeExecCredentialCode, errors.Alert, []string{"Unable to exchange the credentials of the plugin of context", contextName, "for a ServiceAccount token"}, []string{err.Error()}, []string{"The credential plugin failed, e.g. because it is not logged in where Meshery Server runs", "The user of the context is not allowed to create ServiceAccounts, their tokens and ClusterRoleBindings", "The user of the context is not allowed to bind the ClusterRole of KUBECONFIG_EXEC_CLUSTER_ROLE"}, []string{"Log the plugin in where Meshery Server runs", "Generate a kubeconfig authenticating with the token of a ServiceAccount, e.g. with mesheryctl system config eks|gke|aks, and upload it instead"})
+}
+
+func ErrUnsupportedAuthProvider(contextName, provider string) error {
+	return errors.New(ErrUnsupportedAuthProviderCode, errors.Alert, []string{"Unsupported auth provider", provider, "of context", contextName}, []string{"Meshery Server supports the gcp and oidc auth providers of kubeconfig users"}, []string{"The user of the context authenticates with a deprecated auth provider"}, []string{"Use an exec credential plugin, such as kubelogin, instead of the auth provider", "Generate a kubeconfig authenticating with the token of a ServiceAccount and upload it instead"})
+}
+
+func ErrSchemaMigration(err error) error {
+	return errors.New(ErrSchemaMigrationCode, errors.Alert, []string{"Unable to migrate the schema of the database"}, []string{err.Error()}, []string{"The database of Meshery Server is not writable", "A migration is incompatible with the data of the database"}, []string{"Check the database of Meshery Server and the migrations applied with `mesheryctl system db status`", "Roll back the migrations applied last with `mesheryctl system db rollback`"})
+}
+
+func ErrInvalidSchemaVersion(err error) error {
+	return errors.New(ErrInvalidSchemaVersionCode, errors.Alert, []string{"Invalid version of the schema of the database"}, []string{err.Error()}, []string{"The version is not that of a migration of Meshery Server, or the migrations to run to reach it cannot be"}, []string{"List the migrations and the version of the schema with `mesheryctl system db status`"})
+}
+
+func ErrJobNotFound(id string) error {
+	return errors.New(ErrJobNotFoundCode, errors.Alert, []string{"No background job " + id}, []string{"Meshery Server runs no job of the ID " + id + " and has none in its history"}, []string{"The ID is wrong", "The job completed long enough ago to be out of the history of the server, or before the server restarted"}, []string{"List the jobs with `mesheryctl exp jobs list`"})
+}
+
+func ErrCancelJob(err error) error {
+	return errors.New(ErrCancelJobCode, errors.Alert, []string{"Unable to cancel the background job"}, []string{err.Error()}, []string{"The job already completed", "The job cannot be cancelled once started"}, []string{"List the jobs which can be cancelled with `mesheryctl exp jobs list --status running`"})
+}
+
+func ErrTokenCipher(err error) error {
+	return errors.New(ErrTokenCipherCode, errors.Alert, []string{"Unable to encrypt or decrypt the provider token"}, []string{err.Error()}, []string{"Key of the provider tokens is not 32 bytes long or is not base64 encoded", "Key of the provider tokens changed since the token was encrypted"}, []string{"Set WORKLOAD_IDENTITY_KEY to the base64 encoding of a 32 bytes key or remove it to use the key file", "Recreate the workload identities encrypted with the previous key"})
+}
+
+func ErrDuplicateWorkloadIdentity(namespace, serviceAccount string) error {
+	return errors.New(ErrDuplicateWorkloadIdentityCode, errors.Alert, []string{"Duplicate workload identity"}, []string{fmt.Sprintf("the ServiceAccount %s/%s of the Kubernetes context is already bound to a workload identity", namespace, serviceAccount)}, []string{"A ServiceAccount can act on behalf of a single user only"}, []string{"Delete the existing workload identity of the ServiceAccount first"})
+}
+
+func ErrPatternReviewNotAllowed(userID string, status PatternReviewStatus) error {
+	return errors.New(ErrPatternReviewNotAllowedCode, errors.Alert, []string{"Pattern review not allowed"}, []string{fmt.Sprintf("user %q cannot move the review to %q", userID, status)}, []string{"Only the reviewer approves a pattern or requests changes on it", "Only the user who requested the review re-requests it"}, []string{"Ask the reviewer to submit the review"})
+}
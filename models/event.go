@@ -0,0 +1,346 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// EventSeverity tells how much attention an event needs
+type EventSeverity string
+
+// Severities of the events
+const (
+	EventSeverityInfo    EventSeverity = "info"
+	EventSeverityWarning EventSeverity = "warning"
+	EventSeverityError   EventSeverity = "error"
+)
+
+// EventSeverities are the valid severities, the least severe first
+var EventSeverities = []EventSeverity{EventSeverityInfo, EventSeverityWarning, EventSeverityError}
+
+// EventSeverityFromType returns the severity of an event type reported by the adapters
+func EventSeverityFromType(t meshes.EventType) EventSeverity {
+	switch t {
+	case meshes.EventType_WARN:
+		return EventSeverityWarning
+	case meshes.EventType_ERROR:
+		return EventSeverityError
+	default:
+		return EventSeverityInfo
+	}
+}
+
+// EventType returns the event type of the severity, the way the adapters report it
+func (s EventSeverity) EventType() meshes.EventType {
+	switch s {
+	case EventSeverityWarning:
+		return meshes.EventType_WARN
+	case EventSeverityError:
+		return meshes.EventType_ERROR
+	default:
+		return meshes.EventType_INFO
+	}
+}
+
+// Valid tells if the severity is one of EventSeverities
+func (s EventSeverity) Valid() bool {
+	for _, severity := range EventSeverities {
+		if s == severity {
+			return true
+		}
+	}
+	return false
+}
+
+// EventCategory tells which part of Meshery an event comes from
+type EventCategory string
+
+// Categories of the events
+const (
+	// EventCategoryAdapter is for the events streamed by the adapters, e.g. the outcome of operations
+	EventCategoryAdapter EventCategory = "adapter"
+	// EventCategoryHealth is for the changes of the availability of the adapters
+	EventCategoryHealth EventCategory = "health"
+	// EventCategoryPerformance is for the performance results deviating from their profile
+	EventCategoryPerformance EventCategory = "performance"
+	// EventCategoryDesign is for the outcome of the scheduled deployments and undeployments of designs,
+	// and for auditing the commands run in their components
+	EventCategoryDesign EventCategory = "design"
+	// EventCategoryConfig is for the changes of the configuration of Meshery Server while it runs
+	EventCategoryConfig EventCategory = "config"
+	// EventCategoryKubernetes is for the requests to the Kubernetes API of the connections being throttled
+	EventCategoryKubernetes EventCategory = "kubernetes"
+)
+
+// EventCategories are the valid categories
+var EventCategories = []EventCategory{EventCategoryAdapter, EventCategoryHealth, EventCategoryPerformance, EventCategoryDesign, EventCategoryConfig, EventCategoryKubernetes}
+
+// Valid tells if the category is one of EventCategories
+func (c EventCategory) Valid() bool {
+	for _, category := range EventCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is an event recorded by Meshery Server. Events are shared by every user, who each
+// acknowledge and resolve them on their own.
+type Event struct {
+	ID *uuid.UUID `json:"id,omitempty"`
+
+	Severity EventSeverity `json:"severity" gorm:"index"`
+	Category EventCategory `json:"category" gorm:"index"`
+	// EventType is the severity the way the adapters report it, kept for the clients of the event stream
+	EventType   meshes.EventType `json:"event_type"`
+	Summary     string           `json:"summary"`
+	Details     string           `json:"details,omitempty"`
+	OperationID string           `json:"operation_id,omitempty"`
+	// Source is what reported the event, e.g. the location of an adapter
+	Source string `json:"source,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty" gorm:"index"`
+}
+
+// NewEvent returns the event of the given category for an event reported the way the adapters do
+func NewEvent(category EventCategory, source string, e *meshes.EventsResponse) *Event {
+	return &Event{
+		Severity:    EventSeverityFromType(e.EventType),
+		Category:    category,
+		EventType:   e.EventType,
+		Summary:     e.Summary,
+		Details:     e.Details,
+		OperationID: e.OperationId,
+		Source:      source,
+	}
+}
+
+// EventStatus is the state of an event for a user. Resolving an event acknowledges it.
+type EventStatus struct {
+	EventID uuid.UUID `json:"event_id" gorm:"primaryKey"`
+	UserID  string    `json:"user_id" gorm:"primaryKey"`
+
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+}
+
+// UserEvent is an event along with its state for a user
+type UserEvent struct {
+	Event `gorm:"embedded"`
+
+	Acknowledged   bool       `json:"acknowledged" gorm:"-"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	Resolved       bool       `json:"resolved" gorm:"-"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+}
+
+// EventFilter selects events. Zero values select every event.
+type EventFilter struct {
+	Severities []EventSeverity `json:"severities,omitempty"`
+	Categories []EventCategory `json:"categories,omitempty"`
+	// Acknowledged and Resolved select the events by their state for the user
+	Acknowledged *bool      `json:"acknowledged,omitempty"`
+	Resolved     *bool      `json:"resolved,omitempty"`
+	Since        *time.Time `json:"since,omitempty"`
+	// Limit is the maximum number of events, the latest first
+	Limit int `json:"limit,omitempty"`
+}
+
+// Validate checks the severities and categories of the filter
+func (f EventFilter) Validate() error {
+	for _, severity := range f.Severities {
+		if !severity.Valid() {
+			return fmt.Errorf("invalid severity %q, expected one of %v", severity, EventSeverities)
+		}
+	}
+	for _, category := range f.Categories {
+		if !category.Valid() {
+			return fmt.Errorf("invalid category %q, expected one of %v", category, EventCategories)
+		}
+	}
+	return nil
+}
+
+// EventStateRequest is the body of the requests acknowledging or resolving events, either the
+// events with the given IDs or, when there are none, every event matching the filter
+type EventStateRequest struct {
+	IDs    []uuid.UUID  `json:"ids,omitempty"`
+	Filter *EventFilter `json:"filter,omitempty"`
+}
+
+// EventStateResult is the number of events whose state changed
+type EventStateResult struct {
+	Updated int `json:"updated"`
+}
+
+// EventRecorderInterface defines the methods a type should implement to record events
+// and stream them to the clients
+type EventRecorderInterface interface {
+	// Record persists the event without publishing it
+	Record(category EventCategory, source string, e *meshes.EventsResponse) (*Event, error)
+	// Subscribe returns a channel on which the published events are sent, along with a function
+	// to cancel the subscription
+	Subscribe() (<-chan *Event, func())
+}
+
+// EventPersister is the persister for persisting
+// the events and their state for every user on the database
+type EventPersister struct {
+	DB *database.Handler
+}
+
+// CreateEvent persists the given event
+func (ep *EventPersister) CreateEvent(event *Event) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ErrGenerateUUID(err)
+	}
+	event.ID = &id
+	return ep.DB.Create(event).Error
+}
+
+// GetEvents returns the events matching the filter along with their state for the user, the latest first
+func (ep *EventPersister) GetEvents(userID string, filter EventFilter) ([]UserEvent, error) {
+	query := ep.DB.Table("events").
+		Select("events.*, event_statuses.acknowledged_at, event_statuses.resolved_at").
+		Joins("LEFT JOIN event_statuses ON event_statuses.event_id = events.id AND event_statuses.user_id = ?", userID)
+	if len(filter.Severities) > 0 {
+		query = query.Where("events.severity IN ?", filter.Severities)
+	}
+	if len(filter.Categories) > 0 {
+		query = query.Where("events.category IN ?", filter.Categories)
+	}
+	if filter.Since != nil {
+		query = query.Where("events.created_at >= ?", *filter.Since)
+	}
+	query = whereStateSet(query, "event_statuses.acknowledged_at", filter.Acknowledged)
+	query = whereStateSet(query, "event_statuses.resolved_at", filter.Resolved)
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	events := []UserEvent{}
+	if err := query.Order("events.created_at desc").Scan(&events).Error; err != nil {
+		return nil, err
+	}
+	for i := range events {
+		events[i].Acknowledged = events[i].AcknowledgedAt != nil
+		events[i].Resolved = events[i].ResolvedAt != nil
+	}
+	return events, nil
+}
+
+func whereStateSet(query *gorm.DB, column string, set *bool) *gorm.DB {
+	if set == nil {
+		return query
+	}
+	if *set {
+		return query.Where(column + " IS NOT NULL")
+	}
+	return query.Where(column + " IS NULL")
+}
+
+// AcknowledgeEvents acknowledges for the user the events of the request, returning the number of
+// events which were not acknowledged yet
+func (ep *EventPersister) AcknowledgeEvents(userID string, req EventStateRequest) (int, error) {
+	return ep.setEventsState(userID, req, false)
+}
+
+// ResolveEvents resolves, and thereby acknowledges, for the user the events of the request, returning
+// the number of events which were not resolved yet
+func (ep *EventPersister) ResolveEvents(userID string, req EventStateRequest) (int, error) {
+	return ep.setEventsState(userID, req, true)
+}
+
+func (ep *EventPersister) setEventsState(userID string, req EventStateRequest, resolve bool) (int, error) {
+	column := "acknowledged_at"
+	if resolve {
+		column = "resolved_at"
+	}
+
+	ids := req.IDs
+	if len(ids) == 0 {
+		if req.Filter == nil {
+			return 0, fmt.Errorf("either the IDs of the events or a filter is required")
+		}
+		if err := req.Filter.Validate(); err != nil {
+			return 0, err
+		}
+		// Only the events whose state changes are selected
+		filter := *req.Filter
+		unset := false
+		if resolve {
+			filter.Resolved = &unset
+		} else {
+			filter.Acknowledged = &unset
+		}
+		events, err := ep.GetEvents(userID, filter)
+		if err != nil {
+			return 0, err
+		}
+		for _, event := range events {
+			ids = append(ids, *event.ID)
+		}
+	}
+
+	unique := []uuid.UUID{}
+	seen := map[uuid.UUID]bool{}
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	ids = unique
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	updated := 0
+	err := ep.DB.Transaction(func(tx *gorm.DB) error {
+		var existing int64
+		if err := tx.Model(&Event{}).Where("id IN ?", ids).Count(&existing).Error; err != nil {
+			return err
+		}
+		if int(existing) != len(ids) {
+			return fmt.Errorf("%d of the %d events do not exist", len(ids)-int(existing), len(ids))
+		}
+
+		var set int64
+		if err := tx.Model(&EventStatus{}).Where("user_id = ? AND event_id IN ? AND "+column+" IS NOT NULL", userID, ids).Count(&set).Error; err != nil {
+			return err
+		}
+		updated = len(ids) - int(set)
+
+		now := time.Now()
+		for _, id := range ids {
+			status := &EventStatus{EventID: id, UserID: userID, AcknowledgedAt: &now}
+			if resolve {
+				status.ResolvedAt = &now
+			}
+			// The time an event was first acknowledged or resolved is kept
+			assignments := map[string]interface{}{
+				"acknowledged_at": gorm.Expr("COALESCE(event_statuses.acknowledged_at, excluded.acknowledged_at)"),
+				"resolved_at":     gorm.Expr("COALESCE(event_statuses.resolved_at, excluded.resolved_at)"),
+			}
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+				DoUpdates: clause.Assignments(assignments),
+			}).Create(status).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
@@ -0,0 +1,132 @@
+package models
+
+import (
+	"time"
+
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm/clause"
+)
+
+// FeatureFlagDefinition describes an experimental capability of Meshery Server gated by a feature flag
+type FeatureFlagDefinition struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Default tells whether the capability is enabled when neither the user nor anyone globally set the flag
+	Default bool `json:"default"`
+	// Endpoints are the endpoints of the API answering with ErrFeatureDisabled while the flag is disabled
+	Endpoints []string `json:"endpoints"`
+}
+
+// Feature flags of the experimental capabilities
+const (
+	// FeatureGatewayAPI gates the inventory of the Gateway API resources discovered by MeshSync
+	FeatureGatewayAPI = "gateway-api"
+)
+
+// FeatureFlagDefinitions are the experimental capabilities of Meshery Server, which clients look up
+// to tell whether the server supports a capability before using it
+var FeatureFlagDefinitions = []FeatureFlagDefinition{
+	{
+		Name:        FeatureGatewayAPI,
+		Description: "Inventory of the GatewayClasses, Gateways and HTTPRoutes discovered by MeshSync",
+		Default:     true,
+		Endpoints:   []string{"/api/system/meshsync/gateway"},
+	},
+}
+
+// FeatureFlagDefinitionByName returns the definition of the feature flag, false if the server has no such flag
+func FeatureFlagDefinitionByName(name string) (FeatureFlagDefinition, bool) {
+	for _, d := range FeatureFlagDefinitions {
+		if d.Name == name {
+			return d, true
+		}
+	}
+	return FeatureFlagDefinition{}, false
+}
+
+// FeatureFlag is a feature flag set globally, when UserID is empty, or for a user, overriding the
+// global setting for them
+type FeatureFlag struct {
+	Name    string `json:"name" gorm:"primaryKey"`
+	UserID  string `json:"user_id,omitempty" gorm:"primaryKey"`
+	Enabled bool   `json:"enabled"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// FeatureFlagState is a feature flag as in effect for a user
+type FeatureFlagState struct {
+	FeatureFlagDefinition
+	// Enabled tells whether the capability is enabled for the user: as they set the flag, else as set
+	// globally, else by default
+	Enabled bool `json:"enabled"`
+	// Global and User are the flag as set globally and by the user, nil when it is not set
+	Global *bool `json:"global,omitempty"`
+	User   *bool `json:"user,omitempty"`
+}
+
+// FeatureFlagRequest is the body of the requests setting a feature flag
+type FeatureFlagRequest struct {
+	Enabled bool `json:"enabled"`
+	// Global sets the flag for every user who did not set it themselves rather than for the user
+	Global bool `json:"global,omitempty"`
+}
+
+// FeatureFlagPersister is the persister for persisting
+// the feature flags set globally and for every user on the database
+type FeatureFlagPersister struct {
+	DB *database.Handler
+}
+
+// SetFeatureFlag enables or disables the flag for the user, globally if the user ID is empty
+func (ffp *FeatureFlagPersister) SetFeatureFlag(name, userID string, enabled bool) error {
+	now := time.Now()
+	return ffp.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+	}).Create(&FeatureFlag{Name: name, UserID: userID, Enabled: enabled, UpdatedAt: &now}).Error
+}
+
+// GetFeatureFlags returns every feature flag of the server as in effect for the user
+func (ffp *FeatureFlagPersister) GetFeatureFlags(userID string) ([]FeatureFlagState, error) {
+	flags := []FeatureFlag{}
+	if err := ffp.DB.Where("user_id = ? OR user_id = ?", "", userID).Find(&flags).Error; err != nil {
+		return nil, err
+	}
+
+	states := make([]FeatureFlagState, 0, len(FeatureFlagDefinitions))
+	for _, d := range FeatureFlagDefinitions {
+		state := FeatureFlagState{FeatureFlagDefinition: d, Enabled: d.Default}
+		for i := range flags {
+			if flags[i].Name != d.Name {
+				continue
+			}
+			if flags[i].UserID == "" {
+				state.Global = &flags[i].Enabled
+			} else {
+				state.User = &flags[i].Enabled
+			}
+		}
+		if state.User != nil {
+			state.Enabled = *state.User
+		} else if state.Global != nil {
+			state.Enabled = *state.Global
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// IsFeatureEnabled tells whether the capability gated by the flag is enabled for the user
+func (ffp *FeatureFlagPersister) IsFeatureEnabled(name, userID string) (bool, error) {
+	states, err := ffp.GetFeatureFlags(userID)
+	if err != nil {
+		return false, err
+	}
+	for _, s := range states {
+		if s.Name == name {
+			return s.Enabled, nil
+		}
+	}
+	return false, nil
+}
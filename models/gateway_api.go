@@ -0,0 +1,118 @@
+package models
+
+// GatewayAPIResources are the Gateway API resources discovered by MeshSync across the connected
+// clusters, along with the problems found in their configuration
+type GatewayAPIResources struct {
+	GatewayClasses []GatewayClass      `json:"gateway_classes"`
+	Gateways       []Gateway           `json:"gateways"`
+	HTTPRoutes     []HTTPRoute         `json:"http_routes"`
+	Issues         []GatewayAPIIssue   `json:"issues"`
+	Conflicts      []HTTPRouteConflict `json:"conflicts"`
+}
+
+// GatewayAPICluster identifies the cluster a Gateway API resource was discovered in, by the ID
+// MeshSync gives it and the name of the Kubernetes context connected to it, when known
+type GatewayAPICluster struct {
+	ClusterID string `json:"cluster_id"`
+	Context   string `json:"context,omitempty"`
+}
+
+// GatewayClass is a class of Gateways, implemented by the controller of a Gateway API implementation
+type GatewayClass struct {
+	GatewayAPICluster
+	Name           string `json:"name"`
+	ControllerName string `json:"controller_name"`
+	// Accepted tells if the controller accepted the class, nil when it did not report it
+	Accepted *bool `json:"accepted,omitempty"`
+}
+
+// Gateway is an instance of a GatewayClass receiving traffic on its listeners
+type Gateway struct {
+	GatewayAPICluster
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	ClassName string            `json:"class_name"`
+	Listeners []GatewayListener `json:"listeners"`
+	Addresses []string          `json:"addresses"`
+	// Programmed tells if the controller configured the Gateway, nil when it did not report it
+	Programmed *bool `json:"programmed,omitempty"`
+}
+
+// GatewayListener is a port, protocol and optional hostname a Gateway receives traffic on
+type GatewayListener struct {
+	Name     string `json:"name"`
+	Hostname string `json:"hostname,omitempty"`
+	Port     int    `json:"port"`
+	Protocol string `json:"protocol"`
+	// AllowedRoutes tells from which namespaces routes may attach to the listener, Same, All or Selector
+	AllowedRoutes string `json:"allowed_routes"`
+}
+
+// HTTPRoute routes HTTP requests received by the Gateways it is attached to, its parents, to backends
+type HTTPRoute struct {
+	GatewayAPICluster
+	Name      string          `json:"name"`
+	Namespace string          `json:"namespace"`
+	Hostnames []string        `json:"hostnames"`
+	Parents   []GatewayRef    `json:"parents"`
+	Rules     []HTTPRouteRule `json:"rules"`
+	CreatedAt string          `json:"created_at,omitempty"`
+}
+
+// GatewayRef references a Gateway, or one of its listeners when SectionName is set
+type GatewayRef struct {
+	Name        string `json:"name"`
+	Namespace   string `json:"namespace"`
+	SectionName string `json:"section_name,omitempty"`
+}
+
+// HTTPRouteRule sends the requests matching any of its matches to its backends
+type HTTPRouteRule struct {
+	Matches  []HTTPRouteMatch   `json:"matches"`
+	Backends []HTTPRouteBackend `json:"backends"`
+}
+
+// HTTPRouteMatch matches requests by path and, optionally, method and headers
+type HTTPRouteMatch struct {
+	PathType  string            `json:"path_type"`
+	PathValue string            `json:"path_value"`
+	Method    string            `json:"method,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+}
+
+// HTTPRouteBackend is a Service, or another kind of backend, requests are sent to
+type HTTPRouteBackend struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Port      int    `json:"port,omitempty"`
+	Weight    *int   `json:"weight,omitempty"`
+}
+
+// Severities of the issues of the Gateway API resources
+const (
+	GatewayAPIIssueError   = "error"
+	GatewayAPIIssueWarning = "warning"
+)
+
+// GatewayAPIIssue is a problem in the configuration of a Gateway API resource, such as a reference
+// to a resource which does not exist
+type GatewayAPIIssue struct {
+	GatewayAPICluster
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Severity  string `json:"severity"`
+	Message   string `json:"message"`
+}
+
+// HTTPRouteConflict is a match of requests, for a hostname of a Gateway, claimed by several HTTPRoutes.
+// Following the Gateway API, the oldest route wins and the other routes are ignored for the match.
+type HTTPRouteConflict struct {
+	GatewayAPICluster
+	Gateway  GatewayRef `json:"gateway"`
+	Hostname string     `json:"hostname"`
+	Match    string     `json:"match"`
+	// Routes claiming the match, given as <namespace>/<name>, the winner first
+	Routes []string `json:"routes"`
+}
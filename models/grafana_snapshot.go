@@ -0,0 +1,316 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grafana-tools/sdk"
+	"github.com/sirupsen/logrus"
+)
+
+// snapshotPoints is the number of points the series of the panels of a snapshot are queried with
+const snapshotPoints = 300
+
+var (
+	templateVarRegex = regexp.MustCompile(`\$\{(\w+)(?::\w+)?\}|\[\[(\w+)\]\]|\$(\w+)`)
+	legendLabelRegex = regexp.MustCompile(`\{\{\s*(\w+)\s*\}\}`)
+)
+
+// GrafanaSnapshot represents a snapshot of a Grafana board over the time range of a performance test
+type GrafanaSnapshot struct {
+	Board     string `json:"board,omitempty"`
+	BoardUID  string `json:"board_uid,omitempty"`
+	ID        uint   `json:"id,omitempty"`
+	Key       string `json:"key,omitempty"`
+	URL       string `json:"url,omitempty"`
+	DeleteURL string `json:"delete_url,omitempty"`
+}
+
+// CreateSnapshot creates a snapshot of the board with the given UID over the given time range.
+// Grafana does not query the data of snapshots, so the series of the panels backed by Prometheus
+// are queried and embedded in the snapshot.
+func (g *GrafanaClient) CreateSnapshot(ctx context.Context, BaseURL, APIKey, boardUID, name string, from, to time.Time) (*GrafanaSnapshot, error) {
+	BaseURL = strings.TrimSuffix(BaseURL, "/")
+	c, err := sdk.NewClient(BaseURL, APIKey, g.httpClient)
+	if err != nil {
+		return nil, ErrGrafanaClient(err)
+	}
+	raw, _, err := c.GetRawDashboardByUID(ctx, boardUID)
+	if err != nil {
+		return nil, ErrGrafanaDashboard(err, boardUID)
+	}
+	board := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &board); err != nil {
+		return nil, ErrUnmarshal(err, "Grafana Board")
+	}
+
+	board["time"] = map[string]string{
+		"from": from.UTC().Format(time.RFC3339),
+		"to":   to.UTC().Format(time.RFC3339),
+	}
+	g.embedSnapshotData(ctx, c, BaseURL, APIKey, board, from, to)
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"dashboard": board,
+		"name":      name,
+		"expires":   0,
+	})
+	if err != nil {
+		return nil, ErrMarshal(err, "Grafana snapshot")
+	}
+	data, err := g.grafanaRequest(ctx, http.MethodPost, BaseURL+"/api/snapshots", APIKey, payload)
+	if err != nil {
+		return nil, ErrGrafanaSnapshot(err, boardUID)
+	}
+	resp := struct {
+		ID        uint   `json:"id"`
+		Key       string `json:"key"`
+		URL       string `json:"url"`
+		DeleteURL string `json:"deleteUrl"`
+	}{}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, ErrUnmarshal(err, "Grafana snapshot")
+	}
+	title, _ := board["title"].(string)
+	return &GrafanaSnapshot{
+		Board:     title,
+		BoardUID:  boardUID,
+		ID:        resp.ID,
+		Key:       resp.Key,
+		URL:       resp.URL,
+		DeleteURL: resp.DeleteURL,
+	}, nil
+}
+
+// embedSnapshotData sets the snapshot data of the panels of the board backed by Prometheus
+// data sources. Panels whose data could not be queried are left without data.
+func (g *GrafanaClient) embedSnapshotData(ctx context.Context, c *sdk.Client, BaseURL, APIKey string, board map[string]interface{}, from, to time.Time) {
+	datasources, err := c.GetAllDatasources(ctx)
+	if err != nil {
+		logrus.Warn(ErrGrafanaDataSource(err, "all"))
+		return
+	}
+
+	step := to.Sub(from) / snapshotPoints
+	if step < time.Second {
+		step = time.Second
+	}
+	rateInterval := 4 * step
+	if rateInterval < time.Minute {
+		rateInterval = time.Minute
+	}
+	vars := boardTemplateValues(board)
+	vars["__interval"] = fmt.Sprintf("%ds", int(step.Seconds()))
+	vars["__rate_interval"] = fmt.Sprintf("%ds", int(rateInterval.Seconds()))
+	vars["__range"] = fmt.Sprintf("%ds", int(to.Sub(from).Seconds()))
+
+	for _, panel := range boardPanels(board) {
+		ds, ok := panelDatasource(panel, datasources)
+		if !ok || ds.Type != "prometheus" {
+			continue
+		}
+		targets, _ := panel["targets"].([]interface{})
+		if len(targets) == 0 {
+			continue
+		}
+		series := []interface{}{}
+		for _, t := range targets {
+			target, _ := t.(map[string]interface{})
+			expr, _ := target["expr"].(string)
+			if expr == "" || target["hide"] == true {
+				continue
+			}
+			q := url.Values{}
+			q.Set("query", expandTemplateVars(expr, vars))
+			q.Set("start", strconv.FormatInt(from.Unix(), 10))
+			q.Set("end", strconv.FormatInt(to.Unix(), 10))
+			q.Set("step", strconv.Itoa(int(step.Seconds())))
+			data, err := g.grafanaRequest(ctx, http.MethodGet, fmt.Sprintf("%s/api/datasources/proxy/%d/api/v1/query_range?%s", BaseURL, ds.ID, q.Encode()), APIKey, nil)
+			if err != nil {
+				logrus.Debugf("unable to query the data of panel %v for the snapshot: %v", panel["title"], err)
+				continue
+			}
+			legend, _ := target["legendFormat"].(string)
+			series = append(series, snapshotSeries(data, legend)...)
+		}
+		panel["snapshotData"] = series
+	}
+}
+
+// grafanaRequest sends a request to the Grafana API, authorized the way the Grafana SDK does
+func (g *GrafanaClient) grafanaRequest(ctx context.Context, method, reqURL, APIKey string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(APIKey, ":") {
+		parts := strings.SplitN(APIKey, ":", 2)
+		req.SetBasicAuth(parts[0], parts[1])
+	} else if APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+APIKey)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
+
+// boardPanels returns the panels of the board, including those of collapsed rows
+func boardPanels(board map[string]interface{}) []map[string]interface{} {
+	var panels []map[string]interface{}
+	var collect func(list interface{})
+	collect = func(list interface{}) {
+		items, _ := list.([]interface{})
+		for _, item := range items {
+			panel, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			panels = append(panels, panel)
+			collect(panel["panels"])
+		}
+	}
+	collect(board["panels"])
+	// Boards from before Grafana 5 keep their panels in rows
+	rows, _ := board["rows"].([]interface{})
+	for _, row := range rows {
+		if r, ok := row.(map[string]interface{}); ok {
+			collect(r["panels"])
+		}
+	}
+	return panels
+}
+
+// panelDatasource returns the data source of the panel, given by name or the default one when not set
+func panelDatasource(panel map[string]interface{}, datasources []sdk.Datasource) (sdk.Datasource, bool) {
+	switch ds := panel["datasource"].(type) {
+	case nil:
+		for _, d := range datasources {
+			if d.IsDefault {
+				return d, true
+			}
+		}
+	case string:
+		for _, d := range datasources {
+			if d.Name == ds {
+				return d, true
+			}
+		}
+	}
+	return sdk.Datasource{}, false
+}
+
+// boardTemplateValues returns the current values of the template variables of the board,
+// multiple values being joined the way Prometheus regular expressions match either of them
+func boardTemplateValues(board map[string]interface{}) map[string]string {
+	values := map[string]string{}
+	templating, _ := board["templating"].(map[string]interface{})
+	list, _ := templating["list"].([]interface{})
+	for _, v := range list {
+		variable, _ := v.(map[string]interface{})
+		name, _ := variable["name"].(string)
+		current, _ := variable["current"].(map[string]interface{})
+		switch value := current["value"].(type) {
+		case string:
+			values[name] = value
+		case []interface{}:
+			parts := make([]string, 0, len(value))
+			for _, part := range value {
+				parts = append(parts, fmt.Sprint(part))
+			}
+			values[name] = strings.Join(parts, "|")
+		}
+		if values[name] == "$__all" {
+			values[name] = ".*"
+		}
+	}
+	return values
+}
+
+func expandTemplateVars(expr string, values map[string]string) string {
+	return templateVarRegex.ReplaceAllStringFunc(expr, func(match string) string {
+		groups := templateVarRegex.FindStringSubmatch(match)
+		for _, name := range groups[1:] {
+			if value, ok := values[name]; ok && name != "" {
+				return value
+			}
+		}
+		return match
+	})
+}
+
+// snapshotSeries converts the response of a Prometheus range query into the series of the
+// snapshot data of a panel
+func snapshotSeries(data []byte, legend string) []interface{} {
+	resp := struct {
+		Data struct {
+			Result []struct {
+				Metric map[string]string `json:"metric"`
+				Values [][]interface{}   `json:"values"`
+			} `json:"result"`
+		} `json:"data"`
+	}{}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil
+	}
+
+	series := make([]interface{}, 0, len(resp.Data.Result))
+	for _, result := range resp.Data.Result {
+		datapoints := make([][]interface{}, 0, len(result.Values))
+		for _, point := range result.Values {
+			if len(point) < 2 {
+				continue
+			}
+			ts, _ := point[0].(float64)
+			value, err := strconv.ParseFloat(fmt.Sprint(point[1]), 64)
+			if err != nil {
+				continue
+			}
+			datapoints = append(datapoints, []interface{}{value, int64(ts * 1000)})
+		}
+		series = append(series, map[string]interface{}{
+			"target":     seriesName(result.Metric, legend),
+			"datapoints": datapoints,
+		})
+	}
+	return series
+}
+
+func seriesName(metric map[string]string, legend string) string {
+	if legend != "" {
+		return legendLabelRegex.ReplaceAllStringFunc(legend, func(match string) string {
+			return metric[legendLabelRegex.FindStringSubmatch(match)[1]]
+		})
+	}
+	labels := make([]string, 0, len(metric))
+	for k, v := range metric {
+		if k != "__name__" {
+			labels = append(labels, fmt.Sprintf("%s=%q", k, v))
+		}
+	}
+	sort.Strings(labels)
+	return metric["__name__"] + "{" + strings.Join(labels, ", ") + "}"
+}
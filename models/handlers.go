@@ -1,10 +1,12 @@
 package models
 
 import (
+	"context"
 	"net/http"
 
 	"time"
 
+	"github.com/layer5io/meshery/models/pattern/secrets"
 	"github.com/vmihailenco/taskq/v3"
 )
 
@@ -16,6 +18,8 @@ type HandlerInterface interface {
 	AuthMiddleware(http.Handler) http.Handler
 	SessionInjectorMiddleware(func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)) http.Handler
 	GraphqlMiddleware(http.Handler) func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)
+	ETagMiddleware(http.Handler) http.Handler
+	FeatureFlagMiddleware(string, func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)) func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)
 
 	ProviderHandler(w http.ResponseWriter, r *http.Request)
 	ProvidersHandler(w http.ResponseWriter, r *http.Request)
@@ -37,6 +41,9 @@ type HandlerInterface interface {
 	DeleteContext(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetCurrentContextHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	SetCurrentContextHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	K8sContextsHealthHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	K8sRateLimitHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	SetK8sRateLimitHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	LoadTestHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	LoadTestUsingSMPHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
@@ -44,15 +51,24 @@ type HandlerInterface interface {
 	FetchResultsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	FetchAllResultsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetResultHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetResultAnomaliesHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	LoadTestCheckpointsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetSMPServiceMeshes(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	FetchSmiResultsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	FetchSingleSmiResultHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	MeshAdapterConfigHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	AdapterRegistrationHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	MeshOpsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	AdaptersHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	AdaptersHealthHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	MeshesStatusHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	MeshInjectionHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	EventStreamHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	EventsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	AcknowledgeEventsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	ResolveEventsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	AdapterPingHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	GrafanaConfigHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
@@ -63,17 +79,28 @@ type HandlerInterface interface {
 	SaveSelectedGrafanaBoardsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	ScanPromGrafanaHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	GatewayAPIResourcesHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	ScanPrometheusHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	ScanGrafanaHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	PrometheusConfigHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	GrafanaBoardImportForPrometheusHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	PrometheusQueryHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	PrometheusConnectionsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	PrometheusConnectionQueryHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	PrometheusQueryRangeHandler(w http.ResponseWriter, req *http.Request)
 	PrometheusPingHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	PrometheusStaticBoardHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	SaveSelectedPrometheusBoardsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
+	DatadogConfigHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	DatadogQueryHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	NewRelicConfigHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	NewRelicQueryHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+
 	UserPrefsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	UserDigestPreferencesHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	SendDigestHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	UserCLIPreferencesHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	UserTestPreferenceHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	UserTestPreferenceStore(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
@@ -83,7 +110,15 @@ type HandlerInterface interface {
 	SavePerformanceProfileHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetPerformanceProfilesHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetPerformanceProfileHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPerformanceProfileMetricsHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPerformanceProfileTrendHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	DeletePerformanceProfileHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PerfTargetsHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PerfTargetMeshHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ResultHooksHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteResultHookHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	RunResultHookHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	RemoteWriteHandler(w http.ResponseWriter, req *http.Request)
 
 	SessionSyncHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
@@ -92,6 +127,49 @@ type HandlerInterface interface {
 	OAMComponentDetailsHandler(rw http.ResponseWriter, r *http.Request)
 	OAMComponentDetailByIDHandler(rw http.ResponseWriter, r *http.Request)
 	PatternFileRequestHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternShareHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternReviewHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternEnvironmentDeployHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternPromotionHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternUpgradeHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternSnapshotHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	OwnershipTransferHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	TrashHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	RestoreMesheryPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	RestorePerformanceProfileHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ServerDebugHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	TelemetryHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ServerConfigHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ServerConfigReloadHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DatabaseSchemaHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	MigrateDatabaseSchemaHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	RollbackDatabaseSchemaHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	JobsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetJobHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	CancelJobHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	FeatureFlagsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	FeatureFlagHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ValidationWebhooksHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteValidationWebhookHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DashboardSnapshotsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetDashboardSnapshotHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	WorkspaceUsageHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	WorkspaceLoadTestLimitsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	WorkspaceLoadTestLimitsAdminHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternScheduleHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DesignSchedulesHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DesignGCHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DesignLogsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DesignEventsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DesignPortForwardHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DesignExecHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	RunDesignSchedule(ctx context.Context, schedule *DesignSchedule) (string, error)
+	PatternDeploymentsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	EnvironmentsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteEnvironmentHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+
+	WorkloadIdentitiesHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteWorkloadIdentityHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	DeleteMesheryPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	DeleteMultiMesheryPatternsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetMesheryPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
@@ -106,6 +184,9 @@ type HandlerInterface interface {
 	ApplicationFileRequestHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetMesheryApplicationHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	DeleteMesheryApplicationHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ApplicationStatusHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ApplicationRolloutRestartHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ApplicationScaleHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	ExtensionsEndpointHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	LoadExtensionFromPackage(w http.ResponseWriter, req *http.Request, provider Provider) error
@@ -124,8 +205,14 @@ type HandlerConfig struct {
 
 	// SessionStore sessions.Store
 
-	AdapterTracker AdaptersTrackerInterface
-	QueryTracker   QueryTrackerInterface
+	AdapterTracker       AdaptersTrackerInterface
+	AdapterHealthTracker AdapterHealthTrackerInterface
+	QueryTracker         QueryTrackerInterface
+	// AdapterRegistrationPersister persists the adapters registered at runtime, registered again on restart
+	AdapterRegistrationPersister *AdapterRegistrationPersister
+	// AdapterCerts, set when mutual TLS with the adapters is enabled, makes the serving certificate of
+	// the adapters valid for those registered at runtime
+	AdapterCerts AdapterCertsInterface
 
 	Queue taskq.Queue
 
@@ -137,6 +224,9 @@ type HandlerConfig struct {
 	PrometheusClient         *PrometheusClient
 	PrometheusClientForQuery *PrometheusClient
 
+	DatadogClient  *DatadogClient
+	NewRelicClient *NewRelicClient
+
 	// GraphQLHandler           http.Handler
 	// GraphQLPlaygroundHandler http.Handler
 
@@ -148,11 +238,101 @@ type HandlerConfig struct {
 
 	PerformanceChannel       chan struct{}
 	PerformanceResultChannel chan struct{}
+
+	ResultSinks []ResultSink
+
+	// ResultArchive holds the performance results moved out of the database, if any
+	ResultArchive ResultArchive
+
+	// ResultAnomalyDetector flags the results deviating from the history of their profile and
+	// ResultAnalysisPersister persists that history
+	ResultAnomalyDetector   ResultAnomalyDetectorInterface
+	ResultAnalysisPersister *ResultAnalysisPersister
+
+	// TrendReporter reports weekly on the trend of the performance of the profiles from that history
+	// and TrendReportPersister persists the reports
+	TrendReporter        TrendReporterInterface
+	TrendReportPersister *TrendReportPersister
+
+	// EnvironmentPersister persists the environments designs are promoted through
+	EnvironmentPersister *EnvironmentPersister
+
+	// SecretResolvers resolve the references to secrets of external stores found in designs
+	SecretResolvers *secrets.Registry
+	// VaultPaths are the prefixes of the paths of the Vault secrets the designs of the users in no
+	// workspace may reference, the members of a workspace referencing those of their workspace
+	VaultPaths []string
+	// DeployNamespaces are the namespaces the designs of the users in no workspace may be deployed
+	// to, the members of a workspace deploying to those of their workspace
+	DeployNamespaces []string
+
+	// WorkloadIdentityPersister persists the ServiceAccounts allowed to call the API on behalf of users
+	WorkloadIdentityPersister *WorkloadIdentityPersister
+
+	// PerfTargetPersister persists the echo servers deployed as targets of performance tests
+	PerfTargetPersister *PerfTargetPersister
+
+	// ResultHookPersister persists the hooks post-processing the performance results of the users
+	// and the annotations they attached to the results
+	ResultHookPersister *ResultHookPersister
+
+	// ValidationWebhookPersister persists the webhooks of the external policy engines the designs
+	// are validated with before they are deployed
+	ValidationWebhookPersister *ValidationWebhookPersister
+
+	// DashboardSnapshotPersister persists the snapshots of Meshery UI the users capture to share them
+	DashboardSnapshotPersister *DashboardSnapshotPersister
+
+	// EventRecorder records the events streamed to the clients and EventPersister persists
+	// them along with their state for every user
+	EventRecorder  EventRecorderInterface
+	EventPersister *EventPersister
+
+	// DigestSender emails their digest to the users, it is nil unless an SMTP server is configured,
+	// and DigestSubscriptionPersister persists the digest preferences of the users
+	DigestSender                DigestSenderInterface
+	DigestSubscriptionPersister *DigestSubscriptionPersister
+
+	// DesignSchedulePersister persists the scheduled deployments and undeployments of the designs
+	DesignSchedulePersister *DesignSchedulePersister
+
+	LoadTestGuard LoadTestGuardInterface
+	// LoadTestLimits are the guardrails every performance test is checked against before it runs,
+	// unless the workspace of its user has limits of its own
+	LoadTestLimits LoadTestLimits
+	// WorkspaceQuotas caps the profiles, concurrent tests, results and designs of the workspaces,
+	// and holds the load test limits set for them
+	WorkspaceQuotas WorkspaceQuotaInterface
+	// Admins are the IDs of the users administering Meshery Server, who set the load test limits
+	// of the workspaces among others
+	Admins []string
+	// RemoteWriteReceiver collects the client-side metrics written through Prometheus remote_write
+	// during performance tests
+	RemoteWriteReceiver RemoteWriteReceiverInterface
+	// LoadTestCheckpointPersister persists the checkpoints of the tests run with a checkpoint interval
+	LoadTestCheckpointPersister *LoadTestCheckpointPersister
+
+	// FeatureFlagPersister persists the feature flags of the experimental capabilities, set globally
+	// and by the users
+	FeatureFlagPersister *FeatureFlagPersister
+
+	// ServerConfigReloader applies the changes of the configuration of the server while it runs
+	ServerConfigReloader ServerConfigReloaderInterface
+	// SchemaMigrator applies and rolls back the migrations of the schema of the database
+	SchemaMigrator SchemaMigratorInterface
+	// Jobs tracks the work the server runs in the background, such as the MeshSync resyncs, the
+	// performance tests, the deployments of designs and the archival of the results
+	Jobs JobTrackerInterface
+
+	// DebugLogRecorder keeps the latest entries logged by the server for clients to pull them
+	DebugLogRecorder DebugLogRecorderInterface
 }
 
 // SubmitMetricsConfig is used to store config used for submitting metrics
 type SubmitMetricsConfig struct {
 	TestUUID, ResultID, PromURL string
+	Datadog                     *Datadog
+	NewRelic                    *NewRelic
 	StartTime, EndTime          time.Time
 	// TokenKey,
 	TokenVal string
@@ -0,0 +1,128 @@
+package models
+
+import (
+	"context"
+	"time"
+)
+
+// JobStatus is the state of a background job of Meshery Server
+type JobStatus string
+
+const (
+	// JobRunning is the state of a job until it completes
+	JobRunning JobStatus = "running"
+
+	// JobCancelling is the state of a running job asked to stop
+	JobCancelling JobStatus = "cancelling"
+
+	// JobSucceeded is the state of a job which completed
+	JobSucceeded JobStatus = "succeeded"
+
+	// JobFailed is the state of a job which completed with an error
+	JobFailed JobStatus = "failed"
+
+	// JobCancelled is the state of a job which stopped once cancelled
+	JobCancelled JobStatus = "cancelled"
+)
+
+// The kinds of the background jobs of Meshery Server
+const (
+	JobMeshSyncResync   = "meshsync-resync"
+	JobPerformanceTest  = "performance-test"
+	JobDesignDeployment = "design-deployment"
+	JobDesignSchedule   = "design-schedule"
+	JobResultArchive    = "result-archive"
+	JobTrashPurge       = "trash-purge"
+)
+
+// Job is the work Meshery Server runs in the background, such as the MeshSync resyncs, the
+// performance tests, the deployments of designs and the archival of the results
+type Job struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// UserID is the user the job runs for, empty for the jobs of the server
+	UserID string    `json:"user_id,omitempty"`
+	Status JobStatus `json:"status"`
+	// Progress is the percentage of the work done, nil when it is not known
+	Progress *float64 `json:"progress,omitempty"`
+	// ItemsDone is the number of items processed out of ItemsTotal, 0 when it is not known
+	ItemsDone   int    `json:"items_done,omitempty"`
+	ItemsTotal  int    `json:"items_total,omitempty"`
+	Message     string `json:"message,omitempty"`
+	Cancellable bool   `json:"cancellable"`
+
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+// Completed tells whether the job completed, whatever its outcome
+func (j *Job) Completed() bool {
+	return j.Status == JobSucceeded || j.Status == JobFailed || j.Status == JobCancelled
+}
+
+// JobFilter selects the jobs listed, every one of them when its fields are empty
+type JobFilter struct {
+	Kind   string
+	Status JobStatus
+	UserID string
+}
+
+// Matches tells whether the job is selected by the filter
+func (f JobFilter) Matches(job *Job) bool {
+	return (f.Kind == "" || job.Kind == f.Kind) &&
+		(f.Status == "" || job.Status == f.Status) &&
+		(f.UserID == "" || job.UserID == f.UserID)
+}
+
+// JobsAPIResponse is the list of the jobs, the running ones first
+type JobsAPIResponse struct {
+	Jobs []Job `json:"jobs"`
+}
+
+// JobReporter reports on a job as it runs, once started with a JobTrackerInterface
+type JobReporter interface {
+	// Context returns the context of the job, done once the job is cancelled or completes
+	Context() context.Context
+	// Progress reports the work done out of the total, the total being 0 when it is not known
+	Progress(done, total int)
+	// Expect estimates the progress of the job from the time elapsed until the job reports it
+	Expect(duration time.Duration)
+	// Message reports what the job is doing
+	Message(msg string)
+	// Finish completes the job, failed when err is not nil
+	Finish(err error)
+	// Discard forgets the job, which turned out to have nothing to do
+	Discard()
+}
+
+// JobTrackerInterface defines the methods a type should implement to track the background jobs
+type JobTrackerInterface interface {
+	// Start tracks a job running from now on with a context derived from the given one, the job being
+	// cancelled through the API when it is cancellable
+	Start(ctx context.Context, kind, name, userID string, cancellable bool) JobReporter
+	// List returns the jobs selected by the filter, the running ones first and the most recent first
+	List(filter JobFilter) []Job
+	// Get returns the job of the given ID
+	Get(id string) (*Job, error)
+	// Cancel asks the running job of the given ID to stop
+	Cancel(id string) (*Job, error)
+}
+
+// untrackedJob ignores the reports on a job
+type untrackedJob struct {
+	ctx context.Context
+}
+
+// UntrackedJob returns a JobReporter ignoring the reports on the job, for the jobs run while the jobs
+// are not tracked
+func UntrackedJob(ctx context.Context) JobReporter {
+	return untrackedJob{ctx: ctx}
+}
+
+func (j untrackedJob) Context() context.Context    { return j.ctx }
+func (untrackedJob) Progress(done, total int)      {}
+func (untrackedJob) Expect(duration time.Duration) {}
+func (untrackedJob) Message(msg string)            {}
+func (untrackedJob) Finish(err error)              {}
+func (untrackedJob) Discard()                      {}
@@ -33,6 +33,9 @@ type K8sContext struct {
 	IsCurrentContext   bool       `json:"is_current_context,omitempty" yaml:"is_current_context,omitempty"`
 	MesheryInstanceID  *uuid.UUID `json:"meshery_instance_id,omitempty" yaml:"meshery_instance_id,omitempty"`
 	KubernetesServerID *uuid.UUID `json:"kubernetes_server_id,omitempty" yaml:"kubernetes_server_id,omitempty"`
+	// QPS and Burst are the rate limit of the requests to the Kubernetes API, the defaults when zero
+	QPS   float32 `json:"qps,omitempty" yaml:"qps,omitempty"`
+	Burst int     `json:"burst,omitempty" yaml:"burst,omitempty"`
 
 	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
 	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
@@ -286,13 +289,15 @@ func (kc K8sContext) GenerateKubeConfig() ([]byte, error) {
 	return yaml.Marshal(cfg)
 }
 
+// GenerateKubeHandler returns a client of the Kubernetes API of the context, its requests being
+// held by the rate limit of the connection
 func (kc K8sContext) GenerateKubeHandler() (*kubernetes.Client, error) {
 	cfg, err := kc.GenerateKubeConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	return kubernetes.New(cfg)
+	return newRateLimitedKubeClient(cfg, K8sThrottling.RateLimiter(kc))
 }
 
 // PingTest uses the k8scontext to to "ping" the kubernetes cluster
@@ -0,0 +1,378 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/errors"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	// for OIDC kube API authentication
+	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
+)
+
+// Policies for the kubeconfigs whose users authenticate with an exec credential plugin,
+// e.g. aws eks get-token or gke-gcloud-auth-plugin
+const (
+	// ExecPluginsDeny rejects the contexts authenticating with a plugin
+	ExecPluginsDeny = "deny"
+	// ExecPluginsAllow keeps the plugin, which Meshery Server then runs whenever it connects to the cluster
+	ExecPluginsAllow = "allow"
+	// ExecPluginsExchange runs the plugin once, when the context is registered, to create a
+	// ServiceAccount in the cluster whose expiring token replaces the plugin
+	ExecPluginsExchange = "exchange"
+)
+
+// DefaultExecPluginCommands are the credential plugins Meshery Server may run, unless configured otherwise
+var DefaultExecPluginCommands = []string{"aws", "aws-iam-authenticator", "gcloud", "gke-gcloud-auth-plugin", "kubelogin"}
+
+// execPluginArgs are the arguments the known plugins may be run with: their subcommand and the flags
+// allowed along with it. The flags choosing other credentials, e.g. --profile, or sending them
+// elsewhere, e.g. --endpoint-url, are not among them. The other plugins are run without arguments.
+var execPluginArgs = map[string]execArgs{
+	"aws":                    {subcommand: []string{"eks", "get-token"}, flags: []string{"--cluster-name", "--cluster-id", "--region", "--output"}},
+	"aws-iam-authenticator":  {subcommand: []string{"token"}, flags: []string{"-i", "--cluster-id", "--region"}},
+	"gcloud":                 {subcommand: []string{"config", "config-helper"}, flags: []string{"--format"}},
+	"gke-gcloud-auth-plugin": {},
+	"kubelogin":              {subcommand: []string{"get-token"}, flags: []string{"--login", "--server-id", "--client-id", "--tenant-id", "--environment"}},
+}
+
+// execPluginServers are the domains of the API servers the credentials of the plugins are for, when
+// known, so that they are never sent to another server
+var execPluginServers = map[string][]string{
+	"aws":                   {".eks.amazonaws.com", ".eks.amazonaws.com.cn"},
+	"aws-iam-authenticator": {".eks.amazonaws.com", ".eks.amazonaws.com.cn"},
+	"kubelogin":             {".azmk8s.io"},
+}
+
+// supportedAuthProviders are the auth providers of kubeconfig users client-go is built with
+var supportedAuthProviders = []string{"gcp", "oidc"}
+
+const (
+	// ConnectionServiceAccount is the ServiceAccount created in the cluster when exchanging
+	// the credentials of an exec plugin for a token
+	ConnectionServiceAccount = "meshery-connection"
+	// ConnectionServiceAccountNamespace is the namespace of the ServiceAccount
+	ConnectionServiceAccountNamespace = "kube-system"
+	// DefaultConnectionClusterRole is the ClusterRole the ServiceAccount is bound to, unless configured otherwise
+	DefaultConnectionClusterRole = "edit"
+	// DefaultConnectionTokenExpiration is how long the token of the ServiceAccount is valid, unless configured otherwise
+	DefaultConnectionTokenExpiration = 24 * time.Hour
+	// minConnectionTokenExpiration is the shortest expiration the Kubernetes API accepts
+	minConnectionTokenExpiration = 10 * time.Minute
+)
+
+// connectionExchangeTimeout is how long the exchange of the credentials of a plugin may take
+var connectionExchangeTimeout = time.Minute
+
+// ExecPluginPolicy is how the contexts of the uploaded kubeconfigs whose users authenticate with an
+// exec credential plugin are handled
+type ExecPluginPolicy struct {
+	// Policy is ExecPluginsDeny, ExecPluginsAllow or ExecPluginsExchange
+	Policy string
+	// Commands are the plugins Meshery Server may run
+	Commands []string
+	// ClusterRole is the ClusterRole the ServiceAccount is bound to on exchange
+	ClusterRole string
+	// TokenExpiration is how long the token requested on exchange is valid
+	TokenExpiration time.Duration
+}
+
+// execArgs are the arguments a plugin may be run with
+type execArgs struct {
+	subcommand []string
+	flags      []string
+}
+
+// check returns why the arguments are not allowed, if they are not. The flags are given either as
+// --flag=value or as --flag value.
+func (a execArgs) check(args []string) error {
+	positional := []string{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			positional = append(positional, arg)
+			continue
+		}
+		name := arg
+		if j := strings.Index(arg, "="); j >= 0 {
+			name = arg[:j]
+		} else if i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+			i++
+		}
+		allowed := false
+		for _, flag := range a.flags {
+			if name == flag {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("flag %s is not allowed", name)
+		}
+	}
+	if strings.Join(positional, " ") != strings.Join(a.subcommand, " ") {
+		if len(a.subcommand) == 0 {
+			return fmt.Errorf("arguments %s are not allowed", strings.Join(positional, " "))
+		}
+		return fmt.Errorf("arguments %s are not allowed, only %s is", strings.Join(positional, " "), strings.Join(a.subcommand, " "))
+	}
+	return nil
+}
+
+// checkExecConfig returns why the exec config of a user may not be run against the server, if it may not.
+// The environment cannot be set, and the arguments are restricted to those of execPluginArgs.
+func checkExecConfig(command string, execConfig map[string]interface{}, server string) error {
+	if env, _ := execConfig["env"].([]interface{}); len(env) > 0 {
+		return fmt.Errorf("the environment of %s cannot be set by the kubeconfig", command)
+	}
+
+	args := []string{}
+	rawArgs, _ := execConfig["args"].([]interface{})
+	for _, a := range rawArgs {
+		arg, ok := a.(string)
+		if !ok {
+			return fmt.Errorf("arguments of %s are not strings", command)
+		}
+		args = append(args, arg)
+	}
+	if err := execPluginArgs[command].check(args); err != nil {
+		return fmt.Errorf("%s cannot be run with the arguments of the kubeconfig: %w", command, err)
+	}
+
+	domains, ok := execPluginServers[command]
+	if !ok {
+		return nil
+	}
+	u, err := url.Parse(server)
+	if err != nil || u.Scheme != "https" {
+		return fmt.Errorf("server %s is not an https URL", server)
+	}
+	for _, domain := range domains {
+		if strings.HasSuffix(u.Hostname(), domain) {
+			return nil
+		}
+	}
+	return fmt.Errorf("credentials of %s are only sent to the servers in %s, not to %s", command, strings.Join(domains, ", "), u.Hostname())
+}
+
+// K8sContextError tells why a context of a kubeconfig was not registered
+type K8sContextError struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+	// Remedy tells the alternatives, when known
+	Remedy string `json:"remedy,omitempty"`
+}
+
+func newK8sContextError(name string, err error) K8sContextError {
+	ctxErr := K8sContextError{Name: name, Error: err.Error()}
+	if e, ok := err.(*errors.Error); ok {
+		ctxErr.Remedy = strings.Join(e.SuggestedRemediation, ". ")
+	}
+	return ctxErr
+}
+
+// K8sContextsFromKubeconfigWithPolicy generates the kubernetes contexts of the kubeconfig like
+// K8sContextsFromKubeconfig, applying the policy to the contexts authenticating with an exec plugin.
+// The contexts which are skipped are returned along with the reason. When names are given, only the
+// contexts of these names are generated, so that the clusters of the other contexts are neither
+// reached nor registered.
+func K8sContextsFromKubeconfigWithPolicy(ctx context.Context, kubeconfig []byte, instanceID *uuid.UUID, policy ExecPluginPolicy, names ...string) ([]K8sContext, []K8sContextError) {
+	kcs := []K8sContext{}
+	errs := []K8sContextError{}
+
+	parsed, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return kcs, append(errs, newK8sContextError("", err))
+	}
+
+	kcfg := InternalKubeConfig{}
+	if err := yaml.Unmarshal(kubeconfig, &kcfg); err != nil {
+		return kcs, append(errs, newK8sContextError("", err))
+	}
+
+	selected := map[string]bool{}
+	for _, name := range names {
+		selected[name] = true
+	}
+
+	for name := range parsed.Contexts {
+		if len(selected) > 0 && !selected[name] {
+			continue
+		}
+		kc := kcfg.K8sContext(name, instanceID)
+		if err := kc.PrepareAuth(ctx, policy); err != nil {
+			logrus.Warn("Skipping context: Reason => ", err)
+			errs = append(errs, newK8sContextError(name, err))
+			continue
+		}
+		if err := kc.AssignServerID(); err != nil {
+			logrus.Warn("Skipping context: Reason => ", err)
+			errs = append(errs, newK8sContextError(name, err))
+			continue
+		}
+
+		kcs = append(kcs, kc)
+	}
+
+	return kcs, errs
+}
+
+// KubeconfigContextNames returns the names of the contexts of the kubeconfig, sorted
+func KubeconfigContextNames(kubeconfig []byte) ([]string, error) {
+	parsed, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(parsed.Contexts))
+	for name := range parsed.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// PrepareAuth checks that Meshery Server is able to authenticate with the user of the context.
+// A user authenticating with an exec plugin is rejected, kept, or exchanged for the token of a
+// ServiceAccount depending on the policy. The ID of the context is left as is, so that registering
+// the same kubeconfig again updates the context.
+func (kc *K8sContext) PrepareAuth(ctx context.Context, policy ExecPluginPolicy) error {
+	user, _ := kc.Auth["user"].(map[string]interface{})
+
+	if provider, ok := user["auth-provider"].(map[string]interface{}); ok {
+		name, _ := provider["name"].(string)
+		for _, supported := range supportedAuthProviders {
+			if name == supported {
+				return nil
+			}
+		}
+		return ErrUnsupportedAuthProvider(kc.Name, name)
+	}
+
+	execConfig, ok := user["exec"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	command, _ := execConfig["command"].(string)
+
+	switch policy.Policy {
+	case ExecPluginsAllow, ExecPluginsExchange:
+	default:
+		return ErrExecPlugin(kc.Name, command, "running credential plugins is not allowed by the KUBECONFIG_EXEC_PLUGINS policy of Meshery Server")
+	}
+	allowed := false
+	for _, c := range policy.Commands {
+		if command == strings.TrimSpace(c) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return ErrExecPlugin(kc.Name, command, fmt.Sprintf("%s is not among the credential plugins allowed by KUBECONFIG_EXEC_COMMANDS: %s", command, strings.Join(policy.Commands, ", ")))
+	}
+	if err := checkExecConfig(command, execConfig, kc.Server); err != nil {
+		return ErrExecPlugin(kc.Name, command, err.Error())
+	}
+	if _, err := exec.LookPath(command); err != nil {
+		return ErrExecPlugin(kc.Name, command, fmt.Sprintf("%s is not installed where Meshery Server runs", command))
+	}
+	if policy.Policy == ExecPluginsAllow {
+		return nil
+	}
+
+	// The plugin is run by client-go to get the credentials used to create the ServiceAccount
+	handler, err := kc.GenerateKubeHandler()
+	if err != nil {
+		return ErrExchangeExecCredential(kc.Name, err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, connectionExchangeTimeout)
+	defer cancel()
+	token, err := exchangeForServiceAccountToken(ctx, handler.KubeClient, policy.ClusterRole, policy.TokenExpiration)
+	if err != nil {
+		return ErrExchangeExecCredential(kc.Name, err)
+	}
+
+	auth := map[string]interface{}{}
+	for k, v := range kc.Auth {
+		auth[k] = v
+	}
+	auth["user"] = map[string]interface{}{"token": token}
+	kc.Auth = auth
+	return nil
+}
+
+// exchangeForServiceAccountToken creates, unless it exists, a ServiceAccount bound to the ClusterRole,
+// and returns a token of it expiring after the expiration. The binding to another ClusterRole, and the
+// Secret holding the non-expiring token created by the former versions of Meshery Server, are deleted.
+func exchangeForServiceAccountToken(ctx context.Context, client kubernetes.Interface, clusterRole string, expiration time.Duration) (string, error) {
+	if clusterRole == "" {
+		clusterRole = DefaultConnectionClusterRole
+	}
+	if expiration == 0 {
+		expiration = DefaultConnectionTokenExpiration
+	}
+	if expiration < minConnectionTokenExpiration {
+		expiration = minConnectionTokenExpiration
+	}
+	meta := metav1.ObjectMeta{
+		Name:      ConnectionServiceAccount,
+		Namespace: ConnectionServiceAccountNamespace,
+		Labels:    map[string]string{"app.kubernetes.io/managed-by": "meshery"},
+	}
+
+	_, err := client.CoreV1().ServiceAccounts(meta.Namespace).Create(ctx, &corev1.ServiceAccount{ObjectMeta: meta}, metav1.CreateOptions{})
+	if err != nil && !kerrors.IsAlreadyExists(err) {
+		return "", err
+	}
+
+	// The role of a binding cannot be changed, so a binding to another role is replaced
+	bindings := client.RbacV1().ClusterRoleBindings()
+	existing, err := bindings.Get(ctx, meta.Name, metav1.GetOptions{})
+	switch {
+	case kerrors.IsNotFound(err):
+	case err != nil:
+		return "", err
+	case existing.RoleRef.Name != clusterRole:
+		if err := bindings.Delete(ctx, meta.Name, metav1.DeleteOptions{}); err != nil && !kerrors.IsNotFound(err) {
+			return "", err
+		}
+	}
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: meta.Name, Labels: meta.Labels},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: clusterRole},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: meta.Name, Namespace: meta.Namespace}},
+	}
+	if _, err := bindings.Create(ctx, binding, metav1.CreateOptions{}); err != nil && !kerrors.IsAlreadyExists(err) {
+		return "", err
+	}
+
+	if err := client.CoreV1().Secrets(meta.Namespace).Delete(ctx, meta.Name+"-token", metav1.DeleteOptions{}); err != nil && !kerrors.IsNotFound(err) {
+		return "", err
+	}
+
+	seconds := int64(expiration.Seconds())
+	tr, err := client.CoreV1().ServiceAccounts(meta.Namespace).CreateToken(ctx, meta.Name, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &seconds},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+	if tr.Status.Token == "" {
+		return "", fmt.Errorf("no token was issued for ServiceAccount %s/%s", meta.Namespace, meta.Name)
+	}
+	return tr.Status.Token, nil
+}
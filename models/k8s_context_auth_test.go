@@ -0,0 +1,123 @@
+package models
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCheckExecConfig(t *testing.T) {
+	eks := "https://0123456789ABCDEF.gr7.us-west-2.eks.amazonaws.com"
+	tests := []struct {
+		name    string
+		command string
+		args    []interface{}
+		env     []interface{}
+		server  string
+		wantErr bool
+	}{
+		{name: "aws eks get-token", command: "aws", args: []interface{}{"--region", "us-west-2", "eks", "get-token", "--cluster-name", "prod"}, server: eks},
+		{name: "flags given with =", command: "aws", args: []interface{}{"eks", "get-token", "--cluster-name=prod", "--output=json"}, server: eks},
+		{name: "profile of the server", command: "aws", args: []interface{}{"eks", "get-token", "--cluster-name", "prod", "--profile", "admin"}, server: eks, wantErr: true},
+		{name: "other endpoint", command: "aws", args: []interface{}{"eks", "get-token", "--cluster-name", "prod", "--endpoint-url=https://attacker.example.com"}, server: eks, wantErr: true},
+		{name: "other subcommand", command: "aws", args: []interface{}{"s3", "cp", "s3://bucket/key", "/tmp"}, server: eks, wantErr: true},
+		{name: "environment set", command: "aws", args: []interface{}{"eks", "get-token", "--cluster-name", "prod"}, env: []interface{}{map[string]interface{}{"name": "AWS_PROFILE", "value": "admin"}}, server: eks, wantErr: true},
+		{name: "server other than EKS", command: "aws", args: []interface{}{"eks", "get-token", "--cluster-name", "prod"}, server: "https://attacker.example.com", wantErr: true},
+		{name: "server suffixed like EKS", command: "aws", args: []interface{}{"eks", "get-token", "--cluster-name", "prod"}, server: "https://eks.amazonaws.com.attacker.example.com", wantErr: true},
+		{name: "plain http server", command: "aws", args: []interface{}{"eks", "get-token", "--cluster-name", "prod"}, server: "http://prod.eks.amazonaws.com", wantErr: true},
+		{name: "gke plugin", command: "gke-gcloud-auth-plugin", server: "https://34.1.2.3"},
+		{name: "gke plugin with arguments", command: "gke-gcloud-auth-plugin", args: []interface{}{"--use_application_default_credentials"}, server: "https://34.1.2.3", wantErr: true},
+		{name: "kubelogin", command: "kubelogin", args: []interface{}{"get-token", "--login", "azurecli", "--server-id", "6dae42f8"}, server: "https://prod-dns-1a2b3c.hcp.eastus.azmk8s.io:443"},
+		{name: "plugin configured by the admins", command: "vault-k8s-token", server: "https://10.0.0.1"},
+		{name: "arguments of a plugin configured by the admins", command: "vault-k8s-token", args: []interface{}{"--address", "https://attacker.example.com"}, server: "https://10.0.0.1", wantErr: true},
+	}
+	for _, tt := range tests {
+		execConfig := map[string]interface{}{"command": tt.command, "args": tt.args, "env": tt.env}
+		err := checkExecConfig(tt.command, execConfig, tt.server)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: got error %v, want an error: %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestExchangeForServiceAccountToken(t *testing.T) {
+	legacy := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ConnectionServiceAccount + "-token", Namespace: ConnectionServiceAccountNamespace}}
+	adminBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: ConnectionServiceAccount},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "cluster-admin"},
+	}
+	client := fake.NewSimpleClientset(legacy, adminBinding)
+	var requested *int64
+	client.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		tr := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+		requested = tr.Spec.ExpirationSeconds
+		tr.Status.Token = "expiring-token"
+		return true, tr, nil
+	})
+
+	token, err := exchangeForServiceAccountToken(context.Background(), client, "", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "expiring-token" {
+		t.Errorf("got token %q, want the one of the TokenRequest", token)
+	}
+	if requested == nil || *requested != int64(minConnectionTokenExpiration.Seconds()) {
+		t.Errorf("token was requested for %v seconds, want %v", requested, minConnectionTokenExpiration.Seconds())
+	}
+
+	binding, err := client.RbacV1().ClusterRoleBindings().Get(context.Background(), ConnectionServiceAccount, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if binding.RoleRef.Name != DefaultConnectionClusterRole {
+		t.Errorf("ServiceAccount is bound to %s, want %s", binding.RoleRef.Name, DefaultConnectionClusterRole)
+	}
+	if _, err := client.CoreV1().Secrets(ConnectionServiceAccountNamespace).Get(context.Background(), legacy.Name, metav1.GetOptions{}); !kerrors.IsNotFound(err) {
+		t.Errorf("Secret holding the non-expiring token was not deleted: %v", err)
+	}
+	if _, err := client.CoreV1().ServiceAccounts(ConnectionServiceAccountNamespace).Get(context.Background(), ConnectionServiceAccount, metav1.GetOptions{}); err != nil {
+		t.Errorf("ServiceAccount was not created: %v", err)
+	}
+
+	// Exchanging again, e.g. to resync the connection, keeps the binding
+	if _, err := exchangeForServiceAccountToken(context.Background(), client, DefaultConnectionClusterRole, DefaultConnectionTokenExpiration); err != nil {
+		t.Fatal(err)
+	}
+	if *requested != int64(DefaultConnectionTokenExpiration.Seconds()) {
+		t.Errorf("token was requested for %d seconds, want %v", *requested, DefaultConnectionTokenExpiration.Seconds())
+	}
+}
+
+func TestPrepareAuthDeniesExecPlugins(t *testing.T) {
+	kc := K8sContext{
+		Name:   "prod",
+		Server: "https://attacker.example.com",
+		Auth: map[string]interface{}{"user": map[string]interface{}{"exec": map[string]interface{}{
+			"command": "aws",
+			"args":    []interface{}{"eks", "get-token", "--cluster-name", "prod"},
+		}}},
+	}
+	policies := []ExecPluginPolicy{
+		{Policy: ExecPluginsDeny, Commands: DefaultExecPluginCommands},
+		{Policy: ExecPluginsAllow, Commands: []string{"gcloud"}},
+		{Policy: ExecPluginsExchange, Commands: DefaultExecPluginCommands},
+	}
+	for _, policy := range policies {
+		if err := kc.PrepareAuth(context.Background(), policy); err == nil {
+			t.Errorf("context was prepared with the policy %+v", policy)
+		}
+	}
+}
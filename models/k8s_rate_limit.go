@@ -0,0 +1,289 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshkit/utils/kubernetes"
+	"k8s.io/client-go/dynamic"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// Rate limits of the requests to the Kubernetes API of the connections which do not set theirs,
+// as set by meshkit
+const (
+	DefaultK8sQPS   float32 = 50
+	DefaultK8sBurst int     = 100
+)
+
+// k8sThrottleEventThreshold is how long a request must have been held by the rate limit of its
+// connection for an event to be published, as client-go logs the requests held longer
+var k8sThrottleEventThreshold = time.Second
+
+// k8sThrottleEventInterval is the least time between two events of the same connection
+var k8sThrottleEventInterval = time.Minute
+
+// K8sRateLimit is the rate limit of the requests Meshery Server sends to the Kubernetes API of a
+// connection, the defaults being used for the settings left zero
+type K8sRateLimit struct {
+	// QPS is the sustained number of requests per second
+	QPS float32 `json:"qps"`
+	// Burst is the number of requests sent at once above QPS
+	Burst int `json:"burst"`
+}
+
+// Validate checks the settings of the rate limit
+func (r K8sRateLimit) Validate() error {
+	if r.QPS < 0 {
+		return fmt.Errorf("qps must not be negative, got %v", r.QPS)
+	}
+	if r.Burst < 0 {
+		return fmt.Errorf("burst must not be negative, got %d", r.Burst)
+	}
+	return nil
+}
+
+// K8sRateLimitStatus is the rate limit in effect for a connection, along with the requests it held
+// since Meshery Server started
+type K8sRateLimitStatus struct {
+	ContextID string `json:"context_id"`
+	Name      string `json:"name"`
+	// QPS and Burst are the settings of the connection, zero for the defaults
+	QPS   float32 `json:"qps"`
+	Burst int     `json:"burst"`
+	// Effective is the rate limit in effect
+	Effective K8sRateLimit `json:"effective"`
+	K8sThrottleStats
+}
+
+// K8sThrottleStats are the requests to the Kubernetes API of a connection held by its rate limit
+type K8sThrottleStats struct {
+	// Requests is the number of requests sent
+	Requests int64 `json:"requests"`
+	// Throttled is the number of requests which waited for the rate limit
+	Throttled int64 `json:"throttled"`
+	// Waited is the time the requests waited for in total, and MaxWait the longest wait
+	Waited  time.Duration `json:"waited"`
+	MaxWait time.Duration `json:"max_wait"`
+	// LastThrottledAt is when a request last waited, nil if none did
+	LastThrottledAt *time.Time `json:"last_throttled_at,omitempty"`
+}
+
+// RateLimit returns the rate limit in effect for the connection
+func (kc K8sContext) RateLimit() K8sRateLimit {
+	limit := K8sRateLimit{QPS: kc.QPS, Burst: kc.Burst}
+	if limit.QPS <= 0 {
+		limit.QPS = DefaultK8sQPS
+	}
+	if limit.Burst <= 0 {
+		limit.Burst = DefaultK8sBurst
+	}
+	return limit
+}
+
+// K8sThrottleTracker shares a rate limiter between the clients of every connection to the
+// Kubernetes API, so that the rate limit applies to the connection as a whole, and tracks the
+// requests the limiters hold. An event is published when a request is held for long.
+type K8sThrottleTracker struct {
+	limiters map[string]*k8sThrottledLimiter
+	lock     *sync.Mutex
+
+	subscribers     map[chan *meshes.EventsResponse]struct{}
+	subscribersLock *sync.Mutex
+}
+
+// K8sThrottling tracks the requests to the Kubernetes API of the connections of Meshery Server
+var K8sThrottling = NewK8sThrottleTracker()
+
+// NewK8sThrottleTracker returns an instance of K8sThrottleTracker
+func NewK8sThrottleTracker() *K8sThrottleTracker {
+	return &K8sThrottleTracker{
+		limiters:        map[string]*k8sThrottledLimiter{},
+		lock:            &sync.Mutex{},
+		subscribers:     map[chan *meshes.EventsResponse]struct{}{},
+		subscribersLock: &sync.Mutex{},
+	}
+}
+
+// RateLimiter returns the rate limiter of the connection, created again when its rate limit changed
+func (t *K8sThrottleTracker) RateLimiter(kc K8sContext) flowcontrol.RateLimiter {
+	limit := kc.RateLimit()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	l, ok := t.limiters[kc.ID]
+	if !ok || l.limit != limit {
+		stats := K8sThrottleStats{}
+		if ok {
+			stats = l.Stats()
+		}
+		l = &k8sThrottledLimiter{
+			RateLimiter: flowcontrol.NewTokenBucketRateLimiter(limit.QPS, limit.Burst),
+			tracker:     t,
+			name:        kc.Name,
+			limit:       limit,
+			stats:       stats,
+			lock:        &sync.Mutex{},
+		}
+		t.limiters[kc.ID] = l
+	}
+	return l
+}
+
+// Stats returns the requests of the connection held by its rate limit
+func (t *K8sThrottleTracker) Stats(contextID string) K8sThrottleStats {
+	t.lock.Lock()
+	l, ok := t.limiters[contextID]
+	t.lock.Unlock()
+	if !ok {
+		return K8sThrottleStats{}
+	}
+	return l.Stats()
+}
+
+// Status returns the rate limit of the connection along with the requests it held
+func (t *K8sThrottleTracker) Status(kc K8sContext) K8sRateLimitStatus {
+	return K8sRateLimitStatus{
+		ContextID:        kc.ID,
+		Name:             kc.Name,
+		QPS:              kc.QPS,
+		Burst:            kc.Burst,
+		Effective:        kc.RateLimit(),
+		K8sThrottleStats: t.Stats(kc.ID),
+	}
+}
+
+// Subscribe returns a channel on which an event is published whenever a request to the Kubernetes
+// API of a connection is held for long, at most once a minute per connection, along with a function
+// to cancel the subscription
+func (t *K8sThrottleTracker) Subscribe() (<-chan *meshes.EventsResponse, func()) {
+	ch := make(chan *meshes.EventsResponse, 10)
+
+	t.subscribersLock.Lock()
+	t.subscribers[ch] = struct{}{}
+	t.subscribersLock.Unlock()
+
+	return ch, func() {
+		t.subscribersLock.Lock()
+		defer t.subscribersLock.Unlock()
+		if _, ok := t.subscribers[ch]; ok {
+			delete(t.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (t *K8sThrottleTracker) publish(event *meshes.EventsResponse) {
+	t.subscribersLock.Lock()
+	defer t.subscribersLock.Unlock()
+
+	for ch := range t.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// k8sThrottledLimiter is the rate limiter of a connection, tracking the requests it holds
+type k8sThrottledLimiter struct {
+	flowcontrol.RateLimiter
+	tracker *K8sThrottleTracker
+	name    string
+	limit   K8sRateLimit
+
+	stats       K8sThrottleStats
+	lastEventAt time.Time
+	lock        *sync.Mutex
+}
+
+func (l *k8sThrottledLimiter) Accept() {
+	start := time.Now()
+	l.RateLimiter.Accept()
+	l.observe(time.Since(start))
+}
+
+func (l *k8sThrottledLimiter) Wait(ctx context.Context) error {
+	start := time.Now()
+	err := l.RateLimiter.Wait(ctx)
+	l.observe(time.Since(start))
+	return err
+}
+
+func (l *k8sThrottledLimiter) TryAccept() bool {
+	ok := l.RateLimiter.TryAccept()
+	if ok {
+		l.observe(0)
+	}
+	return ok
+}
+
+// Stats returns the requests the limiter held
+func (l *k8sThrottledLimiter) Stats() K8sThrottleStats {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.stats
+}
+
+// observe records a request which waited for the rate limit for the given time, publishing an
+// event when it waited for long
+func (l *k8sThrottledLimiter) observe(wait time.Duration) {
+	l.lock.Lock()
+	l.stats.Requests++
+	// the token bucket hands out the tokens available at once
+	if wait < time.Millisecond {
+		l.lock.Unlock()
+		return
+	}
+	now := time.Now()
+	l.stats.Throttled++
+	l.stats.Waited += wait
+	if wait > l.stats.MaxWait {
+		l.stats.MaxWait = wait
+	}
+	l.stats.LastThrottledAt = &now
+	notify := wait >= k8sThrottleEventThreshold && now.Sub(l.lastEventAt) >= k8sThrottleEventInterval
+	if notify {
+		l.lastEventAt = now
+	}
+	throttled := l.stats.Throttled
+	l.lock.Unlock()
+
+	if notify {
+		l.tracker.publish(&meshes.EventsResponse{
+			EventType: meshes.EventType_WARN,
+			Summary:   fmt.Sprintf("Requests to the Kubernetes API of %s are throttled", l.name),
+			Details: fmt.Sprintf("A request waited %s for the rate limit of %v requests per second with a burst of %d, %d request(s) waited so far. Raise the rate limit of the connection if the API server can take more.",
+				wait.Round(time.Millisecond), l.limit.QPS, l.limit.Burst, throttled),
+		})
+	}
+}
+
+// newRateLimitedKubeClient returns a client of the Kubernetes API described by the kubeconfig,
+// whose requests are held by the rate limiter
+func newRateLimitedKubeClient(kubeconfig []byte, limiter flowcontrol.RateLimiter) (*kubernetes.Client, error) {
+	restConfig, err := kubernetes.DetectKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	restConfig.QPS = limiter.QPS()
+	restConfig.RateLimiter = limiter
+
+	kclient, err := k8s.NewForConfig(restConfig)
+	if err != nil {
+		return nil, kubernetes.ErrNewKubeClient(err)
+	}
+	dyclient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, kubernetes.ErrNewDynClient(err)
+	}
+	return &kubernetes.Client{
+		RestConfig:        *restConfig,
+		DynamicKubeClient: dyclient,
+		KubeClient:        kclient,
+	}, nil
+}
@@ -1,8 +1,12 @@
 package models
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"fortio.org/fortio/fhttp"
@@ -42,6 +46,9 @@ const (
 
 	// TCP Load Test
 	TCP SupportedLoadTestMethods = 3
+
+	// WebSocket Load Test
+	WebSocket SupportedLoadTestMethods = 4
 )
 
 // LoadTestOptions represents the load test options
@@ -59,6 +66,10 @@ type LoadTestOptions struct {
 	Body        []byte
 	ContentType string
 
+	// Method is the method of the HTTP requests of the test, POST when the test has a body and
+	// GET otherwise when empty
+	Method string
+
 	IsInsecure bool
 	Duration   time.Duration
 
@@ -68,14 +79,76 @@ type LoadTestOptions struct {
 
 	Cert, Key, CACert string
 
+	// UnixSocket is the path of the Unix domain socket the requests are sent over rather
+	// than to the host and port of the URL, which remains the Host of the requests
+	UnixSocket string
+
 	AllowInitialErrors bool
 
+	// BodyTemplate marks the Body as a template rendered for every request
+	BodyTemplate bool
+	// TemplateData is the CSV data whose rows the body template uses in turn
+	TemplateData []byte
+
+	// RequestMix is the weighted set of requests sent rather than requests to the URL alone,
+	// their paths being resolved against the URL
+	RequestMix RequestMix
+
+	// Assertions are the checks every response is validated with, the responses failing them
+	// counting as errors
+	Assertions *LoadTestAssertions
+
+	// Values required for WebSocket tests, the connections being opened evenly
+	// over the ramp up and sending messages of the given size at the given rate
+	WSRampUp      time.Duration
+	WSMessageRate float64
+	WSMessageSize int
+
+	// Adaptive runs a Nighthawk test as a series of steps searching for the highest rate whose
+	// p99 latency stays within TargetP99, rather than at the fixed HTTPQPS, which is the rate the
+	// search starts at. The search never exceeds AdaptiveMaxQPS, unless zero.
+	Adaptive       bool
+	TargetP99      time.Duration
+	AdaptiveMaxQPS float64
+
+	// CheckpointInterval runs the test as consecutive segments of the interval, the aggregates of the
+	// test being checkpointed once every segment completes, unless zero
+	CheckpointInterval time.Duration
+
+	// Clients are the options of further load clients of an SMP test configuration,
+	// run concurrently with this one and stored along with its result
+	Clients []*LoadTestOptions
+
 	// Values required for fortio gRPC health & ping test
 	GRPCStreamsCount int
 	GRPCDoHealth     bool
 	GRPCHealthSvc    string
 	GRPCDoPing       bool
 	GRPCPingDelay    time.Duration
+
+	// Context is done once the test is cancelled, the load generators stopping the test early
+	// but for wrk2, nil when the test cannot be cancelled
+	Context context.Context `json:"-"`
+}
+
+// HTTPMethod returns the method of the HTTP requests of the test
+func (o *LoadTestOptions) HTTPMethod() string {
+	if o.Method != "" {
+		return strings.ToUpper(o.Method)
+	}
+	if len(o.Body) > 0 || o.BodyTemplate {
+		return http.MethodPost
+	}
+	return http.MethodGet
+}
+
+// ValidateHTTPMethod returns an error unless the requests of a test may use the method, which
+// they may for the methods of the requests of a mix
+func ValidateHTTPMethod(method string) error {
+	if !requestMixMethods[strings.ToUpper(method)] {
+		return fmt.Errorf("unsupported HTTP method %q", method)
+	}
+	return nil
 }
 
 // LoadTestStatus - used for representing load test status
@@ -92,11 +165,108 @@ const (
 	LoadTestSuccess LoadTestStatus = "success"
 )
 
+// LoadTestGuardInterface serializes the load tests run against the same target
+type LoadTestGuardInterface interface {
+	Acquire(ctx context.Context, target string, onQueued func(position int)) (func(), error)
+}
+
+// LoadTestLimits caps the load the performance tests run by Meshery Server may generate,
+// a zero limit leaving the setting unlimited
+type LoadTestLimits struct {
+	// MaxQPS caps the requests, or WebSocket messages, sent per second by all the clients of a test
+	MaxQPS float64
+	// MaxDuration caps the length of a test
+	MaxDuration time.Duration
+	// MaxConnections caps the connections opened by all the clients of a test
+	MaxConnections int
+}
+
+// Violations returns the limits exceeded by the test with the given options, none when it may run.
+// A test without a rate runs as fast as the target responds, so it exceeds any QPS limit.
+func (l LoadTestLimits) Violations(opts *LoadTestOptions) []string {
+	var violations []string
+	clients := append([]*LoadTestOptions{opts}, opts.Clients...)
+
+	if l.MaxQPS > 0 {
+		qps := 0.0
+		for _, client := range clients {
+			rate := client.HTTPQPS
+			if client.SupportedLoadTestMethods == WebSocket {
+				rate = client.WSMessageRate * float64(client.HTTPNumThreads)
+			}
+			// the rate of an adaptive test is searched for within the limit
+			if client.Adaptive {
+				continue
+			}
+			if rate <= 0 {
+				violations = append(violations, fmt.Sprintf("%s is tested at the maximum rate, set a rate of at most %s requests per second", client.URL, strconv.FormatFloat(l.MaxQPS, 'f', -1, 64)))
+			}
+			qps += rate
+		}
+		if qps > l.MaxQPS {
+			violations = append(violations, fmt.Sprintf("%s requests per second exceed the limit of %s", strconv.FormatFloat(qps, 'f', -1, 64), strconv.FormatFloat(l.MaxQPS, 'f', -1, 64)))
+		}
+	}
+	if l.MaxDuration > 0 && opts.Duration > l.MaxDuration {
+		violations = append(violations, fmt.Sprintf("duration of %s exceeds the limit of %s", opts.Duration, l.MaxDuration))
+	}
+	if l.MaxConnections > 0 {
+		connections := 0
+		for _, client := range clients {
+			connections += client.HTTPNumThreads
+		}
+		if connections > l.MaxConnections {
+			violations = append(violations, fmt.Sprintf("%d concurrent connections exceed the limit of %d", connections, l.MaxConnections))
+		}
+	}
+	return violations
+}
+
+// loadTestLimitsJSON is the JSON of LoadTestLimits, the duration being given as a string such as 10m
+type loadTestLimitsJSON struct {
+	MaxQPS         float64 `json:"max_qps"`
+	MaxDuration    string  `json:"max_duration"`
+	MaxConnections int     `json:"max_connections"`
+}
+
+// MarshalJSON implements json.Marshaler
+func (l LoadTestLimits) MarshalJSON() ([]byte, error) {
+	return json.Marshal(loadTestLimitsJSON{MaxQPS: l.MaxQPS, MaxDuration: l.MaxDuration.String(), MaxConnections: l.MaxConnections})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (l *LoadTestLimits) UnmarshalJSON(data []byte) error {
+	var limits loadTestLimitsJSON
+	if err := json.Unmarshal(data, &limits); err != nil {
+		return err
+	}
+	duration := time.Duration(0)
+	if limits.MaxDuration != "" {
+		var err error
+		if duration, err = time.ParseDuration(limits.MaxDuration); err != nil {
+			return fmt.Errorf("invalid max_duration %q", limits.MaxDuration)
+		}
+	}
+	*l = LoadTestLimits{MaxQPS: limits.MaxQPS, MaxDuration: duration, MaxConnections: limits.MaxConnections}
+	return nil
+}
+
+// Validate returns an error when a limit is negative
+func (l LoadTestLimits) Validate() error {
+	if l.MaxQPS < 0 || l.MaxDuration < 0 || l.MaxConnections < 0 {
+		return fmt.Errorf("limits cannot be negative, a limit of zero leaving the setting unlimited")
+	}
+	return nil
+}
+
 // LoadTestResponse - used to bundle the response with status to the client
 type LoadTestResponse struct {
 	Status  LoadTestStatus `json:"status,omitempty"`
 	Message string         `json:"message,omitempty"`
 	Result  *MesheryResult `json:"result,omitempty"`
+	// RemoteWriteToken is the token the writers of the client-side metrics of the test authenticate
+	// with, sent to the user running the test only
+	RemoteWriteToken string `json:"remote_write_token,omitempty"`
 }
 
 // MesheryResult - represents the results from Meshery test run to be shipped
@@ -104,19 +274,22 @@ type MesheryResult struct {
 	ID                 uuid.UUID              `json:"meshery_id,omitempty"`
 	Name               string                 `json:"name,omitempty"`
 	Mesh               string                 `json:"mesh,omitempty"`
-	PerformanceProfile *uuid.UUID             `json:"performance_profile,omitempty"`
+	PerformanceProfile *uuid.UUID             `json:"performance_profile,omitempty" gorm:"index:idx_meshery_results_profile_start,priority:1"`
 	TestID             string                 `json:"test_id"`
 	Result             map[string]interface{} `json:"runner_results,omitempty" gorm:"type:JSONB"`
 
 	ServerMetrics     interface{} `json:"server_metrics,omitempty" gorm:"type:JSONB"`
 	ServerBoardConfig interface{} `json:"server_board_config,omitempty" gorm:"type:JSONB"`
 
-	TestStartTime          *time.Time         `json:"test_start_time,omitempty"`
+	TestStartTime          *time.Time         `json:"test_start_time,omitempty" gorm:"index:idx_meshery_results_profile_start,priority:2"`
 	PerformanceProfileInfo PerformanceProfile `json:"-,omitempty" gorm:"constraint:OnDelete:SET NULL;foreignKey:PerformanceProfile"`
 
 	UpdatedAt string `json:"updated_at,omitempty"`
 	CreatedAt string `json:"created_at,omitempty"`
 	UserID    string `json:"user_id,omitempty"`
+
+	// Annotations are what the result hooks attached to the result, by hook
+	Annotations map[string]ResultAnnotations `json:"annotations,omitempty" gorm:"-"`
 }
 
 // ConvertToSpec - converts meshery result to SMP
@@ -159,19 +332,20 @@ func (m *MesheryResult) ConvertToSpec() (*PerformanceSpec, error) {
 	b.labels = map[string]string{"test_label": "test_value"}
 	b.StartTime = result.StartTime
 	b.EndTime = result.StartTime.Add(result.ActualDuration)
+	// the durations of fortio are in seconds, the latencies of SMP in milliseconds
 	b.Latencies = &LatenciesMs{
-		Min:     result.DurationHistogram.Min,
-		Max:     result.DurationHistogram.Max,
-		Average: result.DurationHistogram.Avg,
+		Min:     result.DurationHistogram.Min * 1000,
+		Max:     result.DurationHistogram.Max * 1000,
+		Average: result.DurationHistogram.Avg * 1000,
 	}
 	for _, p := range result.DurationHistogram.Percentiles {
 		switch p.Percentile {
 		case 50:
-			b.Latencies.P50 = p.Value
+			b.Latencies.P50 = p.Value * 1000
 		case 90:
-			b.Latencies.P90 = p.Value
+			b.Latencies.P90 = p.Value * 1000
 		case 99:
-			b.Latencies.P99 = p.Value
+			b.Latencies.P99 = p.Value * 1000
 		}
 	}
 	b.ActualQPS = result.ActualQPS
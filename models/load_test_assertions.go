@@ -0,0 +1,128 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// LoadTestAssertions are the checks the responses of a load test are validated with. A response
+// failing any of them counts as an error of the result, even when answered with a 2xx status.
+type LoadTestAssertions struct {
+	// Status are the statuses the responses must be answered with, any 2xx status when empty
+	Status []int `json:"status,omitempty" yaml:"status,omitempty"`
+	// BodyContains are the substrings the body of the responses must contain
+	BodyContains []string `json:"body_contains,omitempty" yaml:"body_contains,omitempty"`
+	// JSONPath are the JSONPath expressions the JSON body of the responses must match
+	JSONPath []JSONPathAssertion `json:"json_path,omitempty" yaml:"json_path,omitempty"`
+	// MaxResponseSize is the size in bytes the body of the responses must not exceed, unless zero
+	MaxResponseSize int64 `json:"max_response_size,omitempty" yaml:"max_response_size,omitempty"`
+}
+
+// JSONPathAssertion checks that the JSONPath expression, such as $.status or .items[0].id, finds
+// a value in the JSON body of the responses, equal to Value when given
+type JSONPathAssertion struct {
+	Path  string `json:"path" yaml:"path"`
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// LoadTestAssertionsResult summarizes the assertions of a load test, kept with its result
+type LoadTestAssertionsResult struct {
+	// Requests is the number of responses validated
+	Requests int64 `json:"requests"`
+	// Failed is the number of responses failing at least one assertion
+	Failed int64 `json:"failed"`
+	// Errors is the number of responses failing an assertion though answered with a 2xx status,
+	// which are counted as errors along with the other statuses
+	Errors     int64             `json:"errors"`
+	Assertions []AssertionResult `json:"assertions"`
+}
+
+// AssertionResult is the number of responses an assertion failed
+type AssertionResult struct {
+	Assertion string `json:"assertion"`
+	Failed    int64  `json:"failed"`
+}
+
+// ParseJSONPathAssertion parses a JSONPath assertion given as <path>[=<value>], such as
+// $.status=up or $.items[0].id
+func ParseJSONPathAssertion(spec string) (JSONPathAssertion, error) {
+	parts := strings.SplitN(spec, "=", 2)
+	a := JSONPathAssertion{Path: strings.TrimSpace(parts[0])}
+	if len(parts) == 2 {
+		a.Value = parts[1]
+	}
+	_, err := a.Compile()
+	return a, err
+}
+
+// Compile returns the parsed JSONPath expression of the assertion, failing on the responses it
+// finds no value in
+func (a JSONPathAssertion) Compile() (*jsonpath.JSONPath, error) {
+	path := a.Path
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	jp := jsonpath.New(a.Path)
+	jp.AllowMissingKeys(false)
+	if err := jp.Parse(path); err != nil {
+		return nil, fmt.Errorf("invalid JSONPath %s: %v", a.Path, err)
+	}
+	return jp, nil
+}
+
+// String describes the assertion
+func (a JSONPathAssertion) String() string {
+	if a.Value == "" {
+		return "json path " + a.Path + " exists"
+	}
+	return fmt.Sprintf("json path %s == %q", a.Path, a.Value)
+}
+
+// Validate returns an error when a status is not an HTTP status, a JSONPath expression is invalid
+// or the maximum response size is negative
+func (a *LoadTestAssertions) Validate() error {
+	for _, s := range a.Status {
+		if s < 100 || s > 599 {
+			return fmt.Errorf("invalid status %d", s)
+		}
+	}
+	for _, p := range a.JSONPath {
+		if _, err := p.Compile(); err != nil {
+			return err
+		}
+	}
+	if a.MaxResponseSize < 0 {
+		return fmt.Errorf("invalid maximum response size %d", a.MaxResponseSize)
+	}
+	return nil
+}
+
+// Empty tells whether no assertion is configured
+func (a *LoadTestAssertions) Empty() bool {
+	return a == nil || (len(a.Status) == 0 && len(a.BodyContains) == 0 && len(a.JSONPath) == 0 && a.MaxResponseSize == 0)
+}
+
+// Descriptions describes every assertion, in the order they are summarized in the result
+func (a *LoadTestAssertions) Descriptions() []string {
+	descriptions := []string{}
+	if len(a.Status) > 0 {
+		statuses := make([]string, 0, len(a.Status))
+		for _, s := range a.Status {
+			statuses = append(statuses, strconv.Itoa(s))
+		}
+		descriptions = append(descriptions, "status in "+strings.Join(statuses, ","))
+	}
+	for _, s := range a.BodyContains {
+		descriptions = append(descriptions, fmt.Sprintf("body contains %q", s))
+	}
+	for _, p := range a.JSONPath {
+		descriptions = append(descriptions, p.String())
+	}
+	if a.MaxResponseSize > 0 {
+		descriptions = append(descriptions, fmt.Sprintf("response size <= %d bytes", a.MaxResponseSize))
+	}
+	return descriptions
+}
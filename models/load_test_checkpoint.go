@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// LoadTestCheckpoint aggregates a long running test up to a point of the run. The checkpoints are
+// persisted while the test runs, so that they can be queried before it completes and outlive a run
+// which dies before its result is persisted.
+type LoadTestCheckpoint struct {
+	ID *uuid.UUID `json:"id,omitempty"`
+
+	TestID    string `json:"test_id" gorm:"index"`
+	ProfileID string `json:"profile_id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	// Client is the index of the load client of the test the checkpoint is of
+	Client int `json:"client"`
+	// Seq is the number of the checkpoint in the run of the client, from 1
+	Seq int `json:"seq"`
+
+	StartTime time.Time `json:"start_time"`
+	// Duration is how long the test ran up to the checkpoint
+	Duration time.Duration `json:"duration"`
+
+	// Requests, QPS and the latencies, in seconds, aggregate the test up to the checkpoint
+	Requests int64   `json:"requests"`
+	QPS      float64 `json:"qps"`
+	Min      float64 `json:"min"`
+	Max      float64 `json:"max"`
+	Average  float64 `json:"average"`
+	P50      float64 `json:"p50"`
+	P90      float64 `json:"p90"`
+	P99      float64 `json:"p99"`
+
+	// SegmentQPS and SegmentP99 are those of the interval since the previous checkpoint alone,
+	// telling a slow degradation the aggregates hide
+	SegmentQPS float64 `json:"segment_qps"`
+	SegmentP99 float64 `json:"segment_p99"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// LoadTestCheckpointPersister is the persister for persisting
+// the checkpoints of load tests on the database
+type LoadTestCheckpointPersister struct {
+	DB *database.Handler
+}
+
+// SaveLoadTestCheckpoint persists the given checkpoint
+func (lcp *LoadTestCheckpointPersister) SaveLoadTestCheckpoint(checkpoint *LoadTestCheckpoint) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ErrGenerateUUID(err)
+	}
+	checkpoint.ID = &id
+	return lcp.DB.Create(checkpoint).Error
+}
+
+// GetLoadTestCheckpoints returns the checkpoints of the test, by client and in the order of the run
+func (lcp *LoadTestCheckpointPersister) GetLoadTestCheckpoints(testID string) ([]LoadTestCheckpoint, error) {
+	checkpoints := []LoadTestCheckpoint{}
+	if err := lcp.DB.Where("test_id = ?", testID).Order("client, seq").Find(&checkpoints).Error; err != nil {
+		return nil, err
+	}
+	return checkpoints, nil
+}
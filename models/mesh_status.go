@@ -0,0 +1,52 @@
+package models
+
+// MeshStatus represents a service mesh found running in the cluster by Meshery Server,
+// without requiring its adapter
+type MeshStatus struct {
+	Name       string                `json:"name"`
+	Version    string                `json:"version"`
+	Namespace  string                `json:"namespace"`
+	Components []MeshComponentStatus `json:"components"`
+	// Number of pods outside of the control plane running the proxy of the mesh
+	Proxies int `json:"proxies"`
+	// Namespaces whose pods get the proxy of the mesh injected
+	InjectedNamespaces []string `json:"injected_namespaces"`
+}
+
+// MeshComponentStatus represents a pod of the control plane of a service mesh
+type MeshComponentStatus struct {
+	Name      string `json:"name"`
+	Component string `json:"component"`
+	Version   string `json:"version"`
+	Namespace string `json:"namespace"`
+}
+
+// MeshInjectionRequest enables or disables the injection of the proxy of a service mesh
+// in the pods of a namespace, or of a workload of the namespace
+type MeshInjectionRequest struct {
+	Namespace string `json:"namespace"`
+	// Workload given as <kind>/<name>, e.g. deployment/reviews-v1
+	Workload string `json:"workload,omitempty"`
+	Enabled  bool   `json:"enabled"`
+	// Restart the workloads for their pods to get or lose the proxy
+	Restart bool `json:"restart,omitempty"`
+	DryRun  bool `json:"dry_run,omitempty"`
+}
+
+// MeshInjectionResult represents the changes made, or which would be made on a dry run,
+// to enable or disable the injection of the proxy of a service mesh
+type MeshInjectionResult struct {
+	DryRun    bool                  `json:"dry_run"`
+	Changes   []MeshInjectionChange `json:"changes"`
+	Restarted []string              `json:"restarted"`
+}
+
+// MeshInjectionChange represents the change of a label or annotation of a resource,
+// From being nil when it is added and To when it is removed
+type MeshInjectionChange struct {
+	Resource string  `json:"resource"`
+	Kind     string  `json:"kind"`
+	Key      string  `json:"key"`
+	From     *string `json:"from"`
+	To       *string `json:"to"`
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshery/internal/sql"
 	"gopkg.in/yaml.v2"
+	"gorm.io/gorm"
 )
 
 // MesheryPattern represents the patterns that needs to be saved
@@ -14,14 +15,17 @@ type MesheryPattern struct {
 
 	Name        string `json:"name,omitempty"`
 	PatternFile string `json:"pattern_file"`
-	// Meshery doesn't have the user id fields
-	// but the remote provider is allowed to provide one
-	UserID *string `json:"user_id" gorm:"-"`
+	// UserID is the owner of the pattern, the user who created it unless
+	// its ownership was transferred
+	UserID *string `json:"user_id"`
 
 	Location sql.Map `json:"location"`
 
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// DeletedAt is set while the pattern is in the trash, until it is restored or purged,
+	// the trash listing it as a TrashItem
+	DeletedAt gorm.DeletedAt `json:"-"`
 }
 
 // GetPatternName takes in a stringified patternfile and extracts the name from it
@@ -0,0 +1,221 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// PatternShareKind is the kind of the principal a pattern is shared with
+type PatternShareKind string
+
+// PatternReviewStatus is the state of a review requested on a pattern
+type PatternReviewStatus string
+
+const (
+	PatternShareUser PatternShareKind = "user"
+	PatternShareTeam PatternShareKind = "team"
+
+	PatternReviewPending          PatternReviewStatus = "pending"
+	PatternReviewApproved         PatternReviewStatus = "approved"
+	PatternReviewChangesRequested PatternReviewStatus = "changes-requested"
+)
+
+// PatternShare represents a pattern shared with a user or a team
+type PatternShare struct {
+	ID        *uuid.UUID `json:"id,omitempty"`
+	PatternID *uuid.UUID `json:"pattern_id,omitempty"`
+
+	Kind       PatternShareKind `json:"kind,omitempty"`
+	SharedWith string           `json:"shared_with,omitempty"`
+	// Permission granted to the user or team, either "view" or "edit"
+	Permission string `json:"permission,omitempty"`
+	SharedBy   string `json:"shared_by,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// PatternReview represents a review requested on a pattern
+type PatternReview struct {
+	ID        *uuid.UUID `json:"id,omitempty"`
+	PatternID *uuid.UUID `json:"pattern_id,omitempty"`
+
+	Reviewer    string              `json:"reviewer,omitempty"`
+	RequestedBy string              `json:"requested_by,omitempty"`
+	Status      PatternReviewStatus `json:"status,omitempty"`
+	Comment     string              `json:"comment,omitempty"`
+	// PatternSHA is the SHA-256 of the pattern file the reviewer approved, the approval not applying
+	// to the later revisions of the pattern
+	PatternSHA string `json:"pattern_sha,omitempty"`
+	// SubmittedBy is the user moving the review to its status, checked against the reviewer
+	SubmittedBy string `json:"submitted_by,omitempty" gorm:"-"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// PatternReviewsAPIResponse is the response for the reviews of a pattern
+type PatternReviewsAPIResponse struct {
+	// Status is the overall review status of the pattern
+	Status  PatternReviewStatus `json:"status,omitempty"`
+	Reviews []PatternReview     `json:"reviews"`
+}
+
+// Validate checks whether the share can be persisted
+func (ps *PatternShare) Validate() error {
+	if ps.SharedWith == "" || (ps.Kind != PatternShareUser && ps.Kind != PatternShareTeam) {
+		return ErrInvalidPatternShare
+	}
+	if ps.Permission == "" {
+		ps.Permission = "view"
+	}
+	if ps.Permission != "view" && ps.Permission != "edit" {
+		return ErrInvalidPatternShare
+	}
+	return nil
+}
+
+// PatternSharesGrant checks whether the shares of a pattern grant the permission to the user, who is
+// a member of the team unless it is empty. The edit permission grants the view permission too.
+func PatternSharesGrant(shares []PatternShare, userID, team, permission string) bool {
+	for _, share := range shares {
+		if share.Permission != permission && share.Permission != "edit" {
+			continue
+		}
+		if (share.Kind == PatternShareUser && share.SharedWith == userID) || (share.Kind == PatternShareTeam && team != "" && share.SharedWith == team) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanTransitionTo checks whether a review in the current state can move to the given one.
+// Pending reviews can be approved or have changes requested, and reviews can be re-requested
+// once changes are made.
+func (s PatternReviewStatus) CanTransitionTo(next PatternReviewStatus) bool {
+	switch s {
+	case PatternReviewPending:
+		return next == PatternReviewApproved || next == PatternReviewChangesRequested
+	case PatternReviewApproved, PatternReviewChangesRequested:
+		return next == PatternReviewPending
+	}
+	return false
+}
+
+// CanBeSubmittedBy checks whether the user can move the review to the given state: the reviewer
+// alone approves the pattern or requests changes on it, and only the user who requested the review
+// re-requests it. A review the requester is the reviewer of cannot be submitted by anyone.
+func (pr *PatternReview) CanBeSubmittedBy(userID string, next PatternReviewStatus) bool {
+	if userID == "" || pr.Reviewer == pr.RequestedBy {
+		return false
+	}
+	if next == PatternReviewPending {
+		return userID == pr.RequestedBy
+	}
+	return userID == pr.Reviewer
+}
+
+// AggregatePatternReviewStatus returns the overall review status of a pattern: a single request
+// for changes blocks the pattern, and it is approved only once every requested review approves it
+func AggregatePatternReviewStatus(reviews []PatternReview) PatternReviewStatus {
+	if len(reviews) == 0 {
+		return ""
+	}
+
+	status := PatternReviewApproved
+	for _, review := range reviews {
+		switch review.Status {
+		case PatternReviewChangesRequested:
+			return PatternReviewChangesRequested
+		case PatternReviewPending:
+			status = PatternReviewPending
+		}
+	}
+	return status
+}
+
+// PatternCollaborationPersister is the persister for persisting
+// the shares and reviews of patterns on the database
+type PatternCollaborationPersister struct {
+	DB *database.Handler
+}
+
+// SavePatternShare persists the given share
+func (pcp *PatternCollaborationPersister) SavePatternShare(share *PatternShare) ([]byte, error) {
+	if share.ID == nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, ErrGenerateUUID(err)
+		}
+		share.ID = &id
+	}
+
+	if err := pcp.DB.Save(share).Error; err != nil {
+		return nil, err
+	}
+	return json.Marshal(share)
+}
+
+// GetPatternShares returns the shares of the pattern with the given id
+func (pcp *PatternCollaborationPersister) GetPatternShares(patternID uuid.UUID) ([]byte, error) {
+	shares := []PatternShare{}
+	if err := pcp.DB.Where("pattern_id = ?", patternID).Order("created_at").Find(&shares).Error; err != nil {
+		return nil, err
+	}
+	return json.Marshal(shares)
+}
+
+// SavePatternReview persists a new review request, or moves an existing review
+// to the state given in the review along with the reviewer's comment once the user
+// submitting the review is allowed to
+func (pcp *PatternCollaborationPersister) SavePatternReview(review *PatternReview) ([]byte, error) {
+	if review.ID == nil {
+		if review.Reviewer == review.RequestedBy {
+			return nil, ErrSelfPatternReview
+		}
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, ErrGenerateUUID(err)
+		}
+		review.ID = &id
+		review.Status = PatternReviewPending
+
+		if err := pcp.DB.Create(review).Error; err != nil {
+			return nil, err
+		}
+		return json.Marshal(review)
+	}
+
+	existing := PatternReview{}
+	if err := pcp.DB.Where("id = ? AND pattern_id = ?", review.ID, review.PatternID).First(&existing).Error; err != nil {
+		return nil, err
+	}
+	if !existing.CanBeSubmittedBy(review.SubmittedBy, review.Status) {
+		return nil, ErrPatternReviewNotAllowed(review.SubmittedBy, review.Status)
+	}
+	if !existing.Status.CanTransitionTo(review.Status) {
+		return nil, ErrInvalidPatternReviewTransition(existing.Status, review.Status)
+	}
+
+	existing.Status = review.Status
+	existing.Comment = review.Comment
+	existing.PatternSHA = ""
+	if review.Status == PatternReviewApproved {
+		existing.PatternSHA = review.PatternSHA
+	}
+	if err := pcp.DB.Save(&existing).Error; err != nil {
+		return nil, err
+	}
+	return json.Marshal(existing)
+}
+
+// GetPatternReviews returns the reviews of the pattern with the given id
+func (pcp *PatternCollaborationPersister) GetPatternReviews(patternID uuid.UUID) ([]byte, error) {
+	reviews := []PatternReview{}
+	if err := pcp.DB.Where("pattern_id = ?", patternID).Order("created_at").Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+	return json.Marshal(reviews)
+}
@@ -22,8 +22,9 @@ type MesheryPatternPage struct {
 	Patterns   []*MesheryPattern `json:"patterns"`
 }
 
-// GetMesheryPatterns returns all of the performance profiles
-func (mpp *MesheryPatternPersister) GetMesheryPatterns(search, order string, page, pageSize uint64) ([]byte, error) {
+// GetMesheryPatterns returns all of the patterns, those of the owner only if
+// one is given
+func (mpp *MesheryPatternPersister) GetMesheryPatterns(search, order, owner string, page, pageSize uint64) ([]byte, error) {
 	order = sanitizeOrderInput(order, []string{"created_at", "updated_at", "name"})
 
 	if order == "" {
@@ -40,7 +41,11 @@ func (mpp *MesheryPatternPersister) GetMesheryPatterns(search, order string, pag
 		query = query.Where("(lower(meshery_patterns.name) like ?)", like)
 	}
 
-	query.Table("meshery_patterns").Count(&count)
+	if owner != "" {
+		query = query.Where("meshery_patterns.user_id = ?", owner)
+	}
+
+	query.Model(&MesheryPattern{}).Count(&count)
 
 	Paginate(uint(page), uint(pageSize))(query).Find(&patterns)
 
@@ -54,7 +59,7 @@ func (mpp *MesheryPatternPersister) GetMesheryPatterns(search, order string, pag
 	return marshalMesheryPatternPage(mesheryPatternPage), nil
 }
 
-// DeleteMesheryPattern takes in a profile id and delete it if it already exists
+// DeleteMesheryPattern takes in a profile id and moves it to the trash if it already exists
 func (mpp *MesheryPatternPersister) DeleteMesheryPattern(id uuid.UUID) ([]byte, error) {
 	pattern := MesheryPattern{ID: &id}
 	mpp.DB.Delete(&pattern)
@@ -83,6 +88,12 @@ func (mpp *MesheryPatternPersister) SaveMesheryPattern(pattern *MesheryPattern)
 		}
 
 		pattern.ID = &id
+	} else {
+		// Ownership only changes by transfer
+		var existing MesheryPattern
+		if err := mpp.DB.Select("user_id").Where("id = ?", pattern.ID).Limit(1).Find(&existing).Error; err == nil && existing.UserID != nil && *existing.UserID != "" {
+			pattern.UserID = existing.UserID
+		}
 	}
 
 	return marshalMesheryPatterns([]MesheryPattern{*pattern}), mpp.DB.Save(pattern).Error
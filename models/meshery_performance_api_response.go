@@ -45,6 +45,16 @@ type PerformanceTestParameters struct {
 	Time int `json:"t"`
 	// duration e.g. s for second
 	Duration string `json:"dur"`
+	// render the request body as a template for every request
+	BodyTemplate bool `json:"bodyTemplate,omitempty"`
+	// CSV data whose rows the body template uses in turn
+	TemplateData string `json:"templateData,omitempty"`
+	// time over which the connections of a WebSocket test are opened e.g. 10s
+	WSRampUp string `json:"wsRampUp,omitempty"`
+	// messages sent per second on every connection of a WebSocket test
+	WSMessageRate float64 `json:"wsMessageRate,omitempty"`
+	// size in bytes of the messages of a WebSocket test
+	WSMessageSize int `json:"wsMessageSize,omitempty"`
 }
 
 // PerformanceProfilesAPIResponse response retruned by performance endpoint on meshery server
@@ -69,7 +79,7 @@ type PerformanceResult struct {
 	Name               string        `json:"name,omitempty"`
 	Mesh               string        `json:"mesh,omitempty"`
 	PerformanceProfile *uuid.UUID    `json:"performance_profile,omitempty"`
-	UserID             *uuid.UUID    `json:"user_id"`
+	UserID             *string       `json:"user_id"`
 	RunnerResults      RunnerResults `json:"runner_results"`
 	ServerMatrics      interface{}   `json:"server_metrics"`
 	ServerBoardConfig  interface{}   `json:"server_board_config,omitempty"`
@@ -83,6 +93,11 @@ type RunnerResults struct {
 	RequestedDuration string     `json:"RequestedDuration"`
 	QPS               float64    `json:"ActualQPS"`
 	StartTime         *time.Time `json:"StartTime"`
+	// Archived is set when only a summary of the result is kept, the result being in the result archive
+	Archived         *ArchivedResult   `json:"archived,omitempty"`
+	GrafanaSnapshots []GrafanaSnapshot `json:"grafana-snapshots,omitempty"`
+	// Assertions summarize the assertions the responses were validated with, if any
+	Assertions        *LoadTestAssertionsResult `json:"assertions,omitempty"`
 	DurationHistogram struct {
 		Average     float64 `json:"Avg,omitempty"`
 		Max         float64 `json:"Max,omitempty"`
@@ -91,5 +106,13 @@ type RunnerResults struct {
 			Percentile float64 `json:"Percentile,omitempty"`
 			Value      float64 `json:"Value,omitempty"`
 		} `json:"Percentiles,omitempty"`
+		// Data are the buckets of the histogram, with the percentage of the requests up to their end
+		Data []struct {
+			Start   float64 `json:"Start"`
+			End     float64 `json:"End"`
+			Percent float64 `json:"Percent"`
+		} `json:"Data,omitempty"`
 	} `json:"DurationHistogram,omitempty"`
+	// RetCodes counts the requests by the code they were answered with
+	RetCodes map[string]int64 `json:"RetCodes,omitempty"`
 }
@@ -32,18 +32,27 @@ type localMesheryResultDBRepresentation struct {
 	ServerBoardConfig interface{} `json:"server_board_config,omitempty" gorm:"type:JSONB"`
 
 	TestStartTime          *time.Time         `json:"test_start_time,omitempty"`
+	UserID                 string             `json:"user_id,omitempty"`
 	PerformanceProfileInfo PerformanceProfile `json:"-" gorm:"constraint:OnDelete:SET NULL;foreignKey:PerformanceProfile"`
 }
 
-func (mrp *MesheryResultsPersister) GetResults(page, pageSize uint64, profileID string) ([]byte, error) {
+// GetResults returns the results of the profile, those of the owner only if one is given, in the
+// order given by test_start_time or name if any
+func (mrp *MesheryResultsPersister) GetResults(page, pageSize uint64, profileID, owner, order string) ([]byte, error) {
 	var res []*localMesheryResultDBRepresentation
 	var count int64
 	query := mrp.DB.Where("performance_profile = ?", profileID)
+	if owner != "" {
+		query = query.Where("user_id = ?", owner)
+	}
 
 	err := query.Table("meshery_results").Count(&count).Error
 	if err != nil {
 		return nil, err
 	}
+	if order = sanitizeOrderInput(order, []string{"test_start_time", "name"}); order != "" {
+		query = query.Order(order)
+	}
 	err = Paginate(uint(page), uint(pageSize))(query).Find(&res).Error
 
 	resultPage := &MesheryResultPage{
@@ -56,10 +65,14 @@ func (mrp *MesheryResultsPersister) GetResults(page, pageSize uint64, profileID
 	return marshalMesheryResultsPage(resultPage), err
 }
 
-func (mrp *MesheryResultsPersister) GetAllResults(page, pageSize uint64) ([]byte, error) {
+// GetAllResults returns all of the results, those of the owner only if one is given
+func (mrp *MesheryResultsPersister) GetAllResults(page, pageSize uint64, owner string) ([]byte, error) {
 	var res []*localMesheryResultDBRepresentation
 	var count int64
 	query := mrp.DB.Table("meshery_results")
+	if owner != "" {
+		query = query.Where("user_id = ?", owner)
+	}
 
 	err := query.Count(&count).Error
 	if err != nil {
@@ -77,10 +90,24 @@ func (mrp *MesheryResultsPersister) GetAllResults(page, pageSize uint64) ([]byte
 	return marshalMesheryResultsPage(resultPage), err
 }
 
+// GetLatestResult returns the result of the latest test of the owner, nil if there is none
+func (mrp *MesheryResultsPersister) GetLatestResult(owner string) (*MesheryResult, error) {
+	var res []*localMesheryResultDBRepresentation
+	err := mrp.DB.Table("meshery_results").
+		Where("user_id = ?", owner).
+		Order("test_start_time desc").
+		Limit(1).
+		Find(&res).Error
+	if err != nil || len(res) == 0 {
+		return nil, err
+	}
+	return convertLocalRepresentationToMesheryResult(res[0]), nil
+}
+
 func (mrp *MesheryResultsPersister) GetResult(key uuid.UUID) (*MesheryResult, error) {
 	var lres localMesheryResultDBRepresentation
 
-	err := mrp.DB.Table("meshery_results").Find(&lres).Where("id = ?", key).Error
+	err := mrp.DB.Table("meshery_results").Where("id = ?", key).Find(&lres).Error
 	res := convertLocalRepresentationToMesheryResult(&lres)
 	return res, err
 }
@@ -98,6 +125,27 @@ func (mrp *MesheryResultsPersister) WriteResult(key uuid.UUID, result []byte) er
 	return mrp.DB.Table("meshery_results").Save(convertMesheryResultToLocalRepresentation(&data)).Error
 }
 
+// GetResultsStartedBefore returns up to limit results whose test started before the given
+// time and which were not moved to a result archive yet, oldest first
+func (mrp *MesheryResultsPersister) GetResultsStartedBefore(before time.Time, limit int) ([]*MesheryResult, error) {
+	var res []*localMesheryResultDBRepresentation
+	err := mrp.DB.Table("meshery_results").
+		Where("test_start_time < ?", before).
+		Where("result NOT LIKE ?", `%"`+ArchivedResultKey+`":{%`).
+		Order("test_start_time").
+		Limit(limit).
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return convertLocalRepresentationSliceToMesheryResultSlice(res), nil
+}
+
+// UpdateResult replaces the stored result with the given one
+func (mrp *MesheryResultsPersister) UpdateResult(result *MesheryResult) error {
+	return mrp.DB.Table("meshery_results").Save(convertMesheryResultToLocalRepresentation(result)).Error
+}
+
 func marshalMesheryResultsPage(mrp *MesheryResultPage) []byte {
 	res, _ := json.Marshal(mrp)
 
@@ -128,6 +176,7 @@ func convertLocalRepresentationToMesheryResult(local *localMesheryResultDBRepres
 		ServerMetrics:      local.ServerMetrics,
 		ServerBoardConfig:  local.ServerMetrics,
 		TestStartTime:      local.TestStartTime,
+		UserID:             local.UserID,
 	}
 
 	return res
@@ -145,6 +194,7 @@ func convertMesheryResultToLocalRepresentation(mr *MesheryResult) *localMesheryR
 		ServerMetrics:      mr.ServerMetrics,
 		ServerBoardConfig:  mr.ServerMetrics,
 		TestStartTime:      mr.TestStartTime,
+		UserID:             mr.UserID,
 	}
 
 	return res
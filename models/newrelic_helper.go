@@ -0,0 +1,136 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NerdGraph endpoints of the New Relic regions
+const (
+	NewRelicUSEndpoint = "https://api.newrelic.com/graphql"
+	NewRelicEUEndpoint = "https://api.eu.newrelic.com/graphql"
+)
+
+const newRelicNRQLQuery = `query($accountId: Int!, $nrql: Nrql!) {
+	actor { account(id: $accountId) { nrql(query: $nrql) { results } } }
+}`
+
+// NewRelicClient represents a New Relic metrics client in Meshery
+type NewRelicClient struct {
+	httpClient *http.Client
+}
+
+// NewNewRelicClient returns a NewRelicClient
+func NewNewRelicClient() *NewRelicClient {
+	return NewNewRelicClientWithHTTPClient(&http.Client{})
+}
+
+// NewNewRelicClientWithHTTPClient returns a NewRelicClient with a given http.Client
+func NewNewRelicClientWithHTTPClient(client *http.Client) *NewRelicClient {
+	return &NewRelicClient{
+		httpClient: client,
+	}
+}
+
+// Validate checks that the API key of the given config is accepted by New Relic
+func (n *NewRelicClient) Validate(ctx context.Context, cfg *NewRelic) error {
+	if _, err := n.makeRequest(ctx, cfg, `{ actor { user { email } } }`, nil); err != nil {
+		return ErrNewRelicQuery(err)
+	}
+	return nil
+}
+
+// Query runs a NRQL query against the account of the given config and returns its results
+func (n *NewRelicClient) Query(ctx context.Context, cfg *NewRelic, nrql string) ([]byte, error) {
+	accountID, err := strconv.Atoi(cfg.AccountID)
+	if err != nil {
+		return nil, ErrNewRelicQuery(fmt.Errorf("invalid account ID %q", cfg.AccountID))
+	}
+
+	data, err := n.makeRequest(ctx, cfg, newRelicNRQLQuery, map[string]interface{}{
+		"accountId": accountID,
+		"nrql":      nrql,
+	})
+	if err != nil {
+		return nil, ErrNewRelicQuery(err)
+	}
+
+	res := struct {
+		Actor struct {
+			Account struct {
+				NRQL struct {
+					Results json.RawMessage `json:"results"`
+				} `json:"nrql"`
+			} `json:"account"`
+		} `json:"actor"`
+	}{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, ErrUnmarshal(err, "New Relic response")
+	}
+	return res.Actor.Account.NRQL.Results, nil
+}
+
+// QueryRange runs a NRQL query restricted to the given time range
+func (n *NewRelicClient) QueryRange(ctx context.Context, cfg *NewRelic, nrql string, start, end time.Time) ([]byte, error) {
+	nrql = fmt.Sprintf("%s SINCE %d UNTIL %d", strings.TrimSpace(nrql), start.UnixNano()/int64(time.Millisecond), end.UnixNano()/int64(time.Millisecond))
+	return n.Query(ctx, cfg, nrql)
+}
+
+// makeRequest posts the GraphQL query to NerdGraph and returns the data of the response
+func (n *NewRelicClient) makeRequest(ctx context.Context, cfg *NewRelic, query string, variables map[string]interface{}) (json.RawMessage, error) {
+	endpoint := NewRelicUSEndpoint
+	if strings.EqualFold(cfg.Region, "eu") {
+		endpoint = NewRelicEUEndpoint
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("API-Key", cfg.APIKey)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch data from New Relic due to status code: %d", resp.StatusCode)
+	}
+
+	res := struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Errors) > 0 {
+		return nil, fmt.Errorf("%s", res.Errors[0].Message)
+	}
+	return res.Data, nil
+}
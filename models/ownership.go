@@ -0,0 +1,100 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm"
+)
+
+// Kinds of the artifacts whose ownership can be transferred
+const (
+	OwnedPattern            = "pattern"
+	OwnedPerformanceProfile = "profile"
+	OwnedResult             = "result"
+)
+
+// ownedTables are the tables of the artifacts of every kind, their owner being recorded
+// in their user_id column
+var ownedTables = map[string]string{
+	OwnedPattern:            "meshery_patterns",
+	OwnedPerformanceProfile: "performance_profiles",
+	OwnedResult:             "meshery_results",
+}
+
+// OwnershipTransfer reassigns artifacts to another user, such as the designs, performance
+// profiles and results of a team member leaving the team. Either the artifacts of the given
+// IDs or all the artifacts owned by From are transferred.
+type OwnershipTransfer struct {
+	// Kind of the artifacts transferred, pattern, profile or result, every kind if empty
+	Kind string `json:"kind,omitempty"`
+	// IDs of the artifacts transferred, of the given kind
+	IDs []string `json:"ids,omitempty"`
+	// From is the user whose artifacts are transferred
+	From string `json:"from,omitempty"`
+	// To is the user the artifacts are transferred to
+	To string `json:"to"`
+}
+
+// OwnershipTransferResult is the number of artifacts of every kind transferred
+type OwnershipTransferResult struct {
+	Transferred map[string]int64 `json:"transferred"`
+}
+
+// Validate reports whether the transfer selects the artifacts to transfer
+func (t *OwnershipTransfer) Validate() error {
+	if t.To == "" {
+		return fmt.Errorf("the user to transfer the artifacts to is required")
+	}
+	if t.Kind != "" {
+		if _, ok := ownedTables[t.Kind]; !ok {
+			return fmt.Errorf("unknown kind %s, expected %s, %s or %s", t.Kind, OwnedPattern, OwnedPerformanceProfile, OwnedResult)
+		}
+	}
+	if len(t.IDs) > 0 && t.Kind == "" {
+		return fmt.Errorf("the kind of the artifacts is required to transfer them by ID")
+	}
+	if len(t.IDs) == 0 && t.From == "" {
+		return fmt.Errorf("either the IDs of the artifacts or the user they are transferred from is required")
+	}
+	return nil
+}
+
+// kinds returns the kinds of the artifacts transferred
+func (t *OwnershipTransfer) kinds() []string {
+	if t.Kind != "" {
+		return []string{t.Kind}
+	}
+	return []string{OwnedPattern, OwnedPerformanceProfile, OwnedResult}
+}
+
+// transferOwnership records the new owner of the artifacts selected by the transfer in
+// the database
+func transferOwnership(db *database.Handler, transfer *OwnershipTransfer) (*OwnershipTransferResult, error) {
+	if err := transfer.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := &OwnershipTransferResult{Transferred: map[string]int64{}}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, kind := range transfer.kinds() {
+			query := tx.Table(ownedTables[kind])
+			if len(transfer.IDs) > 0 {
+				query = query.Where("id IN ?", transfer.IDs)
+			}
+			if transfer.From != "" {
+				query = query.Where("user_id = ?", transfer.From)
+			}
+			update := query.Update("user_id", transfer.To)
+			if update.Error != nil {
+				return update.Error
+			}
+			result.Transferred[kind] = update.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
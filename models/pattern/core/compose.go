@@ -0,0 +1,373 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// defaultComposeVolumeSize is the storage requested by the PersistentVolumeClaims
+// created for the named volumes of a compose file
+const defaultComposeVolumeSize = "1Gi"
+
+// composeFile is the subset of the compose specification understood by the importer
+type composeFile struct {
+	Name     string                    `yaml:"name,omitempty"`
+	Services map[string]composeService `yaml:"services,omitempty"`
+	Volumes  map[string]interface{}    `yaml:"volumes,omitempty"`
+}
+
+type composeService struct {
+	Image       string        `yaml:"image,omitempty"`
+	Command     interface{}   `yaml:"command,omitempty"`
+	Entrypoint  interface{}   `yaml:"entrypoint,omitempty"`
+	Environment interface{}   `yaml:"environment,omitempty"`
+	Ports       []interface{} `yaml:"ports,omitempty"`
+	Volumes     []interface{} `yaml:"volumes,omitempty"`
+	Deploy      struct {
+		Replicas *int `yaml:"replicas,omitempty"`
+	} `yaml:"deploy,omitempty"`
+}
+
+type composePort struct {
+	Published int
+	Target    int
+	Protocol  string
+}
+
+type composeMount struct {
+	Source   string
+	Target   string
+	ReadOnly bool
+}
+
+// NewPatternFileFromCompose converts the given Docker Compose file into a pattern.
+// Services are converted into Deployments, along with a Service when they publish
+// ports, and named volumes are converted into PersistentVolumeClaims.
+func NewPatternFileFromCompose(data []byte) (Pattern, error) {
+	name, manifests, err := ComposeToK8sManifests(data)
+	if err != nil {
+		return Pattern{}, err
+	}
+
+	pattern, err := NewPatternFileFromK8sManifest(manifests, false)
+	if err != nil {
+		return pattern, err
+	}
+	if name != "" {
+		pattern.Name = name
+	}
+	return pattern, nil
+}
+
+// ComposeToK8sManifests converts the given Docker Compose file into Kubernetes
+// manifests and returns them along with the name of the compose project
+func ComposeToK8sManifests(data []byte) (string, string, error) {
+	compose := composeFile{}
+	if err := yaml.Unmarshal(data, &compose); err != nil {
+		return "", "", ErrParseComposeFile(err)
+	}
+	if len(compose.Services) == 0 {
+		return "", "", ErrParseComposeFile(fmt.Errorf("no services found in the compose file"))
+	}
+
+	manifests := []map[string]interface{}{}
+
+	volumeNames := make([]string, 0, len(compose.Volumes))
+	for name := range compose.Volumes {
+		volumeNames = append(volumeNames, name)
+	}
+	sort.Strings(volumeNames)
+	for _, name := range volumeNames {
+		manifests = append(manifests, map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "PersistentVolumeClaim",
+			"metadata":   map[string]interface{}{"name": k8sName(name)},
+			"spec": map[string]interface{}{
+				"accessModes": []interface{}{"ReadWriteOnce"},
+				"resources": map[string]interface{}{
+					"requests": map[string]interface{}{"storage": defaultComposeVolumeSize},
+				},
+			},
+		})
+	}
+
+	serviceNames := make([]string, 0, len(compose.Services))
+	for name := range compose.Services {
+		serviceNames = append(serviceNames, name)
+	}
+	sort.Strings(serviceNames)
+	for _, name := range serviceNames {
+		svcManifests, err := composeServiceToK8s(k8sName(name), compose.Services[name], compose.Volumes)
+		if err != nil {
+			return "", "", ErrParseComposeFile(fmt.Errorf("service %s: %s", name, err))
+		}
+		manifests = append(manifests, svcManifests...)
+	}
+
+	docs := make([]string, 0, len(manifests))
+	for _, manifest := range manifests {
+		byt, err := yaml.Marshal(manifest)
+		if err != nil {
+			return "", "", ErrParseComposeFile(err)
+		}
+		docs = append(docs, string(byt))
+	}
+	return compose.Name, strings.Join(docs, "\n---\n"), nil
+}
+
+func composeServiceToK8s(name string, svc composeService, namedVolumes map[string]interface{}) ([]map[string]interface{}, error) {
+	if svc.Image == "" {
+		return nil, fmt.Errorf("only services with an image are supported, build contexts cannot be imported")
+	}
+
+	labels := map[string]interface{}{"app": name}
+	container := map[string]interface{}{
+		"name":  name,
+		"image": svc.Image,
+	}
+	if args := toStringSlice(svc.Command); len(args) > 0 {
+		container["args"] = args
+	}
+	if cmd := toStringSlice(svc.Entrypoint); len(cmd) > 0 {
+		container["command"] = cmd
+	}
+	if env := composeEnvironment(svc.Environment); len(env) > 0 {
+		container["env"] = env
+	}
+
+	ports := []composePort{}
+	for _, p := range svc.Ports {
+		port, err := parseComposePort(p)
+		if err != nil {
+			return nil, err
+		}
+		ports = append(ports, port)
+	}
+	if len(ports) > 0 {
+		containerPorts := []interface{}{}
+		for _, port := range ports {
+			containerPorts = append(containerPorts, map[string]interface{}{
+				"containerPort": port.Target,
+				"protocol":      port.Protocol,
+			})
+		}
+		container["ports"] = containerPorts
+	}
+
+	volumes := []interface{}{}
+	mounts := []interface{}{}
+	for i, v := range svc.Volumes {
+		mount, err := parseComposeMount(v)
+		if err != nil {
+			return nil, err
+		}
+
+		volumeName := fmt.Sprintf("%s-volume-%d", name, i)
+		volume := map[string]interface{}{"name": volumeName}
+		if _, ok := namedVolumes[mount.Source]; ok {
+			volume["persistentVolumeClaim"] = map[string]interface{}{"claimName": k8sName(mount.Source)}
+		} else {
+			// Bind mounts and anonymous volumes have no counterpart in the cluster
+			volume["emptyDir"] = map[string]interface{}{}
+		}
+		volumes = append(volumes, volume)
+		mounts = append(mounts, map[string]interface{}{
+			"name":      volumeName,
+			"mountPath": mount.Target,
+			"readOnly":  mount.ReadOnly,
+		})
+	}
+	if len(mounts) > 0 {
+		container["volumeMounts"] = mounts
+	}
+
+	replicas := 1
+	if svc.Deploy.Replicas != nil {
+		replicas = *svc.Deploy.Replicas
+	}
+
+	podSpec := map[string]interface{}{"containers": []interface{}{container}}
+	if len(volumes) > 0 {
+		podSpec["volumes"] = volumes
+	}
+
+	manifests := []map[string]interface{}{{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": name, "labels": labels},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"selector": map[string]interface{}{"matchLabels": labels},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": labels},
+				"spec":     podSpec,
+			},
+		},
+	}}
+
+	if len(ports) > 0 {
+		servicePorts := []interface{}{}
+		for _, port := range ports {
+			published := port.Published
+			if published == 0 {
+				published = port.Target
+			}
+			servicePorts = append(servicePorts, map[string]interface{}{
+				"name":       fmt.Sprintf("%s-%d", strings.ToLower(port.Protocol), published),
+				"port":       published,
+				"targetPort": port.Target,
+				"protocol":   port.Protocol,
+			})
+		}
+		manifests = append(manifests, map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]interface{}{"name": name, "labels": labels},
+			"spec": map[string]interface{}{
+				"selector": labels,
+				"ports":    servicePorts,
+			},
+		})
+	}
+
+	return manifests, nil
+}
+
+// parseComposePort parses both the short ("8080:80/udp") and the long syntax of a port
+func parseComposePort(p interface{}) (composePort, error) {
+	port := composePort{Protocol: "TCP"}
+
+	switch v := p.(type) {
+	case int:
+		port.Target = v
+		return port, nil
+	case string:
+		spec := v
+		if i := strings.LastIndex(spec, "/"); i >= 0 {
+			port.Protocol = strings.ToUpper(spec[i+1:])
+			spec = spec[:i]
+		}
+		parts := strings.Split(spec, ":")
+		target, err := strconv.Atoi(parts[len(parts)-1])
+		if err != nil {
+			return port, fmt.Errorf("invalid port %q", v)
+		}
+		port.Target = target
+		if len(parts) > 1 {
+			// ignore the host IP, if any, and port ranges which have no counterpart in a Service
+			published, err := strconv.Atoi(parts[len(parts)-2])
+			if err != nil {
+				return port, fmt.Errorf("invalid port %q", v)
+			}
+			port.Published = published
+		}
+		return port, nil
+	case map[interface{}]interface{}:
+		target, ok := v["target"].(int)
+		if !ok {
+			return port, fmt.Errorf("port target is required")
+		}
+		port.Target = target
+		switch published := v["published"].(type) {
+		case int:
+			port.Published = published
+		case string:
+			port.Published, _ = strconv.Atoi(published)
+		}
+		if protocol, ok := v["protocol"].(string); ok {
+			port.Protocol = strings.ToUpper(protocol)
+		}
+		return port, nil
+	}
+	return port, fmt.Errorf("invalid port %v", p)
+}
+
+// parseComposeMount parses both the short ("data:/var/lib/data:ro") and the long syntax of a volume
+func parseComposeMount(v interface{}) (composeMount, error) {
+	switch m := v.(type) {
+	case string:
+		parts := strings.Split(m, ":")
+		switch len(parts) {
+		case 1:
+			return composeMount{Target: parts[0]}, nil
+		case 2:
+			return composeMount{Source: parts[0], Target: parts[1]}, nil
+		default:
+			return composeMount{Source: parts[0], Target: parts[1], ReadOnly: parts[2] == "ro"}, nil
+		}
+	case map[interface{}]interface{}:
+		mount := composeMount{}
+		mount.Source, _ = m["source"].(string)
+		mount.Target, _ = m["target"].(string)
+		mount.ReadOnly, _ = m["read_only"].(bool)
+		if mount.Target == "" {
+			return mount, fmt.Errorf("volume target is required")
+		}
+		return mount, nil
+	}
+	return composeMount{}, fmt.Errorf("invalid volume %v", v)
+}
+
+// composeEnvironment converts both the map and the list syntax of environment variables
+func composeEnvironment(env interface{}) []interface{} {
+	vars := map[string]string{}
+	switch e := env.(type) {
+	case map[interface{}]interface{}:
+		for k, v := range e {
+			if v == nil {
+				vars[fmt.Sprint(k)] = ""
+				continue
+			}
+			vars[fmt.Sprint(k)] = fmt.Sprint(v)
+		}
+	case []interface{}:
+		for _, item := range e {
+			kv := strings.SplitN(fmt.Sprint(item), "=", 2)
+			if len(kv) == 2 {
+				vars[kv[0]] = kv[1]
+			} else {
+				vars[kv[0]] = ""
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := []interface{}{}
+	for _, k := range keys {
+		result = append(result, map[string]interface{}{"name": k, "value": vars[k]})
+	}
+	return result
+}
+
+// toStringSlice converts a command given either as a string or as a list
+func toStringSlice(v interface{}) []interface{} {
+	switch c := v.(type) {
+	case string:
+		result := []interface{}{}
+		for _, f := range strings.Fields(c) {
+			result = append(result, f)
+		}
+		return result
+	case []interface{}:
+		result := []interface{}{}
+		for _, f := range c {
+			result = append(result, fmt.Sprint(f))
+		}
+		return result
+	}
+	return nil
+}
+
+// k8sName turns a compose name into a valid Kubernetes resource name
+func k8sName(name string) string {
+	return strings.Trim(strings.ReplaceAll(strings.ToLower(name), "_", "-"), "-")
+}
@@ -0,0 +1,101 @@
+package core
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestComposeToK8sManifests(t *testing.T) {
+	compose := `
+name: shop
+services:
+  web:
+    image: nginx:1.21
+    ports:
+      - "8080:80"
+    environment:
+      MODE: production
+    volumes:
+      - static_data:/usr/share/nginx/html:ro
+      - ./conf:/etc/nginx/conf.d
+  worker:
+    image: shop/worker
+    command: ["run", "--queue", "orders"]
+    deploy:
+      replicas: 3
+volumes:
+  static_data: {}
+`
+	name, manifests, err := ComposeToK8sManifests([]byte(compose))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "shop" {
+		t.Errorf("got name %q want %q", name, "shop")
+	}
+
+	kinds := []string{}
+	byName := map[string]map[interface{}]interface{}{}
+	for _, doc := range strings.Split(manifests, "\n---\n") {
+		manifest := map[interface{}]interface{}{}
+		if err := yaml.Unmarshal([]byte(doc), &manifest); err != nil {
+			t.Fatal(err)
+		}
+		metadata := manifest["metadata"].(map[interface{}]interface{})
+		kind := manifest["kind"].(string)
+		kinds = append(kinds, kind)
+		byName[kind+"/"+metadata["name"].(string)] = manifest
+	}
+
+	wantKinds := []string{"PersistentVolumeClaim", "Deployment", "Service", "Deployment"}
+	if !reflect.DeepEqual(kinds, wantKinds) {
+		t.Fatalf("got kinds %v want %v", kinds, wantKinds)
+	}
+	if _, ok := byName["PersistentVolumeClaim/static-data"]; !ok {
+		t.Error("expected a PersistentVolumeClaim for the named volume")
+	}
+
+	service := byName["Service/web"]["spec"].(map[interface{}]interface{})
+	port := service["ports"].([]interface{})[0].(map[interface{}]interface{})
+	if port["port"] != 8080 || port["targetPort"] != 80 {
+		t.Errorf("got service port %v want 8080 -> 80", port)
+	}
+
+	worker := byName["Deployment/worker"]["spec"].(map[interface{}]interface{})
+	if worker["replicas"] != 3 {
+		t.Errorf("got %v replicas want 3", worker["replicas"])
+	}
+	if _, ok := byName["Service/worker"]; ok {
+		t.Error("expected no Service for a service without ports")
+	}
+}
+
+func TestComposeToK8sManifestsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		compose string
+	}{
+		{
+			name:    "No services",
+			compose: "volumes:\n  data: {}\n",
+		},
+		{
+			name:    "Service without an image",
+			compose: "services:\n  app:\n    build: .\n",
+		},
+		{
+			name:    "Invalid port",
+			compose: "services:\n  app:\n    image: app\n    ports:\n      - \"http:80\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := ComposeToK8sManifests([]byte(tt.compose)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
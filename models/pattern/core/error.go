@@ -8,6 +8,7 @@ const (
 	ErrGetK8sComponentsCode     = "2154"
 	ErrParseK8sManifestCode     = "2155"
 	ErrCreatePatternServiceCode = "2156"
+	ErrParseComposeFileCode     = "2182"
 )
 
 func ErrGetK8sComponents(err error) error {
@@ -21,3 +22,7 @@ func ErrParseK8sManifest(err error) error {
 func ErrCreatePatternService(err error) error {
 	return errors.New(ErrParseK8sManifestCode, errors.Alert, []string{"Failed to create pattern service from Manifest"}, []string{err.Error()}, []string{"Invalid Manifest", "Meshery doesn't identifies the Resource mentioned in the Manifest"}, []string{"Check if all of the meshery adapters are running", "Check if Meshery has successfully identified and registered Kubernetes components"})
 }
+
+func ErrParseComposeFile(err error) error {
+	return errors.New(ErrParseComposeFileCode, errors.Alert, []string{"Failed to convert the compose file"}, []string{err.Error()}, []string{"Compose file is invalid or uses features that have no counterpart in Kubernetes"}, []string{"Ensure every service has an image and the ports and volumes use the compose specification syntax"})
+}
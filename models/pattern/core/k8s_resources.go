@@ -0,0 +1,359 @@
+package core
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/models/pattern/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// NewPatternFileFromK8sResources creates a pattern out of kubernetes resources which
+// already exist in a cluster. Resources that Meshery has no components for are skipped.
+// The relationships between the resources, e.g. a Service selecting the pods of a
+// Deployment or a Deployment mounting a ConfigMap, are recorded as dependencies of
+// the services of the pattern.
+func NewPatternFileFromK8sResources(name string, manifests []map[string]interface{}) (Pattern, error) {
+	pattern := Pattern{
+		Name:     name,
+		Services: map[string]*Service{},
+	}
+
+	for _, manifest := range manifests {
+		manifest = utils.RecursiveCastMapStringInterfaceToMapStringInterface(manifest)
+		if manifest == nil {
+			continue
+		}
+
+		id, svc, err := createPatternServiceFromK8s(manifest)
+		if err != nil {
+			logrus.Debugf("skipping resource while creating pattern from kubernetes resources: %s", err)
+			continue
+		}
+
+		pattern.Services[id] = &svc
+	}
+
+	InferRelationships(&pattern, manifests)
+
+	return pattern, nil
+}
+
+// InferredRelationshipsAnnotation is the annotation of a service listing the ids of
+// the services it was found to depend on by InferRelationships
+const InferredRelationshipsAnnotation = "relationships.meshery.io/inferred"
+
+// InferRelationships adds to the dependencies of the services of the pattern those
+// inferred from their kubernetes resources, e.g. a Service selecting the pods of a
+// Deployment, an Ingress routing to a Service or a Deployment mounting a ConfigMap.
+// A service is related as the live resource of the same kind, namespace and name
+// found in the cluster, as discovered by MeshSync, rather than as it is described
+// in the pattern, if there is one. The inferred dependencies are recorded under the
+// InferredRelationshipsAnnotation of the services. It returns the number of
+// dependencies added.
+func InferRelationships(pattern *Pattern, live []map[string]interface{}) int {
+	liveResources := map[k8sRef]map[string]interface{}{}
+	for _, manifest := range live {
+		manifest = utils.RecursiveCastMapStringInterfaceToMapStringInterface(manifest)
+		if manifest != nil {
+			liveResources[refOf(manifest)] = manifest
+		}
+	}
+
+	resources := map[string]map[string]interface{}{}
+	for id, svc := range pattern.Services {
+		manifest := manifestOfService(svc)
+		ref := refOf(manifest)
+		liveManifest, ok := liveResources[ref]
+		if !ok && ref.namespace == "" {
+			// Services without a namespace are deployed to the default one
+			ref.namespace = "default"
+			liveManifest, ok = liveResources[ref]
+		}
+		if ok {
+			manifest = withNamespace(liveManifest, svc.Namespace)
+		}
+		resources[id] = manifest
+	}
+
+	added := 0
+	for id, inferred := range inferK8sDependencies(resources) {
+		svc := pattern.Services[id]
+		dependsOn := map[string]bool{}
+		for _, dep := range svc.DependsOn {
+			dependsOn[dep] = true
+		}
+		for _, dep := range inferred {
+			if !dependsOn[dep] {
+				dependsOn[dep] = true
+				svc.DependsOn = append(svc.DependsOn, dep)
+				added++
+			}
+		}
+		sort.Strings(svc.DependsOn)
+
+		if svc.Annotations == nil {
+			svc.Annotations = map[string]string{}
+		}
+		svc.Annotations[InferredRelationshipsAnnotation] = strings.Join(inferred, ",")
+	}
+	return added
+}
+
+// manifestOfService returns the kubernetes manifest of the resource a service stands for
+func manifestOfService(svc *Service) map[string]interface{} {
+	kind := strings.SplitN(svc.Type, ".", 2)[0]
+	for _, w := range GetWorkload(svc.Type) {
+		if k := w.OAMDefinition.Spec.Metadata["k8sKind"]; k != "" {
+			kind = k
+			break
+		}
+	}
+
+	metadata := map[string]interface{}{"name": svc.Name}
+	if svc.Namespace != "" {
+		metadata["namespace"] = svc.Namespace
+	}
+	manifest := map[string]interface{}{
+		"kind":     kind,
+		"metadata": metadata,
+	}
+
+	// The settings of core resources are the manifest without its metadata, those
+	// of the other resources are their spec
+	settings := utils.RecursiveCastMapStringInterfaceToMapStringInterface(svc.Settings)
+	if _, ok := settings["spec"]; ok {
+		for k, v := range settings {
+			manifest[k] = v
+		}
+	} else if len(settings) > 0 {
+		manifest["spec"] = settings
+	}
+	return manifest
+}
+
+// withNamespace returns a copy of the manifest in the given namespace
+func withNamespace(manifest map[string]interface{}, namespace string) map[string]interface{} {
+	metadata := map[string]interface{}{}
+	if m, ok := manifest["metadata"].(map[string]interface{}); ok {
+		for k, v := range m {
+			metadata[k] = v
+		}
+	}
+	delete(metadata, "namespace")
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	}
+
+	result := map[string]interface{}{}
+	for k, v := range manifest {
+		result[k] = v
+	}
+	result["metadata"] = metadata
+	return result
+}
+
+// k8sRef identifies a kubernetes resource
+type k8sRef struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// inferK8sDependencies returns, for every resource which depends on others, the
+// ids of the resources it depends on
+func inferK8sDependencies(resources map[string]map[string]interface{}) map[string][]string {
+	ids := map[k8sRef]string{}
+	for id, manifest := range resources {
+		ids[refOf(manifest)] = id
+	}
+
+	deps := map[string]map[string]bool{}
+	addDep := func(id string, ref k8sRef) {
+		depID, ok := ids[ref]
+		if !ok || depID == id {
+			return
+		}
+		if deps[id] == nil {
+			deps[id] = map[string]bool{}
+		}
+		deps[id][depID] = true
+	}
+
+	for id, manifest := range resources {
+		ref := refOf(manifest)
+
+		// Namespaced resources depend on their namespace
+		if ref.namespace != "" {
+			addDep(id, k8sRef{kind: "Namespace", name: ref.namespace})
+		}
+
+		switch ref.kind {
+		case "Service":
+			selector := stringMap(nested(manifest, "spec", "selector"))
+			if len(selector) == 0 {
+				continue
+			}
+			for _, other := range resources {
+				otherRef := refOf(other)
+				if otherRef.namespace != ref.namespace {
+					continue
+				}
+				if _, podLabels := podTemplate(other); podLabels != nil && selects(selector, podLabels) {
+					addDep(id, otherRef)
+				}
+			}
+		case "Ingress":
+			for _, svcName := range ingressBackends(manifest) {
+				addDep(id, k8sRef{kind: "Service", namespace: ref.namespace, name: svcName})
+			}
+		default:
+			podSpec, _ := podTemplate(manifest)
+			for _, dep := range podSpecReferences(podSpec) {
+				dep.namespace = ref.namespace
+				addDep(id, dep)
+			}
+		}
+	}
+
+	result := map[string][]string{}
+	for id, set := range deps {
+		for depID := range set {
+			result[id] = append(result[id], depID)
+		}
+		sort.Strings(result[id])
+	}
+	return result
+}
+
+// refOf returns the reference to the resource described by the manifest
+func refOf(manifest map[string]interface{}) k8sRef {
+	kind, _ := manifest["kind"].(string)
+	name, _ := nested(manifest, "metadata", "name").(string)
+	namespace, _ := nested(manifest, "metadata", "namespace").(string)
+	return k8sRef{kind: kind, namespace: namespace, name: name}
+}
+
+// podTemplate returns the pod spec and pod labels of a workload resource, both
+// nil if the resource does not run pods
+func podTemplate(manifest map[string]interface{}) (map[string]interface{}, map[string]string) {
+	var template interface{}
+	switch kind, _ := manifest["kind"].(string); kind {
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job":
+		template = nested(manifest, "spec", "template")
+	case "CronJob":
+		template = nested(manifest, "spec", "jobTemplate", "spec", "template")
+	case "Pod":
+		template = manifest
+	}
+
+	t, ok := template.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	spec, _ := t["spec"].(map[string]interface{})
+	labels := stringMap(nested(t, "metadata", "labels"))
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return spec, labels
+}
+
+// podSpecReferences returns the ServiceAccount, ConfigMaps, Secrets and
+// PersistentVolumeClaims referenced by the pod spec
+func podSpecReferences(podSpec map[string]interface{}) []k8sRef {
+	if podSpec == nil {
+		return nil
+	}
+
+	refs := []k8sRef{}
+	add := func(kind string, name interface{}) {
+		if n, ok := name.(string); ok && n != "" {
+			refs = append(refs, k8sRef{kind: kind, name: n})
+		}
+	}
+
+	add("ServiceAccount", podSpec["serviceAccountName"])
+	for _, v := range slice(podSpec["volumes"]) {
+		add("ConfigMap", nested(v, "configMap", "name"))
+		add("Secret", nested(v, "secret", "secretName"))
+		add("PersistentVolumeClaim", nested(v, "persistentVolumeClaim", "claimName"))
+	}
+
+	containers := append(slice(podSpec["containers"]), slice(podSpec["initContainers"])...)
+	for _, c := range containers {
+		for _, envFrom := range slice(nested(c, "envFrom")) {
+			add("ConfigMap", nested(envFrom, "configMapRef", "name"))
+			add("Secret", nested(envFrom, "secretRef", "name"))
+		}
+		for _, env := range slice(nested(c, "env")) {
+			add("ConfigMap", nested(env, "valueFrom", "configMapKeyRef", "name"))
+			add("Secret", nested(env, "valueFrom", "secretKeyRef", "name"))
+		}
+	}
+	return refs
+}
+
+// ingressBackends returns the names of the Services an Ingress routes to
+func ingressBackends(manifest map[string]interface{}) []string {
+	names := []string{}
+	add := func(backend interface{}) {
+		// networking.k8s.io/v1 and the older v1beta1 backend format
+		if n, ok := nested(backend, "service", "name").(string); ok && n != "" {
+			names = append(names, n)
+		}
+		if n, ok := nested(backend, "serviceName").(string); ok && n != "" {
+			names = append(names, n)
+		}
+	}
+
+	add(nested(manifest, "spec", "defaultBackend"))
+	add(nested(manifest, "spec", "backend"))
+	for _, rule := range slice(nested(manifest, "spec", "rules")) {
+		for _, path := range slice(nested(rule, "http", "paths")) {
+			add(nested(path, "backend"))
+		}
+	}
+	return names
+}
+
+// selects reports whether the selector matches the labels
+func selects(selector, labels map[string]string) bool {
+	for k, v := range selector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// nested returns the value found by following the keys through nested maps
+func nested(v interface{}, keys ...string) interface{} {
+	for _, k := range keys {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[k]
+	}
+	return v
+}
+
+func slice(v interface{}) []interface{} {
+	s, _ := v.([]interface{})
+	return s
+}
+
+func stringMap(v interface{}) map[string]string {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	result := map[string]string{}
+	for k, val := range m {
+		if s, ok := val.(string); ok {
+			result[k] = s
+		}
+	}
+	return result
+}
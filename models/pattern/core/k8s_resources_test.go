@@ -0,0 +1,198 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/internal/store"
+)
+
+func TestInferK8sDependencies(t *testing.T) {
+	resources := map[string]map[string]interface{}{
+		"ns": {
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]interface{}{"name": "shop"},
+		},
+		"config": {
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "web-config", "namespace": "shop"},
+		},
+		"sa": {
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "shop"},
+		},
+		"web": {
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "shop"},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{"app": "web", "tier": "frontend"},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "web",
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "web",
+								"envFrom": []interface{}{
+									map[string]interface{}{"configMapRef": map[string]interface{}{"name": "web-config"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"svc": {
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "shop"},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{"app": "web"},
+			},
+		},
+		"other-svc": {
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "other"},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{"app": "web"},
+			},
+		},
+		"ingress": {
+			"apiVersion": "networking.k8s.io/v1",
+			"kind":       "Ingress",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "shop"},
+			"spec": map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{
+						"http": map[string]interface{}{
+							"paths": []interface{}{
+								map[string]interface{}{
+									"backend": map[string]interface{}{
+										"service": map[string]interface{}{"name": "web"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := inferK8sDependencies(resources)
+	want := map[string][]string{
+		"config":  {"ns"},
+		"sa":      {"ns"},
+		"web":     {"config", "ns", "sa"},
+		"svc":     {"ns", "web"},
+		"ingress": {"ns", "svc"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got dependencies %v want %v", got, want)
+	}
+}
+
+func TestInferRelationships(t *testing.T) {
+	// The kinds of the services are found in the registry, empty here
+	store.Initialize()
+
+	pattern := &Pattern{
+		Name: "shop",
+		Services: map[string]*Service{
+			"config": {
+				Name:      "web-config",
+				Type:      "ConfigMap.K8s",
+				Namespace: "shop",
+				Settings:  map[string]interface{}{"data": map[string]interface{}{"mode": "production"}},
+			},
+			"web": {
+				Name:      "web",
+				Type:      "Deployment.K8s",
+				Namespace: "shop",
+				Settings: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"metadata": map[string]interface{}{
+								"labels": map[string]interface{}{"app": "web"},
+							},
+						},
+					},
+				},
+			},
+			"svc": {
+				Name:      "web",
+				Type:      "Service.K8s",
+				Namespace: "shop",
+				DependsOn: []string{"config"},
+				Settings: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"selector": map[string]interface{}{"app": "web"},
+					},
+				},
+			},
+			"worker": {
+				Name:     "worker",
+				Type:     "Deployment.K8s",
+				Settings: map[string]interface{}{},
+			},
+		},
+	}
+
+	// The live Deployments mount the ConfigMap, which their designs do not
+	mount := map[string]interface{}{
+		"volumes": []interface{}{
+			map[string]interface{}{"configMap": map[string]interface{}{"name": "web-config"}},
+		},
+	}
+	live := []map[string]interface{}{
+		{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "shop"},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{"app": "web"},
+					},
+					"spec": mount,
+				},
+			},
+		},
+		{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "worker", "namespace": "default"},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{"spec": mount},
+			},
+		},
+	}
+
+	if added := InferRelationships(pattern, live); added != 2 {
+		t.Errorf("got %d dependencies added want 2", added)
+	}
+
+	want := map[string][]string{
+		"config": nil,
+		"web":    {"config"},
+		"svc":    {"config", "web"},
+		"worker": nil,
+	}
+	for id, dependsOn := range want {
+		if got := pattern.Services[id].DependsOn; !reflect.DeepEqual(got, dependsOn) {
+			t.Errorf("got dependencies %v of %s want %v", got, id, dependsOn)
+		}
+	}
+	if got := pattern.Services["svc"].Annotations[InferredRelationshipsAnnotation]; got != "web" {
+		t.Errorf("got inferred relationships %q of svc want %q", got, "web")
+	}
+	if _, ok := pattern.Services["config"].Annotations[InferredRelationshipsAnnotation]; ok {
+		t.Errorf("got inferred relationships of config, which depends on nothing")
+	}
+}
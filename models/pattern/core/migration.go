@@ -0,0 +1,380 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/layer5io/meshery/internal/store"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// Actions of the migration rules
+const (
+	// MigrationRename moves the value of the deprecated field to its replacement
+	MigrationRename = "rename"
+	// MigrationRemove deletes the deprecated field
+	MigrationRemove = "remove"
+	// MigrationReport only reports the deprecated field, its replacement requiring a manual change
+	MigrationReport = "report"
+)
+
+// MigrationCapability is a rule upgrading a deprecated field of the components of a type,
+// registered by the model publishing the version of the components deprecating it
+type MigrationCapability struct {
+	// Type is the type of the components the rule applies to, e.g. VirtualService
+	Type string `json:"type"`
+	// Model is the model deprecating the field, e.g. istio, as of Version
+	Model   string `json:"model"`
+	Version string `json:"version"`
+	// Field is the path of the deprecated field in the settings of the component,
+	// [*] standing for every item of a list, e.g. spec.http[*].mirror_percent
+	Field  string `json:"field"`
+	Action string `json:"action"`
+	// To is the path of the replacement of the field, its [*] standing for the items
+	// of the lists of Field in order
+	To      string `json:"to,omitempty"`
+	Message string `json:"message,omitempty"`
+
+	genericCapability
+}
+
+// Validate checks that the rule has a type, a field and a valid action
+func (m *MigrationCapability) Validate() error {
+	if m.Type == "" || m.Field == "" {
+		return fmt.Errorf("migration rule requires a type and a field")
+	}
+	switch m.Action {
+	case MigrationRemove, MigrationReport:
+	case MigrationRename:
+		if m.To == "" {
+			return fmt.Errorf("migration rule renaming %s of %s requires the field to rename it to", m.Field, m.Type)
+		}
+		if strings.Count(m.To, "[*]") > strings.Count(m.Field, "[*]") {
+			return fmt.Errorf("migration rule renaming %s of %s to %s has more lists in its replacement than in its field", m.Field, m.Type, m.To)
+		}
+	default:
+		return fmt.Errorf("invalid action %q of migration rule, expected %s, %s or %s", m.Action, MigrationRename, MigrationRemove, MigrationReport)
+	}
+	return nil
+}
+
+// RegisterMigration will register a migration rule into the database
+func RegisterMigration(data []byte) (err error) {
+	var migration MigrationCapability
+
+	if err = json.Unmarshal(data, &migration); err != nil {
+		return
+	}
+	if err = migration.Validate(); err != nil {
+		return
+	}
+
+	store.Set("/meshery/registry/migration/"+strings.ToLower(migration.Type), &migration)
+
+	return
+}
+
+// GetMigrations returns all of the migration rules registered
+func GetMigrations() (m []MigrationCapability) {
+	res := store.PrefixMatch("/meshery/registry/migration/")
+	for _, mc := range res {
+		casted, ok := mc.(*MigrationCapability)
+		if ok {
+			m = append(m, *casted)
+		}
+	}
+
+	return
+}
+
+// mesheryMigrations are the migration rules of the deprecations of Kubernetes and Istio known to Meshery
+var mesheryMigrations = []MigrationCapability{
+	{Type: "Deployment", Model: "kubernetes", Version: "1.0", Field: "spec.template.spec.serviceAccount", Action: MigrationRename, To: "spec.template.spec.serviceAccountName",
+		Message: "serviceAccount is a deprecated alias of serviceAccountName"},
+	{Type: "StatefulSet", Model: "kubernetes", Version: "1.0", Field: "spec.template.spec.serviceAccount", Action: MigrationRename, To: "spec.template.spec.serviceAccountName",
+		Message: "serviceAccount is a deprecated alias of serviceAccountName"},
+	{Type: "DaemonSet", Model: "kubernetes", Version: "1.0", Field: "spec.template.spec.serviceAccount", Action: MigrationRename, To: "spec.template.spec.serviceAccountName",
+		Message: "serviceAccount is a deprecated alias of serviceAccountName"},
+	{Type: "Pod", Model: "kubernetes", Version: "1.0", Field: "spec.serviceAccount", Action: MigrationRename, To: "spec.serviceAccountName",
+		Message: "serviceAccount is a deprecated alias of serviceAccountName"},
+	{Type: "Ingress", Model: "kubernetes", Version: "1.19", Field: "spec.backend", Action: MigrationRename, To: "spec.defaultBackend",
+		Message: "backend was renamed to defaultBackend in networking.k8s.io/v1"},
+	{Type: "Ingress", Model: "kubernetes", Version: "1.19", Field: "spec.rules[*].http.paths[*].backend.serviceName", Action: MigrationRename, To: "spec.rules[*].http.paths[*].backend.service.name",
+		Message: "serviceName was replaced by service.name in networking.k8s.io/v1"},
+	{Type: "Ingress", Model: "kubernetes", Version: "1.19", Field: "spec.rules[*].http.paths[*].backend.servicePort", Action: MigrationReport,
+		Message: "servicePort was replaced by service.port.number, or service.port.name for a named port, in networking.k8s.io/v1"},
+	{Type: "HorizontalPodAutoscaler", Model: "kubernetes", Version: "1.23", Field: "spec.targetCPUUtilizationPercentage", Action: MigrationReport,
+		Message: "targetCPUUtilizationPercentage was replaced by a Resource metric of spec.metrics in autoscaling/v2"},
+	{Type: "VirtualService", Model: "istio", Version: "1.9", Field: "spec.http[*].mirror_percent", Action: MigrationReport,
+		Message: "mirror_percent was replaced by mirrorPercentage, whose value is a percentage given as a double"},
+	{Type: "VirtualService", Model: "istio", Version: "1.9", Field: "spec.http[*].mirrorPercent", Action: MigrationReport,
+		Message: "mirrorPercent was replaced by mirrorPercentage, whose value is a percentage given as a double"},
+	{Type: "EnvoyFilter", Model: "istio", Version: "1.2", Field: "spec.workloadLabels", Action: MigrationRename, To: "spec.workloadSelector.labels",
+		Message: "workloadLabels was replaced by workloadSelector"},
+}
+
+// RegisterMesheryMigrations will register the migration rules known to meshery server
+func RegisterMesheryMigrations() error {
+	var errs []string
+	for _, migration := range mesheryMigrations {
+		data, err := json.Marshal(migration)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		if err := RegisterMigration(data); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", strings.Join(errs, "\n"))
+}
+
+// UpgradeFinding is a deprecated field used by a component of a pattern
+type UpgradeFinding struct {
+	// Component is the name of the component in the services of the pattern
+	Component string `json:"component"`
+	Type      string `json:"type"`
+	Model     string `json:"model"`
+	Version   string `json:"version"`
+	// Field is the path of the deprecated field in the settings of the component and To the
+	// path of its replacement, when it can be rewritten
+	Field   string `json:"field"`
+	Action  string `json:"action"`
+	To      string `json:"to,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Rewritable tells if the field can be rewritten by the rule, and Reason why it cannot
+	Rewritable bool   `json:"rewritable"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// PatternUpgradeCheck is the result of checking a saved pattern against the migration rules
+type PatternUpgradeCheck struct {
+	ID       string           `json:"id"`
+	Name     string           `json:"name"`
+	Findings []UpgradeFinding `json:"findings"`
+	// PatternFile is the pattern file with the rewritable fields rewritten
+	PatternFile string `json:"pattern_file"`
+	// Saved tells if the rewritten pattern file was saved
+	Saved bool `json:"saved"`
+}
+
+// UpgradePatternFile returns the deprecated fields used by the components of the pattern file
+// according to the migration rules and the pattern file with the rewritable fields rewritten,
+// keeping its formatting and comments
+func UpgradePatternFile(content []byte, migrations []MigrationCapability) ([]byte, []UpgradeFinding, error) {
+	doc := &yamlv3.Node{}
+	if err := yamlv3.Unmarshal(content, doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the pattern file: %s", err)
+	}
+	var services *yamlv3.Node
+	if len(doc.Content) > 0 {
+		services = mappingValue(doc.Content[0], "services")
+	}
+	findings := []UpgradeFinding{}
+	if services == nil || services.Kind != yamlv3.MappingNode {
+		return content, findings, nil
+	}
+
+	rewritten := false
+	for i := 0; i+1 < len(services.Content); i += 2 {
+		name, service := services.Content[i].Value, services.Content[i+1]
+		typ := ""
+		if t := mappingValue(service, "type"); t != nil {
+			typ = t.Value
+		}
+		settings := mappingValue(service, "settings")
+		if settings == nil {
+			continue
+		}
+
+		for _, migration := range migrations {
+			if !componentTypeMatches(typ, migration.Type) {
+				continue
+			}
+			for _, field := range findFields(settings, strings.Split(migration.Field, "."), "", nil) {
+				finding := UpgradeFinding{
+					Component: name,
+					Type:      typ,
+					Model:     migration.Model,
+					Version:   migration.Version,
+					Field:     field.path,
+					Action:    migration.Action,
+					Message:   migration.Message,
+				}
+				switch migration.Action {
+				case MigrationRemove:
+					finding.Rewritable = true
+					removeMappingKey(field.parent, field.key)
+					rewritten = true
+				case MigrationRename:
+					to := concretePath(migration.To, field.indices)
+					finding.To = to
+					if err := renameField(settings, field, to); err != nil {
+						finding.Reason = err.Error()
+						break
+					}
+					finding.Rewritable = true
+					rewritten = true
+				default:
+					finding.Reason = "requires a manual change"
+				}
+				findings = append(findings, finding)
+			}
+		}
+	}
+
+	if !rewritten {
+		return content, findings, nil
+	}
+	var out bytes.Buffer
+	encoder := yamlv3.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to encode the pattern file: %s", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to encode the pattern file: %s", err)
+	}
+	return out.Bytes(), findings, nil
+}
+
+// componentTypeMatches tells if the type of a component, which may be qualified by its model,
+// e.g. VirtualService.ISTIO, is the type of a rule
+func componentTypeMatches(typ, ruleType string) bool {
+	return strings.EqualFold(typ, ruleType) || strings.EqualFold(strings.SplitN(typ, ".", 2)[0], ruleType)
+}
+
+// foundField is a field found in the settings of a component, along with the indices of the
+// items of the lists on its path
+type foundField struct {
+	parent  *yamlv3.Node
+	key     string
+	value   *yamlv3.Node
+	path    string
+	indices []int
+}
+
+// findFields returns the fields of the node at the path, [*] expanding to every item of a list
+func findFields(node *yamlv3.Node, segments []string, prefix string, indices []int) []foundField {
+	segment := segments[0]
+	key, each := segment, strings.HasSuffix(segment, "[*]")
+	if each {
+		key = strings.TrimSuffix(segment, "[*]")
+	}
+	value := mappingValue(node, key)
+	if value == nil {
+		return nil
+	}
+	path := key
+	if prefix != "" {
+		path = prefix + "." + key
+	}
+
+	if !each {
+		if len(segments) == 1 {
+			return []foundField{{parent: node, key: key, value: value, path: path, indices: indices}}
+		}
+		return findFields(value, segments[1:], path, indices)
+	}
+	if value.Kind != yamlv3.SequenceNode || len(segments) == 1 {
+		return nil
+	}
+	var fields []foundField
+	for i, item := range value.Content {
+		itemIndices := append(append([]int{}, indices...), i)
+		fields = append(fields, findFields(item, segments[1:], path+"["+strconv.Itoa(i)+"]", itemIndices)...)
+	}
+	return fields
+}
+
+// concretePath replaces the [*] of the path by the indices, in order
+func concretePath(path string, indices []int) string {
+	for _, i := range indices {
+		if !strings.Contains(path, "[*]") {
+			break
+		}
+		path = strings.Replace(path, "[*]", "["+strconv.Itoa(i)+"]", 1)
+	}
+	return path
+}
+
+// renameField moves the value of the field to the path in the settings, creating the missing maps,
+// unless a value is already set at the path
+func renameField(settings *yamlv3.Node, field foundField, to string) error {
+	node := settings
+	segments := strings.Split(to, ".")
+	for i, segment := range segments {
+		key, index := segment, -1
+		if open := strings.Index(segment, "["); open >= 0 && strings.HasSuffix(segment, "]") {
+			n, err := strconv.Atoi(segment[open+1 : len(segment)-1])
+			if err != nil {
+				return fmt.Errorf("invalid path %s", to)
+			}
+			key, index = segment[:open], n
+		}
+		if node.Kind != yamlv3.MappingNode {
+			return fmt.Errorf("%s is not a map", strings.Join(segments[:i], "."))
+		}
+		child := mappingValue(node, key)
+		last := i == len(segments)-1
+		if last && index < 0 {
+			if child != nil {
+				return fmt.Errorf("%s is already set", to)
+			}
+			// The comments of the field are on its key
+			renamed := &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}
+			if old := removeMappingKey(field.parent, field.key); old != nil {
+				renamed.HeadComment, renamed.LineComment, renamed.FootComment = old.HeadComment, old.LineComment, old.FootComment
+			}
+			node.Content = append(node.Content, renamed, field.value)
+			return nil
+		}
+		if index >= 0 {
+			if child == nil || child.Kind != yamlv3.SequenceNode || index >= len(child.Content) {
+				return fmt.Errorf("%s has no item %d", key, index)
+			}
+			if last {
+				return fmt.Errorf("%s is already set", to)
+			}
+			node = child.Content[index]
+			continue
+		}
+		if child == nil {
+			child = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+			node.Content = append(node.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, child)
+		}
+		node = child
+	}
+	return nil
+}
+
+// removeMappingKey removes the key from the map and returns the node of the key
+func removeMappingKey(node *yamlv3.Node, key string) *yamlv3.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if k := node.Content[i]; k.Value == key {
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+			return k
+		}
+	}
+	return nil
+}
+
+func mappingValue(node *yamlv3.Node, key string) *yamlv3.Node {
+	if node == nil || node.Kind != yamlv3.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUpgradePatternFile(t *testing.T) {
+	pattern := `name: shop
+services:
+  api:
+    type: Deployment
+    settings:
+      spec:
+        template:
+          spec:
+            # the account of the api
+            serviceAccount: api
+  web:
+    type: Ingress
+    settings:
+      spec:
+        rules:
+          - http:
+              paths:
+                - path: /
+                  backend:
+                    serviceName: web
+                    servicePort: 80
+                - path: /admin
+                  backend:
+                    service:
+                      name: admin
+                    serviceName: legacy
+  reviews:
+    type: VirtualService.ISTIO
+    settings:
+      spec:
+        http:
+          - mirror_percent: 50
+`
+	content, findings, err := UpgradePatternFile([]byte(pattern), mesheryMigrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []UpgradeFinding{
+		{Component: "api", Field: "spec.template.spec.serviceAccount", To: "spec.template.spec.serviceAccountName", Rewritable: true},
+		{Component: "web", Field: "spec.rules[0].http.paths[0].backend.serviceName", To: "spec.rules[0].http.paths[0].backend.service.name", Rewritable: true},
+		{Component: "web", Field: "spec.rules[0].http.paths[1].backend.serviceName", To: "spec.rules[0].http.paths[1].backend.service.name", Reason: "spec.rules[0].http.paths[1].backend.service.name is already set"},
+		{Component: "web", Field: "spec.rules[0].http.paths[0].backend.servicePort", Reason: "requires a manual change"},
+		{Component: "reviews", Field: "spec.http[0].mirror_percent", Reason: "requires a manual change"},
+	}
+	if len(findings) != len(want) {
+		t.Fatalf("got %d findings %+v, want %d", len(findings), findings, len(want))
+	}
+	for i, w := range want {
+		got := findings[i]
+		if got.Component != w.Component || got.Field != w.Field || got.To != w.To || got.Rewritable != w.Rewritable || got.Reason != w.Reason {
+			t.Errorf("finding %d: got %+v, want %+v", i, got, w)
+		}
+	}
+
+	upgraded := string(content)
+	for _, s := range []string{"# the account of the api\n            serviceAccountName: api", "service:\n                      name: web", "serviceName: legacy"} {
+		if !strings.Contains(upgraded, s) {
+			t.Errorf("upgraded pattern does not contain %q:\n%s", s, upgraded)
+		}
+	}
+	if strings.Contains(upgraded, "serviceAccount: api") || strings.Contains(upgraded, "serviceName: web") {
+		t.Errorf("upgraded pattern still contains the deprecated fields:\n%s", upgraded)
+	}
+
+	unchanged := "name: empty\nservices:\n  api:\n    type: Deployment\n    settings: {}\n"
+	content, findings, err = UpgradePatternFile([]byte(unchanged), mesheryMigrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 0 || string(content) != unchanged {
+		t.Errorf("got findings %+v and pattern %q for a pattern without deprecated fields", findings, content)
+	}
+}
+
+func TestMigrationValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		migration MigrationCapability
+		expectErr bool
+	}{
+		{name: "rename", migration: MigrationCapability{Type: "Ingress", Field: "spec.backend", Action: MigrationRename, To: "spec.defaultBackend"}},
+		{name: "remove", migration: MigrationCapability{Type: "Ingress", Field: "spec.backend", Action: MigrationRemove}},
+		{name: "rename without replacement", migration: MigrationCapability{Type: "Ingress", Field: "spec.backend", Action: MigrationRename}, expectErr: true},
+		{name: "replacement with more lists", migration: MigrationCapability{Type: "Ingress", Field: "spec.backend", Action: MigrationRename, To: "spec.rules[*].backend"}, expectErr: true},
+		{name: "unknown action", migration: MigrationCapability{Type: "Ingress", Field: "spec.backend", Action: "move"}, expectErr: true},
+		{name: "no type", migration: MigrationCapability{Field: "spec.backend", Action: MigrationRemove}, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.migration.Validate(); (err != nil) != tt.expectErr {
+				t.Errorf("got error %v, expected an error: %t", err, tt.expectErr)
+			}
+		})
+	}
+}
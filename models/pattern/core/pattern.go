@@ -45,11 +45,40 @@ type Service struct {
 	// DependsOn correlates one or more objects as a required dependency of this service
 	// DependsOn is used to determine sequence of operations
 	DependsOn []string `yaml:"dependsOn,omitempty" json:"dependsOn,omitempty"`
+	// Hook runs the service, a Job, to completion before every other service of the pattern
+	// is deployed, or after all of them, such as database migrations. Hook Jobs are run again
+	// on every deployment of the pattern.
+	Hook string `yaml:"hook,omitempty" json:"hook,omitempty"`
 
 	Settings map[string]interface{} `yaml:"settings,omitempty" json:"settings,omitempty"`
 	Traits   map[string]interface{} `yaml:"traits,omitempty" json:"traits,omitempty"`
 }
 
+// Phases of the deployment of a pattern a hook service runs at
+const (
+	PreDeployHook  = "pre-deploy"
+	PostDeployHook = "post-deploy"
+)
+
+// IsJob returns true if the service is a Kubernetes Job
+func (s *Service) IsJob() bool {
+	return strings.TrimSuffix(strings.ToLower(s.Type), ".k8s") == "job"
+}
+
+// ValidateHook checks that the hook of the service, if any, is a known phase set on a Job
+func (s *Service) ValidateHook() error {
+	switch s.Hook {
+	case "":
+		return nil
+	case PreDeployHook, PostDeployHook:
+		if !s.IsJob() {
+			return fmt.Errorf("service %s of type %s cannot be a %s hook, only Jobs can", s.Name, s.Type, s.Hook)
+		}
+		return nil
+	}
+	return fmt.Errorf("service %s has an unknown hook %q, use %s or %s", s.Name, s.Hook, PreDeployHook, PostDeployHook)
+}
+
 // NewPatternFile takes in raw yaml and encodes it into a construct
 func NewPatternFile(yml []byte) (af Pattern, err error) {
 	err = yaml.Unmarshal(yml, &af)
@@ -252,7 +281,6 @@ func NewPatternFileFromK8sManifest(data string, ignoreErrors bool) (Pattern, err
 
 	manifests := strings.Split(data, "\n---\n")
 
-ManifestLoop:
 	for _, manifestYAML := range manifests {
 		manifest := map[string]interface{}{}
 
@@ -272,31 +300,13 @@ ManifestLoop:
 			return pattern, ErrParseK8sManifest(fmt.Errorf("failed to parse manifest into an internal representation"))
 		}
 
-		// Treat Kubernetes core resources specially as we don't enforce "spec" as the top field there
-		for _, core := range coreK8sAPIVersions() {
-			if manifest["apiVersion"] == core {
-				name, svc, err := createPatternServiceFromCoreK8s(manifest)
-				if err != nil {
-					if ignoreErrors {
-						continue ManifestLoop
-					}
-
-					return pattern, ErrCreatePatternService(fmt.Errorf("failed to create pattern service from core kubernetes component: %s", err))
-				}
-				pattern.Services[name] = &svc
-
-				continue ManifestLoop
-			}
-		}
-
-		// Extended K8s resources
-		name, svc, err := createPatternServiceFromExtendedK8s(manifest)
+		name, svc, err := createPatternServiceFromK8s(manifest)
 		if err != nil {
 			if ignoreErrors {
 				continue
 			}
 
-			return pattern, ErrCreatePatternService(fmt.Errorf("failed to create pattern service from extended kubernetes component: %s", err))
+			return pattern, err
 		}
 
 		pattern.Services[name] = &svc
@@ -305,6 +315,27 @@ ManifestLoop:
 	return pattern, nil
 }
 
+// createPatternServiceFromK8s creates a pattern service from a core or extended kubernetes manifest
+func createPatternServiceFromK8s(manifest map[string]interface{}) (string, Service, error) {
+	// Treat Kubernetes core resources specially as we don't enforce "spec" as the top field there
+	for _, core := range coreK8sAPIVersions() {
+		if manifest["apiVersion"] == core {
+			name, svc, err := createPatternServiceFromCoreK8s(manifest)
+			if err != nil {
+				return "", svc, ErrCreatePatternService(fmt.Errorf("failed to create pattern service from core kubernetes component: %s", err))
+			}
+			return name, svc, nil
+		}
+	}
+
+	// Extended K8s resources
+	name, svc, err := createPatternServiceFromExtendedK8s(manifest)
+	if err != nil {
+		return "", svc, ErrCreatePatternService(fmt.Errorf("failed to create pattern service from extended kubernetes component: %s", err))
+	}
+	return name, svc, nil
+}
+
 func createPatternServiceFromCoreK8s(manifest map[string]interface{}) (string, Service, error) {
 	apiVersion, _ := manifest["apiVersion"].(string)
 	kind, _ := manifest["kind"].(string)
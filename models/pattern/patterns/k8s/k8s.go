@@ -1,14 +1,32 @@
 package k8s
 
 import (
+	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
 	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
 	"gopkg.in/yaml.v2"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// HookAnnotation is set to the phase of the hook on the Jobs run as hooks of a pattern
+const HookAnnotation = "pattern.meshery.io/hook"
+
+// hookDeletionTimeout is how long to wait for the previous run of a hook to be deleted
+const hookDeletionTimeout = time.Minute
+
 func Deploy(kubeClient *meshkube.Client, oamComp v1alpha1.Component, oamConfig v1alpha1.Configuration, isDel bool) error {
+	// The spec of a Job cannot be updated, hooks are run again by creating their Job anew
+	if !isDel && oamComp.Annotations[HookAnnotation] != "" {
+		if err := deletePreviousRun(kubeClient, oamComp); err != nil {
+			return err
+		}
+	}
+
 	resource := createK8sResourceStructure(oamComp)
 
 	manifest, err := yaml.Marshal(resource)
@@ -23,6 +41,49 @@ func Deploy(kubeClient *meshkube.Client, oamComp v1alpha1.Component, oamConfig v
 	})
 }
 
+// deletePreviousRun deletes the Job of the previous run of a hook, along with its pods, and
+// waits for it to be gone
+func deletePreviousRun(kubeClient *meshkube.Client, comp v1alpha1.Component) error {
+	resource, _, err := componentResource(kubeClient, comp)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), hookDeletionTimeout)
+	defer cancel()
+
+	propagation := metav1.DeletePropagationBackground
+	err = resource.Delete(ctx, comp.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(readinessPollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := resource.Get(ctx, comp.Name, metav1.GetOptions{}); kerrors.IsNotFound(err) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the previous run of hook %s is still being deleted", comp.Name)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Manifest returns the Kubernetes resource the component is applied as, along with its namespace
+func Manifest(comp v1alpha1.Component) map[string]interface{} {
+	resource := createK8sResourceStructure(comp)
+	if comp.Namespace != "" {
+		resource["metadata"].(map[string]interface{})["namespace"] = comp.Namespace
+	}
+	return resource
+}
+
 func createK8sResourceStructure(comp v1alpha1.Component) map[string]interface{} {
 	apiVersion := getAPIVersionFromComponent(comp)
 	kind := getKindFromComponent(comp)
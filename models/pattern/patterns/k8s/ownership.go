@@ -0,0 +1,159 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// Labels and annotations of ownership Meshery Server sets on the resources it applies for a design
+const (
+	// ManagedByLabel is the well-known label of the tool managing a resource
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+	// ManagedByMeshery is the value of ManagedByLabel on the resources applied by Meshery Server
+	ManagedByMeshery = "meshery"
+	// InstanceIDLabel is the ID of the Meshery Server which applied the resource
+	InstanceIDLabel = "meshery.io/instance-id"
+	// DesignIDLabel is the ID of the saved design the resource was applied for, unset for the
+	// designs deployed without being saved
+	DesignIDLabel = "design.meshery.io/id"
+	// DesignNameAnnotation is the name of the design the resource was applied for, which label
+	// values are too restricted to hold
+	DesignNameAnnotation = "design.meshery.io/name"
+)
+
+// OwnershipLabels returns the labels of ownership of the resources applied by the Meshery Server
+// with the given instance ID for the design with the given ID, if saved
+func OwnershipLabels(instanceID, designID string) map[string]string {
+	labels := map[string]string{
+		ManagedByLabel:  ManagedByMeshery,
+		InstanceIDLabel: instanceID,
+	}
+	if designID != "" {
+		labels[DesignIDLabel] = designID
+	}
+	return labels
+}
+
+// OwnershipAnnotations returns the annotations of ownership of the resources applied for the design
+func OwnershipAnnotations(designName string) map[string]string {
+	if designName == "" {
+		return map[string]string{}
+	}
+	return map[string]string{DesignNameAnnotation: designName}
+}
+
+// ManagedResource is a resource of the cluster labeled as applied by Meshery Server
+type ManagedResource struct {
+	Resource   schema.GroupVersionResource
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+	DesignID   string
+	DesignName string
+}
+
+// String names the resource the way kubectl does
+func (r ManagedResource) String() string {
+	name := strings.ToLower(r.Kind) + "/" + r.Name
+	if r.Namespace != "" {
+		return r.Namespace + "/" + name
+	}
+	return name
+}
+
+// ListManagedResources returns the resources of the cluster labeled as applied by the Meshery Server
+// with the given instance ID, across every kind which can be listed and deleted. The resources owned
+// by another one, which Kubernetes deletes along with their owner, are left out, as are the Endpoints
+// mirroring the labels of their Service.
+func ListManagedResources(ctx context.Context, kubeClient *meshkube.Client, instanceID string) ([]ManagedResource, error) {
+	lists, err := kubeClient.KubeClient.Discovery().ServerPreferredResources()
+	// the groups which cannot be discovered, e.g. of an unavailable aggregated API, are skipped
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	selector := fmt.Sprintf("%s=%s,%s=%s", ManagedByLabel, ManagedByMeshery, InstanceIDLabel, instanceID)
+	resources := []ManagedResource{}
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			if strings.Contains(r.Name, "/") || !hasVerbs(r.Verbs, "list", "delete") {
+				continue
+			}
+			if gv.Group == "" && r.Name == "endpoints" {
+				continue
+			}
+			gvr := gv.WithResource(r.Name)
+			objs, err := kubeClient.DynamicKubeClient.Resource(gvr).List(ctx, metav1.ListOptions{LabelSelector: selector})
+			if err != nil {
+				if kerrors.IsForbidden(err) || kerrors.IsNotFound(err) || kerrors.IsMethodNotSupported(err) {
+					continue
+				}
+				return nil, err
+			}
+			for _, obj := range objs.Items {
+				if len(obj.GetOwnerReferences()) > 0 {
+					continue
+				}
+				resources = append(resources, ManagedResource{
+					Resource:   gvr,
+					APIVersion: obj.GetAPIVersion(),
+					Kind:       obj.GetKind(),
+					Namespace:  obj.GetNamespace(),
+					Name:       obj.GetName(),
+					DesignID:   obj.GetLabels()[DesignIDLabel],
+					DesignName: obj.GetAnnotations()[DesignNameAnnotation],
+				})
+			}
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].String() < resources[j].String()
+	})
+	return resources, nil
+}
+
+// DeleteManagedResource deletes the resource, along with the resources it owns
+func DeleteManagedResource(ctx context.Context, kubeClient *meshkube.Client, r ManagedResource) error {
+	propagation := metav1.DeletePropagationBackground
+	opts := metav1.DeleteOptions{PropagationPolicy: &propagation}
+	var err error
+	if r.Namespace != "" {
+		err = kubeClient.DynamicKubeClient.Resource(r.Resource).Namespace(r.Namespace).Delete(ctx, r.Name, opts)
+	} else {
+		err = kubeClient.DynamicKubeClient.Resource(r.Resource).Delete(ctx, r.Name, opts)
+	}
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func hasVerbs(verbs metav1.Verbs, want ...string) bool {
+	for _, w := range want {
+		found := false
+		for _, v := range verbs {
+			if v == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,218 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+// readinessPollInterval is the interval at which the status of a component is checked
+const readinessPollInterval = 2 * time.Second
+
+// maxReportedEvents is the number of most recent events reported for a component which is not ready
+const maxReportedEvents = 5
+
+// WaitForReady blocks until the kubernetes resource created for the component is
+// ready or the context is done. When the context is done first, the returned error
+// carries the reason the resource is not ready along with its recent events.
+func WaitForReady(ctx context.Context, kubeClient *meshkube.Client, comp v1alpha1.Component) error {
+	resource, namespace, err := componentResource(kubeClient, comp)
+	if err != nil {
+		return err
+	}
+	kind := getKindFromComponent(comp)
+
+	reason := "resource not found"
+	ticker := time.NewTicker(readinessPollInterval)
+	defer ticker.Stop()
+	for {
+		obj, err := resource.Get(ctx, comp.Name, metav1.GetOptions{})
+		if err == nil {
+			var ready bool
+			ready, reason = IsReady(obj)
+			if ready {
+				return nil
+			}
+			// a failed Job never becomes ready
+			if failed, why := jobFailed(obj); failed {
+				return fmt.Errorf("%s %s/%s failed: %s%s", kind, namespace, comp.Name, why, recentEvents(kubeClient, namespace, kind, comp.Name))
+			}
+		} else if ctx.Err() == nil {
+			reason = err.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s %s/%s is not ready: %s%s", kind, namespace, comp.Name, reason, recentEvents(kubeClient, namespace, kind, comp.Name))
+		case <-ticker.C:
+		}
+	}
+}
+
+// componentResource returns the client of the kubernetes resource created for the component,
+// along with its namespace
+func componentResource(kubeClient *meshkube.Client, comp v1alpha1.Component) (dynamic.ResourceInterface, string, error) {
+	gv, err := schema.ParseGroupVersion(getAPIVersionFromComponent(comp))
+	if err != nil {
+		return nil, "", err
+	}
+	kind := getKindFromComponent(comp)
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(kubeClient.KubeClient.Discovery()))
+	mapping, err := mapper.RESTMapping(gv.WithKind(kind).GroupKind(), gv.Version)
+	if err != nil {
+		return nil, "", err
+	}
+
+	namespace := comp.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	if mapping.Scope.Name() == "namespace" {
+		return kubeClient.DynamicKubeClient.Resource(mapping.Resource).Namespace(namespace), namespace, nil
+	}
+	return kubeClient.DynamicKubeClient.Resource(mapping.Resource), namespace, nil
+}
+
+// IsReady reports whether the resource is ready and, when it is not, why
+func IsReady(obj *unstructured.Unstructured) (bool, string) {
+	generation, _, _ := unstructured.NestedInt64(obj.Object, "metadata", "generation")
+	observed, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if found && observed < generation {
+		return false, "the latest spec has not been observed yet"
+	}
+
+	switch obj.GetKind() {
+	case "Deployment", "StatefulSet", "ReplicaSet":
+		replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		if !found {
+			replicas = 1
+		}
+		readyField := "readyReplicas"
+		if obj.GetKind() == "Deployment" {
+			readyField = "availableReplicas"
+		}
+		ready, _, _ := unstructured.NestedInt64(obj.Object, "status", readyField)
+		updated, found, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+		if !found && obj.GetKind() == "ReplicaSet" {
+			updated = replicas
+		}
+		if ready < replicas || updated < replicas {
+			return false, fmt.Sprintf("%d/%d replicas ready, %d/%d updated", ready, replicas, updated, replicas)
+		}
+		return true, ""
+	case "Job":
+		// a Job is ready once it completed
+		if jobCondition(obj, "Complete") != nil {
+			return true, ""
+		}
+		completions, found, _ := unstructured.NestedInt64(obj.Object, "spec", "completions")
+		if !found {
+			completions = 1
+		}
+		succeeded, _, _ := unstructured.NestedInt64(obj.Object, "status", "succeeded")
+		return false, fmt.Sprintf("%d/%d completions", succeeded, completions)
+	case "DaemonSet":
+		desired, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+		ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberReady")
+		if ready < desired {
+			return false, fmt.Sprintf("%d/%d pods ready", ready, desired)
+		}
+		return true, ""
+	}
+
+	// Any other resource is ready once its Ready, Available or Complete condition is
+	// true, or as soon as it exists if it does not report conditions
+	conditions, found, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if !found || len(conditions) == 0 {
+		return true, ""
+	}
+	reason := "no Ready condition reported"
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		typ, _ := cond["type"].(string)
+		if typ != "Ready" && typ != "Available" && typ != "Complete" {
+			continue
+		}
+		if status, _ := cond["status"].(string); status == "True" {
+			return true, ""
+		}
+		msg, _ := cond["message"].(string)
+		reason = fmt.Sprintf("condition %s is not true: %s", typ, msg)
+	}
+	return false, reason
+}
+
+// jobFailed reports whether the resource is a Job which failed and, if so, why
+func jobFailed(obj *unstructured.Unstructured) (bool, string) {
+	if obj.GetKind() != "Job" {
+		return false, ""
+	}
+	cond := jobCondition(obj, "Failed")
+	if cond == nil {
+		return false, ""
+	}
+	reason, _ := cond["reason"].(string)
+	msg, _ := cond["message"].(string)
+	return true, strings.TrimSpace(reason + " " + msg)
+}
+
+// jobCondition returns the condition of the Job of the given type if it is true, nil otherwise
+func jobCondition(obj *unstructured.Unstructured, typ string) map[string]interface{} {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if t, _ := cond["type"].(string); t != typ {
+			continue
+		}
+		if status, _ := cond["status"].(string); status == "True" {
+			return cond
+		}
+	}
+	return nil
+}
+
+// recentEvents returns the most recent events of the object formatted for an error message
+func recentEvents(kubeClient *meshkube.Client, namespace, kind, name string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	events, err := kubeClient.KubeClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=%s,involvedObject.name=%s", kind, name),
+	})
+	if err != nil || len(events.Items) == 0 {
+		return ""
+	}
+
+	items := events.Items
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].LastTimestamp.Before(&items[j].LastTimestamp)
+	})
+	if len(items) > maxReportedEvents {
+		items = items[len(items)-maxReportedEvents:]
+	}
+
+	var b strings.Builder
+	b.WriteString("\nevents:")
+	for _, e := range items {
+		b.WriteString(fmt.Sprintf("\n  %s %s: %s", e.Type, e.Reason, e.Message))
+	}
+	return b.String()
+}
@@ -0,0 +1,103 @@
+package k8s
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestIsReady(t *testing.T) {
+	tests := []struct {
+		name  string
+		obj   map[string]interface{}
+		ready bool
+	}{
+		{
+			name: "available deployment",
+			obj: map[string]interface{}{
+				"kind":   "Deployment",
+				"spec":   map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{"availableReplicas": int64(2), "updatedReplicas": int64(2)},
+			},
+			ready: true,
+		},
+		{
+			name: "rolling out deployment",
+			obj: map[string]interface{}{
+				"kind":   "Deployment",
+				"spec":   map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{"availableReplicas": int64(2), "updatedReplicas": int64(1)},
+			},
+			ready: false,
+		},
+		{
+			name: "statefulset with unobserved spec",
+			obj: map[string]interface{}{
+				"kind":     "StatefulSet",
+				"metadata": map[string]interface{}{"generation": int64(3)},
+				"spec":     map[string]interface{}{"replicas": int64(1)},
+				"status":   map[string]interface{}{"observedGeneration": int64(2), "readyReplicas": int64(1), "updatedReplicas": int64(1)},
+			},
+			ready: false,
+		},
+		{
+			name: "custom resource with false Ready condition",
+			obj: map[string]interface{}{
+				"kind": "Certificate",
+				"status": map[string]interface{}{"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False", "message": "issuing"},
+				}},
+			},
+			ready: false,
+		},
+		{
+			name: "running job",
+			obj: map[string]interface{}{
+				"kind":   "Job",
+				"status": map[string]interface{}{"active": int64(1)},
+			},
+			ready: false,
+		},
+		{
+			name: "completed job",
+			obj: map[string]interface{}{
+				"kind": "Job",
+				"status": map[string]interface{}{"succeeded": int64(1), "conditions": []interface{}{
+					map[string]interface{}{"type": "Complete", "status": "True"},
+				}},
+			},
+			ready: true,
+		},
+		{
+			name:  "resource without status",
+			obj:   map[string]interface{}{"kind": "ConfigMap"},
+			ready: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, reason := IsReady(&unstructured.Unstructured{Object: tt.obj})
+			if ready != tt.ready {
+				t.Errorf("IsReady() = %v (%s), want %v", ready, reason, tt.ready)
+			}
+		})
+	}
+}
+
+func TestJobFailed(t *testing.T) {
+	failed := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Job",
+		"status": map[string]interface{}{"conditions": []interface{}{
+			map[string]interface{}{"type": "Failed", "status": "True", "reason": "BackoffLimitExceeded", "message": "Job has reached the specified backoff limit"},
+		}},
+	}}
+	if ok, why := jobFailed(failed); !ok || why != "BackoffLimitExceeded Job has reached the specified backoff limit" {
+		t.Errorf("jobFailed() = %v (%s), want true", ok, why)
+	}
+
+	running := &unstructured.Unstructured{Object: map[string]interface{}{"kind": "Job"}}
+	if ok, _ := jobFailed(running); ok {
+		t.Error("jobFailed() = true for a running job, want false")
+	}
+}
@@ -1,6 +1,10 @@
 package planner
 
-import "github.com/layer5io/meshery/models/pattern/core"
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/models/pattern/core"
+)
 
 // Plan struct represents a node of an execution plan
 type Plan struct {
@@ -21,27 +25,82 @@ func (p *Plan) Execute(cb func(string, core.Service) bool) error {
 	return nil
 }
 
-// CreatePlan takes in the application components and creates a plan of execution for it
+// HasDependents returns true if a node of the plan is executed after the given one
+func (p *Plan) HasDependents(name string) bool {
+	p.RLock()
+	defer p.RUnlock()
+
+	return len(p.Edges[name]) > 0
+}
+
+// CreatePlan takes in the application components and creates a plan of execution for it.
+// Besides the dependencies of the services, the pre-deploy hooks are executed before every
+// other service but the ones they depend on, and the post-deploy hooks after every other service
+// but the ones depending on them, the order being inverted for deletion.
 func CreatePlan(pattern core.Pattern, invert bool) (*Plan, error) {
 	g := NewGraph()
 
 	for name, svc := range pattern.Services {
+		if err := svc.ValidateHook(); err != nil {
+			return nil, err
+		}
 		g.AddNode(name, *svc)
 	}
 
+	addEdge := func(from, to string) {
+		if invert {
+			from, to = to, from
+		}
+		g.AddEdge(from, to)
+	}
+
+	dependents := map[string][]string{}
 	for name, svc := range pattern.Services {
 		for _, dep := range svc.DependsOn {
-			from := dep
-			to := name
-
-			if invert {
-				from = name
-				to = dep
+			if _, ok := pattern.Services[dep]; !ok {
+				return nil, fmt.Errorf("service %s depends on %s, which is not a service of the pattern", name, dep)
 			}
+			addEdge(dep, name)
+			dependents[dep] = append(dependents[dep], name)
+		}
+	}
 
-			g.AddEdge(from, to)
+	for name, svc := range pattern.Services {
+		switch svc.Hook {
+		case core.PreDeployHook:
+			deps := reachable(name, func(n string) []string { return pattern.Services[n].DependsOn })
+			for other, osvc := range pattern.Services {
+				if osvc.Hook != core.PreDeployHook && !deps[other] {
+					addEdge(name, other)
+				}
+			}
+		case core.PostDeployHook:
+			deps := reachable(name, func(n string) []string { return dependents[n] })
+			for other, osvc := range pattern.Services {
+				if osvc.Hook != core.PostDeployHook && !deps[other] {
+					addEdge(other, name)
+				}
+			}
 		}
 	}
 
 	return &Plan{pattern, g}, nil
 }
+
+// reachable returns the services reachable from the given one following the edges, the
+// service itself included
+func reachable(name string, edges func(string) []string) map[string]bool {
+	seen := map[string]bool{name: true}
+	stack := []string{name}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, next := range edges(n) {
+			if !seen[next] {
+				seen[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+	return seen
+}
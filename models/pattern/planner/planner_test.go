@@ -0,0 +1,95 @@
+package planner
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/layer5io/meshery/models/pattern/core"
+)
+
+func TestCreatePlan_Order(t *testing.T) {
+	pattern := core.Pattern{Services: map[string]*core.Service{
+		"ns":        {Name: "shop", Type: "Namespace.K8s"},
+		"migrate":   {Name: "migrate", Type: "Job.K8s", Hook: core.PreDeployHook, DependsOn: []string{"ns"}},
+		"db":        {Name: "db", Type: "StatefulSet.K8s"},
+		"app":       {Name: "app", Type: "Deployment.K8s", DependsOn: []string{"db"}},
+		"smoketest": {Name: "smoketest", Type: "Job.K8s", Hook: core.PostDeployHook},
+	}}
+
+	tests := []struct {
+		name   string
+		invert bool
+		before [][2]string
+	}{
+		{
+			name:   "deployment",
+			before: [][2]string{{"ns", "migrate"}, {"migrate", "db"}, {"migrate", "app"}, {"db", "app"}, {"app", "smoketest"}, {"db", "smoketest"}},
+		},
+		{
+			name:   "deletion",
+			invert: true,
+			before: [][2]string{{"smoketest", "app"}, {"app", "db"}, {"db", "migrate"}, {"app", "migrate"}, {"migrate", "ns"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := CreatePlan(pattern, tt.invert)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !plan.IsFeasible() {
+				t.Fatal("expected the plan to be feasible")
+			}
+
+			var lock sync.Mutex
+			order := map[string]int{}
+			_ = plan.Execute(func(name string, _ core.Service) bool {
+				lock.Lock()
+				defer lock.Unlock()
+				order[name] = len(order)
+				return true
+			})
+			if len(order) != len(pattern.Services) {
+				t.Fatalf("executed %v, want every service", order)
+			}
+			for _, b := range tt.before {
+				if order[b[0]] > order[b[1]] {
+					t.Errorf("%s was executed after %s: %v", b[0], b[1], order)
+				}
+			}
+		})
+	}
+}
+
+func TestCreatePlan_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		services map[string]*core.Service
+	}{
+		{
+			name: "unknown dependency",
+			services: map[string]*core.Service{
+				"app": {Name: "app", Type: "Deployment.K8s", DependsOn: []string{"db"}},
+			},
+		},
+		{
+			name: "hook which is not a Job",
+			services: map[string]*core.Service{
+				"app": {Name: "app", Type: "Deployment.K8s", Hook: core.PreDeployHook},
+			},
+		},
+		{
+			name: "unknown hook",
+			services: map[string]*core.Service{
+				"migrate": {Name: "migrate", Type: "Job.K8s", Hook: "pre-delete"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := CreatePlan(core.Pattern{Services: tt.services}, false); err == nil {
+				t.Error("expected the plan to be rejected")
+			}
+		})
+	}
+}
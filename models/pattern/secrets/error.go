@@ -0,0 +1,18 @@
+package secrets
+
+import (
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrSecretReferenceCode = "2206"
+	ErrResolveSecretCode   = "2207"
+)
+
+func ErrSecretReference(ref string, err error) error {
+	return errors.New(ErrSecretReferenceCode, errors.Alert, []string{"Invalid secret reference", ref}, []string{err.Error()}, []string{"Reference is not a valid URI", "Secret store of the reference is not configured on Meshery Server"}, []string{"Reference secrets as vault://<path>#<key> or k8s-secret://<namespace>/<name>/<key>", "Configure VAULT_ADDR and VAULT_TOKEN for Meshery Server to resolve vault references"})
+}
+
+func ErrResolveSecret(ref string, err error) error {
+	return errors.New(ErrResolveSecretCode, errors.Alert, []string{"Unable to resolve the secret", ref}, []string{err.Error()}, []string{"Secret or key does not exist", "Meshery is not allowed to read the secret"}, []string{"Check that the secret exists and holds the key", "Grant Meshery, or the service account the design is deployed as, read access to the secret"})
+}
@@ -0,0 +1,48 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// KubernetesSecretScheme is the scheme of the references to Kubernetes Secrets,
+// k8s-secret://<namespace>/<name>/<key>
+const KubernetesSecretScheme = "k8s-secret"
+
+// KubernetesSecretResolver reads secrets from the Kubernetes Secrets of a cluster
+type KubernetesSecretResolver struct {
+	client kubernetes.Interface
+}
+
+// NewKubernetesSecretResolver returns a resolver reading the Secrets of the cluster of the given client
+func NewKubernetesSecretResolver(client kubernetes.Interface) *KubernetesSecretResolver {
+	return &KubernetesSecretResolver{client: client}
+}
+
+// Scheme returns the scheme of the references handled by the resolver
+func (k *KubernetesSecretResolver) Scheme() string {
+	return KubernetesSecretScheme
+}
+
+// Resolve reads the key of the Secret the reference points to
+func (k *KubernetesSecretResolver) Resolve(ctx context.Context, ref *url.URL) (string, error) {
+	parts := strings.Split(strings.Trim(ref.Path, "/"), "/")
+	if ref.Host == "" || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("kubernetes secret references must be of the form k8s-secret://<namespace>/<name>/<key>")
+	}
+
+	secret, err := k.client.CoreV1().Secrets(ref.Host).Get(ctx, parts[0], metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[parts[1]]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s/%s", parts[1], ref.Host, parts[0])
+	}
+	return string(value), nil
+}
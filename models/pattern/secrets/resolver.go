@@ -0,0 +1,91 @@
+// Package secrets resolves the references to secrets held in external secret stores,
+// such as vault://secret/data/db#password, which designs use in place of credentials
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Schemes of the secret references Meshery recognizes. A reference of one of these schemes
+// is never passed on as is, it fails the deployment when no resolver handles it.
+var Schemes = []string{VaultScheme, KubernetesSecretScheme}
+
+// Resolver resolves the references to the secrets of a secret store
+type Resolver interface {
+	// Scheme is the URI scheme of the references handled by the resolver
+	Scheme() string
+	Resolve(ctx context.Context, ref *url.URL) (string, error)
+}
+
+// Registry dispatches secret references to the resolver of their scheme
+type Registry struct {
+	resolvers map[string]Resolver
+}
+
+// NewRegistry returns a registry of the given resolvers
+func NewRegistry(resolvers ...Resolver) *Registry {
+	r := &Registry{resolvers: map[string]Resolver{}}
+	for _, resolver := range resolvers {
+		r.resolvers[resolver.Scheme()] = resolver
+	}
+	return r
+}
+
+// With returns a copy of the registry with the given resolvers added, e.g. the ones bound to
+// the cluster of a deployment. It can be called on a nil registry.
+func (r *Registry) With(resolvers ...Resolver) *Registry {
+	all := []Resolver{}
+	if r != nil {
+		for _, resolver := range r.resolvers {
+			all = append(all, resolver)
+		}
+	}
+	return NewRegistry(append(all, resolvers...)...)
+}
+
+// WithVaultPaths returns a copy of the registry whose Vault resolver, if any, reads only the
+// secrets under the given paths. It can be called on a nil registry.
+func (r *Registry) WithVaultPaths(paths []string) *Registry {
+	if r == nil {
+		return nil
+	}
+	vault, ok := r.resolvers[VaultScheme].(*VaultResolver)
+	if !ok {
+		return r
+	}
+	return r.With(vault.WithPaths(paths))
+}
+
+// IsReference tells whether the value is a reference to a secret
+func IsReference(value string) bool {
+	for _, scheme := range Schemes {
+		if strings.HasPrefix(value, scheme+"://") {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve returns the secret the value references, and false when the value is not a reference
+func (r *Registry) Resolve(ctx context.Context, value string) (string, bool, error) {
+	if !IsReference(value) {
+		return value, false, nil
+	}
+
+	ref, err := url.Parse(value)
+	if err != nil {
+		return "", true, ErrSecretReference(value, err)
+	}
+	resolver, ok := r.resolvers[ref.Scheme]
+	if !ok {
+		return "", true, ErrSecretReference(value, fmt.Errorf("no secret store is configured for %s references", ref.Scheme))
+	}
+	secret, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return "", true, ErrResolveSecret(value, err)
+	}
+	return secret, true, nil
+}
@@ -0,0 +1,96 @@
+package secrets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRegistryResolve(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" || r.URL.Path != "/v1/secret/data/db" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"data":{"password":"s3cret"},"metadata":{"version":1}}}`))
+	}))
+	defer vault.Close()
+
+	client := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "shop"},
+		Data:       map[string][]byte{"password": []byte("hunter2")},
+	})
+	registry := NewRegistry(NewVaultResolver(vault.URL, "root", "")).With(NewKubernetesSecretResolver(client)).WithVaultPaths([]string{"secret/data"})
+
+	tests := []struct {
+		value   string
+		want    string
+		isRef   bool
+		wantErr bool
+	}{
+		{value: "plain", want: "plain"},
+		{value: "https://example.com", want: "https://example.com"},
+		{value: "vault://secret/data/db#password", want: "s3cret", isRef: true},
+		{value: "vault://secret/data/db#user", isRef: true, wantErr: true},
+		{value: "vault://secret/data/db", isRef: true, wantErr: true},
+		{value: "vault://secret/data/other#password", isRef: true, wantErr: true},
+		{value: "k8s-secret://shop/db/password", want: "hunter2", isRef: true},
+		{value: "k8s-secret://shop/db", isRef: true, wantErr: true},
+		{value: "k8s-secret://shop/missing/password", isRef: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, isRef, err := registry.Resolve(context.Background(), tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Resolve(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if isRef != tt.isRef || got != tt.want {
+			t.Errorf("Resolve(%q) = %q, %v, want %q, %v", tt.value, got, isRef, tt.want, tt.isRef)
+		}
+	}
+
+	// References of a known scheme without a configured store are not passed on as is
+	if _, isRef, err := NewRegistry().Resolve(context.Background(), "vault://secret/data/db#password"); !isRef || err == nil {
+		t.Errorf("expected an error for a vault reference without a vault resolver, got %v", err)
+	}
+}
+
+func TestVaultResolverPaths(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"data":{"password":"s3cret"},"metadata":{"version":1}}}`))
+	}))
+	defer vault.Close()
+	registry := NewRegistry(NewVaultResolver(vault.URL, "root", ""))
+
+	tests := []struct {
+		paths   []string
+		value   string
+		wantErr bool
+	}{
+		{paths: []string{"secret/data/payments"}, value: "vault://secret/data/payments/db#password"},
+		{paths: []string{"/secret/data/payments/"}, value: "vault://secret/data/payments#password"},
+		{paths: []string{"secret/data/payments", "secret/data/shop"}, value: "vault://secret/data/shop/db#password"},
+		{paths: []string{"secret/data/payments"}, value: "vault://secret/data/payments-admin/db#password", wantErr: true},
+		{paths: []string{"secret/data/payments"}, value: "vault://secret/data/shop/db#password", wantErr: true},
+		{paths: []string{"secret/data/payments"}, value: "vault://secret/data/payments/../shop/db#password", wantErr: true},
+		{paths: []string{"secret/data/payments"}, value: "vault://secret/data/payments/%2e%2e/shop#password", wantErr: true},
+		{paths: []string{"secret/data/payments"}, value: "vault://secret/data/payments//db#password", wantErr: true},
+		// the secrets of Vault are read only under the paths the resolver is restricted to
+		{value: "vault://secret/data/payments/db#password", wantErr: true},
+		{paths: []string{"/"}, value: "vault://secret/data/payments/db#password", wantErr: true},
+	}
+	for _, tt := range tests {
+		_, _, err := registry.WithVaultPaths(tt.paths).Resolve(context.Background(), tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Resolve(%q) under %v error = %v, wantErr %v", tt.value, tt.paths, err, tt.wantErr)
+		}
+	}
+	if _, _, err := registry.Resolve(context.Background(), "vault://secret/data/payments/db#password"); err == nil {
+		t.Error("Vault resolver restricted to no paths read a secret")
+	}
+}
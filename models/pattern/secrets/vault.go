@@ -0,0 +1,130 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// VaultScheme is the scheme of the references to secrets of HashiCorp Vault,
+// vault://<path>#<key>, e.g. vault://secret/data/db#password for a KV version 2 engine
+const VaultScheme = "vault"
+
+// VaultResolver reads secrets from HashiCorp Vault over its HTTP API. As a single token reads the
+// secrets of every user, the resolver only reads those under the paths it is restricted to, none
+// unless restricted with WithPaths.
+type VaultResolver struct {
+	address   string
+	token     string
+	namespace string
+	client    *http.Client
+	// paths are the prefixes of the paths of the secrets the resolver reads
+	paths []string
+}
+
+// NewVaultResolver returns a resolver reading secrets from the Vault server at the given
+// address with the given token, within the given Vault Enterprise namespace if any
+func NewVaultResolver(address, token, namespace string) *VaultResolver {
+	return &VaultResolver{
+		address:   strings.TrimSuffix(address, "/"),
+		token:     token,
+		namespace: namespace,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// WithPaths returns a copy of the resolver reading only the secrets under the given paths, such as
+// the paths the admins allowed the designs of a workspace to reference
+func (v *VaultResolver) WithPaths(paths []string) *VaultResolver {
+	restricted := *v
+	restricted.paths = nil
+	for _, p := range paths {
+		if p = strings.Trim(p, "/"); p != "" {
+			restricted.paths = append(restricted.paths, p)
+		}
+	}
+	return &restricted
+}
+
+// allowed tells whether the secret at the path is under one of the paths of the resolver
+func (v *VaultResolver) allowed(path string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+	for _, p := range v.paths {
+		if path == p || strings.HasPrefix(path, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Scheme returns the scheme of the references handled by the resolver
+func (v *VaultResolver) Scheme() string {
+	return VaultScheme
+}
+
+// Resolve reads the key of the secret at the path of the reference
+func (v *VaultResolver) Resolve(ctx context.Context, ref *url.URL) (string, error) {
+	path := strings.Trim(ref.Host+ref.Path, "/")
+	if path == "" || ref.Fragment == "" {
+		return "", fmt.Errorf("vault references must be of the form vault://<path>#<key>")
+	}
+	if !v.allowed(path) {
+		return "", fmt.Errorf("the secret at %s is not under the Vault paths allowed to the workspace", path)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.address+"/v1/"+path, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault responded with status %d", resp.StatusCode)
+	}
+
+	secret := struct {
+		Data map[string]interface{} `json:"data"`
+	}{}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", err
+	}
+	data := secret.Data
+	// KV version 2 engines nest the secret along with its metadata
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+
+	value, ok := data[ref.Fragment]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in the secret at %s", ref.Fragment, path)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(value), nil
+}
@@ -0,0 +1,160 @@
+package snapshot
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// pngScale is the number of pixels of a PNG image per pixel of the layout, for the
+// images to remain sharp on high density displays
+const pngScale = 2
+
+func (l *layout) writePNG(w io.Writer) error {
+	c := &canvas{img: image.NewRGBA(image.Rect(0, 0, l.width*pngScale, l.height*pngScale))}
+	draw.Draw(c.img, c.img.Bounds(), &image.Uniform{C: rgb(fillColor)}, image.Point{}, draw.Src)
+
+	c.text(margin, margin+4, l.title, 3, rgb(textColor), false)
+
+	for _, e := range l.edges {
+		x1, y1, x2, y2 := e.ends()
+		c.line(x1, y1, x2, y2, rgb(mutedColor))
+		ax, ay, bx, by := arrowHead(x1, y1, x2, y2)
+		c.triangle(x2, y2, ax, ay, bx, by, rgb(mutedColor))
+	}
+
+	for _, n := range l.nodes {
+		c.fill(n.x, n.y, nodeWidth, nodeHeight, rgb(fillColor))
+		c.border(n.x, n.y, nodeWidth, nodeHeight, 2, rgb(accentColor))
+		c.text(n.x+nodeWidth/2, n.y+11, truncate(n.name), 2, rgb(textColor), true)
+		c.text(n.x+nodeWidth/2, n.y+30, truncate(n.kind), 2, rgb(mutedColor), true)
+	}
+
+	return png.Encode(w, c.img)
+}
+
+// canvas draws on an image in the coordinates of the layout
+type canvas struct {
+	img *image.RGBA
+}
+
+// fillPixels paints the rectangle, in pixels of the image
+func (c *canvas) fillPixels(x, y, w, h int, col color.RGBA) {
+	draw.Draw(c.img, image.Rect(x, y, x+w, y+h), &image.Uniform{C: col}, image.Point{}, draw.Src)
+}
+
+func (c *canvas) fill(x, y, w, h int, col color.RGBA) {
+	c.fillPixels(x*pngScale, y*pngScale, w*pngScale, h*pngScale, col)
+}
+
+func (c *canvas) border(x, y, w, h, width int, col color.RGBA) {
+	c.fill(x, y, w, width, col)
+	c.fill(x, y+h-width, w, width, col)
+	c.fill(x, y, width, h, col)
+	c.fill(x+w-width, y, width, h, col)
+}
+
+// line draws a line with Bresenham's algorithm
+func (c *canvas) line(x1, y1, x2, y2 int, col color.RGBA) {
+	x1, y1, x2, y2 = x1*pngScale, y1*pngScale, x2*pngScale, y2*pngScale
+	dx, dy := abs(x2-x1), -abs(y2-y1)
+	sx, sy := 1, 1
+	if x1 > x2 {
+		sx = -1
+	}
+	if y1 > y2 {
+		sy = -1
+	}
+	err := dx + dy
+	for {
+		c.fillPixels(x1-pngScale/2, y1-pngScale/2, pngScale, pngScale, col)
+		if x1 == x2 && y1 == y2 {
+			return
+		}
+		if e2 := 2 * err; e2 >= dy {
+			err += dy
+			x1 += sx
+		} else {
+			err += dx
+			y1 += sy
+		}
+	}
+}
+
+// triangle paints the triangle of the given corners
+func (c *canvas) triangle(x1, y1, x2, y2, x3, y3 int, col color.RGBA) {
+	x1, y1, x2, y2, x3, y3 = x1*pngScale, y1*pngScale, x2*pngScale, y2*pngScale, x3*pngScale, y3*pngScale
+	side := func(ax, ay, bx, by, px, py int) int {
+		return (bx-ax)*(py-ay) - (by-ay)*(px-ax)
+	}
+	for y := minOf(y1, y2, y3); y <= maxOf(y1, y2, y3); y++ {
+		for x := minOf(x1, x2, x3); x <= maxOf(x1, x2, x3); x++ {
+			d1, d2, d3 := side(x1, y1, x2, y2, x, y), side(x2, y2, x3, y3, x, y), side(x3, y3, x1, y1, x, y)
+			if (d1 >= 0 && d2 >= 0 && d3 >= 0) || (d1 <= 0 && d2 <= 0 && d3 <= 0) {
+				c.img.SetRGBA(x, y, col)
+			}
+		}
+	}
+}
+
+// text writes the text with the glyphs of the bitmap font enlarged by size, from
+// its top left corner or centered on x
+func (c *canvas) text(x, y int, s string, size int, col color.RGBA, centered bool) {
+	s = strings.ToUpper(s)
+	runes := []rune(s)
+	advance := (glyphWidth + 1) * size
+	px, py := x*pngScale, y*pngScale
+	if centered {
+		px -= (len(runes)*advance - size) / 2
+	}
+	for _, r := range runes {
+		g, ok := glyphs[r]
+		if !ok {
+			g = glyphs['?']
+		}
+		for row, bits := range g {
+			for i := 0; i < glyphWidth; i++ {
+				if bits&(1<<uint(glyphWidth-1-i)) != 0 {
+					c.fillPixels(px+i*size, py+row*size, size, size, col)
+				}
+			}
+		}
+		px += advance
+	}
+}
+
+func rgb(hex string) color.RGBA {
+	v, _ := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func minOf(v ...int) int {
+	m := v[0]
+	for _, x := range v[1:] {
+		if x < m {
+			m = x
+		}
+	}
+	return m
+}
+
+func maxOf(v ...int) int {
+	m := v[0]
+	for _, x := range v[1:] {
+		if x > m {
+			m = x
+		}
+	}
+	return m
+}
@@ -0,0 +1,198 @@
+// Package snapshot renders the topology of a design to an image, for embedding
+// designs in documents and reviews without taking screenshots of Meshery UI
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/models/pattern/core"
+)
+
+// Formats of the images a design can be rendered to
+const (
+	SVG = "svg"
+	PNG = "png"
+)
+
+// Dimensions of the layout, in pixels of an SVG image
+const (
+	margin      = 40
+	titleHeight = 40
+	nodeWidth   = 180
+	nodeHeight  = 48
+	nodeSpacing = 40
+	rankSpacing = 80
+	// maxLabel is the number of characters of the labels fitting in a node
+	maxLabel = 24
+)
+
+// node is a service of the design placed in the image
+type node struct {
+	id    string
+	name  string
+	kind  string
+	x, y  int
+	rank  int
+	order int
+}
+
+// edge joins a service to a service it depends on
+type edge struct {
+	from, to *node
+}
+
+// layout is the topology of a design laid out as the designer of Meshery UI does,
+// top to bottom, the services a service depends on being ranked above it
+type layout struct {
+	title  string
+	width  int
+	height int
+	nodes  []*node
+	edges  []edge
+}
+
+// Render writes the image of the topology of the design in the given format,
+// svg or png
+func Render(w io.Writer, pattern core.Pattern, format string) error {
+	l := newLayout(pattern)
+	switch strings.ToLower(format) {
+	case SVG, "":
+		return l.writeSVG(w)
+	case PNG:
+		return l.writePNG(w)
+	}
+	return fmt.Errorf("unsupported image format %q, use svg or png", format)
+}
+
+// ContentType returns the media type of the images of the given format
+func ContentType(format string) string {
+	if strings.ToLower(format) == PNG {
+		return "image/png"
+	}
+	return "image/svg+xml"
+}
+
+func newLayout(pattern core.Pattern) *layout {
+	l := &layout{title: pattern.Name}
+
+	nodes := map[string]*node{}
+	for id, svc := range pattern.Services {
+		if svc == nil {
+			continue
+		}
+		name := svc.Name
+		if name == "" {
+			name = id
+		}
+		nodes[id] = &node{id: id, name: name, kind: strings.SplitN(svc.Type, ".", 2)[0]}
+	}
+
+	// A service is ranked below all the services it depends on. The dependencies
+	// closing a cycle are ignored.
+	visiting := map[string]bool{}
+	ranked := map[string]bool{}
+	var rank func(id string) int
+	rank = func(id string) int {
+		n := nodes[id]
+		if ranked[id] || visiting[id] {
+			return n.rank
+		}
+		visiting[id] = true
+		for _, dep := range pattern.Services[id].DependsOn {
+			if _, ok := nodes[dep]; ok && !visiting[dep] {
+				if r := rank(dep) + 1; r > n.rank {
+					n.rank = r
+				}
+			}
+		}
+		visiting[id] = false
+		ranked[id] = true
+		return n.rank
+	}
+
+	ranks := [][]*node{}
+	for id, n := range nodes {
+		r := rank(id)
+		for len(ranks) <= r {
+			ranks = append(ranks, nil)
+		}
+		ranks[r] = append(ranks[r], n)
+	}
+
+	widest := 0
+	for _, nodes := range ranks {
+		if len(nodes) > widest {
+			widest = len(nodes)
+		}
+	}
+	l.width = 2*margin + widest*nodeWidth + (widest-1)*nodeSpacing
+	if l.width < 2*margin+nodeWidth {
+		l.width = 2*margin + nodeWidth
+	}
+	l.height = 2*margin + titleHeight + len(ranks)*nodeHeight + (len(ranks)-1)*rankSpacing
+	if len(ranks) == 0 {
+		l.height = 2*margin + titleHeight
+	}
+
+	for r, nodes := range ranks {
+		sort.Slice(nodes, func(i, j int) bool {
+			if nodes[i].kind != nodes[j].kind {
+				return nodes[i].kind < nodes[j].kind
+			}
+			if nodes[i].name != nodes[j].name {
+				return nodes[i].name < nodes[j].name
+			}
+			return nodes[i].id < nodes[j].id
+		})
+		rowWidth := len(nodes)*nodeWidth + (len(nodes)-1)*nodeSpacing
+		for i, n := range nodes {
+			n.order = i
+			n.x = (l.width-rowWidth)/2 + i*(nodeWidth+nodeSpacing)
+			n.y = margin + titleHeight + r*(nodeHeight+rankSpacing)
+			l.nodes = append(l.nodes, n)
+		}
+	}
+
+	for _, from := range l.nodes {
+		deps := append([]string{}, pattern.Services[from.id].DependsOn...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if to, ok := nodes[dep]; ok && to != from {
+				l.edges = append(l.edges, edge{from: from, to: to})
+			}
+		}
+	}
+	return l
+}
+
+// ends returns the points an edge leaves its service and reaches the service it
+// depends on, the top and the bottom of the nodes when ranked apart
+func (e edge) ends() (x1, y1, x2, y2 int) {
+	x1, x2 = e.from.x+nodeWidth/2, e.to.x+nodeWidth/2
+	switch {
+	case e.from.y > e.to.y:
+		y1, y2 = e.from.y, e.to.y+nodeHeight
+	case e.from.y < e.to.y:
+		y1, y2 = e.from.y+nodeHeight, e.to.y
+	default:
+		y1, y2 = e.from.y+nodeHeight/2, e.to.y+nodeHeight/2
+		if e.from.x < e.to.x {
+			x1, x2 = e.from.x+nodeWidth, e.to.x
+		} else {
+			x1, x2 = e.from.x, e.to.x+nodeWidth
+		}
+	}
+	return
+}
+
+// truncate shortens the label to fit in a node
+func truncate(label string) string {
+	r := []rune(label)
+	if len(r) <= maxLabel {
+		return label
+	}
+	return string(r[:maxLabel-3]) + "..."
+}
@@ -0,0 +1,81 @@
+package snapshot
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/models/pattern/core"
+)
+
+func testPattern() core.Pattern {
+	return core.Pattern{
+		Name: "bookinfo <demo>",
+		Services: map[string]*core.Service{
+			"ns":      {Name: "bookinfo", Type: "Namespace.K8s"},
+			"config":  {Name: "reviews-config", Type: "ConfigMap.K8s", DependsOn: []string{"ns"}},
+			"reviews": {Name: "reviews", Type: "Deployment.K8s", DependsOn: []string{"ns", "config"}},
+			"svc":     {Name: "reviews", Type: "Service.K8s", DependsOn: []string{"reviews", "ns"}},
+			// a cycle does not prevent the design from being laid out
+			"a": {Name: "a", Type: "Deployment.K8s", DependsOn: []string{"b"}},
+			"b": {Name: "b", Type: "Deployment.K8s", DependsOn: []string{"a"}},
+		},
+	}
+}
+
+func TestNewLayout(t *testing.T) {
+	l := newLayout(testPattern())
+
+	ranks := map[string]int{}
+	for _, n := range l.nodes {
+		ranks[n.id] = n.rank
+	}
+	for id, want := range map[string]int{"ns": 0, "config": 1, "reviews": 2, "svc": 3} {
+		if ranks[id] != want {
+			t.Errorf("got rank %d of %s want %d", ranks[id], id, want)
+		}
+	}
+	if len(l.edges) != 7 {
+		t.Errorf("got %d edges want 7", len(l.edges))
+	}
+	for _, e := range l.edges {
+		if e.from.rank > e.to.rank && e.from.y <= e.to.y {
+			t.Errorf("got %s drawn above %s, which it depends on", e.from.id, e.to.id)
+		}
+	}
+	for _, n := range l.nodes {
+		if n.x < margin || n.x+nodeWidth > l.width-margin || n.y+nodeHeight > l.height-margin {
+			t.Errorf("got %s laid out outside of the image", n.id)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	var svg bytes.Buffer
+	if err := Render(&svg, testPattern(), SVG); err != nil {
+		t.Fatalf("rendering svg: %s", err)
+	}
+	for _, want := range []string{"<svg ", "bookinfo &lt;demo&gt;", "reviews-config", "Deployment"} {
+		if !strings.Contains(svg.String(), want) {
+			t.Errorf("got svg without %q", want)
+		}
+	}
+
+	var image bytes.Buffer
+	if err := Render(&image, testPattern(), PNG); err != nil {
+		t.Fatalf("rendering png: %s", err)
+	}
+	img, err := png.Decode(&image)
+	if err != nil {
+		t.Fatalf("decoding png: %s", err)
+	}
+	l := newLayout(testPattern())
+	if b := img.Bounds(); b.Dx() != l.width*pngScale || b.Dy() != l.height*pngScale {
+		t.Errorf("got png of %dx%d want %dx%d", b.Dx(), b.Dy(), l.width*pngScale, l.height*pngScale)
+	}
+
+	if err := Render(&svg, testPattern(), "gif"); err == nil {
+		t.Errorf("got no error rendering gif")
+	}
+}
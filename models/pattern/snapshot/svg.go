@@ -0,0 +1,59 @@
+package snapshot
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"math"
+)
+
+// Colors of the images, those of Meshery UI
+const (
+	accentColor = "#00b39f"
+	textColor   = "#3c494f"
+	mutedColor  = "#647881"
+	fillColor   = "#ffffff"
+)
+
+func (l *layout) writeSVG(w io.Writer) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Helvetica, Arial, sans-serif">`+"\n", l.width, l.height, l.width, l.height)
+	fmt.Fprintf(b, `  <rect width="%d" height="%d" fill="%s"/>`+"\n", l.width, l.height, fillColor)
+	fmt.Fprintf(b, `  <text x="%d" y="%d" font-size="18" font-weight="bold" fill="%s">%s</text>`+"\n", margin, margin+18, textColor, html.EscapeString(l.title))
+
+	for _, e := range l.edges {
+		x1, y1, x2, y2 := e.ends()
+		fmt.Fprintf(b, `  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s" stroke-width="1.5"/>`+"\n", x1, y1, x2, y2, mutedColor)
+		ax, ay, bx, by := arrowHead(x1, y1, x2, y2)
+		fmt.Fprintf(b, `  <polygon points="%d,%d %d,%d %d,%d" fill="%s"/>`+"\n", x2, y2, ax, ay, bx, by, mutedColor)
+	}
+
+	for _, n := range l.nodes {
+		fmt.Fprintf(b, `  <g id="%s">`+"\n", html.EscapeString(n.id))
+		fmt.Fprintf(b, `    <rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="%s" stroke="%s" stroke-width="2"/>`+"\n", n.x, n.y, nodeWidth, nodeHeight, fillColor, accentColor)
+		fmt.Fprintf(b, `    <text x="%d" y="%d" font-size="13" text-anchor="middle" fill="%s">%s</text>`+"\n", n.x+nodeWidth/2, n.y+20, textColor, html.EscapeString(truncate(n.name)))
+		fmt.Fprintf(b, `    <text x="%d" y="%d" font-size="11" text-anchor="middle" fill="%s">%s</text>`+"\n", n.x+nodeWidth/2, n.y+37, mutedColor, html.EscapeString(truncate(n.kind)))
+		fmt.Fprintln(b, `  </g>`)
+	}
+
+	fmt.Fprintln(b, `</svg>`)
+	return b.Flush()
+}
+
+// arrowHead returns the corners of the head of an arrow pointing to x2, y2
+func arrowHead(x1, y1, x2, y2 int) (ax, ay, bx, by int) {
+	const length, halfWidth = 9.0, 4.5
+	dx, dy := float64(x2-x1), float64(y2-y1)
+	d := math.Hypot(dx, dy)
+	if d == 0 {
+		return x2, y2, x2, y2
+	}
+	ux, uy := dx/d, dy/d
+	baseX, baseY := float64(x2)-ux*length, float64(y2)-uy*length
+	ax = int(math.Round(baseX - uy*halfWidth))
+	ay = int(math.Round(baseY + ux*halfWidth))
+	bx = int(math.Round(baseX + uy*halfWidth))
+	by = int(math.Round(baseY - ux*halfWidth))
+	return
+}
@@ -12,6 +12,11 @@ type Data struct {
 	PatternSvcWorkloadCapabilities map[string]core.WorkloadCapability
 	PatternSvcTraitCapabilities    map[string][]core.TraitCapability
 
+	// Labels and Annotations are set on every component provisioned, such as the labels of
+	// ownership of Meshery Server
+	Labels      map[string]string
+	Annotations map[string]string
+
 	// Other is for passing metadata across different stages
 	Lock  sync.Mutex
 	Other map[string]interface{}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/layer5io/meshery/helpers"
 	"github.com/layer5io/meshery/models/pattern/core"
+	"github.com/layer5io/meshery/models/pattern/patterns/k8s"
 	"github.com/layer5io/meshery/models/pattern/planner"
 	"github.com/layer5io/meshery/models/pattern/resource/selector"
 	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
@@ -19,6 +20,15 @@ type CompConfigPair struct {
 
 const ProvisionSuffixKey = ".isProvisioned"
 
+// ProvisionedKey holds the services provisioned successfully, in the order of provisioning
+const ProvisionedKey = "provisioned"
+
+// ProvisionedService is a service which was provisioned successfully
+type ProvisionedService struct {
+	Name string
+	CompConfigPair
+}
+
 func Provision(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
 	return func(data *Data, err error, next ChainStageNextFunction) {
 		if err != nil {
@@ -52,7 +62,7 @@ func Provision(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFu
 			ccp := CompConfigPair{}
 
 			// Create application component
-			comp, err := data.Pattern.GetApplicationComponent(name)
+			comp, err := applicationComponent(data, name, svc)
 			if err != nil {
 				return false
 			}
@@ -63,16 +73,6 @@ func Provision(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFu
 				data.PatternSvcTraitCapabilities[name],
 			)
 
-			comp.SetLabels(map[string]string{
-				"resource.pattern.meshery.io/id": svc.ID.String(),
-			})
-
-			// Get annotations for the component, if any
-			comp.Annotations = helpers.MergeStringMaps(
-				selector.GetAnnotationsForWorkload(data.PatternSvcWorkloadCapabilities[name]),
-				comp.Annotations,
-			)
-
 			ccp.Component = comp
 
 			// Add configuration only if traits are applied to the component
@@ -82,13 +82,28 @@ func Provision(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFu
 
 			msg, err := act.Provision(ccp)
 			if err != nil {
+				data.Lock.Lock()
 				errs = append(errs, err)
+				data.Lock.Unlock()
 				return false
 			}
 
+			// The services depending on this one are provisioned once it is ready, and
+			// the hooks once they completed
+			if !prov.IsDelete() && (svc.Hook != "" || plan.HasDependents(name)) {
+				if err := act.WaitForReady(ccp); err != nil {
+					data.Lock.Lock()
+					errs = append(errs, err)
+					data.Lock.Unlock()
+					return false
+				}
+			}
+
 			data.Lock.Lock()
 			// Store that this service was provisioned successfully
 			data.Other[fmt.Sprintf("%s%s", name, ProvisionSuffixKey)] = msg
+			provisioned, _ := data.Other[ProvisionedKey].([]ProvisionedService)
+			data.Other[ProvisionedKey] = append(provisioned, ProvisionedService{Name: name, CompConfigPair: ccp})
 			data.Lock.Unlock()
 
 			return true
@@ -100,6 +115,30 @@ func Provision(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFu
 	}
 }
 
+// applicationComponent returns the component the service of the pattern is provisioned as, with
+// the labels and annotations of the data
+func applicationComponent(data *Data, name string, svc core.Service) (v1alpha1.Component, error) {
+	comp, err := data.Pattern.GetApplicationComponent(name)
+	if err != nil {
+		return comp, err
+	}
+
+	comp.SetLabels(helpers.MergeStringMaps(data.Labels, map[string]string{
+		"resource.pattern.meshery.io/id": svc.ID.String(),
+	}))
+
+	// Get annotations for the component, if any
+	comp.Annotations = helpers.MergeStringMaps(
+		data.Annotations,
+		selector.GetAnnotationsForWorkload(data.PatternSvcWorkloadCapabilities[name]),
+		comp.Annotations,
+	)
+	if svc.Hook != "" {
+		comp.Annotations[k8s.HookAnnotation] = svc.Hook
+	}
+	return comp, nil
+}
+
 func generateHosts(wc core.WorkloadCapability, tcs []core.TraitCapability) map[string]bool {
 	res := map[string]bool{}
 
@@ -0,0 +1,48 @@
+package stages
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RecordedServices restricts the pattern to the services Meshery holds a record of
+// having provisioned, leaving the namespaces out as well when keepNamespaces is set.
+// It must run after ServiceIdentifier.
+func RecordedServices(keepNamespaces bool) ChainStageFunction {
+	return func(data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			if next != nil {
+				next(data, err)
+			}
+			return
+		}
+
+		data.Lock.Lock()
+		for name, svc := range data.Pattern.Services {
+			_, recorded := data.Other[fmt.Sprintf("%s%s", name, UpdateSuffixKey)]
+			if !recorded || (keepNamespaces && isNamespaceService(svc.Type)) {
+				delete(data.Pattern.Services, name)
+			}
+		}
+		data.Lock.Unlock()
+
+		// Drop the dependencies on the services which were left out
+		for _, svc := range data.Pattern.Services {
+			deps := []string{}
+			for _, dep := range svc.DependsOn {
+				if _, ok := data.Pattern.Services[dep]; ok {
+					deps = append(deps, dep)
+				}
+			}
+			svc.DependsOn = deps
+		}
+
+		if next != nil {
+			next(data, nil)
+		}
+	}
+}
+
+func isNamespaceService(typ string) bool {
+	return strings.EqualFold(strings.TrimSuffix(strings.ToLower(typ), ".k8s"), "namespace")
+}
@@ -0,0 +1,48 @@
+package stages
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Rollback deletes the services which were provisioned successfully when the
+// provisioning of the pattern failed, so that the cluster is not left with a
+// partially applied pattern
+func Rollback(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
+	return func(data *Data, err error, next ChainStageNextFunction) {
+		if err == nil || prov.IsDelete() {
+			if next != nil {
+				next(data, err)
+			}
+			return
+		}
+
+		data.Lock.Lock()
+		provisioned, _ := data.Other[ProvisionedKey].([]ProvisionedService)
+		delete(data.Other, ProvisionedKey)
+		for _, svc := range provisioned {
+			delete(data.Other, fmt.Sprintf("%s%s", svc.Name, ProvisionSuffixKey))
+		}
+		data.Lock.Unlock()
+
+		errs := []error{err}
+		rolledBack := []string{}
+
+		// Undo in the reverse order so that dependents are removed before their dependencies
+		for i := len(provisioned) - 1; i >= 0; i-- {
+			svc := provisioned[i]
+			if _, err := act.Rollback(svc.CompConfigPair); err != nil {
+				errs = append(errs, fmt.Errorf("failed to roll back %s: %s", svc.Name, err))
+				continue
+			}
+			rolledBack = append(rolledBack, svc.Name)
+		}
+		if len(rolledBack) > 0 {
+			errs = append(errs, fmt.Errorf("rolled back %s", strings.Join(rolledBack, ", ")))
+		}
+
+		if next != nil {
+			next(data, mergeErrors(errs))
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package stages
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/models/pattern/core"
+)
+
+type fakeInfoProvider struct{}
+
+func (fakeInfoProvider) GetMesheryPatternResource(name, namespace, typ, oamType string) (*uuid.UUID, error) {
+	return nil, nil
+}
+func (fakeInfoProvider) GetServiceMesh() (string, string)   { return "", "" }
+func (fakeInfoProvider) GetAPIVersionForKind(string) string { return "" }
+func (fakeInfoProvider) IsDelete() bool                     { return false }
+
+type fakeActionProvider struct {
+	rolledBack []string
+}
+
+func (f *fakeActionProvider) Terminate(error)                          {}
+func (f *fakeActionProvider) Provision(CompConfigPair) (string, error) { return "", nil }
+func (f *fakeActionProvider) Persist(string, core.Service, bool) error { return nil }
+func (f *fakeActionProvider) WaitForReady(CompConfigPair) error        { return nil }
+func (f *fakeActionProvider) Rollback(ccp CompConfigPair) (string, error) {
+	f.rolledBack = append(f.rolledBack, ccp.Component.Name)
+	return "", nil
+}
+
+func TestRollback(t *testing.T) {
+	provisioned := []ProvisionedService{}
+	for _, name := range []string{"namespace", "deployment"} {
+		svc := ProvisionedService{Name: name}
+		svc.Component.Name = name
+		provisioned = append(provisioned, svc)
+	}
+
+	act := &fakeActionProvider{}
+	data := &Data{
+		Other: map[string]interface{}{
+			ProvisionedKey:                    provisioned,
+			"namespace" + ProvisionSuffixKey:  "",
+			"deployment" + ProvisionSuffixKey: "",
+			"unrelated" + UpdateSuffixKey:     true,
+		},
+	}
+
+	var gotErr error
+	Rollback(fakeInfoProvider{}, act)(data, errors.New("failed to provision service"), func(data *Data, err error) {
+		gotErr = err
+	})
+
+	if strings.Join(act.rolledBack, ",") != "deployment,namespace" {
+		t.Errorf("services rolled back in order %v, want [deployment namespace]", act.rolledBack)
+	}
+	if gotErr == nil || !strings.Contains(gotErr.Error(), "failed to provision service") || !strings.Contains(gotErr.Error(), "rolled back deployment, namespace") {
+		t.Errorf("unexpected error passed to the next stage: %v", gotErr)
+	}
+	for k := range data.Other {
+		if strings.HasSuffix(k, ProvisionSuffixKey) || k == ProvisionedKey {
+			t.Errorf("rolled back service still recorded as provisioned: %s", k)
+		}
+	}
+}
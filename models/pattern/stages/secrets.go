@@ -0,0 +1,77 @@
+package stages
+
+import (
+	"context"
+)
+
+// SecretResolver resolves the value when it references a secret of an external secret store
+type SecretResolver interface {
+	Resolve(ctx context.Context, value string) (string, bool, error)
+}
+
+// ResolveSecrets replaces the secret references found in the settings and traits of
+// the services by the secrets they reference, so that the stored design never holds
+// credentials. It must run after Filler so that the secrets are not logged.
+func ResolveSecrets(ctx context.Context, resolver SecretResolver) ChainStageFunction {
+	return func(data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			if next != nil {
+				next(data, err)
+			}
+			return
+		}
+
+		for _, svc := range data.Pattern.Services {
+			if err = resolveSecretRefs(ctx, resolver, svc.Settings); err != nil {
+				break
+			}
+			if err = resolveSecretRefs(ctx, resolver, svc.Traits); err != nil {
+				break
+			}
+		}
+
+		if next != nil {
+			next(data, err)
+		}
+	}
+}
+
+// resolveSecretRefs resolves the secret references among the values of the map, or of the
+// maps and slices it holds, in place
+func resolveSecretRefs(ctx context.Context, resolver SecretResolver, v interface{}) error {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if s, ok := item.(string); ok {
+				secret, isRef, err := resolver.Resolve(ctx, s)
+				if err != nil {
+					return err
+				}
+				if isRef {
+					val[k] = secret
+				}
+				continue
+			}
+			if err := resolveSecretRefs(ctx, resolver, item); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i, item := range val {
+			if s, ok := item.(string); ok {
+				secret, isRef, err := resolver.Resolve(ctx, s)
+				if err != nil {
+					return err
+				}
+				if isRef {
+					val[i] = secret
+				}
+				continue
+			}
+			if err := resolveSecretRefs(ctx, resolver, item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package stages
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/models/pattern/core"
+)
+
+type fakeSecretResolver map[string]string
+
+func (f fakeSecretResolver) Resolve(_ context.Context, value string) (string, bool, error) {
+	if !strings.HasPrefix(value, "vault://") {
+		return value, false, nil
+	}
+	secret, ok := f[value]
+	if !ok {
+		return "", true, errors.New("secret not found")
+	}
+	return secret, true, nil
+}
+
+func TestResolveSecrets(t *testing.T) {
+	resolver := fakeSecretResolver{"vault://secret/data/db#password": "s3cret"}
+	data := &Data{
+		Pattern: &core.Pattern{
+			Services: map[string]*core.Service{
+				"db": {
+					Settings: map[string]interface{}{
+						"image": "postgres",
+						"env": []interface{}{
+							map[string]interface{}{"name": "POSTGRES_PASSWORD", "value": "vault://secret/data/db#password"},
+						},
+					},
+					Traits: map[string]interface{}{"token": "vault://secret/data/db#password"},
+				},
+			},
+		},
+	}
+
+	var gotErr error
+	ResolveSecrets(context.Background(), resolver)(data, nil, func(_ *Data, err error) { gotErr = err })
+	if gotErr != nil {
+		t.Fatalf("unexpected error: %v", gotErr)
+	}
+	svc := data.Pattern.Services["db"]
+	env := svc.Settings["env"].([]interface{})[0].(map[string]interface{})
+	if env["value"] != "s3cret" || svc.Traits["token"] != "s3cret" || svc.Settings["image"] != "postgres" {
+		t.Errorf("secret references not resolved: %+v %+v", svc.Settings, svc.Traits)
+	}
+
+	data.Pattern.Services["db"].Settings["missing"] = "vault://secret/data/missing#password"
+	ResolveSecrets(context.Background(), resolver)(data, nil, func(_ *Data, err error) { gotErr = err })
+	if gotErr == nil {
+		t.Error("expected an error for an unresolvable reference")
+	}
+}
@@ -0,0 +1,109 @@
+package stages
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SelectedServices restricts the pattern to the given services, named by their identifier
+// in the pattern or their name. A service selected for provisioning can only depend on the
+// services selected along with it or which Meshery holds a record of having provisioned,
+// and a service selected for deletion cannot be depended upon by a provisioned service
+// left in place. It must run after ServiceIdentifier and Filler.
+func SelectedServices(prov ServiceInfoProvider, components []string) ChainStageFunction {
+	return func(data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			if next != nil {
+				next(data, err)
+			}
+			return
+		}
+
+		selected, err := selectServices(data, components)
+		if err == nil {
+			err = checkSelection(data, selected, prov.IsDelete())
+		}
+		if err != nil {
+			if next != nil {
+				next(data, err)
+			}
+			return
+		}
+
+		data.Lock.Lock()
+		for name := range data.Pattern.Services {
+			if !selected[name] {
+				delete(data.Pattern.Services, name)
+			}
+		}
+		data.Lock.Unlock()
+
+		// The dependencies left out are provisioned already
+		for _, svc := range data.Pattern.Services {
+			deps := []string{}
+			for _, dep := range svc.DependsOn {
+				if selected[dep] {
+					deps = append(deps, dep)
+				}
+			}
+			svc.DependsOn = deps
+		}
+
+		if next != nil {
+			next(data, nil)
+		}
+	}
+}
+
+// selectServices returns the identifiers of the services of the pattern with the given
+// identifiers or names
+func selectServices(data *Data, components []string) (map[string]bool, error) {
+	selected := map[string]bool{}
+	unknown := []string{}
+	for _, c := range components {
+		found := false
+		for name, svc := range data.Pattern.Services {
+			if name == c || svc.Name == c {
+				selected[name] = true
+				found = true
+			}
+		}
+		if !found {
+			unknown = append(unknown, c)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("the pattern has no component named %s", strings.Join(unknown, ", "))
+	}
+	return selected, nil
+}
+
+// checkSelection verifies that the selected services can be provisioned or deleted
+// without the others
+func checkSelection(data *Data, selected map[string]bool, isDelete bool) error {
+	data.Lock.Lock()
+	defer data.Lock.Unlock()
+
+	errs := []string{}
+	for name, svc := range data.Pattern.Services {
+		for _, dep := range svc.DependsOn {
+			if selected[dep] == selected[name] {
+				continue
+			}
+			_, recorded := data.Other[fmt.Sprintf("%s%s", name, UpdateSuffixKey)]
+			_, depRecorded := data.Other[fmt.Sprintf("%s%s", dep, UpdateSuffixKey)]
+			if !isDelete && selected[name] && !depRecorded {
+				errs = append(errs, fmt.Sprintf("%s depends on %s, which is neither selected nor deployed", name, dep))
+			}
+			if isDelete && selected[dep] && recorded {
+				errs = append(errs, fmt.Sprintf("%s would be left without %s, which it depends on", name, dep))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("invalid selection of components: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package stages
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/models/pattern/core"
+)
+
+type fakeDeleteInfoProvider struct{ fakeInfoProvider }
+
+func (fakeDeleteInfoProvider) IsDelete() bool { return true }
+
+func TestSelectedServices(t *testing.T) {
+	newData := func(recorded ...string) *Data {
+		data := &Data{
+			Pattern: &core.Pattern{Services: map[string]*core.Service{
+				"db":       {Name: "postgres"},
+				"cart":     {Name: "cart", DependsOn: []string{"db"}},
+				"frontend": {Name: "frontend", DependsOn: []string{"cart"}},
+			}},
+			Other: map[string]interface{}{},
+		}
+		for _, name := range recorded {
+			data.Other[name+UpdateSuffixKey] = true
+		}
+		return data
+	}
+
+	tests := []struct {
+		name       string
+		prov       ServiceInfoProvider
+		data       *Data
+		components []string
+		want       []string
+		wantErr    string
+	}{
+		{
+			name:       "dependency selected along",
+			prov:       fakeInfoProvider{},
+			data:       newData(),
+			components: []string{"postgres", "cart"},
+			want:       []string{"cart", "db"},
+		},
+		{
+			name:       "dependency deployed already",
+			prov:       fakeInfoProvider{},
+			data:       newData("db"),
+			components: []string{"cart"},
+			want:       []string{"cart"},
+		},
+		{
+			name:       "dependency neither selected nor deployed",
+			prov:       fakeInfoProvider{},
+			data:       newData(),
+			components: []string{"frontend", "cart"},
+			wantErr:    "cart depends on db",
+		},
+		{
+			name:       "unknown component",
+			prov:       fakeInfoProvider{},
+			data:       newData(),
+			components: []string{"checkout"},
+			wantErr:    "no component named checkout",
+		},
+		{
+			name:       "deletion of a dependency of a deployed service",
+			prov:       fakeDeleteInfoProvider{},
+			data:       newData("db", "cart", "frontend"),
+			components: []string{"cart"},
+			wantErr:    "frontend would be left without cart",
+		},
+		{
+			name:       "deletion of the dependents",
+			prov:       fakeDeleteInfoProvider{},
+			data:       newData("db", "cart", "frontend"),
+			components: []string{"frontend", "cart"},
+			want:       []string{"cart", "frontend"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotErr error
+			SelectedServices(tt.prov, tt.components)(tt.data, nil, func(_ *Data, err error) { gotErr = err })
+			if tt.wantErr != "" {
+				if gotErr == nil || !strings.Contains(gotErr.Error(), tt.wantErr) {
+					t.Errorf("expected an error containing %q, got %v", tt.wantErr, gotErr)
+				}
+				return
+			}
+			if gotErr != nil {
+				t.Fatalf("unexpected error: %v", gotErr)
+			}
+
+			got := []string{}
+			for name, svc := range tt.data.Pattern.Services {
+				got = append(got, name)
+				for _, dep := range svc.DependsOn {
+					if _, ok := tt.data.Pattern.Services[dep]; !ok {
+						t.Errorf("%s still depends on %s, which was left out", name, dep)
+					}
+				}
+			}
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("selected %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -20,5 +20,8 @@ type ServiceInfoProvider interface {
 type ServiceActionProvider interface {
 	Terminate(error)
 	Provision(CompConfigPair) (string, error)
+	Rollback(CompConfigPair) (string, error)
+	// WaitForReady blocks until the provisioned component is ready, a Job until it completed
+	WaitForReady(CompConfigPair) error
 	Persist(string, core.Service, bool) error
 }
@@ -0,0 +1,57 @@
+package stages
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/models/pattern/patterns/k8s"
+)
+
+// ManifestValidator validates the manifests of the Kubernetes components of the named pattern before
+// they are applied, such as against the admission policies of an organization
+type ManifestValidator interface {
+	ValidateManifests(ctx context.Context, patternName string, manifests []map[string]interface{}) error
+}
+
+// ValidateManifests renders the manifests of the Kubernetes components of the pattern, as they are
+// provisioned, and terminates the chain when the validator rejects them. It must run after Validator.
+func ValidateManifests(ctx context.Context, validator ManifestValidator, act ServiceActionProvider) ChainStageFunction {
+	return func(data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+
+		names := make([]string, 0, len(data.Pattern.Services))
+		for name := range data.Pattern.Services {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		manifests := []map[string]interface{}{}
+		for _, name := range names {
+			svc := data.Pattern.Services[name]
+			if !strings.HasSuffix(strings.ToLower(svc.Type), ".k8s") {
+				continue
+			}
+			comp, err := applicationComponent(data, name, *svc)
+			if err != nil {
+				act.Terminate(err)
+				return
+			}
+			manifests = append(manifests, k8s.Manifest(comp))
+		}
+
+		if len(manifests) > 0 {
+			if err := validator.ValidateManifests(ctx, data.Pattern.Name, manifests); err != nil {
+				act.Terminate(err)
+				return
+			}
+		}
+
+		if next != nil {
+			next(data, nil)
+		}
+	}
+}
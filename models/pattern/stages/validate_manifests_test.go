@@ -0,0 +1,83 @@
+package stages
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/models/pattern/core"
+)
+
+type fakeManifestValidator struct {
+	pattern   string
+	manifests []map[string]interface{}
+	err       error
+}
+
+func (f *fakeManifestValidator) ValidateManifests(_ context.Context, patternName string, manifests []map[string]interface{}) error {
+	f.pattern = patternName
+	f.manifests = manifests
+	return f.err
+}
+
+type terminatingActionProvider struct {
+	fakeActionProvider
+	terminated error
+}
+
+func (t *terminatingActionProvider) Terminate(err error) { t.terminated = err }
+
+func TestValidateManifests(t *testing.T) {
+	newData := func() *Data {
+		id := uuid.Must(uuid.FromString("3f1c4f6e-9a8b-4c2d-8e7f-1a2b3c4d5e6f"))
+		return &Data{
+			Pattern: &core.Pattern{
+				Name: "web",
+				Services: map[string]*core.Service{
+					"nginx": {
+						ID:        &id,
+						Name:      "nginx",
+						Type:      "Deployment.K8s",
+						Namespace: "web",
+						// set from the workload capabilities of the service by the Filler stage
+						Annotations: map[string]string{
+							"pattern.meshery.io.k8s.k8sAPIVersion": "apps/v1",
+							"pattern.meshery.io.k8s.k8sKind":       "Deployment",
+						},
+						Settings: map[string]interface{}{
+							"spec": map[string]interface{}{"replicas": 2},
+						},
+					},
+					"mesh": {Name: "mesh", Type: "IstioMesh"},
+				},
+			},
+			Labels: map[string]string{"app.kubernetes.io/managed-by": "meshery"},
+		}
+	}
+
+	validator := &fakeManifestValidator{}
+	act := &terminatingActionProvider{}
+	nextCalled := false
+	ValidateManifests(context.Background(), validator, act)(newData(), nil, func(_ *Data, err error) { nextCalled = err == nil })
+	if !nextCalled || act.terminated != nil {
+		t.Fatalf("allowed manifests did not continue the chain: %v", act.terminated)
+	}
+	if validator.pattern != "web" || len(validator.manifests) != 1 {
+		t.Fatalf("unexpected manifests validated for %q: %v", validator.pattern, validator.manifests)
+	}
+	metadata := validator.manifests[0]["metadata"].(map[string]interface{})
+	labels := metadata["labels"].(map[string]string)
+	if validator.manifests[0]["apiVersion"] != "apps/v1" || validator.manifests[0]["kind"] != "Deployment" || metadata["name"] != "nginx" || metadata["namespace"] != "web" ||
+		labels["app.kubernetes.io/managed-by"] != "meshery" || labels["resource.pattern.meshery.io/id"] == "" {
+		t.Errorf("manifest not rendered as provisioned: %v", validator.manifests[0])
+	}
+
+	validator = &fakeManifestValidator{err: errors.New("denied")}
+	act = &terminatingActionProvider{}
+	nextCalled = false
+	ValidateManifests(context.Background(), validator, act)(newData(), nil, func(_ *Data, err error) { nextCalled = true })
+	if nextCalled || act.terminated == nil || act.terminated.Error() != "denied" {
+		t.Errorf("denied manifests did not terminate the chain: %v", act.terminated)
+	}
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+const (
+	// PerfTargetName is the name of the deployment and of the service of the echo server
+	// Meshery deploys as a known-good target of performance tests
+	PerfTargetName = "fortio-server"
+	// DefaultPerfTargetNamespace is the namespace the echo server is deployed to by default
+	DefaultPerfTargetNamespace = "meshery-perf"
+	// DefaultPerfTargetImage is the image of the echo server
+	DefaultPerfTargetImage = "fortio/fortio:latest"
+	// PerfTargetHTTPPort is the port the echo server serves HTTP on, PerfTargetGRPCPort the one it serves gRPC on
+	PerfTargetHTTPPort = 8080
+	PerfTargetGRPCPort = 8079
+	// PerfTargetProxyName is the name of the fortio proxy deployed in front of the echo server, so
+	// that requests sent to the proxy cross the mesh between two sidecars
+	PerfTargetProxyName = "fortio-proxy"
+	PerfTargetProxyPort = 8081
+)
+
+// PerfTarget is an echo server deployed by Meshery in a cluster, registered so that
+// performance tests can be run against it to measure the overhead of a service mesh
+// without involving the applications of the user
+type PerfTarget struct {
+	ID *uuid.UUID `json:"id,omitempty"`
+
+	K8sContextID string `json:"k8s_context_id,omitempty" gorm:"uniqueIndex:idx_perf_target_location"`
+	Namespace    string `json:"namespace,omitempty" gorm:"uniqueIndex:idx_perf_target_location"`
+	Image        string `json:"image,omitempty"`
+	// URL is the echo endpoint of the server, reachable from within the cluster
+	URL string `json:"url,omitempty"`
+	// MeshURL is the echo endpoint of the server reached through the proxy
+	MeshURL string `json:"mesh_url,omitempty"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// PerfTargetDeployRequest is the request to deploy the echo server in a cluster
+type PerfTargetDeployRequest struct {
+	// Cluster is the id or the name of the Kubernetes context, the current context by default
+	Cluster   string `json:"cluster,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Image     string `json:"image,omitempty"`
+}
+
+// PerfTargetAPIResponse is the response for the deployment of the echo server
+type PerfTargetAPIResponse struct {
+	Target PerfTarget `json:"target"`
+	// Ready tells whether the echo server became available before the response was sent
+	Ready bool `json:"ready"`
+}
+
+// PerfTargetMeshConfiguration is the configuration of the service mesh for the echo server, as
+// set for every stage of the mesh overhead benchmark
+type PerfTargetMeshConfiguration struct {
+	Mesh      string `json:"mesh"`
+	Sidecar   bool   `json:"sidecar"`
+	MTLS      bool   `json:"mtls"`
+	Telemetry bool   `json:"telemetry"`
+}
+
+// PerfTargetMeshConfigurationResult is the response for the configuration of the service mesh for the echo server
+type PerfTargetMeshConfigurationResult struct {
+	Configuration PerfTargetMeshConfiguration `json:"configuration"`
+	// AlwaysOn lists the features, mtls or telemetry, the mesh enables along with the sidecar
+	// and which cannot be turned off
+	AlwaysOn []string `json:"always_on,omitempty"`
+	// Ready tells whether the echo server and the proxy were rolled out before the response was sent
+	Ready bool `json:"ready"`
+}
+
+// Validate checks whether the configuration can be applied
+func (c *PerfTargetMeshConfiguration) Validate() error {
+	if c.Mesh == "" {
+		return fmt.Errorf("mesh is required")
+	}
+	if !c.Sidecar && (c.MTLS || c.Telemetry) {
+		return fmt.Errorf("mtls and telemetry require the sidecar")
+	}
+	return nil
+}
+
+// PerfTargetURL returns the echo endpoint of the echo server deployed in the given namespace
+func PerfTargetURL(namespace string) string {
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local:%d/echo", PerfTargetName, namespace, PerfTargetHTTPPort)
+}
+
+// PerfTargetMeshURL returns the echo endpoint of the echo server deployed in the given namespace, reached through the proxy
+func PerfTargetMeshURL(namespace string) string {
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local:%d/echo", PerfTargetProxyName, namespace, PerfTargetProxyPort)
+}
+
+// PerfTargetPersister is the persister for persisting
+// the performance test targets on the database
+type PerfTargetPersister struct {
+	DB *database.Handler
+}
+
+// SavePerfTarget persists the given target, replacing the one deployed to the same namespace of the same cluster if any
+func (ptp *PerfTargetPersister) SavePerfTarget(target *PerfTarget) error {
+	existing := PerfTarget{}
+	if err := ptp.DB.Where("k8s_context_id = ? AND namespace = ?", target.K8sContextID, target.Namespace).First(&existing).Error; err == nil {
+		target.ID = existing.ID
+		target.CreatedAt = existing.CreatedAt
+	} else {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		target.ID = &id
+	}
+	return ptp.DB.Save(target).Error
+}
+
+// GetPerfTarget returns the performance test target with the given id
+func (ptp *PerfTargetPersister) GetPerfTarget(id uuid.UUID) (*PerfTarget, error) {
+	target := &PerfTarget{}
+	if err := ptp.DB.Where("id = ?", id).First(target).Error; err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// GetPerfTargets returns all the performance test targets
+func (ptp *PerfTargetPersister) GetPerfTargets() ([]PerfTarget, error) {
+	targets := []PerfTarget{}
+	if err := ptp.DB.Order("created_at").Find(&targets).Error; err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
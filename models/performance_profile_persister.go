@@ -22,8 +22,9 @@ type PerformanceProfilePage struct {
 	Profiles   []*PerformanceProfile `json:"profiles"`
 }
 
-// GetPerformanceProfiles returns all of the performance profiles
-func (ppp *PerformanceProfilePersister) GetPerformanceProfiles(userID, search, order string, page, pageSize uint64) ([]byte, error) {
+// GetPerformanceProfiles returns all of the performance profiles, those of the owner
+// only if one is given
+func (ppp *PerformanceProfilePersister) GetPerformanceProfiles(userID, search, order, owner string, page, pageSize uint64) ([]byte, error) {
 	order = sanitizeOrderInput(order, []string{"updated_at", "created_at", "name", "last_run"})
 	if order == "" {
 		order = "updated_at desc"
@@ -37,8 +38,8 @@ func (ppp *PerformanceProfilePersister) GetPerformanceProfiles(userID, search, o
 		id, name, load_generators,
 		endpoints, qps, service_mesh,
 		duration, request_headers, request_cookies,
-		request_body, content_type, created_at,
-		updated_at, (?) as last_run, (?) as total_results`,
+		request_body, content_type, user_id,
+		created_at, updated_at, (?) as last_run, (?) as total_results`,
 			ppp.DB.Table("meshery_results").Select("DATETIME(MAX(meshery_results.test_start_time))").Where("performance_profile = performance_profiles.id"),
 			ppp.DB.Table("meshery_results").Select("COUNT(meshery_results.name)").Where("performance_profile = performance_profiles.id"),
 		).
@@ -49,7 +50,11 @@ func (ppp *PerformanceProfilePersister) GetPerformanceProfiles(userID, search, o
 		query = query.Where("(lower(performance_profiles.name) like ?)", like)
 	}
 
-	query.Table("performance_profiles").Count(&count)
+	if owner != "" {
+		query = query.Where("performance_profiles.user_id = ?", owner)
+	}
+
+	query.Model(&PerformanceProfile{}).Count(&count)
 
 	Paginate(uint(page), uint(pageSize))(query).Find(&profiles)
 
@@ -63,15 +68,23 @@ func (ppp *PerformanceProfilePersister) GetPerformanceProfiles(userID, search, o
 	return marshalPerformanceProfilePage(performanceProfilePage), nil
 }
 
-// DeletePerformanceProfile takes in a profile id and delete it if it already exists
+// DeletePerformanceProfile takes in a profile id and moves it to the trash if it already exists
 func (ppp *PerformanceProfilePersister) DeletePerformanceProfile(id uuid.UUID) ([]byte, error) {
 	profile := PerformanceProfile{ID: &id}
-	ppp.DB.Delete(profile)
+	ppp.DB.Delete(&profile)
 
 	return marshalPerformanceProfile(&profile), nil
 }
 
+// SavePerformanceProfile saves the profile, keeping the owner of the profile if it exists
+// already as ownership only changes by transfer
 func (ppp *PerformanceProfilePersister) SavePerformanceProfile(id uuid.UUID, profile *PerformanceProfile) error {
+	if profile.ID != nil {
+		var existing PerformanceProfile
+		if err := ppp.DB.Select("user_id").Where("id = ?", profile.ID).Limit(1).Find(&existing).Error; err == nil && existing.UserID != "" {
+			profile.UserID = existing.UserID
+		}
+	}
 	return ppp.DB.Save(profile).Error
 }
 
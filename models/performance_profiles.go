@@ -4,6 +4,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshery/internal/sql"
 	"github.com/lib/pq"
+	"gorm.io/gorm"
 
 	SMP "github.com/layer5io/service-mesh-performance/spec"
 )
@@ -28,12 +29,32 @@ type PerformanceProfile struct {
 	RequestCookies string `json:"request_cookies,omitempty"`
 	RequestBody    string `json:"request_body,omitempty"`
 	ContentType    string `json:"content_type,omitempty"`
+	// Method is the method of the requests the tests of the profile send, POST when the profile
+	// has a request body and GET otherwise when empty
+	Method string `json:"method,omitempty"`
+	// RequestMix is the weighted set of requests the tests of the profile send, rather than
+	// requests to the endpoint alone
+	RequestMix RequestMix `json:"request_mix,omitempty" gorm:"type:text"`
+	// UserID is the owner of the profile, the user who created it unless its ownership
+	// was transferred
+	UserID string `json:"user_id,omitempty"`
 
 	UpdatedAt *sql.Time `json:"updated_at,omitempty"`
 	CreatedAt *sql.Time `json:"created_at,omitempty"`
+	// DeletedAt is set while the profile is in the trash, until it is restored or purged,
+	// its results remaining associated with it and the trash listing it as a TrashItem
+	DeletedAt gorm.DeletedAt `json:"-"`
 }
 
 type PerformanceTestConfigFile struct {
 	Config      *SMP.PerformanceTestConfig `json:"test,omitempty"`
 	ServiceMesh *SMP.ServiceMesh           `json:"mesh,omitempty"`
+	// RequestMix is the weighted set of requests sent by every client of the test, an
+	// extension of SMP
+	RequestMix RequestMix `json:"request_mix,omitempty"`
+	// Method is the method of the requests sent by every client of the test, an extension of SMP
+	Method string `json:"method,omitempty"`
+	// Assertions are the checks the responses of every client of the test are validated with, an
+	// extension of SMP
+	Assertions *LoadTestAssertions `json:"assertions,omitempty"`
 }
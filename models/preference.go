@@ -21,6 +21,8 @@ type K8SNode struct {
 	KubeProxyVersion        string `json:"kubeproxy_version,omitempty"`
 	ContainerRuntimeVersion string `json:"container_runtime_version,omitempty"`
 	Architecture            string `json:"architecture,omitempty"`
+	// InstanceType is the type of the machine of the node, as labeled by its cloud provider
+	InstanceType string `json:"instance_type,omitempty"`
 }
 
 // Grafana represents the Grafana session config
@@ -42,6 +44,37 @@ type SelectedGrafanaConfig struct {
 type Prometheus struct {
 	PrometheusURL                   string                   `json:"prometheusURL,omitempty"`
 	SelectedPrometheusBoardsConfigs []*SelectedGrafanaConfig `json:"selectedPrometheusBoardsConfigs,omitempty"`
+	// Connections are the Prometheus URLs registered by name besides the default one
+	Connections map[string]string `json:"connections,omitempty"`
+}
+
+// DefaultPrometheusConnection is the name of the connection to the default Prometheus URL
+const DefaultPrometheusConnection = "default"
+
+// ConnectionURL returns the URL of the Prometheus connection with the given name
+func (p *Prometheus) ConnectionURL(name string) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+	if name == "" || name == DefaultPrometheusConnection {
+		return p.PrometheusURL, p.PrometheusURL != ""
+	}
+	promURL, ok := p.Connections[name]
+	return promURL, ok && promURL != ""
+}
+
+// Datadog represents the Datadog session config
+type Datadog struct {
+	Site           string `json:"site,omitempty"`
+	APIKey         string `json:"apiKey,omitempty"`
+	ApplicationKey string `json:"applicationKey,omitempty"`
+}
+
+// NewRelic represents the New Relic session config
+type NewRelic struct {
+	Region    string `json:"region,omitempty"`
+	AccountID string `json:"accountID,omitempty"`
+	APIKey    string `json:"apiKey,omitempty"`
 }
 
 // LoadTestPreferences represents the load test preferences
@@ -58,12 +91,23 @@ type PreferenceParams struct {
 	AnonymousPerfResults bool `json:"anonymousPerfResults"`
 }
 
+// CLIPreferences represents the mesheryctl preferences which follow the user across machines
+type CLIPreferences struct {
+	OutputFormat     string    `json:"outputFormat,omitempty"`
+	SkipConfirmation bool      `json:"skipConfirmation"`
+	TelemetryOptOut  bool      `json:"telemetryOptOut"`
+	UpdatedAt        time.Time `json:"updated_at,omitempty"`
+}
+
 // Preference represents the data stored in session / local DB
 type Preference struct {
 	MeshAdapters              []*Adapter             `json:"meshAdapters,omitempty"`
 	Grafana                   *Grafana               `json:"grafana,omitempty"`
 	Prometheus                *Prometheus            `json:"prometheus,omitempty"`
+	Datadog                   *Datadog               `json:"datadog,omitempty"`
+	NewRelic                  *NewRelic              `json:"newRelic,omitempty"`
 	LoadTestPreferences       *LoadTestPreferences   `json:"loadTestPrefs,omitempty"`
+	CLIPreferences            *CLIPreferences        `json:"cliPrefs,omitempty"`
 	AnonymousUsageStats       bool                   `json:"anonymousUsageStats"`
 	AnonymousPerfResults      bool                   `json:"anonymousPerfResults"`
 	UpdatedAt                 time.Time              `json:"updated_at,omitempty"`
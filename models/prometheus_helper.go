@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"text/template"
@@ -83,6 +84,54 @@ func (p *PrometheusClient) QueryRange(ctx context.Context, promURL string, query
 	return data, nil
 }
 
+// PromQL runs the PromQL query of the given params, as a range query when a start time is
+// given, and returns the response of Prometheus as is
+func (p *PrometheusClient) PromQL(ctx context.Context, promURL string, queryData *url.Values) ([]byte, error) {
+	if queryData == nil || queryData.Get("query") == "" {
+		return nil, ErrNilQuery
+	}
+	q := url.Values{}
+	q.Set("query", queryData.Get("query"))
+	endpoint := "/api/v1/query"
+	if queryData.Get("start") != "" {
+		endpoint = "/api/v1/query_range"
+		for _, key := range []string{"start", "end", "step"} {
+			q.Set(key, queryData.Get(key))
+		}
+	} else if queryData.Get("time") != "" {
+		q.Set("time", queryData.Get("time"))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, promURL+endpoint+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.grafanaClient.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		// Prometheus explains why a query failed, e.g. a syntax error, in the body
+		promErr := struct {
+			ErrorType string `json:"errorType"`
+			Error     string `json:"error"`
+		}{}
+		if json.Unmarshal(data, &promErr) == nil && promErr.Error != "" {
+			return nil, fmt.Errorf("%s: %s", promErr.ErrorType, promErr.Error)
+		}
+		return nil, fmt.Errorf("prometheus returned status %d", resp.StatusCode)
+	}
+	return data, nil
+}
+
 // GetClusterStaticBoard retrieves the cluster static board config
 func (p *PrometheusClient) GetClusterStaticBoard(ctx context.Context, promURL string) (*GrafanaBoard, error) {
 	return p.ImportGrafanaBoard(ctx, []byte(staticBoardCluster))
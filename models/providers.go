@@ -126,6 +126,8 @@ const (
 
 	PersistMesheryPatternResources Feature = "persist-meshery-pattern-resources" // /patterns/resources
 
+	ShareMesheryPatterns Feature = "share-meshery-patterns" // /patterns/{id}/shares, /patterns/{id}/reviews
+
 	PersistMesheryFilters Feature = "persist-meshery-filters" // /filter
 
 	PersistMesheryApplications Feature = "persist-meshery-applications" // /applications
@@ -133,6 +135,10 @@ const (
 	PersistPerformanceProfiles Feature = "persist-performance-profiles" // /user/performance/profile
 
 	PersistSchedules Feature = "persist-schedules" // /user/schedules
+
+	TransferOwnership Feature = "transfer-ownership" // /user/ownership/transfer
+
+	PersistTrash Feature = "persist-trash" // /user/trash
 )
 
 const (
@@ -154,6 +160,9 @@ const (
 	// UserPrefsCtxKey is the context key for persisting user preferences to context
 	PerfObjCtxKey ContextKey = "perf_obj"
 
+	// RequestCtxKey is the context key for persisting the http request to context
+	RequestCtxKey ContextKey = "http_request"
+
 	KubeHanderKey ContextKey = "kube_handler"
 
 	KubeConfigKey ContextKey = "kubeconfig"
@@ -162,6 +171,10 @@ const (
 
 	KubeClustersKey ContextKey = "kubeclusters"
 
+	// WorkloadIdentityCtxKey is the context key for persisting the workload identity
+	// a request is authenticated with, if any
+	WorkloadIdentityCtxKey ContextKey = "workload_identity"
+
 	// UserPrefsCtxKey is the context key for latest broker endpoint to context
 	BrokerURLCtxKey = "broker_endpoint"
 )
@@ -219,8 +232,8 @@ type Provider interface {
 	GetProviderToken(req *http.Request) (string, error)
 	UpdateToken(http.ResponseWriter, *http.Request) string
 	Logout(http.ResponseWriter, *http.Request)
-	FetchResults(tokenVal string, page, pageSize, search, order, profileID string) ([]byte, error)
-	FetchAllResults(tokenVal string, page, pageSize, search, order, from, to string) ([]byte, error)
+	FetchResults(tokenVal string, page, pageSize, search, order, profileID, owner string) ([]byte, error)
+	FetchAllResults(tokenVal string, page, pageSize, search, order, from, to, owner string) ([]byte, error)
 	PublishResults(req *http.Request, result *MesheryResult, profileID string) (string, error)
 	FetchSmiResults(req *http.Request, page, pageSize, search, order string) ([]byte, error)
 	FetchSmiResult(req *http.Request, page, pageSize, search, order string, resultID uuid.UUID) ([]byte, error)
@@ -250,7 +263,7 @@ type Provider interface {
 	GetKubeClient() *mesherykube.Client
 
 	SaveMesheryPattern(tokenString string, pattern *MesheryPattern) ([]byte, error)
-	GetMesheryPatterns(tokenString string, page, pageSize, search, order string) ([]byte, error)
+	GetMesheryPatterns(tokenString string, page, pageSize, search, order, owner string) ([]byte, error)
 	DeleteMesheryPattern(req *http.Request, patternID string) ([]byte, error)
 	DeleteMesheryPatterns(req *http.Request, patterns MesheryPatternDeleteRequestBody) ([]byte, error)
 	GetMesheryPattern(req *http.Request, patternID string) ([]byte, error)
@@ -259,6 +272,10 @@ type Provider interface {
 	GetMesheryPatternResource(token, resourceID string) (*PatternResource, error)
 	GetMesheryPatternResources(token, page, pageSize, search, order, name, namespace, typ, oamType string) (*PatternResourcePage, error)
 	DeleteMesheryPatternResource(token, resourceID string) error
+	SharePattern(req *http.Request, patternID string, share *PatternShare) ([]byte, error)
+	GetPatternShares(req *http.Request, patternID string) ([]byte, error)
+	SavePatternReview(req *http.Request, patternID string, review *PatternReview) ([]byte, error)
+	GetPatternReviews(req *http.Request, patternID string) ([]byte, error)
 
 	SaveMesheryFilter(tokenString string, filter *MesheryFilter) ([]byte, error)
 	GetMesheryFilters(req *http.Request, page, pageSize, search, order string) ([]byte, error)
@@ -274,7 +291,7 @@ type Provider interface {
 	RemoteApplicationFile(req *http.Request, resourceURL, path string, save bool) ([]byte, error)
 
 	SavePerformanceProfile(tokenString string, performanceProfile *PerformanceProfile) ([]byte, error)
-	GetPerformanceProfiles(tokenString string, page, pageSize, search, order string) ([]byte, error)
+	GetPerformanceProfiles(tokenString string, page, pageSize, search, order, owner string) ([]byte, error)
 	GetPerformanceProfile(req *http.Request, performanceProfileID string) ([]byte, error)
 	DeletePerformanceProfile(req *http.Request, performanceProfileID string) ([]byte, error)
 
@@ -282,4 +299,11 @@ type Provider interface {
 	GetSchedules(req *http.Request, page, pageSize, order string) ([]byte, error)
 	GetSchedule(req *http.Request, scheduleID string) ([]byte, error)
 	DeleteSchedule(req *http.Request, scheduleID string) ([]byte, error)
+
+	TransferOwnership(tokenString string, transfer *OwnershipTransfer) (*OwnershipTransferResult, error)
+
+	GetTrash(tokenString, kind string) ([]byte, error)
+	RestoreFromTrash(tokenString, kind, id string) ([]byte, error)
+
+	GetTelemetryReport(user *User, pref *Preference) (*TelemetryReport, error)
 }
@@ -617,7 +617,7 @@ func (l *RemoteProvider) GetCurrentContext(token string) (K8sContext, error) {
 }
 
 // FetchResults - fetches results for profile id from provider backend
-func (l *RemoteProvider) FetchResults(tokenVal string, page, pageSize, search, order, profileID string) ([]byte, error) {
+func (l *RemoteProvider) FetchResults(tokenVal string, page, pageSize, search, order, profileID, owner string) ([]byte, error) {
 	if !l.Capabilities.IsSupported(PersistPerformanceProfiles) {
 		logrus.Warn("operation not available")
 		return []byte{}, ErrInvalidCapability("PersistPerformanceProfiles", l.ProviderName)
@@ -642,6 +642,9 @@ func (l *RemoteProvider) FetchResults(tokenVal string, page, pageSize, search, o
 	if order != "" {
 		q.Set("order", order)
 	}
+	if owner != "" {
+		q.Set("owner", owner)
+	}
 	remoteProviderURL.RawQuery = q.Encode()
 	logrus.Debugf("constructed results url: %s", remoteProviderURL.String())
 	cReq, _ := http.NewRequest(http.MethodGet, remoteProviderURL.String(), nil)
@@ -671,7 +674,7 @@ func (l *RemoteProvider) FetchResults(tokenVal string, page, pageSize, search, o
 }
 
 // FetchAllResults - fetches results from provider backend
-func (l *RemoteProvider) FetchAllResults(tokenString string, page, pageSize, search, order, from, to string) ([]byte, error) {
+func (l *RemoteProvider) FetchAllResults(tokenString string, page, pageSize, search, order, from, to, owner string) ([]byte, error) {
 	if !l.Capabilities.IsSupported(PersistResults) {
 		logrus.Error("operation not available")
 		return []byte{}, ErrInvalidCapability("Persist Results", l.ProviderName)
@@ -695,6 +698,9 @@ func (l *RemoteProvider) FetchAllResults(tokenString string, page, pageSize, sea
 	if order != "" {
 		q.Set("order", order)
 	}
+	if owner != "" {
+		q.Set("owner", owner)
+	}
 	if from != "" {
 		q.Set("from", from)
 	}
@@ -1278,7 +1284,7 @@ func (l *RemoteProvider) SaveMesheryPattern(tokenString string, pattern *Meshery
 }
 
 // GetMesheryPatterns gives the patterns stored with the provider
-func (l *RemoteProvider) GetMesheryPatterns(tokenString string, page, pageSize, search, order string) ([]byte, error) {
+func (l *RemoteProvider) GetMesheryPatterns(tokenString string, page, pageSize, search, order, owner string) ([]byte, error) {
 	if !l.Capabilities.IsSupported(PersistMesheryPatterns) {
 		logrus.Error("operation not available")
 		return []byte{}, fmt.Errorf("%s is not suppported by provider: %s", PersistMesheryPatterns, l.ProviderName)
@@ -1302,6 +1308,9 @@ func (l *RemoteProvider) GetMesheryPatterns(tokenString string, page, pageSize,
 	if order != "" {
 		q.Set("order", order)
 	}
+	if owner != "" {
+		q.Set("owner", owner)
+	}
 	remoteProviderURL.RawQuery = q.Encode()
 	logrus.Debugf("constructed patterns url: %s", remoteProviderURL.String())
 	cReq, _ := http.NewRequest(http.MethodGet, remoteProviderURL.String(), nil)
@@ -1370,6 +1379,74 @@ func (l *RemoteProvider) GetMesheryPattern(req *http.Request, patternID string)
 	return nil, fmt.Errorf("error while getting pattern - Status code: %d, Body: %s", resp.StatusCode, bdr)
 }
 
+// SharePattern shares the pattern with the given id with a user or a team
+func (l *RemoteProvider) SharePattern(req *http.Request, patternID string, share *PatternShare) ([]byte, error) {
+	data, err := json.Marshal(share)
+	if err != nil {
+		return nil, ErrMarshal(err, "pattern share")
+	}
+	return l.doPatternCollaborationRequest(req, http.MethodPost, fmt.Sprintf("%s/shares", patternID), data)
+}
+
+// GetPatternShares returns the users and teams the pattern with the given id is shared with
+func (l *RemoteProvider) GetPatternShares(req *http.Request, patternID string) ([]byte, error) {
+	return l.doPatternCollaborationRequest(req, http.MethodGet, fmt.Sprintf("%s/shares", patternID), nil)
+}
+
+// SavePatternReview requests a review on the pattern with the given id, or updates an existing one
+func (l *RemoteProvider) SavePatternReview(req *http.Request, patternID string, review *PatternReview) ([]byte, error) {
+	data, err := json.Marshal(review)
+	if err != nil {
+		return nil, ErrMarshal(err, "pattern review")
+	}
+	if review.ID == nil {
+		return l.doPatternCollaborationRequest(req, http.MethodPost, fmt.Sprintf("%s/reviews", patternID), data)
+	}
+	return l.doPatternCollaborationRequest(req, http.MethodPut, fmt.Sprintf("%s/reviews/%s", patternID, review.ID), data)
+}
+
+// GetPatternReviews returns the reviews of the pattern with the given id
+func (l *RemoteProvider) GetPatternReviews(req *http.Request, patternID string) ([]byte, error) {
+	return l.doPatternCollaborationRequest(req, http.MethodGet, fmt.Sprintf("%s/reviews", patternID), nil)
+}
+
+func (l *RemoteProvider) doPatternCollaborationRequest(req *http.Request, method, path string, data []byte) ([]byte, error) {
+	if !l.Capabilities.IsSupported(ShareMesheryPatterns) {
+		logrus.Error("operation not available")
+		return nil, fmt.Errorf("%s is not suppported by provider: %s", ShareMesheryPatterns, l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(ShareMesheryPatterns)
+
+	remoteProviderURL, _ := url.Parse(fmt.Sprintf("%s%s/%s", l.RemoteProviderURL, ep, path))
+	logrus.Debugf("constructed pattern collaboration url: %s", remoteProviderURL.String())
+	cReq, _ := http.NewRequest(method, remoteProviderURL.String(), bytes.NewBuffer(data))
+
+	tokenString, err := l.GetToken(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		logrus.Errorf("unable to send pattern collaboration request: %v", err)
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logrus.Errorf("unable to read response body: %v", err)
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		return bdr, nil
+	}
+	logrus.Errorf("error while sending pattern collaboration request: %s", bdr)
+	return nil, fmt.Errorf("error while sending pattern collaboration request - Status code: %d, Body: %s", resp.StatusCode, bdr)
+}
+
 // DeleteMesheryPattern deletes a meshery pattern with the given id
 func (l *RemoteProvider) DeleteMesheryPattern(req *http.Request, patternID string) ([]byte, error) {
 	if !l.Capabilities.IsSupported(PersistMesheryPatterns) {
@@ -2077,7 +2154,7 @@ func (l *RemoteProvider) SavePerformanceProfile(tokenString string, pp *Performa
 }
 
 // GetPerformanceProfiles gives the performance profiles stored with the provider
-func (l *RemoteProvider) GetPerformanceProfiles(tokenString string, page, pageSize, search, order string) ([]byte, error) {
+func (l *RemoteProvider) GetPerformanceProfiles(tokenString string, page, pageSize, search, order, owner string) ([]byte, error) {
 	if !l.Capabilities.IsSupported(PersistPerformanceProfiles) {
 		logrus.Error("operation not available")
 		return []byte{}, ErrInvalidCapability("PersistPerformanceProfiles", l.ProviderName)
@@ -2101,6 +2178,9 @@ func (l *RemoteProvider) GetPerformanceProfiles(tokenString string, page, pageSi
 	if order != "" {
 		q.Set("order", order)
 	}
+	if owner != "" {
+		q.Set("owner", owner)
+	}
 	remoteProviderURL.RawQuery = q.Encode()
 	logrus.Debugf("constructed performance profiles url: %s", remoteProviderURL.String())
 	cReq, _ := http.NewRequest(http.MethodGet, remoteProviderURL.String(), nil)
@@ -2614,6 +2694,123 @@ func (l *RemoteProvider) SMPTestConfigDelete(req *http.Request, testUUID string)
 	return ErrDelete(fmt.Errorf("could not delete the test profile: %d", resp.StatusCode), "Perf Test Config :"+testUUID, resp.StatusCode)
 }
 
+// TransferOwnership transfers the ownership of the designs, performance profiles and results
+// selected by the transfer
+func (l *RemoteProvider) TransferOwnership(tokenString string, transfer *OwnershipTransfer) (*OwnershipTransferResult, error) {
+	if !l.Capabilities.IsSupported(TransferOwnership) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("TransferOwnership", l.ProviderName)
+	}
+	if err := transfer.Validate(); err != nil {
+		return nil, err
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(TransferOwnership)
+
+	data, err := json.Marshal(transfer)
+	if err != nil {
+		return nil, ErrMarshal(err, "ownership transfer")
+	}
+
+	logrus.Infof("attempting to transfer ownership to %s with remote provider", transfer.To)
+	remoteProviderURL, _ := url.Parse(l.RemoteProviderURL + ep)
+	cReq, _ := http.NewRequest(http.MethodPost, remoteProviderURL.String(), bytes.NewBuffer(data))
+
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		return nil, ErrPost(err, "Ownership Transfer", http.StatusInternalServerError)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDataRead(err, "Ownership Transfer")
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, ErrPost(fmt.Errorf("failed to transfer ownership with remote provider: %s", string(bdr)), fmt.Sprint(bdr), resp.StatusCode)
+	}
+
+	result := &OwnershipTransferResult{}
+	if err := json.Unmarshal(bdr, result); err != nil {
+		return nil, ErrUnmarshal(err, "Ownership Transfer")
+	}
+	return result, nil
+}
+
+// GetTrash returns the designs or performance profiles in the trash of the provider, both
+// if kind is empty
+func (l *RemoteProvider) GetTrash(tokenString, kind string) ([]byte, error) {
+	path := ""
+	if kind != "" {
+		path = "?kind=" + url.QueryEscape(kind)
+	}
+	return l.doTrashRequest(tokenString, http.MethodGet, path)
+}
+
+// RestoreFromTrash restores the design or performance profile of the given id from the
+// trash of the provider
+func (l *RemoteProvider) RestoreFromTrash(tokenString, kind, id string) ([]byte, error) {
+	return l.doTrashRequest(tokenString, http.MethodPost, fmt.Sprintf("/%s/%s/restore", url.PathEscape(kind), url.PathEscape(id)))
+}
+
+func (l *RemoteProvider) doTrashRequest(tokenString, method, path string) ([]byte, error) {
+	if !l.Capabilities.IsSupported(PersistTrash) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("PersistTrash", l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(PersistTrash)
+
+	remoteProviderURL := l.RemoteProviderURL + ep + path
+	logrus.Debugf("constructed trash url: %s", remoteProviderURL)
+	cReq, _ := http.NewRequest(method, remoteProviderURL, nil)
+
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		return nil, ErrFetch(err, "Trash", http.StatusInternalServerError)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDataRead(err, "Trash")
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		return bdr, nil
+	}
+	return nil, ErrFetch(fmt.Errorf("failed to send trash request to remote provider: %s", string(bdr)), fmt.Sprint(bdr), resp.StatusCode)
+}
+
+// GetTelemetryReport returns the data sent by Meshery with the preferences of the user, the results
+// and metrics of the performance tests being persisted with the provider under the account of the user
+// rather than sent anonymously
+func (l *RemoteProvider) GetTelemetryReport(user *User, pref *Preference) (*TelemetryReport, error) {
+	return &TelemetryReport{
+		Provider: l.Name(),
+		Categories: []*TelemetryCategory{
+			usageStatisticsCategory(pref),
+			{
+				Name:        TelemetryPerformanceResults,
+				Enabled:     l.Capabilities.IsSupported(PersistPerformanceProfiles),
+				Sender:      "Meshery Server",
+				Destination: l.RemoteProviderURL,
+				Description: "The result of every performance test, persisted with the provider under your account once the test completes.",
+			},
+			{
+				Name:        TelemetryPerformanceMetrics,
+				Enabled:     l.Capabilities.IsSupported(PersistMetrics),
+				Sender:      "Meshery Server",
+				Destination: l.RemoteProviderURL,
+				Description: "The metrics of the service mesh queried from Prometheus, Datadog or New Relic over every performance test, persisted with the provider under your account if a metrics source is configured.",
+			},
+		},
+	}, nil
+}
+
 // RecordMeshSyncData records the mesh sync data
 func (l *RemoteProvider) RecordMeshSyncData(obj model.Object) error {
 	result := l.GenericPersister.Create(&obj)
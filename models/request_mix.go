@@ -0,0 +1,121 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// WeightedRequest is a request of a request mix, sent for its weight out of the total
+// weight of the mix. Its path is resolved against the URL of the test, so paths starting
+// with / replace the path of the URL.
+type WeightedRequest struct {
+	Weight int    `json:"weight" yaml:"weight"`
+	Method string `json:"method,omitempty" yaml:"method,omitempty"`
+	Path   string `json:"path" yaml:"path"`
+	Body   string `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// RequestMix is a weighted set of requests a load test sends in proportion to their weights,
+// such as 70% GET /products, 20% GET /product/{{.Seq}} and 10% POST /cart. The path and
+// body of every request are rendered as body templates.
+type RequestMix []WeightedRequest
+
+// requestMixMethods are the methods the requests of a mix may use
+var requestMixMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+}
+
+// ParseWeightedRequest parses a request of a mix given as <weight>:<method> <path>[ <body>],
+// such as "70:GET /products" or `10:POST /cart {"sku": 1}`
+func ParseWeightedRequest(spec string) (WeightedRequest, error) {
+	parts := strings.SplitN(strings.TrimSpace(spec), ":", 2)
+	if len(parts) != 2 {
+		return WeightedRequest{}, fmt.Errorf("invalid request %q, expected <weight>:<method> <path>[ <body>]", spec)
+	}
+	w, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return WeightedRequest{}, fmt.Errorf("invalid weight of request %q", spec)
+	}
+	fields := strings.SplitN(strings.TrimSpace(parts[1]), " ", 3)
+	if len(fields) < 2 {
+		return WeightedRequest{}, fmt.Errorf("invalid request %q, expected <weight>:<method> <path>[ <body>]", spec)
+	}
+	r := WeightedRequest{
+		Weight: w,
+		Method: strings.ToUpper(fields[0]),
+		Path:   fields[1],
+	}
+	if len(fields) == 3 {
+		r.Body = strings.TrimSpace(fields[2])
+	}
+	return r, RequestMix{r}.Validate()
+}
+
+// Validate returns an error when a request of the mix has no path, a weight below one or an
+// unknown method
+func (m RequestMix) Validate() error {
+	for _, r := range m {
+		if r.Weight < 1 {
+			return fmt.Errorf("weight of %s must be at least 1", r.Path)
+		}
+		if r.Path == "" {
+			return fmt.Errorf("a request of the mix has no path")
+		}
+		if r.Method != "" && !requestMixMethods[strings.ToUpper(r.Method)] {
+			return fmt.Errorf("unknown method %s of %s", r.Method, r.Path)
+		}
+	}
+	return nil
+}
+
+// TotalWeight returns the sum of the weights of the requests of the mix
+func (m RequestMix) TotalWeight() int {
+	total := 0
+	for _, r := range m {
+		total += r.Weight
+	}
+	return total
+}
+
+// Scan implements the sql.Scanner interface, the mix being stored as JSON
+func (m *RequestMix) Scan(src interface{}) error {
+	var b []byte
+	switch t := src.(type) {
+	case nil:
+		*m = nil
+		return nil
+	case []byte:
+		b = t
+	case string:
+		b = []byte(t)
+	default:
+		return fmt.Errorf("scan source was not []byte nor string but %T", src)
+	}
+	if len(b) == 0 {
+		*m = nil
+		return nil
+	}
+	return json.Unmarshal(b, m)
+}
+
+// Value implements the driver.Valuer interface, the mix being stored as JSON
+func (m RequestMix) Value() (driver.Value, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal([]WeightedRequest(m))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/meshes"
+	"github.com/layer5io/meshkit/database"
+)
+
+// ResultAnalysis is the comparison of the p99 latency of a completed performance result with the
+// rolling history of its profile. Every analysis is kept, the analyses of a profile being its history.
+type ResultAnalysis struct {
+	ID *uuid.UUID `json:"id,omitempty"`
+
+	ResultID  string `json:"result_id" gorm:"uniqueIndex"`
+	ProfileID string `json:"profile_id" gorm:"index"`
+	Name      string `json:"name,omitempty"`
+
+	// P99 is the p99 latency of the result, Mean and StdDev those of the results before it, in seconds
+	P99    float64 `json:"p99"`
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"std_dev"`
+	// Samples is the number of results before it the result was compared with
+	Samples int `json:"samples"`
+	// Deviations is the number of standard deviations the p99 latency is away from the mean,
+	// positive when slower, and Threshold the number beyond which the result is an anomaly
+	Deviations float64 `json:"deviations"`
+	Threshold  float64 `json:"threshold"`
+	Anomaly    bool    `json:"anomaly" gorm:"index"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// ResultAnomalyDetectorInterface defines the methods a type should implement to detect
+// performance results deviating from the history of their profile
+type ResultAnomalyDetectorInterface interface {
+	// Analyze compares the result with the history of its profile and records it in the history
+	Analyze(*ResultPoint) (*ResultAnalysis, error)
+	Subscribe() (<-chan *meshes.EventsResponse, func())
+}
+
+// ResultAnalysisPersister is the persister for persisting
+// the analyses of performance results on the database
+type ResultAnalysisPersister struct {
+	DB *database.Handler
+}
+
+// CreateResultAnalysis persists the given analysis
+func (rap *ResultAnalysisPersister) CreateResultAnalysis(analysis *ResultAnalysis) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ErrGenerateUUID(err)
+	}
+	analysis.ID = &id
+	return rap.DB.Create(analysis).Error
+}
+
+// GetProfileHistory returns the analyses of the last results of the profile, at most limit, the latest first
+func (rap *ResultAnalysisPersister) GetProfileHistory(profileID string, limit int) ([]ResultAnalysis, error) {
+	analyses := []ResultAnalysis{}
+	if err := rap.DB.Where("profile_id = ?", profileID).Order("created_at desc").Limit(limit).Find(&analyses).Error; err != nil {
+		return nil, err
+	}
+	return analyses, nil
+}
+
+// GetAnomalies returns the analyses of the results found to be anomalies, of the given profile
+// or of every profile when none is given, the latest first
+func (rap *ResultAnalysisPersister) GetAnomalies(profileID string) ([]ResultAnalysis, error) {
+	query := rap.DB.Where("anomaly = ?", true)
+	if profileID != "" {
+		query = query.Where("profile_id = ?", profileID)
+	}
+	analyses := []ResultAnalysis{}
+	if err := query.Order("created_at desc").Find(&analyses).Error; err != nil {
+		return nil, err
+	}
+	return analyses, nil
+}
+
+// GetAnalysesBetween returns the analyses of the results completed in the given period, the latest first
+func (rap *ResultAnalysisPersister) GetAnalysesBetween(from, to time.Time) ([]ResultAnalysis, error) {
+	analyses := []ResultAnalysis{}
+	if err := rap.DB.Where("created_at >= ? AND created_at < ?", from, to).Order("created_at desc").Find(&analyses).Error; err != nil {
+		return nil, err
+	}
+	return analyses, nil
+}
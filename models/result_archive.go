@@ -0,0 +1,47 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ArchivedResultKey is the key of the runner results of a result moved to a result
+// archive, telling where the complete runner results were moved to
+const ArchivedResultKey = "archived"
+
+// ResultArchive defines the methods a type should implement to store the performance
+// results moved out of the database, e.g. an object storage bucket
+type ResultArchive interface {
+	Name() string
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// ArchivedResult tells where the runner results of an archived result were moved to
+type ArchivedResult struct {
+	Archive    string    `json:"archive"`
+	Key        string    `json:"key"`
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// ArchivedResultOf returns where the runner results of the result were moved to,
+// or false when the result was not archived
+func ArchivedResultOf(result *MesheryResult) (*ArchivedResult, bool) {
+	if result == nil || result.Result == nil {
+		return nil, false
+	}
+	raw, ok := result.Result[ArchivedResultKey]
+	if !ok || raw == nil {
+		return nil, false
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, false
+	}
+	archived := &ArchivedResult{}
+	if err := json.Unmarshal(b, archived); err != nil || archived.Key == "" {
+		return nil, false
+	}
+	return archived, true
+}
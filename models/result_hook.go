@@ -0,0 +1,216 @@
+package models
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm/clause"
+)
+
+// WebhookSignatureHeader is the header of the requests to result hooks and validation webhooks holding
+// "sha256=" and the hex HMAC-SHA256 of their body with the secret of the webhook, when it has one
+const WebhookSignatureHeader = "X-Meshery-Signature"
+
+// ResultHook is a webhook invoked after every performance result of its owner is persisted,
+// receiving the SMP document of the result and answering with annotations attached to the
+// result, such as custom scores or business KPIs
+type ResultHook struct {
+	ID   *uuid.UUID `json:"id,omitempty"`
+	Name string     `json:"name" gorm:"uniqueIndex:idx_result_hook_name"`
+	URL  string     `json:"url"`
+	// Secret signs the requests to the hook, it is never returned
+	Secret string `json:"secret,omitempty"`
+	// Disabled hooks are only invoked when run explicitly
+	Disabled bool   `json:"disabled"`
+	UserID   string `json:"user_id,omitempty" gorm:"uniqueIndex:idx_result_hook_name"`
+
+	// LastInvokedAt is when the hook was last invoked and LastError why it then failed, if it did
+	LastInvokedAt *time.Time `json:"last_invoked_at,omitempty"`
+	LastError     string     `json:"last_error,omitempty"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// Validate checks the name and the URL of the hook
+func (h *ResultHook) Validate() error {
+	if h.Name == "" {
+		return fmt.Errorf("the hook has no name")
+	}
+	u, err := url.Parse(h.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %q, expected an http(s) URL", h.URL)
+	}
+	return nil
+}
+
+// ResultHookEvent is what result hooks receive once a result is persisted
+type ResultHookEvent struct {
+	ResultID  string `json:"result_id"`
+	ProfileID string `json:"profile_id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Mesh      string `json:"mesh,omitempty"`
+	// SMP is the result as an SMP document, which the results of other than HTTP tests have none of
+	SMP json.RawMessage `json:"smp,omitempty"`
+}
+
+// ResultHookResponse is what result hooks answer with, the annotations attached to the result
+type ResultHookResponse struct {
+	Annotations ResultAnnotations `json:"annotations"`
+}
+
+// ResultAnnotations are the values a result hook computed for a result
+type ResultAnnotations map[string]interface{}
+
+// Scan implements the sql.Scanner interface, the annotations being stored as JSON
+func (a *ResultAnnotations) Scan(src interface{}) error {
+	var b []byte
+	switch t := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case []byte:
+		b = t
+	case string:
+		b = []byte(t)
+	default:
+		return fmt.Errorf("scan source was not []byte nor string but %T", src)
+	}
+	if len(b) == 0 {
+		*a = nil
+		return nil
+	}
+	return json.Unmarshal(b, a)
+}
+
+// Value implements the driver.Valuer interface, the annotations being stored as JSON
+func (a ResultAnnotations) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(map[string]interface{}(a))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// ResultAnnotation holds the annotations a hook attached to a result, stored apart from the
+// result so that the results of every provider can be annotated
+type ResultAnnotation struct {
+	ResultID    string            `json:"result_id" gorm:"primaryKey"`
+	Hook        string            `json:"hook" gorm:"primaryKey"`
+	Annotations ResultAnnotations `json:"annotations" gorm:"type:text"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// ResultPostProcessor defines the methods a type should implement to post-process the performance
+// results once they are persisted, e.g. a webhook
+type ResultPostProcessor interface {
+	Name() string
+	Process(ctx context.Context, event *ResultHookEvent) (ResultAnnotations, error)
+}
+
+// ResultHookPersister is the persister for persisting
+// the result hooks and the annotations they attached on the database
+type ResultHookPersister struct {
+	DB *database.Handler
+}
+
+// SaveHook persists the given hook, replacing the hook of the user with the same id or else the same
+// name, whose secret is kept when none is given
+func (rhp *ResultHookPersister) SaveHook(hook *ResultHook) error {
+	existing := ResultHook{}
+	query := rhp.DB.Where("user_id = ? AND name = ?", hook.UserID, hook.Name)
+	if hook.ID != nil {
+		query = rhp.DB.Where("user_id = ? AND id = ?", hook.UserID, hook.ID)
+	}
+	if err := query.First(&existing).Error; err == nil {
+		hook.ID = existing.ID
+		hook.CreatedAt = existing.CreatedAt
+		hook.LastInvokedAt = existing.LastInvokedAt
+		hook.LastError = existing.LastError
+		if hook.Secret == "" {
+			hook.Secret = existing.Secret
+		}
+	} else {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		hook.ID = &id
+	}
+	return rhp.DB.Save(hook).Error
+}
+
+// GetHook returns the hook of the user with the given id
+func (rhp *ResultHookPersister) GetHook(userID string, id uuid.UUID) (*ResultHook, error) {
+	hook := &ResultHook{}
+	if err := rhp.DB.Where("user_id = ? AND id = ?", userID, id).First(hook).Error; err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// GetHooks returns the hooks of the user, only those enabled when told so
+func (rhp *ResultHookPersister) GetHooks(userID string, enabledOnly bool) ([]ResultHook, error) {
+	hooks := []ResultHook{}
+	query := rhp.DB.Where("user_id = ?", userID)
+	if enabledOnly {
+		query = query.Where("disabled = ?", false)
+	}
+	if err := query.Order("name").Find(&hooks).Error; err != nil {
+		return nil, err
+	}
+	return hooks, nil
+}
+
+// DeleteHook deletes the hook of the user with the given id
+func (rhp *ResultHookPersister) DeleteHook(userID string, id uuid.UUID) error {
+	res := rhp.DB.Where("user_id = ? AND id = ?", userID, id).Delete(&ResultHook{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("no result hook with id %s", id)
+	}
+	return nil
+}
+
+// RecordInvocation records when the hook was invoked and the error it then failed with, if any
+func (rhp *ResultHookPersister) RecordInvocation(id uuid.UUID, at time.Time, err error) error {
+	lastError := ""
+	if err != nil {
+		lastError = err.Error()
+	}
+	return rhp.DB.Model(&ResultHook{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"last_invoked_at": at, "last_error": lastError}).Error
+}
+
+// SaveAnnotations creates or replaces the annotations the hook attached to the result
+func (rhp *ResultHookPersister) SaveAnnotations(annotation *ResultAnnotation) error {
+	annotation.UpdatedAt = time.Now()
+	return rhp.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "result_id"}, {Name: "hook"}},
+		DoUpdates: clause.AssignmentColumns([]string{"annotations", "updated_at"}),
+	}).Create(annotation).Error
+}
+
+// GetAnnotations returns the annotations attached to the result, by hook
+func (rhp *ResultHookPersister) GetAnnotations(resultID string) (map[string]ResultAnnotations, error) {
+	annotations := []ResultAnnotation{}
+	if err := rhp.DB.Where("result_id = ?", resultID).Find(&annotations).Error; err != nil {
+		return nil, err
+	}
+	byHook := make(map[string]ResultAnnotations, len(annotations))
+	for _, a := range annotations {
+		byHook[a.Hook] = a.Annotations
+	}
+	return byHook, nil
+}
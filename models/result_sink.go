@@ -0,0 +1,61 @@
+package models
+
+import (
+	"context"
+	"time"
+)
+
+// ResultPoint is the flattened form of a completed performance result
+// which is written to the time-series result sinks
+type ResultPoint struct {
+	ResultID      string
+	ProfileID     string
+	Name          string
+	Mesh          string
+	LoadGenerator string
+
+	StartTime time.Time
+	Duration  time.Duration
+	QPS       float64
+	Requests  int64
+	// Errors are the requests answered with other than a 2xx status
+	Errors int64
+
+	// Latencies are in seconds
+	Min     float64
+	Max     float64
+	Average float64
+	P50     float64
+	P90     float64
+	P95     float64
+	P99     float64
+}
+
+// ResultSink defines the methods a type should implement to receive every
+// completed performance result, e.g. a time-series database
+type ResultSink interface {
+	Name() string
+	Write(context.Context, *ResultPoint) error
+}
+
+// ProfileMetrics are the rolling aggregates of the results of a performance profile, telling
+// how healthy the profile is without going through its results
+type ProfileMetrics struct {
+	ProfileID string
+	Name      string
+
+	// Results is the number of results the aggregates are computed from, and Latest the most
+	// recent of them, nil when the profile has none
+	Results int
+	Latest  *ResultPoint
+
+	// WindowMedianP99 is the median p99 latency of the WindowResults results started within the
+	// last 7 days, in seconds
+	WindowMedianP99 float64
+	WindowResults   int
+
+	// P99Trend is the slope of the least-squares fit of the p99 latency of the results over their
+	// start time, in seconds per day, only set when HasTrend as it takes two results started apart
+	P99Trend float64
+	HasTrend bool
+}
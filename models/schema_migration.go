@@ -0,0 +1,256 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm"
+)
+
+// SchemaMigration records a migration applied to the database of Meshery Server
+type SchemaMigration struct {
+	Version     int       `json:"version" gorm:"primaryKey;autoIncrement:false"`
+	Description string    `json:"description"`
+	AppliedAt   time.Time `json:"applied_at"`
+}
+
+// Migration is a versioned change of the schema of the database. Down reverses Up, it is nil
+// when the migration cannot be rolled back.
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(tx *gorm.DB) error
+	Down        func(tx *gorm.DB) error
+}
+
+// MigrationStatus tells whether a migration is applied to the database
+type MigrationStatus struct {
+	Version     int        `json:"version"`
+	Description string     `json:"description"`
+	Applied     bool       `json:"applied"`
+	AppliedAt   *time.Time `json:"applied_at,omitempty"`
+	Reversible  bool       `json:"reversible"`
+	// Unknown is set for a migration applied by a later version of Meshery Server
+	Unknown bool `json:"unknown,omitempty"`
+}
+
+// SchemaStatus is the version of the schema of the database against the migrations of Meshery Server
+type SchemaStatus struct {
+	// Version is the version of the last migration applied, 0 when none is
+	Version int `json:"version"`
+	// LatestVersion is the version of the last migration of Meshery Server
+	LatestVersion int               `json:"latest_version"`
+	Pending       int               `json:"pending"`
+	Migrations    []MigrationStatus `json:"migrations"`
+}
+
+// SchemaMigrationResult is the outcome of running migrations: those migrated or rolled back, in the
+// order they were run, and the status of the schema afterwards
+type SchemaMigrationResult struct {
+	Migrations []MigrationStatus `json:"migrations"`
+	Status     SchemaStatus      `json:"status"`
+}
+
+// SchemaMigratorInterface defines the methods a type should implement to migrate the schema of the database
+type SchemaMigratorInterface interface {
+	// Status returns the version of the schema and the migrations applied and pending
+	Status() (*SchemaStatus, error)
+	// Migrate applies the pending migrations up to the given version, every one of them when it is 0
+	Migrate(to int) ([]MigrationStatus, error)
+	// Rollback reverses the applied migrations above the given version, the last one only when it is
+	// negative
+	Rollback(to int) ([]MigrationStatus, error)
+}
+
+// SchemaMigrator applies and rolls back the migrations of the schema of the database, recording the
+// migrations applied in the schema_migrations table. Every migration runs in a transaction of its own.
+type SchemaMigrator struct {
+	db         *database.Handler
+	migrations []Migration
+	lock       *sync.Mutex
+}
+
+// NewSchemaMigrator returns an instance of SchemaMigrator running the given migrations in the order
+// of their versions
+func NewSchemaMigrator(db *database.Handler, migrations []Migration) *SchemaMigrator {
+	sorted := append([]Migration{}, migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	return &SchemaMigrator{
+		db:         db,
+		migrations: sorted,
+		lock:       &sync.Mutex{},
+	}
+}
+
+// Status returns the version of the schema and the migrations applied and pending
+func (m *SchemaMigrator) Status() (*SchemaStatus, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.status()
+}
+
+func (m *SchemaMigrator) status() (*SchemaStatus, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, ErrSchemaMigration(err)
+	}
+
+	status := &SchemaStatus{Migrations: []MigrationStatus{}}
+	known := map[int]bool{}
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+		s := MigrationStatus{Version: migration.Version, Description: migration.Description, Reversible: migration.Down != nil}
+		if record, ok := applied[migration.Version]; ok {
+			appliedAt := record.AppliedAt
+			s.Applied, s.AppliedAt = true, &appliedAt
+		} else {
+			status.Pending++
+		}
+		status.Migrations = append(status.Migrations, s)
+		status.LatestVersion = migration.Version
+	}
+	for version, record := range applied {
+		if !known[version] {
+			appliedAt := record.AppliedAt
+			status.Migrations = append(status.Migrations, MigrationStatus{Version: version, Description: record.Description, Applied: true, AppliedAt: &appliedAt, Unknown: true})
+		}
+		if version > status.Version {
+			status.Version = version
+		}
+	}
+	sort.Slice(status.Migrations, func(i, j int) bool { return status.Migrations[i].Version < status.Migrations[j].Version })
+	return status, nil
+}
+
+// Migrate applies the pending migrations up to the given version, every one of them when it is 0,
+// and returns those applied
+func (m *SchemaMigrator) Migrate(to int) ([]MigrationStatus, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	status, err := m.status()
+	if err != nil {
+		return nil, err
+	}
+	if to <= 0 {
+		to = status.LatestVersion
+	}
+	if to < status.Version {
+		return nil, ErrInvalidSchemaVersion(fmt.Errorf("the schema is at version %d, roll back to migrate it to version %d", status.Version, to))
+	}
+	if to > status.LatestVersion {
+		return nil, ErrInvalidSchemaVersion(fmt.Errorf("no migration of version %d, the latest being %d", to, status.LatestVersion))
+	}
+	if err := m.db.AutoMigrate(&SchemaMigration{}); err != nil {
+		return nil, ErrSchemaMigration(err)
+	}
+	applied, err := m.applied()
+	if err != nil {
+		return nil, ErrSchemaMigration(err)
+	}
+
+	migrated := []MigrationStatus{}
+	for _, migration := range m.migrations {
+		if migration.Version > to {
+			break
+		}
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		migration := migration
+		record := &SchemaMigration{Version: migration.Version, Description: migration.Description, AppliedAt: time.Now().UTC()}
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := migration.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(record).Error
+		})
+		if err != nil {
+			return migrated, ErrSchemaMigration(fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Description, err))
+		}
+		migrated = append(migrated, MigrationStatus{Version: migration.Version, Description: migration.Description, Applied: true, AppliedAt: &record.AppliedAt, Reversible: migration.Down != nil})
+	}
+	return migrated, nil
+}
+
+// Rollback reverses the applied migrations above the given version, the last one only when it is
+// negative, and returns those rolled back. Nothing is rolled back when one of them is irreversible.
+func (m *SchemaMigrator) Rollback(to int) ([]MigrationStatus, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	status, err := m.status()
+	if err != nil {
+		return nil, err
+	}
+	applied := []MigrationStatus{}
+	for _, s := range status.Migrations {
+		if s.Applied {
+			applied = append(applied, s)
+		}
+	}
+	if len(applied) == 0 {
+		return nil, ErrInvalidSchemaVersion(fmt.Errorf("no migration is applied"))
+	}
+	if to < 0 {
+		to = 0
+		if len(applied) > 1 {
+			to = applied[len(applied)-2].Version
+		}
+	}
+	if to >= status.Version {
+		return nil, ErrInvalidSchemaVersion(fmt.Errorf("the schema is at version %d, migrate to bring it to version %d", status.Version, to))
+	}
+
+	byVersion := map[int]Migration{}
+	for _, migration := range m.migrations {
+		byVersion[migration.Version] = migration
+	}
+	reversed := []Migration{}
+	for i := len(applied) - 1; i >= 0 && applied[i].Version > to; i-- {
+		migration, ok := byVersion[applied[i].Version]
+		if !ok {
+			return nil, ErrInvalidSchemaVersion(fmt.Errorf("migration %d (%s) was applied by a later version of Meshery Server, which is required to roll it back", applied[i].Version, applied[i].Description))
+		}
+		if migration.Down == nil {
+			return nil, ErrInvalidSchemaVersion(fmt.Errorf("migration %d (%s) cannot be rolled back", migration.Version, migration.Description))
+		}
+		reversed = append(reversed, migration)
+	}
+
+	rolledBack := []MigrationStatus{}
+	for _, migration := range reversed {
+		migration := migration
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := migration.Down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaMigration{}, migration.Version).Error
+		})
+		if err != nil {
+			return rolledBack, ErrSchemaMigration(fmt.Errorf("rollback of migration %d (%s) failed: %w", migration.Version, migration.Description, err))
+		}
+		rolledBack = append(rolledBack, MigrationStatus{Version: migration.Version, Description: migration.Description, Reversible: true})
+	}
+	return rolledBack, nil
+}
+
+// applied returns the migrations applied to the database by version, none before the table
+// recording them is created by the first migration run
+func (m *SchemaMigrator) applied() (map[int]SchemaMigration, error) {
+	if !m.db.Migrator().HasTable(&SchemaMigration{}) {
+		return map[int]SchemaMigration{}, nil
+	}
+	records := []SchemaMigration{}
+	if err := m.db.Find(&records).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[int]SchemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
@@ -0,0 +1,353 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/layer5io/meshkit/database"
+	meshkiterrors "github.com/layer5io/meshkit/errors"
+	meshsyncmodel "github.com/layer5io/meshsync/pkg/model"
+	"gorm.io/gorm"
+)
+
+func newTestDB(t *testing.T, name string) *database.Handler {
+	t.Helper()
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s?cache=private&mode=rwc", filepath.Join(t.TempDir(), name)),
+		Engine:   database.SQLITE,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.DBClose() })
+	return &db
+}
+
+type testWidget struct {
+	ID   string `gorm:"primaryKey"`
+	Name string
+}
+
+type testWidgetV2 struct {
+	ID    string `gorm:"primaryKey"`
+	Name  string
+	Color string
+}
+
+func (testWidgetV2) TableName() string { return "test_widgets" }
+
+var testMigrations = []Migration{
+	{
+		Version:     2,
+		Description: "Add the color of the widgets",
+		Up: func(tx *gorm.DB) error {
+			return tx.Migrator().AddColumn(&testWidgetV2{}, "Color")
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&testWidgetV2{}, "Color")
+		},
+	},
+	{
+		Version:     1,
+		Description: "Create the widgets",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&testWidget{})
+		},
+	},
+	{
+		Version:     3,
+		Description: "Index the widgets by name",
+		Up: func(tx *gorm.DB) error {
+			return tx.Exec("CREATE INDEX idx_test_widgets_name ON test_widgets (name)").Error
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Exec("DROP INDEX idx_test_widgets_name").Error
+		},
+	},
+}
+
+func migrationVersions(migrations []MigrationStatus) []int {
+	versions := []int{}
+	for _, migration := range migrations {
+		versions = append(versions, migration.Version)
+	}
+	return versions
+}
+
+func TestSchemaMigratorStatus(t *testing.T) {
+	db := newTestDB(t, "status.db")
+	m := NewSchemaMigrator(db, testMigrations)
+
+	status, err := m.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Version != 0 || status.LatestVersion != 3 || status.Pending != 3 {
+		t.Errorf("Status() = version %d, latest %d, pending %d, want 0, 3, 3", status.Version, status.LatestVersion, status.Pending)
+	}
+	if got := migrationVersions(status.Migrations); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Status() migrations = %v, want [1 2 3]", got)
+	}
+	if status.Migrations[0].Reversible || !status.Migrations[1].Reversible {
+		t.Errorf("Status() reversible = %t, %t, want false, true", status.Migrations[0].Reversible, status.Migrations[1].Reversible)
+	}
+	if db.Migrator().HasTable(&SchemaMigration{}) {
+		t.Error("Status() created the schema_migrations table")
+	}
+
+	if _, err := m.Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+	// A migration applied by a later version of Meshery Server
+	if err := db.Create(&SchemaMigration{Version: 4, Description: "Drop the widgets"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	status, err = m.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Version != 4 || status.Pending != 0 {
+		t.Errorf("Status() = version %d, pending %d, want 4, 0", status.Version, status.Pending)
+	}
+	if last := status.Migrations[len(status.Migrations)-1]; last.Version != 4 || !last.Unknown || !last.Applied {
+		t.Errorf("Status() last migration = %+v, want the unknown migration 4", last)
+	}
+}
+
+func TestSchemaMigratorMigrate(t *testing.T) {
+	db := newTestDB(t, "migrate.db")
+	m := NewSchemaMigrator(db, testMigrations)
+
+	migrated, err := m.Migrate(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := migrationVersions(migrated); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("Migrate(1) = %v, want [1]", got)
+	}
+	if db.Migrator().HasColumn(&testWidgetV2{}, "Color") {
+		t.Error("Migrate(1) applied migration 2")
+	}
+
+	migrated, err = m.Migrate(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := migrationVersions(migrated); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("Migrate(0) = %v, want [2 3]", got)
+	}
+	if !db.Migrator().HasColumn(&testWidgetV2{}, "Color") || !db.Migrator().HasIndex(&testWidgetV2{}, "idx_test_widgets_name") {
+		t.Error("Migrate(0) did not apply migrations 2 and 3")
+	}
+
+	if migrated, err = m.Migrate(0); err != nil || len(migrated) != 0 {
+		t.Errorf("Migrate(0) of a migrated schema = %v, %v, want none", migrated, err)
+	}
+	if _, err := m.Migrate(2); !isSchemaVersionError(err) {
+		t.Errorf("Migrate(2) below the version of the schema error = %v, want %s", err, ErrInvalidSchemaVersionCode)
+	}
+	if _, err := m.Migrate(5); !isSchemaVersionError(err) {
+		t.Errorf("Migrate(5) above the latest migration error = %v, want %s", err, ErrInvalidSchemaVersionCode)
+	}
+}
+
+func TestSchemaMigratorMigrateFailure(t *testing.T) {
+	db := newTestDB(t, "failure.db")
+	migrations := append([]Migration{}, testMigrations[1], Migration{
+		Version:     2,
+		Description: "Fail halfway",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&testWidgetV2{}, "Color"); err != nil {
+				return err
+			}
+			return errors.New("halfway")
+		},
+	})
+	m := NewSchemaMigrator(db, migrations)
+
+	migrated, err := m.Migrate(0)
+	if err == nil {
+		t.Fatal("Migrate(0) of a failing migration succeeded")
+	}
+	if got := migrationVersions(migrated); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("Migrate(0) = %v, want [1]", got)
+	}
+	if db.Migrator().HasColumn(&testWidgetV2{}, "Color") {
+		t.Error("the failed migration was not rolled back")
+	}
+	if status, err := m.Status(); err != nil || status.Version != 1 {
+		t.Errorf("Status() after the failed migration = %+v, %v, want version 1", status, err)
+	}
+}
+
+func TestSchemaMigratorRollback(t *testing.T) {
+	db := newTestDB(t, "rollback.db")
+	m := NewSchemaMigrator(db, testMigrations)
+
+	if _, err := m.Rollback(-1); !isSchemaVersionError(err) {
+		t.Errorf("Rollback(-1) with no migration applied error = %v, want %s", err, ErrInvalidSchemaVersionCode)
+	}
+	if _, err := m.Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+
+	rolledBack, err := m.Rollback(-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := migrationVersions(rolledBack); !reflect.DeepEqual(got, []int{3}) {
+		t.Errorf("Rollback(-1) = %v, want [3]", got)
+	}
+	if db.Migrator().HasIndex(&testWidgetV2{}, "idx_test_widgets_name") {
+		t.Error("Rollback(-1) did not drop the index of migration 3")
+	}
+
+	if _, err := m.Rollback(0); !isSchemaVersionError(err) {
+		t.Errorf("Rollback(0) through the irreversible migration 1 error = %v, want %s", err, ErrInvalidSchemaVersionCode)
+	}
+	if status, err := m.Status(); err != nil || status.Version != 2 {
+		t.Errorf("Status() after the refused rollback = %+v, %v, want version 2", status, err)
+	}
+
+	if _, err := m.Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+	rolledBack, err = m.Rollback(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := migrationVersions(rolledBack); !reflect.DeepEqual(got, []int{3, 2}) {
+		t.Errorf("Rollback(1) = %v, want [3 2]", got)
+	}
+	if db.Migrator().HasColumn(&testWidgetV2{}, "Color") {
+		t.Error("Rollback(1) did not drop the column of migration 2")
+	}
+	if _, err := m.Rollback(2); !isSchemaVersionError(err) {
+		t.Errorf("Rollback(2) above the version of the schema error = %v, want %s", err, ErrInvalidSchemaVersionCode)
+	}
+}
+
+func isSchemaVersionError(err error) bool {
+	return err != nil && meshkiterrors.GetCode(err) == ErrInvalidSchemaVersionCode
+}
+
+// TestSchemaMigrationsMatchModels checks that the migrations bring the schema to that of the models of
+// Meshery Server, a change of the models requiring a migration
+func TestSchemaMigrationsMatchModels(t *testing.T) {
+	migrated := newTestDB(t, "migrated.db")
+	if _, err := NewSchemaMigrator(migrated, SchemaMigrations).Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+
+	models := newTestDB(t, "models.db")
+	if err := models.AutoMigrate(
+		&meshsyncmodel.KeyValue{},
+		&meshsyncmodel.Object{},
+		&meshsyncmodel.ResourceSpec{},
+		&meshsyncmodel.ResourceStatus{},
+		&meshsyncmodel.ResourceObjectMeta{},
+		&PerformanceProfile{},
+		&MesheryResult{},
+		&MesheryPattern{},
+		&PatternShare{},
+		&PatternReview{},
+		&DeploymentEnvironment{},
+		&PatternDeployment{},
+		&WorkloadIdentity{},
+		&PerfTarget{},
+		&ResultAnalysis{},
+		&ResultHook{},
+		&ResultAnnotation{},
+		&ValidationWebhook{},
+		&DashboardSnapshot{},
+		&TrendReport{},
+		&LoadTestCheckpoint{},
+		&Event{},
+		&EventStatus{},
+		&DigestSubscription{},
+		&DesignSchedule{},
+		&FeatureFlag{},
+		&MesheryFilter{},
+		&PatternResource{},
+		&MesheryApplication{},
+		&UserPreference{},
+		&PerformanceTestConfig{},
+		&SmiResultWithID{},
+		K8sContext{},
+		&AdapterRegistration{},
+		&WorkspaceLoadTestLimits{},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	want := sqliteSchema(t, models)
+	got := sqliteSchema(t, migrated)
+	delete(got, "schema_migrations")
+	for table, columns := range want {
+		if !reflect.DeepEqual(got[table], columns) {
+			t.Errorf("table %s migrated to\n%v\nwant\n%v", table, got[table], columns)
+		}
+	}
+	for table := range got {
+		if _, ok := want[table]; !ok {
+			t.Errorf("table %s is not of a model", table)
+		}
+	}
+}
+
+// TestSchemaMigrationsRollback checks that the migrations rolled back down to the first one can be applied again
+func TestSchemaMigrationsRollback(t *testing.T) {
+	db := newTestDB(t, "rollback.db")
+	m := NewSchemaMigrator(db, SchemaMigrations)
+	if _, err := m.Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+	migrated := sqliteSchema(t, db)
+
+	if _, err := m.Rollback(1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+	if got := sqliteSchema(t, db); !reflect.DeepEqual(got, migrated) {
+		t.Errorf("schema migrated again to\n%v\nwant\n%v", got, migrated)
+	}
+}
+
+// sqliteSchema returns the columns and indexes of every table of the database
+func sqliteSchema(t *testing.T, db *database.Handler) map[string][]string {
+	t.Helper()
+	tables := []string{}
+	if err := db.Raw("SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'").Scan(&tables).Error; err != nil {
+		t.Fatal(err)
+	}
+	schema := map[string][]string{}
+	for _, table := range tables {
+		columns := []struct {
+			Name    string
+			Type    string
+			NotNull bool `gorm:"column:notnull"`
+			Pk      int
+		}{}
+		if err := db.Raw("SELECT name, type, \"notnull\", pk FROM pragma_table_info(?)", table).Scan(&columns).Error; err != nil {
+			t.Fatal(err)
+		}
+		for _, column := range columns {
+			schema[table] = append(schema[table], fmt.Sprintf("%s %s notnull=%t pk=%d", column.Name, column.Type, column.NotNull, column.Pk))
+		}
+		indexes := []string{}
+		if err := db.Raw("SELECT name FROM sqlite_master WHERE type = 'index' AND tbl_name = ? AND name NOT LIKE 'sqlite_%'", table).Scan(&indexes).Error; err != nil {
+			t.Fatal(err)
+		}
+		for _, index := range indexes {
+			schema[table] = append(schema[table], "index "+index)
+		}
+		sort.Strings(schema[table])
+	}
+	return schema
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SchemaMigrations are the migrations of the schema of the database of Meshery Server. A change of
+// the schema, such as a new model or column, is appended as a migration of the next version, with
+// its Down reversing it whenever possible; the migrations released are never changed. They migrate
+// frozen copies of the models, such as those of schema_migrations_v1.go, rather than the models
+// themselves, whose changes would otherwise change the migrations already applied.
+var SchemaMigrations = []Migration{
+	{
+		Version:     1,
+		Description: "Create the tables of Meshery Server",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(
+				&v1KeyValue{},
+				&v1Object{},
+				&v1ResourceSpec{},
+				&v1ResourceStatus{},
+				&v1ResourceObjectMeta{},
+				&v1PerformanceProfile{},
+				&v1MesheryResult{},
+				&v1MesheryPattern{},
+				&v1PatternShare{},
+				&v1PatternReview{},
+				&v1DeploymentEnvironment{},
+				&v1PatternDeployment{},
+				&v1WorkloadIdentity{},
+				&v1PerfTarget{},
+				&v1ResultAnalysis{},
+				&v1ResultHook{},
+				&v1ResultAnnotation{},
+				&v1ValidationWebhook{},
+				&v1DashboardSnapshot{},
+				&v1TrendReport{},
+				&v1LoadTestCheckpoint{},
+				&v1Event{},
+				&v1EventStatus{},
+				&v1DigestSubscription{},
+				&v1DesignSchedule{},
+				&v1FeatureFlag{},
+				&v1MesheryFilter{},
+				&v1PatternResource{},
+				&v1MesheryApplication{},
+				&v1UserPreference{},
+				&v1PerformanceTestConfig{},
+				&v1SmiResultWithID{},
+				&v1K8sContext{},
+			)
+		},
+	},
+	{
+		Version:     2,
+		Description: "Index the results by performance profile and test start time",
+		Up: func(tx *gorm.DB) error {
+			return tx.Exec("CREATE INDEX IF NOT EXISTS idx_meshery_results_profile_start ON meshery_results (performance_profile, test_start_time)").Error
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Exec("DROP INDEX IF EXISTS idx_meshery_results_profile_start").Error
+		},
+	},
+	{
+		Version:     3,
+		Description: "Record the revision of the design a review approved",
+		Up: func(tx *gorm.DB) error {
+			return tx.Migrator().AddColumn(&v3PatternReview{}, "PatternSHA")
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&v3PatternReview{}, "PatternSHA")
+		},
+	},
+	{
+		Version:     4,
+		Description: "Persist the adapters registered at runtime",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v4AdapterRegistration{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&v4AdapterRegistration{})
+		},
+	},
+	{
+		Version:     5,
+		Description: "Persist the load test limits set for the workspaces",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v5WorkspaceLoadTestLimits{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&v5WorkspaceLoadTestLimits{})
+		},
+	},
+}
+
+// v3PatternReview is the column of the reviews of patterns added by the migration of version 3
+type v3PatternReview struct {
+	PatternSHA string
+}
+
+func (v3PatternReview) TableName() string { return "pattern_reviews" }
+
+// v4AdapterRegistration is the table of the adapters registered at runtime created by the migration of version 4
+type v4AdapterRegistration struct {
+	Name      string
+	Address   string `gorm:"primaryKey"`
+	TLSCA     string
+	UpdatedAt *time.Time
+}
+
+func (v4AdapterRegistration) TableName() string { return "adapter_registrations" }
+
+// v5WorkspaceLoadTestLimits is the table of the load test limits of the workspaces created by the migration of version 5
+type v5WorkspaceLoadTestLimits struct {
+	Workspace      string `gorm:"primaryKey"`
+	MaxQPS         float64
+	MaxDuration    time.Duration
+	MaxConnections int
+	UpdatedBy      string
+	UpdatedAt      *time.Time
+}
+
+func (v5WorkspaceLoadTestLimits) TableName() string { return "workspace_load_test_limits" }
@@ -0,0 +1,464 @@
+package models
+
+import "time"
+
+// The models of the schema of version 1, as created by the first migration. They are frozen: the
+// migration creating the schema keeps creating the same tables whatever the changes of the models
+// of Meshery Server, which are applied to the schema by the migrations of the later versions.
+
+type v1KeyValue struct {
+	ID       string `gorm:"index"`
+	UniqueID string `gorm:"primarykey"`
+	Kind     string `gorm:"index"`
+	Key      string `gorm:"index"`
+	Value    string `gorm:"index"`
+}
+
+func (v1KeyValue) TableName() string { return "key_values" }
+
+type v1Object struct {
+	ID              string                `gorm:"primarykey"`
+	APIVersion      string                `gorm:"index"`
+	Kind            string                `gorm:"index"`
+	ObjectMeta      *v1ResourceObjectMeta `gorm:"foreignkey:ID;references:id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Spec            *v1ResourceSpec       `gorm:"foreignkey:ID;references:id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Status          *v1ResourceStatus     `gorm:"foreignkey:ID;references:id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	ClusterID       string
+	PatternResource *string
+	Immutable       string
+	Data            string
+	BinaryData      string
+	StringData      string
+	Type            string
+}
+
+func (v1Object) TableName() string { return "objects" }
+
+type v1ResourceSpec struct {
+	ID        string `gorm:"primarykey"`
+	Attribute string `gorm:"type:json"`
+}
+
+func (v1ResourceSpec) TableName() string { return "resource_specs" }
+
+type v1ResourceStatus struct {
+	ID        string `gorm:"primarykey"`
+	Attribute string `gorm:"type:json"`
+}
+
+func (v1ResourceStatus) TableName() string { return "resource_statuses" }
+
+type v1ResourceObjectMeta struct {
+	ID                         string `gorm:"primarykey"`
+	Name                       string `gorm:"index"`
+	GenerateName               string
+	Namespace                  string
+	SelfLink                   string
+	UID                        string
+	ResourceVersion            string
+	Generation                 int64
+	CreationTimestamp          string
+	DeletionTimestamp          string
+	DeletionGracePeriodSeconds *int64
+	Labels                     []*v1KeyValue `gorm:"foreignkey:ID;references:id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Annotations                []*v1KeyValue `gorm:"foreignkey:ID;references:id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	OwnerReferences            string        `gorm:"type:json"`
+	Finalizers                 string        `gorm:"type:json"`
+	ClusterName                string
+	ManagedFields              string `gorm:"type:json"`
+	ClusterID                  string
+}
+
+func (v1ResourceObjectMeta) TableName() string { return "resource_object_meta" }
+
+type v1PerformanceProfile struct {
+	ID                *string
+	Name              string
+	LastRun           *string `gorm:"type:datetime"`
+	Schedule          *string
+	LoadGenerators    string `gorm:"type:text[]"`
+	Endpoints         string `gorm:"type:text[]"`
+	ServiceMesh       string
+	ConcurrentRequest int64
+	QPS               int64
+	Duration          string
+	TotalResults      int64
+	RequestHeaders    string
+	RequestCookies    string
+	RequestBody       string
+	ContentType       string
+	Method            string
+	RequestMix        string `gorm:"type:text"`
+	UserID            string
+	UpdatedAt         *time.Time
+	CreatedAt         *time.Time
+	DeletedAt         time.Time
+}
+
+func (v1PerformanceProfile) TableName() string { return "performance_profiles" }
+
+type v1MesheryResult struct {
+	ID                 string
+	Name               string
+	Mesh               string
+	PerformanceProfile *string
+	TestID             string
+	Result             string `gorm:"type:JSONB"`
+	ServerMetrics      string `gorm:"type:JSONB"`
+	ServerBoardConfig  string `gorm:"type:JSONB"`
+	TestStartTime      *time.Time
+	UpdatedAt          string
+	CreatedAt          string
+	UserID             string
+
+	PerformanceProfileInfo v1PerformanceProfile `gorm:"constraint:OnDelete:SET NULL;foreignKey:PerformanceProfile"`
+}
+
+func (v1MesheryResult) TableName() string { return "meshery_results" }
+
+type v1MesheryPattern struct {
+	ID          *string
+	Name        string
+	PatternFile string
+	UserID      *string
+	Location    string
+	UpdatedAt   *time.Time
+	CreatedAt   *time.Time
+	DeletedAt   time.Time
+}
+
+func (v1MesheryPattern) TableName() string { return "meshery_patterns" }
+
+type v1PatternShare struct {
+	ID         *string
+	PatternID  *string
+	Kind       string
+	SharedWith string
+	Permission string
+	SharedBy   string
+	CreatedAt  *time.Time
+}
+
+func (v1PatternShare) TableName() string { return "pattern_shares" }
+
+type v1PatternReview struct {
+	ID          *string
+	PatternID   *string
+	Reviewer    string
+	RequestedBy string
+	Status      string
+	Comment     string
+	UpdatedAt   *time.Time
+	CreatedAt   *time.Time
+}
+
+func (v1PatternReview) TableName() string { return "pattern_reviews" }
+
+type v1DeploymentEnvironment struct {
+	ID                *string
+	Name              string `gorm:"uniqueIndex"`
+	K8sContextID      string
+	PromotesFrom      string
+	RequiredApprovals int64
+	UpdatedAt         *time.Time
+	CreatedAt         *time.Time
+}
+
+func (v1DeploymentEnvironment) TableName() string { return "deployment_environments" }
+
+type v1PatternDeployment struct {
+	ID                *string
+	PatternID         *string `gorm:"index"`
+	PatternName       string
+	Environment       string
+	PatternSHA        string
+	DeployedBy        string
+	PromotedFrom      *string
+	SourceEnvironment string
+	ApprovedBy        string
+	CreatedAt         *time.Time
+}
+
+func (v1PatternDeployment) TableName() string { return "pattern_deployments" }
+
+type v1WorkloadIdentity struct {
+	ID             *string
+	K8sContextID   string
+	Namespace      string
+	ServiceAccount string
+	Audience       string
+	Provider       string
+	ProviderToken  string
+	CreatedBy      string
+	CreatedAt      *time.Time
+}
+
+func (v1WorkloadIdentity) TableName() string { return "workload_identities" }
+
+type v1PerfTarget struct {
+	ID           *string
+	K8sContextID string `gorm:"uniqueIndex:idx_perf_target_location"`
+	Namespace    string `gorm:"uniqueIndex:idx_perf_target_location"`
+	Image        string
+	URL          string
+	MeshURL      string
+	UpdatedAt    *time.Time
+	CreatedAt    *time.Time
+}
+
+func (v1PerfTarget) TableName() string { return "perf_targets" }
+
+type v1ResultAnalysis struct {
+	ID         *string
+	ResultID   string `gorm:"uniqueIndex"`
+	ProfileID  string `gorm:"index"`
+	Name       string
+	P99        float64
+	Mean       float64
+	StdDev     float64
+	Samples    int64
+	Deviations float64
+	Threshold  float64
+	Anomaly    bool `gorm:"index"`
+	CreatedAt  *time.Time
+}
+
+func (v1ResultAnalysis) TableName() string { return "result_analyses" }
+
+type v1ResultHook struct {
+	ID            *string
+	Name          string `gorm:"uniqueIndex:idx_result_hook_name"`
+	URL           string
+	Secret        string
+	Disabled      bool
+	UserID        string `gorm:"uniqueIndex:idx_result_hook_name"`
+	LastInvokedAt *time.Time
+	LastError     string
+	UpdatedAt     *time.Time
+	CreatedAt     *time.Time
+}
+
+func (v1ResultHook) TableName() string { return "result_hooks" }
+
+type v1ResultAnnotation struct {
+	ResultID    string `gorm:"primaryKey"`
+	Hook        string `gorm:"primaryKey"`
+	Annotations string `gorm:"type:text"`
+	UpdatedAt   time.Time
+}
+
+func (v1ResultAnnotation) TableName() string { return "result_annotations" }
+
+type v1ValidationWebhook struct {
+	ID            *string
+	Name          string `gorm:"uniqueIndex"`
+	URL           string
+	Secret        string
+	FailurePolicy string
+	Disabled      bool
+	UpdatedAt     *time.Time
+	CreatedAt     *time.Time
+}
+
+func (v1ValidationWebhook) TableName() string { return "validation_webhooks" }
+
+type v1DashboardSnapshot struct {
+	ID        *string
+	UserID    string
+	Title     string
+	State     string `gorm:"type:text"`
+	CreatedAt *time.Time
+}
+
+func (v1DashboardSnapshot) TableName() string { return "dashboard_snapshots" }
+
+type v1TrendReport struct {
+	ID          *string
+	ProfileID   string `gorm:"uniqueIndex:idx_trend_report_period"`
+	Name        string
+	PeriodStart time.Time
+	PeriodEnd   time.Time `gorm:"uniqueIndex:idx_trend_report_period"`
+	Results     int64
+	P99Mean     float64
+	P99Variance float64
+	P99Slope    float64
+	P99Change   float64
+	HasTrend    bool
+	Threshold   float64
+	Regression  bool
+	Weeks       string `gorm:"type:text"`
+	CreatedAt   *time.Time
+}
+
+func (v1TrendReport) TableName() string { return "trend_reports" }
+
+type v1LoadTestCheckpoint struct {
+	ID         *string
+	TestID     string `gorm:"index"`
+	ProfileID  string
+	Name       string
+	Client     int64
+	Seq        int64
+	StartTime  time.Time
+	Duration   int64
+	Requests   int64
+	QPS        float64
+	Min        float64
+	Max        float64
+	Average    float64
+	P50        float64
+	P90        float64
+	P99        float64
+	SegmentQPS float64
+	SegmentP99 float64
+	CreatedAt  *time.Time
+}
+
+func (v1LoadTestCheckpoint) TableName() string { return "load_test_checkpoints" }
+
+type v1Event struct {
+	ID          *string
+	Severity    string `gorm:"index"`
+	Category    string `gorm:"index"`
+	EventType   int32
+	Summary     string
+	Details     string
+	OperationID string
+	Source      string
+	CreatedAt   *time.Time `gorm:"index"`
+}
+
+func (v1Event) TableName() string { return "events" }
+
+type v1EventStatus struct {
+	EventID        string `gorm:"primaryKey"`
+	UserID         string `gorm:"primaryKey"`
+	AcknowledgedAt *time.Time
+	ResolvedAt     *time.Time
+}
+
+func (v1EventStatus) TableName() string { return "event_statuses" }
+
+type v1DigestSubscription struct {
+	UserID     string `gorm:"primaryKey"`
+	Email      string
+	Frequency  string
+	LastSentAt *time.Time
+	UpdatedAt  time.Time
+}
+
+func (v1DigestSubscription) TableName() string { return "digest_subscriptions" }
+
+type v1DesignSchedule struct {
+	ID             *string
+	UserID         string `gorm:"index"`
+	PatternID      string `gorm:"index"`
+	PatternName    string
+	Operation      string
+	KeepNamespace  bool
+	RunAt          *time.Time
+	Cron           string
+	K8sContextID   string
+	K8sContextName string
+	NextRunAt      *time.Time `gorm:"index"`
+	LastRunAt      *time.Time
+	LastStatus     string
+	LastMessage    string
+	PatternFile    string
+	Provider       string
+	Token          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (v1DesignSchedule) TableName() string { return "design_schedules" }
+
+type v1FeatureFlag struct {
+	Name      string `gorm:"primaryKey"`
+	UserID    string `gorm:"primaryKey"`
+	Enabled   bool
+	UpdatedAt *time.Time
+}
+
+func (v1FeatureFlag) TableName() string { return "feature_flags" }
+
+type v1MesheryFilter struct {
+	ID         *string
+	Name       string
+	FilterFile string
+	Location   string
+	UpdatedAt  *time.Time
+	CreatedAt  *time.Time
+}
+
+func (v1MesheryFilter) TableName() string { return "meshery_filters" }
+
+type v1PatternResource struct {
+	ID        *string
+	UserID    *string
+	Name      string
+	Namespace string
+	Type      string
+	OAMType   string
+	Deleted   bool
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+func (v1PatternResource) TableName() string { return "pattern_resources" }
+
+type v1MesheryApplication struct {
+	ID              *string
+	Name            string
+	ApplicationFile string
+	Location        string
+	UpdatedAt       *time.Time
+	CreatedAt       *time.Time
+}
+
+func (v1MesheryApplication) TableName() string { return "meshery_applications" }
+
+type v1UserPreference struct {
+	ID              string
+	PreferenceBytes []byte
+}
+
+func (v1UserPreference) TableName() string { return "user_preferences" }
+
+type v1PerformanceTestConfig struct {
+	ID                         string
+	PerformanceTestConfigBytes []byte
+	UpdatedAt                  time.Time
+}
+
+func (v1PerformanceTestConfig) TableName() string { return "performance_test_configs" }
+
+type v1SmiResultWithID struct {
+	ID                string
+	Date              string
+	MeshName          string
+	MeshVersion       string
+	CasesPassed       string
+	PassingPercentage string
+	Status            string
+	MoreDetails       string `gorm:"type:detail[]"`
+}
+
+func (v1SmiResultWithID) TableName() string { return "smi_result_with_ids" }
+
+type v1K8sContext struct {
+	ID                 string
+	Name               string
+	Auth               string
+	Cluster            string
+	Server             string
+	IsCurrentContext   bool
+	MesheryInstanceID  *string
+	KubernetesServerID *string
+	QPS                float32
+	Burst              int64
+	UpdatedAt          *time.Time
+	CreatedAt          *time.Time
+}
+
+func (v1K8sContext) TableName() string { return "k8s_contexts" }
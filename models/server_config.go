@@ -0,0 +1,36 @@
+package models
+
+// ServerConfig is the configuration of Meshery Server which can be changed while it runs
+type ServerConfig struct {
+	// ProviderURLs are the base URLs of the remote providers, the local provider being always available
+	ProviderURLs []string `json:"provider_urls"`
+	// AdapterURLs are the addresses of the adapters
+	AdapterURLs []string `json:"adapter_urls"`
+	// LogLevel is the level of the logs of the server, among those of logrus
+	LogLevel string `json:"log_level"`
+	// FeatureFlags enable or disable the features of the server which can be toggled while it runs
+	FeatureFlags map[string]bool `json:"feature_flags"`
+}
+
+// ServerConfigChange is a setting changed by a reload of the configuration
+type ServerConfigChange struct {
+	Setting string `json:"setting"`
+	Old     string `json:"old"`
+	New     string `json:"new"`
+}
+
+// ServerConfigReload is the outcome of a reload of the configuration
+type ServerConfigReload struct {
+	Config  ServerConfig         `json:"config"`
+	Changes []ServerConfigChange `json:"changes"`
+}
+
+// ServerConfigReloaderInterface applies the changes of the configuration of the server while it runs
+type ServerConfigReloaderInterface interface {
+	// Config returns the configuration in effect
+	Config() ServerConfig
+	// Reload reads the configuration file of the server again, if any, and applies it along with the
+	// update. The settings left empty in the update, nil for the URLs, are kept as configured; the
+	// feature flags of the update are merged with those in effect.
+	Reload(update *ServerConfig) (*ServerConfigReload, error)
+}
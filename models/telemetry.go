@@ -0,0 +1,91 @@
+package models
+
+// GoogleAnalyticsMeasurementID is the Google Analytics property Meshery UI sends its usage statistics to
+const GoogleAnalyticsMeasurementID = "G-8Q51RLT8TZ"
+
+// Categories of the data sent by Meshery, as listed in the telemetry report
+const (
+	TelemetryUsageStatistics    = "usage-statistics"
+	TelemetryPerformanceResults = "performance-results"
+	TelemetryPerformanceMetrics = "performance-metrics"
+)
+
+// TelemetryReport shows which data Meshery sends, where to and whether the user enabled it, so that
+// the telemetry can be audited rather than trusted
+type TelemetryReport struct {
+	Provider   string               `json:"provider"`
+	Categories []*TelemetryCategory `json:"categories"`
+}
+
+// TelemetryCategory is a category of data sent by Meshery
+type TelemetryCategory struct {
+	Name string `json:"name"`
+	// Preference is the preference of the user enabling the category, empty if the data is always sent
+	Preference string `json:"preference,omitempty"`
+	Enabled    bool   `json:"enabled"`
+	// Anonymous tells whether the data is sent without the account of the user
+	Anonymous   bool   `json:"anonymous"`
+	Sender      string `json:"sender"`
+	Destination string `json:"destination"`
+	Description string `json:"description"`
+	// Sample is the data as it would be sent next, built from the latest data of the user if any
+	Sample interface{} `json:"sample,omitempty"`
+}
+
+// TelemetryPreferences enables or disables the categories of anonymous data, those left unset
+// being unchanged
+type TelemetryPreferences struct {
+	AnonymousUsageStats  *bool `json:"anonymousUsageStats,omitempty"`
+	AnonymousPerfResults *bool `json:"anonymousPerfResults,omitempty"`
+}
+
+// Apply sets the preferences given on the preferences of the user
+func (t *TelemetryPreferences) Apply(pref *Preference) {
+	if t.AnonymousUsageStats != nil {
+		pref.AnonymousUsageStats = *t.AnonymousUsageStats
+	}
+	if t.AnonymousPerfResults != nil {
+		pref.AnonymousPerfResults = *t.AnonymousPerfResults
+	}
+}
+
+// usageStatisticsCategory returns the category of the page views Meshery UI sends to Google Analytics
+func usageStatisticsCategory(pref *Preference) *TelemetryCategory {
+	return &TelemetryCategory{
+		Name:        TelemetryUsageStatistics,
+		Preference:  "anonymousUsageStats",
+		Enabled:     pref.AnonymousUsageStats,
+		Anonymous:   true,
+		Sender:      "Meshery UI",
+		Destination: "https://www.google-analytics.com (" + GoogleAnalyticsMeasurementID + ")",
+		Description: "The path of every page of Meshery UI visited, sent by the browser through Google Analytics.",
+		Sample: map[string]string{
+			"measurement_id": GoogleAnalyticsMeasurementID,
+			"page_path":      "/performance",
+		},
+	}
+}
+
+// anonymousResultSamples returns the result and the metrics of the latest test of the user as they
+// would be shipped for the next test, following PublishResults and CollectStaticMetrics
+func anonymousResultSamples(latest *MesheryResult) (result, metrics interface{}) {
+	if latest == nil {
+		return nil, nil
+	}
+	result = &MesheryResult{
+		Name:               latest.Name,
+		Mesh:               latest.Mesh,
+		PerformanceProfile: latest.PerformanceProfile,
+		Result:             latest.Result,
+		UserID:             latest.UserID,
+	}
+	if latest.ServerMetrics != nil {
+		metrics = &MesheryResult{
+			ID:                latest.ID,
+			TestID:            latest.TestID,
+			ServerMetrics:     latest.ServerMetrics,
+			ServerBoardConfig: latest.ServerBoardConfig,
+		}
+	}
+	return result, metrics
+}
@@ -0,0 +1,104 @@
+package models
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// sealedTokenPrefix marks the tokens sealed by a TokenCipher, telling them apart from
+// those persisted in plaintext before the tokens were encrypted
+const sealedTokenPrefix = "sealed:v1:"
+
+// TokenCipher encrypts the provider tokens Meshery Server persists on behalf of the users,
+// such as those of the workload identities, with AES-256-GCM
+type TokenCipher struct {
+	aead cipher.AEAD
+}
+
+// NewTokenCipher returns a TokenCipher of the given 32 bytes key
+func NewTokenCipher(key []byte) (*TokenCipher, error) {
+	if len(key) != 32 {
+		return nil, ErrTokenCipher(fmt.Errorf("key is %d bytes long, 32 bytes are required", len(key)))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, ErrTokenCipher(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, ErrTokenCipher(err)
+	}
+	return &TokenCipher{aead: aead}, nil
+}
+
+// LoadTokenCipher returns a TokenCipher of the base64 encoded key, or of the key in the key file
+// when no key is given, the key file being created with a random key the first time
+func LoadTokenCipher(key, keyFile string) (*TokenCipher, error) {
+	if key == "" {
+		content, err := os.ReadFile(keyFile)
+		if os.IsNotExist(err) {
+			raw := make([]byte, 32)
+			if _, err := io.ReadFull(rand.Reader, raw); err != nil {
+				return nil, ErrTokenCipher(err)
+			}
+			content = []byte(base64.StdEncoding.EncodeToString(raw))
+			if err := os.MkdirAll(filepath.Dir(keyFile), 0700); err != nil {
+				return nil, ErrTokenCipher(err)
+			}
+			if err := os.WriteFile(keyFile, content, 0600); err != nil {
+				return nil, ErrTokenCipher(err)
+			}
+		} else if err != nil {
+			return nil, ErrTokenCipher(err)
+		}
+		key = string(content)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, ErrTokenCipher(err)
+	}
+	return NewTokenCipher(raw)
+}
+
+// Seal encrypts the token
+func (tc *TokenCipher) Seal(token string) (string, error) {
+	nonce := make([]byte, tc.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", ErrTokenCipher(err)
+	}
+	sealed := tc.aead.Seal(nonce, nonce, []byte(token), nil)
+	return sealedTokenPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts the token sealed by Seal, returning the tokens persisted in plaintext as they are
+func (tc *TokenCipher) Open(token string) (string, error) {
+	if !IsSealedToken(token) {
+		return token, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(token, sealedTokenPrefix))
+	if err != nil {
+		return "", ErrTokenCipher(err)
+	}
+	size := tc.aead.NonceSize()
+	if len(sealed) < size {
+		return "", ErrTokenCipher(fmt.Errorf("sealed token is truncated"))
+	}
+	plain, err := tc.aead.Open(nil, sealed[:size], sealed[size:], nil)
+	if err != nil {
+		return "", ErrTokenCipher(err)
+	}
+	return string(plain), nil
+}
+
+// IsSealedToken checks whether the token was sealed by a TokenCipher
+func IsSealedToken(token string) bool {
+	return strings.HasPrefix(token, sealedTokenPrefix)
+}
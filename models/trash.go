@@ -0,0 +1,136 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm"
+)
+
+// DefaultTrashRetention is how long deleted designs and performance profiles are kept in
+// the trash before they are purged
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// TrashItem is a design or performance profile in the trash
+type TrashItem struct {
+	ID     *uuid.UUID `json:"id"`
+	Kind   string     `json:"kind"`
+	Name   string     `json:"name"`
+	UserID string     `json:"user_id,omitempty"`
+	// DeletedAt is when the item was moved to the trash
+	DeletedAt time.Time `json:"deleted_at"`
+	// PurgeAt is when the item is purged from the trash, unset if items are kept until restored
+	PurgeAt *time.Time `json:"purge_at,omitempty"`
+}
+
+// TrashPage is a page of the items in the trash
+type TrashPage struct {
+	// Retention is how long items are kept in the trash, in seconds, zero if items are kept
+	// until restored
+	Retention int64        `json:"retention"`
+	Items     []*TrashItem `json:"items"`
+}
+
+// TrashPersister lists, restores and purges the designs and performance profiles moved to
+// the trash when deleted
+type TrashPersister struct {
+	DB *database.Handler
+	// Retention is how long items are kept in the trash, zero keeping them until restored
+	Retention time.Duration
+}
+
+// trashModels are the models of the kinds of items which are moved to the trash when deleted
+var trashModels = map[string]interface{}{
+	OwnedPattern:            &MesheryPattern{},
+	OwnedPerformanceProfile: &PerformanceProfile{},
+}
+
+// trashKinds returns the kinds of the items in the trash to handle, every kind if empty
+func trashKinds(kind string) ([]string, error) {
+	if kind == "" {
+		return []string{OwnedPattern, OwnedPerformanceProfile}, nil
+	}
+	if _, ok := trashModels[kind]; !ok {
+		return nil, fmt.Errorf("unknown kind %s, expected %s or %s", kind, OwnedPattern, OwnedPerformanceProfile)
+	}
+	return []string{kind}, nil
+}
+
+// GetTrash returns the items of the kind in the trash, of every kind if empty, latest
+// deleted first
+func (tp *TrashPersister) GetTrash(kind string) (*TrashPage, error) {
+	kinds, err := trashKinds(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TrashPage{Retention: int64(tp.Retention.Seconds()), Items: []*TrashItem{}}
+	for _, kind := range kinds {
+		items := []*TrashItem{}
+		err := tp.DB.Unscoped().Model(trashModels[kind]).
+			Select("id, name, user_id, deleted_at").
+			Where("deleted_at IS NOT NULL").
+			Order("deleted_at desc").
+			Find(&items).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			item.Kind = kind
+			if tp.Retention > 0 {
+				purgeAt := item.DeletedAt.Add(tp.Retention)
+				item.PurgeAt = &purgeAt
+			}
+		}
+		page.Items = append(page.Items, items...)
+	}
+	return page, nil
+}
+
+// Restore takes the item of the kind and id out of the trash
+func (tp *TrashPersister) Restore(kind string, id uuid.UUID) (*TrashItem, error) {
+	if _, ok := trashModels[kind]; !ok {
+		return nil, fmt.Errorf("unknown kind %q, expected %s or %s", kind, OwnedPattern, OwnedPerformanceProfile)
+	}
+
+	item := &TrashItem{}
+	err := tp.DB.Transaction(func(tx *gorm.DB) error {
+		found := tx.Unscoped().Model(trashModels[kind]).
+			Select("id, name, user_id, deleted_at").
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Limit(1).
+			Find(item)
+		if found.Error != nil {
+			return found.Error
+		}
+		if found.RowsAffected == 0 {
+			return fmt.Errorf("no %s of id %s in the trash", kind, id)
+		}
+		return tx.Unscoped().Model(trashModels[kind]).Where("id = ?", id).Update("deleted_at", nil).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	item.Kind = kind
+	return item, nil
+}
+
+// Purge permanently deletes the items kept in the trash for longer than the retention,
+// returning the number of items purged
+func (tp *TrashPersister) Purge() (int64, error) {
+	var purged int64
+	if tp.Retention <= 0 {
+		return purged, nil
+	}
+	before := time.Now().Add(-tp.Retention)
+	for _, kind := range []string{OwnedPattern, OwnedPerformanceProfile} {
+		result := tp.DB.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Delete(trashModels[kind])
+		if result.Error != nil {
+			return purged, result.Error
+		}
+		purged += result.RowsAffected
+	}
+	return purged, nil
+}
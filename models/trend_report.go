@@ -0,0 +1,133 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// TrendWeek holds the statistics of the p99 latency of the results of a profile completed in a week,
+// in seconds
+type TrendWeek struct {
+	Start       time.Time `json:"start"`
+	Results     int       `json:"results"`
+	P99Median   float64   `json:"p99_median"`
+	P99Mean     float64   `json:"p99_mean"`
+	P99Variance float64   `json:"p99_variance"`
+}
+
+// TrendWeeks are the weeks of a trend report, stored as JSON
+type TrendWeeks []TrendWeek
+
+// Scan reads the weeks from their JSON in the database
+func (w *TrendWeeks) Scan(src interface{}) error {
+	var b []byte
+	switch t := src.(type) {
+	case nil:
+		*w = nil
+		return nil
+	case []byte:
+		b = t
+	case string:
+		b = []byte(t)
+	default:
+		return fmt.Errorf("scan source was not []byte nor string but %T", src)
+	}
+	if len(b) == 0 {
+		*w = nil
+		return nil
+	}
+	return json.Unmarshal(b, w)
+}
+
+// Value stores the weeks as JSON in the database
+func (w TrendWeeks) Value() (driver.Value, error) {
+	b, err := json.Marshal([]TrendWeek(w))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// TrendReport holds the rate of change of the p99 latency of the results of a profile over the weeks of
+// a period, catching the slow regressions no comparison of two results shows
+type TrendReport struct {
+	ID *uuid.UUID `json:"id,omitempty"`
+
+	ProfileID string `json:"profile_id" gorm:"uniqueIndex:idx_trend_report_period"`
+	Name      string `json:"name,omitempty"`
+	// PeriodStart and PeriodEnd bound the period of the report, PeriodEnd excluded
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end" gorm:"uniqueIndex:idx_trend_report_period"`
+
+	// Results is the number of results completed in the period, P99Mean and P99Variance the mean and the
+	// variance of their p99 latency, in seconds and seconds squared
+	Results     int     `json:"results"`
+	P99Mean     float64 `json:"p99_mean"`
+	P99Variance float64 `json:"p99_variance"`
+	// P99Slope is the slope of the least-squares fit of the p99 latency over time, in seconds per week,
+	// and P99Change the change of the p99 latency it amounts to over the period relative to P99Mean
+	P99Slope  float64 `json:"p99_slope"`
+	P99Change float64 `json:"p99_change"`
+	HasTrend  bool    `json:"has_trend"`
+	// Regression is set when P99Change exceeds Threshold
+	Threshold  float64 `json:"threshold"`
+	Regression bool    `json:"regression"`
+
+	Weeks TrendWeeks `json:"weeks" gorm:"type:text"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// TrendReporterInterface defines the methods a type should implement to report on the trend of the
+// performance of profiles
+type TrendReporterInterface interface {
+	// Report computes the report of the profile over the weeks up to the given time
+	Report(profileID, name string, now time.Time) (*TrendReport, error)
+}
+
+// TrendReportPersister is the persister for persisting
+// the performance trend reports on the database
+type TrendReportPersister struct {
+	DB *database.Handler
+}
+
+// SaveTrendReport persists the given report, replacing the report of the same profile and period
+func (trp *TrendReportPersister) SaveTrendReport(report *TrendReport) error {
+	existing := TrendReport{}
+	if err := trp.DB.Where("profile_id = ? AND period_end = ?", report.ProfileID, report.PeriodEnd).First(&existing).Error; err == nil {
+		report.ID = existing.ID
+	} else {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		report.ID = &id
+	}
+	return trp.DB.Save(report).Error
+}
+
+// HasTrendReport tells whether the report of the profile for the period ending at the given time exists
+func (trp *TrendReportPersister) HasTrendReport(profileID string, periodEnd time.Time) (bool, error) {
+	var count int64
+	if err := trp.DB.Model(&TrendReport{}).Where("profile_id = ? AND period_end = ?", profileID, periodEnd).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetLatestTrendReport returns the report of the profile for the most recent period, nil when there is none
+func (trp *TrendReportPersister) GetLatestTrendReport(profileID string) (*TrendReport, error) {
+	reports := []TrendReport{}
+	if err := trp.DB.Where("profile_id = ?", profileID).Order("period_end desc").Limit(1).Find(&reports).Error; err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, nil
+	}
+	return &reports[0], nil
+}
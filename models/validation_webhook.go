@@ -0,0 +1,156 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// ValidationWebhookFailurePolicy tells whether a design is deployed when its validation webhook
+// cannot be reached or does not answer as expected
+type ValidationWebhookFailurePolicy string
+
+const (
+	// ValidationWebhookFail blocks the deployment, the default
+	ValidationWebhookFail ValidationWebhookFailurePolicy = "Fail"
+	// ValidationWebhookIgnore deploys the design as if the webhook allowed it
+	ValidationWebhookIgnore ValidationWebhookFailurePolicy = "Ignore"
+)
+
+// ValidationWebhook is a webhook of an external policy engine, such as Kyverno or OPA Gatekeeper,
+// Meshery Server calls with the manifests of every design before deploying it, the design being
+// deployed only when every webhook allows it
+type ValidationWebhook struct {
+	ID   *uuid.UUID `json:"id,omitempty"`
+	Name string     `json:"name" gorm:"uniqueIndex"`
+	URL  string     `json:"url"`
+	// Secret signs the requests to the webhook, it is never returned
+	Secret        string                         `json:"secret,omitempty"`
+	FailurePolicy ValidationWebhookFailurePolicy `json:"failure_policy"`
+	// Disabled webhooks are not called
+	Disabled bool `json:"disabled"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// Validate checks the name, the URL and the failure policy of the webhook, defaulting the latter to Fail
+func (w *ValidationWebhook) Validate() error {
+	if w.Name == "" {
+		return fmt.Errorf("the webhook has no name")
+	}
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %q, expected an http(s) URL", w.URL)
+	}
+	switch w.FailurePolicy {
+	case "":
+		w.FailurePolicy = ValidationWebhookFail
+	case ValidationWebhookFail, ValidationWebhookIgnore:
+	default:
+		return fmt.Errorf("invalid failure policy %q, expected %s or %s", w.FailurePolicy, ValidationWebhookFail, ValidationWebhookIgnore)
+	}
+	return nil
+}
+
+// DesignValidationRequest is what validation webhooks receive before a design is deployed
+type DesignValidationRequest struct {
+	// UID identifies the request, and is returned in the response
+	UID string `json:"uid"`
+	// DryRun is set when the design is only verified, not deployed
+	DryRun bool `json:"dry_run"`
+
+	UserID     string `json:"user_id,omitempty"`
+	DesignID   string `json:"design_id,omitempty"`
+	DesignName string `json:"design_name,omitempty"`
+	// Cluster is the name of the Kubernetes context the design is deployed to
+	Cluster string `json:"cluster,omitempty"`
+
+	// Manifests are the Kubernetes resources of the design, as they are applied
+	Manifests []map[string]interface{} `json:"manifests"`
+}
+
+// DesignValidationResponse is what validation webhooks answer with, denying the deployment unless Allowed
+type DesignValidationResponse struct {
+	UID        string                      `json:"uid,omitempty"`
+	Allowed    bool                        `json:"allowed"`
+	Message    string                      `json:"message,omitempty"`
+	Violations []DesignValidationViolation `json:"violations,omitempty"`
+}
+
+// DesignValidationViolation is a policy a resource of the design violates
+type DesignValidationViolation struct {
+	// Resource is the resource violating the policy, as kind/namespace/name
+	Resource string `json:"resource,omitempty"`
+	Policy   string `json:"policy,omitempty"`
+	Message  string `json:"message"`
+}
+
+func (v DesignValidationViolation) String() string {
+	s := v.Message
+	if v.Policy != "" {
+		s = v.Policy + ": " + s
+	}
+	if v.Resource != "" {
+		s = v.Resource + ": " + s
+	}
+	return s
+}
+
+// ValidationWebhookPersister is the persister for persisting
+// the validation webhooks on the database
+type ValidationWebhookPersister struct {
+	DB *database.Handler
+}
+
+// SaveWebhook persists the given webhook, replacing the webhook with the same id or else the same name,
+// whose secret is kept when none is given
+func (vwp *ValidationWebhookPersister) SaveWebhook(webhook *ValidationWebhook) error {
+	existing := ValidationWebhook{}
+	query := vwp.DB.Where("name = ?", webhook.Name)
+	if webhook.ID != nil {
+		query = vwp.DB.Where("id = ?", webhook.ID)
+	}
+	if err := query.First(&existing).Error; err == nil {
+		webhook.ID = existing.ID
+		webhook.CreatedAt = existing.CreatedAt
+		if webhook.Secret == "" {
+			webhook.Secret = existing.Secret
+		}
+	} else {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		webhook.ID = &id
+	}
+	return vwp.DB.Save(webhook).Error
+}
+
+// GetWebhooks returns the validation webhooks, only those enabled when told so
+func (vwp *ValidationWebhookPersister) GetWebhooks(enabledOnly bool) ([]ValidationWebhook, error) {
+	webhooks := []ValidationWebhook{}
+	query := vwp.DB.Order("name")
+	if enabledOnly {
+		query = query.Where("disabled = ?", false)
+	}
+	if err := query.Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook deletes the validation webhook with the given id
+func (vwp *ValidationWebhookPersister) DeleteWebhook(id uuid.UUID) error {
+	res := vwp.DB.Where("id = ?", id).Delete(&ValidationWebhook{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("no validation webhook with id %s", id)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// DefaultWorkloadAudience is the audience of the projected ServiceAccount tokens
+// workloads authenticate to Meshery with, when the identity does not set one
+const DefaultWorkloadAudience = "meshery"
+
+// TokenCookieName is the name of the cookie holding the provider token of a session
+const TokenCookieName = tokenName
+
+// WorkloadIdentity binds a ServiceAccount of a connected cluster to the Meshery user who
+// created the binding, allowing the pods running as the ServiceAccount to call the API of
+// Meshery on behalf of the user with their projected ServiceAccount token
+type WorkloadIdentity struct {
+	ID *uuid.UUID `json:"id,omitempty"`
+
+	K8sContextID   string `json:"k8s_context_id,omitempty"`
+	Namespace      string `json:"namespace,omitempty"`
+	ServiceAccount string `json:"service_account,omitempty"`
+	// Audience the projected tokens must be issued for
+	Audience string `json:"audience,omitempty"`
+
+	// Provider and ProviderToken are the session of the user the workloads act on behalf of,
+	// they are never returned by the API and the token is encrypted at rest
+	Provider      string `json:"provider,omitempty"`
+	ProviderToken string `json:"-"`
+	CreatedBy     string `json:"created_by,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// Subject returns the username Kubernetes authenticates the tokens of the ServiceAccount as
+func (wi *WorkloadIdentity) Subject() string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", wi.Namespace, wi.ServiceAccount)
+}
+
+// Validate checks whether the workload identity can be persisted
+func (wi *WorkloadIdentity) Validate() error {
+	wi.Namespace = strings.TrimSpace(wi.Namespace)
+	wi.ServiceAccount = strings.TrimSpace(wi.ServiceAccount)
+	if wi.K8sContextID == "" || wi.Namespace == "" || wi.ServiceAccount == "" {
+		return ErrWorkloadIdentity
+	}
+	if wi.Audience == "" {
+		wi.Audience = DefaultWorkloadAudience
+	}
+	return nil
+}
+
+// WorkloadIdentityPersister is the persister for persisting
+// the workload identities on the database
+type WorkloadIdentityPersister struct {
+	DB *database.Handler
+	// Cipher encrypts the provider tokens of the identities
+	Cipher *TokenCipher
+}
+
+// SaveWorkloadIdentity persists the given workload identity, a ServiceAccount of a Kubernetes
+// context being bound to a single workload identity
+func (wip *WorkloadIdentityPersister) SaveWorkloadIdentity(identity *WorkloadIdentity) error {
+	var count int64
+	if err := wip.DB.Model(&WorkloadIdentity{}).
+		Where("k8s_context_id = ? AND namespace = ? AND service_account = ?", identity.K8sContextID, identity.Namespace, identity.ServiceAccount).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrDuplicateWorkloadIdentity(identity.Namespace, identity.ServiceAccount)
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ErrGenerateUUID(err)
+	}
+	sealed, err := wip.Cipher.Seal(identity.ProviderToken)
+	if err != nil {
+		return err
+	}
+
+	record := *identity
+	record.ID = &id
+	record.ProviderToken = sealed
+	if err := wip.DB.Create(&record).Error; err != nil {
+		return err
+	}
+	identity.ID = &id
+	identity.CreatedAt = record.CreatedAt
+	return nil
+}
+
+// SealProviderTokens encrypts the provider tokens persisted in plaintext before the tokens
+// of the workload identities were encrypted
+func (wip *WorkloadIdentityPersister) SealProviderTokens() error {
+	identities := []WorkloadIdentity{}
+	if err := wip.DB.Where("provider_token NOT LIKE ?", sealedTokenPrefix+"%").Find(&identities).Error; err != nil {
+		return err
+	}
+	for _, identity := range identities {
+		sealed, err := wip.Cipher.Seal(identity.ProviderToken)
+		if err != nil {
+			return err
+		}
+		if err := wip.DB.Model(&WorkloadIdentity{}).Where("id = ?", identity.ID).Update("provider_token", sealed).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetWorkloadIdentities returns the workload identities created by the given user
+func (wip *WorkloadIdentityPersister) GetWorkloadIdentities(createdBy string) ([]WorkloadIdentity, error) {
+	identities := []WorkloadIdentity{}
+	if err := wip.DB.Where("created_by = ?", createdBy).Order("created_at").Find(&identities).Error; err != nil {
+		return nil, err
+	}
+	return identities, nil
+}
+
+// GetWorkloadIdentitiesBySubject returns the workload identities of the ServiceAccount
+// with the given namespace and name, across the connected clusters, with their provider tokens decrypted
+func (wip *WorkloadIdentityPersister) GetWorkloadIdentitiesBySubject(namespace, serviceAccount string) ([]WorkloadIdentity, error) {
+	identities := []WorkloadIdentity{}
+	if err := wip.DB.Where("namespace = ? AND service_account = ?", namespace, serviceAccount).Find(&identities).Error; err != nil {
+		return nil, err
+	}
+	for i := range identities {
+		token, err := wip.Cipher.Open(identities[i].ProviderToken)
+		if err != nil {
+			return nil, err
+		}
+		identities[i].ProviderToken = token
+	}
+	return identities, nil
+}
+
+// DeleteWorkloadIdentity deletes the workload identity with the given id created by the given user
+func (wip *WorkloadIdentityPersister) DeleteWorkloadIdentity(id uuid.UUID, createdBy string) error {
+	result := wip.DB.Where("id = ? AND created_by = ?", id, createdBy).Delete(&WorkloadIdentity{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("workload identity %s not found", id)
+	}
+	return nil
+}
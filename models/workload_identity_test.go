@@ -0,0 +1,123 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+func newTestTokenCipher(t *testing.T) *TokenCipher {
+	t.Helper()
+	tc, err := LoadTokenCipher("", filepath.Join(t.TempDir(), "workload_identity.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tc
+}
+
+func TestTokenCipher(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "workload_identity.key")
+	tc, err := LoadTokenCipher("", keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("key file has the mode %v, want 0600", info.Mode().Perm())
+	}
+
+	sealed, err := tc.Seal("provider-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSealedToken(sealed) || strings.Contains(sealed, "provider-token") {
+		t.Fatalf("token sealed as %q", sealed)
+	}
+
+	// The key generated the first time is read back from the key file
+	reloaded, err := LoadTokenCipher("", keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token, err := reloaded.Open(sealed); err != nil || token != "provider-token" {
+		t.Errorf("Open() = %q, %v, want the provider token", token, err)
+	}
+	if token, err := reloaded.Open("plaintext-token"); err != nil || token != "plaintext-token" {
+		t.Errorf("Open() of a plaintext token = %q, %v, want it unchanged", token, err)
+	}
+
+	other := newTestTokenCipher(t)
+	if _, err := other.Open(sealed); err == nil {
+		t.Error("token sealed with another key was opened")
+	}
+	if _, err := LoadTokenCipher("c2hvcnQ=", keyFile); err == nil {
+		t.Error("key shorter than 32 bytes was accepted")
+	}
+}
+
+func TestWorkloadIdentityPersister(t *testing.T) {
+	db := newTestDB(t, "mesherydb.sql")
+	if _, err := NewSchemaMigrator(db, SchemaMigrations).Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+	wip := &WorkloadIdentityPersister{DB: db, Cipher: newTestTokenCipher(t)}
+
+	identity := &WorkloadIdentity{K8sContextID: "kind", Namespace: "ops", ServiceAccount: "release-bot", Provider: "Meshery", ProviderToken: "provider-token", CreatedBy: "alice"}
+	if err := wip.SaveWorkloadIdentity(identity); err != nil {
+		t.Fatal(err)
+	}
+	if identity.ID == nil || identity.ProviderToken != "provider-token" {
+		t.Fatalf("saved identity is %+v", identity)
+	}
+
+	var stored string
+	if err := db.Model(&WorkloadIdentity{}).Where("id = ?", identity.ID).Select("provider_token").Scan(&stored).Error; err != nil {
+		t.Fatal(err)
+	}
+	if !IsSealedToken(stored) {
+		t.Errorf("provider token is stored as %q, want it encrypted", stored)
+	}
+
+	duplicate := &WorkloadIdentity{K8sContextID: "kind", Namespace: "ops", ServiceAccount: "release-bot", Provider: "Meshery", ProviderToken: "other-token", CreatedBy: "bob"}
+	err := wip.SaveWorkloadIdentity(duplicate)
+	if e, ok := err.(*errors.Error); !ok || e.Code != ErrDuplicateWorkloadIdentityCode {
+		t.Errorf("SaveWorkloadIdentity() of a duplicate binding = %v, want a duplicate workload identity error", err)
+	}
+	otherCluster := &WorkloadIdentity{K8sContextID: "eks", Namespace: "ops", ServiceAccount: "release-bot", Provider: "Meshery", ProviderToken: "other-token", CreatedBy: "bob"}
+	if err := wip.SaveWorkloadIdentity(otherCluster); err != nil {
+		t.Errorf("SaveWorkloadIdentity() of the ServiceAccount of another cluster = %v", err)
+	}
+
+	// Tokens persisted before they were encrypted are sealed in place
+	legacy := WorkloadIdentity{ID: otherCluster.ID}
+	if err := db.Model(&legacy).Update("provider_token", "legacy-token").Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := wip.SealProviderTokens(); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Model(&WorkloadIdentity{}).Where("id = ?", otherCluster.ID).Select("provider_token").Scan(&stored).Error; err != nil {
+		t.Fatal(err)
+	}
+	if !IsSealedToken(stored) {
+		t.Errorf("legacy provider token is stored as %q, want it encrypted", stored)
+	}
+
+	identities, err := wip.GetWorkloadIdentitiesBySubject("ops", "release-bot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens := map[string]string{}
+	for _, identity := range identities {
+		tokens[identity.K8sContextID] = identity.ProviderToken
+	}
+	if tokens["kind"] != "provider-token" || tokens["eks"] != "legacy-token" {
+		t.Errorf("GetWorkloadIdentitiesBySubject() returned the tokens %v", tokens)
+	}
+}
@@ -0,0 +1,219 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/layer5io/meshkit/database"
+)
+
+// Kinds of the quotas of a workspace
+const (
+	QuotaProfiles        = "profiles"
+	QuotaConcurrentTests = "concurrent_tests"
+	QuotaResults         = "results"
+	QuotaDesigns         = "designs"
+)
+
+// bytesPerGB is the number of bytes of a gigabyte of results
+const bytesPerGB = 1 << 30
+
+// WorkspaceQuotas caps the artifacts of the members of a workspace, a zero quota
+// leaving the kind unlimited
+type WorkspaceQuotas struct {
+	// MaxProfiles caps the performance profiles owned by the members
+	MaxProfiles int64 `json:"max_profiles" mapstructure:"max_profiles"`
+	// MaxConcurrentTests caps the performance tests run by the members at the same time
+	MaxConcurrentTests int `json:"max_concurrent_tests" mapstructure:"max_concurrent_tests"`
+	// MaxResultsGB caps the size of the performance results owned by the members, in gigabytes
+	MaxResultsGB float64 `json:"max_results_gb" mapstructure:"max_results_gb"`
+	// MaxDesigns caps the designs owned by the members
+	MaxDesigns int64 `json:"max_designs" mapstructure:"max_designs"`
+}
+
+// Workspace is a group of users of Meshery Server sharing quotas, such as a department
+type Workspace struct {
+	Name string `json:"name" mapstructure:"name"`
+	// Members are the IDs of the users of the workspace
+	Members []string        `json:"members" mapstructure:"members"`
+	Quotas  WorkspaceQuotas `json:"quotas" mapstructure:"quotas"`
+	// VaultPaths are the prefixes of the paths of the Vault secrets the designs of the members may
+	// reference, none when empty
+	VaultPaths []string `json:"vault_paths,omitempty" mapstructure:"vault_paths"`
+	// Namespaces are the namespaces the designs of the members may be deployed to, any when empty
+	// unless they are deployed as a ServiceAccount, which is then denied
+	Namespaces []string `json:"namespaces,omitempty" mapstructure:"namespaces"`
+}
+
+// WorkspaceUsage is what the members of a workspace use of its quotas
+type WorkspaceUsage struct {
+	Profiles        int64   `json:"profiles"`
+	ConcurrentTests int     `json:"concurrent_tests"`
+	ResultsBytes    int64   `json:"results_bytes"`
+	ResultsGB       float64 `json:"results_gb"`
+	Designs         int64   `json:"designs"`
+}
+
+// WorkspaceUsageReport is the usage of the quotas of the workspace of a user
+type WorkspaceUsageReport struct {
+	Workspace string          `json:"workspace"`
+	Members   []string        `json:"members"`
+	Quotas    WorkspaceQuotas `json:"quotas"`
+	Usage     WorkspaceUsage  `json:"usage"`
+}
+
+// QuotaExceeded is the error of an artifact which the quota of its kind does not leave room for
+type QuotaExceeded struct {
+	Workspace string  `json:"workspace"`
+	Quota     string  `json:"quota"`
+	Limit     float64 `json:"limit"`
+	Usage     float64 `json:"usage"`
+}
+
+func (e *QuotaExceeded) Error() string {
+	limit, usage := strconv.FormatFloat(e.Limit, 'f', -1, 64), strconv.FormatFloat(e.Usage, 'f', -1, 64)
+	switch e.Quota {
+	case QuotaProfiles:
+		return fmt.Sprintf("workspace %s owns %s of its %s performance profiles", e.Workspace, usage, limit)
+	case QuotaConcurrentTests:
+		return fmt.Sprintf("workspace %s runs %s of its %s concurrent performance tests", e.Workspace, usage, limit)
+	case QuotaResults:
+		return fmt.Sprintf("workspace %s stores %sGB of results, its quota being %sGB", e.Workspace, strconv.FormatFloat(e.Usage, 'f', 2, 64), limit)
+	case QuotaDesigns:
+		return fmt.Sprintf("workspace %s owns %s of its %s designs", e.Workspace, usage, limit)
+	}
+	return fmt.Sprintf("workspace %s exceeds its %s quota of %s", e.Workspace, e.Quota, limit)
+}
+
+// CapsArtifacts tells whether the quotas cap the profiles, designs or results, which are counted
+// in the database of Meshery Server
+func (q WorkspaceQuotas) CapsArtifacts() bool {
+	return q.MaxProfiles > 0 || q.MaxResultsGB > 0 || q.MaxDesigns > 0
+}
+
+// Check returns the quota the usage leaves no room for one more artifact of the kind in, nil when
+// there is room
+func (q WorkspaceQuotas) Check(workspace, kind string, usage *WorkspaceUsage) *QuotaExceeded {
+	var limit, used float64
+	switch kind {
+	case QuotaProfiles:
+		limit, used = float64(q.MaxProfiles), float64(usage.Profiles)
+	case QuotaConcurrentTests:
+		limit, used = float64(q.MaxConcurrentTests), float64(usage.ConcurrentTests)
+	case QuotaResults:
+		limit, used = q.MaxResultsGB, usage.ResultsGB
+	case QuotaDesigns:
+		limit, used = float64(q.MaxDesigns), float64(usage.Designs)
+	}
+	if limit <= 0 || used < limit {
+		return nil
+	}
+	return &QuotaExceeded{Workspace: workspace, Quota: kind, Limit: limit, Usage: used}
+}
+
+// WorkspaceQuotaInterface defines the methods a type should implement to enforce the quotas of the
+// workspaces
+type WorkspaceQuotaInterface interface {
+	// Workspace returns the workspace the user is a member of, false when the user is a member of none
+	Workspace(userID string) (Workspace, bool)
+	// Usage returns the usage of the quotas of the workspace of the user
+	Usage(userID string) (*WorkspaceUsageReport, error)
+	// Check returns a *QuotaExceeded error when the workspace of the user has no room for one more
+	// artifact of the kind
+	Check(userID, kind string) error
+	// AcquireTest takes one of the concurrent tests of the workspace of the user, returning the
+	// function to call once the test completes, or a *QuotaExceeded error when none is left
+	AcquireTest(userID string) (func(), error)
+	// LoadTestLimits returns the load test limits of the workspace of the user
+	LoadTestLimits(userID string) (*WorkspaceLoadTestLimitsReport, error)
+	// WorkspaceLoadTestLimits returns the load test limits of the workspace with the given name
+	WorkspaceLoadTestLimits(workspace string) (*WorkspaceLoadTestLimitsReport, error)
+	// SetLoadTestLimits sets the load test limits of the workspace, nil limits restoring the
+	// default ones of Meshery Server
+	SetLoadTestLimits(workspace string, limits *LoadTestLimits, updatedBy string) error
+}
+
+// WorkspaceLoadTestLimitsReport is the load test limits the tests of a workspace are checked against
+type WorkspaceLoadTestLimitsReport struct {
+	Workspace string         `json:"workspace"`
+	Limits    LoadTestLimits `json:"limits"`
+	// Default is set when the workspace has no limits of its own, the default limits of Meshery
+	// Server applying to it
+	Default   bool       `json:"default"`
+	UpdatedBy string     `json:"updated_by,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// WorkspaceLoadTestLimits is the load test limits an admin set for a workspace, in place of the
+// default ones of Meshery Server
+type WorkspaceLoadTestLimits struct {
+	Workspace      string `gorm:"primaryKey"`
+	MaxQPS         float64
+	MaxDuration    time.Duration
+	MaxConnections int
+	UpdatedBy      string
+	UpdatedAt      *time.Time
+}
+
+// TableName returns the table of the load test limits of the workspaces
+func (WorkspaceLoadTestLimits) TableName() string { return "workspace_load_test_limits" }
+
+// WorkspaceLoadTestLimitsPersister is the persister for persisting
+// the load test limits of the workspaces on the database
+type WorkspaceLoadTestLimitsPersister struct {
+	DB *database.Handler
+}
+
+// GetWorkspaceLoadTestLimits returns the load test limits of the workspace, nil when it has none
+// of its own
+func (wlp *WorkspaceLoadTestLimitsPersister) GetWorkspaceLoadTestLimits(workspace string) (*WorkspaceLoadTestLimits, error) {
+	limits := []WorkspaceLoadTestLimits{}
+	if err := wlp.DB.Where("workspace = ?", workspace).Limit(1).Find(&limits).Error; err != nil {
+		return nil, err
+	}
+	if len(limits) == 0 {
+		return nil, nil
+	}
+	return &limits[0], nil
+}
+
+// SaveWorkspaceLoadTestLimits persists the load test limits, replacing those of the same workspace
+func (wlp *WorkspaceLoadTestLimitsPersister) SaveWorkspaceLoadTestLimits(limits *WorkspaceLoadTestLimits) error {
+	return wlp.DB.Save(limits).Error
+}
+
+// DeleteWorkspaceLoadTestLimits deletes the load test limits of the workspace
+func (wlp *WorkspaceLoadTestLimitsPersister) DeleteWorkspaceLoadTestLimits(workspace string) error {
+	return wlp.DB.Where("workspace = ?", workspace).Delete(&WorkspaceLoadTestLimits{}).Error
+}
+
+// WorkspaceUsagePersister is the persister for reading
+// the usage of the workspaces from the database
+type WorkspaceUsagePersister struct {
+	DB *database.Handler
+}
+
+// GetUsage returns the profiles, designs and size of the results owned by the members, those
+// in the trash excepted
+func (wup *WorkspaceUsagePersister) GetUsage(members []string) (*WorkspaceUsage, error) {
+	usage := &WorkspaceUsage{}
+	if len(members) == 0 {
+		return usage, nil
+	}
+	if err := wup.DB.Model(&PerformanceProfile{}).Where("user_id IN ?", members).Count(&usage.Profiles).Error; err != nil {
+		return nil, err
+	}
+	if err := wup.DB.Model(&MesheryPattern{}).Where("user_id IN ?", members).Count(&usage.Designs).Error; err != nil {
+		return nil, err
+	}
+	err := wup.DB.Table("meshery_results").
+		Select("COALESCE(SUM(COALESCE(LENGTH(CAST(result AS BLOB)), 0) + COALESCE(LENGTH(CAST(server_metrics AS BLOB)), 0) + COALESCE(LENGTH(CAST(server_board_config AS BLOB)), 0)), 0)").
+		Where("user_id IN ?", members).
+		Row().Scan(&usage.ResultsBytes)
+	if err != nil {
+		return nil, err
+	}
+	usage.ResultsGB = float64(usage.ResultsBytes) / bytesPerGB
+	return usage, nil
+}
@@ -26,6 +26,34 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 	gMux.Handle("/api/system/graphql/query", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GraphqlMiddleware(g))))).Methods("GET", "POST")
 	gMux.Handle("/api/system/graphql/playground", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GraphqlMiddleware(gp))))).Methods("GET", "POST")
 
+	gMux.Handle("/api/system/debug", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ServerDebugHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/telemetry", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.TelemetryHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/system/config", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ServerConfigHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/config/reload", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ServerConfigReloadHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/system/database/schema", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DatabaseSchemaHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/database/schema/migrate", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.MigrateDatabaseSchemaHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/system/database/schema/rollback", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.RollbackDatabaseSchemaHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/system/jobs", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.JobsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/jobs/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetJobHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/jobs/{id}/cancel", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CancelJobHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/system/features", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.FeatureFlagsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/features/{name}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.FeatureFlagHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/system/validation_webhooks", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ValidationWebhooksHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/system/validation_webhooks/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteValidationWebhookHandler)))).
+		Methods("DELETE")
 	gMux.HandleFunc("/api/system/version", h.ServerVersionHandler).
 		Methods("GET")
 	gMux.Handle("/api/extension/version", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ExtensionsVersionHandler)))).
@@ -53,6 +81,25 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("GET")
 	gMux.Handle("/api/user/prefs", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UserPrefsHandler)))).
 		Methods("GET", "POST")
+	gMux.Handle("/api/user/prefs/cli", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UserCLIPreferencesHandler)))).
+		Methods("GET", "POST")
+
+	gMux.Handle("/api/user/prefs/digest", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UserDigestPreferencesHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/user/prefs/digest/send", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SendDigestHandler)))).
+		Methods("POST")
+
+	gMux.Handle("/api/user/dashboard/snapshots", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DashboardSnapshotsHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/user/dashboard/snapshots/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetDashboardSnapshotHandler)))).
+		Methods("GET")
+
+	gMux.Handle("/api/user/workspace/usage", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.WorkspaceUsageHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/user/workspace/limits", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.WorkspaceLoadTestLimitsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/workspaces/{workspace}/limits", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.WorkspaceLoadTestLimitsAdminHandler)))).
+		Methods("GET", "PUT", "DELETE")
 
 	gMux.Handle("/api/user/prefs/perf", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UserTestPreferenceHandler)))).
 		Methods("GET", "POST", "DELETE")
@@ -70,6 +117,12 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("GET")
 	gMux.Handle("/api/system/kubernetes/contexts/current/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SetCurrentContextHandler)))).
 		Methods("POST")
+	gMux.Handle("/api/system/kubernetes/contexts/health", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.K8sContextsHealthHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/kubernetes/contexts/{id}/rate-limit", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.K8sRateLimitHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/kubernetes/contexts/{id}/rate-limit", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SetK8sRateLimitHandler)))).
+		Methods("POST")
 	gMux.Handle("/api/system/kubernetes/contexts/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetContext)))).
 		Methods("GET")
 	gMux.Handle("/api/system/kubernetes/contexts/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteContext)))).
@@ -81,6 +134,23 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("GET")
 	gMux.Handle("/api/perf/profile/result/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetResultHandler)))).
 		Methods("GET")
+	gMux.Handle("/api/perf/results/anomalies", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetResultAnomaliesHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/perf/checkpoints", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.LoadTestCheckpointsHandler)))).
+		Methods("GET")
+	// The writers of the client-side metrics of a test have no session, they bear the write token of the test
+	gMux.HandleFunc("/api/perf/remote_write", h.RemoteWriteHandler).
+		Methods("POST")
+	gMux.Handle("/api/perf/targets", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PerfTargetsHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/perf/targets/{id}/mesh", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PerfTargetMeshHandler)))).
+		Methods("PUT")
+	gMux.Handle("/api/perf/hooks", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ResultHooksHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/perf/hooks/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteResultHookHandler)))).
+		Methods("DELETE")
+	gMux.Handle("/api/perf/hooks/{id}/run", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.RunResultHookHandler)))).
+		Methods("POST")
 	gMux.Handle("/api/mesh", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetSMPServiceMeshes)))).
 		Methods("GET")
 
@@ -90,11 +160,25 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("GET")
 
 	gMux.Handle("/api/system/adapter/manage", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.MeshAdapterConfigHandler))))
+	gMux.Handle("/api/system/adapter/register", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.AdapterRegistrationHandler)))).
+		Methods("POST", "DELETE")
 	gMux.Handle("/api/system/adapter/operation", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.MeshOpsHandler)))).
 		Methods("POST")
 	gMux.Handle("/api/system/adapters", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.AdaptersHandler))))
+	gMux.Handle("/api/system/adapters/health", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.AdaptersHealthHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/meshes", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.MeshesStatusHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/meshes/{mesh}/injection", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.MeshInjectionHandler)))).
+		Methods("POST")
 	gMux.Handle("/api/events", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.EventStreamHandler)))).
 		Methods("GET")
+	gMux.Handle("/api/system/events", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.EventsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/events/acknowledge", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.AcknowledgeEventsHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/system/events/resolve", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ResolveEventsHandler)))).
+		Methods("POST")
 
 	gMux.Handle("/api/telemetry/metrics/grafana/config", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GrafanaConfigHandler)))).
 		Methods("GET", "POST", "DELETE")
@@ -114,6 +198,10 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("POST")
 	gMux.Handle("/api/telemetry/metrics/query", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PrometheusQueryHandler)))).
 		Methods("GET")
+	gMux.Handle("/api/telemetry/metrics/connections", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PrometheusConnectionsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/telemetry/metrics/connections/{name}/query", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PrometheusConnectionQueryHandler)))).
+		Methods("GET")
 	gMux.Handle("/api/prometheus/query_range", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.PrometheusQueryRangeHandler)))).
 		Methods("GET")
 	gMux.Handle("/api/telemetry/metrics/ping", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PrometheusPingHandler)))).
@@ -122,24 +210,79 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("GET")
 	gMux.Handle("/api/telemetry/metrics/boards", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SaveSelectedPrometheusBoardsHandler)))).
 		Methods("POST")
+
+	gMux.Handle("/api/telemetry/metrics/datadog/config", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DatadogConfigHandler)))).
+		Methods("GET", "POST", "DELETE")
+	gMux.Handle("/api/telemetry/metrics/datadog/query", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DatadogQueryHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/telemetry/metrics/newrelic/config", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.NewRelicConfigHandler)))).
+		Methods("GET", "POST", "DELETE")
+	gMux.Handle("/api/telemetry/metrics/newrelic/query", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.NewRelicQueryHandler)))).
+		Methods("GET")
+
 	gMux.Handle("/api/system/meshsync/prometheus", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ScanPrometheusHandler))))
 
 	gMux.Handle("/api/system/meshsync/grafana", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ScanPromGrafanaHandler))))
 
+	gMux.Handle("/api/system/meshsync/gateway", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.FeatureFlagMiddleware(models.FeatureGatewayAPI, h.GatewayAPIResourcesHandler))))).
+		Methods("GET")
+
 	gMux.Handle("/api/pattern/deploy", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternFileHandler)))).
 		Methods("POST", "DELETE")
-	gMux.Handle("/api/pattern", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternFileRequestHandler)))).
+	gMux.Handle("/api/pattern", h.ETagMiddleware(h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternFileRequestHandler))))).
 		Methods("POST", "GET")
-	gMux.Handle("/api/pattern/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetMesheryPatternHandler)))).
+	gMux.Handle("/api/pattern/{id}", h.ETagMiddleware(h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetMesheryPatternHandler))))).
 		Methods("GET")
 	gMux.Handle("/api/pattern/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteMesheryPatternHandler)))).
 		Methods("DELETE")
+	gMux.Handle("/api/pattern/{id}/share", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternShareHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/pattern/{id}/review", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternReviewHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/pattern/{id}/review/{reviewID}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternReviewHandler)))).
+		Methods("PUT")
+	gMux.Handle("/api/pattern/{id}/environments/{environment}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternEnvironmentDeployHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/{id}/promote", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternPromotionHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/{id}/upgrade", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternUpgradeHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/pattern/{id}/snapshot", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternSnapshotHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/{id}/restore", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.RestoreMesheryPatternHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/{id}/schedules", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternScheduleHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/pattern/{id}/deployments", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternDeploymentsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/{id}/logs", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DesignLogsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/{id}/events", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DesignEventsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/{id}/port-forward", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DesignPortForwardHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/{id}/exec", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DesignExecHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/identity/workloads", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.WorkloadIdentitiesHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/identity/workloads/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteWorkloadIdentityHandler)))).
+		Methods("DELETE")
+	gMux.Handle("/api/environments", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.EnvironmentsHandler)))).
+		Methods("GET", "POST")
+	gMux.Handle("/api/environments/{name}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteEnvironmentHandler)))).
+		Methods("DELETE")
 	gMux.Handle("/api/patterns/delete", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteMultiMesheryPatternsHandler)))).
 		Methods("POST")
-	gMux.HandleFunc("/api/oam/{type}", h.OAMRegisterHandler).Methods("GET", "POST")
-	gMux.HandleFunc("/api/oam/{type}/{name}", h.OAMComponentDetailsHandler).Methods("GET")
-	gMux.HandleFunc("/api/oam/{type}/{name}/{id}", h.OAMComponentDetailByIDHandler).Methods("GET")
-	gMux.HandleFunc("/api/experimental/oam/{type}", h.OAMRegisterHandler).Methods("GET", "POST")
+	gMux.Handle("/api/patterns/schedules", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DesignSchedulesHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/patterns/schedules/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DesignSchedulesHandler)))).
+		Methods("DELETE")
+	gMux.Handle("/api/patterns/gc", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DesignGCHandler)))).
+		Methods("GET", "DELETE")
+	gMux.Handle("/api/oam/{type}", h.ETagMiddleware(http.HandlerFunc(h.OAMRegisterHandler))).Methods("GET", "POST")
+	gMux.Handle("/api/oam/{type}/{name}", h.ETagMiddleware(http.HandlerFunc(h.OAMComponentDetailsHandler))).Methods("GET")
+	gMux.Handle("/api/oam/{type}/{name}/{id}", h.ETagMiddleware(http.HandlerFunc(h.OAMComponentDetailByIDHandler))).Methods("GET")
+	gMux.Handle("/api/experimental/oam/{type}", h.ETagMiddleware(http.HandlerFunc(h.OAMRegisterHandler))).Methods("GET", "POST")
 
 	gMux.Handle("/api/filter/deploy", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.FilterFileHandler)))).
 		Methods("POST", "DELETE")
@@ -160,12 +303,18 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("GET")
 	gMux.Handle("/api/application/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteMesheryApplicationHandler)))).
 		Methods("DELETE")
+	gMux.Handle("/api/application/{id}/status", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ApplicationStatusHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/application/{id}/rollout/restart", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ApplicationRolloutRestartHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/application/{id}/scale", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ApplicationScaleHandler)))).
+		Methods("POST")
 
-	gMux.Handle("/api/user/performance/profiles", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPerformanceProfilesHandler)))).
+	gMux.Handle("/api/user/performance/profiles", h.ETagMiddleware(h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPerformanceProfilesHandler))))).
 		Methods("GET")
 	gMux.Handle("/api/user/performance/profiles/results", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.FetchAllResultsHandler)))).
 		Methods("GET")
-	gMux.Handle("/api/user/performance/profiles/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPerformanceProfileHandler)))).
+	gMux.Handle("/api/user/performance/profiles/{id}", h.ETagMiddleware(h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPerformanceProfileHandler))))).
 		Methods("GET")
 	gMux.Handle("/api/user/performance/profiles/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeletePerformanceProfileHandler)))).
 		Methods("DELETE")
@@ -175,6 +324,12 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("GET")
 	gMux.Handle("/api/user/performance/profiles/{id}/results", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.FetchResultsHandler)))).
 		Methods("GET")
+	gMux.Handle("/api/user/performance/profiles/{id}/metrics", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPerformanceProfileMetricsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/user/performance/profiles/{id}/trend", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPerformanceProfileTrendHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/user/performance/profiles/{id}/restore", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.RestorePerformanceProfileHandler)))).
+		Methods("POST")
 
 	gMux.Handle("/api/user/schedules", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetSchedulesHandler)))).
 		Methods("GET")
@@ -185,6 +340,11 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 	gMux.Handle("/api/user/schedules", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SaveScheduleHandler)))).
 		Methods("POST")
 
+	gMux.Handle("/api/user/ownership/transfer", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.OwnershipTransferHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/user/trash", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.TrashHandler)))).
+		Methods("GET")
+
 	//gMux.PathPrefix("/api/system/graphql").Handler(h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GraphqlSystemHandler)))).Methods("GET", "POST")
 
 	gMux.Handle("/user/logout", h.ProviderMiddleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {